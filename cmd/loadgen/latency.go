@@ -0,0 +1,86 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+)
+
+// latencyRecorder collects per-RPC latency samples and error counts
+type latencyRecorder struct {
+	mu      sync.Mutex
+	samples map[string][]time.Duration
+	errors  map[string]int
+}
+
+// newLatencyRecorder creates an empty latency recorder
+func newLatencyRecorder() *latencyRecorder {
+	return &latencyRecorder{
+		samples: make(map[string][]time.Duration),
+		errors:  make(map[string]int),
+	}
+}
+
+// Record stores a single RPC observation
+func (r *latencyRecorder) Record(method string, d time.Duration, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err != nil {
+		r.errors[method]++
+		return
+	}
+	r.samples[method] = append(r.samples[method], d)
+}
+
+// Report writes a latency percentile table for every recorded method
+func (r *latencyRecorder) Report(w io.Writer) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	methods := make([]string, 0, len(r.samples)+len(r.errors))
+	seen := make(map[string]bool)
+	for m := range r.samples {
+		methods = append(methods, m)
+		seen[m] = true
+	}
+	for m := range r.errors {
+		if !seen[m] {
+			methods = append(methods, m)
+		}
+	}
+	sort.Strings(methods)
+
+	fmt.Fprintf(w, "%-20s %8s %8s %10s %10s %10s %10s %10s\n", "method", "ok", "errors", "p50", "p90", "p95", "p99", "max")
+	for _, m := range methods {
+		samples := r.samples[m]
+		sort.Slice(samples, func(i, j int) bool { return samples[i] < samples[j] })
+		fmt.Fprintf(w, "%-20s %8d %8d %10s %10s %10s %10s %10s\n",
+			m,
+			len(samples),
+			r.errors[m],
+			percentile(samples, 0.50),
+			percentile(samples, 0.90),
+			percentile(samples, 0.95),
+			percentile(samples, 0.99),
+			percentile(samples, 1.00),
+		)
+	}
+}
+
+// percentile returns the p-th percentile of sorted samples (nearest-rank)
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	idx := int(float64(len(sorted))*p+0.5) - 1
+	if idx < 0 {
+		idx = 0
+	}
+	if idx >= len(sorted) {
+		idx = len(sorted) - 1
+	}
+	return sorted[idx].Round(time.Microsecond)
+}
@@ -0,0 +1,295 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pb "catalog-proj/proto/product/v1"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	target        = flag.String("target", "localhost:50051", "gRPC address of the catalog server")
+	productCount  = flag.Int("products", 1000, "Number of products to generate")
+	categories    = flag.String("categories", "electronics:5,books:3,clothing:2", "Category distribution as name:weight pairs")
+	discountRatio = flag.Float64("discount-ratio", 0.3, "Fraction of generated products that receive an active discount")
+	concurrency   = flag.Int("concurrency", 16, "Number of concurrent workers")
+	reads         = flag.Int("reads", 2000, "Number of read RPCs (Get/List) to issue after seeding")
+	pageSize      = flag.Int("page-size", 50, "Page size used for ListProducts calls")
+	seedOnly      = flag.Bool("seed-only", false, "Only generate the catalog, skip the read benchmark")
+	skipSeed      = flag.Bool("skip-seed", false, "Skip catalog generation and benchmark reads against existing data")
+	randSeed      = flag.Int64("rand-seed", time.Now().UnixNano(), "Random seed for reproducible catalogs")
+	useGzip       = flag.Bool("gzip", false, "Compress requests and responses with gzip")
+)
+
+// weightedCategory is a category name with its relative weight
+type weightedCategory struct {
+	name   string
+	weight int
+}
+
+func main() {
+	flag.Parse()
+
+	ctx := context.Background()
+	rng := rand.New(rand.NewSource(*randSeed))
+
+	dist, err := parseCategories(*categories)
+	if err != nil {
+		slog.Error("Invalid category distribution", "error", err)
+		os.Exit(1)
+	}
+
+	dialOpts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if *useGzip {
+		dialOpts = append(dialOpts, grpc.WithDefaultCallOptions(grpc.UseCompressor(gzip.Name)))
+	}
+	conn, err := grpc.NewClient(*target, dialOpts...)
+	if err != nil {
+		slog.Error("Failed to connect to server", "target", *target, "error", err)
+		os.Exit(1)
+	}
+	defer conn.Close()
+
+	client := pb.NewProductServiceClient(conn)
+	recorder := newLatencyRecorder()
+
+	var productIDs []string
+	if !*skipSeed {
+		slog.Info("Generating catalog", "products", *productCount, "categories", *categories, "discount_ratio", *discountRatio)
+		start := time.Now()
+		productIDs = seedCatalog(ctx, client, recorder, dist, rng)
+		slog.Info("Catalog generated", "created", len(productIDs), "elapsed", time.Since(start).Round(time.Millisecond))
+	} else {
+		productIDs, err = loadProductIDs(ctx, client)
+		if err != nil {
+			slog.Error("Failed to load existing products", "error", err)
+			os.Exit(1)
+		}
+		slog.Info("Loaded existing products", "count", len(productIDs))
+	}
+
+	if !*seedOnly {
+		if len(productIDs) == 0 {
+			slog.Error("No products available for the read benchmark")
+			os.Exit(1)
+		}
+		slog.Info("Running read benchmark", "reads", *reads, "concurrency", *concurrency)
+		start := time.Now()
+		runReads(ctx, client, recorder, productIDs, dist, rng)
+		elapsed := time.Since(start)
+		slog.Info("Read benchmark finished", "elapsed", elapsed.Round(time.Millisecond), "rps", fmt.Sprintf("%.1f", float64(*reads)/elapsed.Seconds()))
+	}
+
+	fmt.Println()
+	recorder.Report(os.Stdout)
+}
+
+// seedCatalog creates, activates, and discounts products according to the configured distribution
+func seedCatalog(ctx context.Context, client pb.ProductServiceClient, recorder *latencyRecorder, dist []weightedCategory, rng *rand.Rand) []string {
+	type job struct {
+		index    int
+		category string
+		price    int64
+		discount bool
+	}
+
+	// Pre-compute jobs so the catalog is reproducible for a given seed
+	jobs := make(chan job, *productCount)
+	for i := 0; i < *productCount; i++ {
+		jobs <- job{
+			index:    i,
+			category: pickCategory(dist, rng),
+			price:    int64(rng.Intn(99900) + 100),
+			discount: rng.Float64() < *discountRatio,
+		}
+	}
+	close(jobs)
+
+	var (
+		mu  sync.Mutex
+		ids []string
+		wg  sync.WaitGroup
+	)
+	for w := 0; w < *concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				id, err := createProduct(ctx, client, recorder, j.index, j.category, j.price, j.discount)
+				if err != nil {
+					slog.Warn("Failed to seed product", "index", j.index, "error", err)
+					continue
+				}
+				mu.Lock()
+				ids = append(ids, id)
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	return ids
+}
+
+// createProduct creates a single product, activates it, and optionally applies a discount
+func createProduct(ctx context.Context, client pb.ProductServiceClient, recorder *latencyRecorder, index int, category string, price int64, discount bool) (string, error) {
+	start := time.Now()
+	resp, err := client.CreateProduct(ctx, &pb.CreateProductRequest{
+		Name:        fmt.Sprintf("Loadgen %s product %d", category, index),
+		Description: fmt.Sprintf("Synthetic %s product generated by loadgen", category),
+		Category:    category,
+		BasePrice:   &pb.Money{Amount: price},
+	})
+	recorder.Record("CreateProduct", time.Since(start), err)
+	if err != nil {
+		return "", fmt.Errorf("create: %w", err)
+	}
+
+	start = time.Now()
+	_, err = client.ActivateProduct(ctx, &pb.ActivateProductRequest{ProductId: resp.ProductId})
+	recorder.Record("ActivateProduct", time.Since(start), err)
+	if err != nil {
+		return resp.ProductId, fmt.Errorf("activate: %w", err)
+	}
+
+	if discount {
+		now := time.Now()
+		start = time.Now()
+		_, err = client.ApplyDiscount(ctx, &pb.ApplyDiscountRequest{
+			ProductId: resp.ProductId,
+			Discount: &pb.Discount{
+				Id:                 fmt.Sprintf("loadgen-%d", index),
+				StartDate:          timestamppb.New(now.Add(-time.Minute)),
+				EndDate:            timestamppb.New(now.Add(30 * 24 * time.Hour)),
+				PercentBasisPoints: proto.Int32(int32(500 + index%40*100)),
+			},
+		})
+		recorder.Record("ApplyDiscount", time.Since(start), err)
+		if err != nil {
+			return resp.ProductId, fmt.Errorf("apply discount: %w", err)
+		}
+	}
+
+	return resp.ProductId, nil
+}
+
+// runReads issues a mix of GetProduct and ListProducts calls against the catalog
+func runReads(ctx context.Context, client pb.ProductServiceClient, recorder *latencyRecorder, ids []string, dist []weightedCategory, rng *rand.Rand) {
+	type job struct {
+		list     bool
+		id       string
+		category string
+		offset   int32
+	}
+
+	jobs := make(chan job, *reads)
+	for i := 0; i < *reads; i++ {
+		j := job{list: rng.Intn(2) == 0}
+		if j.list {
+			if rng.Intn(2) == 0 {
+				j.category = pickCategory(dist, rng)
+			}
+			j.offset = int32(rng.Intn(len(ids)/2 + 1))
+		} else {
+			j.id = ids[rng.Intn(len(ids))]
+		}
+		jobs <- j
+	}
+	close(jobs)
+
+	var wg sync.WaitGroup
+	for w := 0; w < *concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				start := time.Now()
+				if j.list {
+					req := &pb.ListProductsRequest{Limit: int32(*pageSize), Offset: j.offset}
+					if j.category != "" {
+						req.Category = &j.category
+					}
+					_, err := client.ListProducts(ctx, req)
+					recorder.Record("ListProducts", time.Since(start), err)
+					continue
+				}
+				_, err := client.GetProduct(ctx, &pb.GetProductRequest{ProductId: j.id})
+				recorder.Record("GetProduct", time.Since(start), err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// loadProductIDs pages through the existing catalog to collect product IDs
+func loadProductIDs(ctx context.Context, client pb.ProductServiceClient) ([]string, error) {
+	var ids []string
+	const page = 500
+	for offset := int32(0); ; offset += page {
+		resp, err := client.ListProducts(ctx, &pb.ListProductsRequest{Limit: page, Offset: offset})
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range resp.Products {
+			ids = append(ids, p.Id)
+		}
+		if len(resp.Products) < page {
+			return ids, nil
+		}
+	}
+}
+
+// parseCategories parses "name:weight,name:weight" into a weighted distribution
+func parseCategories(spec string) ([]weightedCategory, error) {
+	var dist []weightedCategory
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		name, weightStr, found := strings.Cut(part, ":")
+		weight := 1
+		if found {
+			w, err := strconv.Atoi(weightStr)
+			if err != nil || w <= 0 {
+				return nil, fmt.Errorf("invalid weight for category %q: %s", name, weightStr)
+			}
+			weight = w
+		}
+		dist = append(dist, weightedCategory{name: strings.TrimSpace(name), weight: weight})
+	}
+	if len(dist) == 0 {
+		return nil, fmt.Errorf("at least one category is required")
+	}
+	return dist, nil
+}
+
+// pickCategory selects a category according to its weight
+func pickCategory(dist []weightedCategory, rng *rand.Rand) string {
+	total := 0
+	for _, c := range dist {
+		total += c.weight
+	}
+	n := rng.Intn(total)
+	for _, c := range dist {
+		if n < c.weight {
+			return c.name
+		}
+		n -= c.weight
+	}
+	return dist[len(dist)-1].name
+}
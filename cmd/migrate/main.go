@@ -0,0 +1,184 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"catalog-proj/internal/pkg/migrate"
+)
+
+const usage = `Usage:
+  migrate plan   [-database <db>] [-dir migrations] [-to <version>]
+  migrate apply  [-database <db>] [-dir migrations] [-to <version>]
+  migrate status [-database <db>] [-dir migrations]
+
+plan prints the statements pending migrations would run, diffed against the live schema.
+apply runs them and records the versions in schema_migrations.
+-to stops plan and apply after that version, e.g. 031_outbox_by_aggregate.
+status lists every migration file as applied, applied (inferred) or pending.
+
+The database defaults to CATALOG_SPANNER_DATABASE, or the emulator database when SPANNER_EMULATOR_HOST is set.
+`
+
+const emulatorDatabase = "projects/test-project/instances/test-instance/databases/test-db"
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+	var err error
+	switch os.Args[1] {
+	case "plan":
+		err = runPlan(ctx, os.Args[2:])
+	case "apply":
+		err = runApply(ctx, os.Args[2:])
+	case "status":
+		err = runStatus(ctx, os.Args[2:])
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		slog.Error("Migrate command failed", "command", os.Args[1], "error", err)
+		os.Exit(1)
+	}
+}
+
+// parseFlags parses the flags shared by every subcommand
+// to is only accepted by plan and apply
+func parseFlags(name string, args []string) (database, dir, to string, err error) {
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+	db := fs.String("database", defaultDatabase(), "Spanner database (projects/{p}/instances/{i}/databases/{d})")
+	migrations := fs.String("dir", "migrations", "Directory of .sql migrations")
+	var target *string
+	if name != "status" {
+		target = fs.String("to", "", "Last migration version to run (default: all)")
+	}
+	fs.Parse(args)
+	if *db == "" {
+		return "", "", "", fmt.Errorf("-database is required (or set SPANNER_EMULATOR_HOST for the emulator)")
+	}
+	if target != nil {
+		to = *target
+	}
+	return *db, *migrations, to, nil
+}
+
+// defaultDatabase mirrors the server: the configured database, else the emulator's
+func defaultDatabase() string {
+	if db := os.Getenv("CATALOG_SPANNER_DATABASE"); db != "" {
+		return db
+	}
+	if os.Getenv("SPANNER_EMULATOR_HOST") != "" {
+		return emulatorDatabase
+	}
+	return ""
+}
+
+// runPlan prints the statements apply would run without changing the database
+func runPlan(ctx context.Context, args []string) error {
+	database, dir, to, err := parseFlags("plan", args)
+	if err != nil {
+		return err
+	}
+	m, err := migrate.NewMigrator(ctx, database, dir)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	steps, err := m.Plan(ctx, to)
+	if err != nil {
+		return err
+	}
+	printSteps(steps)
+	return nil
+}
+
+// runApply runs the pending migrations, creating the database first on a fresh emulator
+func runApply(ctx context.Context, args []string) error {
+	database, dir, to, err := parseFlags("apply", args)
+	if err != nil {
+		return err
+	}
+	if os.Getenv("SPANNER_EMULATOR_HOST") != "" {
+		db, err := migrate.ParseDatabase(database)
+		if err != nil {
+			return err
+		}
+		if err := migrate.EnsureInstance(ctx, db); err != nil {
+			return err
+		}
+		if _, err := migrate.EnsureDatabase(ctx, db, dir); err != nil {
+			return err
+		}
+	}
+
+	m, err := migrate.NewMigrator(ctx, database, dir)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	steps, err := m.Apply(ctx, to)
+	if err != nil {
+		return err
+	}
+	printSteps(steps)
+	slog.Info("Migrations applied", "database", database, "versions", len(steps))
+	return nil
+}
+
+// runStatus lists every migration file and whether it has been applied
+func runStatus(ctx context.Context, args []string) error {
+	database, dir, _, err := parseFlags("status", args)
+	if err != nil {
+		return err
+	}
+	m, err := migrate.NewMigrator(ctx, database, dir)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tSTATE\tAPPLIED AT")
+	for _, s := range statuses {
+		appliedAt := "-"
+		if !s.AppliedAt.IsZero() {
+			appliedAt = s.AppliedAt.UTC().Format("2006-01-02 15:04:05Z")
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", s.Version, s.State, appliedAt)
+	}
+	return w.Flush()
+}
+
+// printSteps writes the plan as runnable SQL, with statements already present in the schema commented out
+func printSteps(steps []migrate.Step) {
+	if len(steps) == 0 {
+		fmt.Println("-- Schema is up to date")
+		return
+	}
+	for _, step := range steps {
+		fmt.Printf("-- %s\n", step.Version)
+		for _, stmt := range step.Statements {
+			fmt.Printf("%s;\n", stmt)
+		}
+		for _, stmt := range step.Skipped {
+			fmt.Printf("-- already present: %s\n", strings.ReplaceAll(stmt, "\n", " "))
+		}
+		fmt.Println()
+	}
+}
@@ -0,0 +1,370 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+	"unicode"
+
+	"catalog-proj/internal/pkg/migrate"
+)
+
+// modelgen writes a model package's table code from the migrations: the table name, field
+// constants, the row struct, AllColumns, the mutation builders and optionally a scanner's field map
+// Run it through go:generate in the model package, e.g.
+//
+//	//go:generate go run ../../../cmd/modelgen -table products -type Product
+var (
+	migrationsDir = flag.String("migrations", "../../../migrations", "Directory of .sql migrations")
+	tableName     = flag.String("table", "", "Spanner table to generate")
+	typeName      = flag.String("type", "", "Go struct name for a row")
+	noun          = flag.String("noun", "", "Singular noun for doc comments (default: the type name in lower case words)")
+	pkgName       = flag.String("package", os.Getenv("GOPACKAGE"), "Go package name (set by go generate)")
+	out           = flag.String("out", "data_gen.go", "Output file")
+	scanner       = flag.Bool("scanner", false, "Also generate fieldPointers, the decode destinations for a hand-written Scanner")
+)
+
+// initialisms are column name words written in upper case, e.g. product_id -> ProductID
+var initialisms = map[string]bool{"id": true, "sku": true, "gtin": true, "url": true, "api": true, "json": true}
+
+func main() {
+	flag.Parse()
+	if *tableName == "" || *typeName == "" || *pkgName == "" {
+		fmt.Fprintln(os.Stderr, "modelgen: -table, -type and -package (or GOPACKAGE) are required")
+		os.Exit(2)
+	}
+	if *noun == "" {
+		*noun = words(*typeName)
+	}
+
+	src, err := generate()
+	if err != nil {
+		slog.Error("modelgen failed", "table", *tableName, "error", err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		slog.Error("modelgen failed to write output", "file", *out, "error", err)
+		os.Exit(1)
+	}
+}
+
+// field is one column with its Go names and types
+type field struct {
+	column   migrate.Column
+	constant string // field name constant and struct field, e.g. ProductID
+	goType   string
+	// null wraps the value in UpdateMut so a nil pointer is written as an explicit NULL ("" when not needed)
+	null string
+}
+
+// generate renders the table's code
+func generate() ([]byte, error) {
+	statements, err := migrate.ReadMigrations(*migrationsDir)
+	if err != nil {
+		return nil, err
+	}
+	table, ok := migrate.BuildTables(statements)[*tableName]
+	if !ok {
+		return nil, fmt.Errorf("table %s is not created by the migrations in %s", *tableName, *migrationsDir)
+	}
+
+	fields := make([]field, 0, len(table.Columns))
+	for _, c := range table.Columns {
+		// Generated columns are computed by Spanner and cannot be written, so they stay out of the row
+		// struct and mutations; code that queries one declares its constant by hand
+		if c.Generated {
+			continue
+		}
+		goType, null, err := goTypeOf(c)
+		if err != nil {
+			return nil, fmt.Errorf("column %s.%s: %w", table.Name, c.Name, err)
+		}
+		fields = append(fields, field{column: c, constant: identifier(c.Name), goType: goType, null: null})
+	}
+	byColumn := map[string]field{}
+	for _, f := range fields {
+		byColumn[f.column.Name] = f
+	}
+
+	var b bytes.Buffer
+	p := func(format string, args ...any) { fmt.Fprintf(&b, format, args...) }
+	recv := strings.ToLower((*typeName)[:1])
+	article := "a"
+	if strings.ContainsRune("aeiou", rune((*noun)[0])) {
+		article = "an"
+	}
+	rows := strings.ReplaceAll(table.Name, "_", " ")
+
+	p("// Code generated by modelgen from %s. DO NOT EDIT.\n\n", *migrationsDir)
+	p("package %s\n\n", *pkgName)
+
+	imports := map[string]bool{`"cloud.google.com/go/spanner"`: true}
+	for _, f := range fields {
+		if strings.Contains(f.goType, "big.") {
+			imports[`"math/big"`] = true
+		}
+		if strings.Contains(f.goType, "time.") {
+			imports[`"time"`] = true
+		}
+	}
+	p("import (\n")
+	for _, imp := range []string{`"math/big"`, `"time"`, ""} {
+		if imports[imp] {
+			p("\t%s\n", imp)
+		}
+	}
+	p("\n\t\"cloud.google.com/go/spanner\"\n)\n\n")
+
+	p("// TableName is the Spanner table name for %s\n", rows)
+	p("const TableName = %q\n\n", table.Name)
+
+	p("// Field name constants for the %s table\n", rows)
+	p("const (\n")
+	for _, f := range fields {
+		p("\t%s = %q\n", f.constant, f.column.Name)
+	}
+	p(")\n\n")
+
+	p("// %s represents the database model for %s\n", *typeName, rows)
+	p("type %s struct {\n", *typeName)
+	for _, f := range fields {
+		p("\t%s %s `spanner:%q`\n", f.constant, f.goType, f.column.Name)
+	}
+	p("}\n\n")
+
+	p("// AllColumns returns all column names in table order\n")
+	p("func AllColumns() []string {\n\treturn []string{\n")
+	for _, f := range fields {
+		p("\t\t%s,\n", f.constant)
+	}
+	p("\t}\n}\n\n")
+
+	var commitTS []string
+	for _, f := range fields {
+		if f.column.CommitTimestamp {
+			commitTS = append(commitTS, f.constant)
+		}
+	}
+	p("// InsertMut creates a Spanner insert mutation for %s %s\n", article, *noun)
+	if len(commitTS) > 0 {
+		p("// %s %s written as the commit timestamp and only set on reads\n", strings.Join(commitTS, ", "), verb(len(commitTS)))
+	}
+	p("func (%s *%s) InsertMut() *spanner.Mutation {\n", recv, *typeName)
+	p("\treturn spanner.Insert(TableName, AllColumns(), []interface{}{\n")
+	for _, f := range fields {
+		if f.column.CommitTimestamp {
+			p("\t\tspanner.CommitTimestamp,\n")
+			continue
+		}
+		p("\t\t%s.%s,\n", recv, f.constant)
+	}
+	p("\t})\n}\n\n")
+
+	var keyConsts, keyValues []string
+	for _, k := range table.PrimaryKey {
+		f, ok := byColumn[k]
+		if !ok {
+			return nil, fmt.Errorf("primary key column %s.%s is not a column", table.Name, k)
+		}
+		keyConsts = append(keyConsts, f.constant)
+		keyValues = append(keyValues, recv+"."+f.constant)
+	}
+
+	p("// UpdateMut creates a Spanner update mutation for %s %s\n", article, *noun)
+	p("// Nil optional fields are written as explicit NULLs\n")
+	// Key columns keep their value so the update addresses the stored row
+	var restamped []string
+	for _, f := range fields {
+		if f.column.CommitTimestamp && !slices.Contains(table.PrimaryKey, f.column.Name) {
+			restamped = append(restamped, f.constant)
+		}
+	}
+	if len(restamped) > 0 {
+		p("// %s %s written as the commit timestamp\n", strings.Join(restamped, ", "), verb(len(restamped)))
+	}
+	p("// Note: columns must include the primary key (%s)\n", strings.Join(keyConsts, ", "))
+	p("func (%s *%s) UpdateMut(columns []string) *spanner.Mutation {\n", recv, *typeName)
+	p("\tvalues := make([]interface{}, 0, len(columns))\n")
+	p("\tfor _, col := range columns {\n\t\tswitch col {\n")
+	for _, f := range fields {
+		p("\t\tcase %s:\n", f.constant)
+		if slices.Contains(restamped, f.constant) {
+			p("\t\t\tvalues = append(values, spanner.CommitTimestamp)\n")
+		} else if f.null != "" {
+			p("\t\t\tvalues = append(values, %s(%s.%s))\n", f.null, recv, f.constant)
+		} else {
+			p("\t\t\tvalues = append(values, %s.%s)\n", recv, f.constant)
+		}
+	}
+	p("\t\t}\n\t}\n\n")
+	p("\treturn spanner.Update(TableName, columns, values)\n}\n\n")
+
+	p("// DeleteMut creates a Spanner delete mutation for %s %s\n", article, *noun)
+	p("func (%s *%s) DeleteMut() *spanner.Mutation {\n", recv, *typeName)
+	p("\treturn spanner.Delete(TableName, spanner.Key{%s})\n}\n\n", strings.Join(keyValues, ", "))
+
+	if *scanner {
+		p("// fieldPointers returns a decode destination for every column, keyed by column name\n")
+		p("func (%s *%s) fieldPointers() map[string]interface{} {\n", recv, *typeName)
+		p("\treturn map[string]interface{}{\n")
+		for _, f := range fields {
+			p("\t\t%s: &%s.%s,\n", f.constant, recv, f.constant)
+		}
+		p("\t}\n}\n")
+	}
+
+	helpers := map[string]bool{}
+	for _, f := range fields {
+		helpers[f.null] = true
+	}
+	for _, h := range nullHelpers {
+		if helpers[h.name] {
+			p("\n%s", h.src)
+		}
+	}
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("generated code does not parse: %w", err)
+	}
+	return src, nil
+}
+
+// verb returns "is" or "are" to agree with a list of n columns
+func verb(n int) string {
+	if n == 1 {
+		return "is"
+	}
+	return "are"
+}
+
+// goTypeOf maps a Spanner column to its Go field type and UpdateMut NULL helper
+// NOT NULL scalars are values and nullable scalars pointers; NUMERIC is always *big.Rat
+func goTypeOf(c migrate.Column) (string, string, error) {
+	base := c.BaseType()
+	if strings.HasPrefix(base, "ARRAY<") {
+		switch base {
+		case "ARRAY<STRING>":
+			return "[]string", "", nil
+		case "ARRAY<INT64>":
+			return "[]int64", "", nil
+		case "ARRAY<FLOAT64>":
+			return "[]float64", "", nil
+		case "ARRAY<BOOL>":
+			return "[]bool", "", nil
+		}
+		return "", "", fmt.Errorf("unsupported type %s", c.Type)
+	}
+
+	var goType, null string
+	switch base {
+	case "STRING", "JSON":
+		goType, null = "string", "nullString"
+	case "INT64":
+		goType, null = "int64", "nullInt"
+	case "FLOAT64":
+		goType, null = "float64", "nullFloat"
+	case "BOOL":
+		goType, null = "bool", "nullBool"
+	case "TIMESTAMP":
+		goType, null = "time.Time", "nullTime"
+	case "NUMERIC":
+		if c.NotNull {
+			return "*big.Rat", "", nil
+		}
+		return "*big.Rat", "nullNumeric", nil
+	case "BYTES":
+		return "[]byte", "", nil
+	default:
+		return "", "", fmt.Errorf("unsupported type %s", c.Type)
+	}
+	if c.NotNull {
+		return goType, "", nil
+	}
+	return "*" + goType, null, nil
+}
+
+// nullHelpers convert optional fields to Spanner NULL-able values; only those a table uses are emitted
+var nullHelpers = []struct{ name, src string }{
+	{"nullString", `// nullString converts an optional string to a Spanner value, NULL when nil
+func nullString(s *string) spanner.NullString {
+	if s == nil {
+		return spanner.NullString{}
+	}
+	return spanner.NullString{StringVal: *s, Valid: true}
+}
+`},
+	{"nullInt", `// nullInt converts an optional INT64 to a Spanner value, NULL when nil
+func nullInt(i *int64) spanner.NullInt64 {
+	if i == nil {
+		return spanner.NullInt64{}
+	}
+	return spanner.NullInt64{Int64: *i, Valid: true}
+}
+`},
+	{"nullFloat", `// nullFloat converts an optional FLOAT64 to a Spanner value, NULL when nil
+func nullFloat(f *float64) spanner.NullFloat64 {
+	if f == nil {
+		return spanner.NullFloat64{}
+	}
+	return spanner.NullFloat64{Float64: *f, Valid: true}
+}
+`},
+	{"nullBool", `// nullBool converts an optional BOOL to a Spanner value, NULL when nil
+func nullBool(b *bool) spanner.NullBool {
+	if b == nil {
+		return spanner.NullBool{}
+	}
+	return spanner.NullBool{Bool: *b, Valid: true}
+}
+`},
+	{"nullNumeric", `// nullNumeric converts an optional NUMERIC to a Spanner value, NULL when nil
+func nullNumeric(r *big.Rat) spanner.NullNumeric {
+	if r == nil {
+		return spanner.NullNumeric{}
+	}
+	return spanner.NullNumeric{Numeric: *r, Valid: true}
+}
+`},
+	{"nullTime", `// nullTime converts an optional timestamp to a Spanner value, NULL when nil
+func nullTime(t *time.Time) spanner.NullTime {
+	if t == nil {
+		return spanner.NullTime{}
+	}
+	return spanner.NullTime{Time: *t, Valid: true}
+}
+`},
+}
+
+// identifier converts a column name to a Go identifier, e.g. download_url -> DownloadURL
+func identifier(column string) string {
+	var b strings.Builder
+	for _, word := range strings.Split(column, "_") {
+		if word == "" {
+			continue
+		}
+		if initialisms[word] {
+			b.WriteString(strings.ToUpper(word))
+			continue
+		}
+		b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	return b.String()
+}
+
+// words splits a Go type name into lower case words, e.g. OutboxEvent -> outbox event
+func words(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) && i > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
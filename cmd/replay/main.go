@@ -0,0 +1,251 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"catalog-proj/internal/models/m_outbox"
+
+	"cloud.google.com/go/spanner"
+	"golang.org/x/time/rate"
+	"google.golang.org/api/iterator"
+)
+
+const usage = `Usage:
+  replay -database <db> -target <target> [-from <time>] [-to <time>] [-types t1,t2] [-aggregate <id>]
+
+Targets are a webhook URL (https://...) or a Pub/Sub topic (pubsub://project/topic).
+Times are RFC 3339. Events are replayed oldest first, including already published ones.
+`
+
+// pageSize is the number of outbox rows read per query
+const pageSize = 500
+
+// Event is a replayed outbox event as delivered to the target
+type Event struct {
+	EventID     string          `json:"event_id"`
+	EventType   string          `json:"event_type"`
+	AggregateID string          `json:"aggregate_id"`
+	OccurredAt  time.Time       `json:"occurred_at"`
+	Payload     json.RawMessage `json:"payload"`
+}
+
+// filter selects the outbox events to replay
+type filter struct {
+	from      time.Time
+	to        time.Time
+	types     []string
+	aggregate string
+}
+
+func main() {
+	fs := flag.NewFlagSet("replay", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprint(os.Stderr, usage)
+		fs.PrintDefaults()
+	}
+	database := fs.String("database", os.Getenv("CATALOG_SPANNER_DATABASE"), "Source Spanner database (projects/{p}/instances/{i}/databases/{d})")
+	target := fs.String("target", "", "Webhook URL or pubsub://project/topic")
+	from := fs.String("from", "", "Replay events created at or after this time (RFC 3339)")
+	to := fs.String("to", "", "Replay events created before this time (RFC 3339)")
+	types := fs.String("types", "", "Comma-separated event types to replay (default all)")
+	aggregate := fs.String("aggregate", "", "Replay only the events of this product ID")
+	ratePerSec := fs.Float64("rate", 50, "Maximum events delivered per second")
+	burst := fs.Int("burst", 10, "Maximum burst of events above the rate")
+	limit := fs.Int("limit", 0, "Stop after this many events (0 for no limit)")
+	dryRun := fs.Bool("dry-run", false, "List the matching events without delivering them")
+	fs.Parse(os.Args[1:])
+
+	if *database == "" || (*target == "" && !*dryRun) {
+		fs.Usage()
+		os.Exit(2)
+	}
+	if *ratePerSec <= 0 || *burst < 1 {
+		slog.Error("-rate must be positive and -burst at least 1")
+		os.Exit(2)
+	}
+
+	f, err := parseFilter(*from, *to, *types, *aggregate)
+	if err != nil {
+		slog.Error("Invalid filter", "error", err)
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := run(ctx, *database, *target, f, rate.NewLimiter(rate.Limit(*ratePerSec), *burst), *limit, *dryRun); err != nil {
+		slog.Error("Replay failed", "error", err)
+		os.Exit(1)
+	}
+}
+
+// parseFilter validates the filter flags
+func parseFilter(from, to, types, aggregate string) (filter, error) {
+	var f filter
+	if from != "" {
+		t, err := time.Parse(time.RFC3339, from)
+		if err != nil {
+			return f, fmt.Errorf("-from: %w", err)
+		}
+		f.from = t
+	}
+	if to != "" {
+		t, err := time.Parse(time.RFC3339, to)
+		if err != nil {
+			return f, fmt.Errorf("-to: %w", err)
+		}
+		f.to = t
+	}
+	if !f.from.IsZero() && !f.to.IsZero() && !f.from.Before(f.to) {
+		return f, fmt.Errorf("-from must be before -to")
+	}
+	for _, t := range strings.Split(types, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			f.types = append(f.types, t)
+		}
+	}
+	f.aggregate = aggregate
+	return f, nil
+}
+
+// run reads matching events page by page and delivers them to the target
+func run(ctx context.Context, database, target string, f filter, limiter *rate.Limiter, limit int, dryRun bool) error {
+	client, err := spanner.NewClient(ctx, database)
+	if err != nil {
+		return fmt.Errorf("failed to create Spanner client: %w", err)
+	}
+	defer client.Close()
+
+	var sink Sink
+	if !dryRun {
+		sink, err = OpenSink(ctx, target)
+		if err != nil {
+			return err
+		}
+	}
+
+	var (
+		after     *Event
+		delivered int
+	)
+	for {
+		events, err := readPage(ctx, client, f, after)
+		if err != nil {
+			return err
+		}
+		for i := range events {
+			if limit > 0 && delivered >= limit {
+				slog.Info("Replay stopped at limit", "events", delivered)
+				return nil
+			}
+			event := &events[i]
+			if dryRun {
+				slog.Info("Would replay event", "event_id", event.EventID, "event_type", event.EventType, "aggregate_id", event.AggregateID, "occurred_at", event.OccurredAt)
+			} else {
+				if err := limiter.Wait(ctx); err != nil {
+					return resumeError(after, delivered, err)
+				}
+				if err := sink.Send(ctx, event); err != nil {
+					return resumeError(after, delivered, fmt.Errorf("failed to deliver event %s: %w", event.EventID, err))
+				}
+			}
+			after = event
+			delivered++
+			if delivered%1000 == 0 {
+				slog.Info("Replay progress", "events", delivered, "last_occurred_at", event.OccurredAt)
+			}
+		}
+		if len(events) < pageSize {
+			break
+		}
+	}
+
+	slog.Info("Replay finished", "events", delivered, "target", target, "dry_run", dryRun)
+	return nil
+}
+
+// resumeError reports how far the replay got, so it can be resumed with -from
+// Events sharing the last timestamp are delivered again on resume
+func resumeError(last *Event, delivered int, err error) error {
+	if last == nil {
+		return err
+	}
+	return fmt.Errorf("%w (delivered %d events; resume with -from %s)", err, delivered, last.OccurredAt.Format(time.RFC3339Nano))
+}
+
+// readPage reads the next page of matching events after the given event, oldest first
+func readPage(ctx context.Context, client *spanner.Client, f filter, after *Event) ([]Event, error) {
+	var (
+		conds  []string
+		params = map[string]interface{}{"limit": pageSize}
+	)
+	if !f.from.IsZero() {
+		conds = append(conds, "created_at >= @from")
+		params["from"] = f.from
+	}
+	if !f.to.IsZero() {
+		conds = append(conds, "created_at < @to")
+		params["to"] = f.to
+	}
+	if len(f.types) > 0 {
+		conds = append(conds, "event_type IN UNNEST(@types)")
+		params["types"] = f.types
+	}
+	if f.aggregate != "" {
+		conds = append(conds, "aggregate_id = @aggregate")
+		params["aggregate"] = f.aggregate
+	}
+	if after != nil {
+		conds = append(conds, "(created_at > @after_time OR (created_at = @after_time AND event_id > @after_id))")
+		params["after_time"] = after.OccurredAt
+		params["after_id"] = after.EventID
+	}
+
+	where := ""
+	if len(conds) > 0 {
+		where = "WHERE " + strings.Join(conds, " AND ")
+	}
+	iter := client.Single().Query(ctx, spanner.Statement{
+		SQL: fmt.Sprintf(`SELECT event_id, event_type, aggregate_id, payload, created_at
+			FROM %s %s ORDER BY created_at, event_id LIMIT @limit`, m_outbox.TableName, where),
+		Params: params,
+	})
+	defer iter.Stop()
+
+	var events []Event
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read outbox events: %w", err)
+		}
+
+		var (
+			event   Event
+			payload spanner.NullJSON
+		)
+		if err := row.Columns(&event.EventID, &event.EventType, &event.AggregateID, &payload, &event.OccurredAt); err != nil {
+			return nil, fmt.Errorf("failed to parse outbox event: %w", err)
+		}
+		if payload.Valid {
+			raw, err := json.Marshal(payload.Value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode event payload: %w", err)
+			}
+			event.Payload = raw
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	pubsub "google.golang.org/api/pubsub/v1"
+)
+
+// sendAttempts is the number of delivery attempts per event before the replay stops
+const sendAttempts = 3
+
+// Sink delivers replayed events to a downstream consumer
+type Sink interface {
+	Send(ctx context.Context, event *Event) error
+}
+
+// OpenSink opens an https:// (or http://) webhook or a pubsub://project/topic target
+// Pub/Sub access uses Application Default Credentials
+func OpenSink(ctx context.Context, target string) (Sink, error) {
+	u, err := url.Parse(target)
+	if err != nil {
+		return nil, fmt.Errorf("invalid replay target %q: %w", target, err)
+	}
+
+	switch u.Scheme {
+	case "http", "https":
+		return &webhookSink{client: &http.Client{Timeout: 30 * time.Second}, url: target}, nil
+	case "pubsub":
+		topic := strings.Trim(u.Path, "/")
+		if u.Host == "" || topic == "" || strings.Contains(topic, "/") {
+			return nil, fmt.Errorf("invalid replay target %q: use pubsub://project/topic", target)
+		}
+		svc, err := pubsub.NewService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Pub/Sub client: %w", err)
+		}
+		return &pubsubSink{svc: svc, topic: fmt.Sprintf("projects/%s/topics/%s", u.Host, topic)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported replay target scheme %q (use https:// or pubsub://)", u.Scheme)
+	}
+}
+
+// webhookSink POSTs each event as JSON; any 2xx response is an acknowledgement
+type webhookSink struct {
+	client *http.Client
+	url    string
+}
+
+func (s *webhookSink) Send(ctx context.Context, event *Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	return withRetry(ctx, func() (bool, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+		if err != nil {
+			return false, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Event-ID", event.EventID)
+		req.Header.Set("X-Event-Type", event.EventType)
+		req.Header.Set("X-Replay", "true")
+
+		resp, err := s.client.Do(req)
+		if err != nil {
+			return true, err
+		}
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		if resp.StatusCode/100 == 2 {
+			return false, nil
+		}
+		retryable := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+		return retryable, fmt.Errorf("webhook returned %s", resp.Status)
+	})
+}
+
+// pubsubSink publishes each event as a message whose data is the JSON event
+// Attributes carry the event ID and type so subscribers can filter and deduplicate
+type pubsubSink struct {
+	svc   *pubsub.Service
+	topic string
+}
+
+func (s *pubsubSink) Send(ctx context.Context, event *Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+	msg := &pubsub.PubsubMessage{
+		Data: base64.StdEncoding.EncodeToString(body),
+		Attributes: map[string]string{
+			"event_id":     event.EventID,
+			"event_type":   event.EventType,
+			"aggregate_id": event.AggregateID,
+			"replay":       "true",
+		},
+	}
+
+	return withRetry(ctx, func() (bool, error) {
+		_, err := s.svc.Projects.Topics.Publish(s.topic, &pubsub.PublishRequest{Messages: []*pubsub.PubsubMessage{msg}}).
+			Context(ctx).
+			Do()
+		// The client library already retries transient Pub/Sub errors
+		return false, err
+	})
+}
+
+// withRetry calls fn until it succeeds, fails permanently, or runs out of attempts
+func withRetry(ctx context.Context, fn func() (retryable bool, err error)) error {
+	backoff := 500 * time.Millisecond
+	for attempt := 1; ; attempt++ {
+		retryable, err := fn()
+		if err == nil || !retryable || attempt >= sendAttempts {
+			return err
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		backoff *= 2
+	}
+}
@@ -0,0 +1,236 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"os"
+	"time"
+
+	"catalog-proj/internal/app/product/domain"
+	domainServices "catalog-proj/internal/app/product/domain/services"
+	"catalog-proj/internal/app/product/queries/find_similar_products"
+	"catalog-proj/internal/app/product/repo"
+	"catalog-proj/internal/app/product/usecases/activate_product"
+	"catalog-proj/internal/app/product/usecases/apply_discount"
+	"catalog-proj/internal/app/product/usecases/archive_product"
+	"catalog-proj/internal/app/product/usecases/create_product"
+	"catalog-proj/internal/app/product/usecases/remove_discount"
+	"catalog-proj/internal/pkg/clock"
+	"catalog-proj/internal/pkg/idgen"
+	"catalog-proj/internal/pkg/tenant"
+
+	"cloud.google.com/go/spanner"
+	spannerdriver "github.com/wuyiadepoju/commitplan/drivers/spanner"
+)
+
+var (
+	spannerDatabase = flag.String("spanner-database", "projects/test-project/instances/test-instance/databases/test-db", "Spanner database to seed")
+	tenantID        = flag.String("tenant", tenant.DefaultID, "Tenant that owns the demo catalog")
+	perCategory     = flag.Int("per-category", 8, "Products generated per category")
+	randSeed        = flag.Int64("rand-seed", 42, "Random seed (the same seed produces the same catalog)")
+	force           = flag.Bool("force", false, "Seed even if the tenant already has products")
+)
+
+// demoCategory is a category with product name templates and a price range in cents
+type demoCategory struct {
+	name     string
+	products []string
+	minPrice int64
+	maxPrice int64
+}
+
+var demoCatalog = []demoCategory{
+	{"electronics", []string{"Wireless Headphones", "4K Monitor", "Mechanical Keyboard", "USB-C Hub", "Smart Speaker", "Portable SSD", "Webcam", "Gaming Mouse"}, 1999, 59999},
+	{"books", []string{"The Pragmatic Programmer", "Designing Data-Intensive Applications", "Domain-Driven Design", "Clean Architecture", "The Go Programming Language", "Refactoring", "Site Reliability Engineering", "Accelerate"}, 999, 6999},
+	{"home", []string{"Cast Iron Skillet", "French Press", "Linen Duvet Cover", "Desk Lamp", "Ceramic Planter", "Chef's Knife", "Throw Blanket", "Wall Clock"}, 1499, 19999},
+	{"toys", []string{"Building Blocks Set", "Puzzle 1000 Pieces", "Remote Control Car", "Plush Bear", "Board Game Classic", "Kite", "Wooden Train Set", "Science Kit"}, 799, 12999},
+	{"clothing", []string{"Merino Wool Sweater", "Rain Jacket", "Running Shoes", "Denim Jeans", "Linen Shirt", "Wool Socks", "Baseball Cap", "Leather Belt"}, 1299, 24999},
+}
+
+// discountState describes the validity of a seeded discount relative to now
+type discountState int
+
+const (
+	discountNone discountState = iota
+	discountActive
+	discountExpired
+	discountRemoved
+)
+
+// seeder drives the real use cases with a clock that is moved through each product's history
+type seeder struct {
+	clock          *clock.FixedClock
+	rng            *rand.Rand
+	now            time.Time
+	createProduct  *create_product.Interactor
+	activate       *activate_product.Interactor
+	applyDiscount  *apply_discount.Interactor
+	removeDiscount *remove_discount.Interactor
+	archive        *archive_product.Interactor
+}
+
+func main() {
+	flag.Parse()
+
+	ctx := tenant.WithID(context.Background(), *tenantID)
+	if os.Getenv("SPANNER_EMULATOR_HOST") == "" {
+		slog.Warn("SPANNER_EMULATOR_HOST is not set; seeding a real Spanner database", "database", *spannerDatabase)
+	}
+
+	client, err := spanner.NewClient(ctx, *spannerDatabase)
+	if err != nil {
+		slog.Error("Failed to create Spanner client", "error", err)
+		os.Exit(1)
+	}
+	defer client.Close()
+
+	quotaCounter := repo.NewSpannerQuotaCounter(client)
+	existing, err := quotaCounter.CountProducts(ctx, *tenantID)
+	if err != nil {
+		slog.Error("Failed to count existing products (did you run make migrate?)", "error", err)
+		os.Exit(1)
+	}
+	if existing > 0 && !*force {
+		slog.Info("Tenant already has products, skipping (use -force to seed anyway)", "tenant", *tenantID, "products", existing)
+		return
+	}
+
+	s := newSeeder(client, quotaCounter)
+	counts := make(map[string]int)
+	for _, category := range demoCatalog {
+		for i := 0; i < *perCategory; i++ {
+			outcome, err := s.seedProduct(ctx, category, i)
+			if err != nil {
+				slog.Error("Failed to seed product", "category", category.name, "index", i, "error", err)
+				os.Exit(1)
+			}
+			counts[outcome]++
+		}
+	}
+
+	slog.Info("Demo catalog seeded",
+		"tenant", *tenantID,
+		"products", len(demoCatalog)**perCategory,
+		"active_discounts", counts["active_discount"],
+		"expired_discounts", counts["expired_discount"],
+		"removed_discounts", counts["removed_discount"],
+		"inactive", counts["inactive"],
+		"archived", counts["archived"])
+}
+
+// newSeeder wires the use cases against Spanner with a settable clock
+func newSeeder(client *spanner.Client, quotaCounter *repo.SpannerQuotaCounter) *seeder {
+	now := time.Now().UTC()
+	clk := clock.NewFixedClock(now)
+	committer := spannerdriver.NewCommitter(client)
+	productRepo := repo.NewSpannerProductRepository(client)
+	quotaPolicy := domainServices.NewQuotaPolicy(domainServices.QuotaLimits{})
+	similar := find_similar_products.NewQuery(repo.NewSpannerReadModel(client, 0))
+	// Demo catalogs reuse names across categories and seeds, so names are not made unique
+	namePolicy := domainServices.NewUniqueNamePolicy(nil)
+
+	return &seeder{
+		clock:          clk,
+		rng:            rand.New(rand.NewSource(*randSeed)),
+		now:            now,
+		createProduct:  create_product.NewInteractor(productRepo, repo.NewSpannerVersionStore(client), committer, clk, quotaCounter, quotaPolicy, similar, namePolicy, repo.NewSpannerNameLookup(client), nil, idgen.NewRandom(), nil),
+		activate:       activate_product.NewInteractor(productRepo, committer, clk),
+		applyDiscount:  apply_discount.NewInteractor(productRepo, committer, clk, quotaCounter, quotaPolicy, nil),
+		removeDiscount: remove_discount.NewInteractor(productRepo, committer, clk),
+		archive:        archive_product.NewInteractor(productRepo, committer, clk),
+	}
+}
+
+// seedProduct creates one product and replays a plausible history for it, returning its final state
+func (s *seeder) seedProduct(ctx context.Context, category demoCategory, index int) (string, error) {
+	// Created 30-180 days ago so expired discounts fit before now
+	createdAt := s.now.Add(-time.Duration(30+s.rng.Intn(150)) * 24 * time.Hour)
+	s.clock.Set(createdAt)
+
+	name := category.products[index%len(category.products)]
+	if index >= len(category.products) {
+		name = fmt.Sprintf("%s %d", name, index/len(category.products)+1)
+	}
+	price := domain.NewMoney(category.minPrice + s.rng.Int63n(category.maxPrice-category.minPrice))
+
+	created, err := s.createProduct.Execute(ctx, &create_product.Request{
+		Name:        name,
+		Description: fmt.Sprintf("Demo %s product: %s", category.name, name),
+		Category:    category.name,
+		SKU:         fmt.Sprintf("%s-%04d", category.name[:3], index+1),
+		BasePrice:   &price,
+	})
+	if err != nil {
+		return "", fmt.Errorf("create: %w", err)
+	}
+	id := created.ProductID
+
+	// ~15% stay inactive drafts
+	if s.rng.Float64() < 0.15 {
+		return "inactive", nil
+	}
+
+	s.clock.Advance(time.Hour)
+	if _, err := s.activate.Execute(ctx, &activate_product.Request{ProductID: id}); err != nil {
+		return "", fmt.Errorf("activate: %w", err)
+	}
+
+	outcome := "active"
+	switch discountState(s.rng.Intn(4)) {
+	case discountActive:
+		start := s.now.Add(-time.Duration(1+s.rng.Intn(5)) * 24 * time.Hour)
+		if err := s.discount(ctx, id, start, s.now.Add(time.Duration(7+s.rng.Intn(30))*24*time.Hour)); err != nil {
+			return "", err
+		}
+		outcome = "active_discount"
+	case discountExpired:
+		start := createdAt.Add(24 * time.Hour)
+		if err := s.discount(ctx, id, start, start.Add(7*24*time.Hour)); err != nil {
+			return "", err
+		}
+		outcome = "expired_discount"
+	case discountRemoved:
+		start := createdAt.Add(2 * 24 * time.Hour)
+		if err := s.discount(ctx, id, start, start.Add(14*24*time.Hour)); err != nil {
+			return "", err
+		}
+		s.clock.Advance(3 * 24 * time.Hour)
+		if _, err := s.removeDiscount.Execute(ctx, &remove_discount.Request{ProductID: id}); err != nil {
+			return "", fmt.Errorf("remove discount: %w", err)
+		}
+		outcome = "removed_discount"
+	}
+
+	// ~10% of active products are archived a week before now
+	if outcome != "active_discount" && s.rng.Float64() < 0.1 {
+		s.clock.Set(s.now.Add(-7 * 24 * time.Hour))
+		if _, err := s.archive.Execute(ctx, &archive_product.Request{ProductID: id}); err != nil {
+			return "", fmt.Errorf("archive: %w", err)
+		}
+		return "archived", nil
+	}
+
+	return outcome, nil
+}
+
+// discount applies a 5-40% discount valid from start to end, with the clock inside the window
+func (s *seeder) discount(ctx context.Context, productID string, start, end time.Time) error {
+	s.clock.Set(start.Add(time.Hour))
+	amount := domain.NewMoney(int64(5 + s.rng.Intn(36)))
+	_, err := s.applyDiscount.Execute(ctx, &apply_discount.Request{
+		ProductID: productID,
+		Discount: &domain.Discount{
+			ID:        fmt.Sprintf("seed-%s", productID[:8]),
+			Amount:    &amount,
+			StartDate: start,
+			EndDate:   end,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("apply discount: %w", err)
+	}
+	return nil
+}
@@ -6,27 +6,30 @@ import (
 	"fmt"
 	"log/slog"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
-	"strings"
 	"syscall"
 
+	"catalog-proj/internal/pkg/config"
+	"catalog-proj/internal/pkg/logging"
+	"catalog-proj/internal/pkg/metrics"
+	"catalog-proj/internal/pkg/migrate"
 	"catalog-proj/internal/services"
 	pb "catalog-proj/proto/product/v1"
+	pbv2 "catalog-proj/proto/product/v2"
 
-	admin "cloud.google.com/go/spanner/admin/database/apiv1"
-	"cloud.google.com/go/spanner/admin/database/apiv1/databasepb"
-	instanceadmin "cloud.google.com/go/spanner/admin/instance/apiv1"
-	"cloud.google.com/go/spanner/admin/instance/apiv1/instancepb"
-	"google.golang.org/grpc/codes"
+	"cloud.google.com/go/longrunning/autogen/longrunningpb"
 	"google.golang.org/grpc/reflection"
-	"google.golang.org/grpc/status"
 )
 
 var (
 	spannerDatabase     = flag.String("spanner-database", "", "Spanner database (format: projects/{project}/instances/{instance}/databases/{database})")
-	grpcPort            = flag.String("grpc-port", "50051", "gRPC server port")
-	shouldRunMigrations = flag.Bool("migrate", false, "Run database migrations")
+	grpcPort            = flag.String("grpc-port", "", "gRPC server port (default 50051, or CATALOG_GRPC_PORT)")
+	shouldRunMigrations = flag.Bool("migrate", false, "Drop and recreate the database with every migration (emulator; see cmd/migrate for incremental migrations)")
+	tlsCertFile         = flag.String("tls-cert", "", "TLS certificate file; the server listens in plaintext without one (or CATALOG_TLS_CERT_FILE)")
+	tlsKeyFile          = flag.String("tls-key", "", "TLS private key file (or CATALOG_TLS_KEY_FILE)")
+	tlsClientCAFile     = flag.String("tls-client-ca", "", "CA file for verifying client certificates (or CATALOG_TLS_CLIENT_CA_FILE)")
 )
 
 func main() {
@@ -34,6 +37,41 @@ func main() {
 
 	ctx := context.Background()
 
+	// Load configuration (defaults + CATALOG_* environment overrides), flags take precedence
+	cfg, err := config.Load()
+	if err != nil {
+		slog.Error("Failed to load configuration", "error", err)
+		os.Exit(1)
+	}
+	// Replace the default logger before anything logs through it; components take their loggers from it
+	logger, err := newLogger(cfg.Logging)
+	if err != nil {
+		slog.Error("Invalid logging configuration", "error", err)
+		os.Exit(1)
+	}
+	slog.SetDefault(logger)
+	if *spannerDatabase == "" {
+		*spannerDatabase = cfg.Spanner.Database
+	}
+	if *grpcPort == "" {
+		*grpcPort = cfg.Server.GRPCPort
+	}
+	if *tlsCertFile != "" || *tlsKeyFile != "" || *tlsClientCAFile != "" {
+		if *tlsCertFile != "" {
+			cfg.Server.TLS.CertFile = *tlsCertFile
+		}
+		if *tlsKeyFile != "" {
+			cfg.Server.TLS.KeyFile = *tlsKeyFile
+		}
+		if *tlsClientCAFile != "" {
+			cfg.Server.TLS.ClientCAFile = *tlsClientCAFile
+		}
+		if err := cfg.Validate(); err != nil {
+			slog.Error("Invalid TLS flags", "error", err)
+			os.Exit(1)
+		}
+	}
+
 	// Default database for emulator if not provided
 	if *spannerDatabase == "" {
 		// Check if using emulator
@@ -57,16 +95,21 @@ func main() {
 		return
 	}
 
+	cfg.Spanner.Database = *spannerDatabase
+	cfg.Server.GRPCPort = *grpcPort
+
 	// Create DI container
-	opts, err := services.NewOptions(ctx, *spannerDatabase)
+	opts, err := services.NewOptions(ctx, cfg)
 	if err != nil {
 		slog.Error("Failed to create service options", "error", err)
 		os.Exit(1)
 	}
 	defer opts.Close()
 
-	// Register gRPC service
+	// Register gRPC services (v2 is served alongside v1 through an adapter)
 	pb.RegisterProductServiceServer(opts.GRPCServer, opts.ProductHandler)
+	pbv2.RegisterProductServiceServer(opts.GRPCServer, opts.ProductV2Handler)
+	longrunningpb.RegisterOperationsServer(opts.GRPCServer, opts.OperationsHandler)
 
 	// Enable gRPC reflection for tools like grpcurl
 	reflection.Register(opts.GRPCServer)
@@ -78,7 +121,93 @@ func main() {
 		os.Exit(1)
 	}
 
-	slog.Info("Starting gRPC server", "port", *grpcPort, "database", *spannerDatabase)
+	slog.Info("Starting gRPC server", "port", *grpcPort, "database", *spannerDatabase,
+		"tls", cfg.Server.TLS.Enabled(), "require_client_cert", cfg.Server.TLS.RequireClientCert)
+
+	// Pick up rotated certificates without a restart
+	certCtx, stopCerts := context.WithCancel(ctx)
+	defer stopCerts()
+	go opts.RunCertReloader(certCtx)
+
+	// Serve metrics (expvar) on a separate port when configured
+	if cfg.Server.MetricsPort != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/debug/vars", metrics.Handler())
+		go func() {
+			slog.Info("Starting metrics server", "port", cfg.Server.MetricsPort)
+			if err := http.ListenAndServe(fmt.Sprintf(":%s", cfg.Server.MetricsPort), mux); err != nil {
+				slog.Error("Metrics server stopped", "error", err)
+			}
+		}()
+	}
+
+	// Serve gRPC-Web to browser apps on a separate port when configured
+	webCtx, stopWeb := context.WithCancel(ctx)
+	defer stopWeb()
+	if cfg.Server.GRPCWeb.Port != "" {
+		go func() {
+			slog.Info("Starting gRPC-Web server", "port", cfg.Server.GRPCWeb.Port, "allowed_origins", cfg.Server.HTTP.CORS.AllowedOrigins)
+			if err := opts.ServeGRPCWeb(webCtx); err != nil {
+				slog.Error("gRPC-Web server stopped", "error", err)
+			}
+		}()
+	}
+
+	// Run queued background jobs (bulk operations, retention purges, count and effective price refreshes, search indexing, CDN purges)
+	jobCtx, stopJobs := context.WithCancel(ctx)
+	defer stopJobs()
+	if err := opts.ScheduleRetention(ctx); err != nil {
+		slog.Error("Failed to schedule retention job", "error", err)
+	}
+	if err := opts.ScheduleCountRefresh(ctx); err != nil {
+		slog.Error("Failed to schedule count refresh job", "error", err)
+	}
+	if err := opts.ScheduleEffectivePriceRefresh(ctx); err != nil {
+		slog.Error("Failed to schedule effective price refresh job", "error", err)
+	}
+	if err := opts.ScheduleSuggestionRefresh(ctx); err != nil {
+		slog.Error("Failed to schedule suggestion refresh job", "error", err)
+	}
+	if err := opts.ScheduleCuratedListRefresh(ctx); err != nil {
+		slog.Error("Failed to schedule curated list refresh job", "error", err)
+	}
+	if err := opts.ScheduleFeedGeneration(ctx); err != nil {
+		slog.Error("Failed to schedule feed generation job", "error", err)
+	}
+	if err := opts.ScheduleSearchIndexSync(ctx); err != nil {
+		slog.Error("Failed to schedule search index sync job", "error", err)
+	}
+	if err := opts.ScheduleCDNPurge(ctx); err != nil {
+		slog.Error("Failed to schedule CDN purge job", "error", err)
+	}
+	workerDone := make(chan struct{})
+	if cfg.Jobs.Enabled {
+		slog.Info("Starting job worker", "concurrency", cfg.Jobs.Concurrency)
+		go func() {
+			defer close(workerDone)
+			opts.JobWorker.Run(jobCtx)
+		}()
+	} else {
+		close(workerDone)
+	}
+
+	// Write recorded product views in batches
+	viewCtx, stopViews := context.WithCancel(ctx)
+	defer stopViews()
+	viewsDone := make(chan struct{})
+	go func() {
+		defer close(viewsDone)
+		opts.RunViewFlusher(viewCtx)
+	}()
+
+	// Write per-caller usage in batches
+	usageCtx, stopUsage := context.WithCancel(ctx)
+	defer stopUsage()
+	usageDone := make(chan struct{})
+	go func() {
+		defer close(usageDone)
+		opts.RunUsageFlusher(usageCtx)
+	}()
 
 	// Graceful shutdown
 	go func() {
@@ -94,181 +223,47 @@ func main() {
 	<-quit
 
 	slog.Info("Shutting down server...")
+	stopJobs()
+	stopWeb()
 	opts.GRPCServer.GracefulStop()
+	// No more views arrive once the server has stopped, so the final flush writes them all
+	stopViews()
+	stopUsage()
+	<-viewsDone
+	<-usageDone
+	<-workerDone
 	slog.Info("Server stopped")
 }
 
-// runMigrations runs database migrations
-func runMigrations(ctx context.Context, database string) error {
-	// Parse database string to extract components
-	// Format: projects/{project}/instances/{instance}/databases/{database}
-	parts := strings.Split(database, "/")
-	if len(parts) != 6 || parts[0] != "projects" || parts[2] != "instances" || parts[4] != "databases" {
-		return fmt.Errorf("invalid database format: %s (expected: projects/{project}/instances/{instance}/databases/{database})", database)
-	}
-	project := parts[1]
-	instance := parts[3]
-	dbName := parts[5]
-	projectName := fmt.Sprintf("projects/%s", project)
-	instanceName := fmt.Sprintf("projects/%s/instances/%s", project, instance)
-
-	// Create instance admin client to check/create instance
-	instanceAdminClient, err := instanceadmin.NewInstanceAdminClient(ctx)
+// newLogger builds the process-wide logger from the logging configuration
+func newLogger(cfg config.LoggingConfig) (*slog.Logger, error) {
+	level, err := logging.ParseLevel(cfg.Level)
 	if err != nil {
-		return fmt.Errorf("failed to create instance admin client: %w", err)
+		return nil, err
 	}
-	defer instanceAdminClient.Close()
-
-	// Check if instance exists, create if it doesn't
-	_, err = instanceAdminClient.GetInstance(ctx, &instancepb.GetInstanceRequest{
-		Name: instanceName,
-	})
-	if err != nil {
-		if st, ok := status.FromError(err); ok && st.Code() == codes.NotFound {
-			slog.Info("Instance does not exist, creating", "instance", instanceName)
-			// For emulator, create instance with minimal config
-			op, err := instanceAdminClient.CreateInstance(ctx, &instancepb.CreateInstanceRequest{
-				Parent:     projectName,
-				InstanceId: instance,
-				Instance: &instancepb.Instance{
-					DisplayName: instance,
-				},
-			})
-			if err != nil {
-				return fmt.Errorf("failed to create instance: %w", err)
-			}
-
-			// Wait for instance creation
-			_, err = op.Wait(ctx)
-			if err != nil {
-				return fmt.Errorf("instance creation failed: %w", err)
-			}
-			slog.Info("Successfully created instance", "instance", instanceName)
-		} else {
-			return fmt.Errorf("failed to check instance existence: %w", err)
-		}
-	}
-
-	// Create database admin client for DDL operations
-	adminClient, err := admin.NewDatabaseAdminClient(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to create database admin client: %w", err)
-	}
-	defer adminClient.Close()
-
-	// Read migration file
-	migrationSQL, err := os.ReadFile("migrations/001_initial_schema.sql")
-	if err != nil {
-		return fmt.Errorf("failed to read migration file: %w", err)
-	}
-
-	// Split SQL into individual statements (split by semicolon, but handle comments)
-	statements := parseDDLStatements(string(migrationSQL))
-
-	// Check if database exists
-	_, err = adminClient.GetDatabase(ctx, &databasepb.GetDatabaseRequest{
-		Name: database,
-	})
-
-	if err != nil {
-		// Database doesn't exist, create it with DDL statements
-		if st, ok := status.FromError(err); ok && st.Code() == codes.NotFound {
-			slog.Info("Database does not exist, creating", "database", database)
-			op, err := adminClient.CreateDatabase(ctx, &databasepb.CreateDatabaseRequest{
-				Parent:          instanceName,
-				CreateStatement: fmt.Sprintf("CREATE DATABASE `%s`", dbName),
-				ExtraStatements: statements,
-			})
-			if err != nil {
-				return fmt.Errorf("failed to create database: %w", err)
-			}
-
-			// Wait for database creation
-			db, err := op.Wait(ctx)
-			if err != nil {
-				return fmt.Errorf("database creation failed: %w", err)
-			}
-			slog.Info("Successfully created database", "database", db.Name)
-			slog.Info("Successfully applied migrations to database", "database", database)
-			return nil
+	componentLevels := make(map[string]slog.Level, len(cfg.ComponentLevels))
+	for component, name := range cfg.ComponentLevels {
+		if componentLevels[component], err = logging.ParseLevel(name); err != nil {
+			return nil, fmt.Errorf("component %s: %w", component, err)
 		}
-		return fmt.Errorf("failed to check database existence: %w", err)
 	}
-
-	// Database exists - for emulator, drop and recreate for clean migrations
-	// In production, you'd want to use proper migration versioning
-	slog.Info("Database exists, dropping and recreating for clean migration", "database", database)
-
-	// Drop the database
-	if err := adminClient.DropDatabase(ctx, &databasepb.DropDatabaseRequest{
-		Database: database,
-	}); err != nil {
-		slog.Warn("Failed to drop database (may not exist or already dropped)", "error", err)
-	} else {
-		slog.Info("Successfully dropped database")
-	}
-
-	// Recreate database with migrations
-	slog.Info("Creating database with migrations", "database", database)
-	createOp, err := adminClient.CreateDatabase(ctx, &databasepb.CreateDatabaseRequest{
-		Parent:          instanceName,
-		CreateStatement: fmt.Sprintf("CREATE DATABASE `%s`", dbName),
-		ExtraStatements: statements,
-	})
-	if err != nil {
-		return fmt.Errorf("failed to create database: %w", err)
-	}
-
-	// Wait for database creation
-	db, err := createOp.Wait(ctx)
-	if err != nil {
-		return fmt.Errorf("database creation failed: %w", err)
-	}
-	slog.Info("Successfully created database", "database", db.Name)
-	slog.Info("Successfully applied migrations to database", "database", database)
-	return nil
+	return logging.New(os.Stderr, logging.Settings{
+		Format:           cfg.Format,
+		Level:            level,
+		ComponentLevels:  componentLevels,
+		SampleFirst:      cfg.DebugSampleFirst,
+		SampleThereafter: cfg.DebugSampleThereafter,
+	}), nil
 }
 
-// parseDDLStatements parses SQL file into individual DDL statements
-func parseDDLStatements(sql string) []string {
-	var statements []string
-	var currentStatement strings.Builder
-
-	lines := strings.Split(sql, "\n")
-
-	for _, line := range lines {
-		trimmed := strings.TrimSpace(line)
-
-		// Skip empty lines and full-line comments
-		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
-			continue
-		}
-
-		// Add line to current statement
-		if currentStatement.Len() > 0 {
-			currentStatement.WriteString(" ")
-		}
-		currentStatement.WriteString(trimmed)
-
-		// If line ends with semicolon, finalize the statement
-		if strings.HasSuffix(trimmed, ";") {
-			stmt := strings.TrimSpace(currentStatement.String())
-			// Remove trailing semicolon
-			stmt = strings.TrimSuffix(stmt, ";")
-			if stmt != "" {
-				statements = append(statements, stmt)
-			}
-			currentStatement.Reset()
-		}
+// runMigrations creates the instance if needed and recreates the database with every migration
+func runMigrations(ctx context.Context, database string) error {
+	db, err := migrate.ParseDatabase(database)
+	if err != nil {
+		return err
 	}
-
-	// Handle any remaining statement without trailing semicolon
-	if currentStatement.Len() > 0 {
-		stmt := strings.TrimSpace(currentStatement.String())
-		if stmt != "" {
-			statements = append(statements, stmt)
-		}
+	if err := migrate.EnsureInstance(ctx, db); err != nil {
+		return err
 	}
-
-	return statements
+	return migrate.RecreateDatabase(ctx, db, "migrations")
 }
@@ -0,0 +1,18 @@
+package contracts
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// AliasStore resolves old references to products, such as the IDs of merged duplicates
+type AliasStore interface {
+	// AliasMuts returns the mutations that make alias resolve to canonicalID within the tenant;
+	// aliases that pointed at alias itself are repointed to canonicalID so every alias resolves in a single hop
+	AliasMuts(ctx context.Context, tenantID, alias, canonicalID string, now time.Time) ([]*spanner.Mutation, error)
+
+	// Resolve returns the product an alias of the caller's tenant points at, or "" if there is no such alias
+	Resolve(ctx context.Context, alias string) (string, error)
+}
@@ -0,0 +1,9 @@
+package contracts
+
+import "context"
+
+// CachePurger invalidates a CDN's cached copies of storefront pages
+type CachePurger interface {
+	// Purge invalidates the absolute URLs; purging a URL that is not cached is not an error
+	Purge(ctx context.Context, urls []string) error
+}
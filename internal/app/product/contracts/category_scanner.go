@@ -0,0 +1,12 @@
+package contracts
+
+import "context"
+
+// CategoryScanner pages through the unarchived products of one of the caller's tenant's categories
+type CategoryScanner interface {
+	// ScanCategory returns up to limit IDs greater than afterID of products in category, in ID order
+	ScanCategory(ctx context.Context, category, afterID string, limit int) ([]string, error)
+
+	// CountCategory returns the number of products in category with IDs greater than afterID
+	CountCategory(ctx context.Context, category, afterID string) (int64, error)
+}
@@ -0,0 +1,21 @@
+package contracts
+
+import (
+	"context"
+
+	"catalog-proj/internal/app/product/domain"
+
+	"cloud.google.com/go/spanner"
+)
+
+// CategoryTemplateStore persists the attribute templates of the tenant's categories
+type CategoryTemplateStore interface {
+	// Load returns the template of a category of the caller's tenant, or ErrCategoryTemplateNotFound
+	Load(ctx context.Context, category string) (*domain.CategoryTemplate, error)
+
+	// UpsertMut returns the mutation that creates or replaces a category's template within the tenant
+	UpsertMut(tenantID string, template domain.CategoryTemplate) (*spanner.Mutation, error)
+
+	// DeleteMut returns the mutation that removes a category's template within the tenant
+	DeleteMut(tenantID, category string) *spanner.Mutation
+}
@@ -0,0 +1,18 @@
+package contracts
+
+import (
+	"context"
+	"time"
+)
+
+// CuratedListStore maintains the trending scores and the curated storefront lists across all tenants
+type CuratedListStore interface {
+	// RefreshTrends folds the views recorded since the last run into each product's decayed score
+	// and returns the number of scores updated
+	RefreshTrends(ctx context.Context, halfLife time.Duration, now time.Time) (int, error)
+
+	// RefreshLists replaces every tenant's curated lists with up to size active products each:
+	// new arrivals created since newSince, newest first, and trending products, highest score first
+	// It returns the number of entries stored
+	RefreshLists(ctx context.Context, size int, newSince time.Time, halfLife time.Duration, now time.Time) (int, error)
+}
@@ -0,0 +1,21 @@
+package contracts
+
+import (
+	"context"
+
+	"catalog-proj/internal/app/product/domain"
+
+	"cloud.google.com/go/spanner"
+)
+
+// DraftStore persists the unpublished content drafts of products
+type DraftStore interface {
+	// Load returns the draft of a product of the caller's tenant, or ErrDraftNotFound
+	Load(ctx context.Context, productID string) (*domain.Draft, error)
+
+	// SaveMut returns the mutation that stores a draft, replacing the product's earlier one
+	SaveMut(draft *domain.Draft) *spanner.Mutation
+
+	// DeleteMut returns the mutation that deletes a product's draft
+	DeleteMut(productID string) *spanner.Mutation
+}
@@ -0,0 +1,13 @@
+package contracts
+
+import (
+	"context"
+	"time"
+)
+
+// EffectivePriceStore keeps the persisted effective price of products current as discounts start and end
+type EffectivePriceStore interface {
+	// RefreshEffectivePrices reprices up to limit products, across all tenants, whose stored effective
+	// price stopped being valid at or before now; it returns the number of products repriced
+	RefreshEffectivePrices(ctx context.Context, now time.Time, limit int) (int, error)
+}
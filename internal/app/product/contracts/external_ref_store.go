@@ -0,0 +1,22 @@
+package contracts
+
+import (
+	"context"
+	"time"
+
+	"catalog-proj/internal/app/product/domain"
+
+	"cloud.google.com/go/spanner"
+)
+
+// ExternalRefStore maps identifiers in external systems to products
+type ExternalRefStore interface {
+	// Lookup returns the product of the caller's tenant linked to ref, or "" if there is none
+	Lookup(ctx context.Context, ref domain.ExternalRef) (string, error)
+
+	// LinkMut returns the mutation that links ref to productID within the tenant
+	LinkMut(tenantID string, ref domain.ExternalRef, productID string, now time.Time) *spanner.Mutation
+
+	// UnlinkMut returns the mutation that removes ref within the tenant
+	UnlinkMut(tenantID string, ref domain.ExternalRef) *spanner.Mutation
+}
@@ -0,0 +1,9 @@
+package contracts
+
+import "context"
+
+// FeedUploader publishes generated product feeds where sales channels fetch them
+type FeedUploader interface {
+	// Upload writes data to the object, replacing any previous version
+	Upload(ctx context.Context, object, contentType string, data []byte) error
+}
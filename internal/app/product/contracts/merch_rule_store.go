@@ -0,0 +1,24 @@
+package contracts
+
+import (
+	"context"
+
+	"catalog-proj/internal/app/product/domain"
+
+	"cloud.google.com/go/spanner"
+)
+
+// MerchRuleStore persists the merchandising rules applied to search results
+type MerchRuleStore interface {
+	// List returns the caller's tenant's rules, oldest first
+	List(ctx context.Context) ([]domain.MerchRule, error)
+
+	// Load returns a rule of the caller's tenant, or ErrMerchRuleNotFound
+	Load(ctx context.Context, id string) (*domain.MerchRule, error)
+
+	// InsertMut returns the mutation that records a new rule within the tenant
+	InsertMut(tenantID string, rule domain.MerchRule) *spanner.Mutation
+
+	// DeleteMut returns the mutation that removes a rule within the tenant
+	DeleteMut(tenantID, id string) *spanner.Mutation
+}
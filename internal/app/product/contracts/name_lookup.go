@@ -0,0 +1,10 @@
+package contracts
+
+import "context"
+
+// NameLookup finds products by normalized name for unique name enforcement
+type NameLookup interface {
+	// FindByName returns the ID of a non-archived product in the tenant's category whose
+	// normalized name is nameKey, ignoring excludeID; "" when there is none
+	FindByName(ctx context.Context, tenantID, category, nameKey, excludeID string) (string, error)
+}
@@ -0,0 +1,25 @@
+package contracts
+
+import (
+	"context"
+
+	"catalog-proj/internal/app/product/domain"
+
+	"cloud.google.com/go/spanner"
+)
+
+// PendingChangeStore persists changes awaiting a second approver
+type PendingChangeStore interface {
+	// Load returns a pending change of the caller's tenant, or ErrChangeNotFound
+	Load(ctx context.Context, id string) (*domain.PendingChange, error)
+
+	// InsertMut returns the mutation that records a new pending change
+	InsertMut(change *domain.PendingChange) *spanner.Mutation
+
+	// Decide records the decision on a pending change, applying extra mutations alongside
+	// The change is re-read in the same transaction and must still be pending, or the decision fails
+	// with domain.ErrChangeNotPending; two approvers racing on one change cannot both succeed.
+	// An approved price change also fails with domain.ErrChangeStale if the product's base price
+	// moved since it was requested, even if that happened after the product was loaded
+	Decide(ctx context.Context, change *domain.PendingChange, extra ...*spanner.Mutation) error
+}
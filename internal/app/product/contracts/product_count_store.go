@@ -0,0 +1,13 @@
+package contracts
+
+import (
+	"context"
+	"time"
+)
+
+// ProductCountStore maintains the cached product counts behind approximate list totals
+type ProductCountStore interface {
+	// RefreshCounts recounts products per tenant, category and status across all tenants and
+	// replaces the cached counts, stamping them with computedAt; it returns the number of groups stored
+	RefreshCounts(ctx context.Context, computedAt time.Time) (int, error)
+}
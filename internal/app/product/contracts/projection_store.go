@@ -0,0 +1,23 @@
+package contracts
+
+import (
+	"context"
+
+	"catalog-proj/internal/app/product/domain"
+)
+
+// StoredProduct is a product as loaded for a projection rebuild, with the derived values
+// currently stored in its row
+type StoredProduct struct {
+	Product *domain.Product
+	NameKey string
+}
+
+// ProjectionStore scans products across all tenants to rebuild derived columns
+type ProjectionStore interface {
+	// ScanProducts returns up to limit products with IDs greater than afterID, in ID order
+	ScanProducts(ctx context.Context, afterID string, limit int) ([]StoredProduct, error)
+
+	// CountProducts returns the number of products with IDs greater than afterID
+	CountProducts(ctx context.Context, afterID string) (int64, error)
+}
@@ -0,0 +1,19 @@
+package contracts
+
+import (
+	"context"
+	"time"
+)
+
+// QuotaCounter counts catalog resources for quota enforcement
+// Archived products do not count against quotas
+type QuotaCounter interface {
+	// CountProducts returns the number of non-archived products owned by the tenant
+	CountProducts(ctx context.Context, tenantID string) (int64, error)
+
+	// CountProductsInCategory returns the number of non-archived products in a tenant's category
+	CountProductsInCategory(ctx context.Context, tenantID, category string) (int64, error)
+
+	// CountActiveDiscounts returns the number of non-archived products with a discount valid at now
+	CountActiveDiscounts(ctx context.Context, tenantID string, now time.Time) (int64, error)
+}
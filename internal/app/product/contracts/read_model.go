@@ -3,13 +3,29 @@ package contracts
 import (
 	"context"
 
+	"catalog-proj/internal/app/product/queries/find_similar_products"
 	"catalog-proj/internal/app/product/queries/get_product"
+	"catalog-proj/internal/app/product/queries/list_products"
+	"catalog-proj/internal/app/product/queries/search_products"
 )
 
 // ReadModel defines the interface for read-only product queries
 // These queries bypass the domain layer and return DTOs directly
-// Note: ListProducts has its own ReadModel interface in list_products package to avoid import cycles
+// Note: each query package also declares its own narrow ReadModel interface to avoid import cycles;
+// this combined interface is what read model implementations and decorators satisfy
 type ReadModel interface {
 	// GetProduct retrieves a single product by ID
 	GetProduct(ctx context.Context, id string) (*get_product.DTO, error)
+
+	// BatchGetProducts retrieves several products by ID in one read (missing IDs are skipped)
+	BatchGetProducts(ctx context.Context, ids []string) ([]*get_product.DTO, error)
+
+	// ListProducts retrieves a page of products with optional filters
+	ListProducts(ctx context.Context, req *list_products.Request) (*list_products.DTO, error)
+
+	// FindSimilarProducts retrieves products that look like duplicates of the requested attributes
+	FindSimilarProducts(ctx context.Context, req *find_similar_products.Request) (*find_similar_products.DTO, error)
+
+	// SearchProducts retrieves active products matching every term of a free-text query, unranked
+	SearchProducts(ctx context.Context, req *search_products.Request) (*search_products.DTO, error)
 }
@@ -0,0 +1,29 @@
+package contracts
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// PurgeCandidate is an archived product past the retention cutoff
+type PurgeCandidate struct {
+	ProductID  string
+	TenantID   string
+	ArchivedAt time.Time
+	EventCount int64
+}
+
+// RetentionStore finds and hard-deletes archived products across all tenants
+type RetentionStore interface {
+	// FindPurgeCandidates returns up to limit products archived before the cutoff and not under legal hold
+	FindPurgeCandidates(ctx context.Context, archivedBefore time.Time, limit int) ([]PurgeCandidate, error)
+
+	// FindHeldProducts returns up to limit IDs of products archived before the cutoff but under legal hold
+	FindHeldProducts(ctx context.Context, archivedBefore time.Time, limit int) ([]string, error)
+
+	// Purge deletes the product, its outbox events, external references, view counter and trending score in one transaction, applying extra mutations alongside
+	// The product is re-checked inside the transaction; purged is false if it no longer qualifies
+	Purge(ctx context.Context, productID string, archivedBefore time.Time, extra ...*spanner.Mutation) (purged bool, eventsDeleted int64, err error)
+}
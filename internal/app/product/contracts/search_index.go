@@ -0,0 +1,41 @@
+package contracts
+
+import (
+	"context"
+	"time"
+
+	"catalog-proj/internal/app/product/queries/get_product"
+
+	"cloud.google.com/go/spanner"
+)
+
+// SearchIndex is an external search index kept in step with products
+type SearchIndex interface {
+	// Upsert indexes the product, replacing its previous document
+	Upsert(ctx context.Context, product *get_product.DTO) error
+
+	// Delete removes the product's document; deleting a product that is not indexed is not an error
+	Delete(ctx context.Context, productID string) error
+}
+
+// FeedEvent is an outbox event waiting for a consumer
+type FeedEvent struct {
+	EventID     string
+	EventType   string
+	AggregateID string
+	CreatedAt   time.Time
+}
+
+// OutboxFeed reads outbox events across all tenants for consumers that track progress in processed_events
+type OutboxFeed interface {
+	// Unprocessed returns up to limit events the consumer has not processed, oldest first
+	Unprocessed(ctx context.Context, consumer string, limit int) ([]FeedEvent, error)
+
+	// MarkProcessedMut returns the mutation that records the consumer processed the event
+	// Marking an event twice is not an error
+	MarkProcessedMut(consumer, eventID string, at time.Time) *spanner.Mutation
+
+	// AdvanceMut returns the mutation that moves the consumer's cursor to the event, the last of a
+	// batch from Unprocessed; later reads start there. Commit it with the batch's MarkProcessedMut rows
+	AdvanceMut(consumer string, event FeedEvent, at time.Time) *spanner.Mutation
+}
@@ -0,0 +1,13 @@
+package contracts
+
+import (
+	"context"
+	"time"
+)
+
+// SuggestionStore maintains the search-as-you-type suggestions projection
+type SuggestionStore interface {
+	// RefreshSuggestions recomputes the distinct names and categories of active products across all
+	// tenants and replaces the stored suggestions, stamping them with computedAt; it returns the number stored
+	RefreshSuggestions(ctx context.Context, computedAt time.Time) (int, error)
+}
@@ -0,0 +1,42 @@
+package contracts
+
+import (
+	"context"
+
+	"catalog-proj/internal/app/product/domain"
+
+	"cloud.google.com/go/spanner"
+)
+
+// TenantRegistry persists the tenants managed with CreateTenant and DeleteTenant
+type TenantRegistry interface {
+	// Load returns the tenant, or domain.ErrTenantNotFound when it was never registered
+	Load(ctx context.Context, tenantID string) (*domain.Tenant, error)
+
+	// Register writes a new tenant unless one is registered under its ID that has not been deleted,
+	// failing with domain.ErrTenantExists or domain.ErrTenantBeingDeleted
+	Register(ctx context.Context, t *domain.Tenant) error
+
+	// SaveMut returns the mutation that writes the tenant's current state
+	SaveMut(t *domain.Tenant) *spanner.Mutation
+}
+
+// TenantPurger removes the data of a tenant being deleted
+type TenantPurger interface {
+	// ScanProducts returns up to limit IDs greater than afterID of the tenant's products, in ID order
+	ScanProducts(ctx context.Context, tenantID, afterID string, limit int) ([]string, error)
+
+	// CountProducts returns the number of the tenant's products with IDs greater than afterID
+	CountProducts(ctx context.Context, tenantID, afterID string) (int64, error)
+
+	// CountHeldProducts returns the number of the tenant's products under legal hold
+	CountHeldProducts(ctx context.Context, tenantID string) (int64, error)
+
+	// PurgeProduct deletes the product with its versions, drafts and outbox events in one transaction, applying extra mutations alongside
+	// The legal hold is re-checked inside the transaction and fails the purge with domain.ErrTenantUnderLegalHold;
+	// purged is false when the product is already gone
+	PurgeProduct(ctx context.Context, productID string, extra ...*spanner.Mutation) (purged bool, err error)
+
+	// PurgeTables deletes the tenant's rows from every other tenant-scoped table except operations and jobs
+	PurgeTables(ctx context.Context, tenantID string) (rowsDeleted int64, err error)
+}
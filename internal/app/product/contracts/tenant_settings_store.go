@@ -0,0 +1,21 @@
+package contracts
+
+import (
+	"context"
+
+	"catalog-proj/internal/app/product/domain"
+
+	"cloud.google.com/go/spanner"
+)
+
+// TenantSettingsStore persists the settings overrides of each tenant
+type TenantSettingsStore interface {
+	// Load returns the overrides of the caller's tenant, or nil when it has none
+	Load(ctx context.Context) (*domain.TenantSettings, error)
+
+	// UpsertMut returns the mutation that creates or replaces a tenant's overrides
+	UpsertMut(tenantID string, settings domain.TenantSettings) *spanner.Mutation
+
+	// DeleteMut returns the mutation that removes a tenant's overrides
+	DeleteMut(tenantID string) *spanner.Mutation
+}
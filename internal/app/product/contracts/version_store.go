@@ -0,0 +1,21 @@
+package contracts
+
+import (
+	"context"
+
+	"catalog-proj/internal/app/product/domain"
+
+	"cloud.google.com/go/spanner"
+)
+
+// VersionStore persists the immutable content versions of products
+type VersionStore interface {
+	// Load returns a version of a product of the caller's tenant, or ErrVersionNotFound
+	Load(ctx context.Context, productID, versionID string) (*domain.ContentVersion, error)
+
+	// List returns the versions of a product of the caller's tenant, newest first
+	List(ctx context.Context, productID string) ([]*domain.ContentVersion, error)
+
+	// InsertMut returns the mutation that records a new version
+	InsertMut(version *domain.ContentVersion) *spanner.Mutation
+}
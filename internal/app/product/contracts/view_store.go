@@ -0,0 +1,19 @@
+package contracts
+
+import (
+	"context"
+	"time"
+)
+
+// ViewKey identifies a product's view counter
+type ViewKey struct {
+	TenantID  string
+	ProductID string
+}
+
+// ViewStore persists product view counters
+type ViewStore interface {
+	// AddViews adds coalesced view counts in one transaction, stamping the counters with viewedAt
+	// Counts for products that do not exist in the key's tenant are discarded
+	AddViews(ctx context.Context, counts map[ViewKey]int64, viewedAt time.Time) error
+}
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"maps"
+	"sort"
+	"strings"
+)
+
+const (
+	// MaxAttributes bounds how many attributes a product or category template holds
+	MaxAttributes = 64
+	// MaxAttributeValueLength bounds each attribute value and allowed value
+	MaxAttributeValueLength = 256
+)
+
+// Attributes are a product's descriptive properties, such as color or screen size, keyed by name
+// Unlike metadata they describe the product to buyers and are checked against the category's template
+type Attributes map[string]string
+
+// Validate checks the number of attributes, the name format and that values are set and short
+// Names follow the metadata key format
+func (a Attributes) Validate() error {
+	if len(a) > MaxAttributes {
+		return ErrInvalidAttributes
+	}
+	for k, v := range a {
+		if !ValidMetadataKey(k) || v == "" || len(v) > MaxAttributeValueLength {
+			return ErrInvalidAttributes
+		}
+	}
+	return nil
+}
+
+// Clone returns a copy of the attributes, or nil when there are none
+func (a Attributes) Clone() Attributes {
+	if len(a) == 0 {
+		return nil
+	}
+	return maps.Clone(a)
+}
+
+// Entries returns the attributes as sorted "name=value" strings, the form they are stored in
+func (a Attributes) Entries() []string {
+	if len(a) == 0 {
+		return nil
+	}
+	entries := make([]string, 0, len(a))
+	for k, v := range a {
+		entries = append(entries, MetadataEntry(k, v))
+	}
+	sort.Strings(entries)
+	return entries
+}
+
+// AttributesFromEntries converts stored "name=value" entries without validating them
+func AttributesFromEntries(entries []string) Attributes {
+	if len(entries) == 0 {
+		return nil
+	}
+	a := make(Attributes, len(entries))
+	for _, e := range entries {
+		k, v, _ := strings.Cut(e, "=")
+		a[k] = v
+	}
+	return a
+}
@@ -0,0 +1,125 @@
+package domain
+
+import (
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AttributeType is the kind of value a template attribute takes
+type AttributeType string
+
+const (
+	// AttributeTypeText accepts any value
+	AttributeTypeText AttributeType = "text"
+	// AttributeTypeNumber accepts decimal numbers such as "15.6"
+	AttributeTypeNumber AttributeType = "number"
+	// AttributeTypeBoolean accepts "true" or "false"
+	AttributeTypeBoolean AttributeType = "boolean"
+)
+
+// AttributeDefinition describes one attribute of a category template
+type AttributeDefinition struct {
+	Name          string
+	Type          AttributeType
+	Required      bool
+	AllowedValues []string // Empty allows any value of the type
+}
+
+// Accepts reports whether value is of the attribute's type and, when the attribute lists
+// allowed values, one of them
+func (d AttributeDefinition) Accepts(value string) bool {
+	if !d.Type.accepts(value) {
+		return false
+	}
+	return len(d.AllowedValues) == 0 || slices.Contains(d.AllowedValues, value)
+}
+
+func (t AttributeType) accepts(value string) bool {
+	switch t {
+	case AttributeTypeText:
+		return true
+	case AttributeTypeNumber:
+		f, err := strconv.ParseFloat(value, 64)
+		return err == nil && !math.IsInf(f, 0) && !math.IsNaN(f)
+	case AttributeTypeBoolean:
+		return value == "true" || value == "false"
+	}
+	return false
+}
+
+// CategoryTemplate lists the attributes products of a category take, in the order admin UIs show them
+type CategoryTemplate struct {
+	Category   string
+	Attributes []AttributeDefinition
+	UpdatedAt  time.Time
+}
+
+// Validate checks the template names a category and defines between 1 and MaxAttributes
+// uniquely named attributes of a known type whose allowed values are values of that type
+func (t CategoryTemplate) Validate() error {
+	if t.Category == "" || len(t.Category) > 100 {
+		return ErrInvalidCategoryTemplate
+	}
+	if len(t.Attributes) == 0 || len(t.Attributes) > MaxAttributes {
+		return ErrInvalidCategoryTemplate
+	}
+	seen := make(map[string]bool, len(t.Attributes))
+	for _, d := range t.Attributes {
+		if !ValidMetadataKey(d.Name) || seen[d.Name] {
+			return ErrInvalidCategoryTemplate
+		}
+		seen[d.Name] = true
+		switch d.Type {
+		case AttributeTypeText, AttributeTypeNumber, AttributeTypeBoolean:
+		default:
+			return ErrInvalidCategoryTemplate
+		}
+		for _, v := range d.AllowedValues {
+			if v == "" || len(v) > MaxAttributeValueLength || !d.Type.accepts(v) {
+				return ErrInvalidCategoryTemplate
+			}
+		}
+	}
+	return nil
+}
+
+// Check returns a violation for each missing required attribute, each value its definition
+// does not accept and each attribute the template does not define
+func (t CategoryTemplate) Check(attributes Attributes) []RuleViolation {
+	var violations []RuleViolation
+	defined := make(map[string]bool, len(t.Attributes))
+	for _, d := range t.Attributes {
+		defined[d.Name] = true
+		field := FieldAttributes + "." + d.Name
+		value, ok := attributes[d.Name]
+		switch {
+		case !ok:
+			if d.Required {
+				violations = append(violations, RuleViolation{Field: field, Description: "is required"})
+			}
+		case !d.Type.accepts(value):
+			violations = append(violations, RuleViolation{Field: field, Description: "must be a " + string(d.Type)})
+		case !d.Accepts(value):
+			violations = append(violations, RuleViolation{Field: field, Description: "must be one of " + strings.Join(d.AllowedValues, ", ")})
+		}
+	}
+
+	var undefined []string
+	for name := range attributes {
+		if !defined[name] {
+			undefined = append(undefined, name)
+		}
+	}
+	sort.Strings(undefined)
+	for _, name := range undefined {
+		violations = append(violations, RuleViolation{
+			Field:       FieldAttributes + "." + name,
+			Description: "is not defined for category " + t.Category,
+		})
+	}
+	return violations
+}
@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+)
+
+// FieldChange is one field's pending change
+// Old is the value when the product was loaded, however often the field changed since; composite
+// fields marked with MarkDirty, such as the shipping and compliance fields, carry no values
+type FieldChange struct {
+	Field string
+	Old   any
+	New   any
+}
+
+// ChangeTracker records the fields changed since a product was loaded, with their previous values
+// It is safe for concurrent use, and Diff and Change return copies that never share its state
+// Values are stored as given; domain methods replace field values rather than mutating them
+type ChangeTracker struct {
+	mu     sync.Mutex
+	fields map[string]*FieldChange
+}
+
+// Record marks field as changed from old to new, keeping the first old value if it changed before
+func (ct *ChangeTracker) Record(field string, old, new any) {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+	if ct.fields == nil {
+		ct.fields = make(map[string]*FieldChange)
+	}
+	if change, ok := ct.fields[field]; ok {
+		change.New = new
+		return
+	}
+	ct.fields[field] = &FieldChange{Field: field, Old: old, New: new}
+}
+
+// MarkDirty marks field as changed without tracking its values
+func (ct *ChangeTracker) MarkDirty(field string) {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+	if ct.fields == nil {
+		ct.fields = make(map[string]*FieldChange)
+	}
+	if _, ok := ct.fields[field]; !ok {
+		ct.fields[field] = &FieldChange{Field: field}
+	}
+}
+
+// Dirty reports whether field has a pending change
+func (ct *ChangeTracker) Dirty(field string) bool {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+	_, ok := ct.fields[field]
+	return ok
+}
+
+// Change returns field's pending change, if it has one
+func (ct *ChangeTracker) Change(field string) (FieldChange, bool) {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+	change, ok := ct.fields[field]
+	if !ok {
+		return FieldChange{}, false
+	}
+	return *change, true
+}
+
+// Diff returns the pending changes ordered by field name
+func (ct *ChangeTracker) Diff() []FieldChange {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+	diff := make([]FieldChange, 0, len(ct.fields))
+	for _, change := range ct.fields {
+		diff = append(diff, *change)
+	}
+	slices.SortFunc(diff, func(a, b FieldChange) int {
+		return cmp.Compare(a.Field, b.Field)
+	})
+	return diff
+}
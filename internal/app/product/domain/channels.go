@@ -0,0 +1,61 @@
+package domain
+
+import "sort"
+
+// Channel is a sales channel a product can be visible on
+type Channel string
+
+const (
+	ChannelWeb         Channel = "web"
+	ChannelMobileApp   Channel = "mobile_app"
+	ChannelMarketplace Channel = "marketplace"
+)
+
+// Valid reports whether c is a known channel
+func (c Channel) Valid() bool {
+	switch c {
+	case ChannelWeb, ChannelMobileApp, ChannelMarketplace:
+		return true
+	default:
+		return false
+	}
+}
+
+// NormalizeChannels validates channels and returns them sorted without duplicates
+// An empty set is valid and means the product is visible on no channel
+func NormalizeChannels(channels []Channel) ([]Channel, error) {
+	seen := make(map[Channel]bool, len(channels))
+	normalized := make([]Channel, 0, len(channels))
+	for _, c := range channels {
+		if !c.Valid() {
+			return nil, ErrInvalidChannel
+		}
+		if !seen[c] {
+			seen[c] = true
+			normalized = append(normalized, c)
+		}
+	}
+	sort.Slice(normalized, func(i, j int) bool { return normalized[i] < normalized[j] })
+	return normalized, nil
+}
+
+// ChannelsFromStrings converts stored channel names without validating them
+func ChannelsFromStrings(values []string) []Channel {
+	if len(values) == 0 {
+		return nil
+	}
+	channels := make([]Channel, len(values))
+	for i, v := range values {
+		channels[i] = Channel(v)
+	}
+	return channels
+}
+
+// ChannelStrings converts channels to their names
+func ChannelStrings(channels []Channel) []string {
+	values := make([]string, len(channels))
+	for i, c := range channels {
+		values[i] = string(c)
+	}
+	return values
+}
@@ -0,0 +1,40 @@
+package domain
+
+// MaxAgeRestriction is the highest minimum buyer age a product can carry
+const MaxAgeRestriction = 21
+
+// Compliance is the regulatory metadata that decides where a product may be offered
+// The zero value is an unrestricted product
+type Compliance struct {
+	AgeRestriction       int // Minimum buyer age in years, 0 for none
+	Hazardous            bool
+	RequiresPrescription bool
+}
+
+// Validate checks the age restriction is within range
+func (c Compliance) Validate() error {
+	if c.AgeRestriction < 0 || c.AgeRestriction > MaxAgeRestriction {
+		return ErrInvalidAgeRestriction
+	}
+	return nil
+}
+
+// Restricted reports whether any compliance flag is set
+func (c Compliance) Restricted() bool {
+	return c != Compliance{}
+}
+
+// changedFields lists the compliance fields that differ from other
+func (c Compliance) changedFields(other Compliance) []string {
+	var fields []string
+	if c.AgeRestriction != other.AgeRestriction {
+		fields = append(fields, FieldAgeRestriction)
+	}
+	if c.Hazardous != other.Hazardous {
+		fields = append(fields, FieldHazardous)
+	}
+	if c.RequiresPrescription != other.RequiresPrescription {
+		fields = append(fields, FieldRequiresPrescription)
+	}
+	return fields
+}
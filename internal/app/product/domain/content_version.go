@@ -0,0 +1,45 @@
+package domain
+
+import "time"
+
+// ContentVersion is an immutable snapshot of the product content merchandisers edit
+// Prices, status, channels and fulfilment are not versioned and are left alone by a rollback
+type ContentVersion struct {
+	ID          string
+	ProductID   string
+	TenantID    string
+	Name        string
+	Description string
+	Category    string
+	Metadata    Metadata
+	CreatedAt   time.Time
+}
+
+// ContentChanged reports whether the product's pending changes touch versioned content
+func (p *Product) ContentChanged() bool {
+	return p.changes.Dirty(FieldName) || p.changes.Dirty(FieldDescription) ||
+		p.changes.Dirty(FieldCategory) || p.changes.Dirty(FieldMetadata)
+}
+
+// ContentVersion snapshots the product's current content as version id
+func (p *Product) ContentVersion(id string, now time.Time) *ContentVersion {
+	return &ContentVersion{
+		ID:          id,
+		ProductID:   p.id,
+		TenantID:    p.tenantID,
+		Name:        p.name,
+		Description: p.description,
+		Category:    p.category,
+		Metadata:    p.metadata.Clone(),
+		CreatedAt:   now,
+	}
+}
+
+// RestoreContent replaces the product's content with the version's, emitting the usual
+// ProductUpdatedEvent and MetadataChangedEvent for whatever differs
+func (p *Product) RestoreContent(version *ContentVersion, now time.Time) error {
+	if err := p.UpdateDetails(version.Name, version.Description, version.Category, now); err != nil {
+		return err
+	}
+	return p.SetMetadata(version.Metadata, now)
+}
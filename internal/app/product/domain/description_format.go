@@ -0,0 +1,34 @@
+package domain
+
+import "catalog-proj/internal/pkg/markdown"
+
+// DescriptionFormat decides how a product's description is written and rendered
+type DescriptionFormat string
+
+const (
+	// DescriptionFormatPlain descriptions are plain text (the default)
+	DescriptionFormatPlain DescriptionFormat = "plain"
+	// DescriptionFormatMarkdown descriptions are markdown, checked for structure when written
+	DescriptionFormatMarkdown DescriptionFormat = "markdown"
+)
+
+// Valid reports whether f is a known description format
+func (f DescriptionFormat) Valid() bool {
+	switch f {
+	case DescriptionFormatPlain, DescriptionFormatMarkdown:
+		return true
+	default:
+		return false
+	}
+}
+
+// ValidateDescriptionFormat checks the format is known and a markdown description is well formed
+func ValidateDescriptionFormat(format DescriptionFormat, description string) error {
+	if !format.Valid() {
+		return ErrInvalidDescriptionFormat
+	}
+	if format == DescriptionFormatMarkdown && markdown.Validate(description) != nil {
+		return ErrInvalidMarkdown
+	}
+	return nil
+}
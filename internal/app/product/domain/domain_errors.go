@@ -1,6 +1,9 @@
 package domain
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 type DomainError struct {
 	Code    string
@@ -64,4 +67,269 @@ var (
 		Code:    "invalid_discount_date_range",
 		Message: "discount start date must be before end date",
 	}
+	ErrInvalidSKU = &DomainError{
+		Code:    "invalid_sku",
+		Message: "sku must be at most 64 characters without whitespace",
+	}
+	ErrInvalidGTIN = &DomainError{
+		Code:    "invalid_gtin",
+		Message: "gtin must be 8, 12, 13 or 14 digits with a valid check digit",
+	}
+	ErrInvalidReviewer = &DomainError{
+		Code:    "invalid_reviewer",
+		Message: "reviewer is required and must be at most 255 characters",
+	}
+	ErrInvalidReviewDecision = &DomainError{
+		Code:    "invalid_review_decision",
+		Message: "review decision must be approved or rejected",
+	}
+	ErrInvalidReviewComment = &DomainError{
+		Code:    "invalid_review_comment",
+		Message: "review comment is required when rejecting and must be at most 2000 characters",
+	}
+	ErrInvalidChannel = &DomainError{
+		Code:    "invalid_channel",
+		Message: "channel must be one of web, mobile_app, marketplace",
+	}
+	ErrInvalidWeight = &DomainError{
+		Code:    "invalid_weight",
+		Message: "weight must be non-negative with unit g, kg, oz or lb",
+	}
+	ErrInvalidDimensions = &DomainError{
+		Code:    "invalid_dimensions",
+		Message: "dimensions must be non-negative with unit mm, cm, m or in",
+	}
+	ErrInvalidShippingClass = &DomainError{
+		Code:    "invalid_shipping_class",
+		Message: "shipping class must be at most 64 lowercase letters, digits, '_' or '-'",
+	}
+	ErrInvalidAgeRestriction = &DomainError{
+		Code:    "invalid_age_restriction",
+		Message: "age restriction must be between 0 and 21",
+	}
+	ErrInvalidDescriptionFormat = &DomainError{
+		Code:    "invalid_description_format",
+		Message: "description format must be plain or markdown",
+	}
+	ErrInvalidMarkdown = &DomainError{
+		Code:    "invalid_markdown",
+		Message: "markdown description must close its code blocks, nest lists at most 3 deep, give headings text and link only to http, https or mailto URLs",
+	}
+	ErrInvalidProductType = &DomainError{
+		Code:    "invalid_product_type",
+		Message: "product type must be physical, digital or service",
+	}
+	ErrInvalidDownloadURL = &DomainError{
+		Code:    "invalid_download_url",
+		Message: "download URL must be an absolute http(s) URL of at most 2048 characters",
+	}
+	ErrInvalidLicenseTerms = &DomainError{
+		Code:    "invalid_license_terms",
+		Message: "license terms must not be blank and must be at most 10000 characters",
+	}
+	ErrShippingNotApplicable = &DomainError{
+		Code:    "shipping_not_applicable",
+		Message: "weight, dimensions and shipping class only apply to physical products",
+	}
+	ErrDigitalDeliveryRequired = &DomainError{
+		Code:    "digital_delivery_required",
+		Message: "digital products require a download URL or license terms",
+	}
+	ErrDigitalDeliveryNotApplicable = &DomainError{
+		Code:    "digital_delivery_not_applicable",
+		Message: "download URL and license terms only apply to digital products",
+	}
+	ErrInvalidMetadata = &DomainError{
+		Code:    "invalid_metadata",
+		Message: "metadata allows at most 32 keys of lowercase letters, digits, '_', '.' or '-' (up to 64 characters) with values of at most 256 characters",
+	}
+	ErrInvalidExternalRef = &DomainError{
+		Code:    "invalid_external_ref",
+		Message: "external system must be 1-32 lowercase letters, digits, '_' or '-' starting with a letter, and external ID 1-255 characters",
+	}
+	ErrExternalRefNotFound = &DomainError{
+		Code:    "external_ref_not_found",
+		Message: "no product is linked to the external reference",
+	}
+	ErrInvalidPriceFloor = &DomainError{
+		Code:    "invalid_price_floor",
+		Message: "price floor prices must be positive and a minimum margin of 0-99% requires a cost",
+	}
+	ErrPriceBelowFloor = &DomainError{
+		Code:    "price_below_floor",
+		Message: "the effective price would drop below the product's price floor or minimum margin",
+	}
+	ErrInvalidApprover = &DomainError{
+		Code:    "invalid_approver",
+		Message: "changes that need approval must be requested and decided by an authenticated caller",
+	}
+	ErrSelfApproval = &DomainError{
+		Code:    "self_approval",
+		Message: "a change must be approved by someone other than its requester",
+	}
+	ErrChangeNotFound = &DomainError{
+		Code:    "change_not_found",
+		Message: "pending change not found",
+	}
+	ErrChangeNotPending = &DomainError{
+		Code:    "change_not_pending",
+		Message: "change has already been approved or rejected",
+	}
+	ErrChangeStale = &DomainError{
+		Code:    "change_stale",
+		Message: "the product price changed after the change was requested; reject it and request a new one",
+	}
+	ErrMergeIntoSelf = &DomainError{
+		Code:    "merge_into_self",
+		Message: "a product cannot be merged into itself",
+	}
+	ErrMergeTargetArchived = &DomainError{
+		Code:    "merge_target_archived",
+		Message: "cannot merge into an archived product",
+	}
+	ErrInvalidMerchRule = &DomainError{
+		Code:    "invalid_merch_rule",
+		Message: "a pin needs a query, a product and a position of 1-100; a boost needs a category and a factor above 0 and up to 100",
+	}
+	ErrMerchRuleNotFound = &DomainError{
+		Code:    "merch_rule_not_found",
+		Message: "merchandising rule not found",
+	}
+	ErrSameCategory = &DomainError{
+		Code:    "same_category",
+		Message: "products cannot be reassigned to the category they are in",
+	}
+	ErrInvalidAttributes = &DomainError{
+		Code:    "invalid_attributes",
+		Message: "attributes allow at most 64 names of lowercase letters, digits, '_', '.' or '-' (up to 64 characters) with non-empty values of at most 256 characters",
+	}
+	ErrInvalidCategoryTemplate = &DomainError{
+		Code:    "invalid_category_template",
+		Message: "a category template needs a category and 1-64 uniquely named attributes of type text, number or boolean whose allowed values match the type",
+	}
+	ErrCategoryTemplateNotFound = &DomainError{
+		Code:    "category_template_not_found",
+		Message: "category has no attribute template",
+	}
+	ErrInvalidTenantSettings = &DomainError{
+		Code:    "invalid_tenant_settings",
+		Message: "default currency must be a three-letter ISO 4217 code, max name length 0-255, and the discount range 0-10000 basis points with min not above max",
+	}
+	ErrDiscountOutOfRange = &DomainError{
+		Code:    "discount_out_of_range",
+		Message: "discount is outside the range this tenant allows",
+	}
+	ErrInvalidTenant = &DomainError{
+		Code:    "invalid_tenant",
+		Message: "tenant_id must be 1-64 lowercase letters, digits, '_' or '-' starting with a letter or digit, and display_name 1-256 characters",
+	}
+	ErrTenantNotFound = &DomainError{
+		Code:    "tenant_not_found",
+		Message: "tenant not found",
+	}
+	ErrTenantExists = &DomainError{
+		Code:    "tenant_exists",
+		Message: "tenant already exists",
+	}
+	ErrTenantBeingDeleted = &DomainError{
+		Code:    "tenant_being_deleted",
+		Message: "tenant is being deleted",
+	}
+	ErrTenantProvisioningDenied = &DomainError{
+		Code:    "tenant_provisioning_denied",
+		Message: "only the operator tenant can create and delete tenants",
+	}
+	ErrOperatorTenantDeletion = &DomainError{
+		Code:    "operator_tenant_deletion",
+		Message: "the operator tenant cannot be deleted",
+	}
+	ErrTenantUnderLegalHold = &DomainError{
+		Code:    "tenant_under_legal_hold",
+		Message: "tenant has products under legal hold",
+	}
+	ErrTenantExportUnavailable = &DomainError{
+		Code:    "tenant_export_unavailable",
+		Message: "tenant exports are not configured",
+	}
+	ErrCrossTenantCloneDenied = &DomainError{
+		Code:    "cross_tenant_clone_denied",
+		Message: "only the operator tenant can clone products into another tenant",
+	}
+	ErrUnboundedScan = &DomainError{
+		Code:    "unbounded_scan",
+		Message: "query reads too many rows; filter by category, or read fewer rows",
+	}
+	ErrEffectivePriceSearchUnavailable = &DomainError{
+		Code:    "effective_price_search_unavailable",
+		Message: "searches can only be ordered or filtered by effective price on the Spanner search backend",
+	}
+	ErrVersionNotFound = &DomainError{
+		Code:    "version_not_found",
+		Message: "product content version not found",
+	}
+	ErrDraftNotFound = &DomainError{
+		Code:    "draft_not_found",
+		Message: "product has no draft",
+	}
 )
+
+// QuotaExceededError reports that an operation would exceed a configured catalog quota
+type QuotaExceededError struct {
+	Quota   string // quota name, e.g. "products_per_tenant"
+	Subject string // what the quota applies to, e.g. "tenant:acme/category:books"
+	Limit   int64
+	Current int64
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("quota_exceeded: %s limit of %d reached for %s (current: %d)", e.Quota, e.Limit, e.Subject, e.Current)
+}
+
+// DuplicateProductError reports that a new product matches existing products of the same tenant
+type DuplicateProductError struct {
+	ProductIDs []string
+}
+
+func (e *DuplicateProductError) Error() string {
+	return fmt.Sprintf("duplicate_product: product matches existing products %s", strings.Join(e.ProductIDs, ", "))
+}
+
+// ProductNameTakenError reports that another product of the tenant's category already has the name
+type ProductNameTakenError struct {
+	ProductID string // the conflicting product
+	Category  string
+	Name      string
+}
+
+func (e *ProductNameTakenError) Error() string {
+	return fmt.Sprintf("product_name_taken: product %s in category %q is already named %q", e.ProductID, e.Category, e.Name)
+}
+
+// ExternalRefTakenError reports that an external reference is already linked to another product of the tenant
+type ExternalRefTakenError struct {
+	ProductID string // the product holding the reference
+	Ref       ExternalRef
+}
+
+func (e *ExternalRefTakenError) Error() string {
+	return fmt.Sprintf("external_ref_taken: %s reference %q is already linked to product %s", e.Ref.System, e.Ref.ExternalID, e.ProductID)
+}
+
+// RuleViolation describes one product field that breaks a validation rule
+type RuleViolation struct {
+	Field       string
+	Description string
+}
+
+// ValidationFailedError reports every validation rule a product breaks
+type ValidationFailedError struct {
+	Violations []RuleViolation
+}
+
+func (e *ValidationFailedError) Error() string {
+	parts := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		parts[i] = fmt.Sprintf("%s: %s", v.Field, v.Description)
+	}
+	return fmt.Sprintf("validation_failed: %s", strings.Join(parts, "; "))
+}
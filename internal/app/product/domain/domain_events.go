@@ -9,10 +9,14 @@ type DomainEvent interface {
 
 type ProductCreatedEvent struct {
 	ProductID string
+	TenantID  string
 	Name      string
 	Category  string
 	BasePrice *Money
 	CreatedAt time.Time
+	// ClonedFrom and ClonedFromTenant name the source of a product created by CloneProduct
+	ClonedFrom       string
+	ClonedFromTenant string
 }
 
 func (e *ProductCreatedEvent) EventName() string {
@@ -20,18 +24,28 @@ func (e *ProductCreatedEvent) EventName() string {
 }
 
 func (e *ProductCreatedEvent) EventData() map[string]interface{} {
-	return map[string]interface{}{
+	data := map[string]interface{}{
 		"product_id": e.ProductID,
+		"tenant_id":  e.TenantID,
 		"name":       e.Name,
 		"category":   e.Category,
 		"created_at": e.CreatedAt,
 	}
+	if e.ClonedFrom != "" {
+		data["cloned_from"] = map[string]interface{}{
+			"product_id": e.ClonedFrom,
+			"tenant_id":  e.ClonedFromTenant,
+		}
+	}
+	return data
 }
 
 type ProductUpdatedEvent struct {
 	ProductID     string
 	UpdatedAt     time.Time
 	ChangedFields []string
+	// Previous holds the earlier name, description and category for those that changed
+	Previous map[string]string
 }
 
 func (e *ProductUpdatedEvent) EventName() string {
@@ -39,11 +53,15 @@ func (e *ProductUpdatedEvent) EventName() string {
 }
 
 func (e *ProductUpdatedEvent) EventData() map[string]interface{} {
-	return map[string]interface{}{
+	data := map[string]interface{}{
 		"product_id":     e.ProductID,
 		"updated_at":     e.UpdatedAt,
 		"changed_fields": e.ChangedFields,
 	}
+	if len(e.Previous) > 0 {
+		data["previous"] = e.Previous
+	}
+	return data
 }
 
 type DiscountAppliedEvent struct {
@@ -127,3 +145,376 @@ func (e *DiscountRemovedEvent) EventData() map[string]interface{} {
 		"removed_at": e.RemovedAt,
 	}
 }
+
+type LegalHoldChangedEvent struct {
+	ProductID string
+	LegalHold bool
+	ChangedAt time.Time
+}
+
+func (e *LegalHoldChangedEvent) EventName() string {
+	return "legal_hold_changed"
+}
+
+func (e *LegalHoldChangedEvent) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"product_id": e.ProductID,
+		"legal_hold": e.LegalHold,
+		"changed_at": e.ChangedAt,
+	}
+}
+
+// ChannelsChangedEvent records the product's new set of sales channels
+type ChannelsChangedEvent struct {
+	ProductID string
+	Channels  []string
+	ChangedAt time.Time
+}
+
+func (e *ChannelsChangedEvent) EventName() string {
+	return "channels_changed"
+}
+
+func (e *ChannelsChangedEvent) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"product_id": e.ProductID,
+		"channels":   e.Channels,
+		"changed_at": e.ChangedAt,
+	}
+}
+
+// MetadataChangedEvent records the product's new integrator metadata in full
+type MetadataChangedEvent struct {
+	ProductID string
+	Metadata  map[string]string
+	ChangedAt time.Time
+}
+
+func (e *MetadataChangedEvent) EventName() string {
+	return "metadata_changed"
+}
+
+func (e *MetadataChangedEvent) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"product_id": e.ProductID,
+		"metadata":   e.Metadata,
+		"changed_at": e.ChangedAt,
+	}
+}
+
+// AttributesChangedEvent records the product's new attributes in full
+type AttributesChangedEvent struct {
+	ProductID  string
+	Attributes map[string]string
+	ChangedAt  time.Time
+}
+
+func (e *AttributesChangedEvent) EventName() string {
+	return "attributes_changed"
+}
+
+func (e *AttributesChangedEvent) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"product_id": e.ProductID,
+		"attributes": e.Attributes,
+		"changed_at": e.ChangedAt,
+	}
+}
+
+// ProductPurgedEvent records that a product was hard-deleted by retention or with its tenant
+// ArchivedAt is zero for products purged by DeleteTenant
+type ProductPurgedEvent struct {
+	ProductID  string
+	TenantID   string
+	ArchivedAt time.Time
+	PurgedAt   time.Time
+}
+
+func (e *ProductPurgedEvent) EventName() string {
+	return "product_purged"
+}
+
+func (e *ProductPurgedEvent) EventData() map[string]interface{} {
+	data := map[string]interface{}{
+		"product_id": e.ProductID,
+		"tenant_id":  e.TenantID,
+		"purged_at":  e.PurgedAt,
+	}
+	if !e.ArchivedAt.IsZero() {
+		data["archived_at"] = e.ArchivedAt
+	}
+	return data
+}
+
+// ProductApprovedEvent records a reviewer approving a product
+type ProductApprovedEvent struct {
+	ProductID  string
+	Reviewer   string
+	Comment    string
+	ReviewedAt time.Time
+}
+
+func (e *ProductApprovedEvent) EventName() string {
+	return "product_approved"
+}
+
+func (e *ProductApprovedEvent) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"product_id":  e.ProductID,
+		"reviewer":    e.Reviewer,
+		"comment":     e.Comment,
+		"reviewed_at": e.ReviewedAt,
+	}
+}
+
+// ProductRejectedEvent records a reviewer rejecting a product, with the reason in Comment
+type ProductRejectedEvent struct {
+	ProductID  string
+	Reviewer   string
+	Comment    string
+	ReviewedAt time.Time
+}
+
+func (e *ProductRejectedEvent) EventName() string {
+	return "product_rejected"
+}
+
+func (e *ProductRejectedEvent) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"product_id":  e.ProductID,
+		"reviewer":    e.Reviewer,
+		"comment":     e.Comment,
+		"reviewed_at": e.ReviewedAt,
+	}
+}
+
+// ProductMergedEvent records a duplicate product being merged into its canonical product
+type ProductMergedEvent struct {
+	ProductID   string
+	CanonicalID string
+	MergedAt    time.Time
+}
+
+func (e *ProductMergedEvent) EventName() string {
+	return "product_merged"
+}
+
+func (e *ProductMergedEvent) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"product_id":   e.ProductID,
+		"canonical_id": e.CanonicalID,
+		"merged_at":    e.MergedAt,
+	}
+}
+
+// ExternalRefLinkedEvent records an external system's identifier being linked to the product
+type ExternalRefLinkedEvent struct {
+	ProductID  string
+	System     string
+	ExternalID string
+	LinkedAt   time.Time
+}
+
+func (e *ExternalRefLinkedEvent) EventName() string {
+	return "external_ref_linked"
+}
+
+func (e *ExternalRefLinkedEvent) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"product_id":  e.ProductID,
+		"system":      e.System,
+		"external_id": e.ExternalID,
+		"linked_at":   e.LinkedAt,
+	}
+}
+
+// ExternalRefUnlinkedEvent records an external system's identifier being unlinked from the product
+type ExternalRefUnlinkedEvent struct {
+	ProductID  string
+	System     string
+	ExternalID string
+	UnlinkedAt time.Time
+}
+
+func (e *ExternalRefUnlinkedEvent) EventName() string {
+	return "external_ref_unlinked"
+}
+
+func (e *ExternalRefUnlinkedEvent) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"product_id":  e.ProductID,
+		"system":      e.System,
+		"external_id": e.ExternalID,
+		"unlinked_at": e.UnlinkedAt,
+	}
+}
+
+// BasePriceChangedEvent records a new base price; ApprovedBy and ChangeID are set when a pending change was approved
+type BasePriceChangedEvent struct {
+	ProductID  string
+	OldPrice   *Money
+	NewPrice   *Money
+	ChangedBy  string
+	ApprovedBy string
+	ChangeID   string
+	ChangedAt  time.Time
+}
+
+func (e *BasePriceChangedEvent) EventName() string {
+	return "base_price_changed"
+}
+
+func (e *BasePriceChangedEvent) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"product_id":  e.ProductID,
+		"old_price":   moneyString(e.OldPrice),
+		"new_price":   moneyString(e.NewPrice),
+		"changed_by":  e.ChangedBy,
+		"approved_by": e.ApprovedBy,
+		"change_id":   e.ChangeID,
+		"changed_at":  e.ChangedAt,
+	}
+}
+
+// PriceChangeRequestedEvent records a base price change held for a second approver
+type PriceChangeRequestedEvent struct {
+	ChangeID    string
+	ProductID   string
+	OldPrice    *Money
+	NewPrice    *Money
+	RequestedBy string
+	RequestedAt time.Time
+}
+
+func (e *PriceChangeRequestedEvent) EventName() string {
+	return "price_change_requested"
+}
+
+func (e *PriceChangeRequestedEvent) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"change_id":    e.ChangeID,
+		"product_id":   e.ProductID,
+		"old_price":    moneyString(e.OldPrice),
+		"new_price":    moneyString(e.NewPrice),
+		"requested_by": e.RequestedBy,
+		"requested_at": e.RequestedAt,
+	}
+}
+
+// ChangeApprovedEvent records the second approver accepting a pending change
+type ChangeApprovedEvent struct {
+	ChangeID   string
+	ProductID  string
+	Approver   string
+	ApprovedAt time.Time
+}
+
+func (e *ChangeApprovedEvent) EventName() string {
+	return "change_approved"
+}
+
+func (e *ChangeApprovedEvent) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"change_id":   e.ChangeID,
+		"product_id":  e.ProductID,
+		"approver":    e.Approver,
+		"approved_at": e.ApprovedAt,
+	}
+}
+
+// ChangeRejectedEvent records the second approver turning down a pending change
+type ChangeRejectedEvent struct {
+	ChangeID   string
+	ProductID  string
+	Approver   string
+	Reason     string
+	RejectedAt time.Time
+}
+
+func (e *ChangeRejectedEvent) EventName() string {
+	return "change_rejected"
+}
+
+func (e *ChangeRejectedEvent) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"change_id":   e.ChangeID,
+		"product_id":  e.ProductID,
+		"approver":    e.Approver,
+		"reason":      e.Reason,
+		"rejected_at": e.RejectedAt,
+	}
+}
+
+// PriceFloorChangedEvent records the product's new price floor; unset prices mean no constraint
+type PriceFloorChangedEvent struct {
+	ProductID        string
+	MinPrice         *Money
+	Cost             *Money
+	MinMarginPercent int64
+	MapPrice         *Money
+	ChangedAt        time.Time
+}
+
+func (e *PriceFloorChangedEvent) EventName() string {
+	return "price_floor_changed"
+}
+
+func (e *PriceFloorChangedEvent) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"product_id":         e.ProductID,
+		"min_price":          moneyString(e.MinPrice),
+		"cost":               moneyString(e.Cost),
+		"min_margin_percent": e.MinMarginPercent,
+		"map_price":          moneyString(e.MapPrice),
+		"changed_at":         e.ChangedAt,
+	}
+}
+
+// TenantProvisionedEvent records a tenant becoming active after CreateTenant
+// Tenant events are keyed by RegistrationID, since outbox aggregates are UUIDs
+type TenantProvisionedEvent struct {
+	TenantID          string
+	RegistrationID    string
+	DisplayName       string
+	CategoryTemplates int
+	APIKeys           int
+	ProvisionedAt     time.Time
+}
+
+func (e *TenantProvisionedEvent) EventName() string {
+	return "tenant_provisioned"
+}
+
+func (e *TenantProvisionedEvent) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"tenant_id":          e.TenantID,
+		"registration_id":    e.RegistrationID,
+		"display_name":       e.DisplayName,
+		"category_templates": e.CategoryTemplates,
+		"api_keys":           e.APIKeys,
+		"provisioned_at":     e.ProvisionedAt,
+	}
+}
+
+// TenantDeletedEvent records a tenant's data being purged by DeleteTenant
+type TenantDeletedEvent struct {
+	TenantID       string
+	RegistrationID string
+	ProductsPurged int64
+	ExportPrefix   string // empty when the products were not exported
+	DeletedAt      time.Time
+}
+
+func (e *TenantDeletedEvent) EventName() string {
+	return "tenant_deleted"
+}
+
+func (e *TenantDeletedEvent) EventData() map[string]interface{} {
+	return map[string]interface{}{
+		"tenant_id":       e.TenantID,
+		"registration_id": e.RegistrationID,
+		"products_purged": e.ProductsPurged,
+		"export_prefix":   e.ExportPrefix,
+		"deleted_at":      e.DeletedAt,
+	}
+}
@@ -0,0 +1,77 @@
+package domain
+
+import "time"
+
+// Draft is a product's staged content, kept apart from the published product until it is published
+// Like a ContentVersion it covers the content merchandisers edit, and nothing else
+type Draft struct {
+	ProductID   string
+	TenantID    string
+	Name        string
+	Description string
+	Category    string
+	Metadata    Metadata
+	UpdatedAt   time.Time
+}
+
+// DraftEdit holds the content to stage; nil fields keep the current draft's value, or the published one
+type DraftEdit struct {
+	Name        *string
+	Description *string
+	Category    *string
+	Metadata    *Metadata
+}
+
+// StageDraft applies the edit on top of the product's draft, or its published content when draft is nil,
+// and validates the result without changing the product
+func (p *Product) StageDraft(draft *Draft, edit DraftEdit, now time.Time) (*Draft, error) {
+	if p.archivedAt != nil {
+		return nil, ErrProductAlreadyArchived
+	}
+
+	staged := &Draft{
+		ProductID:   p.id,
+		TenantID:    p.tenantID,
+		Name:        p.name,
+		Description: p.description,
+		Category:    p.category,
+		Metadata:    p.metadata.Clone(),
+		UpdatedAt:   now,
+	}
+	if draft != nil {
+		staged.Name, staged.Description, staged.Category = draft.Name, draft.Description, draft.Category
+		staged.Metadata = draft.Metadata.Clone()
+	}
+	if edit.Name != nil {
+		staged.Name = *edit.Name
+	}
+	if edit.Description != nil {
+		staged.Description = *edit.Description
+	}
+	if edit.Category != nil {
+		staged.Category = *edit.Category
+	}
+	if edit.Metadata != nil {
+		staged.Metadata = edit.Metadata.Clone()
+	}
+
+	// Validate inputs
+	name, description, category, err := validateDetails(staged.Name, staged.Description, staged.Category)
+	if err != nil {
+		return nil, err
+	}
+	if err := staged.Metadata.Validate(); err != nil {
+		return nil, err
+	}
+	staged.Name, staged.Description, staged.Category = name, description, category
+	return staged, nil
+}
+
+// PublishDraft replaces the product's content with the draft's, emitting the usual
+// ProductUpdatedEvent and MetadataChangedEvent for whatever differs
+func (p *Product) PublishDraft(draft *Draft, now time.Time) error {
+	if err := p.UpdateDetails(draft.Name, draft.Description, draft.Category, now); err != nil {
+		return err
+	}
+	return p.SetMetadata(draft.Metadata, now)
+}
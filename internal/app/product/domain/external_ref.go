@@ -0,0 +1,27 @@
+package domain
+
+import "regexp"
+
+// MaxExternalIDLength bounds an identifier issued by an external system
+const MaxExternalIDLength = 255
+
+// externalSystemPattern allows short lowercase system names such as "erp" or "amazon-de"
+var externalSystemPattern = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,31}$`)
+
+// ExternalRef identifies a product in an external system, such as an ERP or a marketplace
+// Unlike metadata, refs are indexed and unique per tenant, so sync jobs can look products up by them
+type ExternalRef struct {
+	System     string
+	ExternalID string
+}
+
+// Validate checks the system name and the external identifier
+func (r ExternalRef) Validate() error {
+	if !externalSystemPattern.MatchString(r.System) {
+		return ErrInvalidExternalRef
+	}
+	if r.ExternalID == "" || len(r.ExternalID) > MaxExternalIDLength {
+		return ErrInvalidExternalRef
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package domain
+
+import "strings"
+
+// ValidateSKU checks an optional merchant SKU ("" means not set)
+func ValidateSKU(sku string) error {
+	if sku == "" {
+		return nil
+	}
+	if len(sku) > 64 || strings.ContainsAny(sku, " \t\r\n") {
+		return ErrInvalidSKU
+	}
+	return nil
+}
+
+// ValidateGTIN checks an optional GTIN-8/12/13/14 ("" means not set), including its check digit
+func ValidateGTIN(gtin string) error {
+	if gtin == "" {
+		return nil
+	}
+	switch len(gtin) {
+	case 8, 12, 13, 14:
+	default:
+		return ErrInvalidGTIN
+	}
+
+	// Weights alternate 3,1,... starting from the digit left of the check digit
+	sum := 0
+	for i := len(gtin) - 2; i >= 0; i-- {
+		c := gtin[i]
+		if c < '0' || c > '9' {
+			return ErrInvalidGTIN
+		}
+		d := int(c - '0')
+		if (len(gtin)-2-i)%2 == 0 {
+			d *= 3
+		}
+		sum += d
+	}
+	check := gtin[len(gtin)-1]
+	if check < '0' || check > '9' || int(check-'0') != (10-sum%10)%10 {
+		return ErrInvalidGTIN
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// MerchRuleKind is what a merchandising rule does to search results
+type MerchRuleKind string
+
+const (
+	// MerchRuleKindPin places a product at a fixed position for a query, whether or not it matches
+	MerchRuleKindPin MerchRuleKind = "pin"
+	// MerchRuleKindBoost multiplies the relevance of a category's products
+	MerchRuleKindBoost MerchRuleKind = "boost"
+)
+
+const (
+	// MaxMerchRuleQueryLength bounds the query a rule applies to
+	MaxMerchRuleQueryLength = 256
+	// MaxPinPosition bounds the result position a pin can claim
+	MaxPinPosition = 100
+	// MaxBoost bounds a boost factor; factors below 1 bury a category instead
+	MaxBoost = 100
+)
+
+// MerchRule lets merchandisers curate SearchProducts results without code changes
+// Pins apply to one query; boosts apply to one query, or to every search when Query is empty
+type MerchRule struct {
+	ID        string
+	Query     string // Normalized with NormalizeSearchQuery
+	Kind      MerchRuleKind
+	ProductID string  // Pins only
+	Position  int64   // Pins only: 1-based result position
+	Category  string  // Boosts only
+	Boost     float64 // Boosts only: relevance multiplier
+	CreatedAt time.Time
+}
+
+// Validate checks the rule carries exactly the fields its kind uses
+func (r MerchRule) Validate() error {
+	if len(r.Query) > MaxMerchRuleQueryLength || r.Query != NormalizeSearchQuery(r.Query) {
+		return ErrInvalidMerchRule
+	}
+	switch r.Kind {
+	case MerchRuleKindPin:
+		if r.Query == "" || r.ProductID == "" || r.Position < 1 || r.Position > MaxPinPosition {
+			return ErrInvalidMerchRule
+		}
+		if r.Category != "" || r.Boost != 0 {
+			return ErrInvalidMerchRule
+		}
+	case MerchRuleKindBoost:
+		if r.Category == "" || !(r.Boost > 0 && r.Boost <= MaxBoost) {
+			return ErrInvalidMerchRule
+		}
+		if r.ProductID != "" || r.Position != 0 {
+			return ErrInvalidMerchRule
+		}
+	default:
+		return ErrInvalidMerchRule
+	}
+	return nil
+}
+
+// AppliesTo reports whether the rule curates the normalized search query
+func (r MerchRule) AppliesTo(query string) bool {
+	return r.Query == query || (r.Kind == MerchRuleKindBoost && r.Query == "")
+}
+
+// NormalizeSearchQuery lowercases a search query and collapses its whitespace,
+// so "Gaming  Laptop" and "gaming laptop" share results and rules
+func NormalizeSearchQuery(query string) string {
+	return strings.Join(strings.Fields(strings.ToLower(query)), " ")
+}
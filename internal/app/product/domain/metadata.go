@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"maps"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	// MaxMetadataEntries bounds how many keys a product's metadata holds
+	MaxMetadataEntries = 32
+	// MaxMetadataValueLength bounds each metadata value
+	MaxMetadataValueLength = 256
+)
+
+// metadataKeyPattern allows lowercase identifiers such as "erp_code" or "marketplace.listing-id"
+var metadataKeyPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_.-]{0,63}$`)
+
+// Metadata is free-form key/value data integrators attach to a product, such as external IDs
+// The catalog never interprets it
+type Metadata map[string]string
+
+// Validate checks the number of entries, the key format and the value length
+func (m Metadata) Validate() error {
+	if len(m) > MaxMetadataEntries {
+		return ErrInvalidMetadata
+	}
+	for k, v := range m {
+		if !ValidMetadataKey(k) || len(v) > MaxMetadataValueLength {
+			return ErrInvalidMetadata
+		}
+	}
+	return nil
+}
+
+// ValidMetadataKey reports whether key is a well-formed metadata key
+func ValidMetadataKey(key string) bool {
+	return metadataKeyPattern.MatchString(key)
+}
+
+// Clone returns a copy of the metadata, or nil when it is empty
+func (m Metadata) Clone() Metadata {
+	if len(m) == 0 {
+		return nil
+	}
+	return maps.Clone(m)
+}
+
+// Entries returns the metadata as sorted "key=value" strings, the form it is stored and filtered in
+func (m Metadata) Entries() []string {
+	if len(m) == 0 {
+		return nil
+	}
+	entries := make([]string, 0, len(m))
+	for k, v := range m {
+		entries = append(entries, MetadataEntry(k, v))
+	}
+	sort.Strings(entries)
+	return entries
+}
+
+// MetadataEntry formats one key/value pair; keys cannot contain "=", so the first one separates them
+func MetadataEntry(key, value string) string {
+	return key + "=" + value
+}
+
+// MetadataFromEntries converts stored "key=value" entries without validating them
+func MetadataFromEntries(entries []string) Metadata {
+	if len(entries) == 0 {
+		return nil
+	}
+	m := make(Metadata, len(entries))
+	for _, e := range entries {
+		k, v, _ := strings.Cut(e, "=")
+		m[k] = v
+	}
+	return m
+}
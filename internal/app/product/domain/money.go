@@ -27,3 +27,16 @@ func Multiply(m, other Money) Money {
 	result := new(big.Rat).Mul(m, other)
 	return result
 }
+
+// Compare returns -1, 0 or +1 as m is less than, equal to or greater than other
+func Compare(m, other Money) int {
+	return (*big.Rat)(m).Cmp(other)
+}
+
+// moneyString formats a price exactly for event payloads, or "" when it is unset
+func moneyString(m *Money) string {
+	if m == nil || *m == nil {
+		return ""
+	}
+	return (*big.Rat)(*m).RatString()
+}
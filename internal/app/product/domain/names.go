@@ -0,0 +1,15 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+)
+
+// whitespace matches the same characters as \s in Spanner's REGEXP_REPLACE (both are RE2)
+var whitespace = regexp.MustCompile(`\s+`)
+
+// NormalizeName folds case and whitespace so "iPhone  Case" and "iphone case" compare equal
+// The repository applies the same normalization in SQL; keep the two in sync
+func NormalizeName(name string) string {
+	return strings.Trim(whitespace.ReplaceAllString(strings.ToLower(name), " "), " ")
+}
@@ -0,0 +1,222 @@
+package domain
+
+import (
+	"math/big"
+	"strings"
+	"time"
+)
+
+// PendingChangeKind names the product change awaiting approval
+type PendingChangeKind string
+
+const (
+	PendingChangeBasePrice PendingChangeKind = "base_price"
+)
+
+// PendingChangeStatus tracks a pending change through the four-eyes review
+type PendingChangeStatus string
+
+const (
+	PendingChangeStatusPending  PendingChangeStatus = "pending"
+	PendingChangeStatusApproved PendingChangeStatus = "approved"
+	PendingChangeStatusRejected PendingChangeStatus = "rejected"
+)
+
+// PendingChange is a product change held back until a second person approves it
+// Only base price changes above the configured threshold are held today
+type PendingChange struct {
+	id           string
+	tenantID     string
+	productID    string
+	kind         PendingChangeKind
+	oldBasePrice *Money
+	newBasePrice *Money
+	requestedBy  string
+	requestedAt  time.Time
+	status       PendingChangeStatus
+	decidedBy    string
+	decisionNote string
+	decidedAt    *time.Time
+	events       []DomainEvent
+}
+
+// NewPendingPriceChange holds a base price change of product for approval and emits PriceChangeRequestedEvent
+func NewPendingPriceChange(id string, product *Product, newBasePrice *Money, requestedBy string, now time.Time) (*PendingChange, error) {
+	if product.archivedAt != nil {
+		return nil, ErrProductAlreadyArchived
+	}
+	if !validPrice(newBasePrice) {
+		return nil, ErrInvalidPrice
+	}
+	// A change the floor forbids could never be applied, so it is refused before anyone reviews it
+	if err := product.priceFloor.check(newBasePrice, product.discount, now); err != nil {
+		return nil, err
+	}
+	requestedBy = strings.TrimSpace(requestedBy)
+	if requestedBy == "" || len(requestedBy) > 255 {
+		return nil, ErrInvalidApprover
+	}
+
+	c := &PendingChange{
+		id:           id,
+		tenantID:     product.tenantID,
+		productID:    product.id,
+		kind:         PendingChangeBasePrice,
+		oldBasePrice: product.basePrice,
+		newBasePrice: newBasePrice,
+		requestedBy:  requestedBy,
+		requestedAt:  now,
+		status:       PendingChangeStatusPending,
+	}
+	c.events = append(c.events, &PriceChangeRequestedEvent{
+		ChangeID:    id,
+		ProductID:   product.id,
+		OldPrice:    product.basePrice,
+		NewPrice:    newBasePrice,
+		RequestedBy: requestedBy,
+		RequestedAt: now,
+	})
+	return c, nil
+}
+
+// ReconstructPendingChange creates a PendingChange from persisted data
+func ReconstructPendingChange(
+	id, tenantID, productID string,
+	kind PendingChangeKind,
+	oldBasePrice, newBasePrice *Money,
+	requestedBy string,
+	requestedAt time.Time,
+	status PendingChangeStatus,
+	decidedBy, decisionNote string,
+	decidedAt *time.Time,
+) *PendingChange {
+	return &PendingChange{
+		id:           id,
+		tenantID:     tenantID,
+		productID:    productID,
+		kind:         kind,
+		oldBasePrice: oldBasePrice,
+		newBasePrice: newBasePrice,
+		requestedBy:  requestedBy,
+		requestedAt:  requestedAt,
+		status:       status,
+		decidedBy:    decidedBy,
+		decisionNote: decisionNote,
+		decidedAt:    decidedAt,
+	}
+}
+
+// Decide records the approver's decision; the requester can never approve their own change
+// Approving does not touch the product: the caller applies the change with ApplyPendingChange
+func (c *PendingChange) Decide(decision ReviewDecision, approver, note string, now time.Time) error {
+	if c.status != PendingChangeStatusPending {
+		return ErrChangeNotPending
+	}
+	approver = strings.TrimSpace(approver)
+	if approver == "" || len(approver) > 255 {
+		return ErrInvalidApprover
+	}
+	if approver == c.requestedBy {
+		return ErrSelfApproval
+	}
+	note = strings.TrimSpace(note)
+	if len(note) > 2000 {
+		return ErrInvalidReviewComment
+	}
+
+	switch decision {
+	case ReviewApproved:
+		c.status = PendingChangeStatusApproved
+		c.events = append(c.events, &ChangeApprovedEvent{
+			ChangeID:   c.id,
+			ProductID:  c.productID,
+			Approver:   approver,
+			ApprovedAt: now,
+		})
+	case ReviewRejected:
+		if note == "" {
+			return ErrInvalidReviewComment
+		}
+		c.status = PendingChangeStatusRejected
+		c.events = append(c.events, &ChangeRejectedEvent{
+			ChangeID:   c.id,
+			ProductID:  c.productID,
+			Approver:   approver,
+			Reason:     note,
+			RejectedAt: now,
+		})
+	default:
+		return ErrInvalidReviewDecision
+	}
+
+	c.decidedBy = approver
+	c.decisionNote = note
+	c.decidedAt = &now
+	return nil
+}
+
+// Getters
+func (c *PendingChange) ID() string {
+	return c.id
+}
+
+func (c *PendingChange) TenantID() string {
+	return c.tenantID
+}
+
+func (c *PendingChange) ProductID() string {
+	return c.productID
+}
+
+func (c *PendingChange) Kind() PendingChangeKind {
+	return c.kind
+}
+
+func (c *PendingChange) OldBasePrice() *Money {
+	return c.oldBasePrice
+}
+
+func (c *PendingChange) NewBasePrice() *Money {
+	return c.newBasePrice
+}
+
+func (c *PendingChange) RequestedBy() string {
+	return c.requestedBy
+}
+
+func (c *PendingChange) RequestedAt() time.Time {
+	return c.requestedAt
+}
+
+func (c *PendingChange) Status() PendingChangeStatus {
+	return c.status
+}
+
+func (c *PendingChange) DecidedBy() string {
+	return c.decidedBy
+}
+
+func (c *PendingChange) DecisionNote() string {
+	return c.decisionNote
+}
+
+func (c *PendingChange) DecidedAt() *time.Time {
+	return c.decidedAt
+}
+
+func (c *PendingChange) DomainEvents() []DomainEvent {
+	return c.events
+}
+
+// validPrice reports whether price is set and positive
+func validPrice(price *Money) bool {
+	return price != nil && *price != nil && (*big.Rat)(*price).Sign() > 0
+}
+
+// samePrice compares two prices, treating unset prices as equal only to each other
+func samePrice(a, b *Money) bool {
+	if a == nil || *a == nil || b == nil || *b == nil {
+		return (a == nil || *a == nil) && (b == nil || *b == nil)
+	}
+	return (*big.Rat)(*a).Cmp(*b) == 0
+}
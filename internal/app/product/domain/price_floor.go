@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"math/big"
+	"time"
+)
+
+// MaxMinMarginPercent bounds the margin a price floor can require
+const MaxMinMarginPercent = 99
+
+// PriceFloor protects a product's effective price, after any discount, from dropping too low
+// The price may not fall below MinPrice, nor below the price that keeps MinMarginPercent over Cost;
+// a Cost without a margin still forbids selling at a loss.
+// MapPrice, the minimum advertised price, does not limit the price charged; the pricing calculator
+// shows it in place of any lower effective price when MAP enforcement is on
+type PriceFloor struct {
+	MinPrice         *Money
+	Cost             *Money
+	MinMarginPercent int64
+	MapPrice         *Money
+}
+
+// IsZero reports whether the floor constrains nothing
+func (f PriceFloor) IsZero() bool {
+	return f.MinPrice == nil && f.Cost == nil && f.MinMarginPercent == 0 && f.MapPrice == nil
+}
+
+// Validate checks the prices are positive and the margin is a percentage that applies to a cost
+func (f PriceFloor) Validate() error {
+	if f.MinPrice != nil && !validPrice(f.MinPrice) {
+		return ErrInvalidPriceFloor
+	}
+	if f.Cost != nil && !validPrice(f.Cost) {
+		return ErrInvalidPriceFloor
+	}
+	if f.MapPrice != nil && !validPrice(f.MapPrice) {
+		return ErrInvalidPriceFloor
+	}
+	if f.MinMarginPercent < 0 || f.MinMarginPercent > MaxMinMarginPercent {
+		return ErrInvalidPriceFloor
+	}
+	if f.MinMarginPercent > 0 && f.Cost == nil {
+		return ErrInvalidPriceFloor
+	}
+	return nil
+}
+
+// Floor returns the lowest allowed effective price, or nil when the floor constrains nothing
+func (f PriceFloor) Floor() *big.Rat {
+	var floor *big.Rat
+	if f.MinPrice != nil && *f.MinPrice != nil {
+		floor = new(big.Rat).Set(*f.MinPrice)
+	}
+	if f.Cost != nil && *f.Cost != nil {
+		// margin = (price - cost) / price  =>  price >= cost / (1 - margin)
+		keep := big.NewRat(100-f.MinMarginPercent, 100)
+		marginFloor := new(big.Rat).Quo(*f.Cost, keep)
+		if floor == nil || marginFloor.Cmp(floor) > 0 {
+			floor = marginFloor
+		}
+	}
+	return floor
+}
+
+// check verifies basePrice, and the discounted price while discount has not ended, stay at or above the floor
+func (f PriceFloor) check(basePrice *Money, discount *Discount, now time.Time) error {
+	floor := f.Floor()
+	if floor == nil || !validPrice(basePrice) {
+		return nil
+	}
+	price := new(big.Rat).Set(*basePrice)
+	if discount != nil && discount.Amount != nil && *discount.Amount != nil && now.Before(discount.EndDate) {
+		price.Mul(price, new(big.Rat).Sub(big.NewRat(1, 1), *discount.Amount))
+	}
+	if price.Cmp(floor) < 0 {
+		return ErrPriceBelowFloor
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"maps"
 	"strings"
 	"time"
 )
@@ -13,53 +14,186 @@ const (
 )
 
 const (
+	FieldBasePrice   = "base_price"
 	FieldDiscount    = "discount"
 	FieldName        = "name"
 	FieldDescription = "description"
 	FieldCategory    = "category"
 	FieldStatus      = "status"
 	FieldArchivedAt  = "archived_at"
+	FieldLegalHold   = "legal_hold"
+	FieldChannels    = "channels"
+	FieldMetadata    = "metadata"
+	FieldAttributes  = "attributes"
+	FieldPriceFloor  = "price_floor"
+	FieldNameKey     = "name_key"
+	FieldUpdatedAt   = "updated_at"
+
+	// FieldDescriptionFormat is reported in ProductUpdatedEvent when the format changes
+	FieldDescriptionFormat = "description_format"
+
+	// Fulfilment fields, also reported in ProductUpdatedEvent when they change
+	FieldProductType   = "product_type"
+	FieldWeight        = "weight"
+	FieldDimensions    = "dimensions"
+	FieldShippingClass = "shipping_class"
+	FieldDownloadURL   = "download_url"
+	FieldLicenseTerms  = "license_terms"
+)
+
+const (
+	// Compliance fields, reported in ProductUpdatedEvent when they change
+	FieldAgeRestriction       = "age_restriction"
+	FieldHazardous            = "hazardous"
+	FieldRequiresPrescription = "requires_prescription"
 )
 
 type Product struct {
-	id          string
-	name        string
-	description string
-	category    string
-	basePrice   *Money
-	discount    *Discount
-	status      ProductStatus
-	changes     ChangeTracker
-	events      []DomainEvent
-	archivedAt  *time.Time
-	createdAt   time.Time
-	updatedAt   time.Time
-}
-
-func NewProduct(id, name, description, category string, basePrice *Money, createdAt time.Time) *Product {
+	id                string
+	tenantID          string
+	name              string
+	description       string
+	descriptionFormat DescriptionFormat
+	category          string
+	sku               string
+	gtin              string
+	basePrice         *Money
+	discount          *Discount
+	status            ProductStatus
+	legalHold         bool
+	channels          []Channel
+	productType       ProductType
+	shipping          ShippingDetails
+	digital           DigitalDelivery
+	compliance        Compliance
+	metadata          Metadata
+	attributes        Attributes
+	priceFloor        PriceFloor
+	uniqueName        bool
+	storedNameKey     string // name_key as persisted, so an unchanged key is not rewritten
+	changes           ChangeTracker
+	events            []DomainEvent
+	archivedAt        *time.Time
+	createdAt         time.Time
+	updatedAt         time.Time
+}
+
+// NewProduct creates an inactive product and emits ProductCreatedEvent
+// Name, description and category are trimmed; invalid details, a missing or non-positive
+// price, a malformed SKU/GTIN, a malformed markdown description or fulfilment fields that do
+// not suit the product type are rejected
+func NewProduct(
+	id, tenantID, name, description, category, sku, gtin string,
+	descriptionFormat DescriptionFormat,
+	basePrice *Money,
+	productType ProductType,
+	shipping ShippingDetails,
+	digital DigitalDelivery,
+	compliance Compliance,
+	now time.Time,
+) (*Product, error) {
+	name, description, category, err := validateDetails(name, description, category)
+	if err != nil {
+		return nil, err
+	}
+	if err := ValidateDescriptionFormat(descriptionFormat, description); err != nil {
+		return nil, err
+	}
+	if !validPrice(basePrice) {
+		return nil, ErrInvalidPrice
+	}
+	if err := ValidateSKU(sku); err != nil {
+		return nil, err
+	}
+	if err := ValidateGTIN(gtin); err != nil {
+		return nil, err
+	}
+	if err := validateFulfilment(productType, shipping, digital); err != nil {
+		return nil, err
+	}
+	if err := compliance.Validate(); err != nil {
+		return nil, err
+	}
+
 	p := &Product{
-		id:          id,
-		name:        name,
-		description: description,
-		category:    category,
-		basePrice:   basePrice,
-		status:      ProductStatusInactive,
-		createdAt:   createdAt,
-		updatedAt:   createdAt,
-		changes:     ChangeTracker{dirtyFields: make(map[string]bool)},
-		events:      []DomainEvent{},
+		id:                id,
+		tenantID:          tenantID,
+		name:              name,
+		description:       description,
+		descriptionFormat: descriptionFormat,
+		category:          category,
+		sku:               sku,
+		gtin:              gtin,
+		basePrice:         basePrice,
+		productType:       productType,
+		shipping:          shipping,
+		digital:           digital,
+		compliance:        compliance,
+		status:            ProductStatusInactive,
+		createdAt:         now,
+		updatedAt:         now,
+		changes:           ChangeTracker{},
+		events:            []DomainEvent{},
 	}
 
 	// Emit domain event
 	p.events = append(p.events, &ProductCreatedEvent{
 		ProductID: id,
+		TenantID:  tenantID,
 		Name:      name,
 		Category:  category,
 		BasePrice: basePrice,
-		CreatedAt: createdAt,
+		CreatedAt: now,
 	})
 
-	return p
+	return p, nil
+}
+
+// Clone creates an inactive copy of the product with a new ID in tenantID and emits a
+// ProductCreatedEvent naming the source as cloned_from
+// Content, fulfilment, compliance, metadata (which holds media URLs) and attributes are copied.
+// An empty name keeps the source's. A nil basePrice copies the source's base price and price
+// floor. The SKU, GTIN, channels, discount, legal hold and archive state are not copied
+func (p *Product) Clone(id, tenantID, name string, basePrice *Money, now time.Time) (*Product, error) {
+	if name == "" {
+		name = p.name
+	}
+	copyPrices := basePrice == nil
+	if copyPrices {
+		basePrice = p.basePrice
+	}
+
+	clone, err := NewProduct(
+		id,
+		tenantID,
+		name,
+		p.description,
+		p.category,
+		"",
+		"",
+		p.descriptionFormat,
+		basePrice,
+		p.productType,
+		p.shipping,
+		p.digital,
+		p.compliance,
+		now,
+	)
+	if err != nil {
+		return nil, err
+	}
+	clone.metadata = p.metadata.Clone()
+	clone.attributes = p.attributes.Clone()
+	if copyPrices {
+		clone.priceFloor = p.priceFloor
+	}
+	for _, event := range clone.events {
+		if created, ok := event.(*ProductCreatedEvent); ok {
+			created.ClonedFrom = p.id
+			created.ClonedFromTenant = p.tenantID
+		}
+	}
+	return clone, nil
 }
 
 // Business method (pure logic)
@@ -85,9 +219,13 @@ func (p *Product) ApplyDiscount(discount *Discount, now time.Time) error {
 	if p.discount != nil && p.discount.IsValidAt(now) {
 		return ErrDiscountAlreadyActive
 	}
+	if err := p.priceFloor.check(p.basePrice, discount, now); err != nil {
+		return err
+	}
 
+	p.changes.Record(FieldDiscount, p.discount, discount)
 	p.discount = discount
-	p.changes.MarkDirty(FieldDiscount)
+	p.touch(now)
 	p.events = append(p.events, &DiscountAppliedEvent{
 		ProductID:  p.id,
 		DiscountID: discount.ID,
@@ -96,11 +234,94 @@ func (p *Product) ApplyDiscount(discount *Discount, now time.Time) error {
 	return nil
 }
 
+// ChangeBasePrice sets a new base price directly
+// Changes that need a second approver go through NewPendingPriceChange and ApplyPendingChange instead
+func (p *Product) ChangeBasePrice(basePrice *Money, changedBy string, now time.Time) error {
+	return p.changeBasePrice(basePrice, changedBy, "", "", now)
+}
+
+// ApplyPendingChange applies an approved pending change to the product
+// The change is refused if the price moved since it was requested, so an approval never overwrites a newer price
+func (p *Product) ApplyPendingChange(change *PendingChange, now time.Time) error {
+	if change.status != PendingChangeStatusApproved || change.productID != p.id {
+		return ErrChangeNotPending
+	}
+	if !samePrice(p.basePrice, change.oldBasePrice) {
+		return ErrChangeStale
+	}
+	return p.changeBasePrice(change.newBasePrice, change.requestedBy, change.decidedBy, change.id, now)
+}
+
+func (p *Product) changeBasePrice(basePrice *Money, changedBy, approvedBy, changeID string, now time.Time) error {
+	if p.archivedAt != nil {
+		return ErrProductAlreadyArchived
+	}
+	if !validPrice(basePrice) {
+		return ErrInvalidPrice
+	}
+	if samePrice(p.basePrice, basePrice) {
+		return nil // No change
+	}
+	if err := p.priceFloor.check(basePrice, p.discount, now); err != nil {
+		return err
+	}
+
+	oldPrice := p.basePrice
+	p.changes.Record(FieldBasePrice, oldPrice, basePrice)
+	p.basePrice = basePrice
+	p.touch(now)
+	p.events = append(p.events, &BasePriceChangedEvent{
+		ProductID:  p.id,
+		OldPrice:   oldPrice,
+		NewPrice:   basePrice,
+		ChangedBy:  strings.TrimSpace(changedBy),
+		ApprovedBy: approvedBy,
+		ChangeID:   changeID,
+		ChangedAt:  now,
+	})
+	return nil
+}
+
+// SetPriceFloor replaces the product's price floor; a zero floor removes it
+// The current price, including a discount that has not ended, must already respect the new floor
+func (p *Product) SetPriceFloor(floor PriceFloor, now time.Time) error {
+	if p.archivedAt != nil {
+		return ErrProductAlreadyArchived
+	}
+	if err := floor.Validate(); err != nil {
+		return err
+	}
+	if err := floor.check(p.basePrice, p.discount, now); err != nil {
+		return err
+	}
+	if samePrice(p.priceFloor.MinPrice, floor.MinPrice) && samePrice(p.priceFloor.Cost, floor.Cost) &&
+		p.priceFloor.MinMarginPercent == floor.MinMarginPercent && samePrice(p.priceFloor.MapPrice, floor.MapPrice) {
+		return nil // No change
+	}
+
+	p.changes.Record(FieldPriceFloor, p.priceFloor, floor)
+	p.priceFloor = floor
+	p.touch(now)
+	p.events = append(p.events, &PriceFloorChangedEvent{
+		ProductID:        p.id,
+		MinPrice:         floor.MinPrice,
+		Cost:             floor.Cost,
+		MinMarginPercent: floor.MinMarginPercent,
+		MapPrice:         floor.MapPrice,
+		ChangedAt:        now,
+	})
+	return nil
+}
+
 // Getters (encapsulation)
 func (p *Product) ID() string {
 	return p.id
 }
 
+func (p *Product) TenantID() string {
+	return p.tenantID
+}
+
 func (p *Product) Name() string {
 	return p.name
 }
@@ -109,10 +330,29 @@ func (p *Product) Description() string {
 	return p.description
 }
 
+// DescriptionFormat returns whether the description is plain text or markdown
+func (p *Product) DescriptionFormat() DescriptionFormat {
+	return p.descriptionFormat
+}
+
 func (p *Product) Category() string {
 	return p.category
 }
 
+// SKU returns the merchant stock keeping unit ("" when not set)
+func (p *Product) SKU() string {
+	return p.sku
+}
+
+// GTIN returns the global trade item number ("" when not set)
+func (p *Product) GTIN() string {
+	return p.gtin
+}
+
+func (p *Product) PriceFloor() PriceFloor {
+	return p.priceFloor
+}
+
 func (p *Product) BasePrice() *Money {
 	return p.basePrice
 }
@@ -125,6 +365,82 @@ func (p *Product) Status() ProductStatus {
 	return p.status
 }
 
+// LegalHold reports whether the product is exempt from retention purges
+func (p *Product) LegalHold() bool {
+	return p.legalHold
+}
+
+// Channels returns the sales channels the product is visible on, sorted
+func (p *Product) Channels() []Channel {
+	return append([]Channel(nil), p.channels...)
+}
+
+// Type returns whether the product is physical, digital or a service
+func (p *Product) Type() ProductType {
+	return p.productType
+}
+
+// Shipping returns the product's weight, dimensions and shipping class
+func (p *Product) Shipping() ShippingDetails {
+	return p.shipping
+}
+
+// DigitalDelivery returns the download URL and license terms of a digital product
+func (p *Product) DigitalDelivery() DigitalDelivery {
+	return p.digital
+}
+
+// Compliance returns the product's age restriction and regulatory flags
+func (p *Product) Compliance() Compliance {
+	return p.compliance
+}
+
+// Metadata returns a copy of the integrator metadata
+func (p *Product) Metadata() Metadata {
+	return p.metadata.Clone()
+}
+
+// Attributes returns a copy of the product's attributes
+func (p *Product) Attributes() Attributes {
+	return p.attributes.Clone()
+}
+
+// VisibleOn reports whether the product is visible on the channel
+func (p *Product) VisibleOn(channel Channel) bool {
+	for _, c := range p.channels {
+		if c == channel {
+			return true
+		}
+	}
+	return false
+}
+
+// NameKey returns the normalized name that must be unique within the category,
+// or "" when uniqueness is not enforced for the product
+func (p *Product) NameKey() string {
+	if !p.uniqueName || p.archivedAt != nil {
+		return ""
+	}
+	return NormalizeName(p.name)
+}
+
+// EnforceUniqueName sets whether the product's name must be unique within its category
+// The key is rewritten when it differs from the stored one, after a rename or a change of the
+// tenant's setting, so products pick up the setting on their next write
+func (p *Product) EnforceUniqueName(enforce bool) {
+	p.uniqueName = enforce
+	if p.NameKey() != p.storedNameKey {
+		p.changes.MarkDirty(FieldNameKey)
+	}
+}
+
+// RestoreNameKey records the name key a reconstructed product was stored with
+// Repositories call it after ReconstructProduct; it marks nothing dirty
+func (p *Product) RestoreNameKey(nameKey string) {
+	p.storedNameKey = nameKey
+}
+
+// Changes returns the product's change tracker; use Diff for a copy that outlives further changes
 func (p *Product) Changes() *ChangeTracker {
 	return &p.changes
 }
@@ -149,92 +465,219 @@ func (p *Product) ArchivedAt() *time.Time {
 // This is used by the repository layer to reconstruct domain objects from the database
 func ReconstructProduct(
 	id string,
+	tenantID string,
 	name string,
 	description string,
+	descriptionFormat DescriptionFormat,
 	category string,
+	sku string,
+	gtin string,
 	basePrice *Money,
 	discount *Discount,
 	status ProductStatus,
+	legalHold bool,
+	channels []Channel,
+	productType ProductType,
+	shipping ShippingDetails,
+	digital DigitalDelivery,
+	compliance Compliance,
+	metadata Metadata,
+	attributes Attributes,
+	priceFloor PriceFloor,
 	archivedAt *time.Time,
 	createdAt time.Time,
 	updatedAt time.Time,
 ) *Product {
 	return &Product{
-		id:          id,
-		name:        name,
-		description: description,
-		category:    category,
-		basePrice:   basePrice,
-		discount:    discount,
-		status:      status,
-		changes:     ChangeTracker{dirtyFields: make(map[string]bool)},
-		events:      []DomainEvent{},
-		archivedAt:  archivedAt,
-		createdAt:   createdAt,
-		updatedAt:   updatedAt,
+		id:                id,
+		tenantID:          tenantID,
+		name:              name,
+		description:       description,
+		descriptionFormat: descriptionFormat,
+		category:          category,
+		sku:               sku,
+		gtin:              gtin,
+		basePrice:         basePrice,
+		discount:          discount,
+		status:            status,
+		legalHold:         legalHold,
+		channels:          channels,
+		productType:       productType,
+		shipping:          shipping,
+		digital:           digital,
+		compliance:        compliance,
+		metadata:          metadata,
+		attributes:        attributes,
+		priceFloor:        priceFloor,
+		changes:           ChangeTracker{},
+		events:            []DomainEvent{},
+		archivedAt:        archivedAt,
+		createdAt:         createdAt,
+		updatedAt:         updatedAt,
 	}
 }
 
 // UpdateDetails updates the product's name, description, and category
+// The description must suit the product's current description format
 func (p *Product) UpdateDetails(name, description, category string, now time.Time) error {
 	if p.archivedAt != nil {
 		return ErrProductAlreadyArchived
 	}
 
 	// Validate inputs
-	name = strings.TrimSpace(name)
-	if name == "" {
-		return ErrInvalidProductName
-	}
-	if len(name) > 255 {
-		return ErrInvalidProductName
-	}
-
-	description = strings.TrimSpace(description)
-	if description == "" {
-		return ErrInvalidProductDescription
-	}
-	if len(description) > 1000 {
-		return ErrInvalidProductDescription
-	}
-
-	category = strings.TrimSpace(category)
-	if category == "" {
-		return ErrInvalidProductCategory
+	name, description, category, err := validateDetails(name, description, category)
+	if err != nil {
+		return err
 	}
-	if len(category) > 100 {
-		return ErrInvalidProductCategory
+	if err := ValidateDescriptionFormat(p.descriptionFormat, description); err != nil {
+		return err
 	}
 
 	changedFields := []string{}
+	previous := map[string]string{}
 	if name != p.name {
+		p.changes.Record(FieldName, p.name, name)
+		previous[FieldName] = p.name
 		p.name = name
-		p.changes.MarkDirty(FieldName)
 		changedFields = append(changedFields, FieldName)
 	}
 	if description != p.description {
+		p.changes.Record(FieldDescription, p.description, description)
+		previous[FieldDescription] = p.description
 		p.description = description
-		p.changes.MarkDirty(FieldDescription)
 		changedFields = append(changedFields, FieldDescription)
 	}
 	if category != p.category {
+		p.changes.Record(FieldCategory, p.category, category)
+		previous[FieldCategory] = p.category
 		p.category = category
-		p.changes.MarkDirty(FieldCategory)
 		changedFields = append(changedFields, FieldCategory)
 	}
 
 	// Emit domain event if there were changes
 	if len(changedFields) > 0 {
+		p.touch(now)
 		p.events = append(p.events, &ProductUpdatedEvent{
 			ProductID:     p.id,
 			UpdatedAt:     now,
 			ChangedFields: changedFields,
+			Previous:      previous,
 		})
 	}
 
 	return nil
 }
 
+// SetDescriptionFormat changes whether the description is plain text or markdown
+// Switching to markdown requires the current description to be well-formed markdown
+func (p *Product) SetDescriptionFormat(format DescriptionFormat, now time.Time) error {
+	if p.archivedAt != nil {
+		return ErrProductAlreadyArchived
+	}
+	if err := ValidateDescriptionFormat(format, p.description); err != nil {
+		return err
+	}
+	if format == p.descriptionFormat {
+		return nil // No change
+	}
+
+	previous := p.descriptionFormat
+	p.changes.Record(FieldDescriptionFormat, previous, format)
+	p.descriptionFormat = format
+	p.touch(now)
+	p.events = append(p.events, &ProductUpdatedEvent{
+		ProductID:     p.id,
+		UpdatedAt:     now,
+		ChangedFields: []string{FieldDescriptionFormat},
+		Previous:      map[string]string{FieldDescriptionFormat: string(previous)},
+	})
+	return nil
+}
+
+// UpdateFulfilment replaces the product's type, shipping details and digital delivery
+// They change together so a product never holds fields its type does not allow
+func (p *Product) UpdateFulfilment(productType ProductType, shipping ShippingDetails, digital DigitalDelivery, now time.Time) error {
+	if p.archivedAt != nil {
+		return ErrProductAlreadyArchived
+	}
+	if err := validateFulfilment(productType, shipping, digital); err != nil {
+		return err
+	}
+
+	var changedFields []string
+	if productType != p.productType {
+		changedFields = append(changedFields, FieldProductType)
+	}
+	changedFields = append(changedFields, shipping.changedFields(p.shipping)...)
+	changedFields = append(changedFields, digital.changedFields(p.digital)...)
+	if len(changedFields) == 0 {
+		return nil // No change
+	}
+
+	p.productType = productType
+	p.shipping = shipping
+	p.digital = digital
+	for _, field := range changedFields {
+		p.changes.MarkDirty(field)
+	}
+	p.touch(now)
+	p.events = append(p.events, &ProductUpdatedEvent{
+		ProductID:     p.id,
+		UpdatedAt:     now,
+		ChangedFields: changedFields,
+	})
+
+	return nil
+}
+
+// SetCompliance replaces the product's age restriction and regulatory flags
+func (p *Product) SetCompliance(compliance Compliance, now time.Time) error {
+	if p.archivedAt != nil {
+		return ErrProductAlreadyArchived
+	}
+	if err := compliance.Validate(); err != nil {
+		return err
+	}
+
+	changedFields := compliance.changedFields(p.compliance)
+	if len(changedFields) == 0 {
+		return nil // No change
+	}
+
+	p.compliance = compliance
+	for _, field := range changedFields {
+		p.changes.MarkDirty(field)
+	}
+	p.touch(now)
+	p.events = append(p.events, &ProductUpdatedEvent{
+		ProductID:     p.id,
+		UpdatedAt:     now,
+		ChangedFields: changedFields,
+	})
+
+	return nil
+}
+
+// validateDetails trims the product's text fields and checks they are present and within length limits
+func validateDetails(name, description, category string) (string, string, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > 255 {
+		return "", "", "", ErrInvalidProductName
+	}
+
+	description = strings.TrimSpace(description)
+	if description == "" || len(description) > 1000 {
+		return "", "", "", ErrInvalidProductDescription
+	}
+
+	category = strings.TrimSpace(category)
+	if category == "" || len(category) > 100 {
+		return "", "", "", ErrInvalidProductCategory
+	}
+
+	return name, description, category, nil
+}
+
 // Activate activates the product
 func (p *Product) Activate(now time.Time) error {
 	if p.archivedAt != nil {
@@ -245,8 +688,9 @@ func (p *Product) Activate(now time.Time) error {
 		return nil // Already active
 	}
 
+	p.changes.Record(FieldStatus, p.status, ProductStatusActive)
 	p.status = ProductStatusActive
-	p.changes.MarkDirty(FieldStatus)
+	p.touch(now)
 	p.events = append(p.events, &ProductActivatedEvent{
 		ProductID:   p.id,
 		ActivatedAt: now,
@@ -272,8 +716,9 @@ func (p *Product) Deactivate(now time.Time) error {
 		return nil // Already inactive
 	}
 
+	p.changes.Record(FieldStatus, p.status, ProductStatusInactive)
 	p.status = ProductStatusInactive
-	p.changes.MarkDirty(FieldStatus)
+	p.touch(now)
 	p.events = append(p.events, &ProductDeactivatedEvent{
 		ProductID:     p.id,
 		DeactivatedAt: now,
@@ -288,8 +733,11 @@ func (p *Product) Archive(now time.Time) error {
 		return ErrProductAlreadyArchived
 	}
 
+	p.changes.Record(FieldArchivedAt, p.archivedAt, &now)
 	p.archivedAt = &now
-	p.changes.MarkDirty(FieldArchivedAt)
+	// Archived products release their name
+	p.changes.MarkDirty(FieldNameKey)
+	p.touch(now)
 	p.events = append(p.events, &ProductArchivedEvent{
 		ProductID:  p.id,
 		ArchivedAt: now,
@@ -298,36 +746,241 @@ func (p *Product) Archive(now time.Time) error {
 	return nil
 }
 
-// RemoveDiscount removes the discount from the product
-func (p *Product) RemoveDiscount(now time.Time) error {
-	if p.discount == nil {
-		return nil // No discount to remove
+// MergeInto archives the product as a duplicate of canonical
+// The caller records this product's ID as an alias of canonical
+func (p *Product) MergeInto(canonical *Product, now time.Time) error {
+	if canonical.id == p.id {
+		return ErrMergeIntoSelf
+	}
+	if canonical.archivedAt != nil {
+		return ErrMergeTargetArchived
+	}
+	if err := p.Archive(now); err != nil {
+		return err
 	}
 
-	p.discount = nil
-	p.changes.MarkDirty(FieldDiscount)
-	p.events = append(p.events, &DiscountRemovedEvent{
+	p.events = append(p.events, &ProductMergedEvent{
+		ProductID:   p.id,
+		CanonicalID: canonical.id,
+		MergedAt:    now,
+	})
+	return nil
+}
+
+// SetLegalHold places or releases a legal hold; held products are never purged
+// Holds can be changed on archived products, which is where they matter most
+func (p *Product) SetLegalHold(hold bool, now time.Time) error {
+	if p.legalHold == hold {
+		return nil // No change
+	}
+
+	p.changes.Record(FieldLegalHold, p.legalHold, hold)
+	p.legalHold = hold
+	p.touch(now)
+	p.events = append(p.events, &LegalHoldChangedEvent{
 		ProductID: p.id,
-		RemovedAt: now,
+		LegalHold: hold,
+		ChangedAt: now,
+	})
+
+	return nil
+}
+
+// SetChannels replaces the sales channels the product is visible on
+// An empty set hides the product from every channel
+func (p *Product) SetChannels(channels []Channel, now time.Time) error {
+	if p.archivedAt != nil {
+		return ErrProductAlreadyArchived
+	}
+
+	channels, err := NormalizeChannels(channels)
+	if err != nil {
+		return err
+	}
+	if sameChannels(p.channels, channels) {
+		return nil // No change
+	}
+
+	p.changes.Record(FieldChannels, p.channels, channels)
+	p.channels = channels
+	p.touch(now)
+	p.events = append(p.events, &ChannelsChangedEvent{
+		ProductID: p.id,
+		Channels:  ChannelStrings(channels),
+		ChangedAt: now,
+	})
+
+	return nil
+}
+
+// SetMetadata replaces the product's integrator metadata; an empty map clears it
+func (p *Product) SetMetadata(metadata Metadata, now time.Time) error {
+	if p.archivedAt != nil {
+		return ErrProductAlreadyArchived
+	}
+	if err := metadata.Validate(); err != nil {
+		return err
+	}
+	if maps.Equal(p.metadata, metadata) {
+		return nil // No change
+	}
+
+	metadata = metadata.Clone()
+	p.changes.Record(FieldMetadata, p.metadata, metadata)
+	p.metadata = metadata
+	p.touch(now)
+	p.events = append(p.events, &MetadataChangedEvent{
+		ProductID: p.id,
+		Metadata:  p.metadata.Clone(),
+		ChangedAt: now,
 	})
 
 	return nil
 }
 
-type ChangeTracker struct {
-	dirtyFields map[string]bool
+// SetAttributes replaces the product's attributes
+// When the product's category has a template, every violation of it is returned in a
+// ValidationFailedError; without a template any well-formed attributes are accepted
+func (p *Product) SetAttributes(attributes Attributes, template *CategoryTemplate, now time.Time) error {
+	if p.archivedAt != nil {
+		return ErrProductAlreadyArchived
+	}
+	if err := attributes.Validate(); err != nil {
+		return err
+	}
+	if template != nil {
+		if violations := template.Check(attributes); len(violations) > 0 {
+			return &ValidationFailedError{Violations: violations}
+		}
+	}
+	if maps.Equal(p.attributes, attributes) {
+		return nil // No change
+	}
+
+	attributes = attributes.Clone()
+	p.changes.Record(FieldAttributes, p.attributes, attributes)
+	p.attributes = attributes
+	p.touch(now)
+	p.events = append(p.events, &AttributesChangedEvent{
+		ProductID:  p.id,
+		Attributes: p.attributes.Clone(),
+		ChangedAt:  now,
+	})
+
+	return nil
 }
 
-func (ct *ChangeTracker) MarkDirty(field string) {
-	if ct.dirtyFields == nil {
-		ct.dirtyFields = make(map[string]bool)
+// LinkExternalRef records that an external system knows the product by ref
+// The caller stores the reference and makes sure no other product of the tenant holds it
+func (p *Product) LinkExternalRef(ref ExternalRef, now time.Time) error {
+	if p.archivedAt != nil {
+		return ErrProductAlreadyArchived
 	}
-	ct.dirtyFields[field] = true
+	if err := ref.Validate(); err != nil {
+		return err
+	}
+
+	p.events = append(p.events, &ExternalRefLinkedEvent{
+		ProductID:  p.id,
+		System:     ref.System,
+		ExternalID: ref.ExternalID,
+		LinkedAt:   now,
+	})
+	return nil
 }
 
-func (ct *ChangeTracker) Dirty(field string) bool {
-	if ct.dirtyFields == nil {
+// UnlinkExternalRef records that ref no longer identifies the product
+// Archived products may be unlinked so their references can be reused
+func (p *Product) UnlinkExternalRef(ref ExternalRef, now time.Time) {
+	p.events = append(p.events, &ExternalRefUnlinkedEvent{
+		ProductID:  p.id,
+		System:     ref.System,
+		ExternalID: ref.ExternalID,
+		UnlinkedAt: now,
+	})
+}
+
+// sameChannels compares two normalized channel sets
+func sameChannels(a, b []Channel) bool {
+	if len(a) != len(b) {
 		return false
 	}
-	return ct.dirtyFields[field]
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// ReviewDecision is a reviewer's verdict on a product
+type ReviewDecision string
+
+const (
+	ReviewApproved ReviewDecision = "approved"
+	ReviewRejected ReviewDecision = "rejected"
+)
+
+// Review records a reviewer's decision; rejections must explain themselves in the comment
+// Reviews change no product state, the emitted event is the audit record
+func (p *Product) Review(decision ReviewDecision, reviewer, comment string, now time.Time) error {
+	if p.archivedAt != nil {
+		return ErrProductAlreadyArchived
+	}
+
+	reviewer = strings.TrimSpace(reviewer)
+	if reviewer == "" || len(reviewer) > 255 {
+		return ErrInvalidReviewer
+	}
+	comment = strings.TrimSpace(comment)
+	if len(comment) > 2000 {
+		return ErrInvalidReviewComment
+	}
+
+	switch decision {
+	case ReviewApproved:
+		p.events = append(p.events, &ProductApprovedEvent{
+			ProductID:  p.id,
+			Reviewer:   reviewer,
+			Comment:    comment,
+			ReviewedAt: now,
+		})
+	case ReviewRejected:
+		if comment == "" {
+			return ErrInvalidReviewComment
+		}
+		p.events = append(p.events, &ProductRejectedEvent{
+			ProductID:  p.id,
+			Reviewer:   reviewer,
+			Comment:    comment,
+			ReviewedAt: now,
+		})
+	default:
+		return ErrInvalidReviewDecision
+	}
+
+	return nil
+}
+
+// RemoveDiscount removes the discount from the product
+func (p *Product) RemoveDiscount(now time.Time) error {
+	if p.discount == nil {
+		return nil // No discount to remove
+	}
+
+	p.changes.Record(FieldDiscount, p.discount, (*Discount)(nil))
+	p.discount = nil
+	p.touch(now)
+	p.events = append(p.events, &DiscountRemovedEvent{
+		ProductID: p.id,
+		RemovedAt: now,
+	})
+
+	return nil
+}
+
+// touch records a state change at now; reviews and name key changes do not count
+func (p *Product) touch(now time.Time) {
+	p.changes.Record(FieldUpdatedAt, p.updatedAt, now)
+	p.updatedAt = now
 }
@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+var testNow = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+// newTestProduct returns a physical product priced at 100 with no pending events or changes
+func newTestProduct(t *testing.T) *Product {
+	t.Helper()
+	price := NewMoney(100)
+	p, err := NewProduct("product-1", "tenant-1", "Desk Lamp", "A lamp", "lighting", "", "",
+		DescriptionFormatPlain, &price, ProductTypePhysical, ShippingDetails{}, DigitalDelivery{}, Compliance{}, testNow)
+	if err != nil {
+		t.Fatalf("NewProduct: %v", err)
+	}
+	p.events = nil
+	p.changes = ChangeTracker{}
+	return p
+}
+
+func TestSetPriceFloorMapPriceOnly(t *testing.T) {
+	p := newTestProduct(t)
+	minPrice := NewMoney(50)
+	if err := p.SetPriceFloor(PriceFloor{MinPrice: &minPrice}, testNow); err != nil {
+		t.Fatalf("SetPriceFloor: %v", err)
+	}
+	p.events = nil
+	p.changes = ChangeTracker{}
+
+	mapPrice := NewMoney(90)
+	floor := PriceFloor{MinPrice: &minPrice, MapPrice: &mapPrice}
+	if err := p.SetPriceFloor(floor, testNow); err != nil {
+		t.Fatalf("SetPriceFloor with MAP price: %v", err)
+	}
+	if !samePrice(p.PriceFloor().MapPrice, &mapPrice) {
+		t.Errorf("MapPrice = %v, want 90", p.PriceFloor().MapPrice)
+	}
+	if !p.Changes().Dirty(FieldPriceFloor) {
+		t.Error("price floor not marked dirty after a MAP price change")
+	}
+	if len(p.DomainEvents()) != 1 {
+		t.Fatalf("got %d events, want 1 PriceFloorChangedEvent", len(p.DomainEvents()))
+	}
+	if _, ok := p.DomainEvents()[0].(*PriceFloorChangedEvent); !ok {
+		t.Errorf("event is %T, want *PriceFloorChangedEvent", p.DomainEvents()[0])
+	}
+}
+
+func TestSetPriceFloorUnchanged(t *testing.T) {
+	p := newTestProduct(t)
+	minPrice, mapPrice := NewMoney(50), NewMoney(90)
+	if err := p.SetPriceFloor(PriceFloor{MinPrice: &minPrice, MapPrice: &mapPrice}, testNow); err != nil {
+		t.Fatalf("SetPriceFloor: %v", err)
+	}
+	p.events = nil
+	p.changes = ChangeTracker{}
+
+	sameMin, sameMap := NewMoney(50), NewMoney(90)
+	if err := p.SetPriceFloor(PriceFloor{MinPrice: &sameMin, MapPrice: &sameMap}, testNow); err != nil {
+		t.Fatalf("SetPriceFloor again: %v", err)
+	}
+	if len(p.DomainEvents()) != 0 || p.Changes().Dirty(FieldPriceFloor) {
+		t.Error("setting an identical floor recorded a change")
+	}
+}
+
+func TestEnforceUniqueNameRewritesKeyOnlyWhenItChanges(t *testing.T) {
+	p := newTestProduct(t)
+	p.RestoreNameKey(NormalizeName("Desk Lamp"))
+
+	p.EnforceUniqueName(true)
+	if p.Changes().Dirty(FieldNameKey) {
+		t.Error("name key marked dirty although the stored key is unchanged")
+	}
+
+	if err := p.SetCompliance(Compliance{Hazardous: true}, testNow); err != nil {
+		t.Fatalf("SetCompliance: %v", err)
+	}
+	p.EnforceUniqueName(true)
+	if p.Changes().Dirty(FieldNameKey) {
+		t.Error("name key marked dirty by a compliance-only update")
+	}
+
+	p.EnforceUniqueName(false)
+	if !p.Changes().Dirty(FieldNameKey) {
+		t.Error("name key not marked dirty after uniqueness was turned off")
+	}
+
+	p.changes = ChangeTracker{}
+	if err := p.UpdateDetails("Floor Lamp", "A lamp", "lighting", testNow); err != nil {
+		t.Fatalf("UpdateDetails: %v", err)
+	}
+	p.EnforceUniqueName(true)
+	if !p.Changes().Dirty(FieldNameKey) {
+		t.Error("name key not marked dirty after a rename")
+	}
+}
@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"net/url"
+	"strings"
+)
+
+// ProductType decides which fulfilment fields a product takes
+type ProductType string
+
+const (
+	// ProductTypePhysical products ship; they take shipping details (the default)
+	ProductTypePhysical ProductType = "physical"
+	// ProductTypeDigital products are delivered by download or license; they need one of the two
+	ProductTypeDigital ProductType = "digital"
+	// ProductTypeService products are neither shipped nor downloaded
+	ProductTypeService ProductType = "service"
+)
+
+// Valid reports whether t is a known product type
+func (t ProductType) Valid() bool {
+	switch t {
+	case ProductTypePhysical, ProductTypeDigital, ProductTypeService:
+		return true
+	default:
+		return false
+	}
+}
+
+// DigitalDelivery is how a digital product reaches the buyer; every field is optional
+type DigitalDelivery struct {
+	DownloadURL  string
+	LicenseTerms string
+}
+
+// IsZero reports whether neither a download URL nor license terms are set
+func (d DigitalDelivery) IsZero() bool {
+	return d.DownloadURL == "" && d.LicenseTerms == ""
+}
+
+// Validate checks the download URL is an absolute http(s) URL and the terms fit the limit
+func (d DigitalDelivery) Validate() error {
+	if d.DownloadURL != "" {
+		u, err := url.Parse(d.DownloadURL)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" || len(d.DownloadURL) > 2048 {
+			return ErrInvalidDownloadURL
+		}
+	}
+	if len(d.LicenseTerms) > 10000 || (d.LicenseTerms != "" && strings.TrimSpace(d.LicenseTerms) == "") {
+		return ErrInvalidLicenseTerms
+	}
+	return nil
+}
+
+// changedFields lists the delivery fields that differ from other
+func (d DigitalDelivery) changedFields(other DigitalDelivery) []string {
+	var fields []string
+	if d.DownloadURL != other.DownloadURL {
+		fields = append(fields, FieldDownloadURL)
+	}
+	if d.LicenseTerms != other.LicenseTerms {
+		fields = append(fields, FieldLicenseTerms)
+	}
+	return fields
+}
+
+// validateFulfilment checks the fields each product type requires or rejects
+// Only physical products take shipping details, and only digital products take
+// delivery details, of which they need at least one
+func validateFulfilment(productType ProductType, shipping ShippingDetails, digital DigitalDelivery) error {
+	if !productType.Valid() {
+		return ErrInvalidProductType
+	}
+	if err := shipping.Validate(); err != nil {
+		return err
+	}
+	if err := digital.Validate(); err != nil {
+		return err
+	}
+
+	hasShipping := shipping.Weight != nil || shipping.Dimensions != nil || shipping.ShippingClass != ""
+	if hasShipping && productType != ProductTypePhysical {
+		return ErrShippingNotApplicable
+	}
+	if productType == ProductTypeDigital {
+		if digital.IsZero() {
+			return ErrDigitalDeliveryRequired
+		}
+	} else if !digital.IsZero() {
+		return ErrDigitalDeliveryNotApplicable
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package services
+
+import (
+	"math/big"
+
+	"catalog-proj/internal/app/product/domain"
+)
+
+// PriceApprovalPolicy decides which base price changes need a second approver (four-eyes)
+type PriceApprovalPolicy struct {
+	threshold *big.Rat // fraction of the current price; nil disables the policy
+}
+
+// NewPriceApprovalPolicy creates a policy holding back changes of more than thresholdPercent of the current price
+// A zero threshold disables the policy
+func NewPriceApprovalPolicy(thresholdPercent float64) *PriceApprovalPolicy {
+	if thresholdPercent <= 0 {
+		return &PriceApprovalPolicy{}
+	}
+	threshold := new(big.Rat).SetFloat64(thresholdPercent)
+	return &PriceApprovalPolicy{threshold: threshold.Quo(threshold, big.NewRat(100, 1))}
+}
+
+// RequiresApproval reports whether moving from current to proposed exceeds the threshold, in either direction
+func (p *PriceApprovalPolicy) RequiresApproval(current, proposed *domain.Money) bool {
+	if p.threshold == nil || current == nil || *current == nil || proposed == nil || *proposed == nil {
+		return false
+	}
+	cur := (*big.Rat)(*current)
+	if cur.Sign() <= 0 {
+		return false
+	}
+	change := new(big.Rat).Sub((*big.Rat)(*proposed), cur)
+	change.Abs(change).Quo(change, cur)
+	return change.Cmp(p.threshold) > 0
+}
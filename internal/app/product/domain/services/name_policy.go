@@ -0,0 +1,27 @@
+package services
+
+// AllTenants enables a per-tenant policy for every tenant
+const AllTenants = "*"
+
+// UniqueNamePolicy decides which tenants require product names to be unique within a category
+type UniqueNamePolicy struct {
+	all     bool
+	tenants map[string]bool
+}
+
+// NewUniqueNamePolicy creates a policy enforcing unique names for the given tenants (AllTenants for every tenant)
+func NewUniqueNamePolicy(tenants []string) *UniqueNamePolicy {
+	p := &UniqueNamePolicy{tenants: make(map[string]bool, len(tenants))}
+	for _, id := range tenants {
+		if id == AllTenants {
+			p.all = true
+		}
+		p.tenants[id] = true
+	}
+	return p
+}
+
+// Enforced reports whether the tenant's product names must be unique within a category
+func (p *UniqueNamePolicy) Enforced(tenantID string) bool {
+	return p.all || p.tenants[tenantID]
+}
@@ -2,13 +2,53 @@ package services
 
 import (
 	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/pkg/lru"
+	"catalog-proj/internal/pkg/metrics"
+	"math/big"
 	"time"
 )
 
-type PricingCalculator struct{}
+type PricingCalculator struct {
+	enforceMAP bool
+	// cache holds display prices by product version and minute; nil disables caching
+	cache *lru.Cache[priceKey, cachedPrice]
+}
+
+// priceKey identifies a product version priced within one minute
+// Every change to a product's prices or discount bumps its updated_at, which is the version here,
+// so a write invalidates its entries without being told; they age out of the LRU
+type priceKey struct {
+	product string // tenant_id/product_id, built afresh so the cache holds no reference to the product
+	version int64  // updated_at in Unix nanoseconds
+	minute  int64  // Unix minute the price was asked for
+}
+
+type cachedPrice struct {
+	price      *big.Rat // nil when the product has no price
+	mapApplied bool
+}
 
-func NewPricingCalculator() *PricingCalculator {
-	return &PricingCalculator{}
+// NewPricingCalculator creates a calculator; with enforceMAP, displayed prices never go below
+// a product's minimum advertised price
+func NewPricingCalculator(enforceMAP bool) *PricingCalculator {
+	return &PricingCalculator{enforceMAP: enforceMAP}
+}
+
+// NewCachingPricingCalculator creates a calculator that caches up to maxEntries display prices, keyed
+// by product version and minute, so the same rows listed and fetched again are not repriced
+// A price may therefore trail a discount starting or ending by up to a minute. maxEntries 0 disables the cache
+// Hits and misses are counted in pricing_cache_hits and pricing_cache_misses
+func NewCachingPricingCalculator(enforceMAP bool, maxEntries int) *PricingCalculator {
+	pc := &PricingCalculator{enforceMAP: enforceMAP}
+	if maxEntries > 0 {
+		pc.cache = lru.New[priceKey, cachedPrice](maxEntries)
+	}
+	return pc
+}
+
+// EnforcesMAP reports whether displayed prices are raised to the minimum advertised price
+func (pc *PricingCalculator) EnforcesMAP() bool {
+	return pc.enforceMAP
 }
 
 // CalculateEffectivePrice calculates the effective price of a product
@@ -49,3 +89,79 @@ func (pc *PricingCalculator) CalculateEffectivePrice(product *domain.Product, no
 	// Return as *domain.Money
 	return &effectivePrice
 }
+
+// EffectivePriceUntil returns the effective price at now, as CalculateEffectivePrice does, and when
+// it next changes: the discount's start while it is still to come, its end while it runs, and nil
+// when it never will
+func (pc *PricingCalculator) EffectivePriceUntil(product *domain.Product, now time.Time) (price *domain.Money, validUntil *time.Time) {
+	price = pc.CalculateEffectivePrice(product, now)
+	discount := product.Discount()
+	if price == nil || discount == nil || discount.Amount == nil {
+		return price, nil
+	}
+	switch {
+	case now.Before(discount.StartDate):
+		return price, &discount.StartDate
+	case now.Before(discount.EndDate):
+		return price, &discount.EndDate
+	default:
+		return price, nil
+	}
+}
+
+// CalculateDisplayPrice calculates the price to advertise for a product
+// In MAP mode an effective price below the product's minimum advertised price is shown as the MAP instead,
+// and mapApplied reports the substitution; the discount itself is left as recorded, so the lower price
+// is still what the product sells for
+func (pc *PricingCalculator) CalculateDisplayPrice(product *domain.Product, now time.Time) (price *domain.Money, mapApplied bool) {
+	// Products never saved have no version to key on
+	if pc.cache == nil || product.UpdatedAt().IsZero() {
+		return pc.calculateDisplayPrice(product, now)
+	}
+	return pc.cachedDisplayPrice(product, now)
+}
+
+// cachedDisplayPrice serves CalculateDisplayPrice from the cache, pricing and caching the product on a miss
+func (pc *PricingCalculator) cachedDisplayPrice(product *domain.Product, now time.Time) (price *domain.Money, mapApplied bool) {
+	key := priceKey{
+		product: product.TenantID() + "/" + product.ID(),
+		version: product.UpdatedAt().UnixNano(),
+		minute:  now.Unix() / 60,
+	}
+	if cached, ok := pc.cache.Get(key); ok {
+		metrics.Counter("pricing_cache_hits").Add(1)
+		return copyPrice(cached.price), cached.mapApplied
+	}
+	metrics.Counter("pricing_cache_misses").Add(1)
+
+	price, mapApplied = pc.calculateDisplayPrice(product, now)
+	cached := cachedPrice{mapApplied: mapApplied}
+	if price != nil {
+		cached.price = new(big.Rat).Set(*price)
+	}
+	pc.cache.Add(key, cached)
+	return price, mapApplied
+}
+
+// copyPrice returns a copy of a cached price, so callers cannot change the cached value
+func copyPrice(price *big.Rat) *domain.Money {
+	if price == nil {
+		return nil
+	}
+	money := domain.Money(new(big.Rat).Set(price))
+	return &money
+}
+
+// calculateDisplayPrice is CalculateDisplayPrice without the cache
+func (pc *PricingCalculator) calculateDisplayPrice(product *domain.Product, now time.Time) (price *domain.Money, mapApplied bool) {
+	effectivePrice := pc.CalculateEffectivePrice(product, now)
+	mapPrice := product.PriceFloor().MapPrice
+	if !pc.enforceMAP || effectivePrice == nil || mapPrice == nil {
+		return effectivePrice, false
+	}
+
+	if domain.Compare(*effectivePrice, *mapPrice) >= 0 {
+		return effectivePrice, false
+	}
+	return mapPrice, true
+}
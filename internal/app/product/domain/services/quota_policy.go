@@ -0,0 +1,76 @@
+package services
+
+import (
+	"fmt"
+
+	"catalog-proj/internal/app/product/domain"
+)
+
+// Quota names reported in QuotaExceededError
+const (
+	QuotaProductsPerTenant        = "products_per_tenant"
+	QuotaProductsPerCategory      = "products_per_category"
+	QuotaActiveDiscountsPerTenant = "active_discounts_per_tenant"
+)
+
+// QuotaLimits holds catalog size limits; zero means unlimited
+type QuotaLimits struct {
+	MaxProductsPerTenant        int64
+	MaxProductsPerCategory      int64
+	MaxActiveDiscountsPerTenant int64
+}
+
+// QuotaPolicy decides whether catalog growth stays within the configured limits
+// Counts are supplied by the caller so the policy stays free of persistence concerns
+type QuotaPolicy struct {
+	limits QuotaLimits
+}
+
+// NewQuotaPolicy creates a quota policy for the given limits
+func NewQuotaPolicy(limits QuotaLimits) *QuotaPolicy {
+	return &QuotaPolicy{limits: limits}
+}
+
+// TracksProducts reports whether product counts are needed to evaluate product creation
+func (p *QuotaPolicy) TracksProducts() bool {
+	return p.limits.MaxProductsPerTenant > 0 || p.limits.MaxProductsPerCategory > 0
+}
+
+// TracksActiveDiscounts reports whether active discount counts are needed to evaluate discounts
+func (p *QuotaPolicy) TracksActiveDiscounts() bool {
+	return p.limits.MaxActiveDiscountsPerTenant > 0
+}
+
+// CheckNewProduct verifies one more product fits in the tenant and category quotas
+func (p *QuotaPolicy) CheckNewProduct(tenantID, category string, tenantProducts, categoryProducts int64) error {
+	if limit := p.limits.MaxProductsPerTenant; limit > 0 && tenantProducts >= limit {
+		return &domain.QuotaExceededError{
+			Quota:   QuotaProductsPerTenant,
+			Subject: fmt.Sprintf("tenant:%s", tenantID),
+			Limit:   limit,
+			Current: tenantProducts,
+		}
+	}
+	if limit := p.limits.MaxProductsPerCategory; limit > 0 && categoryProducts >= limit {
+		return &domain.QuotaExceededError{
+			Quota:   QuotaProductsPerCategory,
+			Subject: fmt.Sprintf("tenant:%s/category:%s", tenantID, category),
+			Limit:   limit,
+			Current: categoryProducts,
+		}
+	}
+	return nil
+}
+
+// CheckNewActiveDiscount verifies one more active discount fits in the tenant quota
+func (p *QuotaPolicy) CheckNewActiveDiscount(tenantID string, activeDiscounts int64) error {
+	if limit := p.limits.MaxActiveDiscountsPerTenant; limit > 0 && activeDiscounts >= limit {
+		return &domain.QuotaExceededError{
+			Quota:   QuotaActiveDiscountsPerTenant,
+			Subject: fmt.Sprintf("tenant:%s", tenantID),
+			Limit:   limit,
+			Current: activeDiscounts,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"catalog-proj/internal/app/product/domain"
+)
+
+// SynonymTable expands a search word to the words that mean the same, such as "tee" for "t-shirt"
+// The zero value and a nil table have no synonyms
+type SynonymTable struct {
+	byWord map[string][]string
+}
+
+// NewSynonymTable creates a table from groups of interchangeable words
+// Words are normalized like search queries and must be single words; a word in several groups matches all of them
+func NewSynonymTable(groups [][]string) (*SynonymTable, error) {
+	members := make(map[string]map[string]bool)
+	for i, group := range groups {
+		words := make([]string, 0, len(group))
+		for _, word := range group {
+			normalized := domain.NormalizeSearchQuery(word)
+			if normalized == "" || strings.Contains(normalized, " ") {
+				return nil, fmt.Errorf("synonym group %d: %q is not a single word", i, word)
+			}
+			words = append(words, normalized)
+		}
+		for _, word := range words {
+			if members[word] == nil {
+				members[word] = make(map[string]bool)
+			}
+			for _, other := range words {
+				if other != word {
+					members[word][other] = true
+				}
+			}
+		}
+	}
+
+	t := &SynonymTable{byWord: make(map[string][]string, len(members))}
+	for word, others := range members {
+		for other := range others {
+			t.byWord[word] = append(t.byWord[word], other)
+		}
+		sort.Strings(t.byWord[word])
+	}
+	return t, nil
+}
+
+// Synonyms returns the other words of the normalized word's groups, sorted
+func (t *SynonymTable) Synonyms(word string) []string {
+	if t == nil {
+		return nil
+	}
+	return t.byWord[word]
+}
@@ -0,0 +1,126 @@
+package services
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"unicode/utf8"
+
+	"catalog-proj/internal/app/product/domain"
+)
+
+// Fields a FieldRule can constrain
+const (
+	RuleFieldName        = "name"
+	RuleFieldDescription = "description"
+	RuleFieldSKU         = "sku"
+	RuleFieldGTIN        = "gtin"
+	RuleFieldBasePrice   = "base_price"
+)
+
+// AllCategories keys rules that apply to every category
+const AllCategories = "*"
+
+// FieldRule constrains one product field; zero values disable a check
+type FieldRule struct {
+	Field     string
+	Required  bool
+	Pattern   *regexp.Regexp
+	MinLength int
+	MaxLength int
+	MinPrice  *big.Rat
+	MaxPrice  *big.Rat
+	// Message replaces the generated violation description
+	Message string
+}
+
+// ValidationRules evaluates per-category field rules against products
+type ValidationRules struct {
+	byCategory map[string][]FieldRule
+}
+
+// NewValidationRules creates a rule set keyed by category (AllCategories for every category)
+func NewValidationRules(byCategory map[string][]FieldRule) (*ValidationRules, error) {
+	for category, rules := range byCategory {
+		for _, rule := range rules {
+			switch rule.Field {
+			case RuleFieldName, RuleFieldDescription, RuleFieldSKU, RuleFieldGTIN:
+				if rule.MinPrice != nil || rule.MaxPrice != nil {
+					return nil, fmt.Errorf("category %q: price bounds only apply to %s, not %s", category, RuleFieldBasePrice, rule.Field)
+				}
+			case RuleFieldBasePrice:
+				if rule.Pattern != nil || rule.MinLength > 0 || rule.MaxLength > 0 {
+					return nil, fmt.Errorf("category %q: %s only supports required and price bounds", category, RuleFieldBasePrice)
+				}
+			default:
+				return nil, fmt.Errorf("category %q: unknown rule field %q", category, rule.Field)
+			}
+		}
+	}
+	return &ValidationRules{byCategory: byCategory}, nil
+}
+
+// Check returns every rule the product breaks for its category
+func (r *ValidationRules) Check(product *domain.Product) []domain.RuleViolation {
+	if r == nil {
+		return nil
+	}
+
+	var violations []domain.RuleViolation
+	for _, rules := range [][]FieldRule{r.byCategory[AllCategories], r.byCategory[product.Category()]} {
+		for _, rule := range rules {
+			if description, ok := rule.check(product); !ok {
+				if rule.Message != "" {
+					description = rule.Message
+				}
+				violations = append(violations, domain.RuleViolation{Field: rule.Field, Description: description})
+			}
+		}
+	}
+	return violations
+}
+
+// check evaluates the rule, returning a description of the violation when it fails
+func (rule FieldRule) check(product *domain.Product) (string, bool) {
+	if rule.Field == RuleFieldBasePrice {
+		if product.BasePrice() == nil {
+			return "is required", !rule.Required
+		}
+		price := (*big.Rat)(*product.BasePrice())
+		if rule.MinPrice != nil && price.Cmp(rule.MinPrice) < 0 {
+			return fmt.Sprintf("must be at least %s", rule.MinPrice.FloatString(2)), false
+		}
+		if rule.MaxPrice != nil && price.Cmp(rule.MaxPrice) > 0 {
+			return fmt.Sprintf("must be at most %s", rule.MaxPrice.FloatString(2)), false
+		}
+		return "", true
+	}
+
+	var value string
+	switch rule.Field {
+	case RuleFieldName:
+		value = product.Name()
+	case RuleFieldDescription:
+		value = product.Description()
+	case RuleFieldSKU:
+		value = product.SKU()
+	case RuleFieldGTIN:
+		value = product.GTIN()
+	}
+
+	// Optional fields are only checked when set
+	if value == "" {
+		return "is required", !rule.Required
+	}
+	length := utf8.RuneCountInString(value)
+	if rule.MinLength > 0 && length < rule.MinLength {
+		return fmt.Sprintf("must be at least %d characters", rule.MinLength), false
+	}
+	if rule.MaxLength > 0 && length > rule.MaxLength {
+		return fmt.Sprintf("must be at most %d characters", rule.MaxLength), false
+	}
+	if rule.Pattern != nil && !rule.Pattern.MatchString(value) {
+		return fmt.Sprintf("must match %s", rule.Pattern), false
+	}
+	return "", true
+}
@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"math"
+	"regexp"
+)
+
+// WeightUnit is a unit a product's weight can be given in
+type WeightUnit string
+
+const (
+	WeightUnitGram     WeightUnit = "g"
+	WeightUnitKilogram WeightUnit = "kg"
+	WeightUnitOunce    WeightUnit = "oz"
+	WeightUnitPound    WeightUnit = "lb"
+)
+
+// LengthUnit is a unit a product's dimensions can be given in
+type LengthUnit string
+
+const (
+	LengthUnitMillimeter LengthUnit = "mm"
+	LengthUnitCentimeter LengthUnit = "cm"
+	LengthUnitMeter      LengthUnit = "m"
+	LengthUnitInch       LengthUnit = "in"
+)
+
+// Weight is a product's shipping weight in the unit it was given in
+type Weight struct {
+	Value float64
+	Unit  WeightUnit
+}
+
+// Validate checks the weight is a finite, non-negative value in a known unit
+func (w *Weight) Validate() error {
+	switch w.Unit {
+	case WeightUnitGram, WeightUnitKilogram, WeightUnitOunce, WeightUnitPound:
+	default:
+		return ErrInvalidWeight
+	}
+	if !validMeasure(w.Value) {
+		return ErrInvalidWeight
+	}
+	return nil
+}
+
+// Dimensions are a product's package dimensions in the unit they were given in
+type Dimensions struct {
+	Length float64
+	Width  float64
+	Height float64
+	Unit   LengthUnit
+}
+
+// Validate checks every dimension is a finite, non-negative value in a known unit
+func (d *Dimensions) Validate() error {
+	switch d.Unit {
+	case LengthUnitMillimeter, LengthUnitCentimeter, LengthUnitMeter, LengthUnitInch:
+	default:
+		return ErrInvalidDimensions
+	}
+	if !validMeasure(d.Length) || !validMeasure(d.Width) || !validMeasure(d.Height) {
+		return ErrInvalidDimensions
+	}
+	return nil
+}
+
+// shippingClassPattern allows lowercase identifiers such as "standard" or "oversized_freight"
+var shippingClassPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,63}$`)
+
+// ShippingDetails are the physical attributes fulfilment needs; every field is optional
+type ShippingDetails struct {
+	Weight        *Weight
+	Dimensions    *Dimensions
+	ShippingClass string
+}
+
+// Validate checks the weight, dimensions and shipping class that are set
+func (s ShippingDetails) Validate() error {
+	if s.Weight != nil {
+		if err := s.Weight.Validate(); err != nil {
+			return err
+		}
+	}
+	if s.Dimensions != nil {
+		if err := s.Dimensions.Validate(); err != nil {
+			return err
+		}
+	}
+	if s.ShippingClass != "" && !shippingClassPattern.MatchString(s.ShippingClass) {
+		return ErrInvalidShippingClass
+	}
+	return nil
+}
+
+// changedFields lists the shipping fields that differ from other
+func (s ShippingDetails) changedFields(other ShippingDetails) []string {
+	var fields []string
+	if !sameWeight(s.Weight, other.Weight) {
+		fields = append(fields, FieldWeight)
+	}
+	if !sameDimensions(s.Dimensions, other.Dimensions) {
+		fields = append(fields, FieldDimensions)
+	}
+	if s.ShippingClass != other.ShippingClass {
+		fields = append(fields, FieldShippingClass)
+	}
+	return fields
+}
+
+func validMeasure(v float64) bool {
+	return v >= 0 && !math.IsInf(v, 0) && !math.IsNaN(v)
+}
+
+func sameWeight(a, b *Weight) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func sameDimensions(a, b *Dimensions) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
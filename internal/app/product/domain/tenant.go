@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// TenantState is the provisioning state of a tenant
+type TenantState string
+
+const (
+	// TenantProvisioning tenants have their API keys but not yet their settings and templates
+	TenantProvisioning TenantState = "provisioning"
+	// TenantActive tenants are fully provisioned
+	TenantActive TenantState = "active"
+	// TenantDeleting tenants have a DeleteTenant operation purging their data
+	TenantDeleting TenantState = "deleting"
+	// TenantDeleted tenants have no data left and may be created again
+	TenantDeleted TenantState = "deleted"
+)
+
+// MaxTenantDisplayNameLength caps tenant display names
+const MaxTenantDisplayNameLength = 256
+
+// tenantIDPattern matches the tenant IDs accepted in x-tenant-id metadata
+var tenantIDPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,63}$`)
+
+// Tenant is a tenant registered by CreateTenant, or by DeleteTenant for a tenant that predates provisioning
+type Tenant struct {
+	ID string
+	// RegistrationID identifies this incarnation of the tenant and keys its audit events
+	RegistrationID string
+	DisplayName    string
+	State          TenantState
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeletedAt      *time.Time
+}
+
+// ValidTenantID reports whether id is a well-formed tenant ID
+func ValidTenantID(id string) bool {
+	return tenantIDPattern.MatchString(id)
+}
+
+// NewTenant validates and creates a tenant in the provisioning state
+func NewTenant(id, registrationID, displayName string, now time.Time) (*Tenant, error) {
+	displayName = strings.TrimSpace(displayName)
+	if !ValidTenantID(id) || displayName == "" || utf8.RuneCountInString(displayName) > MaxTenantDisplayNameLength {
+		return nil, ErrInvalidTenant
+	}
+	return &Tenant{
+		ID:             id,
+		RegistrationID: registrationID,
+		DisplayName:    displayName,
+		State:          TenantProvisioning,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}, nil
+}
+
+// Activate marks a provisioned tenant active
+func (t *Tenant) Activate(now time.Time) {
+	t.State = TenantActive
+	t.UpdatedAt = now
+}
+
+// StartDeletion marks the tenant as being deleted
+func (t *Tenant) StartDeletion(now time.Time) {
+	t.State = TenantDeleting
+	t.UpdatedAt = now
+}
+
+// MarkDeleted records that all of the tenant's data has been purged
+func (t *Tenant) MarkDeleted(now time.Time) {
+	t.State = TenantDeleted
+	t.UpdatedAt = now
+	t.DeletedAt = &now
+}
@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"time"
+)
+
+// Limits that tenant settings can tighten but never exceed
+const (
+	MaxProductNameLength   = 255
+	MaxDiscountBasisPoints = 10000
+)
+
+// currencyCodePattern matches an ISO 4217 currency code
+var currencyCodePattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// TenantSettings overrides catalog behaviour for one tenant
+// A zero field keeps the service default; see WithDefaults
+type TenantSettings struct {
+	DefaultCurrency        string // ISO 4217 code used when a request names no currency
+	MaxNameLength          int    // Longest product name the tenant accepts, at most MaxProductNameLength
+	MinDiscountBasisPoints int64  // Smallest discount the tenant accepts
+	MaxDiscountBasisPoints int64  // Largest discount the tenant accepts, at most MaxDiscountBasisPoints
+	UpdatedAt              time.Time
+}
+
+// Validate checks every override is within the service limits
+func (s TenantSettings) Validate() error {
+	if s.DefaultCurrency != "" && !currencyCodePattern.MatchString(s.DefaultCurrency) {
+		return ErrInvalidTenantSettings
+	}
+	if s.MaxNameLength < 0 || s.MaxNameLength > MaxProductNameLength {
+		return ErrInvalidTenantSettings
+	}
+	if s.MinDiscountBasisPoints < 0 || s.MinDiscountBasisPoints > MaxDiscountBasisPoints ||
+		s.MaxDiscountBasisPoints < 0 || s.MaxDiscountBasisPoints > MaxDiscountBasisPoints {
+		return ErrInvalidTenantSettings
+	}
+	if s.MaxDiscountBasisPoints > 0 && s.MinDiscountBasisPoints > s.MaxDiscountBasisPoints {
+		return ErrInvalidTenantSettings
+	}
+	return nil
+}
+
+// WithDefaults fills the fields the tenant has not overridden from defaults
+func (s TenantSettings) WithDefaults(defaults TenantSettings) TenantSettings {
+	if s.DefaultCurrency == "" {
+		s.DefaultCurrency = defaults.DefaultCurrency
+	}
+	if s.MaxNameLength == 0 {
+		s.MaxNameLength = defaults.MaxNameLength
+	}
+	if s.MinDiscountBasisPoints == 0 {
+		s.MinDiscountBasisPoints = defaults.MinDiscountBasisPoints
+	}
+	if s.MaxDiscountBasisPoints == 0 {
+		s.MaxDiscountBasisPoints = defaults.MaxDiscountBasisPoints
+	}
+	return s
+}
+
+// Check reports the product fields that break the tenant's limits, in the form of validation rule violations
+func (s TenantSettings) Check(product *Product) []RuleViolation {
+	if s.MaxNameLength > 0 && len(product.Name()) > s.MaxNameLength {
+		return []RuleViolation{{
+			Field:       "name",
+			Description: fmt.Sprintf("name exceeds this tenant's maximum length of %d characters", s.MaxNameLength),
+		}}
+	}
+	return nil
+}
+
+// CheckDiscount returns ErrDiscountOutOfRange when the discount falls outside the tenant's range
+func (s TenantSettings) CheckDiscount(discount *Discount) error {
+	if discount == nil || discount.Amount == nil || s.MaxDiscountBasisPoints == 0 {
+		return nil
+	}
+	basisPoints := new(big.Rat).Mul((*big.Rat)(*discount.Amount), big.NewRat(MaxDiscountBasisPoints, 1))
+	if basisPoints.Cmp(big.NewRat(s.MinDiscountBasisPoints, 1)) < 0 || basisPoints.Cmp(big.NewRat(s.MaxDiscountBasisPoints, 1)) > 0 {
+		return ErrDiscountOutOfRange
+	}
+	return nil
+}
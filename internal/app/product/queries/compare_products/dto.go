@@ -0,0 +1,40 @@
+package compare_products
+
+import (
+	"math/big"
+
+	"catalog-proj/internal/app/product/queries/get_product"
+)
+
+// Compared attribute names, in matrix row order
+const (
+	AttributeName           = "name"
+	AttributeDescription    = "description"
+	AttributeCategory       = "category"
+	AttributeSKU            = "sku"
+	AttributeGTIN           = "gtin"
+	AttributeStatus         = "status"
+	AttributeBasePrice      = "base_price"
+	AttributeDiscount       = "discount"
+	AttributeEffectivePrice = "effective_price"
+)
+
+// Row is one attribute across all compared products
+// Values are aligned with DTO.Products ("" when a product has no value)
+type Row struct {
+	Attribute string
+	Values    []string
+	Differs   bool
+}
+
+// DTO represents the comparison matrix for the requested products
+type DTO struct {
+	// Products in request order, with effective prices filled in
+	Products []*get_product.DTO
+	Rows     []Row
+
+	// CheapestProductID has the lowest effective price (first wins on ties)
+	CheapestProductID string
+	// PriceDifferences is each product's effective price minus the lowest, aligned with Products
+	PriceDifferences []*big.Rat
+}
@@ -0,0 +1,153 @@
+package compare_products
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/domain/services"
+	"catalog-proj/internal/app/product/queries/get_product"
+	"catalog-proj/internal/pkg/clock"
+	"catalog-proj/internal/pkg/tenant"
+)
+
+// Bounds on the number of products that can be compared at once
+const (
+	MinProducts = 2
+	MaxProducts = 5
+)
+
+// ErrInvalidProductCount is returned when fewer than MinProducts or more than MaxProducts IDs are given
+var ErrInvalidProductCount = fmt.Errorf("between %d and %d distinct product ids are required", MinProducts, MaxProducts)
+
+// ReadModel defines the interface for batch reading products (to avoid import cycle)
+type ReadModel interface {
+	// BatchGetProducts returns the products that exist, in no particular order
+	BatchGetProducts(ctx context.Context, ids []string) ([]*get_product.DTO, error)
+}
+
+// Query handles the compare products query
+type Query struct {
+	readModel  ReadModel
+	calculator *services.PricingCalculator
+	clock      clock.Clock
+}
+
+// NewQuery creates a new compare products query
+func NewQuery(
+	readModel ReadModel,
+	calculator *services.PricingCalculator,
+	clock clock.Clock,
+) *Query {
+	return &Query{
+		readModel:  readModel,
+		calculator: calculator,
+		clock:      clock,
+	}
+}
+
+// Execute loads the products and builds an aligned attribute/price matrix
+func (q *Query) Execute(ctx context.Context, productIDs []string) (*DTO, error) {
+	// 1. Validate the ID set
+	seen := make(map[string]bool, len(productIDs))
+	for _, id := range productIDs {
+		if seen[id] {
+			return nil, ErrInvalidProductCount
+		}
+		seen[id] = true
+	}
+	if len(productIDs) < MinProducts || len(productIDs) > MaxProducts {
+		return nil, ErrInvalidProductCount
+	}
+
+	// 2. Batch read and restore request order
+	dtos, err := q.readModel.BatchGetProducts(ctx, productIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get products: %w", err)
+	}
+	byID := make(map[string]*get_product.DTO, len(dtos))
+	tenantID := tenant.FromContext(ctx)
+	for _, dto := range dtos {
+		// Products of other tenants are indistinguishable from missing ones
+		if dto.TenantID == tenantID {
+			byID[dto.ID] = dto
+		}
+	}
+
+	now := q.clock.Now()
+	products := make([]*get_product.DTO, 0, len(productIDs))
+	for _, id := range productIDs {
+		dto, ok := byID[id]
+		if !ok {
+			return nil, domain.ErrProductNotFound
+		}
+		dto.EffectivePrice, dto.MapApplied = get_product.EffectivePrice(dto, q.calculator, now)
+		products = append(products, dto)
+	}
+
+	// 3. Build the matrix
+	result := &DTO{
+		Products: products,
+		Rows: []Row{
+			buildRow(AttributeName, products, func(p *get_product.DTO) string { return p.Name }),
+			buildRow(AttributeDescription, products, func(p *get_product.DTO) string { return p.Description }),
+			buildRow(AttributeCategory, products, func(p *get_product.DTO) string { return p.Category }),
+			buildRow(AttributeSKU, products, func(p *get_product.DTO) string { return p.SKU }),
+			buildRow(AttributeGTIN, products, func(p *get_product.DTO) string { return p.GTIN }),
+			buildRow(AttributeStatus, products, func(p *get_product.DTO) string { return p.Status }),
+			buildRow(AttributeBasePrice, products, func(p *get_product.DTO) string { return formatRat(p.BasePrice) }),
+			buildRow(AttributeDiscount, products, func(p *get_product.DTO) string { return formatDiscount(p, now) }),
+			buildRow(AttributeEffectivePrice, products, func(p *get_product.DTO) string { return formatRat(p.EffectivePrice) }),
+		},
+	}
+
+	// 4. Price differences relative to the cheapest product
+	var lowest *big.Rat
+	for _, p := range products {
+		if p.EffectivePrice != nil && (lowest == nil || p.EffectivePrice.Cmp(lowest) < 0) {
+			lowest = p.EffectivePrice
+			result.CheapestProductID = p.ID
+		}
+	}
+	result.PriceDifferences = make([]*big.Rat, len(products))
+	for i, p := range products {
+		if p.EffectivePrice != nil && lowest != nil {
+			result.PriceDifferences[i] = new(big.Rat).Sub(p.EffectivePrice, lowest)
+		}
+	}
+
+	return result, nil
+}
+
+// buildRow extracts one attribute from every product and flags whether the values differ
+func buildRow(attribute string, products []*get_product.DTO, value func(*get_product.DTO) string) Row {
+	row := Row{Attribute: attribute, Values: make([]string, len(products))}
+	for i, p := range products {
+		row.Values[i] = value(p)
+		if i > 0 && row.Values[i] != row.Values[0] {
+			row.Differs = true
+		}
+	}
+	return row
+}
+
+// formatRat renders a price with two decimals ("" for nil)
+func formatRat(r *big.Rat) string {
+	if r == nil {
+		return ""
+	}
+	return r.FloatString(2)
+}
+
+// formatDiscount renders the discount percentage active at now ("" when none applies)
+func formatDiscount(p *get_product.DTO, now time.Time) string {
+	if p.DiscountAmount == nil || p.DiscountStartDate == nil || p.DiscountEndDate == nil {
+		return ""
+	}
+	if now.Before(*p.DiscountStartDate) || !now.Before(*p.DiscountEndDate) {
+		return ""
+	}
+	return new(big.Rat).Mul(p.DiscountAmount, big.NewRat(100, 1)).FloatString(2) + "%"
+}
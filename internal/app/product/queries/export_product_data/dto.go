@@ -0,0 +1,87 @@
+package export_product_data
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// ProductRecord is the stored product row
+type ProductRecord struct {
+	ID                   string            `json:"product_id"`
+	TenantID             string            `json:"tenant_id"`
+	Name                 string            `json:"name"`
+	Description          string            `json:"description"`
+	DescriptionFormat    string            `json:"description_format"`
+	Category             string            `json:"category"`
+	SKU                  string            `json:"sku,omitempty"`
+	GTIN                 string            `json:"gtin,omitempty"`
+	BasePrice            string            `json:"base_price"`
+	DiscountID           *string           `json:"discount_id,omitempty"`
+	DiscountAmount       string            `json:"discount_amount,omitempty"`
+	DiscountStartDate    *time.Time        `json:"discount_start_date,omitempty"`
+	DiscountEndDate      *time.Time        `json:"discount_end_date,omitempty"`
+	Status               string            `json:"status"`
+	LegalHold            bool              `json:"legal_hold"`
+	Channels             []string          `json:"channels,omitempty"`
+	ProductType          string            `json:"product_type"`
+	Weight               *Weight           `json:"weight,omitempty"`
+	Dimensions           *Dimensions       `json:"dimensions,omitempty"`
+	ShippingClass        string            `json:"shipping_class,omitempty"`
+	DownloadURL          string            `json:"download_url,omitempty"`
+	LicenseTerms         string            `json:"license_terms,omitempty"`
+	AgeRestriction       int64             `json:"age_restriction,omitempty"`
+	Hazardous            bool              `json:"hazardous"`
+	RequiresPrescription bool              `json:"requires_prescription"`
+	Metadata             map[string]string `json:"metadata,omitempty"`
+	Attributes           map[string]string `json:"attributes,omitempty"`
+	FloorPrice           string            `json:"floor_price,omitempty"`
+	CostPrice            string            `json:"cost_price,omitempty"`
+	MinMarginPercent     int64             `json:"min_margin_percent,omitempty"`
+	MapPrice             string            `json:"map_price,omitempty"`
+	ArchivedAt           *time.Time        `json:"archived_at,omitempty"`
+	CreatedAt            time.Time         `json:"created_at"`
+	UpdatedAt            time.Time         `json:"updated_at"`
+}
+
+// Weight is the stored shipping weight
+type Weight struct {
+	Value float64 `json:"value"`
+	Unit  string  `json:"unit"`
+}
+
+// Dimensions are the stored package dimensions
+type Dimensions struct {
+	Length float64 `json:"length"`
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+	Unit   string  `json:"unit"`
+}
+
+// EventRecord is a stored outbox event referencing the product
+type EventRecord struct {
+	EventID     string          `json:"event_id"`
+	EventType   string          `json:"event_type"`
+	Status      string          `json:"status"`
+	Payload     json.RawMessage `json:"payload"`
+	CreatedAt   time.Time       `json:"created_at"`
+	ProcessedAt *time.Time      `json:"processed_at,omitempty"`
+}
+
+// Data is everything stored about a product, read at a single timestamp
+type Data struct {
+	// Product is nil when the row no longer exists (e.g. after a purge)
+	Product *ProductRecord
+	// Events in creation order; they double as the product's change history
+	Events []EventRecord
+	ReadAt time.Time
+}
+
+// Document is the exported JSON document
+type Document struct {
+	ProductID    string         `json:"product_id"`
+	ExportedAt   time.Time      `json:"exported_at"`
+	ReadAt       time.Time      `json:"read_at"`
+	ProductFound bool           `json:"product_found"`
+	Product      *ProductRecord `json:"product,omitempty"`
+	Events       []EventRecord  `json:"events"`
+}
@@ -0,0 +1,60 @@
+package export_product_data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/pkg/clock"
+)
+
+// Reader defines the interface for reading all stored data about a product (to avoid import cycle)
+type Reader interface {
+	ReadProductData(ctx context.Context, productID string) (*Data, error)
+}
+
+// Query handles the export product data query
+// Exports are an admin operation and are not scoped to the caller's tenant
+type Query struct {
+	reader Reader
+	clock  clock.Clock
+}
+
+// NewQuery creates a new export product data query
+func NewQuery(reader Reader, clock clock.Clock) *Query {
+	return &Query{
+		reader: reader,
+		clock:  clock,
+	}
+}
+
+// Execute gathers the product row and every event referencing it into one JSON document
+// A purged product still exports its remaining events, which supports deletion verification
+func (q *Query) Execute(ctx context.Context, productID string) ([]byte, error) {
+	data, err := q.reader.ReadProductData(ctx, productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read product data: %w", err)
+	}
+	if data.Product == nil && len(data.Events) == 0 {
+		return nil, domain.ErrProductNotFound
+	}
+
+	doc := &Document{
+		ProductID:    productID,
+		ExportedAt:   q.clock.Now(),
+		ReadAt:       data.ReadAt,
+		ProductFound: data.Product != nil,
+		Product:      data.Product,
+		Events:       data.Events,
+	}
+	if doc.Events == nil {
+		doc.Events = []EventRecord{}
+	}
+
+	out, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode export document: %w", err)
+	}
+	return out, nil
+}
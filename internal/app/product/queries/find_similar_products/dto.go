@@ -0,0 +1,38 @@
+package find_similar_products
+
+import "time"
+
+// Match criteria reported in Match.MatchedOn
+const (
+	MatchedOnNameCategory = "name_category"
+	MatchedOnSKU          = "sku"
+	MatchedOnGTIN         = "gtin"
+)
+
+// Request represents the attributes to search for within a tenant's catalog
+// Empty fields are ignored; Name and Category only match together
+type Request struct {
+	TenantID string
+	Name     string
+	Category string
+	SKU      string
+	GTIN     string
+	Limit    int
+}
+
+// Match represents an existing product that looks like the requested one
+type Match struct {
+	ID        string
+	Name      string
+	Category  string
+	SKU       string
+	GTIN      string
+	Status    string
+	MatchedOn []string
+	CreatedAt time.Time
+}
+
+// DTO represents the data transfer object for find similar products query result
+type DTO struct {
+	Matches []Match
+}
@@ -0,0 +1,51 @@
+package find_similar_products
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// defaultLimit caps the number of matches when the request does not set one
+const defaultLimit = 10
+
+// ReadModel defines the interface for finding similar products (to avoid import cycle)
+type ReadModel interface {
+	FindSimilarProducts(ctx context.Context, req *Request) (*DTO, error)
+}
+
+// Query handles the find similar products query
+// Archived products are never reported as matches
+type Query struct {
+	readModel ReadModel
+}
+
+// NewQuery creates a new find similar products query
+func NewQuery(readModel ReadModel) *Query {
+	return &Query{
+		readModel: readModel,
+	}
+}
+
+// Execute returns non-archived products of the tenant with an identical name+category or matching SKU/GTIN
+func (q *Query) Execute(ctx context.Context, req *Request) (*DTO, error) {
+	normalized := *req
+	normalized.Name = strings.TrimSpace(req.Name)
+	normalized.Category = strings.TrimSpace(req.Category)
+	normalized.SKU = strings.TrimSpace(req.SKU)
+	normalized.GTIN = strings.TrimSpace(req.GTIN)
+	if normalized.Limit <= 0 {
+		normalized.Limit = defaultLimit
+	}
+
+	// Nothing to compare against
+	if (normalized.Name == "" || normalized.Category == "") && normalized.SKU == "" && normalized.GTIN == "" {
+		return &DTO{}, nil
+	}
+
+	dto, err := q.readModel.FindSimilarProducts(ctx, &normalized)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find similar products: %w", err)
+	}
+	return dto, nil
+}
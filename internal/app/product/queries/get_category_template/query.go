@@ -0,0 +1,39 @@
+package get_category_template
+
+import (
+	"context"
+	"fmt"
+
+	"catalog-proj/internal/app/product/domain"
+)
+
+// TemplateSource provides the tenant's category templates
+type TemplateSource interface {
+	Load(ctx context.Context, category string) (*domain.CategoryTemplate, error)
+}
+
+// DTO represents the data transfer object for get category template query result
+type DTO struct {
+	Template domain.CategoryTemplate
+}
+
+// Query handles the get category template query
+type Query struct {
+	templates TemplateSource
+}
+
+// NewQuery creates a new get category template query
+func NewQuery(templates TemplateSource) *Query {
+	return &Query{
+		templates: templates,
+	}
+}
+
+// Execute returns the template of a category of the caller's tenant, or ErrCategoryTemplateNotFound
+func (q *Query) Execute(ctx context.Context, category string) (*DTO, error) {
+	template, err := q.templates.Load(ctx, category)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load category template: %w", err)
+	}
+	return &DTO{Template: *template}, nil
+}
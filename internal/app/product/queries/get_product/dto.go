@@ -3,22 +3,42 @@ package get_product
 import (
 	"math/big"
 	"time"
+
+	"catalog-proj/internal/app/product/domain"
 )
 
 // DTO represents the data transfer object for a single product query result
 type DTO struct {
 	ID                string
+	TenantID          string
 	Name              string
 	Description       string
+	DescriptionFormat domain.DescriptionFormat
 	Category          string
+	SKU               string
+	GTIN              string
 	BasePrice         *big.Rat
-	EffectivePrice    *big.Rat // Calculated price after discount
+	EffectivePrice    *big.Rat // Calculated price after discount, raised to the MAP when MapApplied
+	MapApplied        bool     // The minimum advertised price is shown in place of a lower effective price
 	DiscountID        *string
 	DiscountAmount    *big.Rat
 	DiscountStartDate *time.Time
 	DiscountEndDate   *time.Time
 	Status            string
+	LegalHold         bool
+	Channels          []string
+	ProductType       domain.ProductType
+	Shipping          domain.ShippingDetails
+	DigitalDelivery   domain.DigitalDelivery
+	Compliance        domain.Compliance
+	Metadata          domain.Metadata
+	Attributes        domain.Attributes
+	PriceFloor        domain.PriceFloor
 	ArchivedAt        *time.Time
 	CreatedAt         time.Time
 	UpdatedAt         time.Time
+	// AliasedFrom is the requested reference when it is an alias of this product, e.g. a merged duplicate's ID
+	AliasedFrom string
+	// Draft is set when the name, description, category and metadata come from the unpublished draft
+	Draft bool
 }
@@ -2,12 +2,15 @@ package get_product
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
+	"time"
 
 	"catalog-proj/internal/app/product/domain"
 	"catalog-proj/internal/app/product/domain/services"
 	"catalog-proj/internal/pkg/clock"
+	"catalog-proj/internal/pkg/tenant"
 )
 
 // ReadModel defines the interface for reading products (to avoid import cycle)
@@ -15,9 +18,21 @@ type ReadModel interface {
 	GetProduct(ctx context.Context, id string) (*DTO, error)
 }
 
+// Aliases resolves old product references such as merged product IDs (to avoid import cycle)
+type Aliases interface {
+	Resolve(ctx context.Context, alias string) (string, error)
+}
+
+// Drafts reads products' unpublished content (to avoid import cycle)
+type Drafts interface {
+	Load(ctx context.Context, productID string) (*domain.Draft, error)
+}
+
 // Query handles the get product query use case
 type Query struct {
 	readModel  ReadModel
+	aliases    Aliases
+	drafts     Drafts
 	calculator *services.PricingCalculator
 	clock      clock.Clock
 }
@@ -25,35 +40,96 @@ type Query struct {
 // NewQuery creates a new get product query
 func NewQuery(
 	readModel ReadModel,
+	aliases Aliases,
+	drafts Drafts,
 	calculator *services.PricingCalculator,
 	clock clock.Clock,
 ) *Query {
 	return &Query{
 		readModel:  readModel,
+		aliases:    aliases,
+		drafts:     drafts,
 		calculator: calculator,
 		clock:      clock,
 	}
 }
 
 // Execute retrieves a product and calculates its effective price
+// An alias, such as the ID of a product merged into another one, is served as its canonical product
 func (q *Query) Execute(ctx context.Context, productID string) (*DTO, error) {
 	// 1. Call read model
 	dto, err := q.readModel.GetProduct(ctx, productID)
+	if err != nil && !errors.Is(err, domain.ErrProductNotFound) {
+		return nil, fmt.Errorf("failed to get product: %w", err)
+	}
+	// Aliases never name a live product: merged duplicates are archived, or purged later
+	var aliasedFrom string
+	if err != nil || dto.ArchivedAt != nil {
+		canonicalID, rerr := q.aliases.Resolve(ctx, productID)
+		if rerr != nil {
+			return nil, fmt.Errorf("failed to resolve alias: %w", rerr)
+		}
+		if canonicalID != "" {
+			aliasedFrom = productID
+			dto, err = q.readModel.GetProduct(ctx, canonicalID)
+		}
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to get product: %w", err)
 	}
+	// Products of other tenants are indistinguishable from missing ones
+	if dto.TenantID != tenant.FromContext(ctx) {
+		return nil, domain.ErrProductNotFound
+	}
+
+	// 2. Calculate effective price using domain service; in MAP mode the advertised price may stand in
+	// for a lower effective price
+	effectivePrice, mapApplied := EffectivePrice(dto, q.calculator, q.clock.Now())
 
-	// 2. Calculate effective price using domain service
-	// Reconstruct domain product to use the pricing calculator
-	now := q.clock.Now()
+	// Create response DTO with effective price
+	// Build new DTO with all fields including calculated effective price
+	return &DTO{
+		ID:                dto.ID,
+		TenantID:          dto.TenantID,
+		Name:              dto.Name,
+		Description:       dto.Description,
+		DescriptionFormat: dto.DescriptionFormat,
+		Category:          dto.Category,
+		SKU:               dto.SKU,
+		GTIN:              dto.GTIN,
+		BasePrice:         dto.BasePrice,
+		EffectivePrice:    effectivePrice,
+		MapApplied:        mapApplied,
+		DiscountID:        dto.DiscountID,
+		DiscountAmount:    dto.DiscountAmount,
+		DiscountStartDate: dto.DiscountStartDate,
+		DiscountEndDate:   dto.DiscountEndDate,
+		Status:            dto.Status,
+		LegalHold:         dto.LegalHold,
+		Channels:          dto.Channels,
+		ProductType:       dto.ProductType,
+		Shipping:          dto.Shipping,
+		DigitalDelivery:   dto.DigitalDelivery,
+		Compliance:        dto.Compliance,
+		Metadata:          dto.Metadata,
+		Attributes:        dto.Attributes,
+		PriceFloor:        dto.PriceFloor,
+		ArchivedAt:        dto.ArchivedAt,
+		CreatedAt:         dto.CreatedAt,
+		UpdatedAt:         dto.UpdatedAt,
+		AliasedFrom:       aliasedFrom,
+	}, nil
+}
 
+// ToDomain reconstructs the product behind a DTO read from the read model
+// Queries use it, not NewProduct, so every field of the aggregate reaches the pricing calculator
+func ToDomain(dto *DTO) *domain.Product {
 	var basePrice *domain.Money
 	if dto.BasePrice != nil {
 		price := domain.Money(dto.BasePrice)
 		basePrice = &price
 	}
 
-	// Reconstruct product from database data (queries should use ReconstructProduct, not NewProduct)
 	var discount *domain.Discount
 	if dto.DiscountID != nil && dto.DiscountStartDate != nil && dto.DiscountEndDate != nil {
 		var discountAmount *domain.Money
@@ -75,46 +151,116 @@ func (q *Query) Execute(ctx context.Context, productID string) (*DTO, error) {
 		status = domain.ProductStatusInactive
 	}
 
-	product := domain.ReconstructProduct(
+	return domain.ReconstructProduct(
 		dto.ID,
+		dto.TenantID,
 		dto.Name,
 		dto.Description,
+		dto.DescriptionFormat,
 		dto.Category,
+		dto.SKU,
+		dto.GTIN,
 		basePrice,
 		discount,
 		status,
+		dto.LegalHold,
+		domain.ChannelsFromStrings(dto.Channels),
+		dto.ProductType,
+		dto.Shipping,
+		dto.DigitalDelivery,
+		dto.Compliance,
+		dto.Metadata,
+		dto.Attributes,
+		dto.PriceFloor,
 		dto.ArchivedAt,
 		dto.CreatedAt,
 		dto.UpdatedAt,
 	)
+}
 
-	// Use pricing calculator
-	effectivePricePtr := q.calculator.CalculateEffectivePrice(product, now)
-	var effectivePrice *big.Rat
-	if effectivePricePtr != nil {
-		// effectivePricePtr is *domain.Money which is *big.Rat
-		// domain.Money is *big.Rat, so *effectivePricePtr gives us *big.Rat
-		effectivePrice = *effectivePricePtr
-	} else if dto.BasePrice != nil {
-		effectivePrice = dto.BasePrice
-	}
+// EffectivePrice returns the price shown for a DTO's product at now, falling back to its base price,
+// and whether the minimum advertised price stands in for a lower effective price
+func EffectivePrice(dto *DTO, calculator *services.PricingCalculator, now time.Time) (*big.Rat, bool) {
+	return displayPrice(calculator, ToDomain(dto), now)
+}
 
-	// Create response DTO with effective price
-	// Build new DTO with all fields including calculated effective price
-	return &DTO{
-		ID:                dto.ID,
-		Name:              dto.Name,
-		Description:       dto.Description,
-		Category:          dto.Category,
-		BasePrice:         dto.BasePrice,
+// FromProduct builds the DTO of an aggregate the caller already holds, such as one a mutation
+// has just committed, with its effective price calculated as Execute does
+func (q *Query) FromProduct(product *domain.Product) *DTO {
+	effectivePrice, mapApplied := displayPrice(q.calculator, product, q.clock.Now())
+
+	dto := &DTO{
+		ID:                product.ID(),
+		TenantID:          product.TenantID(),
+		Name:              product.Name(),
+		Description:       product.Description(),
+		DescriptionFormat: product.DescriptionFormat(),
+		Category:          product.Category(),
+		SKU:               product.SKU(),
+		GTIN:              product.GTIN(),
 		EffectivePrice:    effectivePrice,
-		DiscountID:        dto.DiscountID,
-		DiscountAmount:    dto.DiscountAmount,
-		DiscountStartDate: dto.DiscountStartDate,
-		DiscountEndDate:   dto.DiscountEndDate,
-		Status:            dto.Status,
-		ArchivedAt:        dto.ArchivedAt,
-		CreatedAt:         dto.CreatedAt,
-		UpdatedAt:         dto.UpdatedAt,
-	}, nil
+		MapApplied:        mapApplied,
+		Status:            string(product.Status()),
+		LegalHold:         product.LegalHold(),
+		Channels:          domain.ChannelStrings(product.Channels()),
+		ProductType:       product.Type(),
+		Shipping:          product.Shipping(),
+		DigitalDelivery:   product.DigitalDelivery(),
+		Compliance:        product.Compliance(),
+		Metadata:          product.Metadata(),
+		Attributes:        product.Attributes(),
+		PriceFloor:        product.PriceFloor(),
+		ArchivedAt:        product.ArchivedAt(),
+		CreatedAt:         product.CreatedAt(),
+		UpdatedAt:         product.UpdatedAt(),
+	}
+	if basePrice := product.BasePrice(); basePrice != nil {
+		dto.BasePrice = *basePrice
+	}
+	if discount := product.Discount(); discount != nil {
+		startDate, endDate := discount.StartDate, discount.EndDate
+		dto.DiscountID = &discount.ID
+		dto.DiscountStartDate = &startDate
+		dto.DiscountEndDate = &endDate
+		if discount.Amount != nil {
+			dto.DiscountAmount = *discount.Amount
+		}
+	}
+	return dto
+}
+
+// displayPrice returns the price shown for a product at now, falling back to its base price,
+// and whether the minimum advertised price stands in for a lower effective price
+func displayPrice(calculator *services.PricingCalculator, product *domain.Product, now time.Time) (*big.Rat, bool) {
+	effectivePrice, mapApplied := calculator.CalculateDisplayPrice(product, now)
+	if effectivePrice != nil {
+		// domain.Money is *big.Rat, so *effectivePrice gives us *big.Rat
+		return *effectivePrice, mapApplied
+	}
+	if basePrice := product.BasePrice(); basePrice != nil {
+		return *basePrice, mapApplied
+	}
+	return nil, mapApplied
+}
+
+// ExecutePreview is Execute with the product's draft, if it has one, in place of its published content
+func (q *Query) ExecutePreview(ctx context.Context, productID string) (*DTO, error) {
+	dto, err := q.Execute(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	draft, err := q.drafts.Load(ctx, dto.ID)
+	if errors.Is(err, domain.ErrDraftNotFound) {
+		return dto, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load draft: %w", err)
+	}
+
+	dto.Name = draft.Name
+	dto.Description = draft.Description
+	dto.Category = draft.Category
+	dto.Metadata = draft.Metadata
+	dto.Draft = true
+	return dto, nil
 }
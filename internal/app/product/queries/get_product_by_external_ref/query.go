@@ -0,0 +1,45 @@
+package get_product_by_external_ref
+
+import (
+	"context"
+	"fmt"
+
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/queries/get_product"
+)
+
+// Refs resolves external references to product IDs (to avoid import cycle)
+type Refs interface {
+	Lookup(ctx context.Context, ref domain.ExternalRef) (string, error)
+}
+
+// Query handles the get product by external reference query use case
+type Query struct {
+	refs     Refs
+	products *get_product.Query
+}
+
+// NewQuery creates a new get product by external reference query
+func NewQuery(refs Refs, products *get_product.Query) *Query {
+	return &Query{
+		refs:     refs,
+		products: products,
+	}
+}
+
+// Execute resolves the reference with a single keyed read and returns the product as GetProduct would,
+// including the effective price and alias resolution for products merged after they were linked
+func (q *Query) Execute(ctx context.Context, ref domain.ExternalRef) (*get_product.DTO, error) {
+	if err := ref.Validate(); err != nil {
+		return nil, err
+	}
+
+	productID, err := q.refs.Lookup(ctx, ref)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up external reference: %w", err)
+	}
+	if productID == "" {
+		return nil, domain.ErrExternalRefNotFound
+	}
+	return q.products.Execute(ctx, productID)
+}
@@ -0,0 +1,116 @@
+package get_product_history
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"catalog-proj/internal/app/product/domain"
+)
+
+// ProductLoader loads the product to check it exists for the caller's tenant (to avoid import cycle)
+type ProductLoader interface {
+	Load(ctx context.Context, id string) (*domain.Product, error)
+}
+
+// Reader defines the interface for reading a product's recorded events (to avoid import cycle)
+type Reader interface {
+	ReadProductEvents(ctx context.Context, productID string) ([]Event, error)
+}
+
+// Event is one stored domain event for a product
+type Event struct {
+	EventID    string
+	EventType  string
+	OccurredAt time.Time
+	Payload    json.RawMessage
+}
+
+// Review is the reviewer decision carried by product_approved and product_rejected events
+type Review struct {
+	Decision   domain.ReviewDecision
+	Reviewer   string
+	Comment    string
+	ReviewedAt time.Time
+}
+
+// Entry is one history entry; Review is set for review decisions
+type Entry struct {
+	Event
+	Review *Review
+}
+
+// DTO lists a product's history oldest first
+type DTO struct {
+	ProductID string
+	Entries   []Entry
+}
+
+// Query handles the get product history query
+// History is read from the outbox, so it is lost when a product is purged
+type Query struct {
+	products ProductLoader
+	reader   Reader
+}
+
+// NewQuery creates a new get product history query
+func NewQuery(products ProductLoader, reader Reader) *Query {
+	return &Query{
+		products: products,
+		reader:   reader,
+	}
+}
+
+// Execute returns every event recorded for the product, including reviewer decisions
+func (q *Query) Execute(ctx context.Context, productID string) (*DTO, error) {
+	// Load enforces tenant ownership, so other tenants' products are not found
+	if _, err := q.products.Load(ctx, productID); err != nil {
+		return nil, fmt.Errorf("failed to load product: %w", err)
+	}
+
+	events, err := q.reader.ReadProductEvents(ctx, productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read product history: %w", err)
+	}
+
+	dto := &DTO{ProductID: productID}
+	for _, event := range events {
+		entry := Entry{Event: event}
+		review, err := parseReview(event)
+		if err != nil {
+			return nil, err
+		}
+		entry.Review = review
+		dto.Entries = append(dto.Entries, entry)
+	}
+	return dto, nil
+}
+
+// parseReview extracts the review decision from review events, returning nil for other events
+func parseReview(event Event) (*Review, error) {
+	var decision domain.ReviewDecision
+	switch event.EventType {
+	case (&domain.ProductApprovedEvent{}).EventName():
+		decision = domain.ReviewApproved
+	case (&domain.ProductRejectedEvent{}).EventName():
+		decision = domain.ReviewRejected
+	default:
+		return nil, nil
+	}
+
+	var payload struct {
+		Reviewer   string    `json:"reviewer"`
+		Comment    string    `json:"comment"`
+		ReviewedAt time.Time `json:"reviewed_at"`
+	}
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return nil, fmt.Errorf("failed to decode %s event %s: %w", event.EventType, event.EventID, err)
+	}
+	return &Review{
+		Decision:   decision,
+		Reviewer:   payload.Reviewer,
+		Comment:    payload.Comment,
+		ReviewedAt: payload.ReviewedAt,
+	}, nil
+}
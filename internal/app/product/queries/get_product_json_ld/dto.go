@@ -0,0 +1,58 @@
+package get_product_json_ld
+
+// Metadata entries read into the snippet; the catalog has no dedicated brand or media fields
+const (
+	BrandMetadataKey = "brand"
+	ImageMetadataKey = "image_url"
+)
+
+// Request represents the request for a product's schema.org snippet
+type Request struct {
+	ProductID string
+	// Currency is the ISO 4217 code the storefront prices in; empty uses the tenant's default currency
+	Currency string
+	// URL is the product page the snippet is embedded in ("" to leave it out)
+	URL string
+}
+
+// Product is a schema.org Product
+type Product struct {
+	Context     string `json:"@context"`
+	Type        string `json:"@type"`
+	ProductID   string `json:"productID"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	SKU         string `json:"sku,omitempty"`
+	GTIN        string `json:"gtin,omitempty"`
+	Category    string `json:"category,omitempty"`
+	Image       string `json:"image,omitempty"`
+	URL         string `json:"url,omitempty"`
+	Brand       *Brand `json:"brand,omitempty"`
+	Offers      *Offer `json:"offers,omitempty"`
+}
+
+// Brand is a schema.org Brand
+type Brand struct {
+	Type string `json:"@type"`
+	Name string `json:"name"`
+}
+
+// Offer is a schema.org Offer carrying the effective price
+type Offer struct {
+	Type          string `json:"@type"`
+	Price         string `json:"price"`
+	PriceCurrency string `json:"priceCurrency"`
+	// PriceValidUntil is the last day of the discount behind a reduced price
+	PriceValidUntil string `json:"priceValidUntil,omitempty"`
+	Availability    string `json:"availability"`
+	ItemCondition   string `json:"itemCondition"`
+	URL             string `json:"url,omitempty"`
+}
+
+// DTO represents the data transfer object for get product JSON-LD query result
+type DTO struct {
+	// JSONLD is the snippet, ready to embed in a <script type="application/ld+json"> element
+	JSONLD []byte
+	// AliasedFrom is the requested ID when it is an alias of the product, e.g. a merged duplicate
+	AliasedFrom string
+}
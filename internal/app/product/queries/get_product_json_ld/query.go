@@ -0,0 +1,117 @@
+package get_product_json_ld
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/queries/get_product"
+	"catalog-proj/internal/app/product/tenant_config"
+	"catalog-proj/internal/pkg/clock"
+)
+
+// currencyPattern matches an ISO 4217 currency code
+var currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// ErrInvalidCurrency is returned when the currency is not an ISO 4217 code
+var ErrInvalidCurrency = fmt.Errorf("currency must be a three-letter ISO 4217 code")
+
+// ValidCurrency reports whether code is a three-letter ISO 4217 currency code
+func ValidCurrency(code string) bool {
+	return currencyPattern.MatchString(code)
+}
+
+// Query handles the get product JSON-LD query
+// Prices and alias resolution come from the get product query, so the snippet always shows what GetProduct does
+type Query struct {
+	products     *get_product.Query
+	clock        clock.Clock
+	tenantConfig *tenant_config.TenantConfig
+}
+
+// NewQuery creates a new get product JSON-LD query
+func NewQuery(products *get_product.Query, clock clock.Clock, tenantConfig *tenant_config.TenantConfig) *Query {
+	return &Query{
+		products:     products,
+		clock:        clock,
+		tenantConfig: tenantConfig,
+	}
+}
+
+// Execute renders the product as a schema.org Product with an offer at its effective price
+func (q *Query) Execute(ctx context.Context, req *Request) (*DTO, error) {
+	// Without a currency the tenant's default currency is used
+	currency := req.Currency
+	if currency == "" {
+		settings, err := q.tenantConfig.Settings(ctx)
+		if err != nil {
+			return nil, err
+		}
+		currency = settings.DefaultCurrency
+	}
+	if !ValidCurrency(currency) {
+		return nil, ErrInvalidCurrency
+	}
+
+	// 1. Read the product as GetProduct would
+	dto, err := q.products.Execute(ctx, req.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Build the snippet
+	product := &Product{
+		Context:     "https://schema.org",
+		Type:        "Product",
+		ProductID:   dto.ID,
+		Name:        dto.Name,
+		Description: dto.Description,
+		SKU:         dto.SKU,
+		GTIN:        dto.GTIN,
+		Category:    dto.Category,
+		Image:       dto.Metadata[ImageMetadataKey],
+		URL:         req.URL,
+	}
+	if brand := dto.Metadata[BrandMetadataKey]; brand != "" {
+		product.Brand = &Brand{Type: "Brand", Name: brand}
+	}
+	// Products without a price cannot be offered
+	if dto.EffectivePrice != nil {
+		product.Offers = &Offer{
+			Type:          "Offer",
+			Price:         dto.EffectivePrice.FloatString(2),
+			PriceCurrency: currency,
+			Availability:  availability(dto),
+			ItemCondition: "https://schema.org/NewCondition",
+			URL:           req.URL,
+		}
+		// A reduced price is only valid while its discount runs
+		now := q.clock.Now()
+		if dto.BasePrice != nil && dto.EffectivePrice.Cmp(dto.BasePrice) < 0 &&
+			dto.DiscountStartDate != nil && dto.DiscountEndDate != nil &&
+			!now.Before(*dto.DiscountStartDate) && now.Before(*dto.DiscountEndDate) {
+			product.Offers.PriceValidUntil = dto.DiscountEndDate.UTC().Format("2006-01-02")
+		}
+	}
+
+	out, err := json.Marshal(product)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode JSON-LD: %w", err)
+	}
+	return &DTO{JSONLD: out, AliasedFrom: dto.AliasedFrom}, nil
+}
+
+// availability maps the product's lifecycle to a schema.org item availability
+// The catalog has no inventory, so sellable products are reported in stock
+func availability(dto *get_product.DTO) string {
+	switch {
+	case dto.ArchivedAt != nil:
+		return "https://schema.org/Discontinued"
+	case dto.Status == string(domain.ProductStatusActive):
+		return "https://schema.org/InStock"
+	default:
+		return "https://schema.org/OutOfStock"
+	}
+}
@@ -0,0 +1,60 @@
+package get_product_stats
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ProductStats holds the engagement counters of a product
+type ProductStats struct {
+	ProductID    string
+	ViewCount    int64
+	LastViewedAt *time.Time // nil until a view is recorded
+}
+
+// DTO represents the data transfer object for get product stats query result
+type DTO struct {
+	Stats []ProductStats // In request order
+}
+
+// StatsSource reads the caller's tenant's view counters
+type StatsSource interface {
+	// Stats returns the counters of the given products that have recorded views
+	Stats(ctx context.Context, ids []string) ([]ProductStats, error)
+}
+
+// Query handles the get product stats query
+// Views are written in batches, so counts lag recorded views by up to the flush interval
+type Query struct {
+	source StatsSource
+}
+
+// NewQuery creates a new get product stats query
+func NewQuery(source StatsSource) *Query {
+	return &Query{
+		source: source,
+	}
+}
+
+// Execute returns the stats of each requested product; products without recorded views report zero
+func (q *Query) Execute(ctx context.Context, ids []string) (*DTO, error) {
+	found, err := q.source.Stats(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read product stats: %w", err)
+	}
+	byID := make(map[string]ProductStats, len(found))
+	for _, stats := range found {
+		byID[stats.ProductID] = stats
+	}
+
+	out := make([]ProductStats, 0, len(ids))
+	for _, id := range ids {
+		stats, ok := byID[id]
+		if !ok {
+			stats = ProductStats{ProductID: id}
+		}
+		out = append(out, stats)
+	}
+	return &DTO{Stats: out}, nil
+}
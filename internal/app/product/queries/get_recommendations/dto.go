@@ -0,0 +1,14 @@
+package get_recommendations
+
+import "catalog-proj/internal/app/product/queries/get_product"
+
+// Request represents the request for recommendations alongside a seed product
+type Request struct {
+	ProductID string
+	Limit     int
+}
+
+// DTO represents the data transfer object for get recommendations query result
+type DTO struct {
+	Products []*get_product.DTO // Best first, with effective prices
+}
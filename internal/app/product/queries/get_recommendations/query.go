@@ -0,0 +1,108 @@
+package get_recommendations
+
+import (
+	"context"
+	"fmt"
+
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/domain/services"
+	"catalog-proj/internal/app/product/queries/get_product"
+	"catalog-proj/internal/pkg/clock"
+	"catalog-proj/internal/pkg/tenant"
+)
+
+// defaultLimit caps the number of recommendations when the request does not set one
+const defaultLimit = 10
+
+// Recommender picks the products to recommend alongside a seed product
+// Implementations return up to limit candidate IDs, best first; the query drops any that are not
+// active products of the seed's tenant, so a recommender may work from stale or cross-tenant data
+type Recommender interface {
+	Recommend(ctx context.Context, seed *get_product.DTO, limit int) ([]string, error)
+}
+
+// ReadModel defines the interface for reading products (to avoid import cycle)
+type ReadModel interface {
+	GetProduct(ctx context.Context, id string) (*get_product.DTO, error)
+	BatchGetProducts(ctx context.Context, ids []string) ([]*get_product.DTO, error)
+}
+
+// Query handles the get recommendations query
+type Query struct {
+	recommender Recommender
+	readModel   ReadModel
+	calculator  *services.PricingCalculator
+	clock       clock.Clock
+}
+
+// NewQuery creates a new get recommendations query
+func NewQuery(
+	recommender Recommender,
+	readModel ReadModel,
+	calculator *services.PricingCalculator,
+	clock clock.Clock,
+) *Query {
+	return &Query{
+		recommender: recommender,
+		readModel:   readModel,
+		calculator:  calculator,
+		clock:       clock,
+	}
+}
+
+// Execute returns the recommender's picks for the seed product that are active products of the same tenant
+func (q *Query) Execute(ctx context.Context, req *Request) (*DTO, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+
+	// 1. Read the seed product
+	seed, err := q.readModel.GetProduct(ctx, req.ProductID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product: %w", err)
+	}
+	// Products of other tenants are indistinguishable from missing ones
+	tenantID := tenant.FromContext(ctx)
+	if seed.TenantID != tenantID {
+		return nil, domain.ErrProductNotFound
+	}
+
+	// 2. Ask the recommender
+	ids, err := q.recommender.Recommend(ctx, seed, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to recommend products: %w", err)
+	}
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+	if len(ids) == 0 {
+		return &DTO{}, nil
+	}
+
+	// 3. Read the recommended products and restore the recommender's order
+	dtos, err := q.readModel.BatchGetProducts(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get products: %w", err)
+	}
+	byID := make(map[string]*get_product.DTO, len(dtos))
+	for _, dto := range dtos {
+		if dto.ID != seed.ID && dto.TenantID == tenantID && dto.Status == string(domain.ProductStatusActive) && dto.ArchivedAt == nil {
+			byID[dto.ID] = dto
+		}
+	}
+
+	now := q.clock.Now()
+	products := make([]*get_product.DTO, 0, len(ids))
+	for _, id := range ids {
+		dto, ok := byID[id]
+		if !ok {
+			continue
+		}
+		// Recommenders may repeat an ID; show each product once
+		delete(byID, id)
+		dto.EffectivePrice, dto.MapApplied = get_product.EffectivePrice(dto, q.calculator, now)
+		products = append(products, dto)
+	}
+	return &DTO{Products: products}, nil
+}
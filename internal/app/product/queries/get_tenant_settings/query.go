@@ -0,0 +1,51 @@
+package get_tenant_settings
+
+import (
+	"context"
+	"fmt"
+
+	"catalog-proj/internal/app/product/domain"
+)
+
+// SettingsSource provides the tenant's stored overrides
+type SettingsSource interface {
+	Load(ctx context.Context) (*domain.TenantSettings, error)
+}
+
+// DTO represents the data transfer object for get tenant settings query result
+type DTO struct {
+	Overrides *domain.TenantSettings // nil when the tenant uses the service defaults
+	Effective domain.TenantSettings
+}
+
+// Query handles the get tenant settings query
+type Query struct {
+	settings SettingsSource
+	defaults domain.TenantSettings
+}
+
+// NewQuery creates a new get tenant settings query
+func NewQuery(settings SettingsSource, defaults domain.TenantSettings) *Query {
+	return &Query{
+		settings: settings,
+		defaults: defaults,
+	}
+}
+
+// Execute returns the caller's tenant settings as stored and as applied
+// It reads the store directly, so it reflects changes made on other servers at once
+func (q *Query) Execute(ctx context.Context) (*DTO, error) {
+	overrides, err := q.settings.Load(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load tenant settings: %w", err)
+	}
+	dto := &DTO{
+		Overrides: overrides,
+		Effective: q.defaults,
+	}
+	if overrides != nil {
+		dto.Effective = overrides.WithDefaults(q.defaults)
+		dto.Effective.UpdatedAt = overrides.UpdatedAt
+	}
+	return dto, nil
+}
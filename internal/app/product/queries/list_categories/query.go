@@ -0,0 +1,117 @@
+package list_categories
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Separator nests categories: "electronics/laptops" is shown under "electronics"
+const Separator = "/"
+
+// Request represents the input for listing the category tree
+type Request struct {
+	// IncludeEmpty keeps categories whose products are all inactive
+	IncludeEmpty bool
+}
+
+// CategoryCount is the number of a tenant's unarchived products filed under one category
+type CategoryCount struct {
+	Category       string
+	ActiveProducts int64
+}
+
+// CountSource counts the caller's tenant's products per category
+type CountSource interface {
+	// CountByCategory returns every category with unarchived products and how many of them are active
+	CountByCategory(ctx context.Context) ([]CategoryCount, error)
+}
+
+// Category is a node of the category tree
+type Category struct {
+	Name string // Last path segment, e.g. "laptops"
+	Path string // The category products are filed under, e.g. "electronics/laptops"
+	// ActiveProducts counts the active products filed under Path itself, 0 for a parent no product uses
+	ActiveProducts int64
+	// TotalActiveProducts adds the active products of every descendant
+	TotalActiveProducts int64
+	Children            []Category // By name
+}
+
+// DTO represents the data transfer object for list categories query result
+type DTO struct {
+	Categories []Category // Top-level categories by name
+}
+
+// Query handles the list categories query
+type Query struct {
+	source CountSource
+}
+
+// NewQuery creates a new list categories query
+func NewQuery(source CountSource) *Query {
+	return &Query{
+		source: source,
+	}
+}
+
+// Execute returns the caller's tenant's categories as a tree annotated with active product counts
+// Counts are read live, so they reflect every committed write
+func (q *Query) Execute(ctx context.Context, req *Request) (*DTO, error) {
+	counts, err := q.source.CountByCategory(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count products by category: %w", err)
+	}
+
+	root := &node{}
+	for _, count := range counts {
+		if count.ActiveProducts == 0 && !req.IncludeEmpty {
+			continue
+		}
+		n := root
+		segments := strings.Split(count.Category, Separator)
+		for i, segment := range segments {
+			n = n.child(segment, strings.Join(segments[:i+1], Separator))
+			n.total += count.ActiveProducts
+		}
+		n.active += count.ActiveProducts
+	}
+	return &DTO{Categories: root.tree()}, nil
+}
+
+// node is a category while the tree is built
+type node struct {
+	name, path    string
+	active, total int64
+	children      map[string]*node
+}
+
+// child returns the named child, adding it when missing
+func (n *node) child(name, path string) *node {
+	if n.children == nil {
+		n.children = make(map[string]*node)
+	}
+	c, ok := n.children[name]
+	if !ok {
+		c = &node{name: name, path: path}
+		n.children[name] = c
+	}
+	return c
+}
+
+// tree converts the node's children to categories sorted by name
+func (n *node) tree() []Category {
+	out := make([]Category, 0, len(n.children))
+	for _, c := range n.children {
+		out = append(out, Category{
+			Name:                c.name,
+			Path:                c.path,
+			ActiveProducts:      c.active,
+			TotalActiveProducts: c.total,
+			Children:            c.tree(),
+		})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
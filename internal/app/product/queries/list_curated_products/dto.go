@@ -0,0 +1,31 @@
+package list_curated_products
+
+import (
+	"time"
+
+	"catalog-proj/internal/app/product/queries/get_product"
+)
+
+// Curated lists
+const (
+	ListNewArrivals = "new_arrivals"
+	ListTrending    = "trending"
+)
+
+// Request represents the request for one of the tenant's curated lists
+type Request struct {
+	List  string
+	Limit int
+}
+
+// Entry is one product of a curated list as stored by the refresh job
+type Entry struct {
+	ProductID  string
+	ComputedAt time.Time
+}
+
+// DTO represents the data transfer object for list curated products query result
+type DTO struct {
+	Products   []*get_product.DTO // In list order, with effective prices
+	ComputedAt *time.Time         // When the list was last refreshed; nil if it never was
+}
@@ -0,0 +1,98 @@
+package list_curated_products
+
+import (
+	"context"
+	"fmt"
+
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/domain/services"
+	"catalog-proj/internal/app/product/queries/get_product"
+	"catalog-proj/internal/pkg/clock"
+	"catalog-proj/internal/pkg/tenant"
+)
+
+// defaultLimit caps the number of products when the request does not set one
+const defaultLimit = 20
+
+// EntrySource reads the caller's tenant's curated lists
+type EntrySource interface {
+	// Entries returns up to limit entries of the list in position order
+	Entries(ctx context.Context, list string, limit int) ([]Entry, error)
+}
+
+// ReadModel defines the interface for batch reading products (to avoid import cycle)
+type ReadModel interface {
+	BatchGetProducts(ctx context.Context, ids []string) ([]*get_product.DTO, error)
+}
+
+// Query handles the list curated products query
+// Lists are computed by the curated lists job; products deactivated or archived since are skipped
+type Query struct {
+	entries    EntrySource
+	readModel  ReadModel
+	calculator *services.PricingCalculator
+	clock      clock.Clock
+}
+
+// NewQuery creates a new list curated products query
+func NewQuery(
+	entries EntrySource,
+	readModel ReadModel,
+	calculator *services.PricingCalculator,
+	clock clock.Clock,
+) *Query {
+	return &Query{
+		entries:    entries,
+		readModel:  readModel,
+		calculator: calculator,
+		clock:      clock,
+	}
+}
+
+// Execute returns the active products of the requested list in list order
+func (q *Query) Execute(ctx context.Context, req *Request) (*DTO, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+
+	// 1. Read the list
+	entries, err := q.entries.Entries(ctx, req.List, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read curated list: %w", err)
+	}
+	if len(entries) == 0 {
+		return &DTO{}, nil
+	}
+	ids := make([]string, len(entries))
+	for i, entry := range entries {
+		ids[i] = entry.ProductID
+	}
+
+	// 2. Read the products and restore list order
+	dtos, err := q.readModel.BatchGetProducts(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get products: %w", err)
+	}
+	byID := make(map[string]*get_product.DTO, len(dtos))
+	tenantID := tenant.FromContext(ctx)
+	for _, dto := range dtos {
+		if dto.TenantID == tenantID && dto.Status == string(domain.ProductStatusActive) && dto.ArchivedAt == nil {
+			byID[dto.ID] = dto
+		}
+	}
+
+	now := q.clock.Now()
+	products := make([]*get_product.DTO, 0, len(ids))
+	for _, id := range ids {
+		dto, ok := byID[id]
+		if !ok {
+			continue
+		}
+		dto.EffectivePrice, dto.MapApplied = get_product.EffectivePrice(dto, q.calculator, now)
+		products = append(products, dto)
+	}
+
+	computedAt := entries[0].ComputedAt
+	return &DTO{Products: products, ComputedAt: &computedAt}, nil
+}
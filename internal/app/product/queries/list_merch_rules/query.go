@@ -0,0 +1,39 @@
+package list_merch_rules
+
+import (
+	"context"
+	"fmt"
+
+	"catalog-proj/internal/app/product/domain"
+)
+
+// RuleSource provides the tenant's merchandising rules
+type RuleSource interface {
+	List(ctx context.Context) ([]domain.MerchRule, error)
+}
+
+// DTO represents the data transfer object for list merchandising rules query result
+type DTO struct {
+	Rules []domain.MerchRule // Oldest first
+}
+
+// Query handles the list merchandising rules query
+type Query struct {
+	rules RuleSource
+}
+
+// NewQuery creates a new list merchandising rules query
+func NewQuery(rules RuleSource) *Query {
+	return &Query{
+		rules: rules,
+	}
+}
+
+// Execute returns every rule of the caller's tenant
+func (q *Query) Execute(ctx context.Context) (*DTO, error) {
+	rules, err := q.rules.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list merchandising rules: %w", err)
+	}
+	return &DTO{Rules: rules}, nil
+}
@@ -0,0 +1,53 @@
+package list_product_versions
+
+import (
+	"context"
+	"fmt"
+
+	"catalog-proj/internal/app/product/domain"
+)
+
+// ProductLoader loads the product to check it exists for the caller's tenant (to avoid import cycle)
+type ProductLoader interface {
+	Load(ctx context.Context, id string) (*domain.Product, error)
+}
+
+// Reader lists a product's content versions (to avoid import cycle)
+type Reader interface {
+	List(ctx context.Context, productID string) ([]*domain.ContentVersion, error)
+}
+
+// DTO lists a product's content versions newest first; the first one is the current content
+type DTO struct {
+	ProductID string
+	Versions  []*domain.ContentVersion
+}
+
+// Query handles the list product versions query
+// Products created before versioning was introduced have no versions until their content first changes
+type Query struct {
+	products ProductLoader
+	reader   Reader
+}
+
+// NewQuery creates a new list product versions query
+func NewQuery(products ProductLoader, reader Reader) *Query {
+	return &Query{
+		products: products,
+		reader:   reader,
+	}
+}
+
+// Execute returns every content version recorded for the product
+func (q *Query) Execute(ctx context.Context, productID string) (*DTO, error) {
+	// Load enforces tenant ownership, so other tenants' products are not found
+	if _, err := q.products.Load(ctx, productID); err != nil {
+		return nil, fmt.Errorf("failed to load product: %w", err)
+	}
+
+	versions, err := q.reader.List(ctx, productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list product versions: %w", err)
+	}
+	return &DTO{ProductID: productID, Versions: versions}, nil
+}
@@ -3,29 +3,88 @@ package list_products
 import (
 	"math/big"
 	"time"
+
+	"catalog-proj/internal/app/product/domain"
+)
+
+// List orders
+const (
+	OrderByCreatedAt          = "created_at"           // Newest first (the default)
+	OrderByPopularity         = "popularity"           // Most viewed first, newest first among equals
+	OrderByEffectivePrice     = "effective_price"      // Cheapest first, newest first among equals
+	OrderByEffectivePriceDesc = "effective_price_desc" // Dearest first, newest first among equals
+)
+
+// Views select how much of each product is read
+const (
+	ViewFull  = "full"  // Every field (the default)
+	ViewBasic = "basic" // Identity, status, channels and prices only, for storefront grids
 )
 
 // Request represents the request parameters for listing products
 type Request struct {
+	TenantID string
 	Category string
 	Status   string
-	Limit    int
-	Offset   int
+	Channel  string // Only products visible on this channel ("" for all)
+	// MetadataKey and MetadataValue only return products whose metadata has this entry ("" for all)
+	MetadataKey   string
+	MetadataValue string
+	// Compliance filters exclude restricted products, e.g. for a market or channel that cannot sell them
+	MaxAgeRestriction   *int // Only products whose age restriction is at most this
+	ExcludeHazardous    bool
+	ExcludePrescription bool
+	Limit               int
+	Offset              int
+	// SkipTotal skips the COUNT(*) query; Total is then 0 and HasMore tells whether a next page exists
+	SkipTotal bool
+	// ApproximateTotal reads Total from the periodically refreshed per-category counts instead of
+	// counting; those ignore the channel, compliance and effective price filters
+	ApproximateTotal bool
+	// OrderBy is OrderByCreatedAt ("" is the same), OrderByPopularity, OrderByEffectivePrice or OrderByEffectivePriceDesc
+	OrderBy string
+	// MinEffectivePrice and MaxEffectivePrice only return products whose effective price is within them (nil for no bound)
+	// They and the effective price orders use the price stored on write and kept current by the effective price
+	// refresh, so a discount that just started or ended may be a refresh interval late
+	MinEffectivePrice *big.Rat
+	MaxEffectivePrice *big.Rat
+	// EnforceMAP is filled in by the query for the read model: effective prices are then compared as shown,
+	// raised to the minimum advertised price
+	EnforceMAP bool
+	// View is ViewFull ("" is the same) or ViewBasic, which only reads the ID, name, category, SKU,
+	// prices, discount, status, channels, product type and timestamps; other item fields stay zero
+	View string
+	// AllowFullScan lifts the read model's scan guard, for admin exports that page through a whole tenant
+	AllowFullScan bool
 }
 
 // ProductItem represents a single product in the list
 type ProductItem struct {
 	ID                string
+	TenantID          string
 	Name              string
 	Description       string
+	DescriptionFormat domain.DescriptionFormat
 	Category          string
+	SKU               string
+	GTIN              string
 	BasePrice         *big.Rat
-	EffectivePrice    *big.Rat // Calculated price after discount
+	EffectivePrice    *big.Rat // Calculated price after discount, raised to the MAP when MapApplied
+	MapApplied        bool     // The minimum advertised price is shown in place of a lower effective price
 	DiscountID        *string
 	DiscountAmount    *big.Rat
 	DiscountStartDate *time.Time
 	DiscountEndDate   *time.Time
 	Status            string
+	LegalHold         bool
+	Channels          []string
+	ProductType       domain.ProductType
+	Shipping          domain.ShippingDetails
+	DigitalDelivery   domain.DigitalDelivery
+	Compliance        domain.Compliance
+	Metadata          domain.Metadata
+	Attributes        domain.Attributes
+	PriceFloor        domain.PriceFloor
 	ArchivedAt        *time.Time
 	CreatedAt         time.Time
 	UpdatedAt         time.Time
@@ -34,5 +93,8 @@ type ProductItem struct {
 // DTO represents the data transfer object for list products query result
 type DTO struct {
 	Products []ProductItem
-	Total    int
+	Total    int  // 0 when the request set SkipTotal
+	HasMore  bool // More products follow this page
+	// TotalApproximate is set when Total came from the cached counts
+	TotalApproximate bool
 }
@@ -3,6 +3,9 @@ package list_products
 import (
 	"context"
 	"fmt"
+	"runtime"
+	"sync"
+	"time"
 
 	"catalog-proj/internal/app/product/domain"
 	"catalog-proj/internal/app/product/domain/services"
@@ -14,11 +17,29 @@ type ReadModel interface {
 	ListProducts(ctx context.Context, req *Request) (*DTO, error)
 }
 
+// PageLimits bounds how many products a single list request returns
+type PageLimits struct {
+	Default int // Used when the request has no limit
+	Max     int // Larger limits are rejected
+}
+
+// PageSizeError reports a limit above the maximum page size
+type PageSizeError struct {
+	Limit int
+	Max   int
+}
+
+func (e *PageSizeError) Error() string {
+	return fmt.Sprintf("limit %d exceeds the maximum page size of %d", e.Limit, e.Max)
+}
+
 // Query handles the list products query use case
 type Query struct {
 	readModel  ReadModel
 	calculator *services.PricingCalculator
 	clock      clock.Clock
+	limits     PageLimits
+	workers    int
 }
 
 // NewQuery creates a new list products query
@@ -26,16 +47,32 @@ func NewQuery(
 	readModel ReadModel,
 	calculator *services.PricingCalculator,
 	clock clock.Clock,
+	limits PageLimits,
+	workers int,
 ) *Query {
 	return &Query{
 		readModel:  readModel,
 		calculator: calculator,
 		clock:      clock,
+		limits:     limits,
+		workers:    workers,
 	}
 }
 
 // Execute retrieves a list of products and calculates effective prices
+// Pages of at least 2*minProductsPerWorker products are priced by up to workers goroutines (0 uses GOMAXPROCS)
+// A request without a limit gets the default page size rather than the whole catalog
 func (q *Query) Execute(ctx context.Context, req *Request) (*DTO, error) {
+	if req.Limit > q.limits.Max {
+		return nil, &PageSizeError{Limit: req.Limit, Max: q.limits.Max}
+	}
+	paged := *req
+	if paged.Limit <= 0 {
+		paged.Limit = q.limits.Default
+	}
+	paged.EnforceMAP = q.calculator.EnforcesMAP()
+	req = &paged
+
 	// 1. Call read model with filters
 	dto, err := q.readModel.ListProducts(ctx, req)
 	if err != nil {
@@ -43,60 +80,108 @@ func (q *Query) Execute(ctx context.Context, req *Request) (*DTO, error) {
 	}
 
 	// 2. Calculate effective prices for each product
-	now := q.clock.Now()
-	for i := range dto.Products {
-		product := &dto.Products[i]
-		
-		// Reconstruct domain product from database data (queries should use ReconstructProduct, not NewProduct)
-		var basePrice *domain.Money
-		if product.BasePrice != nil {
-			price := domain.Money(product.BasePrice)
-			basePrice = &price
+	q.priceAll(dto.Products, q.clock.Now())
+
+	// 3. Return paginated DTO
+	return dto, nil
+}
+
+// minProductsPerWorker keeps goroutine start-up negligible next to the pricing work, so pages
+// smaller than two chunks are priced serially
+const minProductsPerWorker = 128
+
+// priceAll calculates the effective prices of a page, splitting large pages into contiguous chunks
+// priced concurrently; products are independent, so every chunk is owned by one goroutine
+func (q *Query) priceAll(products []ProductItem, now time.Time) {
+	workers := q.workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	workers = min(workers, len(products)/minProductsPerWorker)
+	if workers < 2 {
+		for i := range products {
+			q.price(&products[i], now)
 		}
-		
-		var discount *domain.Discount
-		if product.DiscountID != nil && product.DiscountStartDate != nil && product.DiscountEndDate != nil {
-			var discountAmount *domain.Money
-			if product.DiscountAmount != nil {
-				amount := domain.Money(product.DiscountAmount)
-				discountAmount = &amount
-			}
-			
-			discount = &domain.Discount{
-				ID:        *product.DiscountID,
-				Amount:    discountAmount,
-				StartDate: *product.DiscountStartDate,
-				EndDate:   *product.DiscountEndDate,
+		return
+	}
+
+	chunk := (len(products) + workers - 1) / workers
+	var wg sync.WaitGroup
+	for start := 0; start < len(products); start += chunk {
+		part := products[start:min(start+chunk, len(products))]
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range part {
+				q.price(&part[i], now)
 			}
+		}()
+	}
+	wg.Wait()
+}
+
+// price reconstructs the domain product behind a list item and sets its effective price
+func (q *Query) price(product *ProductItem, now time.Time) {
+	// Reconstruct domain product from database data (queries should use ReconstructProduct, not NewProduct)
+	var basePrice *domain.Money
+	if product.BasePrice != nil {
+		price := domain.Money(product.BasePrice)
+		basePrice = &price
+	}
+
+	var discount *domain.Discount
+	if product.DiscountID != nil && product.DiscountStartDate != nil && product.DiscountEndDate != nil {
+		var discountAmount *domain.Money
+		if product.DiscountAmount != nil {
+			amount := domain.Money(product.DiscountAmount)
+			discountAmount = &amount
 		}
-		
-		status := domain.ProductStatus(product.Status)
-		if status != domain.ProductStatusActive && status != domain.ProductStatusInactive {
-			status = domain.ProductStatusInactive
-		}
-		
-		domainProduct := domain.ReconstructProduct(
-			product.ID,
-			product.Name,
-			product.Description,
-			product.Category,
-			basePrice,
-			discount,
-			status,
-			product.ArchivedAt,
-			product.CreatedAt,
-			product.UpdatedAt,
-		)
-		
-		// Calculate effective price
-		effectivePricePtr := q.calculator.CalculateEffectivePrice(domainProduct, now)
-		if effectivePricePtr != nil {
-			product.EffectivePrice = *effectivePricePtr
-		} else if product.BasePrice != nil {
-			product.EffectivePrice = product.BasePrice
+
+		discount = &domain.Discount{
+			ID:        *product.DiscountID,
+			Amount:    discountAmount,
+			StartDate: *product.DiscountStartDate,
+			EndDate:   *product.DiscountEndDate,
 		}
 	}
 
-	// 3. Return paginated DTO
-	return dto, nil
+	status := domain.ProductStatus(product.Status)
+	if status != domain.ProductStatusActive && status != domain.ProductStatusInactive {
+		status = domain.ProductStatusInactive
+	}
+
+	domainProduct := domain.ReconstructProduct(
+		product.ID,
+		product.TenantID,
+		product.Name,
+		product.Description,
+		product.DescriptionFormat,
+		product.Category,
+		product.SKU,
+		product.GTIN,
+		basePrice,
+		discount,
+		status,
+		product.LegalHold,
+		domain.ChannelsFromStrings(product.Channels),
+		product.ProductType,
+		product.Shipping,
+		product.DigitalDelivery,
+		product.Compliance,
+		product.Metadata,
+		product.Attributes,
+		product.PriceFloor,
+		product.ArchivedAt,
+		product.CreatedAt,
+		product.UpdatedAt,
+	)
+
+	// Calculate effective price
+	effectivePricePtr, mapApplied := q.calculator.CalculateDisplayPrice(domainProduct, now)
+	product.MapApplied = mapApplied
+	if effectivePricePtr != nil {
+		product.EffectivePrice = *effectivePricePtr
+	} else if product.BasePrice != nil {
+		product.EffectivePrice = product.BasePrice
+	}
 }
@@ -0,0 +1,61 @@
+package search_products
+
+import (
+	"math/big"
+	"time"
+)
+
+// Search orders
+const (
+	OrderByRelevance          = "relevance"            // Best first (the default)
+	OrderByEffectivePrice     = "effective_price"      // Cheapest first, then best first
+	OrderByEffectivePriceDesc = "effective_price_desc" // Dearest first, then best first
+)
+
+// Request represents a free-text search within a tenant's catalog
+type Request struct {
+	TenantID string
+	Query    string
+	Limit    int
+	// OrderBy is OrderByRelevance ("" is the same), OrderByEffectivePrice or OrderByEffectivePriceDesc
+	OrderBy string
+	// MinEffectivePrice and MaxEffectivePrice only match products whose effective price is within them
+	// (nil for no bound), compared as stored, like the list_products filters
+	MinEffectivePrice *big.Rat
+	MaxEffectivePrice *big.Rat
+	// Terms are the query's words with their accepted spellings, filled in by the query for the read model
+	Terms []Term
+	// EnforceMAP is filled in by the query for the read model, as for list_products
+	EnforceMAP bool
+}
+
+// ByEffectivePrice reports whether the request orders or filters by effective price
+func (r *Request) ByEffectivePrice() bool {
+	return r.OrderBy == OrderByEffectivePrice || r.OrderBy == OrderByEffectivePriceDesc ||
+		r.MinEffectivePrice != nil || r.MaxEffectivePrice != nil
+}
+
+// Term is one word of a search query and the words that match it
+type Term struct {
+	Word     string
+	Synonyms []string // From the synonym table
+	MaxEdits int      // Words within this many typos of Word also match; 0 for exact matches only
+}
+
+// Hit represents an active product returned by a search
+type Hit struct {
+	ID          string
+	Name        string
+	Description string
+	Category    string
+	Score       float64 // Relevance after boosts; pinned products that do not match score 0
+	Pinned      bool    // Placed by a pin rule rather than by relevance
+	CreatedAt   time.Time
+	// EffectivePrice is the stored effective price the read model ordered by; nil unless ordered by effective price
+	EffectivePrice *big.Rat
+}
+
+// DTO represents the data transfer object for search products query result
+type DTO struct {
+	Hits []Hit // Best first
+}
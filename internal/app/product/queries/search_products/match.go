@@ -0,0 +1,107 @@
+package search_products
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Words shorter than minFuzzyLength must match exactly; from longFuzzyLength on, two typos are tolerated
+const (
+	minFuzzyLength  = 4
+	longFuzzyLength = 8
+)
+
+// maxEdits returns the typos tolerated in a query word
+func maxEdits(word string) int {
+	switch n := len([]rune(word)); {
+	case n < minFuzzyLength:
+		return 0
+	case n < longFuzzyLength:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// Trigrams returns the distinct three-letter substrings of a word, which the read model
+// uses to find candidates for typo-tolerant terms
+func Trigrams(word string) []string {
+	runes := []rune(word)
+	seen := make(map[string]bool)
+	var trigrams []string
+	for i := 0; i+3 <= len(runes); i++ {
+		trigram := string(runes[i : i+3])
+		if !seen[trigram] {
+			seen[trigram] = true
+			trigrams = append(trigrams, trigram)
+		}
+	}
+	return trigrams
+}
+
+// matchWeight reports how well text matches a term: 1 when it contains the word or a synonym,
+// 0.5 when one of its words is within the term's typo allowance, 0 otherwise
+func matchWeight(text string, term Term) float64 {
+	if strings.Contains(text, term.Word) {
+		return 1
+	}
+	for _, synonym := range term.Synonyms {
+		if strings.Contains(text, synonym) {
+			return 1
+		}
+	}
+	if term.MaxEdits == 0 {
+		return 0
+	}
+	words := strings.FieldsFunc(text, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-' })
+	for _, word := range words {
+		if withinEdits(word, term.Word, term.MaxEdits) {
+			return 0.5
+		}
+	}
+	return 0
+}
+
+// withinEdits reports whether a and b are at most max insertions, deletions, substitutions
+// or adjacent transpositions apart (optimal string alignment distance)
+func withinEdits(a, b string, max int) bool {
+	ra, rb := []rune(a), []rune(b)
+	if abs(len(ra)-len(rb)) > max {
+		return false
+	}
+
+	// Three rolling rows: the one before previous is needed for transpositions
+	prev2 := make([]int, len(rb)+1)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		rowMin := curr[0]
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				curr[j] = min(curr[j], prev2[j-2]+1)
+			}
+			rowMin = min(rowMin, curr[j])
+		}
+		if rowMin > max {
+			return false
+		}
+		prev2, prev, curr = prev, curr, prev2
+	}
+	return prev[len(rb)] <= max
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
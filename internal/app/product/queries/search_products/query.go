@@ -0,0 +1,251 @@
+package search_products
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/domain/services"
+	"catalog-proj/internal/app/product/queries/get_product"
+	"catalog-proj/internal/pkg/featureflags"
+)
+
+const (
+	// defaultLimit caps the number of hits when the request does not set one
+	defaultLimit = 20
+	// candidateLimit bounds the matching products read for ranking
+	candidateLimit = 500
+	// Relevance earned by each query term found in a product's name or description; typo matches earn half
+	nameTermScore        = 2
+	descriptionTermScore = 1
+)
+
+// ReadModel defines the interface for searching products (to avoid import cycle)
+type ReadModel interface {
+	// SearchProducts returns up to Limit active products that may match every one of Terms, unscored
+	// Requests by effective price get the cheapest (or dearest) candidates within the bounds, with EffectivePrice set
+	SearchProducts(ctx context.Context, req *Request) (*DTO, error)
+	BatchGetProducts(ctx context.Context, ids []string) ([]*get_product.DTO, error)
+}
+
+// RuleSource provides the tenant's merchandising rules
+type RuleSource interface {
+	List(ctx context.Context) ([]domain.MerchRule, error)
+}
+
+// SynonymSource expands a normalized query word to its synonyms
+type SynonymSource interface {
+	Synonyms(word string) []string
+}
+
+// Flags decides per-tenant rollouts of search behavior
+type Flags interface {
+	Enabled(ctx context.Context, name string) bool
+}
+
+// Query handles the search products query
+// Matching products are ranked by relevance, boosted per category, then pinned products take their positions
+type Query struct {
+	readModel  ReadModel
+	calculator *services.PricingCalculator
+	rules      RuleSource
+	synonyms   SynonymSource
+	flags      Flags
+}
+
+// NewQuery creates a new search products query
+// For tenants with the search_fuzzy flag, query words also match words a typo or two away
+// The calculator only decides whether effective prices are ordered and filtered as raised to the MAP
+func NewQuery(readModel ReadModel, calculator *services.PricingCalculator, rules RuleSource, synonyms SynonymSource, flags Flags) *Query {
+	return &Query{
+		readModel:  readModel,
+		calculator: calculator,
+		rules:      rules,
+		synonyms:   synonyms,
+		flags:      flags,
+	}
+}
+
+// Execute returns the tenant's active products matching the query, best first
+func (q *Query) Execute(ctx context.Context, req *Request) (*DTO, error) {
+	query := domain.NormalizeSearchQuery(req.Query)
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	if query == "" {
+		return &DTO{}, nil
+	}
+
+	// 1. Read matching candidates and the rules that curate them
+	terms := q.terms(query, q.flags.Enabled(ctx, featureflags.SearchFuzzy))
+	candidates, err := q.readModel.SearchProducts(ctx, &Request{
+		TenantID:          req.TenantID,
+		Query:             query,
+		Limit:             candidateLimit,
+		OrderBy:           req.OrderBy,
+		MinEffectivePrice: req.MinEffectivePrice,
+		MaxEffectivePrice: req.MaxEffectivePrice,
+		Terms:             terms,
+		EnforceMAP:        q.calculator.EnforcesMAP(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search products: %w", err)
+	}
+	allRules, err := q.rules.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load merchandising rules: %w", err)
+	}
+	var boosts, pins []domain.MerchRule
+	for _, rule := range allRules {
+		if !rule.AppliesTo(query) {
+			continue
+		}
+		if rule.Kind == domain.MerchRuleKindPin {
+			pins = append(pins, rule)
+		} else {
+			boosts = append(boosts, rule)
+		}
+	}
+
+	// 2. Score by relevance and boosts, dropping candidates a term does not match, then order them
+	hits := candidates.Hits[:0]
+	for _, hit := range candidates.Hits {
+		score, ok := relevance(&hit, terms)
+		if !ok {
+			continue
+		}
+		hit.Score = score
+		for _, boost := range boosts {
+			if hit.Category == boost.Category {
+				hit.Score *= boost.Boost
+			}
+		}
+		hits = append(hits, hit)
+	}
+	sort.SliceStable(hits, func(i, j int) bool {
+		return less(req.OrderBy, &hits[i], &hits[j])
+	})
+
+	// 3. Place pinned products; with price bounds, only those within them
+	hits, err = q.applyPins(ctx, req.TenantID, hits, pins, req.MinEffectivePrice == nil && req.MaxEffectivePrice == nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return &DTO{Hits: hits}, nil
+}
+
+// less orders hits by effective price when asked to, products without a price last, and otherwise
+// by score; newest first on ties
+func less(orderBy string, a, b *Hit) bool {
+	if orderBy == OrderByEffectivePrice || orderBy == OrderByEffectivePriceDesc {
+		switch {
+		case a.EffectivePrice == nil && b.EffectivePrice != nil:
+			return false
+		case a.EffectivePrice != nil && b.EffectivePrice == nil:
+			return true
+		case a.EffectivePrice != nil:
+			if c := a.EffectivePrice.Cmp(b.EffectivePrice); c != 0 {
+				return (c < 0) == (orderBy == OrderByEffectivePrice)
+			}
+		}
+	}
+	if a.Score != b.Score {
+		return a.Score > b.Score
+	}
+	return a.CreatedAt.After(b.CreatedAt)
+}
+
+// applyPins moves pinned products to their positions, lowest position first; the older pin wins a contested slot
+// Pinned products that do not match the query are read separately and skipped unless active, or left out
+// entirely unless readMissing
+func (q *Query) applyPins(ctx context.Context, tenantID string, hits []Hit, pins []domain.MerchRule, readMissing bool) ([]Hit, error) {
+	if len(pins) == 0 {
+		return hits, nil
+	}
+	sort.SliceStable(pins, func(i, j int) bool { return pins[i].Position < pins[j].Position })
+
+	pinned := make(map[string]Hit, len(pins))
+	var missing []string
+	for _, pin := range pins {
+		if _, ok := pinned[pin.ProductID]; ok {
+			continue
+		}
+		found := false
+		for i, hit := range hits {
+			if hit.ID == pin.ProductID {
+				pinned[pin.ProductID] = hit
+				hits = append(hits[:i], hits[i+1:]...)
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, pin.ProductID)
+		}
+	}
+	if len(missing) > 0 && readMissing {
+		dtos, err := q.readModel.BatchGetProducts(ctx, missing)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read pinned products: %w", err)
+		}
+		for _, dto := range dtos {
+			if dto.TenantID != tenantID || dto.Status != string(domain.ProductStatusActive) || dto.ArchivedAt != nil {
+				continue
+			}
+			pinned[dto.ID] = Hit{ID: dto.ID, Name: dto.Name, Description: dto.Description, Category: dto.Category, CreatedAt: dto.CreatedAt}
+		}
+	}
+
+	// A pin whose slot an earlier pin took goes right after it
+	next := 0
+	for _, pin := range pins {
+		hit, ok := pinned[pin.ProductID]
+		if !ok {
+			continue
+		}
+		delete(pinned, pin.ProductID)
+		hit.Pinned = true
+		position := max(int(pin.Position)-1, next)
+		if position > len(hits) {
+			position = len(hits)
+		}
+		hits = append(hits[:position], append([]Hit{hit}, hits[position:]...)...)
+		next = position + 1
+	}
+	return hits, nil
+}
+
+// terms splits a normalized query into words with their synonyms and, with fuzzy, their typo allowance
+func (q *Query) terms(query string, fuzzy bool) []Term {
+	words := strings.Fields(query)
+	terms := make([]Term, 0, len(words))
+	for _, word := range words {
+		term := Term{Word: word, Synonyms: q.synonyms.Synonyms(word)}
+		if fuzzy {
+			term.MaxEdits = maxEdits(word)
+		}
+		terms = append(terms, term)
+	}
+	return terms
+}
+
+// relevance scores how well a product matches the query terms; ok is false unless every term matches
+func relevance(hit *Hit, terms []Term) (score float64, ok bool) {
+	name := strings.ToLower(hit.Name)
+	description := strings.ToLower(hit.Description)
+	for _, term := range terms {
+		termScore := matchWeight(name, term)*nameTermScore + matchWeight(description, term)*descriptionTermScore
+		if termScore == 0 {
+			return 0, false
+		}
+		score += termScore
+	}
+	return score, true
+}
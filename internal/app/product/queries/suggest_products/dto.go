@@ -0,0 +1,26 @@
+package suggest_products
+
+// Suggestion kinds
+const (
+	KindName     = "name"
+	KindCategory = "category"
+)
+
+// Request represents a search-as-you-type request within a tenant's catalog
+type Request struct {
+	TenantID string
+	Prefix   string
+	Limit    int
+}
+
+// Suggestion is a product name or category starting with the prefix
+type Suggestion struct {
+	Text       string
+	Kind       string
+	Popularity int64 // Active products carrying the text
+}
+
+// DTO represents the data transfer object for suggest products query result
+type DTO struct {
+	Suggestions []Suggestion // Most popular first
+}
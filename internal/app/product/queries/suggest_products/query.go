@@ -0,0 +1,54 @@
+package suggest_products
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"catalog-proj/internal/app/product/domain"
+)
+
+// defaultLimit caps the number of suggestions when the request does not set one
+const defaultLimit = 10
+
+// SuggestionSource reads the suggestions projection
+type SuggestionSource interface {
+	// Suggest returns up to Limit suggestions of the tenant whose key starts with Prefix, most popular first
+	Suggest(ctx context.Context, req *Request) (*DTO, error)
+}
+
+// Query handles the suggest products query
+// Suggestions come from a projection refreshed by a job, so new products appear after the next refresh
+type Query struct {
+	source SuggestionSource
+}
+
+// NewQuery creates a new suggest products query
+func NewQuery(source SuggestionSource) *Query {
+	return &Query{
+		source: source,
+	}
+}
+
+// Execute returns the tenant's most popular names and categories starting with the prefix
+// The prefix is normalized like a search query; a trailing space is kept so "desk " completes words after "desk"
+func (q *Query) Execute(ctx context.Context, req *Request) (*DTO, error) {
+	prefix := domain.NormalizeSearchQuery(req.Prefix)
+	if prefix == "" {
+		return &DTO{}, nil
+	}
+	if strings.TrimRightFunc(req.Prefix, unicode.IsSpace) != req.Prefix {
+		prefix += " "
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+
+	dto, err := q.source.Suggest(ctx, &Request{TenantID: req.TenantID, Prefix: prefix, Limit: limit})
+	if err != nil {
+		return nil, fmt.Errorf("failed to suggest products: %w", err)
+	}
+	return dto, nil
+}
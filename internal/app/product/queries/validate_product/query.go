@@ -0,0 +1,169 @@
+package validate_product
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/domain/services"
+	"catalog-proj/internal/pkg/clock"
+	"catalog-proj/internal/pkg/tenant"
+)
+
+// ProductLoader loads the product whose changes are validated (to avoid import cycle)
+type ProductLoader interface {
+	Load(ctx context.Context, id string) (*domain.Product, error)
+}
+
+// NameLookup finds products holding a normalized name (to avoid import cycle)
+type NameLookup interface {
+	FindByName(ctx context.Context, tenantID, category, nameKey, excludeID string) (string, error)
+}
+
+// Request describes a new product, or changes to ProductID when set; nil fields keep stored values
+type Request struct {
+	ProductID         string
+	Name              *string
+	Description       *string
+	DescriptionFormat *domain.DescriptionFormat
+	Category          *string
+	BasePrice         *domain.Money
+	SKU               *string
+	GTIN              *string
+}
+
+// DTO lists every violation; an empty list means the product would be accepted
+type DTO struct {
+	Violations []domain.RuleViolation
+}
+
+// Query dry-runs the validation done by create and update without committing
+// Quotas and duplicate detection are not evaluated since they depend on the moment of the write
+type Query struct {
+	products   ProductLoader
+	names      NameLookup
+	namePolicy *services.UniqueNamePolicy
+	rules      *services.ValidationRules
+	clock      clock.Clock
+}
+
+// NewQuery creates a new validate product query
+func NewQuery(
+	products ProductLoader,
+	names NameLookup,
+	namePolicy *services.UniqueNamePolicy,
+	rules *services.ValidationRules,
+	clock clock.Clock,
+) *Query {
+	return &Query{
+		products:   products,
+		names:      names,
+		namePolicy: namePolicy,
+		rules:      rules,
+		clock:      clock,
+	}
+}
+
+// Execute returns all built-in, category rule and unique name violations of the product
+func (q *Query) Execute(ctx context.Context, req *Request) (*DTO, error) {
+	tenantID := tenant.FromContext(ctx)
+
+	// 1. Start from the stored product when validating changes
+	var name, description, category, sku, gtin string
+	var basePrice *domain.Money
+	descriptionFormat := domain.DescriptionFormatPlain
+	if req.ProductID != "" {
+		existing, err := q.products.Load(ctx, req.ProductID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load product: %w", err)
+		}
+		if existing.ArchivedAt() != nil {
+			return nil, domain.ErrProductAlreadyArchived
+		}
+		name, description, category = existing.Name(), existing.Description(), existing.Category()
+		sku, gtin, basePrice = existing.SKU(), existing.GTIN(), existing.BasePrice()
+		descriptionFormat = existing.DescriptionFormat()
+	}
+	overlay(&name, req.Name)
+	overlay(&description, req.Description)
+	overlay(&category, req.Category)
+	overlay(&sku, req.SKU)
+	overlay(&gtin, req.GTIN)
+	if req.BasePrice != nil {
+		basePrice = req.BasePrice
+	}
+	if req.DescriptionFormat != nil {
+		descriptionFormat = *req.DescriptionFormat
+	}
+
+	// 2. Built-in field checks
+	dto := &DTO{}
+	dto.add("name", checkText(name, 255))
+	dto.add("description", checkText(description, 1000))
+	dto.add("category", checkText(category, 100))
+	if err := domain.ValidateDescriptionFormat(descriptionFormat, description); errors.Is(err, domain.ErrInvalidDescriptionFormat) {
+		dto.add("description_format", domain.ErrInvalidDescriptionFormat.Message)
+	} else if err != nil {
+		dto.add("description", domain.ErrInvalidMarkdown.Message)
+	}
+	if basePrice == nil {
+		dto.add("base_price", "is required")
+	} else if (*big.Rat)(*basePrice).Sign() <= 0 {
+		dto.add("base_price", "must be positive")
+	}
+	if err := domain.ValidateSKU(sku); err != nil {
+		dto.add("sku", domain.ErrInvalidSKU.Message)
+	}
+	if err := domain.ValidateGTIN(gtin); err != nil {
+		dto.add("gtin", domain.ErrInvalidGTIN.Message)
+	}
+
+	// 3. Category rules, evaluated on the product as it would be stored
+	// The draft is reconstructed rather than created so invalid fields still reach the rules
+	now := q.clock.Now()
+	product := domain.ReconstructProduct(req.ProductID, tenantID, name, description, descriptionFormat, category, sku, gtin, basePrice, nil, domain.ProductStatusInactive, false, nil, domain.ProductTypePhysical, domain.ShippingDetails{}, domain.DigitalDelivery{}, domain.Compliance{}, nil, nil, domain.PriceFloor{}, nil, now, now)
+	dto.Violations = append(dto.Violations, q.rules.Check(product)...)
+
+	// 4. Unique names, for tenants that enforce them
+	product.EnforceUniqueName(q.namePolicy != nil && q.namePolicy.Enforced(tenantID))
+	if nameKey := product.NameKey(); nameKey != "" && category != "" {
+		conflictID, err := q.names.FindByName(ctx, tenantID, category, nameKey, req.ProductID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check product name: %w", err)
+		}
+		if conflictID != "" {
+			dto.add("name", fmt.Sprintf("is already used by product %s in this category", conflictID))
+		}
+	}
+
+	return dto, nil
+}
+
+// add records a violation unless description is empty
+func (d *DTO) add(field, description string) {
+	if description != "" {
+		d.Violations = append(d.Violations, domain.RuleViolation{Field: field, Description: description})
+	}
+}
+
+// overlay replaces *value with the trimmed override when one is given
+func overlay(value *string, override *string) {
+	if override != nil {
+		*value = strings.TrimSpace(*override)
+	}
+}
+
+// checkText describes why a required text field is invalid ("" when it is valid)
+func checkText(value string, maxLength int) string {
+	if value == "" {
+		return "is required"
+	}
+	// Byte length, matching the limits applied by CreateProduct and UpdateProduct
+	if len(value) > maxLength {
+		return fmt.Sprintf("must be at most %d characters", maxLength)
+	}
+	return ""
+}
@@ -0,0 +1,82 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"catalog-proj/internal/models/m_product_alias"
+	"catalog-proj/internal/pkg/tenant"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+)
+
+// SpannerAliasStore implements AliasStore using Spanner
+type SpannerAliasStore struct {
+	client *spanner.Client
+}
+
+// NewSpannerAliasStore creates a new Spanner alias store
+func NewSpannerAliasStore(client *spanner.Client) *SpannerAliasStore {
+	return &SpannerAliasStore{
+		client: client,
+	}
+}
+
+// AliasMuts records alias → canonicalID and repoints the aliases that targeted alias
+func (s *SpannerAliasStore) AliasMuts(ctx context.Context, tenantID, alias, canonicalID string, now time.Time) ([]*spanner.Mutation, error) {
+	record := &m_product_alias.ProductAlias{
+		TenantID:    tenantID,
+		Alias:       alias,
+		CanonicalID: canonicalID,
+		CreatedAt:   now,
+	}
+	mutations := []*spanner.Mutation{record.InsertOrUpdateMut()}
+
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(`SELECT %s FROM %s WHERE %s = @canonical AND %s = @tenant`,
+			m_product_alias.Alias, m_product_alias.TableName,
+			m_product_alias.CanonicalID, m_product_alias.TenantID),
+		Params: map[string]interface{}{"canonical": alias, "tenant": tenantID},
+	}
+
+	iter := s.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to find aliases: %w", err)
+		}
+
+		existing := &m_product_alias.ProductAlias{TenantID: tenantID}
+		if err := row.Columns(&existing.Alias); err != nil {
+			return nil, fmt.Errorf("failed to parse alias: %w", err)
+		}
+		mutations = append(mutations, existing.RepointMut(canonicalID))
+	}
+	return mutations, nil
+}
+
+// Resolve returns the canonical product an alias of the caller's tenant points at
+func (s *SpannerAliasStore) Resolve(ctx context.Context, alias string) (string, error) {
+	key := spanner.Key{tenant.FromContext(ctx), alias}
+	row, err := s.client.Single().ReadRow(ctx, m_product_alias.TableName, key, []string{m_product_alias.CanonicalID})
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to read alias: %w", err)
+	}
+
+	var canonicalID string
+	if err := row.Columns(&canonicalID); err != nil {
+		return "", fmt.Errorf("failed to parse alias: %w", err)
+	}
+	return canonicalID, nil
+}
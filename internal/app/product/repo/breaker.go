@@ -0,0 +1,205 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/app/product/queries/find_similar_products"
+	"catalog-proj/internal/app/product/queries/get_product"
+	"catalog-proj/internal/app/product/queries/list_products"
+	"catalog-proj/internal/app/product/queries/search_products"
+	"catalog-proj/internal/pkg/breaker"
+	"catalog-proj/internal/pkg/clock"
+	"catalog-proj/internal/pkg/lru"
+	"catalog-proj/internal/pkg/metrics"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
+)
+
+// StaleCacheOptions configures serving last-known-good results while the breaker is open
+type StaleCacheOptions struct {
+	Enabled    bool
+	MaxAge     time.Duration
+	MaxEntries int
+}
+
+// staleEntry is a cached read model result with the time it was fetched
+type staleEntry[T any] struct {
+	value     T
+	fetchedAt time.Time
+}
+
+// BreakerReadModel fails read model calls fast with breaker.ErrOpen when Spanner is degraded,
+// optionally serving stale cached results instead
+type BreakerReadModel struct {
+	inner   contracts.ReadModel
+	breaker *breaker.Breaker
+	clock   clock.Clock
+	stale   StaleCacheOptions
+
+	products *lru.Cache[string, staleEntry[*get_product.DTO]]
+	lists    *lru.Cache[listKey, staleEntry[*list_products.DTO]]
+}
+
+// listKey is a list request as a stale cache key
+// Pointer fields are replaced by the values they point to, so equal requests share an entry
+type listKey struct {
+	req               list_products.Request
+	maxAgeRestriction int
+	hasMaxAge         bool
+	// Effective price bounds as exact fractions, "" when unset
+	minEffectivePrice string
+	maxEffectivePrice string
+}
+
+// newListKey returns the cache key of a list request
+func newListKey(req *list_products.Request) listKey {
+	key := listKey{req: *req}
+	key.req.MaxAgeRestriction = nil
+	if req.MaxAgeRestriction != nil {
+		key.maxAgeRestriction, key.hasMaxAge = *req.MaxAgeRestriction, true
+	}
+	key.req.MinEffectivePrice, key.req.MaxEffectivePrice = nil, nil
+	if req.MinEffectivePrice != nil {
+		key.minEffectivePrice = req.MinEffectivePrice.RatString()
+	}
+	if req.MaxEffectivePrice != nil {
+		key.maxEffectivePrice = req.MaxEffectivePrice.RatString()
+	}
+	return key
+}
+
+// NewBreakerReadModel wraps a read model with a circuit breaker
+func NewBreakerReadModel(inner contracts.ReadModel, b *breaker.Breaker, clock clock.Clock, stale StaleCacheOptions) *BreakerReadModel {
+	rm := &BreakerReadModel{
+		inner:   inner,
+		breaker: b,
+		clock:   clock,
+		stale:   stale,
+	}
+	if stale.Enabled {
+		rm.products = lru.New[string, staleEntry[*get_product.DTO]](stale.MaxEntries)
+		rm.lists = lru.New[listKey, staleEntry[*list_products.DTO]](stale.MaxEntries)
+	}
+	return rm
+}
+
+// GetProduct retrieves a single product through the breaker
+func (r *BreakerReadModel) GetProduct(ctx context.Context, id string) (*get_product.DTO, error) {
+	var dto *get_product.DTO
+	err := r.breaker.Execute(func() error {
+		var err error
+		dto, err = r.inner.GetProduct(ctx, id)
+		return err
+	}, isBackendFailure)
+
+	if err == nil {
+		if r.products != nil {
+			r.products.Add(id, staleEntry[*get_product.DTO]{value: dto, fetchedAt: r.clock.Now()})
+		}
+		return dto, nil
+	}
+	if r.products != nil && isBackendFailure(err) {
+		if cached, ok := r.products.Get(id); ok && r.fresh(cached.fetchedAt) {
+			metrics.Labeled("read_model_stale_served_total").Add("get", 1)
+			return cached.value, nil
+		}
+	}
+	return nil, err
+}
+
+// ListProducts retrieves a page of products through the breaker
+func (r *BreakerReadModel) ListProducts(ctx context.Context, req *list_products.Request) (*list_products.DTO, error) {
+	var dto *list_products.DTO
+	err := r.breaker.Execute(func() error {
+		var err error
+		dto, err = r.inner.ListProducts(ctx, req)
+		return err
+	}, isBackendFailure)
+
+	if err == nil {
+		if r.lists != nil {
+			r.lists.Add(newListKey(req), staleEntry[*list_products.DTO]{value: copyListDTO(dto), fetchedAt: r.clock.Now()})
+		}
+		return dto, nil
+	}
+	if r.lists != nil && isBackendFailure(err) {
+		if cached, ok := r.lists.Get(newListKey(req)); ok && r.fresh(cached.fetchedAt) {
+			metrics.Labeled("read_model_stale_served_total").Add("list", 1)
+			return copyListDTO(cached.value), nil
+		}
+	}
+	return nil, err
+}
+
+// BatchGetProducts retrieves several products through the breaker (no stale fallback)
+func (r *BreakerReadModel) BatchGetProducts(ctx context.Context, ids []string) ([]*get_product.DTO, error) {
+	var dtos []*get_product.DTO
+	err := r.breaker.Execute(func() error {
+		var err error
+		dtos, err = r.inner.BatchGetProducts(ctx, ids)
+		return err
+	}, isBackendFailure)
+	return dtos, err
+}
+
+// FindSimilarProducts retrieves similar products through the breaker
+// Duplicate checks must see current data, so stale results are never served
+func (r *BreakerReadModel) FindSimilarProducts(ctx context.Context, req *find_similar_products.Request) (*find_similar_products.DTO, error) {
+	var dto *find_similar_products.DTO
+	err := r.breaker.Execute(func() error {
+		var err error
+		dto, err = r.inner.FindSimilarProducts(ctx, req)
+		return err
+	}, isBackendFailure)
+	return dto, err
+}
+
+// SearchProducts retrieves search candidates through the breaker (no stale fallback)
+func (r *BreakerReadModel) SearchProducts(ctx context.Context, req *search_products.Request) (*search_products.DTO, error) {
+	var dto *search_products.DTO
+	err := r.breaker.Execute(func() error {
+		var err error
+		dto, err = r.inner.SearchProducts(ctx, req)
+		return err
+	}, isBackendFailure)
+	return dto, err
+}
+
+// fresh reports whether a cached entry is still young enough to be served
+func (r *BreakerReadModel) fresh(fetchedAt time.Time) bool {
+	return r.stale.MaxAge <= 0 || r.clock.Now().Sub(fetchedAt) <= r.stale.MaxAge
+}
+
+// isBackendFailure reports whether err indicates Spanner itself is unhealthy
+// Client errors such as not-found or invalid arguments do not trip the breaker
+func isBackendFailure(err error) bool {
+	if err == breaker.ErrOpen {
+		return true
+	}
+	switch spanner.ErrCode(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Internal, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
+
+// copyListDTO returns a shallow copy of a cached page so callers can mutate product items
+// (the list query fills in effective prices in place)
+func copyListDTO(dto *list_products.DTO) *list_products.DTO {
+	if dto == nil {
+		return nil
+	}
+	products := make([]list_products.ProductItem, len(dto.Products))
+	copy(products, dto.Products)
+	return &list_products.DTO{
+		Products: products,
+		Total:    dto.Total,
+		HasMore:  dto.HasMore,
+
+		TotalApproximate: dto.TotalApproximate,
+	}
+}
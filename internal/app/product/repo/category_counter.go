@@ -0,0 +1,62 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/queries/list_categories"
+	"catalog-proj/internal/models/m_product"
+	"catalog-proj/internal/pkg/tenant"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+)
+
+// SpannerCategoryCounter implements list_categories.CountSource using Spanner
+type SpannerCategoryCounter struct {
+	client *spanner.Client
+}
+
+// NewSpannerCategoryCounter creates a new Spanner category counter
+func NewSpannerCategoryCounter(client *spanner.Client) *SpannerCategoryCounter {
+	return &SpannerCategoryCounter{
+		client: client,
+	}
+}
+
+// CountByCategory groups the tenant's unarchived products by category
+// idx_products_tenant_category covers the query, so the products table itself is not read
+func (c *SpannerCategoryCounter) CountByCategory(ctx context.Context) ([]list_categories.CategoryCount, error) {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(`SELECT category, COUNTIF(status = @active) AS active_products
+			FROM %s@{FORCE_INDEX=idx_products_tenant_category}
+			WHERE tenant_id = @tenant AND archived_at IS NULL
+			GROUP BY category`, m_product.TableName),
+		Params: map[string]interface{}{
+			"tenant": tenant.FromContext(ctx),
+			"active": string(domain.ProductStatusActive),
+		},
+	}
+
+	iter := c.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var counts []list_categories.CategoryCount
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to count products by category: %w", err)
+		}
+
+		var count list_categories.CategoryCount
+		if err := row.Columns(&count.Category, &count.ActiveProducts); err != nil {
+			return nil, fmt.Errorf("failed to parse category count: %w", err)
+		}
+		counts = append(counts, count)
+	}
+	return counts, nil
+}
@@ -0,0 +1,81 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"catalog-proj/internal/models/m_product"
+	"catalog-proj/internal/pkg/tenant"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+)
+
+// SpannerCategoryScanner implements CategoryScanner using Spanner
+type SpannerCategoryScanner struct {
+	client *spanner.Client
+}
+
+// NewSpannerCategoryScanner creates a new Spanner category scanner
+func NewSpannerCategoryScanner(client *spanner.Client) *SpannerCategoryScanner {
+	return &SpannerCategoryScanner{
+		client: client,
+	}
+}
+
+// ScanCategory reads a page of product IDs from idx_products_tenant_category
+func (s *SpannerCategoryScanner) ScanCategory(ctx context.Context, category, afterID string, limit int) ([]string, error) {
+	iter := s.client.Single().Query(ctx, spanner.Statement{
+		SQL: fmt.Sprintf(`SELECT product_id FROM %s@{FORCE_INDEX=idx_products_tenant_category}
+			WHERE tenant_id = @tenant AND category = @category AND archived_at IS NULL AND product_id > @after
+			ORDER BY product_id LIMIT @limit`, m_product.TableName),
+		Params: map[string]interface{}{
+			"tenant":   tenant.FromContext(ctx),
+			"category": category,
+			"after":    afterID,
+			"limit":    int64(limit),
+		},
+	})
+	defer iter.Stop()
+
+	var ids []string
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan category: %w", err)
+		}
+		var id string
+		if err := row.Columns(&id); err != nil {
+			return nil, fmt.Errorf("failed to parse product ID: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// CountCategory counts the products a scan starting after afterID would visit
+func (s *SpannerCategoryScanner) CountCategory(ctx context.Context, category, afterID string) (int64, error) {
+	iter := s.client.Single().Query(ctx, spanner.Statement{
+		SQL: fmt.Sprintf(`SELECT COUNT(*) FROM %s@{FORCE_INDEX=idx_products_tenant_category}
+			WHERE tenant_id = @tenant AND category = @category AND archived_at IS NULL AND product_id > @after`, m_product.TableName),
+		Params: map[string]interface{}{
+			"tenant":   tenant.FromContext(ctx),
+			"category": category,
+			"after":    afterID,
+		},
+	})
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count category: %w", err)
+	}
+	var count int64
+	if err := row.Columns(&count); err != nil {
+		return 0, fmt.Errorf("failed to parse product count: %w", err)
+	}
+	return count, nil
+}
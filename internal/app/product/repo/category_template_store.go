@@ -0,0 +1,104 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/models/m_category_template"
+	"catalog-proj/internal/pkg/tenant"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
+)
+
+// SpannerCategoryTemplateStore implements CategoryTemplateStore using Spanner
+type SpannerCategoryTemplateStore struct {
+	client *spanner.Client
+}
+
+// NewSpannerCategoryTemplateStore creates a new Spanner category template store
+func NewSpannerCategoryTemplateStore(client *spanner.Client) *SpannerCategoryTemplateStore {
+	return &SpannerCategoryTemplateStore{
+		client: client,
+	}
+}
+
+// attributeRecord is the stored JSON form of an attribute definition
+type attributeRecord struct {
+	Name          string   `json:"name"`
+	Type          string   `json:"type"`
+	Required      bool     `json:"required,omitempty"`
+	AllowedValues []string `json:"allowed_values,omitempty"`
+}
+
+// Load reads a template by primary key
+func (s *SpannerCategoryTemplateStore) Load(ctx context.Context, category string) (*domain.CategoryTemplate, error) {
+	key := spanner.Key{tenant.FromContext(ctx), category}
+	row, err := s.client.Single().ReadRow(ctx, m_category_template.TableName, key, m_category_template.AllColumns())
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, domain.ErrCategoryTemplateNotFound
+		}
+		return nil, fmt.Errorf("failed to load category template: %w", err)
+	}
+
+	model := &m_category_template.CategoryTemplate{}
+	if err := row.ToStruct(model); err != nil {
+		return nil, fmt.Errorf("failed to parse category template row: %w", err)
+	}
+	var records []attributeRecord
+	if err := json.Unmarshal([]byte(model.Attributes), &records); err != nil {
+		return nil, fmt.Errorf("failed to parse category template attributes: %w", err)
+	}
+
+	template := &domain.CategoryTemplate{
+		Category:   model.Category,
+		Attributes: make([]domain.AttributeDefinition, len(records)),
+		UpdatedAt:  model.UpdatedAt,
+	}
+	for i, r := range records {
+		template.Attributes[i] = domain.AttributeDefinition{
+			Name:          r.Name,
+			Type:          domain.AttributeType(r.Type),
+			Required:      r.Required,
+			AllowedValues: r.AllowedValues,
+		}
+	}
+	return template, nil
+}
+
+// UpsertMut inserts or replaces the template
+func (s *SpannerCategoryTemplateStore) UpsertMut(tenantID string, template domain.CategoryTemplate) (*spanner.Mutation, error) {
+	records := make([]attributeRecord, len(template.Attributes))
+	for i, d := range template.Attributes {
+		records[i] = attributeRecord{
+			Name:          d.Name,
+			Type:          string(d.Type),
+			Required:      d.Required,
+			AllowedValues: d.AllowedValues,
+		}
+	}
+	attributes, err := json.Marshal(records)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal category template attributes: %w", err)
+	}
+
+	record := &m_category_template.CategoryTemplate{
+		TenantID:   tenantID,
+		Category:   template.Category,
+		Attributes: string(attributes),
+		UpdatedAt:  template.UpdatedAt,
+	}
+	return record.UpsertMut(), nil
+}
+
+// DeleteMut deletes the template
+func (s *SpannerCategoryTemplateStore) DeleteMut(tenantID, category string) *spanner.Mutation {
+	record := &m_category_template.CategoryTemplate{
+		TenantID: tenantID,
+		Category: category,
+	}
+	return record.DeleteMut()
+}
@@ -0,0 +1,70 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/queries/get_product"
+	"catalog-proj/internal/models/m_product"
+	"catalog-proj/internal/models/m_product_view"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+)
+
+// SpannerCoCategoryRecommender implements the get recommendations Recommender using Spanner
+// It recommends the seed's category peers, most viewed first
+type SpannerCoCategoryRecommender struct {
+	client *spanner.Client
+}
+
+// NewSpannerCoCategoryRecommender creates a new Spanner co-category recommender
+func NewSpannerCoCategoryRecommender(client *spanner.Client) *SpannerCoCategoryRecommender {
+	return &SpannerCoCategoryRecommender{
+		client: client,
+	}
+}
+
+// Recommend returns up to limit other active products of the seed's tenant and category
+// Popularity comes from the view counters; unviewed products follow, newest first
+func (r *SpannerCoCategoryRecommender) Recommend(ctx context.Context, seed *get_product.DTO, limit int) ([]string, error) {
+	iter := r.client.Single().Query(ctx, spanner.Statement{
+		SQL: fmt.Sprintf(`
+			SELECT p.%[1]s
+			FROM %[2]s p
+			WHERE p.%[3]s = @tenant AND p.%[4]s = @category AND p.%[1]s != @seed
+				AND p.%[5]s = @active AND p.%[6]s IS NULL
+			ORDER BY COALESCE((SELECT v.%[7]s FROM %[8]s v WHERE v.%[9]s = p.%[1]s), 0) DESC, p.%[10]s DESC
+			LIMIT @limit`,
+			m_product.ProductID, m_product.TableName, m_product.TenantID, m_product.Category,
+			m_product.Status, m_product.ArchivedAt,
+			m_product_view.ViewCount, m_product_view.TableName, m_product_view.ProductID,
+			m_product.CreatedAt),
+		Params: map[string]interface{}{
+			"tenant":   seed.TenantID,
+			"category": seed.Category,
+			"seed":     seed.ID,
+			"active":   string(domain.ProductStatusActive),
+			"limit":    int64(limit),
+		},
+	})
+	defer iter.Stop()
+
+	var ids []string
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read co-category products: %w", err)
+		}
+		var id string
+		if err := row.Columns(&id); err != nil {
+			return nil, fmt.Errorf("failed to parse co-category product: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
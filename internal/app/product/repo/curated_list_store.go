@@ -0,0 +1,225 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/queries/list_curated_products"
+	"catalog-proj/internal/models/m_curated_list"
+	"catalog-proj/internal/models/m_product"
+	"catalog-proj/internal/models/m_product_trend"
+	"catalog-proj/internal/models/m_product_view"
+	"catalog-proj/internal/pkg/tenant"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+)
+
+// SpannerCuratedListStore implements CuratedListStore and the list curated products source using Spanner
+type SpannerCuratedListStore struct {
+	client *spanner.Client
+}
+
+// NewSpannerCuratedListStore creates a new Spanner curated list store
+func NewSpannerCuratedListStore(client *spanner.Client) *SpannerCuratedListStore {
+	return &SpannerCuratedListStore{
+		client: client,
+	}
+}
+
+// RefreshTrends decays each viewed product's score to now and adds the views counted since it was last scored
+// Products without new views keep their stored score; RefreshLists decays it when ranking
+func (s *SpannerCuratedListStore) RefreshTrends(ctx context.Context, halfLife time.Duration, now time.Time) (int, error) {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(`
+			SELECT v.%[1]s, v.%[2]s, v.%[3]s, t.%[4]s, t.%[5]s, t.%[6]s
+			FROM %[7]s v
+			LEFT JOIN %[8]s t ON t.%[9]s = v.%[1]s
+			WHERE t.%[9]s IS NULL OR t.%[5]s != v.%[3]s`,
+			m_product_view.ProductID, m_product_view.TenantID, m_product_view.ViewCount,
+			m_product_trend.Score, m_product_trend.ScoredViews, m_product_trend.ScoredAt,
+			m_product_view.TableName, m_product_trend.TableName, m_product_trend.ProductID),
+	}
+
+	// Read outside the write transaction; views landing meanwhile are picked up by the next run
+	iter := s.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var mutations []*spanner.Mutation
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to read product views: %w", err)
+		}
+
+		var (
+			trend       = &m_product_trend.ProductTrend{ScoredAt: now}
+			score       spanner.NullFloat64
+			scoredViews spanner.NullInt64
+			scoredAt    spanner.NullTime
+		)
+		if err := row.Columns(&trend.ProductID, &trend.TenantID, &trend.ScoredViews, &score, &scoredViews, &scoredAt); err != nil {
+			return 0, fmt.Errorf("failed to parse product views: %w", err)
+		}
+
+		// A count below the scored one means the views were reset; start over from the new count
+		added := trend.ScoredViews - scoredViews.Int64
+		if added < 0 {
+			trend.Score = float64(trend.ScoredViews)
+		} else {
+			trend.Score = decay(score.Float64, now.Sub(scoredAt.Time), halfLife) + float64(added)
+		}
+		mutations = append(mutations, trend.UpsertMut())
+	}
+
+	if len(mutations) == 0 {
+		return 0, nil
+	}
+	if _, err := s.client.Apply(ctx, mutations); err != nil {
+		return 0, fmt.Errorf("failed to store trending scores: %w", err)
+	}
+	return len(mutations), nil
+}
+
+// RefreshLists replaces every tenant's new arrivals and trending lists
+// The old lists are deleted in the same transaction, so products that dropped out disappear
+func (s *SpannerCuratedListStore) RefreshLists(ctx context.Context, size int, newSince time.Time, halfLife time.Duration, now time.Time) (int, error) {
+	mutations := []*spanner.Mutation{spanner.Delete(m_curated_list.TableName, spanner.AllKeys())}
+
+	// 1. New arrivals: newest active products first
+	newArrivals := spanner.Statement{
+		SQL: fmt.Sprintf(`
+			SELECT %[1]s, %[2]s, 0.0 AS score
+			FROM %[3]s
+			WHERE %[4]s = @active AND %[5]s IS NULL AND %[6]s >= @since
+			ORDER BY %[1]s, %[6]s DESC, %[2]s`,
+			m_product.TenantID, m_product.ProductID, m_product.TableName,
+			m_product.Status, m_product.ArchivedAt, m_product.CreatedAt),
+		Params: map[string]interface{}{
+			"active": string(domain.ProductStatusActive),
+			"since":  newSince,
+		},
+	}
+	entries, err := s.rankEntries(ctx, newArrivals, list_curated_products.ListNewArrivals, size, now)
+	if err != nil {
+		return 0, err
+	}
+	mutations = append(mutations, entries...)
+
+	// 2. Trending: active products by score decayed to now
+	trending := spanner.Statement{
+		SQL: fmt.Sprintf(`
+			SELECT t.%[1]s, t.%[2]s, t.%[3]s * POW(0.5, TIMESTAMP_DIFF(@now, t.%[4]s, SECOND) / @halfLife) AS score
+			FROM %[5]s t
+			JOIN %[6]s p ON p.%[7]s = t.%[2]s AND p.%[8]s = t.%[1]s
+			WHERE p.%[9]s = @active AND p.%[10]s IS NULL AND t.%[3]s > 0
+			ORDER BY t.%[1]s, score DESC, t.%[2]s`,
+			m_product_trend.TenantID, m_product_trend.ProductID, m_product_trend.Score, m_product_trend.ScoredAt,
+			m_product_trend.TableName, m_product.TableName, m_product.ProductID, m_product.TenantID,
+			m_product.Status, m_product.ArchivedAt),
+		Params: map[string]interface{}{
+			"active":   string(domain.ProductStatusActive),
+			"now":      now,
+			"halfLife": halfLife.Seconds(),
+		},
+	}
+	entries, err = s.rankEntries(ctx, trending, list_curated_products.ListTrending, size, now)
+	if err != nil {
+		return 0, err
+	}
+	mutations = append(mutations, entries...)
+
+	if _, err := s.client.Apply(ctx, mutations); err != nil {
+		return 0, fmt.Errorf("failed to store curated lists: %w", err)
+	}
+	return len(mutations) - 1, nil
+}
+
+// rankEntries reads (tenant, product, score) rows ordered by tenant and rank and keeps the first size per tenant
+func (s *SpannerCuratedListStore) rankEntries(ctx context.Context, stmt spanner.Statement, list string, size int, computedAt time.Time) ([]*spanner.Mutation, error) {
+	iter := s.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var (
+		mutations []*spanner.Mutation
+		tenantID  string
+		position  int64
+	)
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to rank %s: %w", list, err)
+		}
+
+		entry := &m_curated_list.CuratedListEntry{List: list, ComputedAt: computedAt}
+		if err := row.Columns(&entry.TenantID, &entry.ProductID, &entry.Score); err != nil {
+			return nil, fmt.Errorf("failed to parse %s entry: %w", list, err)
+		}
+		if entry.TenantID != tenantID {
+			tenantID, position = entry.TenantID, 0
+		}
+		if position >= int64(size) {
+			continue
+		}
+		entry.Position = position
+		position++
+		mutations = append(mutations, entry.InsertMut())
+	}
+	return mutations, nil
+}
+
+// Entries reads the first limit entries of the caller's tenant's list
+func (s *SpannerCuratedListStore) Entries(ctx context.Context, list string, limit int) ([]list_curated_products.Entry, error) {
+	iter := s.client.Single().Query(ctx, spanner.Statement{
+		SQL: fmt.Sprintf(`
+			SELECT %s, %s
+			FROM %s
+			WHERE %s = @tenant AND %s = @list
+			ORDER BY %s
+			LIMIT @limit`,
+			m_curated_list.ProductID, m_curated_list.ComputedAt,
+			m_curated_list.TableName,
+			m_curated_list.TenantID, m_curated_list.List,
+			m_curated_list.Position),
+		Params: map[string]interface{}{
+			"tenant": tenant.FromContext(ctx),
+			"list":   list,
+			"limit":  int64(limit),
+		},
+	})
+	defer iter.Stop()
+
+	var entries []list_curated_products.Entry
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read curated list: %w", err)
+		}
+		var entry list_curated_products.Entry
+		if err := row.Columns(&entry.ProductID, &entry.ComputedAt); err != nil {
+			return nil, fmt.Errorf("failed to parse curated list entry: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// decay halves score once per elapsed half-life
+func decay(score float64, elapsed, halfLife time.Duration) float64 {
+	if score == 0 || elapsed <= 0 {
+		return score
+	}
+	return score * math.Pow(0.5, elapsed.Seconds()/halfLife.Seconds())
+}
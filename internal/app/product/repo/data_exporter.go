@@ -0,0 +1,155 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/queries/export_product_data"
+	"catalog-proj/internal/models/m_outbox"
+	"catalog-proj/internal/models/m_product"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+)
+
+// SpannerDataExporter reads every stored record about a product for exports
+type SpannerDataExporter struct {
+	client *spanner.Client
+}
+
+// NewSpannerDataExporter creates a new Spanner data exporter
+func NewSpannerDataExporter(client *spanner.Client) *SpannerDataExporter {
+	return &SpannerDataExporter{
+		client: client,
+	}
+}
+
+// ReadProductData reads the product row and its outbox events in one read-only transaction
+// so the export reflects a single consistent timestamp
+func (e *SpannerDataExporter) ReadProductData(ctx context.Context, productID string) (*export_product_data.Data, error) {
+	txn := e.client.ReadOnlyTransaction()
+	defer txn.Close()
+
+	data := &export_product_data.Data{}
+
+	row, err := txn.ReadRow(ctx, m_product.TableName, spanner.Key{productID}, m_product.AllColumns())
+	switch {
+	case err == nil:
+		model := &m_product.Product{}
+		if err := row.ToStruct(model); err != nil {
+			return nil, fmt.Errorf("failed to parse product row: %w", err)
+		}
+		data.Product = modelToExportRecord(model)
+	case spanner.ErrCode(err) == codes.NotFound:
+		// Purged or never existed; events may still reference it
+	default:
+		return nil, fmt.Errorf("failed to read product: %w", err)
+	}
+
+	iter := txn.Query(ctx, spanner.Statement{
+		SQL: fmt.Sprintf(`SELECT event_id, event_type, status, payload, created_at, processed_at
+			FROM %s WHERE aggregate_id = @id ORDER BY created_at`, m_outbox.TableName),
+		Params: map[string]interface{}{"id": productID},
+	})
+	defer iter.Stop()
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read outbox events: %w", err)
+		}
+
+		var (
+			event       export_product_data.EventRecord
+			payload     spanner.NullJSON
+			processedAt spanner.NullTime
+		)
+		if err := row.Columns(&event.EventID, &event.EventType, &event.Status, &payload, &event.CreatedAt, &processedAt); err != nil {
+			return nil, fmt.Errorf("failed to parse outbox event: %w", err)
+		}
+		if payload.Valid {
+			raw, err := json.Marshal(payload.Value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode event payload: %w", err)
+			}
+			event.Payload = raw
+		}
+		if processedAt.Valid {
+			t := processedAt.Time
+			event.ProcessedAt = &t
+		}
+		data.Events = append(data.Events, event)
+	}
+
+	readAt, err := txn.Timestamp()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get read timestamp: %w", err)
+	}
+	data.ReadAt = readAt
+
+	return data, nil
+}
+
+// modelToExportRecord converts a database model to an export record
+func modelToExportRecord(model *m_product.Product) *export_product_data.ProductRecord {
+	record := &export_product_data.ProductRecord{
+		ID:                   model.ProductID,
+		TenantID:             model.TenantID,
+		Name:                 model.Name,
+		Description:          model.Description,
+		DescriptionFormat:    string(descriptionFormatFromModel(model.DescriptionFormat)),
+		Category:             model.Category,
+		SKU:                  stringValue(model.SKU),
+		GTIN:                 stringValue(model.GTIN),
+		DiscountID:           model.DiscountID,
+		DiscountStartDate:    model.DiscountStartDate,
+		DiscountEndDate:      model.DiscountEndDate,
+		Status:               model.Status,
+		LegalHold:            model.LegalHold,
+		Channels:             model.Channels,
+		ProductType:          string(productTypeFromModel(model.ProductType)),
+		DownloadURL:          stringValue(model.DownloadURL),
+		LicenseTerms:         stringValue(model.LicenseTerms),
+		AgeRestriction:       model.AgeRestriction,
+		Hazardous:            model.Hazardous,
+		RequiresPrescription: model.RequiresPrescription,
+		Metadata:             domain.MetadataFromEntries(model.Metadata),
+		Attributes:           domain.AttributesFromEntries(model.Attributes),
+		MinMarginPercent:     model.MinMarginPercent,
+		ArchivedAt:           model.ArchivedAt,
+		CreatedAt:            model.CreatedAt,
+		UpdatedAt:            model.UpdatedAt,
+	}
+	if model.BasePriceDenominator != 0 {
+		record.BasePrice = big.NewRat(model.BasePriceNumerator, model.BasePriceDenominator).RatString()
+	}
+	if model.DiscountAmount != nil {
+		record.DiscountAmount = model.DiscountAmount.RatString()
+	}
+	if model.FloorPrice != nil {
+		record.FloorPrice = model.FloorPrice.RatString()
+	}
+	if model.CostPrice != nil {
+		record.CostPrice = model.CostPrice.RatString()
+	}
+	if model.MapPrice != nil {
+		record.MapPrice = model.MapPrice.RatString()
+	}
+
+	// Shipping details are exported as stored, in the units they were given in
+	shipping := shippingFromModel(model)
+	record.ShippingClass = shipping.ShippingClass
+	if w := shipping.Weight; w != nil {
+		record.Weight = &export_product_data.Weight{Value: w.Value, Unit: string(w.Unit)}
+	}
+	if d := shipping.Dimensions; d != nil {
+		record.Dimensions = &export_product_data.Dimensions{Length: d.Length, Width: d.Width, Height: d.Height, Unit: string(d.Unit)}
+	}
+	return record
+}
@@ -0,0 +1,73 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/models/m_product_draft"
+	"catalog-proj/internal/pkg/tenant"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
+)
+
+// SpannerDraftStore implements DraftStore using Spanner
+type SpannerDraftStore struct {
+	client *spanner.Client
+}
+
+// NewSpannerDraftStore creates a new Spanner draft store
+func NewSpannerDraftStore(client *spanner.Client) *SpannerDraftStore {
+	return &SpannerDraftStore{
+		client: client,
+	}
+}
+
+// Load reads a product's draft; drafts of other tenants are reported as missing
+func (s *SpannerDraftStore) Load(ctx context.Context, productID string) (*domain.Draft, error) {
+	row, err := s.client.Single().ReadRow(ctx, m_product_draft.TableName, spanner.Key{productID}, m_product_draft.AllColumns())
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, domain.ErrDraftNotFound
+		}
+		return nil, fmt.Errorf("failed to load product draft: %w", err)
+	}
+
+	model := &m_product_draft.ProductDraft{}
+	if err := row.ToStruct(model); err != nil {
+		return nil, fmt.Errorf("failed to parse product draft row: %w", err)
+	}
+	if model.TenantID != tenant.FromContext(ctx) {
+		return nil, domain.ErrDraftNotFound
+	}
+	return &domain.Draft{
+		ProductID:   model.ProductID,
+		TenantID:    model.TenantID,
+		Name:        model.Name,
+		Description: model.Description,
+		Category:    model.Category,
+		Metadata:    domain.MetadataFromEntries(model.Metadata),
+		UpdatedAt:   model.UpdatedAt,
+	}, nil
+}
+
+// SaveMut stores the draft, replacing the product's earlier one
+func (s *SpannerDraftStore) SaveMut(draft *domain.Draft) *spanner.Mutation {
+	model := &m_product_draft.ProductDraft{
+		ProductID:   draft.ProductID,
+		TenantID:    draft.TenantID,
+		Name:        draft.Name,
+		Description: draft.Description,
+		Category:    draft.Category,
+		Metadata:    draft.Metadata.Entries(),
+		UpdatedAt:   draft.UpdatedAt,
+	}
+	return model.SaveMut()
+}
+
+// DeleteMut deletes the product's draft; deleting a missing draft is a no-op
+func (s *SpannerDraftStore) DeleteMut(productID string) *spanner.Mutation {
+	model := &m_product_draft.ProductDraft{ProductID: productID}
+	return model.DeleteMut()
+}
@@ -0,0 +1,78 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"catalog-proj/internal/models/m_product"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+)
+
+// SpannerEffectivePriceStore implements EffectivePriceStore using Spanner
+type SpannerEffectivePriceStore struct {
+	client *spanner.Client
+	// products maps rows to the domain and prices them exactly as a write would
+	products *SpannerProductRepository
+}
+
+// NewSpannerEffectivePriceStore creates a new Spanner effective price store
+func NewSpannerEffectivePriceStore(client *spanner.Client) *SpannerEffectivePriceStore {
+	return &SpannerEffectivePriceStore{
+		client:   client,
+		products: NewSpannerProductRepository(client),
+	}
+}
+
+// RefreshEffectivePrices reprices the products whose effective price expired, soonest expired first
+// Rows are read and rewritten in one transaction, so a concurrent price or discount change is never overwritten
+// Only the effective price columns change; the product's updated_at and version are left alone
+func (s *SpannerEffectivePriceStore) RefreshEffectivePrices(ctx context.Context, now time.Time, limit int) (int, error) {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(`SELECT %s FROM %s
+			WHERE effective_price_valid_until <= @now
+			ORDER BY effective_price_valid_until
+			LIMIT @limit`, buildColumnList(m_product.AllColumns()), m_product.TableName),
+		Params: map[string]interface{}{"now": now, "limit": int64(limit)},
+	}
+
+	var repriced int
+	_, err := s.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		repriced = 0
+		iter := txn.Query(ctx, stmt)
+		defer iter.Stop()
+
+		var mutations []*spanner.Mutation
+		for {
+			row, err := iter.Next()
+			if err == iterator.Done {
+				break
+			}
+			if err != nil {
+				return fmt.Errorf("failed to find expired effective prices: %w", err)
+			}
+
+			model := &m_product.Product{}
+			if err := row.ToStruct(model); err != nil {
+				return fmt.Errorf("failed to parse product row: %w", err)
+			}
+			product, err := s.products.modelToDomain(model)
+			if err != nil {
+				return err
+			}
+			model.EffectivePrice, model.EffectivePriceValidUntil = s.products.effectivePrice(product, now)
+			mutations = append(mutations, model.UpdateMut(append([]string{m_product.ProductID}, m_product.EffectivePriceColumns()...)))
+		}
+		if len(mutations) == 0 {
+			return nil
+		}
+		repriced = len(mutations)
+		return txn.BufferWrite(mutations)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to refresh effective prices: %w", err)
+	}
+	return repriced, nil
+}
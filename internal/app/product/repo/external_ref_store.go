@@ -0,0 +1,66 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/models/m_external_ref"
+	"catalog-proj/internal/pkg/tenant"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
+)
+
+// SpannerExternalRefStore implements ExternalRefStore using Spanner
+type SpannerExternalRefStore struct {
+	client *spanner.Client
+}
+
+// NewSpannerExternalRefStore creates a new Spanner external reference store
+func NewSpannerExternalRefStore(client *spanner.Client) *SpannerExternalRefStore {
+	return &SpannerExternalRefStore{
+		client: client,
+	}
+}
+
+// Lookup reads the product linked to ref by primary key
+func (s *SpannerExternalRefStore) Lookup(ctx context.Context, ref domain.ExternalRef) (string, error) {
+	key := spanner.Key{tenant.FromContext(ctx), ref.System, ref.ExternalID}
+	row, err := s.client.Single().ReadRow(ctx, m_external_ref.TableName, key, []string{m_external_ref.ProductID})
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to read external reference: %w", err)
+	}
+
+	var productID string
+	if err := row.Columns(&productID); err != nil {
+		return "", fmt.Errorf("failed to parse external reference: %w", err)
+	}
+	return productID, nil
+}
+
+// LinkMut inserts ref → productID
+func (s *SpannerExternalRefStore) LinkMut(tenantID string, ref domain.ExternalRef, productID string, now time.Time) *spanner.Mutation {
+	record := &m_external_ref.ExternalRef{
+		TenantID:   tenantID,
+		System:     ref.System,
+		ExternalID: ref.ExternalID,
+		ProductID:  productID,
+		CreatedAt:  now,
+	}
+	return record.InsertMut()
+}
+
+// UnlinkMut deletes ref
+func (s *SpannerExternalRefStore) UnlinkMut(tenantID string, ref domain.ExternalRef) *spanner.Mutation {
+	record := &m_external_ref.ExternalRef{
+		TenantID:   tenantID,
+		System:     ref.System,
+		ExternalID: ref.ExternalID,
+	}
+	return record.DeleteMut()
+}
@@ -0,0 +1,72 @@
+package repo
+
+import (
+	"context"
+	"errors"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/queries/find_similar_products"
+	"catalog-proj/internal/app/product/queries/get_product"
+	"catalog-proj/internal/app/product/queries/list_products"
+	"catalog-proj/internal/app/product/queries/search_products"
+	"catalog-proj/internal/pkg/faults"
+)
+
+// FaultyReadModel injects latency and failures into read model queries, for resilience testing
+// Only single-product lookups can be made to miss; the other queries get latency and transient errors
+type FaultyReadModel struct {
+	inner    contracts.ReadModel
+	injector *faults.Injector
+}
+
+// NewFaultyReadModel wraps a read model with fault injection
+func NewFaultyReadModel(inner contracts.ReadModel, injector *faults.Injector) *FaultyReadModel {
+	return &FaultyReadModel{
+		inner:    inner,
+		injector: injector,
+	}
+}
+
+// GetProduct retrieves a single product unless an injected fault fails it first
+func (r *FaultyReadModel) GetProduct(ctx context.Context, id string) (*get_product.DTO, error) {
+	if err := r.injector.Inject(ctx, "read_model.get", true); err != nil {
+		if errors.Is(err, faults.ErrNotFound) {
+			return nil, domain.ErrProductNotFound
+		}
+		return nil, err
+	}
+	return r.inner.GetProduct(ctx, id)
+}
+
+// BatchGetProducts retrieves several products unless an injected fault fails it first
+func (r *FaultyReadModel) BatchGetProducts(ctx context.Context, ids []string) ([]*get_product.DTO, error) {
+	if err := r.injector.Inject(ctx, "read_model.batch_get", false); err != nil {
+		return nil, err
+	}
+	return r.inner.BatchGetProducts(ctx, ids)
+}
+
+// ListProducts retrieves a page of products unless an injected fault fails it first
+func (r *FaultyReadModel) ListProducts(ctx context.Context, req *list_products.Request) (*list_products.DTO, error) {
+	if err := r.injector.Inject(ctx, "read_model.list", false); err != nil {
+		return nil, err
+	}
+	return r.inner.ListProducts(ctx, req)
+}
+
+// FindSimilarProducts retrieves similar products unless an injected fault fails it first
+func (r *FaultyReadModel) FindSimilarProducts(ctx context.Context, req *find_similar_products.Request) (*find_similar_products.DTO, error) {
+	if err := r.injector.Inject(ctx, "read_model.find_similar", false); err != nil {
+		return nil, err
+	}
+	return r.inner.FindSimilarProducts(ctx, req)
+}
+
+// SearchProducts retrieves search candidates unless an injected fault fails it first
+func (r *FaultyReadModel) SearchProducts(ctx context.Context, req *search_products.Request) (*search_products.DTO, error) {
+	if err := r.injector.Inject(ctx, "read_model.search", false); err != nil {
+		return nil, err
+	}
+	return r.inner.SearchProducts(ctx, req)
+}
@@ -0,0 +1,65 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"catalog-proj/internal/app/product/queries/get_product_history"
+	"catalog-proj/internal/models/m_outbox"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+)
+
+// SpannerHistoryReader reads a product's events from the outbox for its history
+type SpannerHistoryReader struct {
+	client *spanner.Client
+}
+
+// NewSpannerHistoryReader creates a new Spanner history reader
+func NewSpannerHistoryReader(client *spanner.Client) *SpannerHistoryReader {
+	return &SpannerHistoryReader{
+		client: client,
+	}
+}
+
+// ReadProductEvents reads every outbox event for the product, oldest first
+// Published events are kept in the outbox, so history is complete until the product is purged
+// The outbox is keyed by (aggregate_id, created_at, event_id), so this reads one key range in order
+func (r *SpannerHistoryReader) ReadProductEvents(ctx context.Context, productID string) ([]get_product_history.Event, error) {
+	iter := r.client.Single().Query(ctx, spanner.Statement{
+		SQL: fmt.Sprintf(`SELECT event_id, event_type, payload, created_at
+			FROM %s WHERE aggregate_id = @id ORDER BY created_at, event_id`, m_outbox.TableName),
+		Params: map[string]interface{}{"id": productID},
+	})
+	defer iter.Stop()
+
+	var events []get_product_history.Event
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read outbox events: %w", err)
+		}
+
+		var (
+			event   get_product_history.Event
+			payload spanner.NullJSON
+		)
+		if err := row.Columns(&event.EventID, &event.EventType, &payload, &event.OccurredAt); err != nil {
+			return nil, fmt.Errorf("failed to parse outbox event: %w", err)
+		}
+		if payload.Valid {
+			raw, err := json.Marshal(payload.Value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode event payload: %w", err)
+			}
+			event.Payload = raw
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
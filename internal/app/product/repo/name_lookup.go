@@ -0,0 +1,56 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"catalog-proj/internal/models/m_product"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+)
+
+// SpannerNameLookup implements NameLookup on the products table
+type SpannerNameLookup struct {
+	client *spanner.Client
+}
+
+// NewSpannerNameLookup creates a new Spanner name lookup
+func NewSpannerNameLookup(client *spanner.Client) *SpannerNameLookup {
+	return &SpannerNameLookup{
+		client: client,
+	}
+}
+
+// FindByName matches on name_key, and on the name itself for products written before
+// the tenant enabled uniqueness; the SQL normalization mirrors domain.NormalizeName
+func (l *SpannerNameLookup) FindByName(ctx context.Context, tenantID, category, nameKey, excludeID string) (string, error) {
+	iter := l.client.Single().Query(ctx, spanner.Statement{
+		SQL: fmt.Sprintf(`SELECT product_id FROM %s
+			WHERE tenant_id = @tenant AND category = @category AND archived_at IS NULL
+			AND product_id != @exclude
+			AND (name_key = @key OR TRIM(REGEXP_REPLACE(LOWER(name), r'\s+', ' '), ' ') = @key)
+			ORDER BY created_at
+			LIMIT 1`, m_product.TableName),
+		Params: map[string]interface{}{
+			"tenant":   tenantID,
+			"category": category,
+			"key":      nameKey,
+			"exclude":  excludeID,
+		},
+	})
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err == iterator.Done {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to look up product name: %w", err)
+	}
+	var id string
+	if err := row.Columns(&id); err != nil {
+		return "", fmt.Errorf("failed to read product id: %w", err)
+	}
+	return id, nil
+}
@@ -0,0 +1,153 @@
+package repo
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"time"
+
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/queries/get_product"
+	"catalog-proj/internal/app/product/queries/search_products"
+	"catalog-proj/internal/pkg/opensearch"
+)
+
+// openSearchMapping is the index definition; a changed mapping needs a new index name and a reindex
+//
+//go:embed opensearch_mapping.json
+var openSearchMapping []byte
+
+// searchDocument is the indexed form of a product
+type searchDocument struct {
+	ProductID   string    `json:"product_id"`
+	TenantID    string    `json:"tenant_id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Category    string    `json:"category"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// searchResponse is the part of an OpenSearch search response the read model uses
+type searchResponse struct {
+	Hits struct {
+		Hits []struct {
+			Source searchDocument `json:"_source"`
+		} `json:"hits"`
+	} `json:"hits"`
+}
+
+// OpenSearchIndex keeps active products in an OpenSearch index and matches search candidates against it
+// It serves as the search_products read model; pinned products are still read from products
+type OpenSearchIndex struct {
+	client   *opensearch.Client
+	products search_products.ReadModel
+}
+
+// NewOpenSearchIndex creates a search index on client; products serves BatchGetProducts
+func NewOpenSearchIndex(client *opensearch.Client, products search_products.ReadModel) *OpenSearchIndex {
+	return &OpenSearchIndex{
+		client:   client,
+		products: products,
+	}
+}
+
+// EnsureIndex creates the index with this repo's mapping unless it exists
+func (s *OpenSearchIndex) EnsureIndex(ctx context.Context) error {
+	if err := s.client.EnsureIndex(ctx, openSearchMapping); err != nil {
+		return fmt.Errorf("failed to ensure search index: %w", err)
+	}
+	return nil
+}
+
+// Upsert indexes the product under its ID
+func (s *OpenSearchIndex) Upsert(ctx context.Context, product *get_product.DTO) error {
+	err := s.client.Put(ctx, product.ID, searchDocument{
+		ProductID:   product.ID,
+		TenantID:    product.TenantID,
+		Name:        product.Name,
+		Description: product.Description,
+		Category:    product.Category,
+		CreatedAt:   product.CreatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to index product %s: %w", product.ID, err)
+	}
+	return nil
+}
+
+// Delete removes the product's document
+func (s *OpenSearchIndex) Delete(ctx context.Context, productID string) error {
+	if err := s.client.Delete(ctx, productID); err != nil {
+		return fmt.Errorf("failed to remove product %s from the index: %w", productID, err)
+	}
+	return nil
+}
+
+// SearchProducts retrieves indexed products of the tenant whose name or description matches every
+// term, one of its synonyms, or (for typo-tolerant terms) a word within MaxEdits of it
+// Only active products are indexed; the caller rescores the candidates the same way as for Spanner
+// Effective prices are not indexed, so requests by effective price fail with ErrEffectivePriceSearchUnavailable
+func (s *OpenSearchIndex) SearchProducts(ctx context.Context, req *search_products.Request) (*search_products.DTO, error) {
+	if req.ByEffectivePrice() {
+		return nil, domain.ErrEffectivePriceSearchUnavailable
+	}
+	var must []map[string]any
+	for _, term := range req.Terms {
+		should := []map[string]any{matchWords(term.Word, term.MaxEdits)}
+		for _, synonym := range term.Synonyms {
+			should = append(should, matchWords(synonym, 0))
+		}
+		must = append(must, map[string]any{
+			"bool": map[string]any{"should": should, "minimum_should_match": 1},
+		})
+	}
+	if len(must) == 0 {
+		return &search_products.DTO{}, nil
+	}
+
+	query := map[string]any{
+		"size": req.Limit,
+		"query": map[string]any{
+			"bool": map[string]any{
+				"filter": []map[string]any{{"term": map[string]any{"tenant_id": req.TenantID}}},
+				"must":   must,
+			},
+		},
+		"sort": []any{"_score", map[string]any{"created_at": "desc"}},
+	}
+	var resp searchResponse
+	if err := s.client.Search(ctx, query, &resp); err != nil {
+		return nil, fmt.Errorf("failed to search index: %w", err)
+	}
+
+	hits := make([]search_products.Hit, 0, len(resp.Hits.Hits))
+	for _, hit := range resp.Hits.Hits {
+		doc := hit.Source
+		hits = append(hits, search_products.Hit{
+			ID:          doc.ProductID,
+			Name:        doc.Name,
+			Description: doc.Description,
+			Category:    doc.Category,
+			CreatedAt:   doc.CreatedAt,
+		})
+	}
+	return &search_products.DTO{Hits: hits}, nil
+}
+
+// BatchGetProducts reads products from the primary read model
+func (s *OpenSearchIndex) BatchGetProducts(ctx context.Context, ids []string) ([]*get_product.DTO, error) {
+	return s.products.BatchGetProducts(ctx, ids)
+}
+
+// matchWords matches text containing every word of words in the name or description
+func matchWords(words string, maxEdits int) map[string]any {
+	match := map[string]any{
+		"query":    words,
+		"fields":   []string{"name", "description"},
+		"operator": "and",
+	}
+	if maxEdits > 0 {
+		match["fuzziness"] = maxEdits
+	}
+	return map[string]any{"multi_match": match}
+}
@@ -0,0 +1,114 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/models/m_outbox"
+	"catalog-proj/internal/models/m_outbox_cursor"
+	"catalog-proj/internal/models/m_processed_event"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+)
+
+// SpannerOutboxFeed reads outbox events a consumer has not yet recorded in processed_events
+// Reads are not tenant scoped; feeds serve background consumers only
+type SpannerOutboxFeed struct {
+	client *spanner.Client
+}
+
+// NewSpannerOutboxFeed creates a new Spanner outbox feed
+func NewSpannerOutboxFeed(client *spanner.Client) *SpannerOutboxFeed {
+	return &SpannerOutboxFeed{client: client}
+}
+
+// Unprocessed returns the oldest events without a processed_events row for the consumer
+// Reads start at the consumer's cursor rather than the oldest event, and go through
+// idx_outbox_by_aggregate_shard_created with a seek per shard. Events committed after the cursor
+// always have a later commit timestamp, so none are skipped
+func (f *SpannerOutboxFeed) Unprocessed(ctx context.Context, consumer string, limit int) ([]contracts.FeedEvent, error) {
+	after, err := f.cursor(ctx, consumer)
+	if err != nil {
+		return nil, err
+	}
+
+	iter := f.client.Single().Query(ctx, spanner.Statement{
+		SQL: fmt.Sprintf(`
+			SELECT o.%s, o.%s, o.%s, o.%s
+			FROM %s@{FORCE_INDEX=idx_outbox_by_aggregate_shard_created} o
+			WHERE o.%s IN UNNEST(@shards) AND o.%s >= @after
+			AND NOT EXISTS (SELECT 1 FROM %s p WHERE p.%s = @consumer AND p.%s = o.%s)
+			ORDER BY o.%s, o.%s
+			LIMIT @limit`,
+			m_outbox.EventID, m_outbox.EventType, m_outbox.AggregateID, m_outbox.CreatedAt,
+			m_outbox.TableName,
+			m_outbox.Shard, m_outbox.CreatedAt,
+			m_processed_event.TableName, m_processed_event.Consumer, m_processed_event.EventID, m_outbox.EventID,
+			m_outbox.CreatedAt, m_outbox.EventID),
+		Params: map[string]interface{}{
+			"shards":   m_outbox.ShardValues(),
+			"after":    after,
+			"consumer": consumer,
+			"limit":    int64(limit),
+		},
+	})
+	defer iter.Stop()
+
+	var events []contracts.FeedEvent
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read outbox feed: %w", err)
+		}
+		var event contracts.FeedEvent
+		if err := row.Columns(&event.EventID, &event.EventType, &event.AggregateID, &event.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to parse outbox row: %w", err)
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// cursor returns the commit time of the last event the consumer advanced past, or the zero time
+func (f *SpannerOutboxFeed) cursor(ctx context.Context, consumer string) (time.Time, error) {
+	row, err := f.client.Single().ReadRow(ctx, m_outbox_cursor.TableName, spanner.Key{consumer}, []string{m_outbox_cursor.CreatedAt})
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return time.Time{}, nil
+		}
+		return time.Time{}, fmt.Errorf("failed to read outbox cursor: %w", err)
+	}
+	var createdAt time.Time
+	if err := row.Columns(&createdAt); err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse outbox cursor: %w", err)
+	}
+	return createdAt, nil
+}
+
+// MarkProcessedMut returns an insert-or-update of the consumer's processed_events row
+func (f *SpannerOutboxFeed) MarkProcessedMut(consumer, eventID string, at time.Time) *spanner.Mutation {
+	row := &m_processed_event.ProcessedEvent{
+		Consumer:    consumer,
+		EventID:     eventID,
+		ProcessedAt: at,
+	}
+	return row.UpsertMut()
+}
+
+// AdvanceMut returns an insert-or-update of the consumer's outbox_cursors row
+func (f *SpannerOutboxFeed) AdvanceMut(consumer string, event contracts.FeedEvent, at time.Time) *spanner.Mutation {
+	row := &m_outbox_cursor.OutboxCursor{
+		Consumer:  consumer,
+		CreatedAt: event.CreatedAt,
+		EventID:   event.EventID,
+		UpdatedAt: at,
+	}
+	return row.UpsertMut()
+}
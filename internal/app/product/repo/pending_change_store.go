@@ -0,0 +1,155 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/models/m_pending_change"
+	"catalog-proj/internal/models/m_product"
+	"catalog-proj/internal/pkg/tenant"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
+)
+
+// SpannerPendingChangeStore implements PendingChangeStore using Spanner
+type SpannerPendingChangeStore struct {
+	client *spanner.Client
+}
+
+// NewSpannerPendingChangeStore creates a new Spanner pending change store
+func NewSpannerPendingChangeStore(client *spanner.Client) *SpannerPendingChangeStore {
+	return &SpannerPendingChangeStore{
+		client: client,
+	}
+}
+
+// Load reads a pending change by ID; changes of other tenants are reported as missing
+func (s *SpannerPendingChangeStore) Load(ctx context.Context, id string) (*domain.PendingChange, error) {
+	row, err := s.client.Single().ReadRow(ctx, m_pending_change.TableName, spanner.Key{id}, m_pending_change.AllColumns())
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, domain.ErrChangeNotFound
+		}
+		return nil, fmt.Errorf("failed to load pending change: %w", err)
+	}
+
+	model := &m_pending_change.PendingChange{}
+	if err := row.ToStruct(model); err != nil {
+		return nil, fmt.Errorf("failed to parse pending change row: %w", err)
+	}
+	if model.TenantID != tenant.FromContext(ctx) {
+		return nil, domain.ErrChangeNotFound
+	}
+
+	oldPrice := domain.NewMoneyFromFraction(model.OldPriceNumerator, model.OldPriceDenominator)
+	newPrice := domain.NewMoneyFromFraction(model.NewPriceNumerator, model.NewPriceDenominator)
+	return domain.ReconstructPendingChange(
+		model.ChangeID,
+		model.TenantID,
+		model.ProductID,
+		domain.PendingChangeKind(model.Kind),
+		&oldPrice,
+		&newPrice,
+		model.RequestedBy,
+		model.RequestedAt,
+		domain.PendingChangeStatus(model.Status),
+		stringValue(model.DecidedBy),
+		stringValue(model.DecisionNote),
+		model.DecidedAt,
+	), nil
+}
+
+// InsertMut inserts a new pending change
+func (s *SpannerPendingChangeStore) InsertMut(change *domain.PendingChange) *spanner.Mutation {
+	return s.toModel(change).InsertMut()
+}
+
+// Decide updates the status and decision columns of a pending change in a read-write transaction
+// that first checks the stored status is still pending, and for an approved price change that the
+// product's base price is still the one the change was requested against
+func (s *SpannerPendingChangeStore) Decide(ctx context.Context, change *domain.PendingChange, extra ...*spanner.Mutation) error {
+	_, err := s.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		row, err := txn.ReadRow(ctx, m_pending_change.TableName, spanner.Key{change.ID()}, []string{m_pending_change.Status})
+		if err != nil {
+			if spanner.ErrCode(err) == codes.NotFound {
+				return domain.ErrChangeNotFound
+			}
+			return err
+		}
+		var status string
+		if err := row.Columns(&status); err != nil {
+			return err
+		}
+		if domain.PendingChangeStatus(status) != domain.PendingChangeStatusPending {
+			return domain.ErrChangeNotPending
+		}
+		if change.Status() == domain.PendingChangeStatusApproved && change.Kind() == domain.PendingChangeBasePrice {
+			if err := s.checkBasePrice(ctx, txn, change); err != nil {
+				return err
+			}
+		}
+
+		mutations := append([]*spanner.Mutation{s.toModel(change).DecisionMut()}, extra...)
+		return txn.BufferWrite(mutations)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record decision on change %s: %w", change.ID(), err)
+	}
+	return nil
+}
+
+// checkBasePrice re-reads the product's base price in txn, so a price written after the product was
+// loaded for the approval is not overwritten; it fails with ErrChangeStale if the price moved
+func (s *SpannerPendingChangeStore) checkBasePrice(ctx context.Context, txn *spanner.ReadWriteTransaction, change *domain.PendingChange) error {
+	row, err := txn.ReadRow(ctx, m_product.TableName, spanner.Key{change.ProductID()}, []string{m_product.BasePriceNumerator, m_product.BasePriceDenominator})
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return domain.ErrProductNotFound
+		}
+		return err
+	}
+	var numerator, denominator int64
+	if err := row.Columns(&numerator, &denominator); err != nil {
+		return err
+	}
+	oldNumerator, oldDenominator := priceFraction(change.OldBasePrice())
+	if denominator == 0 || big.NewRat(numerator, denominator).Cmp(big.NewRat(oldNumerator, oldDenominator)) != 0 {
+		return domain.ErrChangeStale
+	}
+	return nil
+}
+
+// toModel converts a domain PendingChange to a database model
+func (s *SpannerPendingChangeStore) toModel(change *domain.PendingChange) *m_pending_change.PendingChange {
+	model := &m_pending_change.PendingChange{
+		ChangeID:    change.ID(),
+		TenantID:    change.TenantID(),
+		ProductID:   change.ProductID(),
+		Kind:        string(change.Kind()),
+		RequestedBy: change.RequestedBy(),
+		RequestedAt: change.RequestedAt(),
+		Status:      string(change.Status()),
+		DecidedAt:   change.DecidedAt(),
+	}
+	model.OldPriceNumerator, model.OldPriceDenominator = priceFraction(change.OldBasePrice())
+	model.NewPriceNumerator, model.NewPriceDenominator = priceFraction(change.NewBasePrice())
+	if decidedBy := change.DecidedBy(); decidedBy != "" {
+		model.DecidedBy = &decidedBy
+	}
+	if note := change.DecisionNote(); note != "" {
+		model.DecisionNote = &note
+	}
+	return model
+}
+
+// priceFraction splits a price into the numerator and denominator columns used for prices
+func priceFraction(price *domain.Money) (int64, int64) {
+	if price == nil || *price == nil {
+		return 0, 1
+	}
+	rat := (*big.Rat)(*price)
+	return rat.Num().Int64(), rat.Denom().Int64()
+}
@@ -0,0 +1,61 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"catalog-proj/internal/models/m_product"
+	"catalog-proj/internal/models/m_product_count"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+)
+
+// SpannerProductCountStore implements ProductCountStore using Spanner
+type SpannerProductCountStore struct {
+	client *spanner.Client
+}
+
+// NewSpannerProductCountStore creates a new Spanner product count store
+func NewSpannerProductCountStore(client *spanner.Client) *SpannerProductCountStore {
+	return &SpannerProductCountStore{
+		client: client,
+	}
+}
+
+// RefreshCounts replaces the cached counts with a fresh GROUP BY over the products table
+// The old counts are deleted in the same transaction, so groups that emptied out disappear
+func (s *SpannerProductCountStore) RefreshCounts(ctx context.Context, computedAt time.Time) (int, error) {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(`SELECT tenant_id, category, status, COUNT(*) AS product_count
+			FROM %s
+			GROUP BY tenant_id, category, status`, m_product.TableName),
+	}
+
+	// Count outside the write transaction; the counts are approximate anyway
+	iter := s.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	mutations := []*spanner.Mutation{spanner.Delete(m_product_count.TableName, spanner.AllKeys())}
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to count products: %w", err)
+		}
+
+		count := &m_product_count.ProductCount{ComputedAt: computedAt}
+		if err := row.Columns(&count.TenantID, &count.Category, &count.Status, &count.ProductCount); err != nil {
+			return 0, fmt.Errorf("failed to parse product count: %w", err)
+		}
+		mutations = append(mutations, count.InsertMut())
+	}
+
+	if _, err := s.client.Apply(ctx, mutations); err != nil {
+		return 0, fmt.Errorf("failed to store product counts: %w", err)
+	}
+	return len(mutations) - 1, nil
+}
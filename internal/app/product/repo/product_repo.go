@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"time"
 
 	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/domain/services"
 	"catalog-proj/internal/models/m_product"
+	"catalog-proj/internal/pkg/tenant"
 
 	"cloud.google.com/go/spanner"
 	"google.golang.org/grpc/codes"
@@ -15,12 +18,15 @@ import (
 // SpannerProductRepository implements ProductRepository using Spanner
 type SpannerProductRepository struct {
 	client *spanner.Client
+	// pricing works out the persisted effective price; MAP only changes the price shown, so it is not enforced
+	pricing *services.PricingCalculator
 }
 
 // NewSpannerProductRepository creates a new Spanner product repository
 func NewSpannerProductRepository(client *spanner.Client) *SpannerProductRepository {
 	return &SpannerProductRepository{
-		client: client,
+		client:  client,
+		pricing: services.NewPricingCalculator(false),
 	}
 }
 
@@ -48,12 +54,19 @@ func (r *SpannerProductRepository) UpdateMut(product *domain.Product) *spanner.M
 		columns = append(columns, "category")
 	}
 	// Base price changes require both numerator and denominator
-	if changes.Dirty("base_price") {
+	if changes.Dirty(domain.FieldBasePrice) {
 		columns = append(columns, "base_price_numerator", "base_price_denominator")
 	}
 	if changes.Dirty(domain.FieldDiscount) {
 		// When discount changes, update all discount-related fields
-		columns = append(columns, "discount_id", "discount_amount", "discount_start_date", "discount_end_date")
+		columns = append(columns, m_product.DiscountColumns()...)
+		if product.Discount() == nil {
+			// Removal must not leave a stale id, amount or dates behind
+			model.ClearDiscount()
+		}
+	}
+	if changes.Dirty(domain.FieldBasePrice) || changes.Dirty(domain.FieldDiscount) {
+		columns = append(columns, m_product.EffectivePriceColumns()...)
 	}
 	if changes.Dirty(domain.FieldStatus) {
 		columns = append(columns, "status")
@@ -61,13 +74,64 @@ func (r *SpannerProductRepository) UpdateMut(product *domain.Product) *spanner.M
 	if changes.Dirty(domain.FieldArchivedAt) {
 		columns = append(columns, "archived_at")
 	}
-	// Always update UpdatedAt
-	columns = append(columns, "updated_at")
+	if changes.Dirty(domain.FieldLegalHold) {
+		columns = append(columns, "legal_hold")
+	}
+	if changes.Dirty(domain.FieldChannels) {
+		columns = append(columns, m_product.Channels)
+	}
+	if changes.Dirty(domain.FieldMetadata) {
+		columns = append(columns, m_product.Metadata)
+	}
+	if changes.Dirty(domain.FieldAttributes) {
+		columns = append(columns, m_product.Attributes)
+	}
+	if changes.Dirty(domain.FieldPriceFloor) {
+		columns = append(columns, m_product.PriceFloorColumns()...)
+	}
+	if changes.Dirty(domain.FieldWeight) {
+		columns = append(columns, m_product.WeightColumns()...)
+	}
+	if changes.Dirty(domain.FieldDimensions) {
+		columns = append(columns, m_product.DimensionColumns()...)
+	}
+	if changes.Dirty(domain.FieldShippingClass) {
+		columns = append(columns, m_product.ShippingClass)
+	}
+	if changes.Dirty(domain.FieldProductType) {
+		columns = append(columns, m_product.ProductType)
+	}
+	if changes.Dirty(domain.FieldDescriptionFormat) {
+		columns = append(columns, m_product.DescriptionFormat)
+	}
+	if changes.Dirty(domain.FieldDownloadURL) {
+		columns = append(columns, m_product.DownloadURL)
+	}
+	if changes.Dirty(domain.FieldLicenseTerms) {
+		columns = append(columns, m_product.LicenseTerms)
+	}
+	if changes.Dirty(domain.FieldAgeRestriction) {
+		columns = append(columns, m_product.AgeRestriction)
+	}
+	if changes.Dirty(domain.FieldHazardous) {
+		columns = append(columns, m_product.Hazardous)
+	}
+	if changes.Dirty(domain.FieldRequiresPrescription) {
+		columns = append(columns, m_product.RequiresPrescription)
+	}
+	if changes.Dirty(domain.FieldNameKey) {
+		columns = append(columns, "name_key")
+	}
+	// The aggregate moves updatedAt forward on every state change; the column takes the commit timestamp
+	if changes.Dirty(domain.FieldUpdatedAt) {
+		columns = append(columns, "updated_at")
+	}
 
 	return model.UpdateMut(columns)
 }
 
 // Load retrieves a product by ID from Spanner and maps it to domain model
+// Products owned by a different tenant than the request's are reported as not found
 func (r *SpannerProductRepository) Load(ctx context.Context, id string) (*domain.Product, error) {
 	columns := m_product.AllColumns()
 	row, err := r.client.Single().ReadRow(ctx, m_product.TableName, spanner.Key{id}, columns)
@@ -83,6 +147,9 @@ func (r *SpannerProductRepository) Load(ctx context.Context, id string) (*domain
 	if err := row.ToStruct(model); err != nil {
 		return nil, fmt.Errorf("failed to parse product row: %w", err)
 	}
+	if model.TenantID != tenant.FromContext(ctx) {
+		return nil, domain.ErrProductNotFound
+	}
 
 	return r.modelToDomain(model)
 }
@@ -91,14 +158,45 @@ func (r *SpannerProductRepository) Load(ctx context.Context, id string) (*domain
 func (r *SpannerProductRepository) domainToModel(product *domain.Product) *m_product.Product {
 	model := &m_product.Product{
 		ProductID:   product.ID(),
+		TenantID:    product.TenantID(),
 		Name:        product.Name(),
 		Description: product.Description(),
 		Category:    product.Category(),
 		Status:      string(product.Status()),
+		LegalHold:   product.LegalHold(),
+		Channels:    domain.ChannelStrings(product.Channels()),
+		Metadata:    product.Metadata().Entries(),
+		Attributes:  product.Attributes().Entries(),
 		CreatedAt:   product.CreatedAt(),
 		UpdatedAt:   product.UpdatedAt(),
 	}
 
+	// Identifiers are optional and stored as NULL when not set
+	if sku := product.SKU(); sku != "" {
+		model.SKU = &sku
+	}
+	if gtin := product.GTIN(); gtin != "" {
+		model.GTIN = &gtin
+	}
+	if nameKey := product.NameKey(); nameKey != "" {
+		model.NameKey = &nameKey
+	}
+	shippingToModel(product.Shipping(), model)
+	productType := string(product.Type())
+	model.ProductType = &productType
+	descriptionFormat := string(product.DescriptionFormat())
+	model.DescriptionFormat = &descriptionFormat
+	if digital := product.DigitalDelivery(); digital.DownloadURL != "" {
+		model.DownloadURL = &digital.DownloadURL
+	}
+	if digital := product.DigitalDelivery(); digital.LicenseTerms != "" {
+		model.LicenseTerms = &digital.LicenseTerms
+	}
+	compliance := product.Compliance()
+	model.AgeRestriction = int64(compliance.AgeRestriction)
+	model.Hazardous = compliance.Hazardous
+	model.RequiresPrescription = compliance.RequiresPrescription
+
 	// Convert base price: domain.Money is *big.Rat, convert to numerator/denominator
 	if basePrice := product.BasePrice(); basePrice != nil {
 		// basePrice is *domain.Money
@@ -128,14 +226,40 @@ func (r *SpannerProductRepository) domainToModel(product *domain.Product) *m_pro
 		model.DiscountEndDate = &discount.EndDate
 	}
 
+	// Convert price floor
+	floor := product.PriceFloor()
+	if floor.MinPrice != nil {
+		model.FloorPrice = (*big.Rat)(*floor.MinPrice)
+	}
+	if floor.Cost != nil {
+		model.CostPrice = (*big.Rat)(*floor.Cost)
+	}
+	model.MinMarginPercent = floor.MinMarginPercent
+	if floor.MapPrice != nil {
+		model.MapPrice = (*big.Rat)(*floor.MapPrice)
+	}
+
 	// Handle archivedAt
 	if archivedAt := product.ArchivedAt(); archivedAt != nil {
 		model.ArchivedAt = archivedAt
 	}
 
+	// The effective price as of the write, for lists to sort and filter on; the effective price
+	// refresh reprices the product when a discount starts or ends
+	model.EffectivePrice, model.EffectivePriceValidUntil = r.effectivePrice(product, product.UpdatedAt())
+
 	return model
 }
 
+// effectivePrice returns the effective price of product at now and when it next changes, as stored
+func (r *SpannerProductRepository) effectivePrice(product *domain.Product, now time.Time) (*big.Rat, *time.Time) {
+	price, validUntil := r.pricing.EffectivePriceUntil(product, now)
+	if price == nil {
+		return nil, validUntil
+	}
+	return (*big.Rat)(*price), validUntil
+}
+
 // modelToDomain converts a database model to a domain Product
 func (r *SpannerProductRepository) modelToDomain(model *m_product.Product) (*domain.Product, error) {
 	// Convert base price: numerator/denominator to *big.Rat
@@ -171,16 +295,125 @@ func (r *SpannerProductRepository) modelToDomain(model *m_product.Product) (*dom
 	// Reconstruct product using factory method
 	product := domain.ReconstructProduct(
 		model.ProductID,
+		model.TenantID,
 		model.Name,
 		model.Description,
+		descriptionFormatFromModel(model.DescriptionFormat),
 		model.Category,
+		stringValue(model.SKU),
+		stringValue(model.GTIN),
 		basePrice,
 		discount,
 		status,
+		model.LegalHold,
+		domain.ChannelsFromStrings(model.Channels),
+		productTypeFromModel(model.ProductType),
+		shippingFromModel(model),
+		digitalFromModel(model),
+		complianceFromModel(model),
+		domain.MetadataFromEntries(model.Metadata),
+		domain.AttributesFromEntries(model.Attributes),
+		priceFloorFromModel(model),
 		model.ArchivedAt,
 		model.CreatedAt,
 		model.UpdatedAt,
 	)
+	product.RestoreNameKey(stringValue(model.NameKey))
 
 	return product, nil
 }
+
+// priceFloorFromModel reads the price floor; NULL prices leave it unconstrained
+func priceFloorFromModel(model *m_product.Product) domain.PriceFloor {
+	floor := domain.PriceFloor{MinMarginPercent: model.MinMarginPercent}
+	if model.FloorPrice != nil {
+		minPrice := domain.Money(model.FloorPrice)
+		floor.MinPrice = &minPrice
+	}
+	if model.CostPrice != nil {
+		cost := domain.Money(model.CostPrice)
+		floor.Cost = &cost
+	}
+	if model.MapPrice != nil {
+		mapPrice := domain.Money(model.MapPrice)
+		floor.MapPrice = &mapPrice
+	}
+	return floor
+}
+
+// shippingToModel copies the shipping details that are set onto the model
+func shippingToModel(shipping domain.ShippingDetails, model *m_product.Product) {
+	if w := shipping.Weight; w != nil {
+		value, unit := w.Value, string(w.Unit)
+		model.WeightValue = &value
+		model.WeightUnit = &unit
+	}
+	if d := shipping.Dimensions; d != nil {
+		length, width, height, unit := d.Length, d.Width, d.Height, string(d.Unit)
+		model.Length = &length
+		model.Width = &width
+		model.Height = &height
+		model.DimensionUnit = &unit
+	}
+	if shipping.ShippingClass != "" {
+		model.ShippingClass = &shipping.ShippingClass
+	}
+}
+
+// shippingFromModel reads the shipping details; a weight or dimensions without a unit is ignored
+func shippingFromModel(model *m_product.Product) domain.ShippingDetails {
+	shipping := domain.ShippingDetails{ShippingClass: stringValue(model.ShippingClass)}
+	if model.WeightValue != nil && model.WeightUnit != nil {
+		shipping.Weight = &domain.Weight{Value: *model.WeightValue, Unit: domain.WeightUnit(*model.WeightUnit)}
+	}
+	if model.Length != nil && model.Width != nil && model.Height != nil && model.DimensionUnit != nil {
+		shipping.Dimensions = &domain.Dimensions{
+			Length: *model.Length,
+			Width:  *model.Width,
+			Height: *model.Height,
+			Unit:   domain.LengthUnit(*model.DimensionUnit),
+		}
+	}
+	return shipping
+}
+
+// digitalFromModel reads the download URL and license terms of a digital product
+func digitalFromModel(model *m_product.Product) domain.DigitalDelivery {
+	return domain.DigitalDelivery{
+		DownloadURL:  stringValue(model.DownloadURL),
+		LicenseTerms: stringValue(model.LicenseTerms),
+	}
+}
+
+// complianceFromModel reads the product's compliance metadata
+func complianceFromModel(model *m_product.Product) domain.Compliance {
+	return domain.Compliance{
+		AgeRestriction:       int(model.AgeRestriction),
+		Hazardous:            model.Hazardous,
+		RequiresPrescription: model.RequiresPrescription,
+	}
+}
+
+// productTypeFromModel reads the product type, treating NULL as physical
+func productTypeFromModel(s *string) domain.ProductType {
+	if s == nil || *s == "" {
+		return domain.ProductTypePhysical
+	}
+	return domain.ProductType(*s)
+}
+
+// descriptionFormatFromModel reads the description format, treating NULL as plain
+func descriptionFormatFromModel(s *string) domain.DescriptionFormat {
+	if s == nil || *s == "" {
+		return domain.DescriptionFormatPlain
+	}
+	return domain.DescriptionFormat(*s)
+}
+
+// stringValue dereferences a nullable string column ("" for NULL)
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
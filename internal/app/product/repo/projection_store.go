@@ -0,0 +1,84 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/models/m_product"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+)
+
+// SpannerProjectionStore scans the products table for projection rebuilds
+// Scans are not tenant scoped; they are used by admin operations only
+type SpannerProjectionStore struct {
+	client   *spanner.Client
+	products *SpannerProductRepository
+}
+
+// NewSpannerProjectionStore creates a new Spanner projection store
+func NewSpannerProjectionStore(client *spanner.Client) *SpannerProjectionStore {
+	return &SpannerProjectionStore{
+		client:   client,
+		products: NewSpannerProductRepository(client),
+	}
+}
+
+// ScanProducts reads the next page of products in primary key order
+func (s *SpannerProjectionStore) ScanProducts(ctx context.Context, afterID string, limit int) ([]contracts.StoredProduct, error) {
+	iter := s.client.Single().Query(ctx, spanner.Statement{
+		SQL: fmt.Sprintf(`SELECT %s FROM %s WHERE product_id > @after ORDER BY product_id LIMIT @limit`,
+			buildColumnList(m_product.AllColumns()), m_product.TableName),
+		Params: map[string]interface{}{
+			"after": afterID,
+			"limit": int64(limit),
+		},
+	})
+	defer iter.Stop()
+
+	var products []contracts.StoredProduct
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan products: %w", err)
+		}
+
+		model := &m_product.Product{}
+		if err := row.ToStruct(model); err != nil {
+			return nil, fmt.Errorf("failed to parse product row: %w", err)
+		}
+		product, err := s.products.modelToDomain(model)
+		if err != nil {
+			return nil, err
+		}
+		products = append(products, contracts.StoredProduct{
+			Product: product,
+			NameKey: stringValue(model.NameKey),
+		})
+	}
+	return products, nil
+}
+
+// CountProducts counts the products a scan starting after afterID would visit
+func (s *SpannerProjectionStore) CountProducts(ctx context.Context, afterID string) (int64, error) {
+	iter := s.client.Single().Query(ctx, spanner.Statement{
+		SQL:    fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE product_id > @after`, m_product.TableName),
+		Params: map[string]interface{}{"after": afterID},
+	})
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count products: %w", err)
+	}
+	var count int64
+	if err := row.Columns(&count); err != nil {
+		return 0, fmt.Errorf("failed to parse product count: %w", err)
+	}
+	return count, nil
+}
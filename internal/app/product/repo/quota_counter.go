@@ -0,0 +1,67 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"catalog-proj/internal/models/m_product"
+
+	"cloud.google.com/go/spanner"
+)
+
+// SpannerQuotaCounter implements QuotaCounter with COUNT queries served by the tenant indexes
+type SpannerQuotaCounter struct {
+	client *spanner.Client
+}
+
+// NewSpannerQuotaCounter creates a new Spanner quota counter
+func NewSpannerQuotaCounter(client *spanner.Client) *SpannerQuotaCounter {
+	return &SpannerQuotaCounter{
+		client: client,
+	}
+}
+
+// CountProducts returns the number of non-archived products owned by the tenant
+func (c *SpannerQuotaCounter) CountProducts(ctx context.Context, tenantID string) (int64, error) {
+	return c.count(ctx, spanner.Statement{
+		SQL: fmt.Sprintf(`SELECT COUNT(*) FROM %s
+			WHERE tenant_id = @tenant AND archived_at IS NULL`, m_product.TableName),
+		Params: map[string]interface{}{"tenant": tenantID},
+	})
+}
+
+// CountProductsInCategory returns the number of non-archived products in a tenant's category
+func (c *SpannerQuotaCounter) CountProductsInCategory(ctx context.Context, tenantID, category string) (int64, error) {
+	return c.count(ctx, spanner.Statement{
+		SQL: fmt.Sprintf(`SELECT COUNT(*) FROM %s
+			WHERE tenant_id = @tenant AND category = @category AND archived_at IS NULL`, m_product.TableName),
+		Params: map[string]interface{}{"tenant": tenantID, "category": category},
+	})
+}
+
+// CountActiveDiscounts returns the number of non-archived products with a discount valid at now
+func (c *SpannerQuotaCounter) CountActiveDiscounts(ctx context.Context, tenantID string, now time.Time) (int64, error) {
+	return c.count(ctx, spanner.Statement{
+		SQL: fmt.Sprintf(`SELECT COUNT(*) FROM %s
+			WHERE tenant_id = @tenant AND discount_end_date > @now AND discount_start_date <= @now
+			AND archived_at IS NULL`, m_product.TableName),
+		Params: map[string]interface{}{"tenant": tenantID, "now": now},
+	})
+}
+
+// count runs a single-row COUNT(*) statement
+func (c *SpannerQuotaCounter) count(ctx context.Context, stmt spanner.Statement) (int64, error) {
+	iter := c.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count products: %w", err)
+	}
+	var n int64
+	if err := row.Columns(&n); err != nil {
+		return 0, fmt.Errorf("failed to read count: %w", err)
+	}
+	return n, nil
+}
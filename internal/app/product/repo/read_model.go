@@ -4,48 +4,101 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"strings"
 
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/queries/find_similar_products"
 	"catalog-proj/internal/app/product/queries/get_product"
 	"catalog-proj/internal/app/product/queries/list_products"
+	"catalog-proj/internal/app/product/queries/search_products"
 	"catalog-proj/internal/models/m_product"
+	"catalog-proj/internal/models/m_product_count"
+	"catalog-proj/internal/models/m_product_view"
 	"cloud.google.com/go/spanner"
 	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
 )
 
 // SpannerReadModel implements ReadModel using direct Spanner queries
 // This bypasses the domain layer and returns DTOs directly
 type SpannerReadModel struct {
 	client *spanner.Client
+	// maxScanRows is the most rows a list or search may read without a category filter
+	maxScanRows int
 }
 
 // NewSpannerReadModel creates a new Spanner read model
-func NewSpannerReadModel(client *spanner.Client) *SpannerReadModel {
+// Lists without a category filter, whose offset plus limit exceeds maxScanRows, are refused
+// with ErrUnboundedScan, since only that filter is backed by an index. Searches may not ask for more than
+// maxScanRows hits. 0 disables the guard
+func NewSpannerReadModel(client *spanner.Client, maxScanRows int) *SpannerReadModel {
 	return &SpannerReadModel{
-		client: client,
+		client:      client,
+		maxScanRows: maxScanRows,
 	}
 }
 
 // GetProduct retrieves a single product by ID
 func (r *SpannerReadModel) GetProduct(ctx context.Context, id string) (*get_product.DTO, error) {
-	row, err := r.client.Single().ReadRow(ctx, m_product.TableName, spanner.Key{id}, m_product.AllColumns())
+	row, err := r.client.Single().ReadRow(ctx, m_product.TableName, spanner.Key{id}, m_product.FullColumns())
 	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, domain.ErrProductNotFound
+		}
 		return nil, fmt.Errorf("failed to get product: %w", err)
 	}
 
-	model := &m_product.Product{}
-	if err := row.ToStruct(model); err != nil {
+	model, err := m_product.NewScanner(m_product.FullColumns()).Scan(row)
+	if err != nil {
 		return nil, fmt.Errorf("failed to parse product row: %w", err)
 	}
 
 	return r.modelToDTO(model), nil
 }
 
+// BatchGetProducts retrieves the products with the given IDs in a single read
+// Missing IDs are skipped; the result order is unspecified
+func (r *SpannerReadModel) BatchGetProducts(ctx context.Context, ids []string) ([]*get_product.DTO, error) {
+	keys := make([]spanner.KeySet, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, spanner.Key{id})
+	}
+
+	iter := r.client.Single().Read(ctx, m_product.TableName, spanner.KeySets(keys...), m_product.FullColumns())
+	defer iter.Stop()
+
+	dtos := make([]*get_product.DTO, 0, len(ids))
+	scanner := m_product.NewScanner(m_product.FullColumns())
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to batch get products: %w", err)
+		}
+
+		model, err := scanner.Scan(row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse product row: %w", err)
+		}
+		dtos = append(dtos, r.modelToDTO(model))
+	}
+
+	return dtos, nil
+}
+
 // ListProducts retrieves a list of products with optional filters
 func (r *SpannerReadModel) ListProducts(ctx context.Context, req *list_products.Request) (*list_products.DTO, error) {
+	// Refuse queries that would scan the tenant's products, before Spanner does the work
+	if err := r.checkListScan(req); err != nil {
+		return nil, err
+	}
+
 	// Build base WHERE clause for both count and data queries
-	whereClause := "WHERE 1=1"
-	args := []interface{}{}
-	argIndex := 1
+	whereClause := "WHERE tenant_id = @p1"
+	args := []interface{}{req.TenantID}
+	argIndex := 2
 
 	if req.Category != "" {
 		whereClause += fmt.Sprintf(" AND category = @p%d", argIndex)
@@ -59,32 +112,76 @@ func (r *SpannerReadModel) ListProducts(ctx context.Context, req *list_products.
 		argIndex++
 	}
 
-	// Get total count (separate query without limit/offset)
-	countQuery := fmt.Sprintf(`
-		SELECT COUNT(*) as total
-		FROM %s
-		%s
-	`, m_product.TableName, whereClause)
+	if req.Channel != "" {
+		whereClause += fmt.Sprintf(" AND @p%d IN UNNEST(channels)", argIndex)
+		args = append(args, req.Channel)
+		argIndex++
+	}
 
-	countStmt := spanner.Statement{
-		SQL:    countQuery,
-		Params: buildParams(args),
+	if req.MetadataKey != "" {
+		whereClause += fmt.Sprintf(" AND @p%d IN UNNEST(metadata)", argIndex)
+		args = append(args, domain.MetadataEntry(req.MetadataKey, req.MetadataValue))
+		argIndex++
 	}
 
-	countIter := r.client.Single().Query(ctx, countStmt)
-	defer countIter.Stop()
+	if req.MaxAgeRestriction != nil {
+		whereClause += fmt.Sprintf(" AND age_restriction <= @p%d", argIndex)
+		args = append(args, int64(*req.MaxAgeRestriction))
+		argIndex++
+	}
+	if req.ExcludeHazardous {
+		whereClause += " AND hazardous = false"
+	}
+	if req.ExcludePrescription {
+		whereClause += " AND requires_prescription = false"
+	}
+	price := effectivePriceExpr(req.EnforceMAP)
+	if req.MinEffectivePrice != nil {
+		whereClause += fmt.Sprintf(" AND %s >= @p%d", price, argIndex)
+		args = append(args, req.MinEffectivePrice)
+		argIndex++
+	}
+	if req.MaxEffectivePrice != nil {
+		whereClause += fmt.Sprintf(" AND %s <= @p%d", price, argIndex)
+		args = append(args, req.MaxEffectivePrice)
+		argIndex++
+	}
 
+	// Get total count (separate query without limit/offset) unless the caller can do without it
+	// Approximate totals come from the cached counts and fall back to counting until they exist
 	var total int
-	countRow, err := countIter.Next()
-	if err != nil && err != iterator.Done {
-		return nil, fmt.Errorf("failed to get total count: %w", err)
+	var approximate bool
+	if !req.SkipTotal && req.ApproximateTotal {
+		var err error
+		if total, approximate, err = r.approximateCount(ctx, req); err != nil {
+			return nil, err
+		}
 	}
-	if err == nil {
-		var countValue int64
-		if err := countRow.ColumnByName("total", &countValue); err != nil {
-			return nil, fmt.Errorf("failed to read count: %w", err)
+	if !req.SkipTotal && !approximate {
+		var err error
+		if total, err = r.countProducts(ctx, whereClause, args); err != nil {
+			return nil, err
 		}
-		total = int(countValue)
+	}
+	// A count that may be stale cannot tell whether another page follows
+	exact := !req.SkipTotal && !approximate
+
+	// Popularity comes from the view counters, which only exist for viewed products
+	orderBy := "created_at DESC"
+	switch req.OrderBy {
+	case list_products.OrderByPopularity:
+		orderBy = fmt.Sprintf("COALESCE((SELECT v.%s FROM %s v WHERE v.%s = %s.product_id), 0) DESC, created_at DESC",
+			m_product_view.ViewCount, m_product_view.TableName, m_product_view.ProductID, m_product.TableName)
+	case list_products.OrderByEffectivePrice:
+		orderBy = fmt.Sprintf("%s IS NULL, %s, created_at DESC", price, price)
+	case list_products.OrderByEffectivePriceDesc:
+		orderBy = fmt.Sprintf("%s IS NULL, %s DESC, created_at DESC", price, price)
+	}
+
+	// The basic view reads only what a storefront grid shows, cutting the bytes Spanner reads and returns
+	columns := m_product.FullColumns()
+	if req.View == list_products.ViewBasic {
+		columns = m_product.BasicColumns()
 	}
 
 	// Build data query with limit/offset
@@ -92,16 +189,21 @@ func (r *SpannerReadModel) ListProducts(ctx context.Context, req *list_products.
 		SELECT %s
 		FROM %s
 		%s
-		ORDER BY created_at DESC
-	`, buildColumnList(m_product.AllColumns()), m_product.TableName, whereClause)
+		ORDER BY %s
+	`, buildColumnList(columns), m_product.TableName, whereClause, orderBy)
 
 	dataArgs := make([]interface{}, len(args))
 	copy(dataArgs, args)
 	dataArgIndex := argIndex
 
+	// Without a count, one extra row tells whether another page follows
 	if req.Limit > 0 {
+		limit := req.Limit
+		if !exact {
+			limit++
+		}
 		query += fmt.Sprintf(" LIMIT @p%d", dataArgIndex)
-		dataArgs = append(dataArgs, req.Limit)
+		dataArgs = append(dataArgs, limit)
 		dataArgIndex++
 	}
 
@@ -118,7 +220,9 @@ func (r *SpannerReadModel) ListProducts(ctx context.Context, req *list_products.
 	iter := r.client.Single().Query(ctx, stmt)
 	defer iter.Stop()
 
-	var products []list_products.ProductItem
+	// Room for the extra row that tells whether another page follows
+	products := make([]list_products.ProductItem, 0, req.Limit+1)
+	scanner := NewProductItemScanner(columns)
 	for {
 		row, err := iter.Next()
 		if err != nil {
@@ -129,20 +233,280 @@ func (r *SpannerReadModel) ListProducts(ctx context.Context, req *list_products.
 			return nil, fmt.Errorf("failed to iterate products: %w", err)
 		}
 
-		model := &m_product.Product{}
-		if err := row.ToStruct(model); err != nil {
-			return nil, fmt.Errorf("failed to parse product row: %w", err)
+		item, err := scanner.Scan(row)
+		if err != nil {
+			return nil, err
 		}
+		products = append(products, item)
+	}
 
-		products = append(products, r.modelToProductItem(model))
+	var hasMore bool
+	if exact {
+		hasMore = req.Offset+len(products) < total
+	} else if req.Limit > 0 && len(products) > req.Limit {
+		products = products[:req.Limit]
+		hasMore = true
 	}
 
 	return &list_products.DTO{
 		Products: products,
 		Total:    total,
+		HasMore:  hasMore,
+
+		TotalApproximate: approximate,
 	}, nil
 }
 
+// checkListScan refuses a list that reads past maxScanRows rows without an indexed filter
+// Only category follows tenant_id in an index (idx_products_tenant_category); a status filter alone
+// and the other filters are applied row by row
+func (r *SpannerReadModel) checkListScan(req *list_products.Request) error {
+	if r.maxScanRows <= 0 || req.AllowFullScan || req.Category != "" {
+		return nil
+	}
+	if req.Limit <= 0 || req.Offset+req.Limit > r.maxScanRows {
+		return fmt.Errorf("%w: offset %d and limit %d read more than %d rows", domain.ErrUnboundedScan, req.Offset, req.Limit, r.maxScanRows)
+	}
+	return nil
+}
+
+// approximateCount sums the cached counts for the request's tenant, category and status
+// ok is false when nothing has been counted for them yet
+func (r *SpannerReadModel) approximateCount(ctx context.Context, req *list_products.Request) (total int, ok bool, err error) {
+	sql := fmt.Sprintf("SELECT SUM(%s) AS total FROM %s WHERE %s = @tenant",
+		m_product_count.Count, m_product_count.TableName, m_product_count.TenantID)
+	params := map[string]interface{}{"tenant": req.TenantID}
+	if req.Category != "" {
+		sql += fmt.Sprintf(" AND %s = @category", m_product_count.Category)
+		params["category"] = req.Category
+	}
+	if req.Status != "" {
+		sql += fmt.Sprintf(" AND %s = @status", m_product_count.Status)
+		params["status"] = req.Status
+	}
+
+	iter := r.client.Single().Query(ctx, spanner.Statement{SQL: sql, Params: params})
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err == iterator.Done {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to get approximate count: %w", err)
+	}
+	var sum spanner.NullInt64
+	if err := row.ColumnByName("total", &sum); err != nil {
+		return 0, false, fmt.Errorf("failed to read approximate count: %w", err)
+	}
+	if !sum.Valid {
+		return 0, false, nil
+	}
+	return int(sum.Int64), true, nil
+}
+
+// countProducts counts the products matching a WHERE clause
+func (r *SpannerReadModel) countProducts(ctx context.Context, whereClause string, args []interface{}) (int, error) {
+	countQuery := fmt.Sprintf(`
+		SELECT COUNT(*) as total
+		FROM %s
+		%s
+	`, m_product.TableName, whereClause)
+
+	countStmt := spanner.Statement{
+		SQL:    countQuery,
+		Params: buildParams(args),
+	}
+
+	countIter := r.client.Single().Query(ctx, countStmt)
+	defer countIter.Stop()
+
+	countRow, err := countIter.Next()
+	if err == iterator.Done {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get total count: %w", err)
+	}
+	var countValue int64
+	if err := countRow.ColumnByName("total", &countValue); err != nil {
+		return 0, fmt.Errorf("failed to read count: %w", err)
+	}
+	return int(countValue), nil
+}
+
+// FindSimilarProducts retrieves non-archived products of the tenant matching on
+// name+category (case-insensitive), SKU, or GTIN
+func (r *SpannerReadModel) FindSimilarProducts(ctx context.Context, req *find_similar_products.Request) (*find_similar_products.DTO, error) {
+	args := []interface{}{req.TenantID}
+	var conditions []string
+
+	if req.Name != "" && req.Category != "" {
+		conditions = append(conditions, fmt.Sprintf("(category = @p%d AND LOWER(name) = LOWER(@p%d))", len(args)+1, len(args)+2))
+		args = append(args, req.Category, req.Name)
+	}
+	if req.SKU != "" {
+		conditions = append(conditions, fmt.Sprintf("sku = @p%d", len(args)+1))
+		args = append(args, req.SKU)
+	}
+	if req.GTIN != "" {
+		conditions = append(conditions, fmt.Sprintf("gtin = @p%d", len(args)+1))
+		args = append(args, req.GTIN)
+	}
+	if len(conditions) == 0 {
+		return &find_similar_products.DTO{}, nil
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM %s
+		WHERE tenant_id = @p1 AND archived_at IS NULL AND (%s)
+		ORDER BY created_at
+		LIMIT @p%d
+	`, buildColumnList(m_product.FullColumns()), m_product.TableName, strings.Join(conditions, " OR "), len(args)+1)
+	args = append(args, int64(req.Limit))
+
+	iter := r.client.Single().Query(ctx, spanner.Statement{SQL: query, Params: buildParams(args)})
+	defer iter.Stop()
+
+	var matches []find_similar_products.Match
+	scanner := m_product.NewScanner(m_product.FullColumns())
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to iterate similar products: %w", err)
+		}
+
+		model, err := scanner.Scan(row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse product row: %w", err)
+		}
+
+		match := find_similar_products.Match{
+			ID:        model.ProductID,
+			Name:      model.Name,
+			Category:  model.Category,
+			SKU:       stringValue(model.SKU),
+			GTIN:      stringValue(model.GTIN),
+			Status:    model.Status,
+			CreatedAt: model.CreatedAt,
+		}
+		if req.Name != "" && model.Category == req.Category && strings.EqualFold(model.Name, req.Name) {
+			match.MatchedOn = append(match.MatchedOn, find_similar_products.MatchedOnNameCategory)
+		}
+		if req.SKU != "" && match.SKU == req.SKU {
+			match.MatchedOn = append(match.MatchedOn, find_similar_products.MatchedOnSKU)
+		}
+		if req.GTIN != "" && match.GTIN == req.GTIN {
+			match.MatchedOn = append(match.MatchedOn, find_similar_products.MatchedOnGTIN)
+		}
+		matches = append(matches, match)
+	}
+
+	return &find_similar_products.DTO{Matches: matches}, nil
+}
+
+// SearchProducts retrieves the newest active products of the tenant whose name or description
+// contains every term of the query, or one of its synonyms (case-insensitive); ranking is left to the caller
+// Typo-tolerant terms also accept text sharing at least half of the word's trigrams, a loose filter
+// the caller narrows down with an edit distance check
+// Requests by effective price bound and order the candidates by the stored effective price instead of age
+func (r *SpannerReadModel) SearchProducts(ctx context.Context, req *search_products.Request) (*search_products.DTO, error) {
+	args := []interface{}{req.TenantID, string(domain.ProductStatusActive)}
+	var conditions []string
+	for _, term := range req.Terms {
+		var alternatives []string
+		for _, word := range append([]string{term.Word}, term.Synonyms...) {
+			alternatives = append(alternatives, fmt.Sprintf("STRPOS(LOWER(name), @p%d) > 0 OR STRPOS(LOWER(description), @p%d) > 0", len(args)+1, len(args)+1))
+			args = append(args, word)
+		}
+		if trigrams := search_products.Trigrams(term.Word); term.MaxEdits > 0 && len(trigrams) > 0 {
+			var shared []string
+			for _, trigram := range trigrams {
+				shared = append(shared, fmt.Sprintf("IF(STRPOS(LOWER(CONCAT(name, ' ', description)), @p%d) > 0, 1, 0)", len(args)+1))
+				args = append(args, trigram)
+			}
+			alternatives = append(alternatives, fmt.Sprintf("(%s) >= %d", strings.Join(shared, " + "), (len(trigrams)+1)/2))
+		}
+		conditions = append(conditions, "("+strings.Join(alternatives, " OR ")+")")
+	}
+	if len(conditions) == 0 {
+		return &search_products.DTO{}, nil
+	}
+	// Term matching cannot use an index, so the row limit is all that bounds the scan
+	if r.maxScanRows > 0 && req.Limit > r.maxScanRows {
+		return nil, fmt.Errorf("%w: limit %d exceeds %d rows", domain.ErrUnboundedScan, req.Limit, r.maxScanRows)
+	}
+
+	// By effective price, the candidates are the cheapest (or dearest) matches rather than the newest
+	price := effectivePriceExpr(req.EnforceMAP)
+	if req.MinEffectivePrice != nil {
+		conditions = append(conditions, fmt.Sprintf("%s >= @p%d", price, len(args)+1))
+		args = append(args, req.MinEffectivePrice)
+	}
+	if req.MaxEffectivePrice != nil {
+		conditions = append(conditions, fmt.Sprintf("%s <= @p%d", price, len(args)+1))
+		args = append(args, req.MaxEffectivePrice)
+	}
+	columns := buildColumnList([]string{m_product.ProductID, m_product.Name, m_product.Description, m_product.Category, m_product.CreatedAt})
+	orderBy := "created_at DESC"
+	byPrice := req.OrderBy == search_products.OrderByEffectivePrice || req.OrderBy == search_products.OrderByEffectivePriceDesc
+	if byPrice {
+		columns += fmt.Sprintf(", %s AS %s", price, m_product.EffectivePrice)
+		orderBy = fmt.Sprintf("%s IS NULL, %s, created_at DESC", price, price)
+		if req.OrderBy == search_products.OrderByEffectivePriceDesc {
+			orderBy = fmt.Sprintf("%s IS NULL, %s DESC, created_at DESC", price, price)
+		}
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM %s
+		WHERE tenant_id = @p1 AND status = @p2 AND archived_at IS NULL AND %s
+		ORDER BY %s
+		LIMIT @p%d
+	`, columns, m_product.TableName, strings.Join(conditions, " AND "), orderBy, len(args)+1)
+	args = append(args, int64(req.Limit))
+
+	iter := r.client.Single().Query(ctx, spanner.Statement{SQL: query, Params: buildParams(args)})
+	defer iter.Stop()
+
+	var hits []search_products.Hit
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to iterate search results: %w", err)
+		}
+
+		var hit search_products.Hit
+		dest := []interface{}{&hit.ID, &hit.Name, &hit.Description, &hit.Category, &hit.CreatedAt}
+		if byPrice {
+			dest = append(dest, &hit.EffectivePrice)
+		}
+		if err := row.Columns(dest...); err != nil {
+			return nil, fmt.Errorf("failed to parse search result row: %w", err)
+		}
+		hits = append(hits, hit)
+	}
+
+	return &search_products.DTO{Hits: hits}, nil
+}
+
+// effectivePriceExpr is the SQL for a product's stored effective price; with enforceMAP it is raised to
+// the minimum advertised price, as CalculateDisplayPrice shows it
+func effectivePriceExpr(enforceMAP bool) string {
+	if !enforceMAP {
+		return m_product.EffectivePrice
+	}
+	return fmt.Sprintf("IF(%s > %s, %s, %s)", m_product.MapPrice, m_product.EffectivePrice, m_product.MapPrice, m_product.EffectivePrice)
+}
+
 // modelToDTO converts a database model to a GetProduct DTO
 func (r *SpannerReadModel) modelToDTO(model *m_product.Product) *get_product.DTO {
 	// Convert numerator/denominator to *big.Rat
@@ -153,23 +517,58 @@ func (r *SpannerReadModel) modelToDTO(model *m_product.Product) *get_product.DTO
 
 	return &get_product.DTO{
 		ID:                model.ProductID,
+		TenantID:          model.TenantID,
 		Name:              model.Name,
 		Description:       model.Description,
+		DescriptionFormat: descriptionFormatFromModel(model.DescriptionFormat),
 		Category:          model.Category,
+		SKU:               stringValue(model.SKU),
+		GTIN:              stringValue(model.GTIN),
 		BasePrice:         basePrice,
 		DiscountID:        model.DiscountID,
 		DiscountAmount:    model.DiscountAmount,
 		DiscountStartDate: model.DiscountStartDate,
 		DiscountEndDate:   model.DiscountEndDate,
 		Status:            model.Status,
+		LegalHold:         model.LegalHold,
+		Channels:          model.Channels,
+		ProductType:       productTypeFromModel(model.ProductType),
+		Shipping:          shippingFromModel(model),
+		DigitalDelivery:   digitalFromModel(model),
+		Compliance:        complianceFromModel(model),
+		Metadata:          domain.MetadataFromEntries(model.Metadata),
+		Attributes:        domain.AttributesFromEntries(model.Attributes),
+		PriceFloor:        priceFloorFromModel(model),
 		ArchivedAt:        model.ArchivedAt,
 		CreatedAt:         model.CreatedAt,
 		UpdatedAt:         model.UpdatedAt,
 	}
 }
 
+// ProductItemScanner maps rows of the products table to ListProducts items
+// It is the per-row cost of ListProducts and is exported for the benchmarks in tests/bench
+type ProductItemScanner struct {
+	scanner *m_product.Scanner
+}
+
+// NewProductItemScanner creates a scanner to be reused for all rows of one query read with columns
+func NewProductItemScanner(columns []string) *ProductItemScanner {
+	return &ProductItemScanner{
+		scanner: m_product.NewScanner(columns),
+	}
+}
+
+// Scan maps one row to a ListProducts item
+func (s *ProductItemScanner) Scan(row *spanner.Row) (list_products.ProductItem, error) {
+	model, err := s.scanner.Scan(row)
+	if err != nil {
+		return list_products.ProductItem{}, fmt.Errorf("failed to parse product row: %w", err)
+	}
+	return modelToProductItem(model), nil
+}
+
 // modelToProductItem converts a database model to a ListProducts ProductItem
-func (r *SpannerReadModel) modelToProductItem(model *m_product.Product) list_products.ProductItem {
+func modelToProductItem(model *m_product.Product) list_products.ProductItem {
 	// Convert numerator/denominator to *big.Rat
 	var basePrice *big.Rat
 	if model.BasePriceDenominator != 0 {
@@ -178,15 +577,28 @@ func (r *SpannerReadModel) modelToProductItem(model *m_product.Product) list_pro
 
 	return list_products.ProductItem{
 		ID:                model.ProductID,
+		TenantID:          model.TenantID,
 		Name:              model.Name,
 		Description:       model.Description,
+		DescriptionFormat: descriptionFormatFromModel(model.DescriptionFormat),
 		Category:          model.Category,
+		SKU:               stringValue(model.SKU),
+		GTIN:              stringValue(model.GTIN),
 		BasePrice:         basePrice,
 		DiscountID:        model.DiscountID,
 		DiscountAmount:    model.DiscountAmount,
 		DiscountStartDate: model.DiscountStartDate,
 		DiscountEndDate:   model.DiscountEndDate,
 		Status:            model.Status,
+		LegalHold:         model.LegalHold,
+		Channels:          model.Channels,
+		ProductType:       productTypeFromModel(model.ProductType),
+		Shipping:          shippingFromModel(model),
+		DigitalDelivery:   digitalFromModel(model),
+		Compliance:        complianceFromModel(model),
+		Metadata:          domain.MetadataFromEntries(model.Metadata),
+		Attributes:        domain.AttributesFromEntries(model.Attributes),
+		PriceFloor:        priceFloorFromModel(model),
 		ArchivedAt:        model.ArchivedAt,
 		CreatedAt:         model.CreatedAt,
 		UpdatedAt:         model.UpdatedAt,
@@ -0,0 +1,180 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/models/m_external_ref"
+	"catalog-proj/internal/models/m_outbox"
+	"catalog-proj/internal/models/m_product"
+	"catalog-proj/internal/models/m_product_trend"
+	"catalog-proj/internal/models/m_product_view"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+)
+
+// SpannerRetentionStore implements RetentionStore using Spanner
+type SpannerRetentionStore struct {
+	client *spanner.Client
+}
+
+// NewSpannerRetentionStore creates a new Spanner retention store
+func NewSpannerRetentionStore(client *spanner.Client) *SpannerRetentionStore {
+	return &SpannerRetentionStore{
+		client: client,
+	}
+}
+
+// FindPurgeCandidates returns products archived before the cutoff that are not under legal hold, oldest first
+func (s *SpannerRetentionStore) FindPurgeCandidates(ctx context.Context, archivedBefore time.Time, limit int) ([]contracts.PurgeCandidate, error) {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(`SELECT p.product_id, p.tenant_id, p.archived_at,
+				(SELECT COUNT(*) FROM %s e WHERE e.aggregate_id = p.product_id) AS event_count
+			FROM %s p
+			WHERE p.archived_at IS NOT NULL AND p.archived_at < @cutoff AND p.legal_hold = false
+			ORDER BY p.archived_at
+			LIMIT @limit`, m_outbox.TableName, m_product.TableName),
+		Params: map[string]interface{}{"cutoff": archivedBefore, "limit": int64(limit)},
+	}
+
+	iter := s.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var candidates []contracts.PurgeCandidate
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to find purge candidates: %w", err)
+		}
+
+		var c contracts.PurgeCandidate
+		if err := row.Columns(&c.ProductID, &c.TenantID, &c.ArchivedAt, &c.EventCount); err != nil {
+			return nil, fmt.Errorf("failed to parse purge candidate: %w", err)
+		}
+		candidates = append(candidates, c)
+	}
+	return candidates, nil
+}
+
+// FindHeldProducts returns IDs of products archived before the cutoff that are under legal hold
+func (s *SpannerRetentionStore) FindHeldProducts(ctx context.Context, archivedBefore time.Time, limit int) ([]string, error) {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(`SELECT product_id FROM %s
+			WHERE archived_at IS NOT NULL AND archived_at < @cutoff AND legal_hold = true
+			ORDER BY archived_at
+			LIMIT @limit`, m_product.TableName),
+		Params: map[string]interface{}{"cutoff": archivedBefore, "limit": int64(limit)},
+	}
+
+	iter := s.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var ids []string
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to find held products: %w", err)
+		}
+
+		var id string
+		if err := row.Columns(&id); err != nil {
+			return nil, fmt.Errorf("failed to parse held product: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// Purge hard-deletes a product and its outbox events
+// Archive state and legal hold are re-read inside the read-write transaction so a hold
+// placed after the candidate scan always wins
+func (s *SpannerRetentionStore) Purge(ctx context.Context, productID string, archivedBefore time.Time, extra ...*spanner.Mutation) (bool, int64, error) {
+	var (
+		purged        bool
+		eventsDeleted int64
+	)
+	_, err := s.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		purged, eventsDeleted = false, 0
+
+		row, err := txn.ReadRow(ctx, m_product.TableName, spanner.Key{productID}, []string{m_product.ArchivedAt, m_product.LegalHold})
+		if err != nil {
+			if spanner.ErrCode(err) == codes.NotFound {
+				return nil // Already gone
+			}
+			return err
+		}
+		var (
+			archivedAt spanner.NullTime
+			legalHold  bool
+		)
+		if err := row.Columns(&archivedAt, &legalHold); err != nil {
+			return err
+		}
+		if !archivedAt.Valid || !archivedAt.Time.Before(archivedBefore) || legalHold {
+			return nil
+		}
+
+		mutations := []*spanner.Mutation{spanner.Delete(m_product.TableName, spanner.Key{productID})}
+
+		// Events are keyed by aggregate, so one prefix delete removes the product's whole history
+		countIter := txn.Query(ctx, spanner.Statement{
+			SQL:    fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE %s = @id`, m_outbox.TableName, m_outbox.AggregateID),
+			Params: map[string]interface{}{"id": productID},
+		})
+		row, err = countIter.Next()
+		countIter.Stop()
+		if err != nil {
+			return err
+		}
+		if err := row.Columns(&eventsDeleted); err != nil {
+			return err
+		}
+		mutations = append(mutations, spanner.Delete(m_outbox.TableName, spanner.Key{productID}.AsPrefix()))
+
+		// External references of a purged product would only resolve to NotFound, so free them for reuse
+		refIter := txn.Query(ctx, spanner.Statement{
+			SQL: fmt.Sprintf(`SELECT %s, %s, %s FROM %s WHERE %s = @id`,
+				m_external_ref.TenantID, m_external_ref.System, m_external_ref.ExternalID,
+				m_external_ref.TableName, m_external_ref.ProductID),
+			Params: map[string]interface{}{"id": productID},
+		})
+		defer refIter.Stop()
+		for {
+			row, err := refIter.Next()
+			if err == iterator.Done {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			ref := &m_external_ref.ExternalRef{}
+			if err := row.Columns(&ref.TenantID, &ref.System, &ref.ExternalID); err != nil {
+				return err
+			}
+			mutations = append(mutations, ref.DeleteMut())
+		}
+
+		// Deleting a missing row is a no-op, so the view counter and trending score need no read
+		mutations = append(mutations,
+			(&m_product_view.ProductView{ProductID: productID}).DeleteMut(),
+			(&m_product_trend.ProductTrend{ProductID: productID}).DeleteMut())
+
+		mutations = append(mutations, extra...)
+		purged = true
+		return txn.BufferWrite(mutations)
+	})
+	if err != nil {
+		return false, 0, fmt.Errorf("failed to purge product %s: %w", productID, err)
+	}
+	return purged, eventsDeleted, nil
+}
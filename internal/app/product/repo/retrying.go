@@ -0,0 +1,120 @@
+package repo
+
+import (
+	"context"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/queries/find_similar_products"
+	"catalog-proj/internal/app/product/queries/get_product"
+	"catalog-proj/internal/app/product/queries/list_products"
+	"catalog-proj/internal/app/product/queries/search_products"
+	"catalog-proj/internal/pkg/retry"
+
+	"cloud.google.com/go/spanner"
+)
+
+// RetryingProductRepository retries transient Spanner errors on repository reads
+// Mutation builders are pure and are delegated as-is
+type RetryingProductRepository struct {
+	inner   contracts.ProductRepository
+	retrier *retry.Retrier
+}
+
+// NewRetryingProductRepository wraps a product repository with retry/backoff
+func NewRetryingProductRepository(inner contracts.ProductRepository, retrier *retry.Retrier) *RetryingProductRepository {
+	return &RetryingProductRepository{
+		inner:   inner,
+		retrier: retrier,
+	}
+}
+
+// InsertMut delegates to the wrapped repository
+func (r *RetryingProductRepository) InsertMut(product *domain.Product) *spanner.Mutation {
+	return r.inner.InsertMut(product)
+}
+
+// UpdateMut delegates to the wrapped repository
+func (r *RetryingProductRepository) UpdateMut(product *domain.Product) *spanner.Mutation {
+	return r.inner.UpdateMut(product)
+}
+
+// Load retrieves a product, retrying transient failures
+func (r *RetryingProductRepository) Load(ctx context.Context, id string) (*domain.Product, error) {
+	var product *domain.Product
+	err := r.retrier.Do(ctx, "repo.load", func(ctx context.Context) error {
+		var err error
+		product, err = r.inner.Load(ctx, id)
+		return err
+	})
+	return product, err
+}
+
+// RetryingReadModel retries transient Spanner errors on read model queries
+type RetryingReadModel struct {
+	inner   contracts.ReadModel
+	retrier *retry.Retrier
+}
+
+// NewRetryingReadModel wraps a read model with retry/backoff
+func NewRetryingReadModel(inner contracts.ReadModel, retrier *retry.Retrier) *RetryingReadModel {
+	return &RetryingReadModel{
+		inner:   inner,
+		retrier: retrier,
+	}
+}
+
+// GetProduct retrieves a single product, retrying transient failures
+func (r *RetryingReadModel) GetProduct(ctx context.Context, id string) (*get_product.DTO, error) {
+	var dto *get_product.DTO
+	err := r.retrier.Do(ctx, "read_model.get", func(ctx context.Context) error {
+		var err error
+		dto, err = r.inner.GetProduct(ctx, id)
+		return err
+	})
+	return dto, err
+}
+
+// BatchGetProducts retrieves several products, retrying transient failures
+func (r *RetryingReadModel) BatchGetProducts(ctx context.Context, ids []string) ([]*get_product.DTO, error) {
+	var dtos []*get_product.DTO
+	err := r.retrier.Do(ctx, "read_model.batch_get", func(ctx context.Context) error {
+		var err error
+		dtos, err = r.inner.BatchGetProducts(ctx, ids)
+		return err
+	})
+	return dtos, err
+}
+
+// ListProducts retrieves a page of products, retrying transient failures
+func (r *RetryingReadModel) ListProducts(ctx context.Context, req *list_products.Request) (*list_products.DTO, error) {
+	var dto *list_products.DTO
+	err := r.retrier.Do(ctx, "read_model.list", func(ctx context.Context) error {
+		var err error
+		dto, err = r.inner.ListProducts(ctx, req)
+		return err
+	})
+	return dto, err
+}
+
+// FindSimilarProducts retrieves similar products, retrying transient failures
+func (r *RetryingReadModel) FindSimilarProducts(ctx context.Context, req *find_similar_products.Request) (*find_similar_products.DTO, error) {
+	var dto *find_similar_products.DTO
+	err := r.retrier.Do(ctx, "read_model.find_similar", func(ctx context.Context) error {
+		var err error
+		dto, err = r.inner.FindSimilarProducts(ctx, req)
+		return err
+	})
+	return dto, err
+}
+
+// SearchProducts retrieves search candidates, retrying transient failures
+func (r *RetryingReadModel) SearchProducts(ctx context.Context, req *search_products.Request) (*search_products.DTO, error) {
+	var dto *search_products.DTO
+	err := r.retrier.Do(ctx, "read_model.search", func(ctx context.Context) error {
+		var err error
+		dto, err = r.inner.SearchProducts(ctx, req)
+		return err
+	})
+	return dto, err
+}
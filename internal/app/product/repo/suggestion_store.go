@@ -0,0 +1,117 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/queries/suggest_products"
+	"catalog-proj/internal/models/m_product"
+	"catalog-proj/internal/models/m_product_suggestion"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+)
+
+// SpannerSuggestionStore implements SuggestionStore and the suggest products source using Spanner
+type SpannerSuggestionStore struct {
+	client *spanner.Client
+}
+
+// NewSpannerSuggestionStore creates a new Spanner suggestion store
+func NewSpannerSuggestionStore(client *spanner.Client) *SpannerSuggestionStore {
+	return &SpannerSuggestionStore{
+		client: client,
+	}
+}
+
+// RefreshSuggestions replaces the suggestions with the distinct names and categories of active products
+// Texts differing only in case or spacing share a key; the smallest spelling is shown and the
+// products of every spelling count towards its popularity
+func (s *SpannerSuggestionStore) RefreshSuggestions(ctx context.Context, computedAt time.Time) (int, error) {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(`
+			SELECT tenant_id, suggest_key, kind, MIN(text) AS text, COUNT(*) AS popularity
+			FROM (
+				SELECT tenant_id, REGEXP_REPLACE(LOWER(TRIM(%[1]s)), r'\s+', ' ') AS suggest_key, @name AS kind, %[1]s AS text
+				FROM %[3]s WHERE status = @active AND archived_at IS NULL
+				UNION ALL
+				SELECT tenant_id, REGEXP_REPLACE(LOWER(TRIM(%[2]s)), r'\s+', ' ') AS suggest_key, @category AS kind, %[2]s AS text
+				FROM %[3]s WHERE status = @active AND archived_at IS NULL
+			)
+			WHERE suggest_key != ''
+			GROUP BY tenant_id, suggest_key, kind`,
+			m_product.Name, m_product.Category, m_product.TableName),
+		Params: map[string]interface{}{
+			"active":   string(domain.ProductStatusActive),
+			"name":     suggest_products.KindName,
+			"category": suggest_products.KindCategory,
+		},
+	}
+
+	// Read outside the write transaction; suggestions lag the catalog by a refresh anyway
+	iter := s.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	mutations := []*spanner.Mutation{spanner.Delete(m_product_suggestion.TableName, spanner.AllKeys())}
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to compute suggestions: %w", err)
+		}
+
+		suggestion := &m_product_suggestion.ProductSuggestion{ComputedAt: computedAt}
+		if err := row.Columns(&suggestion.TenantID, &suggestion.SuggestKey, &suggestion.Kind, &suggestion.Text, &suggestion.Popularity); err != nil {
+			return 0, fmt.Errorf("failed to parse suggestion: %w", err)
+		}
+		mutations = append(mutations, suggestion.InsertMut())
+	}
+
+	if _, err := s.client.Apply(ctx, mutations); err != nil {
+		return 0, fmt.Errorf("failed to store suggestions: %w", err)
+	}
+	return len(mutations) - 1, nil
+}
+
+// Suggest reads the tenant's suggestions whose key starts with the prefix, most popular first
+func (s *SpannerSuggestionStore) Suggest(ctx context.Context, req *suggest_products.Request) (*suggest_products.DTO, error) {
+	iter := s.client.Single().Query(ctx, spanner.Statement{
+		SQL: fmt.Sprintf(`
+			SELECT %s, %s, %s
+			FROM %s
+			WHERE %s = @tenant AND STARTS_WITH(%s, @prefix)
+			ORDER BY %s DESC, %s
+			LIMIT @limit`,
+			m_product_suggestion.Text, m_product_suggestion.Kind, m_product_suggestion.Popularity,
+			m_product_suggestion.TableName,
+			m_product_suggestion.TenantID, m_product_suggestion.SuggestKey,
+			m_product_suggestion.Popularity, m_product_suggestion.SuggestKey),
+		Params: map[string]interface{}{
+			"tenant": req.TenantID,
+			"prefix": req.Prefix,
+			"limit":  int64(req.Limit),
+		},
+	})
+	defer iter.Stop()
+
+	var suggestions []suggest_products.Suggestion
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read suggestions: %w", err)
+		}
+		var suggestion suggest_products.Suggestion
+		if err := row.Columns(&suggestion.Text, &suggestion.Kind, &suggestion.Popularity); err != nil {
+			return nil, fmt.Errorf("failed to parse suggestion: %w", err)
+		}
+		suggestions = append(suggestions, suggestion)
+	}
+	return &suggest_products.DTO{Suggestions: suggestions}, nil
+}
@@ -0,0 +1,177 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/models/m_api_key"
+	"catalog-proj/internal/models/m_api_usage"
+	"catalog-proj/internal/models/m_category_template"
+	"catalog-proj/internal/models/m_curated_list"
+	"catalog-proj/internal/models/m_external_ref"
+	"catalog-proj/internal/models/m_merch_rule"
+	"catalog-proj/internal/models/m_outbox"
+	"catalog-proj/internal/models/m_pending_change"
+	"catalog-proj/internal/models/m_product"
+	"catalog-proj/internal/models/m_product_alias"
+	"catalog-proj/internal/models/m_product_count"
+	"catalog-proj/internal/models/m_product_suggestion"
+	"catalog-proj/internal/models/m_product_trend"
+	"catalog-proj/internal/models/m_product_view"
+	"catalog-proj/internal/models/m_tenant_setting"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+)
+
+// tenantTables are the tenant-scoped tables PurgeTables empties for a tenant
+// Products and their interleaved versions and drafts are purged one by one; operations and jobs
+// are kept so the DeleteTenant operation itself stays readable
+var tenantTables = []string{
+	m_product_count.TableName,
+	"product_redirects",
+	m_product_alias.TableName,
+	m_external_ref.TableName,
+	m_pending_change.TableName,
+	m_merch_rule.TableName,
+	m_product_suggestion.TableName,
+	m_product_view.TableName,
+	m_product_trend.TableName,
+	m_curated_list.TableName,
+	m_api_key.TableName,
+	m_api_usage.TableName,
+	m_category_template.TableName,
+	m_tenant_setting.TableName,
+}
+
+// SpannerTenantPurger implements TenantPurger using Spanner
+type SpannerTenantPurger struct {
+	client *spanner.Client
+}
+
+// NewSpannerTenantPurger creates a new Spanner tenant purger
+func NewSpannerTenantPurger(client *spanner.Client) *SpannerTenantPurger {
+	return &SpannerTenantPurger{
+		client: client,
+	}
+}
+
+// ScanProducts reads a page of the tenant's product IDs from idx_products_tenant_category, archived products included
+func (p *SpannerTenantPurger) ScanProducts(ctx context.Context, tenantID, afterID string, limit int) ([]string, error) {
+	iter := p.client.Single().Query(ctx, spanner.Statement{
+		SQL: fmt.Sprintf(`SELECT product_id FROM %s@{FORCE_INDEX=idx_products_tenant_category}
+			WHERE tenant_id = @tenant AND product_id > @after
+			ORDER BY product_id LIMIT @limit`, m_product.TableName),
+		Params: map[string]interface{}{
+			"tenant": tenantID,
+			"after":  afterID,
+			"limit":  int64(limit),
+		},
+	})
+	defer iter.Stop()
+
+	var ids []string
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan tenant products: %w", err)
+		}
+		var id string
+		if err := row.Columns(&id); err != nil {
+			return nil, fmt.Errorf("failed to parse product ID: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// CountProducts counts the products a scan starting after afterID would visit
+func (p *SpannerTenantPurger) CountProducts(ctx context.Context, tenantID, afterID string) (int64, error) {
+	return p.count(ctx, spanner.Statement{
+		SQL: fmt.Sprintf(`SELECT COUNT(*) FROM %s@{FORCE_INDEX=idx_products_tenant_category}
+			WHERE tenant_id = @tenant AND product_id > @after`, m_product.TableName),
+		Params: map[string]interface{}{"tenant": tenantID, "after": afterID},
+	})
+}
+
+// CountHeldProducts counts the tenant's products under legal hold
+func (p *SpannerTenantPurger) CountHeldProducts(ctx context.Context, tenantID string) (int64, error) {
+	return p.count(ctx, spanner.Statement{
+		SQL: fmt.Sprintf(`SELECT COUNT(*) FROM %s
+			WHERE tenant_id = @tenant AND legal_hold = true`, m_product.TableName),
+		Params: map[string]interface{}{"tenant": tenantID},
+	})
+}
+
+func (p *SpannerTenantPurger) count(ctx context.Context, stmt spanner.Statement) (int64, error) {
+	iter := p.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count tenant products: %w", err)
+	}
+	var count int64
+	if err := row.Columns(&count); err != nil {
+		return 0, fmt.Errorf("failed to parse product count: %w", err)
+	}
+	return count, nil
+}
+
+// PurgeProduct deletes the product row, which cascades to its versions and drafts, and prefix-deletes its outbox events
+// External references, views and trending scores go with the rest of the tenant's rows in PurgeTables
+func (p *SpannerTenantPurger) PurgeProduct(ctx context.Context, productID string, extra ...*spanner.Mutation) (bool, error) {
+	var purged bool
+	_, err := p.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		purged = false
+
+		row, err := txn.ReadRow(ctx, m_product.TableName, spanner.Key{productID}, []string{m_product.LegalHold})
+		if err != nil {
+			if spanner.ErrCode(err) == codes.NotFound {
+				return nil // Already gone
+			}
+			return err
+		}
+		var legalHold bool
+		if err := row.Columns(&legalHold); err != nil {
+			return err
+		}
+		if legalHold {
+			return domain.ErrTenantUnderLegalHold
+		}
+
+		mutations := []*spanner.Mutation{
+			spanner.Delete(m_product.TableName, spanner.Key{productID}),
+			spanner.Delete(m_outbox.TableName, spanner.Key{productID}.AsPrefix()),
+		}
+		mutations = append(mutations, extra...)
+		purged = true
+		return txn.BufferWrite(mutations)
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to purge product %s: %w", productID, err)
+	}
+	return purged, nil
+}
+
+// PurgeTables runs one partitioned DML delete per table, so no transaction has to hold a whole tenant's rows
+// Partitioned DML is idempotent per row, so a failed purge can simply be run again
+func (p *SpannerTenantPurger) PurgeTables(ctx context.Context, tenantID string) (int64, error) {
+	var total int64
+	for _, table := range tenantTables {
+		count, err := p.client.PartitionedUpdate(ctx, spanner.Statement{
+			SQL:    fmt.Sprintf(`DELETE FROM %s WHERE tenant_id = @tenant`, table),
+			Params: map[string]interface{}{"tenant": tenantID},
+		})
+		if err != nil {
+			return total, fmt.Errorf("failed to purge %s: %w", table, err)
+		}
+		total += count
+	}
+	return total, nil
+}
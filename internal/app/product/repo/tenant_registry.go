@@ -0,0 +1,95 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/models/m_tenant"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
+)
+
+// SpannerTenantRegistry implements TenantRegistry using Spanner
+type SpannerTenantRegistry struct {
+	client *spanner.Client
+}
+
+// NewSpannerTenantRegistry creates a new Spanner tenant registry
+func NewSpannerTenantRegistry(client *spanner.Client) *SpannerTenantRegistry {
+	return &SpannerTenantRegistry{
+		client: client,
+	}
+}
+
+// Load reads a tenant by primary key
+func (r *SpannerTenantRegistry) Load(ctx context.Context, tenantID string) (*domain.Tenant, error) {
+	row, err := r.client.Single().ReadRow(ctx, m_tenant.TableName, spanner.Key{tenantID}, m_tenant.AllColumns())
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, domain.ErrTenantNotFound
+		}
+		return nil, fmt.Errorf("failed to load tenant: %w", err)
+	}
+	return rowToTenant(row)
+}
+
+// Register checks for a live tenant and writes the new one in a single read-write transaction,
+// so two concurrent CreateTenant calls cannot both succeed
+func (r *SpannerTenantRegistry) Register(ctx context.Context, t *domain.Tenant) error {
+	_, err := r.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		row, err := txn.ReadRow(ctx, m_tenant.TableName, spanner.Key{t.ID}, m_tenant.AllColumns())
+		switch {
+		case err == nil:
+			existing, err := rowToTenant(row)
+			if err != nil {
+				return err
+			}
+			switch existing.State {
+			case domain.TenantDeleted:
+			case domain.TenantDeleting:
+				return domain.ErrTenantBeingDeleted
+			default:
+				return domain.ErrTenantExists
+			}
+		case spanner.ErrCode(err) != codes.NotFound:
+			return err
+		}
+		return txn.BufferWrite([]*spanner.Mutation{r.SaveMut(t)})
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register tenant %s: %w", t.ID, err)
+	}
+	return nil
+}
+
+// SaveMut inserts or replaces the tenant's row
+func (r *SpannerTenantRegistry) SaveMut(t *domain.Tenant) *spanner.Mutation {
+	record := &m_tenant.Tenant{
+		TenantID:       t.ID,
+		RegistrationID: t.RegistrationID,
+		DisplayName:    t.DisplayName,
+		State:          string(t.State),
+		CreatedAt:      t.CreatedAt,
+		UpdatedAt:      t.UpdatedAt,
+		DeletedAt:      t.DeletedAt,
+	}
+	return record.UpsertMut()
+}
+
+func rowToTenant(row *spanner.Row) (*domain.Tenant, error) {
+	model := &m_tenant.Tenant{}
+	if err := row.ToStruct(model); err != nil {
+		return nil, fmt.Errorf("failed to parse tenant row: %w", err)
+	}
+	return &domain.Tenant{
+		ID:             model.TenantID,
+		RegistrationID: model.RegistrationID,
+		DisplayName:    model.DisplayName,
+		State:          domain.TenantState(model.State),
+		CreatedAt:      model.CreatedAt,
+		UpdatedAt:      model.UpdatedAt,
+		DeletedAt:      model.DeletedAt,
+	}, nil
+}
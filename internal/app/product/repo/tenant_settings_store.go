@@ -0,0 +1,69 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/models/m_tenant_setting"
+	"catalog-proj/internal/pkg/tenant"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
+)
+
+// SpannerTenantSettingsStore implements TenantSettingsStore using Spanner
+type SpannerTenantSettingsStore struct {
+	client *spanner.Client
+}
+
+// NewSpannerTenantSettingsStore creates a new Spanner tenant settings store
+func NewSpannerTenantSettingsStore(client *spanner.Client) *SpannerTenantSettingsStore {
+	return &SpannerTenantSettingsStore{
+		client: client,
+	}
+}
+
+// Load reads the caller's tenant settings by primary key
+func (s *SpannerTenantSettingsStore) Load(ctx context.Context) (*domain.TenantSettings, error) {
+	row, err := s.client.Single().ReadRow(ctx, m_tenant_setting.TableName, spanner.Key{tenant.FromContext(ctx)}, m_tenant_setting.AllColumns())
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to load tenant settings: %w", err)
+	}
+
+	model := &m_tenant_setting.TenantSetting{}
+	if err := row.ToStruct(model); err != nil {
+		return nil, fmt.Errorf("failed to parse tenant settings row: %w", err)
+	}
+	return &domain.TenantSettings{
+		DefaultCurrency:        model.DefaultCurrency,
+		MaxNameLength:          int(model.MaxNameLength),
+		MinDiscountBasisPoints: model.MinDiscountBasisPoints,
+		MaxDiscountBasisPoints: model.MaxDiscountBasisPoints,
+		UpdatedAt:              model.UpdatedAt,
+	}, nil
+}
+
+// UpsertMut inserts or replaces the tenant's settings
+func (s *SpannerTenantSettingsStore) UpsertMut(tenantID string, settings domain.TenantSettings) *spanner.Mutation {
+	record := &m_tenant_setting.TenantSetting{
+		TenantID:               tenantID,
+		DefaultCurrency:        settings.DefaultCurrency,
+		MaxNameLength:          int64(settings.MaxNameLength),
+		MinDiscountBasisPoints: settings.MinDiscountBasisPoints,
+		MaxDiscountBasisPoints: settings.MaxDiscountBasisPoints,
+		UpdatedAt:              settings.UpdatedAt,
+	}
+	return record.UpsertMut()
+}
+
+// DeleteMut deletes the tenant's settings
+func (s *SpannerTenantSettingsStore) DeleteMut(tenantID string) *spanner.Mutation {
+	record := &m_tenant_setting.TenantSetting{
+		TenantID: tenantID,
+	}
+	return record.DeleteMut()
+}
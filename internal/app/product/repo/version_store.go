@@ -0,0 +1,114 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/models/m_product_version"
+	"catalog-proj/internal/pkg/tenant"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+)
+
+// SpannerVersionStore implements VersionStore using Spanner
+type SpannerVersionStore struct {
+	client *spanner.Client
+}
+
+// NewSpannerVersionStore creates a new Spanner version store
+func NewSpannerVersionStore(client *spanner.Client) *SpannerVersionStore {
+	return &SpannerVersionStore{
+		client: client,
+	}
+}
+
+// Load reads a version by key; versions of other tenants are reported as missing
+func (s *SpannerVersionStore) Load(ctx context.Context, productID, versionID string) (*domain.ContentVersion, error) {
+	row, err := s.client.Single().ReadRow(ctx, m_product_version.TableName, spanner.Key{productID, versionID}, m_product_version.AllColumns())
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, domain.ErrVersionNotFound
+		}
+		return nil, fmt.Errorf("failed to load product version: %w", err)
+	}
+
+	model := &m_product_version.ProductVersion{}
+	if err := row.ToStruct(model); err != nil {
+		return nil, fmt.Errorf("failed to parse product version row: %w", err)
+	}
+	if model.TenantID != tenant.FromContext(ctx) {
+		return nil, domain.ErrVersionNotFound
+	}
+	return toContentVersion(model), nil
+}
+
+// List reads the product's versions from its interleaved rows, newest first
+func (s *SpannerVersionStore) List(ctx context.Context, productID string) ([]*domain.ContentVersion, error) {
+	iter := s.client.Single().Query(ctx, spanner.Statement{
+		SQL: fmt.Sprintf(`
+			SELECT %s, %s, %s, %s, %s, %s, %s, %s
+			FROM %s
+			WHERE %s = @product_id AND %s = @tenant_id
+			ORDER BY %s DESC, %s DESC`,
+			m_product_version.ProductID, m_product_version.VersionID, m_product_version.TenantID,
+			m_product_version.Name, m_product_version.Description, m_product_version.Category,
+			m_product_version.Metadata, m_product_version.CreatedAt,
+			m_product_version.TableName,
+			m_product_version.ProductID, m_product_version.TenantID,
+			m_product_version.CreatedAt, m_product_version.VersionID),
+		Params: map[string]interface{}{
+			"product_id": productID,
+			"tenant_id":  tenant.FromContext(ctx),
+		},
+	})
+	defer iter.Stop()
+
+	var versions []*domain.ContentVersion
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list product versions: %w", err)
+		}
+		model := &m_product_version.ProductVersion{}
+		if err := row.ToStruct(model); err != nil {
+			return nil, fmt.Errorf("failed to parse product version row: %w", err)
+		}
+		versions = append(versions, toContentVersion(model))
+	}
+	return versions, nil
+}
+
+// InsertMut inserts a new version
+func (s *SpannerVersionStore) InsertMut(version *domain.ContentVersion) *spanner.Mutation {
+	model := &m_product_version.ProductVersion{
+		ProductID:   version.ProductID,
+		VersionID:   version.ID,
+		TenantID:    version.TenantID,
+		Name:        version.Name,
+		Description: version.Description,
+		Category:    version.Category,
+		Metadata:    version.Metadata.Entries(),
+		CreatedAt:   version.CreatedAt,
+	}
+	return model.InsertMut()
+}
+
+// toContentVersion converts a database model to a domain ContentVersion
+func toContentVersion(model *m_product_version.ProductVersion) *domain.ContentVersion {
+	return &domain.ContentVersion{
+		ID:          model.VersionID,
+		ProductID:   model.ProductID,
+		TenantID:    model.TenantID,
+		Name:        model.Name,
+		Description: model.Description,
+		Category:    model.Category,
+		Metadata:    domain.MetadataFromEntries(model.Metadata),
+		CreatedAt:   model.CreatedAt,
+	}
+}
@@ -0,0 +1,120 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/app/product/queries/get_product_stats"
+	"catalog-proj/internal/models/m_product"
+	"catalog-proj/internal/models/m_product_view"
+	"catalog-proj/internal/pkg/tenant"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+)
+
+// SpannerViewStore implements ViewStore and the product stats source using Spanner
+type SpannerViewStore struct {
+	client *spanner.Client
+}
+
+// NewSpannerViewStore creates a new Spanner view store
+func NewSpannerViewStore(client *spanner.Client) *SpannerViewStore {
+	return &SpannerViewStore{
+		client: client,
+	}
+}
+
+// AddViews reads the products and their counters and writes the new totals in one read-write transaction,
+// so servers flushing at the same time never lose each other's counts
+func (s *SpannerViewStore) AddViews(ctx context.Context, counts map[contracts.ViewKey]int64, viewedAt time.Time) error {
+	keys := make([]spanner.KeySet, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, spanner.Key{key.ProductID})
+	}
+	keySet := spanner.KeySets(keys...)
+
+	_, err := s.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		// Each product's real tenant; views recorded under another tenant or for missing products are dropped
+		tenants := make(map[string]string, len(counts))
+		err := txn.Read(ctx, m_product.TableName, keySet, []string{m_product.ProductID, m_product.TenantID}).Do(func(row *spanner.Row) error {
+			var productID, tenantID string
+			if err := row.Columns(&productID, &tenantID); err != nil {
+				return err
+			}
+			tenants[productID] = tenantID
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to read viewed products: %w", err)
+		}
+
+		current := make(map[string]int64, len(counts))
+		err = txn.Read(ctx, m_product_view.TableName, keySet, []string{m_product_view.ProductID, m_product_view.ViewCount}).Do(func(row *spanner.Row) error {
+			var productID string
+			var viewCount int64
+			if err := row.Columns(&productID, &viewCount); err != nil {
+				return err
+			}
+			current[productID] = viewCount
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to read view counters: %w", err)
+		}
+
+		mutations := make([]*spanner.Mutation, 0, len(counts))
+		for key, n := range counts {
+			if tenants[key.ProductID] != key.TenantID {
+				continue
+			}
+			view := &m_product_view.ProductView{
+				ProductID:    key.ProductID,
+				TenantID:     key.TenantID,
+				ViewCount:    current[key.ProductID] + n,
+				LastViewedAt: viewedAt,
+			}
+			mutations = append(mutations, view.UpsertMut())
+		}
+		return txn.BufferWrite(mutations)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add product views: %w", err)
+	}
+	return nil
+}
+
+// Stats reads the view counters of the caller's tenant's products
+func (s *SpannerViewStore) Stats(ctx context.Context, ids []string) ([]get_product_stats.ProductStats, error) {
+	iter := s.client.Single().Query(ctx, spanner.Statement{
+		SQL: fmt.Sprintf(`SELECT %s, %s, %s FROM %s WHERE %s IN UNNEST(@ids) AND %s = @tenant`,
+			m_product_view.ProductID, m_product_view.ViewCount, m_product_view.LastViewedAt,
+			m_product_view.TableName, m_product_view.ProductID, m_product_view.TenantID),
+		Params: map[string]interface{}{
+			"ids":    ids,
+			"tenant": tenant.FromContext(ctx),
+		},
+	})
+	defer iter.Stop()
+
+	var stats []get_product_stats.ProductStats
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read product stats: %w", err)
+		}
+		var entry get_product_stats.ProductStats
+		var lastViewedAt time.Time
+		if err := row.Columns(&entry.ProductID, &entry.ViewCount, &lastViewedAt); err != nil {
+			return nil, fmt.Errorf("failed to parse product stats: %w", err)
+		}
+		entry.LastViewedAt = &lastViewedAt
+		stats = append(stats, entry)
+	}
+	return stats, nil
+}
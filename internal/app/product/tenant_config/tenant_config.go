@@ -0,0 +1,86 @@
+package tenant_config
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/pkg/clock"
+	"catalog-proj/internal/pkg/lru"
+	"catalog-proj/internal/pkg/tenant"
+)
+
+// maxCachedTenants bounds the settings cache
+const maxCachedTenants = 10000
+
+// cachedSettings is a tenant's effective settings remembered until expiresAt
+type cachedSettings struct {
+	settings  domain.TenantSettings
+	expiresAt time.Time
+}
+
+// TenantConfig resolves the settings use cases and queries apply to the caller's tenant:
+// the tenant's stored overrides on top of the service defaults
+type TenantConfig struct {
+	store    contracts.TenantSettingsStore
+	defaults domain.TenantSettings
+	clock    clock.Clock
+	cacheTTL time.Duration
+	cache    *lru.Cache[string, cachedSettings]
+}
+
+// NewTenantConfig creates a tenant config over the stored overrides
+// Effective settings are cached for cacheTTL (0 disables caching), so an override changed on
+// another server can take that long to apply here
+func NewTenantConfig(store contracts.TenantSettingsStore, defaults domain.TenantSettings, clock clock.Clock, cacheTTL time.Duration) *TenantConfig {
+	return &TenantConfig{
+		store:    store,
+		defaults: defaults,
+		clock:    clock,
+		cacheTTL: cacheTTL,
+		cache:    lru.New[string, cachedSettings](maxCachedTenants),
+	}
+}
+
+// Defaults returns the settings of tenants without overrides
+func (c *TenantConfig) Defaults() domain.TenantSettings {
+	return c.defaults
+}
+
+// Settings returns the effective settings of the caller's tenant
+// A nil config returns zero settings, which impose no limits
+func (c *TenantConfig) Settings(ctx context.Context) (domain.TenantSettings, error) {
+	if c == nil {
+		return domain.TenantSettings{}, nil
+	}
+
+	tenantID := tenant.FromContext(ctx)
+	now := c.clock.Now()
+	if cached, ok := c.cache.Get(tenantID); ok && now.Before(cached.expiresAt) {
+		return cached.settings, nil
+	}
+
+	overrides, err := c.store.Load(ctx)
+	if err != nil {
+		return domain.TenantSettings{}, fmt.Errorf("failed to load tenant settings: %w", err)
+	}
+	settings := c.defaults
+	if overrides != nil {
+		settings = overrides.WithDefaults(c.defaults)
+	}
+
+	if c.cacheTTL > 0 {
+		c.cache.Add(tenantID, cachedSettings{settings: settings, expiresAt: now.Add(c.cacheTTL)})
+	}
+	return settings, nil
+}
+
+// Invalidate drops the cached settings of a tenant, so a change made on this server applies at once
+func (c *TenantConfig) Invalidate(tenantID string) {
+	if c == nil {
+		return
+	}
+	c.cache.Remove(tenantID)
+}
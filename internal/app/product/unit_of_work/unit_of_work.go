@@ -0,0 +1,136 @@
+package unit_of_work
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/models/m_outbox"
+
+	"cloud.google.com/go/spanner"
+	"github.com/wuyiadepoju/commitplan"
+)
+
+// UnitOfWork tracks the products a use case loads or creates and commits all their changes and
+// events in one plan, so a change spanning several aggregates lands atomically or not at all
+//
+// Products are written in the order they were first tracked, each followed by its events in the
+// order it emitted them. Event IDs are time-ordered, so readers ordering the outbox by created_at
+// and event_id see every aggregate's events in order even though they share a commit timestamp.
+// A UnitOfWork serves one request and is not safe for concurrent use.
+type UnitOfWork struct {
+	repo      contracts.ProductRepository
+	committer commitplan.Committer
+	products  map[string]*domain.Product
+	created   map[string]bool
+	order     []*domain.Product
+	mutations []*spanner.Mutation
+}
+
+// New creates an empty unit of work
+func New(repo contracts.ProductRepository, committer commitplan.Committer) *UnitOfWork {
+	return &UnitOfWork{
+		repo:      repo,
+		committer: committer,
+		products:  make(map[string]*domain.Product),
+		created:   make(map[string]bool),
+	}
+}
+
+// Load returns the tracked product, loading it on first use so every change goes to one instance
+func (u *UnitOfWork) Load(ctx context.Context, id string) (*domain.Product, error) {
+	if product, ok := u.products[id]; ok {
+		return product, nil
+	}
+	product, err := u.repo.Load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	u.track(product)
+	return product, nil
+}
+
+// Create tracks a new product to be inserted
+func (u *UnitOfWork) Create(product *domain.Product) {
+	u.created[product.ID()] = true
+	u.track(product)
+}
+
+// Add includes a mutation for rows outside the products, such as aliases, written after the products
+func (u *UnitOfWork) Add(mutation *spanner.Mutation) {
+	u.mutations = append(u.mutations, mutation)
+}
+
+// Commit applies every tracked change and event in one plan; unchanged products are not written
+func (u *UnitOfWork) Commit(ctx context.Context, now time.Time) error {
+	plan, err := u.Plan(now)
+	if err != nil {
+		return err
+	}
+	if len(plan.Mutations()) == 0 {
+		return nil
+	}
+	return u.committer.Apply(ctx, plan)
+}
+
+// Plan builds the plan Commit applies, for use cases that add their own mutations before applying it
+func (u *UnitOfWork) Plan(now time.Time) (*commitplan.Plan, error) {
+	plan := commitplan.NewPlan()
+	for _, product := range u.order {
+		if u.created[product.ID()] {
+			plan.Add(u.repo.InsertMut(product))
+		} else if len(product.Changes().Diff()) > 0 {
+			plan.Add(u.repo.UpdateMut(product))
+		}
+		for _, event := range product.DomainEvents() {
+			outboxMut, err := eventToOutboxMutation(event)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create outbox event: %w", err)
+			}
+			plan.Add(outboxMut)
+		}
+	}
+	for _, mutation := range u.mutations {
+		plan.Add(mutation)
+	}
+	return plan, nil
+}
+
+// track registers a product the first time it is seen
+func (u *UnitOfWork) track(product *domain.Product) {
+	if _, ok := u.products[product.ID()]; ok {
+		return
+	}
+	u.products[product.ID()] = product
+	u.order = append(u.order, product)
+}
+
+// eventToOutboxMutation converts a domain event to an outbox mutation
+func eventToOutboxMutation(event domain.DomainEvent) (*spanner.Mutation, error) {
+	eventData, err := json.Marshal(event.EventData())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event data for event %s: %w", event.EventName(), err)
+	}
+
+	// Extract aggregate_id from event data (product_id)
+	aggregateID := ""
+	if data := event.EventData(); data != nil {
+		if pid, ok := data["product_id"].(string); ok {
+			aggregateID = pid
+		}
+	}
+
+	outboxEvent := &m_outbox.OutboxEvent{
+		EventID:     m_outbox.NewEventID(),
+		EventType:   event.EventName(),
+		AggregateID: aggregateID,
+		Payload:     string(eventData),
+		Status:      "pending",
+		ProcessedAt: nil,
+	}
+
+	return outboxEvent.InsertMut(), nil
+}
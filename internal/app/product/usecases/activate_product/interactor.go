@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"time"
 
 	"catalog-proj/internal/app/product/contracts"
 	"catalog-proj/internal/app/product/domain"
@@ -12,7 +11,6 @@ import (
 	"catalog-proj/internal/pkg/clock"
 	"github.com/wuyiadepoju/commitplan"
 	"cloud.google.com/go/spanner"
-	"github.com/google/uuid"
 )
 
 // Request represents the input for activating a product
@@ -23,6 +21,8 @@ type Request struct {
 // Response represents the output of activating a product
 type Response struct {
 	ProductID string
+	// Product is the aggregate as committed; its timestamps come from the server clock, not the commit
+	Product *domain.Product
 }
 
 // Interactor handles the activate product use case
@@ -69,7 +69,7 @@ func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, erro
 	// 4. Collect events → outbox
 	events := product.DomainEvents()
 	for _, event := range events {
-		outboxMut, err := i.eventToOutboxMutation(event, now)
+		outboxMut, err := i.eventToOutboxMutation(event)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create outbox event: %w", err)
 		}
@@ -88,11 +88,12 @@ func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, erro
 	// 6. Return product ID
 	return &Response{
 		ProductID: req.ProductID,
+		Product:   product,
 	}, nil
 }
 
 // eventToOutboxMutation converts a domain event to an outbox mutation
-func (i *Interactor) eventToOutboxMutation(event domain.DomainEvent, now time.Time) (*spanner.Mutation, error) {
+func (i *Interactor) eventToOutboxMutation(event domain.DomainEvent) (*spanner.Mutation, error) {
 	eventData, err := json.Marshal(event.EventData())
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal event data for event %s: %w", event.EventName(), err)
@@ -107,12 +108,11 @@ func (i *Interactor) eventToOutboxMutation(event domain.DomainEvent, now time.Ti
 	}
 
 	outboxEvent := &m_outbox.OutboxEvent{
-		EventID:     uuid.New().String(),
+		EventID:     m_outbox.NewEventID(),
 		EventType:   event.EventName(),
 		AggregateID: aggregateID,
 		Payload:     string(eventData),
 		Status:      "pending",
-		CreatedAt:   now,
 		ProcessedAt: nil,
 	}
 
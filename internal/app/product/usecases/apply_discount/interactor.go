@@ -4,15 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"time"
 
 	"catalog-proj/internal/app/product/contracts"
 	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/domain/services"
+	"catalog-proj/internal/app/product/tenant_config"
 	"catalog-proj/internal/models/m_outbox"
 	"catalog-proj/internal/pkg/clock"
-	"github.com/wuyiadepoju/commitplan"
 	"cloud.google.com/go/spanner"
-	"github.com/google/uuid"
+	"github.com/wuyiadepoju/commitplan"
 )
 
 // Request represents the input for applying a discount
@@ -24,13 +24,18 @@ type Request struct {
 // Response represents the output of applying a discount
 type Response struct {
 	ProductID string
+	// Product is the aggregate as committed; its timestamps come from the server clock, not the commit
+	Product *domain.Product
 }
 
 // Interactor handles the apply discount use case
 type Interactor struct {
-	repo      contracts.ProductRepository
-	committer commitplan.Committer
-	clock     clock.Clock
+	repo         contracts.ProductRepository
+	committer    commitplan.Committer
+	clock        clock.Clock
+	quotaCounter contracts.QuotaCounter
+	quotaPolicy  *services.QuotaPolicy
+	tenantConfig *tenant_config.TenantConfig
 }
 
 // NewInteractor creates a new apply discount interactor
@@ -38,11 +43,17 @@ func NewInteractor(
 	repo contracts.ProductRepository,
 	committer commitplan.Committer,
 	clock clock.Clock,
+	quotaCounter contracts.QuotaCounter,
+	quotaPolicy *services.QuotaPolicy,
+	tenantConfig *tenant_config.TenantConfig,
 ) *Interactor {
 	return &Interactor{
-		repo:      repo,
-		committer: committer,
-		clock:     clock,
+		repo:         repo,
+		committer:    committer,
+		clock:        clock,
+		quotaCounter: quotaCounter,
+		quotaPolicy:  quotaPolicy,
+		tenantConfig: tenantConfig,
 	}
 }
 
@@ -60,6 +71,26 @@ func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, erro
 		return nil, fmt.Errorf("failed to apply discount: %w", err)
 	}
 
+	// Enforce the tenant's allowed discount range
+	settings, err := i.tenantConfig.Settings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := settings.CheckDiscount(req.Discount); err != nil {
+		return nil, err
+	}
+
+	// Enforce active discount quota (the product had no active discount, or ApplyDiscount would have failed)
+	if i.quotaPolicy != nil && i.quotaPolicy.TracksActiveDiscounts() {
+		active, err := i.quotaCounter.CountActiveDiscounts(ctx, product.TenantID(), now)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check discount quota: %w", err)
+		}
+		if err := i.quotaPolicy.CheckNewActiveDiscount(product.TenantID(), active); err != nil {
+			return nil, err
+		}
+	}
+
 	// 3. Get update mutation
 	plan := commitplan.NewPlan()
 	productMut := i.repo.UpdateMut(product)
@@ -70,7 +101,7 @@ func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, erro
 	// 4. Collect events
 	events := product.DomainEvents()
 	for _, event := range events {
-		outboxMut, err := i.eventToOutboxMutation(event, now)
+		outboxMut, err := i.eventToOutboxMutation(event)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create outbox event: %w", err)
 		}
@@ -89,11 +120,12 @@ func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, erro
 	// 6. Return product ID
 	return &Response{
 		ProductID: req.ProductID,
+		Product:   product,
 	}, nil
 }
 
 // eventToOutboxMutation converts a domain event to an outbox mutation
-func (i *Interactor) eventToOutboxMutation(event domain.DomainEvent, now time.Time) (*spanner.Mutation, error) {
+func (i *Interactor) eventToOutboxMutation(event domain.DomainEvent) (*spanner.Mutation, error) {
 	eventData, err := json.Marshal(event.EventData())
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal event data for event %s: %w", event.EventName(), err)
@@ -108,12 +140,11 @@ func (i *Interactor) eventToOutboxMutation(event domain.DomainEvent, now time.Ti
 	}
 
 	outboxEvent := &m_outbox.OutboxEvent{
-		EventID:     uuid.New().String(),
+		EventID:     m_outbox.NewEventID(),
 		EventType:   event.EventName(),
 		AggregateID: aggregateID,
 		Payload:     string(eventData),
 		Status:      "pending",
-		CreatedAt:   now,
 		ProcessedAt: nil,
 	}
 
@@ -0,0 +1,183 @@
+package batch_transition
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/models/m_outbox"
+	"catalog-proj/internal/pkg/clock"
+	"cloud.google.com/go/spanner"
+	"github.com/wuyiadepoju/commitplan"
+)
+
+// ChunkSize is the number of products whose changes are committed in one transaction
+const ChunkSize = 100
+
+// Transition is the status change applied to every product in a batch
+type Transition string
+
+const (
+	TransitionActivate   Transition = "activate"
+	TransitionDeactivate Transition = "deactivate"
+	TransitionArchive    Transition = "archive"
+)
+
+// Request represents the input for a batch status transition
+type Request struct {
+	Transition Transition
+	ProductIDs []string
+}
+
+// Outcome is the result for one product; Err is nil when the transition applied or was a no-op
+type Outcome struct {
+	ProductID string
+	Err       error
+}
+
+// Response represents the output of a batch status transition
+type Response struct {
+	Outcomes []Outcome // Aligned with Request.ProductIDs
+}
+
+// Interactor applies a status transition to many products
+// Products are processed in chunks of ChunkSize; each chunk's changes and outbox events
+// commit together, so a failed commit only fails the products of that chunk
+type Interactor struct {
+	repo      contracts.ProductRepository
+	committer commitplan.Committer
+	clock     clock.Clock
+}
+
+// NewInteractor creates a new batch transition interactor
+func NewInteractor(
+	repo contracts.ProductRepository,
+	committer commitplan.Committer,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		repo:      repo,
+		committer: committer,
+		clock:     clock,
+	}
+}
+
+// Execute applies the transition to every product, collecting per-product outcomes
+// instead of stopping at the first failure
+func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, error) {
+	apply, err := transitionFunc(req.Transition)
+	if err != nil {
+		return nil, err
+	}
+
+	outcomes := make([]Outcome, len(req.ProductIDs))
+	for start := 0; start < len(req.ProductIDs); start += ChunkSize {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		end := min(start+ChunkSize, len(req.ProductIDs))
+		i.executeChunk(ctx, req.Transition, apply, req.ProductIDs[start:end], outcomes[start:end])
+	}
+
+	return &Response{Outcomes: outcomes}, nil
+}
+
+// executeChunk applies the transition to one chunk of products and commits it following
+// the Golden Mutation Pattern
+func (i *Interactor) executeChunk(ctx context.Context, transition Transition, apply func(*domain.Product, time.Time) error, productIDs []string, outcomes []Outcome) {
+	now := i.clock.Now()
+	plan := commitplan.NewPlan()
+	var pending []int // Outcomes that depend on the chunk's commit
+
+	for j, productID := range productIDs {
+		outcomes[j].ProductID = productID
+
+		// 1. Load aggregate
+		product, err := i.repo.Load(ctx, productID)
+		if err != nil {
+			outcomes[j].Err = fmt.Errorf("failed to load product: %w", err)
+			continue
+		}
+
+		// 2. Call domain method
+		if err := apply(product, now); err != nil {
+			outcomes[j].Err = fmt.Errorf("failed to %s product: %w", transition, err)
+			continue
+		}
+
+		// 3. Get update mutation and 4. collect events → outbox
+		mutations := []*spanner.Mutation{}
+		if productMut := i.repo.UpdateMut(product); productMut != nil {
+			mutations = append(mutations, productMut)
+		}
+		for _, event := range product.DomainEvents() {
+			outboxMut, err := i.eventToOutboxMutation(event)
+			if err != nil {
+				outcomes[j].Err = fmt.Errorf("failed to create outbox event: %w", err)
+				break
+			}
+			mutations = append(mutations, outboxMut)
+		}
+		if outcomes[j].Err != nil {
+			continue
+		}
+		for _, mut := range mutations {
+			plan.Add(mut)
+		}
+		pending = append(pending, j)
+	}
+
+	// 5. Apply plan
+	if len(plan.Mutations()) == 0 {
+		return
+	}
+	if err := i.committer.Apply(ctx, plan); err != nil {
+		for _, j := range pending {
+			outcomes[j].Err = fmt.Errorf("failed to commit batch: %w", err)
+		}
+	}
+}
+
+// transitionFunc returns the domain method behind a transition
+func transitionFunc(transition Transition) (func(*domain.Product, time.Time) error, error) {
+	switch transition {
+	case TransitionActivate:
+		return (*domain.Product).Activate, nil
+	case TransitionDeactivate:
+		return (*domain.Product).Deactivate, nil
+	case TransitionArchive:
+		return (*domain.Product).Archive, nil
+	default:
+		return nil, fmt.Errorf("unknown batch transition %q", transition)
+	}
+}
+
+// eventToOutboxMutation converts a domain event to an outbox mutation
+func (i *Interactor) eventToOutboxMutation(event domain.DomainEvent) (*spanner.Mutation, error) {
+	eventData, err := json.Marshal(event.EventData())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event data for event %s: %w", event.EventName(), err)
+	}
+
+	// Extract aggregate_id from event data (product_id)
+	aggregateID := ""
+	if data := event.EventData(); data != nil {
+		if pid, ok := data["product_id"].(string); ok {
+			aggregateID = pid
+		}
+	}
+
+	outboxEvent := &m_outbox.OutboxEvent{
+		EventID:     m_outbox.NewEventID(),
+		EventType:   event.EventName(),
+		AggregateID: aggregateID,
+		Payload:     string(eventData),
+		Status:      "pending",
+		ProcessedAt: nil,
+	}
+
+	return outboxEvent.InsertMut(), nil
+}
@@ -0,0 +1,146 @@
+package change_base_price
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/domain/services"
+	"catalog-proj/internal/models/m_outbox"
+	"catalog-proj/internal/pkg/clock"
+	"catalog-proj/internal/pkg/idgen"
+	"cloud.google.com/go/spanner"
+	"github.com/wuyiadepoju/commitplan"
+)
+
+// Request represents the input for changing a product's base price
+type Request struct {
+	ProductID   string
+	BasePrice   *domain.Money
+	RequestedBy string // The authenticated caller, e.g. its API key ID; required when the change needs a second approver
+}
+
+// Response represents the output of changing a base price
+// PendingChangeID is set when the change was held for approval instead of applied
+type Response struct {
+	ProductID       string
+	PendingChangeID string
+}
+
+// Interactor handles the change base price use case
+type Interactor struct {
+	repo      contracts.ProductRepository
+	changes   contracts.PendingChangeStore
+	policy    *services.PriceApprovalPolicy
+	committer commitplan.Committer
+	clock     clock.Clock
+	ids       idgen.Generator // Pending change IDs
+}
+
+// NewInteractor creates a new change base price interactor
+func NewInteractor(
+	repo contracts.ProductRepository,
+	changes contracts.PendingChangeStore,
+	policy *services.PriceApprovalPolicy,
+	committer commitplan.Committer,
+	clock clock.Clock,
+	ids idgen.Generator,
+) *Interactor {
+	return &Interactor{
+		repo:      repo,
+		changes:   changes,
+		policy:    policy,
+		committer: committer,
+		clock:     clock,
+		ids:       ids,
+	}
+}
+
+// Execute changes the base price following the Golden Mutation Pattern
+// Changes above the approval threshold are recorded as a pending change and leave the product untouched
+func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, error) {
+	// 1. Load aggregate
+	product, err := i.repo.Load(ctx, req.ProductID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load product: %w", err)
+	}
+
+	// 2. Call domain method, or hold the change for a second approver
+	now := i.clock.Now()
+	plan := commitplan.NewPlan()
+	var (
+		events          []domain.DomainEvent
+		pendingChangeID string
+	)
+	if i.policy.RequiresApproval(product.BasePrice(), req.BasePrice) {
+		change, err := domain.NewPendingPriceChange(i.ids.NewID(), product, req.BasePrice, req.RequestedBy, now)
+		if err != nil {
+			return nil, fmt.Errorf("failed to request price change: %w", err)
+		}
+		plan.Add(i.changes.InsertMut(change))
+		events = change.DomainEvents()
+		pendingChangeID = change.ID()
+	} else {
+		if err := product.ChangeBasePrice(req.BasePrice, req.RequestedBy, now); err != nil {
+			return nil, fmt.Errorf("failed to change base price: %w", err)
+		}
+		// 3. Get update mutation
+		if productMut := i.repo.UpdateMut(product); productMut != nil {
+			plan.Add(productMut)
+		}
+		events = product.DomainEvents()
+	}
+
+	// 4. Collect events → outbox
+	for _, event := range events {
+		outboxMut, err := i.eventToOutboxMutation(event)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create outbox event: %w", err)
+		}
+		if outboxMut != nil {
+			plan.Add(outboxMut)
+		}
+	}
+
+	// 5. Apply plan (nothing to write when the price is unchanged)
+	if len(plan.Mutations()) > 0 {
+		if err := i.committer.Apply(ctx, plan); err != nil {
+			return nil, fmt.Errorf("failed to change base price: %w", err)
+		}
+	}
+
+	// 6. Return product ID and the pending change, if any
+	return &Response{
+		ProductID:       product.ID(),
+		PendingChangeID: pendingChangeID,
+	}, nil
+}
+
+// eventToOutboxMutation converts a domain event to an outbox mutation
+func (i *Interactor) eventToOutboxMutation(event domain.DomainEvent) (*spanner.Mutation, error) {
+	eventData, err := json.Marshal(event.EventData())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event data for event %s: %w", event.EventName(), err)
+	}
+
+	// Extract aggregate_id from event data (product_id)
+	aggregateID := ""
+	if data := event.EventData(); data != nil {
+		if pid, ok := data["product_id"].(string); ok {
+			aggregateID = pid
+		}
+	}
+
+	outboxEvent := &m_outbox.OutboxEvent{
+		EventID:     m_outbox.NewEventID(),
+		EventType:   event.EventName(),
+		AggregateID: aggregateID,
+		Payload:     string(eventData),
+		Status:      "pending",
+		ProcessedAt: nil,
+	}
+
+	return outboxEvent.InsertMut(), nil
+}
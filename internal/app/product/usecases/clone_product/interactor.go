@@ -0,0 +1,284 @@
+package clone_product
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/domain/services"
+	"catalog-proj/internal/app/product/tenant_config"
+	"catalog-proj/internal/models/m_outbox"
+	"catalog-proj/internal/pkg/clock"
+	"catalog-proj/internal/pkg/idgen"
+	"catalog-proj/internal/pkg/tenant"
+
+	"github.com/wuyiadepoju/commitplan"
+
+	"cloud.google.com/go/spanner"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+)
+
+// Request represents the input for cloning a product
+type Request struct {
+	ProductID string
+	// TargetTenantID receives the clone; empty clones within the caller's tenant
+	TargetTenantID string
+	// Name overrides the clone's name; empty keeps the source's
+	Name string
+	// CopyPrices copies the source's base price and price floor; otherwise BasePrice is required
+	CopyPrices bool
+	BasePrice  *domain.Money
+}
+
+// Response represents the output of cloning a product
+type Response struct {
+	ProductID string
+	TenantID  string
+	// Product is the clone as committed; its timestamps come from the server clock, not the commit
+	Product *domain.Product
+}
+
+// Interactor handles the clone product use case
+// The clone is created inactive and is checked like a CreateProduct call in the target tenant:
+// its quotas, unique names, validation rules, settings and the category's attribute template apply
+type Interactor struct {
+	repo           contracts.ProductRepository
+	versions       contracts.VersionStore
+	templates      contracts.CategoryTemplateStore
+	registry       contracts.TenantRegistry
+	committer      commitplan.Committer
+	clock          clock.Clock
+	quotaCounter   contracts.QuotaCounter
+	quotaPolicy    *services.QuotaPolicy
+	namePolicy     *services.UniqueNamePolicy
+	names          contracts.NameLookup
+	rules          *services.ValidationRules
+	ids            idgen.Generator // Product IDs
+	tenantConfig   *tenant_config.TenantConfig
+	operatorTenant string
+}
+
+// NewInteractor creates a new clone product interactor
+// Only callers of operatorTenant may clone into another tenant; an empty operatorTenant disables it.
+// The caller's tenant is trusted here: config refuses an operator tenant unless API keys are
+// required, and CloneProduct is an admin RPC, so it is the tenant of an admin key
+func NewInteractor(
+	repo contracts.ProductRepository,
+	versions contracts.VersionStore,
+	templates contracts.CategoryTemplateStore,
+	registry contracts.TenantRegistry,
+	committer commitplan.Committer,
+	clock clock.Clock,
+	quotaCounter contracts.QuotaCounter,
+	quotaPolicy *services.QuotaPolicy,
+	namePolicy *services.UniqueNamePolicy,
+	names contracts.NameLookup,
+	rules *services.ValidationRules,
+	ids idgen.Generator,
+	tenantConfig *tenant_config.TenantConfig,
+	operatorTenant string,
+) *Interactor {
+	return &Interactor{
+		repo:           repo,
+		versions:       versions,
+		templates:      templates,
+		registry:       registry,
+		committer:      committer,
+		clock:          clock,
+		quotaCounter:   quotaCounter,
+		quotaPolicy:    quotaPolicy,
+		namePolicy:     namePolicy,
+		names:          names,
+		rules:          rules,
+		ids:            ids,
+		tenantConfig:   tenantConfig,
+		operatorTenant: operatorTenant,
+	}
+}
+
+// Execute clones a product of the caller's tenant following the Golden Mutation Pattern
+func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, error) {
+	// 1. Resolve the target tenant
+	targetTenantID := req.TargetTenantID
+	if targetTenantID == "" {
+		targetTenantID = tenant.FromContext(ctx)
+	}
+	if targetTenantID != tenant.FromContext(ctx) {
+		if i.operatorTenant == "" || tenant.FromContext(ctx) != i.operatorTenant {
+			return nil, domain.ErrCrossTenantCloneDenied
+		}
+		if err := i.checkTarget(ctx, targetTenantID); err != nil {
+			return nil, err
+		}
+	}
+	if !req.CopyPrices && req.BasePrice == nil {
+		return nil, domain.ErrInvalidPrice
+	}
+
+	// 2. Load the source aggregate (scoped to the caller's tenant)
+	source, err := i.repo.Load(ctx, req.ProductID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load product: %w", err)
+	}
+
+	// 3. Call domain method
+	basePrice := req.BasePrice
+	if req.CopyPrices {
+		basePrice = nil
+	}
+	now := i.clock.Now()
+	product, err := source.Clone(i.ids.NewID(), targetTenantID, req.Name, basePrice, now)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every check below runs as the target tenant
+	targetCtx := tenant.WithID(ctx, targetTenantID)
+	if err := i.checkQuota(targetCtx, targetTenantID, product.Category()); err != nil {
+		return nil, err
+	}
+	product.EnforceUniqueName(i.namePolicy != nil && i.namePolicy.Enforced(targetTenantID))
+	violations, err := i.check(targetCtx, product)
+	if err != nil {
+		return nil, err
+	}
+	if len(violations) > 0 {
+		return nil, &domain.ValidationFailedError{Violations: violations}
+	}
+	if err := i.checkUniqueName(targetCtx, product); err != nil {
+		return nil, err
+	}
+
+	// 4. Get insert mutations; the copied content is the clone's first version
+	plan := commitplan.NewPlan()
+	plan.Add(i.repo.InsertMut(product))
+	plan.Add(i.versions.InsertMut(product.ContentVersion(uuid.New().String(), now)))
+
+	// 5. Collect domain events → outbox mutations
+	for _, event := range product.DomainEvents() {
+		outboxMut, err := i.eventToOutboxMutation(event)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create outbox event: %w", err)
+		}
+		plan.Add(outboxMut)
+	}
+
+	// 6. Apply plan
+	if err := i.committer.Apply(ctx, plan); err != nil {
+		if spanner.ErrCode(err) == codes.AlreadyExists && product.NameKey() != "" {
+			if nameErr := i.checkUniqueName(targetCtx, product); nameErr != nil {
+				return nil, nameErr
+			}
+		}
+		return nil, fmt.Errorf("failed to clone product: %w", err)
+	}
+
+	// 7. Return clone
+	return &Response{
+		ProductID: product.ID(),
+		TenantID:  targetTenantID,
+		Product:   product,
+	}, nil
+}
+
+// checkTarget refuses malformed tenant IDs and registered tenants that are being deleted
+// Tenants that were never registered are accepted, like any x-tenant-id
+func (i *Interactor) checkTarget(ctx context.Context, tenantID string) error {
+	if !domain.ValidTenantID(tenantID) {
+		return domain.ErrInvalidTenant
+	}
+	target, err := i.registry.Load(ctx, tenantID)
+	if errors.Is(err, domain.ErrTenantNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if target.State == domain.TenantDeleting || target.State == domain.TenantDeleted {
+		return domain.ErrTenantBeingDeleted
+	}
+	return nil
+}
+
+// check returns the clone's violations of the target tenant's validation rules, settings and category template
+func (i *Interactor) check(ctx context.Context, product *domain.Product) ([]domain.RuleViolation, error) {
+	settings, err := i.tenantConfig.Settings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	violations := append(i.rules.Check(product), settings.Check(product)...)
+
+	template, err := i.templates.Load(ctx, product.Category())
+	if err != nil && !errors.Is(err, domain.ErrCategoryTemplateNotFound) {
+		return nil, fmt.Errorf("failed to load category template: %w", err)
+	}
+	if template != nil {
+		violations = append(violations, template.Check(product.Attributes())...)
+	}
+	return violations, nil
+}
+
+// checkQuota verifies the target tenant and category have room for one more product
+func (i *Interactor) checkQuota(ctx context.Context, tenantID, category string) error {
+	if i.quotaPolicy == nil || !i.quotaPolicy.TracksProducts() {
+		return nil
+	}
+
+	tenantProducts, err := i.quotaCounter.CountProducts(ctx, tenantID)
+	if err != nil {
+		return fmt.Errorf("failed to check product quota: %w", err)
+	}
+	categoryProducts, err := i.quotaCounter.CountProductsInCategory(ctx, tenantID, category)
+	if err != nil {
+		return fmt.Errorf("failed to check category quota: %w", err)
+	}
+
+	return i.quotaPolicy.CheckNewProduct(tenantID, category, tenantProducts, categoryProducts)
+}
+
+// checkUniqueName reports the product already using the clone's name in the category, if uniqueness is enforced
+func (i *Interactor) checkUniqueName(ctx context.Context, product *domain.Product) error {
+	nameKey := product.NameKey()
+	if nameKey == "" {
+		return nil
+	}
+	conflictID, err := i.names.FindByName(ctx, product.TenantID(), product.Category(), nameKey, product.ID())
+	if err != nil {
+		return fmt.Errorf("failed to check product name: %w", err)
+	}
+	if conflictID != "" {
+		return &domain.ProductNameTakenError{ProductID: conflictID, Category: product.Category(), Name: product.Name()}
+	}
+	return nil
+}
+
+// eventToOutboxMutation converts a domain event to an outbox mutation
+func (i *Interactor) eventToOutboxMutation(event domain.DomainEvent) (*spanner.Mutation, error) {
+	eventData, err := json.Marshal(event.EventData())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event data for event %s: %w", event.EventName(), err)
+	}
+
+	// Extract aggregate_id from event data (product_id)
+	aggregateID := ""
+	if data := event.EventData(); data != nil {
+		if pid, ok := data["product_id"].(string); ok {
+			aggregateID = pid
+		}
+	}
+
+	outboxEvent := &m_outbox.OutboxEvent{
+		EventID:     m_outbox.NewEventID(),
+		EventType:   event.EventName(),
+		AggregateID: aggregateID,
+		Payload:     string(eventData),
+		Status:      "pending",
+		ProcessedAt: nil,
+	}
+
+	return outboxEvent.InsertMut(), nil
+}
@@ -0,0 +1,80 @@
+package create_merch_rule
+
+import (
+	"context"
+	"fmt"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/pkg/clock"
+	"catalog-proj/internal/pkg/tenant"
+	"github.com/google/uuid"
+	"github.com/wuyiadepoju/commitplan"
+)
+
+// Request represents the input for creating a merchandising rule
+// Rule.ID and Rule.CreatedAt are assigned by the interactor
+type Request struct {
+	Rule domain.MerchRule
+}
+
+// Response represents the output of creating a merchandising rule
+type Response struct {
+	RuleID string
+}
+
+// Interactor handles the create merchandising rule use case
+type Interactor struct {
+	repo      contracts.ProductRepository
+	rules     contracts.MerchRuleStore
+	committer commitplan.Committer
+	clock     clock.Clock
+}
+
+// NewInteractor creates a new create merchandising rule interactor
+func NewInteractor(
+	repo contracts.ProductRepository,
+	rules contracts.MerchRuleStore,
+	committer commitplan.Committer,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		repo:      repo,
+		rules:     rules,
+		committer: committer,
+		clock:     clock,
+	}
+}
+
+// Execute records the rule for the caller's tenant
+// A pinned product must exist when the rule is created; it is skipped in results once archived or inactive
+func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, error) {
+	rule := req.Rule
+	rule.Query = domain.NormalizeSearchQuery(rule.Query)
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+
+	// 1. Check the pinned product belongs to the tenant
+	if rule.Kind == domain.MerchRuleKindPin {
+		if _, err := i.repo.Load(ctx, rule.ProductID); err != nil {
+			return nil, fmt.Errorf("failed to load pinned product: %w", err)
+		}
+	}
+
+	// 2. Get rule mutation
+	rule.ID = uuid.New().String()
+	rule.CreatedAt = i.clock.Now()
+	plan := commitplan.NewPlan()
+	plan.Add(i.rules.InsertMut(tenant.FromContext(ctx), rule))
+
+	// 3. Apply plan
+	if err := i.committer.Apply(ctx, plan); err != nil {
+		return nil, fmt.Errorf("failed to create merchandising rule: %w", err)
+	}
+
+	// 4. Return rule ID
+	return &Response{
+		RuleID: rule.ID,
+	}, nil
+}
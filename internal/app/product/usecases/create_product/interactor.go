@@ -4,87 +4,181 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"math/big"
-	"time"
 
 	"catalog-proj/internal/app/product/contracts"
 	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/domain/services"
+	"catalog-proj/internal/app/product/queries/find_similar_products"
+	"catalog-proj/internal/app/product/tenant_config"
 	"catalog-proj/internal/models/m_outbox"
 	"catalog-proj/internal/pkg/clock"
+	"catalog-proj/internal/pkg/idgen"
+	"catalog-proj/internal/pkg/tenant"
 
 	"github.com/wuyiadepoju/commitplan"
 
 	"cloud.google.com/go/spanner"
 	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+)
+
+// DuplicateCheck controls how products similar to existing ones are handled
+type DuplicateCheck string
+
+const (
+	// DuplicateCheckAllow skips duplicate detection (default)
+	DuplicateCheckAllow DuplicateCheck = "allow"
+	// DuplicateCheckWarn creates the product and reports similar existing products
+	DuplicateCheckWarn DuplicateCheck = "warn"
+	// DuplicateCheckReject refuses to create a product similar to an existing one
+	DuplicateCheckReject DuplicateCheck = "reject"
 )
 
 // Request represents the input for creating a product
 type Request struct {
-	Name        string
-	Description string
-	Category    string
-	BasePrice   *domain.Money
+	Name              string
+	Description       string
+	DescriptionFormat domain.DescriptionFormat // Defaults to plain
+	Category          string
+	SKU               string
+	GTIN              string
+	BasePrice         *domain.Money
+	ProductType       domain.ProductType // Defaults to physical
+	Shipping          domain.ShippingDetails
+	Digital           domain.DigitalDelivery
+	Compliance        domain.Compliance
+	DuplicateCheck    DuplicateCheck
 }
 
 // Response represents the output of creating a product
 type Response struct {
 	ProductID string
+	// PossibleDuplicates lists similar existing products (DuplicateCheckWarn only)
+	PossibleDuplicates []string
+	// Product is the aggregate as committed; its timestamps come from the server clock, not the commit
+	Product *domain.Product
 }
 
 // Interactor handles the create product use case
 type Interactor struct {
-	repo      contracts.ProductRepository
-	committer commitplan.Committer
-	clock     clock.Clock
+	repo         contracts.ProductRepository
+	versions     contracts.VersionStore
+	committer    commitplan.Committer
+	clock        clock.Clock
+	quotaCounter contracts.QuotaCounter
+	quotaPolicy  *services.QuotaPolicy
+	similar      *find_similar_products.Query
+	namePolicy   *services.UniqueNamePolicy
+	names        contracts.NameLookup
+	rules        *services.ValidationRules
+	ids          idgen.Generator // Product IDs
+	tenantConfig *tenant_config.TenantConfig
 }
 
 // NewInteractor creates a new create product interactor
 func NewInteractor(
 	repo contracts.ProductRepository,
+	versions contracts.VersionStore,
 	committer commitplan.Committer,
 	clock clock.Clock,
+	quotaCounter contracts.QuotaCounter,
+	quotaPolicy *services.QuotaPolicy,
+	similar *find_similar_products.Query,
+	namePolicy *services.UniqueNamePolicy,
+	names contracts.NameLookup,
+	rules *services.ValidationRules,
+	ids idgen.Generator,
+	tenantConfig *tenant_config.TenantConfig,
 ) *Interactor {
 	return &Interactor{
-		repo:      repo,
-		committer: committer,
-		clock:     clock,
+		repo:         repo,
+		versions:     versions,
+		committer:    committer,
+		clock:        clock,
+		quotaCounter: quotaCounter,
+		quotaPolicy:  quotaPolicy,
+		similar:      similar,
+		namePolicy:   namePolicy,
+		names:        names,
+		rules:        rules,
+		ids:          ids,
+		tenantConfig: tenantConfig,
 	}
 }
 
 // Execute creates a new product following the Golden Mutation Pattern
 func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, error) {
-	// Validate inputs
-	if req.BasePrice == nil {
-		return nil, fmt.Errorf("base_price is required")
+	now := i.clock.Now()
+	productID := i.ids.NewID()
+	tenantID := tenant.FromContext(ctx)
+	productType := req.ProductType
+	if productType == "" {
+		productType = domain.ProductTypePhysical
 	}
-	// Validate price is positive (check sign of *big.Rat)
-	priceRat := (*big.Rat)(*req.BasePrice)
-	if priceRat.Sign() <= 0 {
-		return nil, domain.ErrInvalidPrice
+	descriptionFormat := req.DescriptionFormat
+	if descriptionFormat == "" {
+		descriptionFormat = domain.DescriptionFormatPlain
 	}
 
-	now := i.clock.Now()
-	productID := uuid.New().String()
-
-	// 1. Create aggregate (NewProduct sets initial status and emits ProductCreatedEvent)
-	product := domain.NewProduct(
+	// 1. Create aggregate (NewProduct enforces invariants, sets initial status and emits ProductCreatedEvent)
+	product, err := domain.NewProduct(
 		productID,
+		tenantID,
 		req.Name,
 		req.Description,
 		req.Category,
+		req.SKU,
+		req.GTIN,
+		descriptionFormat,
 		req.BasePrice,
+		productType,
+		req.Shipping,
+		req.Digital,
+		req.Compliance,
 		now,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Enforce catalog size quotas (soft limit: counted outside the commit)
+	if err := i.checkQuota(ctx, tenantID, product.Category()); err != nil {
+		return nil, err
+	}
+
+	// Look for existing products with the same name+category or identifiers
+	possibleDuplicates, err := i.checkDuplicates(ctx, tenantID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	product.EnforceUniqueName(i.namePolicy != nil && i.namePolicy.Enforced(tenantID))
+
+	// Category rules and the tenant's limits are checked on the resulting product so every violation is reported at once
+	settings, err := i.tenantConfig.Settings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if violations := append(i.rules.Check(product), settings.Check(product)...); len(violations) > 0 {
+		return nil, &domain.ValidationFailedError{Violations: violations}
+	}
+
+	// Refuse names already used in the category (the unique index backs this up at commit)
+	if err := i.checkUniqueName(ctx, product); err != nil {
+		return nil, err
+	}
 
 	// 2. Get insert mutation from repo
 	plan := commitplan.NewPlan()
 	productMut := i.repo.InsertMut(product)
 	plan.Add(productMut)
+	// The initial content is the product's first version
+	plan.Add(i.versions.InsertMut(product.ContentVersion(uuid.New().String(), now)))
 
 	// 3. Collect domain events → outbox mutations
 	events := product.DomainEvents()
 	for _, event := range events {
-		outboxMut, err := i.eventToOutboxMutation(event, now)
+		outboxMut, err := i.eventToOutboxMutation(event)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create outbox event: %w", err)
 		}
@@ -95,17 +189,94 @@ func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, erro
 
 	// 4. Apply plan via committer
 	if err := i.committer.Apply(ctx, plan); err != nil {
+		// A concurrent create took the name between the check and the commit
+		if spanner.ErrCode(err) == codes.AlreadyExists && product.NameKey() != "" {
+			if nameErr := i.checkUniqueName(ctx, product); nameErr != nil {
+				return nil, nameErr
+			}
+		}
 		return nil, fmt.Errorf("failed to create product: %w", err)
 	}
 
 	// 5. Return product ID
 	return &Response{
-		ProductID: productID,
+		ProductID:          productID,
+		PossibleDuplicates: possibleDuplicates,
+		Product:            product,
 	}, nil
 }
 
+// checkQuota verifies the tenant and category have room for one more product
+func (i *Interactor) checkQuota(ctx context.Context, tenantID, category string) error {
+	if i.quotaPolicy == nil || !i.quotaPolicy.TracksProducts() {
+		return nil
+	}
+
+	tenantProducts, err := i.quotaCounter.CountProducts(ctx, tenantID)
+	if err != nil {
+		return fmt.Errorf("failed to check product quota: %w", err)
+	}
+	categoryProducts, err := i.quotaCounter.CountProductsInCategory(ctx, tenantID, category)
+	if err != nil {
+		return fmt.Errorf("failed to check category quota: %w", err)
+	}
+
+	return i.quotaPolicy.CheckNewProduct(tenantID, category, tenantProducts, categoryProducts)
+}
+
+// checkUniqueName reports the product already using the name in the category, if uniqueness is enforced
+func (i *Interactor) checkUniqueName(ctx context.Context, product *domain.Product) error {
+	nameKey := product.NameKey()
+	if nameKey == "" {
+		return nil
+	}
+	conflictID, err := i.names.FindByName(ctx, product.TenantID(), product.Category(), nameKey, product.ID())
+	if err != nil {
+		return fmt.Errorf("failed to check product name: %w", err)
+	}
+	if conflictID != "" {
+		return &domain.ProductNameTakenError{ProductID: conflictID, Category: product.Category(), Name: product.Name()}
+	}
+	return nil
+}
+
+// checkDuplicates finds similar existing products according to the request's duplicate check mode
+// Like quotas, this is a best-effort check outside the commit
+func (i *Interactor) checkDuplicates(ctx context.Context, tenantID string, req *Request) ([]string, error) {
+	switch req.DuplicateCheck {
+	case "", DuplicateCheckAllow:
+		return nil, nil
+	case DuplicateCheckWarn, DuplicateCheckReject:
+	default:
+		return nil, fmt.Errorf("unknown duplicate check mode %q", req.DuplicateCheck)
+	}
+
+	dto, err := i.similar.Execute(ctx, &find_similar_products.Request{
+		TenantID: tenantID,
+		Name:     req.Name,
+		Category: req.Category,
+		SKU:      req.SKU,
+		GTIN:     req.GTIN,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to check for duplicates: %w", err)
+	}
+	if len(dto.Matches) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, 0, len(dto.Matches))
+	for _, match := range dto.Matches {
+		ids = append(ids, match.ID)
+	}
+	if req.DuplicateCheck == DuplicateCheckReject {
+		return nil, &domain.DuplicateProductError{ProductIDs: ids}
+	}
+	return ids, nil
+}
+
 // eventToOutboxMutation converts a domain event to an outbox mutation
-func (i *Interactor) eventToOutboxMutation(event domain.DomainEvent, now time.Time) (*spanner.Mutation, error) {
+func (i *Interactor) eventToOutboxMutation(event domain.DomainEvent) (*spanner.Mutation, error) {
 	eventData, err := json.Marshal(event.EventData())
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal event data for event %s: %w", event.EventName(), err)
@@ -120,12 +291,11 @@ func (i *Interactor) eventToOutboxMutation(event domain.DomainEvent, now time.Ti
 	}
 
 	outboxEvent := &m_outbox.OutboxEvent{
-		EventID:     uuid.New().String(),
+		EventID:     m_outbox.NewEventID(),
 		EventType:   event.EventName(),
 		AggregateID: aggregateID,
 		Payload:     string(eventData),
 		Status:      "pending",
-		CreatedAt:   now,
 		ProcessedAt: nil,
 	}
 
@@ -0,0 +1,233 @@
+package create_tenant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/tenant_config"
+	"catalog-proj/internal/models/m_api_key"
+	"catalog-proj/internal/models/m_outbox"
+	"catalog-proj/internal/pkg/apikey"
+	"catalog-proj/internal/pkg/clock"
+	"catalog-proj/internal/pkg/tenant"
+	"cloud.google.com/go/spanner"
+	"github.com/google/uuid"
+	"github.com/wuyiadepoju/commitplan"
+)
+
+// KeyIssuer issues API keys for the tenant carried by ctx; apikey.Manager satisfies it
+type KeyIssuer interface {
+	Issue(ctx context.Context, name string, scopes []apikey.Scope) (*m_api_key.APIKey, string, error)
+}
+
+// KeySpec is an API key to issue for the new tenant
+type KeySpec struct {
+	Name   string
+	Scopes []apikey.Scope
+}
+
+// IssuedKey is an issued key with its secret, which is only available at issue time
+type IssuedKey struct {
+	Key    *m_api_key.APIKey
+	Secret string
+}
+
+// Request represents the input for provisioning a tenant
+type Request struct {
+	TenantID    string
+	DisplayName string
+	// Settings are the tenant's overrides; nil keeps the service defaults
+	Settings *domain.TenantSettings
+	// Templates seed the tenant's category attribute templates
+	Templates []domain.CategoryTemplate
+	APIKeys   []KeySpec
+}
+
+// Progress receives per-template progress; lro.Progress satisfies it
+type Progress interface {
+	Item(ok bool)
+}
+
+// Registration is the result of registering a tenant, returned before its provisioning finishes
+type Registration struct {
+	Tenant *domain.Tenant
+	Keys   []IssuedKey
+}
+
+// Response represents the provisioned tenant
+type Response struct {
+	Tenant            *domain.Tenant
+	CategoryTemplates int
+}
+
+// Interactor handles the create tenant use case
+// Begin registers the tenant and issues its API keys; Execute, run as a long-running operation,
+// writes the settings and category templates and activates the tenant in one commit
+type Interactor struct {
+	registry       contracts.TenantRegistry
+	settings       contracts.TenantSettingsStore
+	templates      contracts.CategoryTemplateStore
+	keys           KeyIssuer
+	config         *tenant_config.TenantConfig
+	committer      commitplan.Committer
+	clock          clock.Clock
+	operatorTenant string
+}
+
+// NewInteractor creates a new create tenant interactor
+// Only callers of operatorTenant may create tenants; an empty operatorTenant disables provisioning
+func NewInteractor(
+	registry contracts.TenantRegistry,
+	settings contracts.TenantSettingsStore,
+	templates contracts.CategoryTemplateStore,
+	keys KeyIssuer,
+	config *tenant_config.TenantConfig,
+	committer commitplan.Committer,
+	clock clock.Clock,
+	operatorTenant string,
+) *Interactor {
+	return &Interactor{
+		registry:       registry,
+		settings:       settings,
+		templates:      templates,
+		keys:           keys,
+		config:         config,
+		committer:      committer,
+		clock:          clock,
+		operatorTenant: operatorTenant,
+	}
+}
+
+// Begin validates the request, registers the tenant in the provisioning state and issues its API keys
+// A tenant ID may be reused once its tenant is deleted. If issuing a key fails the tenant stays
+// provisioning, and DeleteTenant removes it together with the keys already issued
+func (i *Interactor) Begin(ctx context.Context, req *Request) (*Registration, error) {
+	if i.operatorTenant == "" || tenant.FromContext(ctx) != i.operatorTenant {
+		return nil, domain.ErrTenantProvisioningDenied
+	}
+
+	// 1. Validate the tenant and everything it is seeded with
+	t, err := domain.NewTenant(req.TenantID, uuid.New().String(), req.DisplayName, i.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+	if req.Settings != nil {
+		if err := req.Settings.Validate(); err != nil {
+			return nil, err
+		}
+		effective := req.Settings.WithDefaults(i.config.Defaults())
+		if effective.MinDiscountBasisPoints > effective.MaxDiscountBasisPoints {
+			return nil, domain.ErrInvalidTenantSettings
+		}
+	}
+	categories := make(map[string]bool, len(req.Templates))
+	for _, template := range req.Templates {
+		if err := template.Validate(); err != nil {
+			return nil, err
+		}
+		if categories[template.Category] {
+			return nil, domain.ErrInvalidCategoryTemplate
+		}
+		categories[template.Category] = true
+	}
+
+	// 2. Register the tenant
+	if err := i.registry.Register(ctx, t); err != nil {
+		return nil, err
+	}
+
+	// 3. Issue the keys as the new tenant
+	tenantCtx := tenant.WithID(ctx, t.ID)
+	reg := &Registration{Tenant: t}
+	for _, spec := range req.APIKeys {
+		key, secret, err := i.keys.Issue(tenantCtx, spec.Name, spec.Scopes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to issue api key %q: %w", spec.Name, err)
+		}
+		reg.Keys = append(reg.Keys, IssuedKey{Key: key, Secret: secret})
+	}
+	return reg, nil
+}
+
+// Execute seeds a registered tenant and marks it active
+// Running it again for an active tenant changes nothing, so a retried operation is harmless
+func (i *Interactor) Execute(ctx context.Context, req *Request, progress Progress) (*Response, error) {
+	// 1. Load the registration
+	t, err := i.registry.Load(ctx, req.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	switch t.State {
+	case domain.TenantActive:
+		return &Response{Tenant: t, CategoryTemplates: len(req.Templates)}, nil
+	case domain.TenantDeleting, domain.TenantDeleted:
+		return nil, domain.ErrTenantBeingDeleted
+	}
+
+	// 2. Get settings, template and tenant mutations
+	now := i.clock.Now()
+	plan := commitplan.NewPlan()
+	if req.Settings != nil {
+		settings := *req.Settings
+		settings.UpdatedAt = now
+		plan.Add(i.settings.UpsertMut(t.ID, settings))
+	}
+	for _, template := range req.Templates {
+		template.UpdatedAt = now
+		mut, err := i.templates.UpsertMut(t.ID, template)
+		if err != nil {
+			return nil, err
+		}
+		plan.Add(mut)
+	}
+	t.Activate(now)
+	plan.Add(i.registry.SaveMut(t))
+
+	// 3. Record the provisioning → outbox
+	outboxMut, err := eventToOutboxMutation(&domain.TenantProvisionedEvent{
+		TenantID:          t.ID,
+		RegistrationID:    t.RegistrationID,
+		DisplayName:       t.DisplayName,
+		CategoryTemplates: len(req.Templates),
+		APIKeys:           len(req.APIKeys),
+		ProvisionedAt:     now,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create outbox event: %w", err)
+	}
+	plan.Add(outboxMut)
+
+	// 4. Apply plan
+	if err := i.committer.Apply(ctx, plan); err != nil {
+		return nil, fmt.Errorf("failed to provision tenant: %w", err)
+	}
+	i.config.Invalidate(t.ID)
+	for range req.Templates {
+		progress.Item(true)
+	}
+
+	return &Response{Tenant: t, CategoryTemplates: len(req.Templates)}, nil
+}
+
+// eventToOutboxMutation converts a tenant event to an outbox mutation keyed by the registration
+func eventToOutboxMutation(event domain.DomainEvent) (*spanner.Mutation, error) {
+	data := event.EventData()
+	eventData, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event data for event %s: %w", event.EventName(), err)
+	}
+	aggregateID, _ := data["registration_id"].(string)
+
+	outboxEvent := &m_outbox.OutboxEvent{
+		EventID:     m_outbox.NewEventID(),
+		EventType:   event.EventName(),
+		AggregateID: aggregateID,
+		Payload:     string(eventData),
+		Status:      "pending",
+		ProcessedAt: nil,
+	}
+	return outboxEvent.InsertMut(), nil
+}
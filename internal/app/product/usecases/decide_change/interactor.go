@@ -0,0 +1,132 @@
+package decide_change
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/models/m_outbox"
+	"catalog-proj/internal/pkg/clock"
+	"cloud.google.com/go/spanner"
+	"github.com/wuyiadepoju/commitplan"
+)
+
+// Request represents the input for approving or rejecting a pending change
+type Request struct {
+	ChangeID string
+	Decision domain.ReviewDecision
+	Approver string // The authenticated caller, e.g. its API key ID
+	Note     string // Required when rejecting
+}
+
+// Response represents the output of deciding a pending change
+type Response struct {
+	ChangeID  string
+	ProductID string
+	Status    domain.PendingChangeStatus
+}
+
+// Interactor handles the approve or reject pending change use case
+type Interactor struct {
+	repo    contracts.ProductRepository
+	changes contracts.PendingChangeStore
+	clock   clock.Clock
+}
+
+// NewInteractor creates a new decide change interactor
+func NewInteractor(
+	repo contracts.ProductRepository,
+	changes contracts.PendingChangeStore,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		repo:    repo,
+		changes: changes,
+		clock:   clock,
+	}
+}
+
+// Execute records the decision following the Golden Mutation Pattern
+// An approval applies the change to the product in the same commit. The commit goes through the
+// pending change store, so the status transition is checked in the transaction
+func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, error) {
+	// 1. Load pending change
+	change, err := i.changes.Load(ctx, req.ChangeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load pending change: %w", err)
+	}
+
+	// 2. Call domain methods
+	now := i.clock.Now()
+	if err := change.Decide(req.Decision, req.Approver, req.Note, now); err != nil {
+		return nil, fmt.Errorf("failed to decide change: %w", err)
+	}
+	plan := commitplan.NewPlan()
+	events := change.DomainEvents()
+	if change.Status() == domain.PendingChangeStatusApproved {
+		product, err := i.repo.Load(ctx, change.ProductID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to load product: %w", err)
+		}
+		if err := product.ApplyPendingChange(change, now); err != nil {
+			return nil, fmt.Errorf("failed to apply change: %w", err)
+		}
+		// 3. Get update mutations
+		if productMut := i.repo.UpdateMut(product); productMut != nil {
+			plan.Add(productMut)
+		}
+		events = append(events, product.DomainEvents()...)
+	}
+
+	// 4. Collect events → outbox
+	for _, event := range events {
+		outboxMut, err := i.eventToOutboxMutation(event)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create outbox event: %w", err)
+		}
+		if outboxMut != nil {
+			plan.Add(outboxMut)
+		}
+	}
+
+	// 5. Record the decision with the plan, if the change is still pending
+	if err := i.changes.Decide(ctx, change, plan.Mutations()...); err != nil {
+		return nil, fmt.Errorf("failed to decide change: %w", err)
+	}
+
+	// 6. Return the decided change
+	return &Response{
+		ChangeID:  change.ID(),
+		ProductID: change.ProductID(),
+		Status:    change.Status(),
+	}, nil
+}
+
+// eventToOutboxMutation converts a domain event to an outbox mutation
+func (i *Interactor) eventToOutboxMutation(event domain.DomainEvent) (*spanner.Mutation, error) {
+	eventData, err := json.Marshal(event.EventData())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event data for event %s: %w", event.EventName(), err)
+	}
+
+	// Extract aggregate_id from event data (product_id)
+	aggregateID := ""
+	if data := event.EventData(); data != nil {
+		if pid, ok := data["product_id"].(string); ok {
+			aggregateID = pid
+		}
+	}
+
+	outboxEvent := &m_outbox.OutboxEvent{
+		EventID:     m_outbox.NewEventID(),
+		EventType:   event.EventName(),
+		AggregateID: aggregateID,
+		Payload:     string(eventData),
+		Status:      "pending",
+		ProcessedAt: nil,
+	}
+
+	return outboxEvent.InsertMut(), nil
+}
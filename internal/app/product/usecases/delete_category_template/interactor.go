@@ -0,0 +1,60 @@
+package delete_category_template
+
+import (
+	"context"
+	"fmt"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/pkg/tenant"
+	"github.com/wuyiadepoju/commitplan"
+)
+
+// Request represents the input for deleting a category's attribute template
+type Request struct {
+	Category string
+}
+
+// Response represents the output of deleting a category template
+type Response struct {
+	Category string
+}
+
+// Interactor handles the delete category template use case
+type Interactor struct {
+	templates contracts.CategoryTemplateStore
+	committer commitplan.Committer
+}
+
+// NewInteractor creates a new delete category template interactor
+func NewInteractor(
+	templates contracts.CategoryTemplateStore,
+	committer commitplan.Committer,
+) *Interactor {
+	return &Interactor{
+		templates: templates,
+		committer: committer,
+	}
+}
+
+// Execute removes a template of the caller's tenant; the category's products keep their attributes
+func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, error) {
+	// 1. Load the template, so deleting a missing template reports ErrCategoryTemplateNotFound
+	template, err := i.templates.Load(ctx, req.Category)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load category template: %w", err)
+	}
+
+	// 2. Get delete mutation
+	plan := commitplan.NewPlan()
+	plan.Add(i.templates.DeleteMut(tenant.FromContext(ctx), template.Category))
+
+	// 3. Apply plan
+	if err := i.committer.Apply(ctx, plan); err != nil {
+		return nil, fmt.Errorf("failed to delete category template: %w", err)
+	}
+
+	// 4. Return category
+	return &Response{
+		Category: template.Category,
+	}, nil
+}
@@ -0,0 +1,60 @@
+package delete_merch_rule
+
+import (
+	"context"
+	"fmt"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/pkg/tenant"
+	"github.com/wuyiadepoju/commitplan"
+)
+
+// Request represents the input for deleting a merchandising rule
+type Request struct {
+	RuleID string
+}
+
+// Response represents the output of deleting a merchandising rule
+type Response struct {
+	RuleID string
+}
+
+// Interactor handles the delete merchandising rule use case
+type Interactor struct {
+	rules     contracts.MerchRuleStore
+	committer commitplan.Committer
+}
+
+// NewInteractor creates a new delete merchandising rule interactor
+func NewInteractor(
+	rules contracts.MerchRuleStore,
+	committer commitplan.Committer,
+) *Interactor {
+	return &Interactor{
+		rules:     rules,
+		committer: committer,
+	}
+}
+
+// Execute removes a rule of the caller's tenant
+func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, error) {
+	// 1. Load the rule, so deleting a missing rule reports ErrMerchRuleNotFound
+	rule, err := i.rules.Load(ctx, req.RuleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load merchandising rule: %w", err)
+	}
+
+	// 2. Get delete mutation
+	plan := commitplan.NewPlan()
+	plan.Add(i.rules.DeleteMut(tenant.FromContext(ctx), rule.ID))
+
+	// 3. Apply plan
+	if err := i.committer.Apply(ctx, plan); err != nil {
+		return nil, fmt.Errorf("failed to delete merchandising rule: %w", err)
+	}
+
+	// 4. Return rule ID
+	return &Response{
+		RuleID: rule.ID,
+	}, nil
+}
@@ -0,0 +1,324 @@
+package delete_tenant
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/tenant_config"
+	"catalog-proj/internal/models/m_api_key"
+	"catalog-proj/internal/models/m_outbox"
+	"catalog-proj/internal/pkg/clock"
+	"catalog-proj/internal/pkg/metrics"
+	"catalog-proj/internal/pkg/tenant"
+	"cloud.google.com/go/spanner"
+	"github.com/google/uuid"
+	"github.com/wuyiadepoju/commitplan"
+)
+
+// ChunkSize is the number of products scanned, exported and purged between checkpoints
+const ChunkSize = 100
+
+// KeyRevoker lists and revokes the API keys of the tenant carried by ctx; apikey.Manager satisfies it
+type KeyRevoker interface {
+	List(ctx context.Context) ([]*m_api_key.APIKey, error)
+	Revoke(ctx context.Context, keyID string) (*m_api_key.APIKey, error)
+}
+
+// Exporter renders a product's export document; export_product_data.Query satisfies it
+type Exporter interface {
+	Execute(ctx context.Context, productID string) ([]byte, error)
+}
+
+// Request represents the input for deleting a tenant
+type Request struct {
+	TenantID string
+	// Export uploads each product's export document before it is purged
+	Export bool
+	// StartAfter resumes a previous run after this product ID
+	StartAfter string
+}
+
+// Progress receives per-product progress; lro.Progress satisfies it
+type Progress interface {
+	Item(ok bool)
+	Checkpoint(productID string)
+}
+
+// Response represents the deletion report
+type Response struct {
+	Tenant           *domain.Tenant
+	KeysRevoked      int
+	ProductsPurged   int64
+	ProductsExported int64
+	// RowsDeleted counts the rows removed from the tenant's other tables
+	RowsDeleted int64
+	// ExportPrefix is the object prefix the export documents were written under; empty without export
+	ExportPrefix  string
+	LastProductID string
+}
+
+// Interactor handles the delete tenant use case
+// Begin marks the tenant deleting; Execute, run as a long-running operation, revokes the tenant's
+// keys, exports and purges its products in chunks of ChunkSize, empties its other tables and
+// records the deletion
+type Interactor struct {
+	registry       contracts.TenantRegistry
+	purger         contracts.TenantPurger
+	keys           KeyRevoker
+	exporter       Exporter
+	uploader       contracts.FeedUploader
+	config         *tenant_config.TenantConfig
+	committer      commitplan.Committer
+	clock          clock.Clock
+	operatorTenant string
+}
+
+// NewInteractor creates a new delete tenant interactor
+// Only callers of operatorTenant may delete tenants; an empty operatorTenant disables provisioning.
+// A nil uploader refuses exports
+func NewInteractor(
+	registry contracts.TenantRegistry,
+	purger contracts.TenantPurger,
+	keys KeyRevoker,
+	exporter Exporter,
+	uploader contracts.FeedUploader,
+	config *tenant_config.TenantConfig,
+	committer commitplan.Committer,
+	clock clock.Clock,
+	operatorTenant string,
+) *Interactor {
+	return &Interactor{
+		registry:       registry,
+		purger:         purger,
+		keys:           keys,
+		exporter:       exporter,
+		uploader:       uploader,
+		config:         config,
+		committer:      committer,
+		clock:          clock,
+		operatorTenant: operatorTenant,
+	}
+}
+
+// Begin checks the tenant can be deleted, marks it deleting and returns the number of products to purge
+// Tenants that predate provisioning are registered on their first deletion. Deleting a tenant
+// again, e.g. after a failed operation, resumes the deletion
+func (i *Interactor) Begin(ctx context.Context, req *Request) (*domain.Tenant, int64, error) {
+	if i.operatorTenant == "" || tenant.FromContext(ctx) != i.operatorTenant {
+		return nil, 0, domain.ErrTenantProvisioningDenied
+	}
+	if !domain.ValidTenantID(req.TenantID) {
+		return nil, 0, domain.ErrInvalidTenant
+	}
+	if req.TenantID == i.operatorTenant {
+		return nil, 0, domain.ErrOperatorTenantDeletion
+	}
+	if req.Export && i.uploader == nil {
+		return nil, 0, domain.ErrTenantExportUnavailable
+	}
+
+	// 1. Refuse tenants with products under legal hold before anything is touched
+	held, err := i.purger.CountHeldProducts(ctx, req.TenantID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if held > 0 {
+		return nil, 0, domain.ErrTenantUnderLegalHold
+	}
+
+	// 2. Load or register the tenant
+	now := i.clock.Now()
+	t, err := i.registry.Load(ctx, req.TenantID)
+	if errors.Is(err, domain.ErrTenantNotFound) {
+		t, err = domain.NewTenant(req.TenantID, uuid.New().String(), req.TenantID, now)
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// 3. Mark it deleting
+	t.StartDeletion(now)
+	plan := commitplan.NewPlan()
+	plan.Add(i.registry.SaveMut(t))
+	if err := i.committer.Apply(ctx, plan); err != nil {
+		return nil, 0, fmt.Errorf("failed to start tenant deletion: %w", err)
+	}
+
+	total, err := i.purger.CountProducts(ctx, req.TenantID, req.StartAfter)
+	if err != nil {
+		return nil, 0, err
+	}
+	return t, total, nil
+}
+
+// Execute purges a tenant marked deleting
+// Progress is checkpointed after each chunk, so an interrupted run resumes from the checkpoint;
+// running it again for a deleted tenant changes nothing
+func (i *Interactor) Execute(ctx context.Context, req *Request, progress Progress) (*Response, error) {
+	t, err := i.registry.Load(ctx, req.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &Response{Tenant: t, LastProductID: req.StartAfter}
+	if t.State == domain.TenantDeleted {
+		return resp, nil
+	}
+	if t.State != domain.TenantDeleting {
+		return nil, fmt.Errorf("tenant %s is %s, not %s", t.ID, t.State, domain.TenantDeleting)
+	}
+	if req.Export {
+		if i.uploader == nil {
+			return nil, domain.ErrTenantExportUnavailable
+		}
+		resp.ExportPrefix = fmt.Sprintf("tenants/%s/%s/", t.ID, t.RegistrationID)
+	}
+
+	// 1. Revoke the keys first so the tenant cannot write while it is purged
+	revoked, err := i.revokeKeys(tenant.WithID(ctx, t.ID))
+	if err != nil {
+		return nil, err
+	}
+	resp.KeysRevoked = revoked
+
+	// 2. Export and purge the products
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		ids, err := i.purger.ScanProducts(ctx, t.ID, resp.LastProductID, ChunkSize)
+		if err != nil {
+			return nil, err
+		}
+		if len(ids) == 0 {
+			break
+		}
+		for _, id := range ids {
+			if req.Export {
+				if err := i.export(ctx, resp.ExportPrefix, id); err != nil {
+					return nil, err
+				}
+				resp.ProductsExported++
+			}
+			purged, err := i.purge(ctx, t.ID, id)
+			if err != nil {
+				return nil, err
+			}
+			if purged {
+				resp.ProductsPurged++
+			}
+			progress.Item(true)
+		}
+		resp.LastProductID = ids[len(ids)-1]
+		progress.Checkpoint(resp.LastProductID)
+		if len(ids) < ChunkSize {
+			break
+		}
+	}
+
+	// 3. Empty the tenant's other tables
+	rows, err := i.purger.PurgeTables(ctx, t.ID)
+	if err != nil {
+		return nil, err
+	}
+	resp.RowsDeleted = rows
+
+	// 4. Record the deletion → outbox
+	now := i.clock.Now()
+	t.MarkDeleted(now)
+	outboxMut, err := eventToOutboxMutation(&domain.TenantDeletedEvent{
+		TenantID:       t.ID,
+		RegistrationID: t.RegistrationID,
+		ProductsPurged: resp.ProductsPurged,
+		ExportPrefix:   resp.ExportPrefix,
+		DeletedAt:      now,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create outbox event: %w", err)
+	}
+	plan := commitplan.NewPlan()
+	plan.Add(i.registry.SaveMut(t))
+	plan.Add(outboxMut)
+
+	// 5. Apply plan
+	if err := i.committer.Apply(ctx, plan); err != nil {
+		return nil, fmt.Errorf("failed to record tenant deletion: %w", err)
+	}
+	i.config.Invalidate(t.ID)
+	metrics.Counter("tenant_products_purged_total").Add(resp.ProductsPurged)
+
+	return resp, nil
+}
+
+// revokeKeys revokes the tenant's live keys; the rows themselves are removed with the tenant's tables
+// Other servers may accept a revoked key until their authentication cache expires
+func (i *Interactor) revokeKeys(ctx context.Context) (int, error) {
+	keys, err := i.keys.List(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list api keys: %w", err)
+	}
+	revoked := 0
+	for _, key := range keys {
+		if key.RevokedAt != nil {
+			continue
+		}
+		if _, err := i.keys.Revoke(ctx, key.KeyID); err != nil {
+			return revoked, fmt.Errorf("failed to revoke api key %s: %w", key.KeyID, err)
+		}
+		revoked++
+	}
+	return revoked, nil
+}
+
+// export uploads the product's export document under prefix
+func (i *Interactor) export(ctx context.Context, prefix, productID string) error {
+	doc, err := i.exporter.Execute(ctx, productID)
+	if err != nil {
+		return fmt.Errorf("failed to export product %s: %w", productID, err)
+	}
+	if err := i.uploader.Upload(ctx, prefix+"products/"+productID+".json", "application/json", doc); err != nil {
+		return fmt.Errorf("failed to upload export of product %s: %w", productID, err)
+	}
+	return nil
+}
+
+// purge deletes one product, recording a product_purged event so search and CDN consumers drop it
+func (i *Interactor) purge(ctx context.Context, tenantID, productID string) (bool, error) {
+	outboxMut, err := eventToOutboxMutation(&domain.ProductPurgedEvent{
+		ProductID: productID,
+		TenantID:  tenantID,
+		PurgedAt:  i.clock.Now(),
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to create outbox event: %w", err)
+	}
+	return i.purger.PurgeProduct(ctx, productID, outboxMut)
+}
+
+// eventToOutboxMutation converts a domain event to an outbox mutation
+// Product events are keyed by the product, tenant events by the registration
+func eventToOutboxMutation(event domain.DomainEvent) (*spanner.Mutation, error) {
+	data := event.EventData()
+	eventData, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event data for event %s: %w", event.EventName(), err)
+	}
+	aggregateID, ok := data["product_id"].(string)
+	if !ok {
+		aggregateID, _ = data["registration_id"].(string)
+	}
+
+	outboxEvent := &m_outbox.OutboxEvent{
+		EventID:     m_outbox.NewEventID(),
+		EventType:   event.EventName(),
+		AggregateID: aggregateID,
+		Payload:     string(eventData),
+		Status:      "pending",
+		ProcessedAt: nil,
+	}
+	return outboxEvent.InsertMut(), nil
+}
@@ -0,0 +1,60 @@
+package delete_tenant_settings
+
+import (
+	"context"
+	"fmt"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/tenant_config"
+	"catalog-proj/internal/pkg/tenant"
+	"github.com/wuyiadepoju/commitplan"
+)
+
+// Request represents the input for deleting the caller's tenant settings
+type Request struct{}
+
+// Response represents the output of deleting tenant settings
+type Response struct {
+	Effective domain.TenantSettings // The service defaults the tenant now uses
+}
+
+// Interactor handles the delete tenant settings use case
+type Interactor struct {
+	store     contracts.TenantSettingsStore
+	config    *tenant_config.TenantConfig
+	committer commitplan.Committer
+}
+
+// NewInteractor creates a new delete tenant settings interactor
+func NewInteractor(
+	store contracts.TenantSettingsStore,
+	config *tenant_config.TenantConfig,
+	committer commitplan.Committer,
+) *Interactor {
+	return &Interactor{
+		store:     store,
+		config:    config,
+		committer: committer,
+	}
+}
+
+// Execute removes the overrides of the caller's tenant, returning it to the service defaults
+// Deleting settings a tenant does not have succeeds
+func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, error) {
+	// 1. Get delete mutation
+	tenantID := tenant.FromContext(ctx)
+	plan := commitplan.NewPlan()
+	plan.Add(i.store.DeleteMut(tenantID))
+
+	// 2. Apply plan
+	if err := i.committer.Apply(ctx, plan); err != nil {
+		return nil, fmt.Errorf("failed to delete tenant settings: %w", err)
+	}
+	i.config.Invalidate(tenantID)
+
+	// 3. Return defaults
+	return &Response{
+		Effective: i.config.Defaults(),
+	}, nil
+}
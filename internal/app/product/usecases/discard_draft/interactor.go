@@ -0,0 +1,57 @@
+package discard_draft
+
+import (
+	"context"
+	"fmt"
+
+	"catalog-proj/internal/app/product/contracts"
+
+	"github.com/wuyiadepoju/commitplan"
+)
+
+// Request represents the input for discarding a product's draft
+type Request struct {
+	ProductID string
+}
+
+// Response represents the output of discarding a draft
+type Response struct {
+	ProductID string
+}
+
+// Interactor handles the discard draft use case
+type Interactor struct {
+	drafts    contracts.DraftStore
+	committer commitplan.Committer
+}
+
+// NewInteractor creates a new discard draft interactor
+func NewInteractor(
+	drafts contracts.DraftStore,
+	committer commitplan.Committer,
+) *Interactor {
+	return &Interactor{
+		drafts:    drafts,
+		committer: committer,
+	}
+}
+
+// Execute deletes the product's draft, leaving its published content as it is
+func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, error) {
+	// 1. Load the draft, which also checks it belongs to the caller's tenant
+	if _, err := i.drafts.Load(ctx, req.ProductID); err != nil {
+		return nil, fmt.Errorf("failed to load draft: %w", err)
+	}
+
+	// 2. Apply plan
+	plan := commitplan.NewPlan()
+	plan.Add(i.drafts.DeleteMut(req.ProductID))
+	if err := i.committer.Apply(ctx, plan); err != nil {
+		return nil, fmt.Errorf("failed to discard draft: %w", err)
+	}
+
+	// 3. Return product ID
+	return &Response{
+		ProductID: req.ProductID,
+	}, nil
+}
@@ -0,0 +1,158 @@
+package generate_product_feeds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/queries/list_products"
+	"catalog-proj/internal/pkg/clock"
+	"catalog-proj/internal/pkg/metrics"
+	"catalog-proj/internal/pkg/tenant"
+)
+
+// Feed formats
+const (
+	FormatGoogleMerchant = "google_merchant"
+	FormatFacebook       = "facebook"
+)
+
+// TenantFeed holds the feed settings of one tenant
+type TenantFeed struct {
+	TenantID string
+	Formats  []string
+	// Channel limits the feed to products visible on it
+	Channel  string
+	Currency string
+	Brand    string
+	// ProductURL is the storefront link of a product, with "{id}" standing for the product ID
+	ProductURL string
+	// ImageMetadataKey names the metadata entry holding a product's image URL
+	ImageMetadataKey string
+}
+
+// Request represents the feeds to generate in one run
+type Request struct {
+	Feeds []TenantFeed
+	// PageSize is the number of products read per list page
+	PageSize int
+}
+
+// Response reports a feed run
+type Response struct {
+	Products int // Products written to at least one feed
+	Skipped  int // Products left out for lack of a price
+	Uploaded int // Feed files uploaded
+}
+
+// Interactor handles the generate product feeds use case
+// Each run rewrites every configured feed from the current catalog; a tenant whose feed fails
+// keeps its previous files and does not stop the other tenants
+type Interactor struct {
+	products *list_products.Query
+	uploader contracts.FeedUploader
+	clock    clock.Clock
+}
+
+// NewInteractor creates a new generate product feeds interactor
+func NewInteractor(
+	products *list_products.Query,
+	uploader contracts.FeedUploader,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		products: products,
+		uploader: uploader,
+		clock:    clock,
+	}
+}
+
+// Execute generates and uploads each tenant's feeds
+func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, error) {
+	resp := &Response{}
+	var errs []error
+	for _, feed := range req.Feeds {
+		if err := i.generate(ctx, feed, req.PageSize, resp); err != nil {
+			slog.Error("Failed to generate product feeds", "tenant_id", feed.TenantID, "error", err)
+			errs = append(errs, fmt.Errorf("tenant %s: %w", feed.TenantID, err))
+		}
+	}
+	return resp, errors.Join(errs...)
+}
+
+// generate reads the tenant's active products on the feed channel and uploads one file per format
+func (i *Interactor) generate(ctx context.Context, feed TenantFeed, pageSize int, resp *Response) error {
+	ctx = tenant.WithID(ctx, feed.TenantID)
+
+	// 1. Read the products page by page
+	// Products created meanwhile shift later pages, so IDs already seen are skipped
+	var items []feedItem
+	seen := make(map[string]bool)
+	for offset := 0; ; offset += pageSize {
+		page, err := i.products.Execute(ctx, &list_products.Request{
+			TenantID:  feed.TenantID,
+			Status:    string(domain.ProductStatusActive),
+			Channel:   feed.Channel,
+			Limit:     pageSize,
+			Offset:    offset,
+			SkipTotal: true,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list products: %w", err)
+		}
+		for _, product := range page.Products {
+			if seen[product.ID] || product.ArchivedAt != nil {
+				continue
+			}
+			seen[product.ID] = true
+			item, ok := newFeedItem(product, feed)
+			if !ok {
+				resp.Skipped++
+				continue
+			}
+			items = append(items, item)
+		}
+		if !page.HasMore {
+			break
+		}
+	}
+	resp.Products += len(items)
+
+	// 2. Render and upload each format
+	now := i.clock.Now()
+	for _, format := range feed.Formats {
+		var (
+			data        []byte
+			contentType string
+			object      string
+			err         error
+		)
+		switch format {
+		case FormatGoogleMerchant:
+			data, err = renderGoogleMerchant(items, feed, now)
+			contentType, object = "application/xml", objectName(feed.TenantID, "google_merchant.xml")
+		case FormatFacebook:
+			data, err = renderFacebook(items)
+			contentType, object = "text/csv", objectName(feed.TenantID, "facebook.csv")
+		default:
+			return fmt.Errorf("unknown feed format %q", format)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to render %s feed: %w", format, err)
+		}
+		if err := i.uploader.Upload(ctx, object, contentType, data); err != nil {
+			return fmt.Errorf("failed to upload %s feed: %w", format, err)
+		}
+		resp.Uploaded++
+		metrics.Labeled("product_feed_uploads_total").Add(format, 1)
+	}
+	return nil
+}
+
+// objectName is where a tenant's feed file is stored in the bucket
+func objectName(tenantID, file string) string {
+	return "feeds/" + tenantID + "/" + file
+}
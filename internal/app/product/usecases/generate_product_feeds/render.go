@@ -0,0 +1,165 @@
+package generate_product_feeds
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"net/url"
+	"strings"
+	"time"
+
+	"catalog-proj/internal/app/product/queries/list_products"
+)
+
+// feedItem is a product as every feed format describes it
+type feedItem struct {
+	ID          string
+	Title       string
+	Description string
+	Link        string
+	ImageLink   string
+	Price       string // Base price, e.g. "19.99 USD"
+	SalePrice   string // Effective price when it is below the base price
+	Brand       string
+	GTIN        string
+	MPN         string
+	ProductType string
+}
+
+// newFeedItem maps a listed product to a feed item; products without a price cannot be listed
+func newFeedItem(product list_products.ProductItem, feed TenantFeed) (feedItem, bool) {
+	if product.BasePrice == nil {
+		return feedItem{}, false
+	}
+
+	item := feedItem{
+		ID:          product.ID,
+		Title:       product.Name,
+		Description: product.Description,
+		Link:        strings.ReplaceAll(feed.ProductURL, "{id}", url.PathEscape(product.ID)),
+		ImageLink:   product.Metadata[feed.ImageMetadataKey],
+		Price:       product.BasePrice.FloatString(2) + " " + feed.Currency,
+		Brand:       feed.Brand,
+		GTIN:        product.GTIN,
+		MPN:         product.SKU,
+		ProductType: product.Category,
+	}
+	// Both channels reject items without a description
+	if item.Description == "" {
+		item.Description = item.Title
+	}
+	if product.EffectivePrice != nil && product.EffectivePrice.Cmp(product.BasePrice) < 0 {
+		item.SalePrice = product.EffectivePrice.FloatString(2) + " " + feed.Currency
+	}
+	return item, true
+}
+
+// googleRSS is a Google Merchant Center RSS 2.0 feed
+type googleRSS struct {
+	XMLName xml.Name      `xml:"rss"`
+	Version string        `xml:"version,attr"`
+	XMLNS   string        `xml:"xmlns:g,attr"`
+	Channel googleChannel `xml:"channel"`
+}
+
+type googleChannel struct {
+	Title       string       `xml:"title"`
+	Link        string       `xml:"link"`
+	Description string       `xml:"description"`
+	Items       []googleItem `xml:"item"`
+}
+
+type googleItem struct {
+	ID               string `xml:"g:id"`
+	Title            string `xml:"g:title"`
+	Description      string `xml:"g:description"`
+	Link             string `xml:"g:link"`
+	ImageLink        string `xml:"g:image_link,omitempty"`
+	Availability     string `xml:"g:availability"`
+	Condition        string `xml:"g:condition"`
+	Price            string `xml:"g:price"`
+	SalePrice        string `xml:"g:sale_price,omitempty"`
+	Brand            string `xml:"g:brand,omitempty"`
+	GTIN             string `xml:"g:gtin,omitempty"`
+	MPN              string `xml:"g:mpn,omitempty"`
+	IdentifierExists string `xml:"g:identifier_exists,omitempty"`
+	ProductType      string `xml:"g:product_type,omitempty"`
+}
+
+// renderGoogleMerchant renders the items as a Google Merchant Center XML feed
+func renderGoogleMerchant(items []feedItem, feed TenantFeed, now time.Time) ([]byte, error) {
+	rss := googleRSS{
+		Version: "2.0",
+		XMLNS:   "http://base.google.com/ns/1.0",
+		Channel: googleChannel{
+			Title:       feed.TenantID + " products",
+			Link:        storeLink(feed.ProductURL),
+			Description: "Generated " + now.UTC().Format(time.RFC3339),
+			Items:       make([]googleItem, 0, len(items)),
+		},
+	}
+	for _, item := range items {
+		out := googleItem{
+			ID:           item.ID,
+			Title:        item.Title,
+			Description:  item.Description,
+			Link:         item.Link,
+			ImageLink:    item.ImageLink,
+			Availability: "in_stock",
+			Condition:    "new",
+			Price:        item.Price,
+			SalePrice:    item.SalePrice,
+			Brand:        item.Brand,
+			GTIN:         item.GTIN,
+			MPN:          item.MPN,
+			ProductType:  item.ProductType,
+		}
+		// Without a GTIN, Google needs brand and MPN or an explicit statement that there are none
+		if item.GTIN == "" && (item.Brand == "" || item.MPN == "") {
+			out.IdentifierExists = "no"
+		}
+		rss.Channel.Items = append(rss.Channel.Items, out)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	encoder := xml.NewEncoder(&buf)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(rss); err != nil {
+		return nil, err
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
+
+// storeLink is the storefront's root, taken from the product URL template
+func storeLink(productURL string) string {
+	parsed, err := url.Parse(strings.ReplaceAll(productURL, "{id}", ""))
+	if err != nil || parsed.Host == "" {
+		return productURL
+	}
+	return parsed.Scheme + "://" + parsed.Host + "/"
+}
+
+// facebookColumns are the Facebook catalog CSV columns in file order
+var facebookColumns = []string{"id", "title", "description", "availability", "condition", "price", "sale_price", "link", "image_link", "brand", "gtin", "mpn", "product_type"}
+
+// renderFacebook renders the items as a Facebook catalog CSV feed
+func renderFacebook(items []feedItem) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write(facebookColumns); err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		record := []string{
+			item.ID, item.Title, item.Description, "in stock", "new", item.Price, item.SalePrice,
+			item.Link, item.ImageLink, item.Brand, item.GTIN, item.MPN, item.ProductType,
+		}
+		if err := writer.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	writer.Flush()
+	return buf.Bytes(), writer.Error()
+}
@@ -0,0 +1,130 @@
+package link_external_ref
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/models/m_outbox"
+	"catalog-proj/internal/pkg/clock"
+	"cloud.google.com/go/spanner"
+	"github.com/wuyiadepoju/commitplan"
+)
+
+// Request represents the input for linking an external reference to a product
+type Request struct {
+	ProductID string
+	Ref       domain.ExternalRef
+}
+
+// Response represents the output of linking an external reference
+type Response struct {
+	ProductID string
+}
+
+// Interactor handles the link external reference use case
+type Interactor struct {
+	repo      contracts.ProductRepository
+	refs      contracts.ExternalRefStore
+	committer commitplan.Committer
+	clock     clock.Clock
+}
+
+// NewInteractor creates a new link external reference interactor
+func NewInteractor(
+	repo contracts.ProductRepository,
+	refs contracts.ExternalRefStore,
+	committer commitplan.Committer,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		repo:      repo,
+		refs:      refs,
+		committer: committer,
+		clock:     clock,
+	}
+}
+
+// Execute links the reference following the Golden Mutation Pattern
+// Linking a reference the product already holds is a no-op; one held by another product is rejected
+func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, error) {
+	if err := req.Ref.Validate(); err != nil {
+		return nil, err
+	}
+
+	// 1. Load aggregate and the current holder of the reference
+	product, err := i.repo.Load(ctx, req.ProductID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load product: %w", err)
+	}
+	holderID, err := i.refs.Lookup(ctx, req.Ref)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up external reference: %w", err)
+	}
+	if holderID == product.ID() {
+		return &Response{ProductID: product.ID()}, nil
+	}
+	if holderID != "" {
+		return nil, &domain.ExternalRefTakenError{ProductID: holderID, Ref: req.Ref}
+	}
+
+	// 2. Call domain method
+	now := i.clock.Now()
+	if err := product.LinkExternalRef(req.Ref, now); err != nil {
+		return nil, fmt.Errorf("failed to link external reference: %w", err)
+	}
+
+	// 3. Get reference mutation (an insert, so a concurrent link of the same reference fails)
+	plan := commitplan.NewPlan()
+	plan.Add(i.refs.LinkMut(product.TenantID(), req.Ref, product.ID(), now))
+
+	// 4. Collect events → outbox
+	for _, event := range product.DomainEvents() {
+		outboxMut, err := i.eventToOutboxMutation(event)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create outbox event: %w", err)
+		}
+		if outboxMut != nil {
+			plan.Add(outboxMut)
+		}
+	}
+
+	// 5. Apply plan
+	if err := i.committer.Apply(ctx, plan); err != nil {
+		return nil, fmt.Errorf("failed to link external reference: %w", err)
+	}
+
+	// 6. Return product ID
+	return &Response{
+		ProductID: product.ID(),
+	}, nil
+}
+
+// eventToOutboxMutation converts a domain event to an outbox mutation
+func (i *Interactor) eventToOutboxMutation(event domain.DomainEvent) (*spanner.Mutation, error) {
+	eventData, err := json.Marshal(event.EventData())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event data for event %s: %w", event.EventName(), err)
+	}
+
+	// Extract aggregate_id from event data (product_id)
+	aggregateID := ""
+	if data := event.EventData(); data != nil {
+		if pid, ok := data["product_id"].(string); ok {
+			aggregateID = pid
+		}
+	}
+
+	outboxEvent := &m_outbox.OutboxEvent{
+		EventID:     m_outbox.NewEventID(),
+		EventType:   event.EventName(),
+		AggregateID: aggregateID,
+		Payload:     string(eventData),
+		Status:      "pending",
+		ProcessedAt: nil,
+	}
+
+	return outboxEvent.InsertMut(), nil
+}
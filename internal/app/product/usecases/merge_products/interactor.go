@@ -0,0 +1,94 @@
+package merge_products
+
+import (
+	"context"
+	"fmt"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/unit_of_work"
+	"catalog-proj/internal/pkg/clock"
+
+	"github.com/wuyiadepoju/commitplan"
+)
+
+// Request represents the input for merging a duplicate product into a canonical one
+type Request struct {
+	DuplicateID string
+	CanonicalID string
+}
+
+// Response represents the output of merging products
+type Response struct {
+	DuplicateID string
+	CanonicalID string
+}
+
+// Interactor handles the merge products use case
+// The duplicate is archived and its ID becomes an alias of the canonical product, which is left unchanged
+type Interactor struct {
+	repo      contracts.ProductRepository
+	aliases   contracts.AliasStore
+	committer commitplan.Committer
+	clock     clock.Clock
+}
+
+// NewInteractor creates a new merge products interactor
+func NewInteractor(
+	repo contracts.ProductRepository,
+	aliases contracts.AliasStore,
+	committer commitplan.Committer,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		repo:      repo,
+		aliases:   aliases,
+		committer: committer,
+		clock:     clock,
+	}
+}
+
+// Execute merges the duplicate into the canonical product following the Golden Mutation Pattern
+// Both products are tracked in one unit of work, so the merge commits atomically with its aliases
+func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, error) {
+	if req.DuplicateID == req.CanonicalID {
+		return nil, domain.ErrMergeIntoSelf
+	}
+
+	// 1. Load aggregates
+	uow := unit_of_work.New(i.repo, i.committer)
+	duplicate, err := uow.Load(ctx, req.DuplicateID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load duplicate product: %w", err)
+	}
+	canonical, err := uow.Load(ctx, req.CanonicalID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load canonical product: %w", err)
+	}
+
+	// 2. Call domain method
+	now := i.clock.Now()
+	if err := duplicate.MergeInto(canonical, now); err != nil {
+		return nil, fmt.Errorf("failed to merge product: %w", err)
+	}
+
+	// 3. Add alias mutations
+	aliasMuts, err := i.aliases.AliasMuts(ctx, duplicate.TenantID(), duplicate.ID(), canonical.ID(), now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to alias product: %w", err)
+	}
+	for _, mut := range aliasMuts {
+		uow.Add(mut)
+	}
+
+	// 4. Commit product changes, events and aliases together
+	if err := uow.Commit(ctx, now); err != nil {
+		return nil, fmt.Errorf("failed to merge product: %w", err)
+	}
+
+	// 5. Return both IDs
+	return &Response{
+		DuplicateID: duplicate.ID(),
+		CanonicalID: canonical.ID(),
+	}, nil
+}
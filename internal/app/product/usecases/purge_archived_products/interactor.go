@@ -0,0 +1,168 @@
+package purge_archived_products
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/models/m_outbox"
+	"catalog-proj/internal/pkg/clock"
+	"catalog-proj/internal/pkg/metrics"
+
+	"cloud.google.com/go/spanner"
+)
+
+// defaultLimit bounds the number of products handled per run when the request does not set one
+const defaultLimit = 500
+
+// Request represents the input for purging archived products
+type Request struct {
+	// RetentionDays is how long archived products are kept before they may be purged
+	RetentionDays int
+	// DryRun reports what would be purged without deleting anything
+	DryRun bool
+	// Limit bounds the number of products purged (and held products reported) per run
+	Limit int
+}
+
+// PurgedProduct describes a product that was (or in dry-run mode would be) purged
+type PurgedProduct struct {
+	ProductID  string
+	TenantID   string
+	ArchivedAt time.Time
+	EventCount int64
+}
+
+// Response represents the purge report
+type Response struct {
+	DryRun         bool
+	ArchivedBefore time.Time
+	Purged         []PurgedProduct
+	// Held lists products past retention that were kept because of a legal hold
+	Held []string
+	// Skipped lists candidates that stopped qualifying before they could be purged
+	Skipped       []string
+	EventsDeleted int64
+}
+
+// Interactor handles the purge archived products use case
+// Purges run across all tenants and are meant for operators and the retention job
+type Interactor struct {
+	store contracts.RetentionStore
+	clock clock.Clock
+}
+
+// NewInteractor creates a new purge archived products interactor
+func NewInteractor(
+	store contracts.RetentionStore,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		store: store,
+		clock: clock,
+	}
+}
+
+// Execute hard-deletes products archived more than RetentionDays ago, honoring legal holds
+func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, error) {
+	if req.RetentionDays < 1 {
+		return nil, fmt.Errorf("retention days must be at least 1, got %d", req.RetentionDays)
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+
+	now := i.clock.Now()
+	cutoff := now.AddDate(0, 0, -req.RetentionDays)
+	resp := &Response{
+		DryRun:         req.DryRun,
+		ArchivedBefore: cutoff,
+	}
+
+	// 1. Report held products
+	held, err := i.store.FindHeldProducts(ctx, cutoff, limit)
+	if err != nil {
+		return nil, err
+	}
+	resp.Held = held
+
+	// 2. Find candidates
+	candidates, err := i.store.FindPurgeCandidates(ctx, cutoff, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Purge each product in its own transaction, recording a product_purged event
+	for _, c := range candidates {
+		purged := PurgedProduct{
+			ProductID:  c.ProductID,
+			TenantID:   c.TenantID,
+			ArchivedAt: c.ArchivedAt,
+			EventCount: c.EventCount,
+		}
+		if req.DryRun {
+			resp.Purged = append(resp.Purged, purged)
+			continue
+		}
+
+		outboxMut, err := i.eventToOutboxMutation(&domain.ProductPurgedEvent{
+			ProductID:  c.ProductID,
+			TenantID:   c.TenantID,
+			ArchivedAt: c.ArchivedAt,
+			PurgedAt:   now,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create outbox event: %w", err)
+		}
+
+		ok, eventsDeleted, err := i.store.Purge(ctx, c.ProductID, cutoff, outboxMut)
+		if err != nil {
+			return resp, err
+		}
+		if !ok {
+			resp.Skipped = append(resp.Skipped, c.ProductID)
+			continue
+		}
+		purged.EventCount = eventsDeleted
+		resp.Purged = append(resp.Purged, purged)
+		resp.EventsDeleted += eventsDeleted
+	}
+
+	if !req.DryRun {
+		metrics.Counter("retention_products_purged_total").Add(int64(len(resp.Purged)))
+		metrics.Counter("retention_events_deleted_total").Add(resp.EventsDeleted)
+	}
+
+	return resp, nil
+}
+
+// eventToOutboxMutation converts a domain event to an outbox mutation
+func (i *Interactor) eventToOutboxMutation(event domain.DomainEvent) (*spanner.Mutation, error) {
+	eventData, err := json.Marshal(event.EventData())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event data for event %s: %w", event.EventName(), err)
+	}
+
+	// Extract aggregate_id from event data (product_id)
+	aggregateID := ""
+	if data := event.EventData(); data != nil {
+		if pid, ok := data["product_id"].(string); ok {
+			aggregateID = pid
+		}
+	}
+
+	outboxEvent := &m_outbox.OutboxEvent{
+		EventID:     m_outbox.NewEventID(),
+		EventType:   event.EventName(),
+		AggregateID: aggregateID,
+		Payload:     string(eventData),
+		Status:      "pending",
+		ProcessedAt: nil,
+	}
+
+	return outboxEvent.InsertMut(), nil
+}
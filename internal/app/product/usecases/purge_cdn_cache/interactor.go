@@ -0,0 +1,188 @@
+package purge_cdn_cache
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/app/product/queries/get_product"
+	"catalog-proj/internal/pkg/clock"
+	"catalog-proj/internal/pkg/metrics"
+
+	"github.com/wuyiadepoju/commitplan"
+)
+
+const (
+	// Consumer keys the purger's rows in processed_events
+	Consumer = "cdn_purger"
+	// defaultBatchSize is the number of outbox events applied per batch when the request does not set one
+	defaultBatchSize = 500
+)
+
+// purgedEvents are the events that change what a product or category page shows: its status,
+// price or content; other events are marked processed without a purge
+var purgedEvents = map[string]bool{
+	"product_activated":   true,
+	"product_deactivated": true,
+	"product_archived":    true,
+	"product_merged":      true,
+	"channels_changed":    true,
+	"product_updated":     true,
+	"metadata_changed":    true,
+	"base_price_changed":  true,
+	"discount_applied":    true,
+	"discount_removed":    true,
+	"change_approved":     true,
+	"price_floor_changed": true,
+}
+
+// Request represents the input for purging changed pages
+type Request struct {
+	// ProductURLs are the pages of a product, with "{tenant}" and "{id}" standing for its tenant and ID
+	ProductURLs []string
+	// CategoryURLs are the pages listing a category, with "{tenant}" and "{category}" standing for
+	// the product's tenant and category
+	CategoryURLs []string
+	BatchSize    int
+}
+
+// Response reports a purge run
+type Response struct {
+	Events int
+	URLs   int
+}
+
+// ProductReader reads the current state of changed products
+type ProductReader interface {
+	BatchGetProducts(ctx context.Context, ids []string) ([]*get_product.DTO, error)
+}
+
+// Interactor handles the purge CDN cache use case
+// Each batch purges the pages of the products its events changed, using their current tenant and
+// category; a product that moved category only has its new category's pages purged, and products
+// that no longer exist are skipped since they were purged when archived
+type Interactor struct {
+	feed      contracts.OutboxFeed
+	products  ProductReader
+	purger    contracts.CachePurger
+	committer commitplan.Committer
+	clock     clock.Clock
+}
+
+// NewInteractor creates a new purge CDN cache interactor
+func NewInteractor(
+	feed contracts.OutboxFeed,
+	products ProductReader,
+	purger contracts.CachePurger,
+	committer commitplan.Committer,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		feed:      feed,
+		products:  products,
+		purger:    purger,
+		committer: committer,
+		clock:     clock,
+	}
+}
+
+// Execute purges the pages behind unprocessed outbox events until none are left
+// A failed purge leaves its batch unprocessed, so the next run retries it
+func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, error) {
+	batchSize := req.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+
+	resp := &Response{}
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		// 1. Read the next batch of events
+		events, err := i.feed.Unprocessed(ctx, Consumer, batchSize)
+		if err != nil {
+			return nil, err
+		}
+		if len(events) == 0 {
+			break
+		}
+
+		// 2. Read the products whose pages changed
+		seen := make(map[string]bool, len(events))
+		var ids []string
+		for _, event := range events {
+			if purgedEvents[event.EventType] && !seen[event.AggregateID] {
+				seen[event.AggregateID] = true
+				ids = append(ids, event.AggregateID)
+			}
+		}
+		var dtos []*get_product.DTO
+		if len(ids) > 0 {
+			if dtos, err = i.products.BatchGetProducts(ctx, ids); err != nil {
+				return nil, fmt.Errorf("failed to read changed products: %w", err)
+			}
+		}
+
+		// 3. Purge their product and category pages
+		urls := pageURLs(dtos, req.ProductURLs, req.CategoryURLs)
+		if len(urls) > 0 {
+			if err := i.purger.Purge(ctx, urls); err != nil {
+				return nil, fmt.Errorf("failed to purge CDN cache: %w", err)
+			}
+		}
+
+		// 4. Record the events as processed
+		now := i.clock.Now()
+		plan := commitplan.NewPlan()
+		for _, event := range events {
+			plan.Add(i.feed.MarkProcessedMut(Consumer, event.EventID, now))
+		}
+		plan.Add(i.feed.AdvanceMut(Consumer, events[len(events)-1], now))
+		if err := i.committer.Apply(ctx, plan); err != nil {
+			return nil, fmt.Errorf("failed to record purged events: %w", err)
+		}
+		resp.Events += len(events)
+		resp.URLs += len(urls)
+
+		if len(events) < batchSize {
+			break
+		}
+	}
+
+	metrics.Counter("cdn_purged_urls_total").Add(int64(resp.URLs))
+	return resp, nil
+}
+
+// pageURLs expands the templates for each product, without duplicates and in a stable order
+func pageURLs(dtos []*get_product.DTO, productURLs, categoryURLs []string) []string {
+	seen := make(map[string]bool)
+	var urls []string
+	add := func(u string) {
+		if !seen[u] {
+			seen[u] = true
+			urls = append(urls, u)
+		}
+	}
+	for _, dto := range dtos {
+		for _, template := range productURLs {
+			add(strings.NewReplacer(
+				"{tenant}", url.PathEscape(dto.TenantID),
+				"{id}", url.PathEscape(dto.ID),
+			).Replace(template))
+		}
+		if dto.Category == "" {
+			continue
+		}
+		for _, template := range categoryURLs {
+			add(strings.NewReplacer(
+				"{tenant}", url.PathEscape(dto.TenantID),
+				"{category}", url.PathEscape(dto.Category),
+			).Replace(template))
+		}
+	}
+	return urls
+}
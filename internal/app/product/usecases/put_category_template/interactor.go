@@ -0,0 +1,71 @@
+package put_category_template
+
+import (
+	"context"
+	"fmt"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/pkg/clock"
+	"catalog-proj/internal/pkg/tenant"
+	"github.com/wuyiadepoju/commitplan"
+)
+
+// Request represents the input for creating or replacing a category's attribute template
+// Template.UpdatedAt is assigned by the interactor
+type Request struct {
+	Template domain.CategoryTemplate
+}
+
+// Response represents the output of putting a category template
+type Response struct {
+	Template domain.CategoryTemplate
+}
+
+// Interactor handles the put category template use case
+type Interactor struct {
+	templates contracts.CategoryTemplateStore
+	committer commitplan.Committer
+	clock     clock.Clock
+}
+
+// NewInteractor creates a new put category template interactor
+func NewInteractor(
+	templates contracts.CategoryTemplateStore,
+	committer commitplan.Committer,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		templates: templates,
+		committer: committer,
+		clock:     clock,
+	}
+}
+
+// Execute records the template for the caller's tenant, replacing any earlier one
+// Products already in the category keep their attributes until they are next set
+func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, error) {
+	template := req.Template
+	if err := template.Validate(); err != nil {
+		return nil, err
+	}
+
+	// 1. Get template mutation
+	template.UpdatedAt = i.clock.Now()
+	mut, err := i.templates.UpsertMut(tenant.FromContext(ctx), template)
+	if err != nil {
+		return nil, err
+	}
+	plan := commitplan.NewPlan()
+	plan.Add(mut)
+
+	// 2. Apply plan
+	if err := i.committer.Apply(ctx, plan); err != nil {
+		return nil, fmt.Errorf("failed to put category template: %w", err)
+	}
+
+	// 3. Return template
+	return &Response{
+		Template: template,
+	}, nil
+}
@@ -0,0 +1,82 @@
+package put_tenant_settings
+
+import (
+	"context"
+	"fmt"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/tenant_config"
+	"catalog-proj/internal/pkg/clock"
+	"catalog-proj/internal/pkg/tenant"
+	"github.com/wuyiadepoju/commitplan"
+)
+
+// Request represents the input for replacing the caller's tenant settings
+// Settings.UpdatedAt is assigned by the interactor
+type Request struct {
+	Settings domain.TenantSettings
+}
+
+// Response represents the output of putting tenant settings
+type Response struct {
+	Settings  domain.TenantSettings // The stored overrides
+	Effective domain.TenantSettings // The overrides on top of the service defaults
+}
+
+// Interactor handles the put tenant settings use case
+type Interactor struct {
+	store     contracts.TenantSettingsStore
+	config    *tenant_config.TenantConfig
+	committer commitplan.Committer
+	clock     clock.Clock
+}
+
+// NewInteractor creates a new put tenant settings interactor
+func NewInteractor(
+	store contracts.TenantSettingsStore,
+	config *tenant_config.TenantConfig,
+	committer commitplan.Committer,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		store:     store,
+		config:    config,
+		committer: committer,
+		clock:     clock,
+	}
+}
+
+// Execute records the settings of the caller's tenant, replacing any earlier overrides
+// Existing products and discounts are not re-checked against the new limits
+func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, error) {
+	settings := req.Settings
+	if err := settings.Validate(); err != nil {
+		return nil, err
+	}
+
+	// The range is checked once the defaults fill any bound left at zero
+	effective := settings.WithDefaults(i.config.Defaults())
+	if effective.MinDiscountBasisPoints > effective.MaxDiscountBasisPoints {
+		return nil, domain.ErrInvalidTenantSettings
+	}
+
+	// 1. Get settings mutation
+	tenantID := tenant.FromContext(ctx)
+	settings.UpdatedAt = i.clock.Now()
+	plan := commitplan.NewPlan()
+	plan.Add(i.store.UpsertMut(tenantID, settings))
+
+	// 2. Apply plan
+	if err := i.committer.Apply(ctx, plan); err != nil {
+		return nil, fmt.Errorf("failed to put tenant settings: %w", err)
+	}
+	i.config.Invalidate(tenantID)
+
+	// 3. Return settings
+	effective.UpdatedAt = settings.UpdatedAt
+	return &Response{
+		Settings:  settings,
+		Effective: effective,
+	}, nil
+}
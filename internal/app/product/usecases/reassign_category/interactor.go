@@ -0,0 +1,275 @@
+package reassign_category
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/domain/services"
+	"catalog-proj/internal/models/m_outbox"
+	"catalog-proj/internal/pkg/clock"
+	"cloud.google.com/go/spanner"
+	"github.com/google/uuid"
+	"github.com/wuyiadepoju/commitplan"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// ChunkSize is the number of products whose moves are committed in one transaction
+	ChunkSize = 100
+	// maxFailures bounds the failed products listed in the response
+	maxFailures = 1000
+)
+
+// Request represents the input for moving every product of a category to another
+type Request struct {
+	From string
+	To   string
+	// StartAfter resumes a previous run after this product ID
+	StartAfter string
+}
+
+// Progress receives per-product progress; lro.Progress satisfies it
+type Progress interface {
+	Item(ok bool)
+	Checkpoint(productID string)
+}
+
+// Failure is a product that stayed in the old category
+type Failure struct {
+	ProductID string
+	Err       error
+}
+
+// Response represents the reassignment report
+type Response struct {
+	Moved         int64
+	Failures      []Failure // The first maxFailures; progress counts every failure
+	LastProductID string
+}
+
+// Interactor handles the reassign category use case
+// Products are moved in chunks of ChunkSize; each chunk's updates, content versions and
+// outbox events commit together. Each move is checked like an UpdateProduct category change:
+// the target category's validation rules apply and, where names are unique, a product whose
+// name is taken in the target category is left where it is
+type Interactor struct {
+	scanner    contracts.CategoryScanner
+	repo       contracts.ProductRepository
+	versions   contracts.VersionStore
+	committer  commitplan.Committer
+	clock      clock.Clock
+	namePolicy *services.UniqueNamePolicy
+	names      contracts.NameLookup
+	rules      *services.ValidationRules
+}
+
+// NewInteractor creates a new reassign category interactor
+func NewInteractor(
+	scanner contracts.CategoryScanner,
+	repo contracts.ProductRepository,
+	versions contracts.VersionStore,
+	committer commitplan.Committer,
+	clock clock.Clock,
+	namePolicy *services.UniqueNamePolicy,
+	names contracts.NameLookup,
+	rules *services.ValidationRules,
+) *Interactor {
+	return &Interactor{
+		scanner:    scanner,
+		repo:       repo,
+		versions:   versions,
+		committer:  committer,
+		clock:      clock,
+		namePolicy: namePolicy,
+		names:      names,
+		rules:      rules,
+	}
+}
+
+// Count returns the number of products a reassignment starting after startAfter will visit
+func (i *Interactor) Count(ctx context.Context, from, startAfter string) (int64, error) {
+	return i.scanner.CountCategory(ctx, from, startAfter)
+}
+
+// Execute moves the caller's tenant's unarchived products from one category to another in ID order
+// Progress is checkpointed after each chunk, so an interrupted run resumes from the checkpoint
+func (i *Interactor) Execute(ctx context.Context, req *Request, progress Progress) (*Response, error) {
+	if req.From == req.To {
+		return nil, domain.ErrSameCategory
+	}
+
+	resp := &Response{LastProductID: req.StartAfter}
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		// Moved products leave the category, but failed ones stay, so the scan pages by ID
+		ids, err := i.scanner.ScanCategory(ctx, req.From, resp.LastProductID, ChunkSize)
+		if err != nil {
+			return nil, err
+		}
+		if len(ids) == 0 {
+			break
+		}
+		moved, failures := i.moveChunk(ctx, ids, req.To)
+		resp.Moved += moved
+		resp.Failures = append(resp.Failures, failures[:min(len(failures), maxFailures-len(resp.Failures))]...)
+		resp.LastProductID = ids[len(ids)-1]
+		for range moved {
+			progress.Item(true)
+		}
+		for range failures {
+			progress.Item(false)
+		}
+		progress.Checkpoint(resp.LastProductID)
+		if len(ids) < ChunkSize {
+			break
+		}
+	}
+	return resp, nil
+}
+
+// move is one product's pending change
+type move struct {
+	product   *domain.Product
+	mutations []*spanner.Mutation
+}
+
+// moveChunk moves one chunk of products following the Golden Mutation Pattern
+// When the chunk's commit hits a taken name, its products are committed one by one so
+// only the conflicting ones fail
+func (i *Interactor) moveChunk(ctx context.Context, ids []string, to string) (int64, []Failure) {
+	now := i.clock.Now()
+	var failures []Failure
+	var moves []move
+	for _, id := range ids {
+		m, err := i.prepare(ctx, id, to, now)
+		if err != nil {
+			failures = append(failures, Failure{ProductID: id, Err: err})
+			continue
+		}
+		moves = append(moves, m)
+	}
+	if len(moves) == 0 {
+		return 0, failures
+	}
+
+	// 5. Apply plan
+	err := i.apply(ctx, moves...)
+	if err == nil {
+		return int64(len(moves)), failures
+	}
+	if spanner.ErrCode(err) != codes.AlreadyExists {
+		for _, m := range moves {
+			failures = append(failures, Failure{ProductID: m.product.ID(), Err: fmt.Errorf("failed to commit chunk: %w", err)})
+		}
+		return 0, failures
+	}
+	var moved int64
+	for _, m := range moves {
+		if err := i.apply(ctx, m); err != nil {
+			if spanner.ErrCode(err) == codes.AlreadyExists {
+				if nameErr := i.nameTaken(ctx, m.product); nameErr != nil {
+					err = nameErr
+				}
+			}
+			failures = append(failures, Failure{ProductID: m.product.ID(), Err: fmt.Errorf("failed to move product: %w", err)})
+			continue
+		}
+		moved++
+	}
+	return moved, failures
+}
+
+// prepare moves one product and returns the mutations recording the move
+func (i *Interactor) prepare(ctx context.Context, productID, to string, now time.Time) (move, error) {
+	// 1. Load aggregate
+	product, err := i.repo.Load(ctx, productID)
+	if err != nil {
+		return move{}, fmt.Errorf("failed to load product: %w", err)
+	}
+
+	// 2. Call domain method
+	if err := product.UpdateDetails(product.Name(), product.Description(), to, now); err != nil {
+		return move{}, fmt.Errorf("failed to move product: %w", err)
+	}
+	product.EnforceUniqueName(i.namePolicy != nil && i.namePolicy.Enforced(product.TenantID()))
+	if violations := i.rules.Check(product); len(violations) > 0 {
+		return move{}, &domain.ValidationFailedError{Violations: violations}
+	}
+
+	// 3. Get update mutation; category changes are recorded as a new content version
+	var mutations []*spanner.Mutation
+	if productMut := i.repo.UpdateMut(product); productMut != nil {
+		mutations = append(mutations, productMut)
+	}
+	if product.ContentChanged() {
+		mutations = append(mutations, i.versions.InsertMut(product.ContentVersion(uuid.New().String(), now)))
+	}
+
+	// 4. Collect events → outbox
+	for _, event := range product.DomainEvents() {
+		outboxMut, err := i.eventToOutboxMutation(event)
+		if err != nil {
+			return move{}, fmt.Errorf("failed to create outbox event: %w", err)
+		}
+		mutations = append(mutations, outboxMut)
+	}
+	return move{product: product, mutations: mutations}, nil
+}
+
+// nameTaken reports the product already using the moved product's name in its new category
+func (i *Interactor) nameTaken(ctx context.Context, product *domain.Product) error {
+	nameKey := product.NameKey()
+	if nameKey == "" {
+		return nil
+	}
+	conflictID, err := i.names.FindByName(ctx, product.TenantID(), product.Category(), nameKey, product.ID())
+	if err != nil || conflictID == "" {
+		return nil
+	}
+	return &domain.ProductNameTakenError{ProductID: conflictID, Category: product.Category(), Name: product.Name()}
+}
+
+// apply commits the moves in one transaction
+func (i *Interactor) apply(ctx context.Context, moves ...move) error {
+	plan := commitplan.NewPlan()
+	for _, m := range moves {
+		for _, mut := range m.mutations {
+			plan.Add(mut)
+		}
+	}
+	return i.committer.Apply(ctx, plan)
+}
+
+// eventToOutboxMutation converts a domain event to an outbox mutation
+func (i *Interactor) eventToOutboxMutation(event domain.DomainEvent) (*spanner.Mutation, error) {
+	eventData, err := json.Marshal(event.EventData())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event data for event %s: %w", event.EventName(), err)
+	}
+
+	// Extract aggregate_id from event data (product_id)
+	aggregateID := ""
+	if data := event.EventData(); data != nil {
+		if pid, ok := data["product_id"].(string); ok {
+			aggregateID = pid
+		}
+	}
+
+	outboxEvent := &m_outbox.OutboxEvent{
+		EventID:     m_outbox.NewEventID(),
+		EventType:   event.EventName(),
+		AggregateID: aggregateID,
+		Payload:     string(eventData),
+		Status:      "pending",
+		ProcessedAt: nil,
+	}
+
+	return outboxEvent.InsertMut(), nil
+}
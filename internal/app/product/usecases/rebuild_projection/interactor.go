@@ -0,0 +1,141 @@
+package rebuild_projection
+
+import (
+	"context"
+	"fmt"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/app/product/domain/services"
+	"catalog-proj/internal/pkg/metrics"
+
+	"cloud.google.com/go/spanner"
+	"github.com/wuyiadepoju/commitplan"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// defaultBatchSize is the number of products read per batch when the request does not set one
+	defaultBatchSize = 500
+	// maxConflicts bounds the conflicting product IDs listed in the response
+	maxConflicts = 1000
+)
+
+// Request represents the input for rebuilding the product projection
+type Request struct {
+	// StartAfter resumes a previous run after this product ID
+	StartAfter string
+	// DryRun counts rows that would change without writing them
+	DryRun    bool
+	BatchSize int
+}
+
+// Progress receives per-product progress; lro.Progress satisfies it
+type Progress interface {
+	Item(ok bool)
+	Checkpoint(productID string)
+}
+
+// Response represents the rebuild report
+type Response struct {
+	Scanned int64
+	Updated int64
+	// Conflicts lists products whose name key is already held by another product
+	Conflicts     []string
+	LastProductID string
+}
+
+// Interactor handles the rebuild projection use case
+// The only derived product data is the unique name key, which drifts when a tenant's
+// unique name policy changes; rebuilds run across all tenants and are meant for operators
+type Interactor struct {
+	store      contracts.ProjectionStore
+	repo       contracts.ProductRepository
+	committer  commitplan.Committer
+	namePolicy *services.UniqueNamePolicy
+}
+
+// NewInteractor creates a new rebuild projection interactor
+func NewInteractor(
+	store contracts.ProjectionStore,
+	repo contracts.ProductRepository,
+	committer commitplan.Committer,
+	namePolicy *services.UniqueNamePolicy,
+) *Interactor {
+	return &Interactor{
+		store:      store,
+		repo:       repo,
+		committer:  committer,
+		namePolicy: namePolicy,
+	}
+}
+
+// Count returns the number of products a rebuild starting after startAfter will scan
+func (i *Interactor) Count(ctx context.Context, startAfter string) (int64, error) {
+	return i.store.CountProducts(ctx, startAfter)
+}
+
+// Execute recomputes every product's name key in ID order, rewriting rows that differ
+// Progress is checkpointed after each product, so an interrupted run resumes from the checkpoint
+func (i *Interactor) Execute(ctx context.Context, req *Request, progress Progress) (*Response, error) {
+	batchSize := req.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+
+	resp := &Response{LastProductID: req.StartAfter}
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		batch, err := i.store.ScanProducts(ctx, resp.LastProductID, batchSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, stored := range batch {
+			ok, err := i.rebuild(ctx, stored, req.DryRun, resp)
+			if err != nil {
+				return nil, err
+			}
+			resp.Scanned++
+			resp.LastProductID = stored.Product.ID()
+			progress.Item(ok)
+			progress.Checkpoint(resp.LastProductID)
+		}
+		if len(batch) < batchSize {
+			break
+		}
+	}
+
+	metrics.Labeled("projection_rows_rebuilt").Add("name_key", resp.Updated)
+	return resp, nil
+}
+
+// rebuild rewrites one product's name key if it differs from the stored one
+// It reports false when the name is held by another product, which leaves the key unset
+func (i *Interactor) rebuild(ctx context.Context, stored contracts.StoredProduct, dryRun bool, resp *Response) (bool, error) {
+	product := stored.Product
+	product.EnforceUniqueName(i.namePolicy != nil && i.namePolicy.Enforced(product.TenantID()))
+	if product.NameKey() == stored.NameKey {
+		return true, nil
+	}
+	if dryRun {
+		resp.Updated++
+		return true, nil
+	}
+
+	// Only the name key is dirty, so updated_at is left alone and no event is recorded
+	plan := commitplan.NewPlan()
+	plan.Add(i.repo.UpdateMut(product))
+	if err := i.committer.Apply(ctx, plan); err != nil {
+		if spanner.ErrCode(err) == codes.AlreadyExists {
+			if len(resp.Conflicts) < maxConflicts {
+				resp.Conflicts = append(resp.Conflicts, product.ID())
+			}
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to rebuild product %s: %w", product.ID(), err)
+	}
+	resp.Updated++
+	return true, nil
+}
@@ -0,0 +1,38 @@
+package record_product_view
+
+import (
+	"context"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/pkg/tenant"
+)
+
+// Request represents the input for recording a product view
+type Request struct {
+	ProductID string
+}
+
+// ViewBuffer coalesces view counts before they are written; *coalesce.Buffer satisfies it
+type ViewBuffer interface {
+	Add(key contracts.ViewKey, n int64)
+}
+
+// Interactor handles the record product view use case
+// Views are buffered in memory and written in batches, so recording one costs no Spanner write;
+// views buffered by a server that crashes are lost
+type Interactor struct {
+	buffer ViewBuffer
+}
+
+// NewInteractor creates a new record product view interactor
+func NewInteractor(buffer ViewBuffer) *Interactor {
+	return &Interactor{
+		buffer: buffer,
+	}
+}
+
+// Execute counts one view of the product
+// The product is not read; views of unknown products are discarded when the batch is written
+func (i *Interactor) Execute(ctx context.Context, req *Request) {
+	i.buffer.Add(contracts.ViewKey{TenantID: tenant.FromContext(ctx), ProductID: req.ProductID}, 1)
+}
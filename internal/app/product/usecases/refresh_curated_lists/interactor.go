@@ -0,0 +1,59 @@
+package refresh_curated_lists
+
+import (
+	"context"
+	"time"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/pkg/clock"
+	"catalog-proj/internal/pkg/metrics"
+)
+
+// Request represents the settings of a curated lists refresh
+type Request struct {
+	// Size is the number of products kept per tenant and list
+	Size int
+	// NewArrivalsWindow bounds how old a new arrival may be
+	NewArrivalsWindow time.Duration
+	// TrendingHalfLife is how long it takes a view's weight in the trending score to halve
+	TrendingHalfLife time.Duration
+}
+
+// Response reports a curated lists refresh
+type Response struct {
+	TrendsUpdated int
+	Entries       int
+}
+
+// Interactor handles the refresh curated lists use case
+// Refreshes run across all tenants and are meant for the curated lists job
+type Interactor struct {
+	store contracts.CuratedListStore
+	clock clock.Clock
+}
+
+// NewInteractor creates a new refresh curated lists interactor
+func NewInteractor(
+	store contracts.CuratedListStore,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		store: store,
+		clock: clock,
+	}
+}
+
+// Execute updates the trending scores, then rebuilds the new arrivals and trending lists
+func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, error) {
+	now := i.clock.Now()
+	trends, err := i.store.RefreshTrends(ctx, req.TrendingHalfLife, now)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := i.store.RefreshLists(ctx, req.Size, now.Add(-req.NewArrivalsWindow), req.TrendingHalfLife, now)
+	if err != nil {
+		return nil, err
+	}
+	metrics.Counter("curated_list_refreshes_total").Add(1)
+	return &Response{TrendsUpdated: trends, Entries: entries}, nil
+}
@@ -0,0 +1,65 @@
+package refresh_effective_prices
+
+import (
+	"context"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/pkg/clock"
+	"catalog-proj/internal/pkg/metrics"
+)
+
+// defaultBatchSize bounds the number of products repriced per transaction when the request does not set one
+const defaultBatchSize = 500
+
+// Request represents the input for refreshing effective prices
+type Request struct {
+	// BatchSize bounds the number of products repriced per transaction
+	BatchSize int
+}
+
+// Response reports an effective price refresh
+type Response struct {
+	// Repriced is the number of products whose stored effective price was brought up to date
+	Repriced int
+}
+
+// Interactor handles the refresh effective prices use case
+// Refreshes run across all tenants and are meant for the effective price refresh job
+type Interactor struct {
+	store contracts.EffectivePriceStore
+	clock clock.Clock
+}
+
+// NewInteractor creates a new refresh effective prices interactor
+func NewInteractor(
+	store contracts.EffectivePriceStore,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		store: store,
+		clock: clock,
+	}
+}
+
+// Execute reprices every product whose discount started or ended since its effective price was stored
+// Batches repeat until one comes back short, so a backlog after downtime clears in a single run
+func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, error) {
+	batchSize := req.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+
+	now := i.clock.Now()
+	resp := &Response{}
+	for {
+		repriced, err := i.store.RefreshEffectivePrices(ctx, now, batchSize)
+		if err != nil {
+			return nil, err
+		}
+		resp.Repriced += repriced
+		metrics.Counter("effective_prices_refreshed_total").Add(int64(repriced))
+		if repriced < batchSize {
+			return resp, nil
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package refresh_product_counts
+
+import (
+	"context"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/pkg/clock"
+	"catalog-proj/internal/pkg/metrics"
+)
+
+// Response reports a count refresh
+type Response struct {
+	// Groups is the number of tenant, category and status combinations counted
+	Groups int
+}
+
+// Interactor handles the refresh product counts use case
+// Refreshes run across all tenants and are meant for the count refresh job
+type Interactor struct {
+	store contracts.ProductCountStore
+	clock clock.Clock
+}
+
+// NewInteractor creates a new refresh product counts interactor
+func NewInteractor(
+	store contracts.ProductCountStore,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		store: store,
+		clock: clock,
+	}
+}
+
+// Execute recounts the products behind approximate list totals
+func (i *Interactor) Execute(ctx context.Context) (*Response, error) {
+	groups, err := i.store.RefreshCounts(ctx, i.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+	metrics.Counter("product_count_refreshes_total").Add(1)
+	return &Response{Groups: groups}, nil
+}
@@ -0,0 +1,43 @@
+package refresh_product_suggestions
+
+import (
+	"context"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/pkg/clock"
+	"catalog-proj/internal/pkg/metrics"
+)
+
+// Response reports a suggestions refresh
+type Response struct {
+	// Suggestions is the number of distinct names and categories stored
+	Suggestions int
+}
+
+// Interactor handles the refresh product suggestions use case
+// Refreshes run across all tenants and are meant for the suggestions refresh job
+type Interactor struct {
+	store contracts.SuggestionStore
+	clock clock.Clock
+}
+
+// NewInteractor creates a new refresh product suggestions interactor
+func NewInteractor(
+	store contracts.SuggestionStore,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		store: store,
+		clock: clock,
+	}
+}
+
+// Execute rebuilds the search-as-you-type suggestions
+func (i *Interactor) Execute(ctx context.Context) (*Response, error) {
+	suggestions, err := i.store.RefreshSuggestions(ctx, i.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+	metrics.Counter("product_suggestion_refreshes_total").Add(1)
+	return &Response{Suggestions: suggestions}, nil
+}
@@ -0,0 +1,112 @@
+package review_product
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/models/m_outbox"
+	"catalog-proj/internal/pkg/clock"
+
+	"github.com/wuyiadepoju/commitplan"
+
+	"cloud.google.com/go/spanner"
+)
+
+// Request represents a reviewer's decision on a product
+type Request struct {
+	ProductID string
+	Decision  domain.ReviewDecision
+	Reviewer  string
+	Comment   string
+}
+
+// Response represents the output of reviewing a product
+type Response struct {
+	ProductID string
+}
+
+// Interactor handles the review product use case
+type Interactor struct {
+	repo      contracts.ProductRepository
+	committer commitplan.Committer
+	clock     clock.Clock
+}
+
+// NewInteractor creates a new review product interactor
+func NewInteractor(
+	repo contracts.ProductRepository,
+	committer commitplan.Committer,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		repo:      repo,
+		committer: committer,
+		clock:     clock,
+	}
+}
+
+// Execute records a review decision following the Golden Mutation Pattern
+// Reviews change no product columns, so the plan holds only the audit events
+func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, error) {
+	// 1. Load aggregate
+	product, err := i.repo.Load(ctx, req.ProductID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load product: %w", err)
+	}
+
+	// 2. Call domain method
+	now := i.clock.Now()
+	if err := product.Review(req.Decision, req.Reviewer, req.Comment, now); err != nil {
+		return nil, fmt.Errorf("failed to review product: %w", err)
+	}
+
+	// 3. Collect events → outbox
+	plan := commitplan.NewPlan()
+	for _, event := range product.DomainEvents() {
+		outboxMut, err := i.eventToOutboxMutation(event)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create outbox event: %w", err)
+		}
+		plan.Add(outboxMut)
+	}
+
+	// 4. Apply plan
+	if err := i.committer.Apply(ctx, plan); err != nil {
+		return nil, fmt.Errorf("failed to record review: %w", err)
+	}
+
+	// 5. Return product ID
+	return &Response{
+		ProductID: req.ProductID,
+	}, nil
+}
+
+// eventToOutboxMutation converts a domain event to an outbox mutation
+func (i *Interactor) eventToOutboxMutation(event domain.DomainEvent) (*spanner.Mutation, error) {
+	eventData, err := json.Marshal(event.EventData())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event data for event %s: %w", event.EventName(), err)
+	}
+
+	// Extract aggregate_id from event data (product_id)
+	aggregateID := ""
+	if data := event.EventData(); data != nil {
+		if pid, ok := data["product_id"].(string); ok {
+			aggregateID = pid
+		}
+	}
+
+	outboxEvent := &m_outbox.OutboxEvent{
+		EventID:     m_outbox.NewEventID(),
+		EventType:   event.EventName(),
+		AggregateID: aggregateID,
+		Payload:     string(eventData),
+		Status:      "pending",
+		ProcessedAt: nil,
+	}
+
+	return outboxEvent.InsertMut(), nil
+}
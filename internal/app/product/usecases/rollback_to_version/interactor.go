@@ -0,0 +1,175 @@
+package rollback_to_version
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/domain/services"
+	"catalog-proj/internal/models/m_outbox"
+	"catalog-proj/internal/pkg/clock"
+
+	"cloud.google.com/go/spanner"
+	"github.com/google/uuid"
+	"github.com/wuyiadepoju/commitplan"
+	"google.golang.org/grpc/codes"
+)
+
+// Request represents the input for restoring a product's content from one of its versions
+type Request struct {
+	ProductID string
+	VersionID string
+}
+
+// Response represents the output of a rollback
+type Response struct {
+	ProductID string
+	// VersionID is the new version holding the restored content, empty when the product already had it
+	VersionID string
+}
+
+// Interactor handles the rollback to version use case
+// A rollback never rewrites history: the restored content is recorded as a new version
+type Interactor struct {
+	repo       contracts.ProductRepository
+	versions   contracts.VersionStore
+	committer  commitplan.Committer
+	clock      clock.Clock
+	namePolicy *services.UniqueNamePolicy
+	names      contracts.NameLookup
+	rules      *services.ValidationRules
+}
+
+// NewInteractor creates a new rollback to version interactor
+func NewInteractor(
+	repo contracts.ProductRepository,
+	versions contracts.VersionStore,
+	committer commitplan.Committer,
+	clock clock.Clock,
+	namePolicy *services.UniqueNamePolicy,
+	names contracts.NameLookup,
+	rules *services.ValidationRules,
+) *Interactor {
+	return &Interactor{
+		repo:       repo,
+		versions:   versions,
+		committer:  committer,
+		clock:      clock,
+		namePolicy: namePolicy,
+		names:      names,
+		rules:      rules,
+	}
+}
+
+// Execute restores the version's name, description, category and metadata following the Golden Mutation Pattern
+// The restored content passes the same category rules and name uniqueness checks as an update
+func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, error) {
+	// 1. Load aggregate and the version to restore
+	product, err := i.repo.Load(ctx, req.ProductID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load product: %w", err)
+	}
+	version, err := i.versions.Load(ctx, req.ProductID, req.VersionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load version: %w", err)
+	}
+
+	// 2. Call domain method
+	now := i.clock.Now()
+	if err := product.RestoreContent(version, now); err != nil {
+		return nil, fmt.Errorf("failed to restore content: %w", err)
+	}
+	if !product.ContentChanged() {
+		return &Response{ProductID: req.ProductID}, nil
+	}
+	product.EnforceUniqueName(i.namePolicy != nil && i.namePolicy.Enforced(product.TenantID()))
+
+	// Category rules may have changed since the version was written
+	if violations := i.rules.Check(product); len(violations) > 0 {
+		return nil, &domain.ValidationFailedError{Violations: violations}
+	}
+	if product.Changes().Dirty(domain.FieldName) || product.Changes().Dirty(domain.FieldCategory) {
+		if err := i.checkUniqueName(ctx, product); err != nil {
+			return nil, err
+		}
+	}
+
+	// 3. Get update mutation and the new version
+	plan := commitplan.NewPlan()
+	if productMut := i.repo.UpdateMut(product); productMut != nil {
+		plan.Add(productMut)
+	}
+	restored := product.ContentVersion(uuid.New().String(), now)
+	plan.Add(i.versions.InsertMut(restored))
+
+	// 4. Collect domain events → outbox mutations
+	for _, event := range product.DomainEvents() {
+		outboxMut, err := i.eventToOutboxMutation(event)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create outbox event: %w", err)
+		}
+		plan.Add(outboxMut)
+	}
+
+	// 5. Apply plan
+	if err := i.committer.Apply(ctx, plan); err != nil {
+		// A concurrent write took the name between the check and the commit
+		if spanner.ErrCode(err) == codes.AlreadyExists && product.NameKey() != "" {
+			if nameErr := i.checkUniqueName(ctx, product); nameErr != nil {
+				return nil, nameErr
+			}
+		}
+		return nil, fmt.Errorf("failed to roll back product: %w", err)
+	}
+
+	// 6. Return the new version
+	return &Response{
+		ProductID: req.ProductID,
+		VersionID: restored.ID,
+	}, nil
+}
+
+// checkUniqueName reports the product already using the name in the category, if uniqueness is enforced
+func (i *Interactor) checkUniqueName(ctx context.Context, product *domain.Product) error {
+	nameKey := product.NameKey()
+	if nameKey == "" {
+		return nil
+	}
+	conflictID, err := i.names.FindByName(ctx, product.TenantID(), product.Category(), nameKey, product.ID())
+	if err != nil {
+		return fmt.Errorf("failed to check product name: %w", err)
+	}
+	if conflictID != "" {
+		return &domain.ProductNameTakenError{ProductID: conflictID, Category: product.Category(), Name: product.Name()}
+	}
+	return nil
+}
+
+// eventToOutboxMutation converts a domain event to an outbox mutation
+func (i *Interactor) eventToOutboxMutation(event domain.DomainEvent) (*spanner.Mutation, error) {
+	eventData, err := json.Marshal(event.EventData())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event data for event %s: %w", event.EventName(), err)
+	}
+
+	// Extract aggregate_id from event data (product_id)
+	aggregateID := ""
+	if data := event.EventData(); data != nil {
+		if pid, ok := data["product_id"].(string); ok {
+			aggregateID = pid
+		}
+	}
+
+	outboxEvent := &m_outbox.OutboxEvent{
+		EventID:     m_outbox.NewEventID(),
+		EventType:   event.EventName(),
+		AggregateID: aggregateID,
+		Payload:     string(eventData),
+		Status:      "pending",
+		ProcessedAt: nil,
+	}
+
+	return outboxEvent.InsertMut(), nil
+}
@@ -0,0 +1,88 @@
+package save_draft
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/pkg/clock"
+
+	"github.com/wuyiadepoju/commitplan"
+)
+
+// Request represents the input for staging content in a product's draft
+// Unset fields keep the draft's current value, or the published value when the product has no draft
+type Request struct {
+	ProductID   string
+	Name        *string
+	Description *string
+	Category    *string
+	Metadata    *domain.Metadata
+}
+
+// Response represents the output of saving a draft
+type Response struct {
+	ProductID string
+}
+
+// Interactor handles the save draft use case
+// The product itself is not changed and no events are emitted until the draft is published
+type Interactor struct {
+	repo      contracts.ProductRepository
+	drafts    contracts.DraftStore
+	committer commitplan.Committer
+	clock     clock.Clock
+}
+
+// NewInteractor creates a new save draft interactor
+func NewInteractor(
+	repo contracts.ProductRepository,
+	drafts contracts.DraftStore,
+	committer commitplan.Committer,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		repo:      repo,
+		drafts:    drafts,
+		committer: committer,
+		clock:     clock,
+	}
+}
+
+// Execute stages the edit on top of the product's draft
+func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, error) {
+	// 1. Load aggregate and its current draft
+	product, err := i.repo.Load(ctx, req.ProductID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load product: %w", err)
+	}
+	draft, err := i.drafts.Load(ctx, req.ProductID)
+	if err != nil && !errors.Is(err, domain.ErrDraftNotFound) {
+		return nil, fmt.Errorf("failed to load draft: %w", err)
+	}
+
+	// 2. Call domain method
+	staged, err := product.StageDraft(draft, domain.DraftEdit{
+		Name:        req.Name,
+		Description: req.Description,
+		Category:    req.Category,
+		Metadata:    req.Metadata,
+	}, i.clock.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to stage draft: %w", err)
+	}
+
+	// 3. Apply plan
+	plan := commitplan.NewPlan()
+	plan.Add(i.drafts.SaveMut(staged))
+	if err := i.committer.Apply(ctx, plan); err != nil {
+		return nil, fmt.Errorf("failed to save draft: %w", err)
+	}
+
+	// 4. Return product ID
+	return &Response{
+		ProductID: req.ProductID,
+	}, nil
+}
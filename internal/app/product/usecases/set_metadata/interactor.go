@@ -0,0 +1,129 @@
+package set_metadata
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/models/m_outbox"
+	"catalog-proj/internal/pkg/clock"
+	"cloud.google.com/go/spanner"
+	"github.com/google/uuid"
+	"github.com/wuyiadepoju/commitplan"
+)
+
+// Request represents the input for replacing a product's integrator metadata
+type Request struct {
+	ProductID string
+	Metadata  domain.Metadata
+}
+
+// Response represents the output of setting metadata
+type Response struct {
+	ProductID string
+	// Product is the aggregate as committed; its timestamps come from the server clock, not the commit
+	Product *domain.Product
+}
+
+// Interactor handles the set metadata use case
+type Interactor struct {
+	repo      contracts.ProductRepository
+	versions  contracts.VersionStore
+	committer commitplan.Committer
+	clock     clock.Clock
+}
+
+// NewInteractor creates a new set metadata interactor
+func NewInteractor(
+	repo contracts.ProductRepository,
+	versions contracts.VersionStore,
+	committer commitplan.Committer,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		repo:      repo,
+		versions:  versions,
+		committer: committer,
+		clock:     clock,
+	}
+}
+
+// Execute replaces a product's metadata following the Golden Mutation Pattern
+func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, error) {
+	// 1. Load aggregate
+	product, err := i.repo.Load(ctx, req.ProductID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load product: %w", err)
+	}
+
+	// 2. Call domain method
+	now := i.clock.Now()
+	if err := product.SetMetadata(req.Metadata, now); err != nil {
+		return nil, fmt.Errorf("failed to set metadata: %w", err)
+	}
+
+	// 3. Get update mutation
+	plan := commitplan.NewPlan()
+	productMut := i.repo.UpdateMut(product)
+	if productMut != nil {
+		plan.Add(productMut)
+	}
+	// Metadata changes are recorded as a new content version
+	if product.ContentChanged() {
+		plan.Add(i.versions.InsertMut(product.ContentVersion(uuid.New().String(), now)))
+	}
+
+	// 4. Collect events → outbox
+	events := product.DomainEvents()
+	for _, event := range events {
+		outboxMut, err := i.eventToOutboxMutation(event)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create outbox event: %w", err)
+		}
+		if outboxMut != nil {
+			plan.Add(outboxMut)
+		}
+	}
+
+	// 5. Apply plan
+	if len(plan.Mutations()) > 0 {
+		if err := i.committer.Apply(ctx, plan); err != nil {
+			return nil, fmt.Errorf("failed to set metadata: %w", err)
+		}
+	}
+
+	// 6. Return product ID
+	return &Response{
+		ProductID: req.ProductID,
+		Product:   product,
+	}, nil
+}
+
+// eventToOutboxMutation converts a domain event to an outbox mutation
+func (i *Interactor) eventToOutboxMutation(event domain.DomainEvent) (*spanner.Mutation, error) {
+	eventData, err := json.Marshal(event.EventData())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event data for event %s: %w", event.EventName(), err)
+	}
+
+	// Extract aggregate_id from event data (product_id)
+	aggregateID := ""
+	if data := event.EventData(); data != nil {
+		if pid, ok := data["product_id"].(string); ok {
+			aggregateID = pid
+		}
+	}
+
+	outboxEvent := &m_outbox.OutboxEvent{
+		EventID:     m_outbox.NewEventID(),
+		EventType:   event.EventName(),
+		AggregateID: aggregateID,
+		Payload:     string(eventData),
+		Status:      "pending",
+		ProcessedAt: nil,
+	}
+
+	return outboxEvent.InsertMut(), nil
+}
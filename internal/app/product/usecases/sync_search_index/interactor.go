@@ -0,0 +1,144 @@
+package sync_search_index
+
+import (
+	"context"
+	"fmt"
+
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/queries/get_product"
+	"catalog-proj/internal/pkg/clock"
+	"catalog-proj/internal/pkg/metrics"
+
+	"github.com/wuyiadepoju/commitplan"
+)
+
+const (
+	// Consumer keys the indexer's rows in processed_events; deleting them reindexes every product with an outbox event
+	Consumer = "search_indexer"
+	// defaultBatchSize is the number of outbox events applied per batch when the request does not set one
+	defaultBatchSize = 500
+)
+
+// Request represents the input for syncing the search index
+type Request struct {
+	BatchSize int
+}
+
+// Response reports a sync run
+type Response struct {
+	Events  int
+	Indexed int
+	Removed int
+}
+
+// ProductReader reads the current state of changed products
+type ProductReader interface {
+	BatchGetProducts(ctx context.Context, ids []string) ([]*get_product.DTO, error)
+}
+
+// Interactor handles the sync search index use case
+// Events only say which products changed; each batch re-reads those products and indexes their
+// current state, so events may be applied out of order or more than once without harm
+type Interactor struct {
+	feed      contracts.OutboxFeed
+	products  ProductReader
+	index     contracts.SearchIndex
+	committer commitplan.Committer
+	clock     clock.Clock
+}
+
+// NewInteractor creates a new sync search index interactor
+func NewInteractor(
+	feed contracts.OutboxFeed,
+	products ProductReader,
+	index contracts.SearchIndex,
+	committer commitplan.Committer,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		feed:      feed,
+		products:  products,
+		index:     index,
+		committer: committer,
+		clock:     clock,
+	}
+}
+
+// Execute applies unprocessed outbox events to the index until none are left
+// Active products are indexed; inactive, archived and deleted products are removed
+func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, error) {
+	batchSize := req.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+
+	resp := &Response{}
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		// 1. Read the next batch of events
+		events, err := i.feed.Unprocessed(ctx, Consumer, batchSize)
+		if err != nil {
+			return nil, err
+		}
+		if len(events) == 0 {
+			break
+		}
+
+		// 2. Read the changed products
+		seen := make(map[string]bool, len(events))
+		var ids []string
+		for _, event := range events {
+			if !seen[event.AggregateID] {
+				seen[event.AggregateID] = true
+				ids = append(ids, event.AggregateID)
+			}
+		}
+		dtos, err := i.products.BatchGetProducts(ctx, ids)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read changed products: %w", err)
+		}
+		current := make(map[string]*get_product.DTO, len(dtos))
+		for _, dto := range dtos {
+			current[dto.ID] = dto
+		}
+
+		// 3. Index or remove each product
+		for _, id := range ids {
+			dto, ok := current[id]
+			if ok && dto.Status == string(domain.ProductStatusActive) && dto.ArchivedAt == nil {
+				if err := i.index.Upsert(ctx, dto); err != nil {
+					return nil, err
+				}
+				resp.Indexed++
+				continue
+			}
+			if err := i.index.Delete(ctx, id); err != nil {
+				return nil, err
+			}
+			resp.Removed++
+		}
+
+		// 4. Record the events as processed
+		now := i.clock.Now()
+		plan := commitplan.NewPlan()
+		for _, event := range events {
+			plan.Add(i.feed.MarkProcessedMut(Consumer, event.EventID, now))
+		}
+		plan.Add(i.feed.AdvanceMut(Consumer, events[len(events)-1], now))
+		if err := i.committer.Apply(ctx, plan); err != nil {
+			return nil, fmt.Errorf("failed to record indexed events: %w", err)
+		}
+		resp.Events += len(events)
+
+		if len(events) < batchSize {
+			break
+		}
+	}
+
+	metrics.Counter("search_index_events_total").Add(int64(resp.Events))
+	return resp, nil
+}
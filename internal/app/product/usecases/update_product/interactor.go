@@ -4,15 +4,17 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"time"
 
 	"catalog-proj/internal/app/product/contracts"
 	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/domain/services"
+	"catalog-proj/internal/app/product/tenant_config"
 	"catalog-proj/internal/models/m_outbox"
 	"catalog-proj/internal/pkg/clock"
 	"github.com/wuyiadepoju/commitplan"
 	"cloud.google.com/go/spanner"
 	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
 )
 
 // Request represents the input for updating a product
@@ -21,30 +23,60 @@ type Request struct {
 	Name        *string
 	Description *string
 	Category    *string
+	// DescriptionFormat is applied together with Description, so both can change in one update
+	DescriptionFormat *domain.DescriptionFormat
+	// Shipping fields replace only what is set; ShippingClass "" clears the class
+	Weight        *domain.Weight
+	Dimensions    *domain.Dimensions
+	ShippingClass *string
+	// Changing the type drops the shipping or delivery fields the new type does not take;
+	// DownloadURL and LicenseTerms "" clear them
+	ProductType  *domain.ProductType
+	DownloadURL  *string
+	LicenseTerms *string
+	// Compliance replaces all compliance metadata when set
+	Compliance *domain.Compliance
 }
 
 // Response represents the output of updating a product
 type Response struct {
 	ProductID string
+	// Product is the aggregate as committed; its timestamps come from the server clock, not the commit
+	Product *domain.Product
 }
 
 // Interactor handles the update product use case
 type Interactor struct {
-	repo      contracts.ProductRepository
-	committer commitplan.Committer
-	clock     clock.Clock
+	repo         contracts.ProductRepository
+	versions     contracts.VersionStore
+	committer    commitplan.Committer
+	clock        clock.Clock
+	namePolicy   *services.UniqueNamePolicy
+	names        contracts.NameLookup
+	rules        *services.ValidationRules
+	tenantConfig *tenant_config.TenantConfig
 }
 
 // NewInteractor creates a new update product interactor
 func NewInteractor(
 	repo contracts.ProductRepository,
+	versions contracts.VersionStore,
 	committer commitplan.Committer,
 	clock clock.Clock,
+	namePolicy *services.UniqueNamePolicy,
+	names contracts.NameLookup,
+	rules *services.ValidationRules,
+	tenantConfig *tenant_config.TenantConfig,
 ) *Interactor {
 	return &Interactor{
-		repo:      repo,
-		committer: committer,
-		clock:     clock,
+		repo:         repo,
+		versions:     versions,
+		committer:    committer,
+		clock:        clock,
+		namePolicy:   namePolicy,
+		names:        names,
+		rules:        rules,
+		tenantConfig: tenantConfig,
 	}
 }
 
@@ -72,9 +104,75 @@ func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, erro
 		category = *req.Category
 	}
 
+	// The description is checked against the format it will be stored with: switching to plain
+	// happens before the new text is set, switching to markdown after
+	toPlain := req.DescriptionFormat != nil && *req.DescriptionFormat == domain.DescriptionFormatPlain
+	if toPlain {
+		if err := product.SetDescriptionFormat(*req.DescriptionFormat, now); err != nil {
+			return nil, fmt.Errorf("failed to set description format: %w", err)
+		}
+	}
 	if err := product.UpdateDetails(name, description, category, now); err != nil {
 		return nil, fmt.Errorf("failed to update product details: %w", err)
 	}
+	if req.DescriptionFormat != nil && !toPlain {
+		if err := product.SetDescriptionFormat(*req.DescriptionFormat, now); err != nil {
+			return nil, fmt.Errorf("failed to set description format: %w", err)
+		}
+	}
+	if req.Weight != nil || req.Dimensions != nil || req.ShippingClass != nil ||
+		req.ProductType != nil || req.DownloadURL != nil || req.LicenseTerms != nil {
+		productType, shipping, digital := product.Type(), product.Shipping(), product.DigitalDelivery()
+		if req.ProductType != nil && *req.ProductType != productType {
+			productType = *req.ProductType
+			if productType != domain.ProductTypePhysical {
+				shipping = domain.ShippingDetails{}
+			}
+			if productType != domain.ProductTypeDigital {
+				digital = domain.DigitalDelivery{}
+			}
+		}
+		if req.Weight != nil {
+			shipping.Weight = req.Weight
+		}
+		if req.Dimensions != nil {
+			shipping.Dimensions = req.Dimensions
+		}
+		if req.ShippingClass != nil {
+			shipping.ShippingClass = *req.ShippingClass
+		}
+		if req.DownloadURL != nil {
+			digital.DownloadURL = *req.DownloadURL
+		}
+		if req.LicenseTerms != nil {
+			digital.LicenseTerms = *req.LicenseTerms
+		}
+		if err := product.UpdateFulfilment(productType, shipping, digital, now); err != nil {
+			return nil, fmt.Errorf("failed to update fulfilment details: %w", err)
+		}
+	}
+	if req.Compliance != nil {
+		if err := product.SetCompliance(*req.Compliance, now); err != nil {
+			return nil, fmt.Errorf("failed to update compliance: %w", err)
+		}
+	}
+	product.EnforceUniqueName(i.namePolicy != nil && i.namePolicy.Enforced(product.TenantID()))
+
+	// Category rules and the tenant's limits are checked on the resulting product so every violation is reported at once
+	settings, err := i.tenantConfig.Settings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if violations := append(i.rules.Check(product), settings.Check(product)...); len(violations) > 0 {
+		return nil, &domain.ValidationFailedError{Violations: violations}
+	}
+
+	// Renames and category moves must not collide with another product's name
+	if product.Changes().Dirty(domain.FieldName) || product.Changes().Dirty(domain.FieldCategory) {
+		if err := i.checkUniqueName(ctx, product); err != nil {
+			return nil, err
+		}
+	}
 
 	// 3. Get update mutation (may be nil if no changes)
 	plan := commitplan.NewPlan()
@@ -82,11 +180,15 @@ func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, erro
 	if productMut != nil {
 		plan.Add(productMut)
 	}
+	// Name, description and category changes are recorded as a new content version
+	if product.ContentChanged() {
+		plan.Add(i.versions.InsertMut(product.ContentVersion(uuid.New().String(), now)))
+	}
 
 	// 4. Collect domain events → outbox mutations
 	events := product.DomainEvents()
 	for _, event := range events {
-		outboxMut, err := i.eventToOutboxMutation(event, now)
+		outboxMut, err := i.eventToOutboxMutation(event)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create outbox event: %w", err)
 		}
@@ -98,6 +200,12 @@ func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, erro
 	// 5. Apply plan
 	if len(plan.Mutations()) > 0 {
 		if err := i.committer.Apply(ctx, plan); err != nil {
+			// A concurrent write took the name between the check and the commit
+			if spanner.ErrCode(err) == codes.AlreadyExists && product.NameKey() != "" {
+				if nameErr := i.checkUniqueName(ctx, product); nameErr != nil {
+					return nil, nameErr
+				}
+			}
 			return nil, fmt.Errorf("failed to update product: %w", err)
 		}
 	}
@@ -105,11 +213,28 @@ func (i *Interactor) Execute(ctx context.Context, req *Request) (*Response, erro
 	// 6. Return product ID
 	return &Response{
 		ProductID: req.ProductID,
+		Product:   product,
 	}, nil
 }
 
+// checkUniqueName reports the product already using the name in the category, if uniqueness is enforced
+func (i *Interactor) checkUniqueName(ctx context.Context, product *domain.Product) error {
+	nameKey := product.NameKey()
+	if nameKey == "" {
+		return nil
+	}
+	conflictID, err := i.names.FindByName(ctx, product.TenantID(), product.Category(), nameKey, product.ID())
+	if err != nil {
+		return fmt.Errorf("failed to check product name: %w", err)
+	}
+	if conflictID != "" {
+		return &domain.ProductNameTakenError{ProductID: conflictID, Category: product.Category(), Name: product.Name()}
+	}
+	return nil
+}
+
 // eventToOutboxMutation converts a domain event to an outbox mutation
-func (i *Interactor) eventToOutboxMutation(event domain.DomainEvent, now time.Time) (*spanner.Mutation, error) {
+func (i *Interactor) eventToOutboxMutation(event domain.DomainEvent) (*spanner.Mutation, error) {
 	eventData, err := json.Marshal(event.EventData())
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal event data for event %s: %w", event.EventName(), err)
@@ -124,12 +249,11 @@ func (i *Interactor) eventToOutboxMutation(event domain.DomainEvent, now time.Ti
 	}
 
 	outboxEvent := &m_outbox.OutboxEvent{
-		EventID:     uuid.New().String(),
+		EventID:     m_outbox.NewEventID(),
 		EventType:   event.EventName(),
 		AggregateID: aggregateID,
 		Payload:     string(eventData),
 		Status:      "pending",
-		CreatedAt:   now,
 		ProcessedAt: nil,
 	}
 
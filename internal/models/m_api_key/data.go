@@ -0,0 +1,35 @@
+package m_api_key
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// APIKey represents the database model for machine client API keys
+type APIKey struct {
+	KeyID     string     `spanner:"key_id"`
+	TenantID  string     `spanner:"tenant_id"`
+	Name      string     `spanner:"name"`
+	KeyHash   string     `spanner:"key_hash"` // Hex SHA-256 of the secret; the secret itself is never stored
+	Scopes    []string   `spanner:"scopes"`
+	CreatedAt time.Time  `spanner:"created_at"`
+	RevokedAt *time.Time `spanner:"revoked_at"` // NULL while the key is valid
+}
+
+// InsertMut creates a Spanner insert mutation for an API key
+func (k *APIKey) InsertMut() *spanner.Mutation {
+	return spanner.Insert(
+		TableName,
+		AllColumns(),
+		[]interface{}{k.KeyID, k.TenantID, k.Name, k.KeyHash, k.Scopes, k.CreatedAt, k.RevokedAt},
+	)
+}
+
+// TableName is the Spanner table name for API keys
+const TableName = "api_keys"
+
+// AllColumns returns all column names in table order
+func AllColumns() []string {
+	return []string{KeyID, TenantID, Name, KeyHash, Scopes, CreatedAt, RevokedAt}
+}
@@ -0,0 +1,12 @@
+package m_api_key
+
+// Field name constants for the api_keys table
+const (
+	KeyID     = "key_id"
+	TenantID  = "tenant_id"
+	Name      = "name"
+	KeyHash   = "key_hash"
+	Scopes    = "scopes"
+	CreatedAt = "created_at"
+	RevokedAt = "revoked_at"
+)
@@ -0,0 +1,35 @@
+package m_api_usage
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// Usage represents the database model for a caller's usage on one UTC day
+type Usage struct {
+	TenantID      string    `spanner:"tenant_id"`
+	Day           time.Time `spanner:"day"`    // UTC midnight
+	KeyID         string    `spanner:"key_id"` // Empty for requests made without an API key
+	RequestCount  int64     `spanner:"request_count"`
+	RowsReturned  int64     `spanner:"rows_returned"`
+	MutationCount int64     `spanner:"mutation_count"`
+	UpdatedAt     time.Time `spanner:"updated_at"`
+}
+
+// UpsertMut creates a Spanner insert-or-update mutation for a usage row
+func (u *Usage) UpsertMut() *spanner.Mutation {
+	return spanner.InsertOrUpdate(
+		TableName,
+		AllColumns(),
+		[]interface{}{u.TenantID, u.Day, u.KeyID, u.RequestCount, u.RowsReturned, u.MutationCount, u.UpdatedAt},
+	)
+}
+
+// TableName is the Spanner table name for usage rows
+const TableName = "api_usage"
+
+// AllColumns returns all column names in table order
+func AllColumns() []string {
+	return []string{TenantID, Day, KeyID, RequestCount, RowsReturned, MutationCount, UpdatedAt}
+}
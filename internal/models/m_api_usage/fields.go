@@ -0,0 +1,12 @@
+package m_api_usage
+
+// Field name constants for the api_usage table
+const (
+	TenantID      = "tenant_id"
+	Day           = "day"
+	KeyID         = "key_id"
+	RequestCount  = "request_count"
+	RowsReturned  = "rows_returned"
+	MutationCount = "mutation_count"
+	UpdatedAt     = "updated_at"
+)
@@ -0,0 +1,37 @@
+package m_category_template
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// CategoryTemplate represents the database model for a category's attribute template
+type CategoryTemplate struct {
+	TenantID   string    `spanner:"tenant_id"`
+	Category   string    `spanner:"category"`
+	Attributes string    `spanner:"attributes"` // JSON array of attribute definitions
+	UpdatedAt  time.Time `spanner:"updated_at"`
+}
+
+// UpsertMut creates a Spanner insert-or-update mutation for a category template
+func (t *CategoryTemplate) UpsertMut() *spanner.Mutation {
+	return spanner.InsertOrUpdate(
+		TableName,
+		AllColumns(),
+		[]interface{}{t.TenantID, t.Category, t.Attributes, t.UpdatedAt},
+	)
+}
+
+// DeleteMut creates a Spanner delete mutation for a category template
+func (t *CategoryTemplate) DeleteMut() *spanner.Mutation {
+	return spanner.Delete(TableName, spanner.Key{t.TenantID, t.Category})
+}
+
+// TableName is the Spanner table name for category templates
+const TableName = "category_templates"
+
+// AllColumns returns all column names in table order
+func AllColumns() []string {
+	return []string{TenantID, Category, Attributes, UpdatedAt}
+}
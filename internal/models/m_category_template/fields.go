@@ -0,0 +1,9 @@
+package m_category_template
+
+// Field name constants for the category_templates table
+const (
+	TenantID   = "tenant_id"
+	Category   = "category"
+	Attributes = "attributes"
+	UpdatedAt  = "updated_at"
+)
@@ -0,0 +1,34 @@
+package m_curated_list
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// CuratedListEntry represents the database model for one product of a curated list
+type CuratedListEntry struct {
+	TenantID   string    `spanner:"tenant_id"`
+	List       string    `spanner:"list"`
+	Position   int64     `spanner:"position"`
+	ProductID  string    `spanner:"product_id"`
+	Score      float64   `spanner:"score"`
+	ComputedAt time.Time `spanner:"computed_at"`
+}
+
+// InsertMut creates a Spanner insert mutation for a curated list entry
+func (e *CuratedListEntry) InsertMut() *spanner.Mutation {
+	return spanner.Insert(
+		TableName,
+		AllColumns(),
+		[]interface{}{e.TenantID, e.List, e.Position, e.ProductID, e.Score, e.ComputedAt},
+	)
+}
+
+// TableName is the Spanner table name for curated list entries
+const TableName = "curated_lists"
+
+// AllColumns returns all column names in table order
+func AllColumns() []string {
+	return []string{TenantID, List, Position, ProductID, Score, ComputedAt}
+}
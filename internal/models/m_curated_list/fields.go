@@ -0,0 +1,11 @@
+package m_curated_list
+
+// Field name constants for the curated_lists table
+const (
+	TenantID   = "tenant_id"
+	List       = "list"
+	Position   = "position"
+	ProductID  = "product_id"
+	Score      = "score"
+	ComputedAt = "computed_at"
+)
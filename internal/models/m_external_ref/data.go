@@ -0,0 +1,39 @@
+package m_external_ref
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// ExternalRef represents the database model for a product's identifier in an external system
+type ExternalRef struct {
+	TenantID   string    `spanner:"tenant_id"`
+	System     string    `spanner:"system"`
+	ExternalID string    `spanner:"external_id"`
+	ProductID  string    `spanner:"product_id"`
+	CreatedAt  time.Time `spanner:"created_at"`
+}
+
+// InsertMut creates a Spanner insert mutation for an external reference
+// The insert fails if a concurrent link claimed the reference first
+func (r *ExternalRef) InsertMut() *spanner.Mutation {
+	return spanner.Insert(
+		TableName,
+		AllColumns(),
+		[]interface{}{r.TenantID, r.System, r.ExternalID, r.ProductID, r.CreatedAt},
+	)
+}
+
+// DeleteMut creates a Spanner delete mutation for an external reference
+func (r *ExternalRef) DeleteMut() *spanner.Mutation {
+	return spanner.Delete(TableName, spanner.Key{r.TenantID, r.System, r.ExternalID})
+}
+
+// TableName is the Spanner table name for external references
+const TableName = "external_refs"
+
+// AllColumns returns all column names in table order
+func AllColumns() []string {
+	return []string{TenantID, System, ExternalID, ProductID, CreatedAt}
+}
@@ -0,0 +1,10 @@
+package m_external_ref
+
+// Field name constants for the external_refs table
+const (
+	TenantID   = "tenant_id"
+	System     = "system"
+	ExternalID = "external_id"
+	ProductID  = "product_id"
+	CreatedAt  = "created_at"
+)
@@ -0,0 +1,48 @@
+package m_job
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// Job represents the database model for queued jobs
+type Job struct {
+	JobID          string     `spanner:"job_id"`
+	Kind           string     `spanner:"kind"`
+	TenantID       string     `spanner:"tenant_id"`
+	Payload        []byte     `spanner:"payload"`
+	Status         string     `spanner:"status"`
+	Attempts       int64      `spanner:"attempts"`
+	MaxAttempts    int64      `spanner:"max_attempts"`
+	RunAt          time.Time  `spanner:"run_at"`
+	UniqueKey      *string    `spanner:"unique_key"`
+	LeaseOwner     *string    `spanner:"lease_owner"`
+	LeaseExpiresAt *time.Time `spanner:"lease_expires_at"`
+	LastError      *string    `spanner:"last_error"`
+	CreatedAt      time.Time  `spanner:"created_at"`
+	UpdatedAt      time.Time  `spanner:"updated_at"`
+}
+
+// InsertMut creates a Spanner insert mutation for a job
+func (j *Job) InsertMut() *spanner.Mutation {
+	return spanner.Insert(
+		TableName,
+		AllColumns(),
+		[]interface{}{
+			j.JobID, j.Kind, j.TenantID, j.Payload, j.Status, j.Attempts, j.MaxAttempts, j.RunAt,
+			j.UniqueKey, j.LeaseOwner, j.LeaseExpiresAt, j.LastError, j.CreatedAt, j.UpdatedAt,
+		},
+	)
+}
+
+// TableName is the Spanner table name for jobs
+const TableName = "jobs"
+
+// AllColumns returns all column names in table order
+func AllColumns() []string {
+	return []string{
+		JobID, Kind, TenantID, Payload, Status, Attempts, MaxAttempts, RunAt,
+		UniqueKey, LeaseOwner, LeaseExpiresAt, LastError, CreatedAt, UpdatedAt,
+	}
+}
@@ -0,0 +1,27 @@
+package m_job
+
+// Field name constants for the jobs table
+const (
+	JobID          = "job_id"
+	Kind           = "kind"
+	TenantID       = "tenant_id"
+	Payload        = "payload"
+	Status         = "status"
+	Attempts       = "attempts"
+	MaxAttempts    = "max_attempts"
+	RunAt          = "run_at"
+	UniqueKey      = "unique_key"
+	LeaseOwner     = "lease_owner"
+	LeaseExpiresAt = "lease_expires_at"
+	LastError      = "last_error"
+	CreatedAt      = "created_at"
+	UpdatedAt      = "updated_at"
+)
+
+// Job status values
+const (
+	StatusPending   = "pending"
+	StatusRunning   = "running"
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+)
@@ -0,0 +1,42 @@
+package m_merch_rule
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// MerchRule represents the database model for a search merchandising rule
+type MerchRule struct {
+	TenantID  string    `spanner:"tenant_id"`
+	RuleID    string    `spanner:"rule_id"`
+	Query     string    `spanner:"query"` // "" for boosts that apply to every search
+	Kind      string    `spanner:"kind"`
+	ProductID *string   `spanner:"product_id"` // Pins only
+	Position  int64     `spanner:"position"`   // 0 for boosts
+	Category  *string   `spanner:"category"`   // Boosts only
+	Boost     float64   `spanner:"boost"`      // 0 for pins
+	CreatedAt time.Time `spanner:"created_at"`
+}
+
+// InsertMut creates a Spanner insert mutation for a merchandising rule
+func (r *MerchRule) InsertMut() *spanner.Mutation {
+	return spanner.Insert(
+		TableName,
+		AllColumns(),
+		[]interface{}{r.TenantID, r.RuleID, r.Query, r.Kind, r.ProductID, r.Position, r.Category, r.Boost, r.CreatedAt},
+	)
+}
+
+// DeleteMut creates a Spanner delete mutation for a merchandising rule
+func (r *MerchRule) DeleteMut() *spanner.Mutation {
+	return spanner.Delete(TableName, spanner.Key{r.TenantID, r.RuleID})
+}
+
+// TableName is the Spanner table name for merchandising rules
+const TableName = "merch_rules"
+
+// AllColumns returns all column names in table order
+func AllColumns() []string {
+	return []string{TenantID, RuleID, Query, Kind, ProductID, Position, Category, Boost, CreatedAt}
+}
@@ -0,0 +1,14 @@
+package m_merch_rule
+
+// Field name constants for the merch_rules table
+const (
+	TenantID  = "tenant_id"
+	RuleID    = "rule_id"
+	Query     = "query"
+	Kind      = "kind"
+	ProductID = "product_id"
+	Position  = "position"
+	Category  = "category"
+	Boost     = "boost"
+	CreatedAt = "created_at"
+)
@@ -0,0 +1,48 @@
+package m_operation
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// Operation represents the database model for long-running operations
+type Operation struct {
+	OperationID     string    `spanner:"operation_id"`
+	TenantID        string    `spanner:"tenant_id"`
+	Kind            string    `spanner:"kind"`
+	Done            bool      `spanner:"done"`
+	TotalItems      int64     `spanner:"total_items"`
+	ProcessedItems  int64     `spanner:"processed_items"`
+	FailedItems     int64     `spanner:"failed_items"`
+	CancelRequested bool      `spanner:"cancel_requested"`
+	ErrorCode       *int64    `spanner:"error_code"`
+	ErrorMessage    *string   `spanner:"error_message"`
+	Result          []byte    `spanner:"result"` // Serialized google.protobuf.Any
+	CreatedAt       time.Time `spanner:"created_at"`
+	UpdatedAt       time.Time `spanner:"updated_at"`
+	Checkpoint      *string   `spanner:"checkpoint"` // Kind-specific resume point, NULL until the task reports one
+}
+
+// InsertMut creates a Spanner insert mutation for an operation
+func (o *Operation) InsertMut() *spanner.Mutation {
+	return spanner.Insert(
+		TableName,
+		AllColumns(),
+		[]interface{}{
+			o.OperationID, o.TenantID, o.Kind, o.Done, o.TotalItems, o.ProcessedItems, o.FailedItems,
+			o.CancelRequested, o.ErrorCode, o.ErrorMessage, o.Result, o.CreatedAt, o.UpdatedAt, o.Checkpoint,
+		},
+	)
+}
+
+// TableName is the Spanner table name for operations
+const TableName = "operations"
+
+// AllColumns returns all column names in table order
+func AllColumns() []string {
+	return []string{
+		OperationID, TenantID, Kind, Done, TotalItems, ProcessedItems, FailedItems,
+		CancelRequested, ErrorCode, ErrorMessage, Result, CreatedAt, UpdatedAt, Checkpoint,
+	}
+}
@@ -0,0 +1,19 @@
+package m_operation
+
+// Field name constants for the operations table
+const (
+	OperationID     = "operation_id"
+	TenantID        = "tenant_id"
+	Kind            = "kind"
+	Done            = "done"
+	TotalItems      = "total_items"
+	ProcessedItems  = "processed_items"
+	FailedItems     = "failed_items"
+	CancelRequested = "cancel_requested"
+	ErrorCode       = "error_code"
+	ErrorMessage    = "error_message"
+	Result          = "result"
+	CreatedAt       = "created_at"
+	UpdatedAt       = "updated_at"
+	Checkpoint      = "checkpoint"
+)
@@ -0,0 +1,101 @@
+// Code generated by modelgen from ../../../migrations. DO NOT EDIT.
+
+package m_outbox
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// TableName is the Spanner table name for outbox events by aggregate
+const TableName = "outbox_events_by_aggregate"
+
+// Field name constants for the outbox events by aggregate table
+const (
+	AggregateID = "aggregate_id"
+	CreatedAt   = "created_at"
+	EventID     = "event_id"
+	EventType   = "event_type"
+	Payload     = "payload"
+	Status      = "status"
+	ProcessedAt = "processed_at"
+)
+
+// OutboxEvent represents the database model for outbox events by aggregate
+type OutboxEvent struct {
+	AggregateID string     `spanner:"aggregate_id"`
+	CreatedAt   time.Time  `spanner:"created_at"`
+	EventID     string     `spanner:"event_id"`
+	EventType   string     `spanner:"event_type"`
+	Payload     string     `spanner:"payload"`
+	Status      string     `spanner:"status"`
+	ProcessedAt *time.Time `spanner:"processed_at"`
+}
+
+// AllColumns returns all column names in table order
+func AllColumns() []string {
+	return []string{
+		AggregateID,
+		CreatedAt,
+		EventID,
+		EventType,
+		Payload,
+		Status,
+		ProcessedAt,
+	}
+}
+
+// InsertMut creates a Spanner insert mutation for an outbox event
+// CreatedAt is written as the commit timestamp and only set on reads
+func (o *OutboxEvent) InsertMut() *spanner.Mutation {
+	return spanner.Insert(TableName, AllColumns(), []interface{}{
+		o.AggregateID,
+		spanner.CommitTimestamp,
+		o.EventID,
+		o.EventType,
+		o.Payload,
+		o.Status,
+		o.ProcessedAt,
+	})
+}
+
+// UpdateMut creates a Spanner update mutation for an outbox event
+// Nil optional fields are written as explicit NULLs
+// Note: columns must include the primary key (AggregateID, CreatedAt, EventID)
+func (o *OutboxEvent) UpdateMut(columns []string) *spanner.Mutation {
+	values := make([]interface{}, 0, len(columns))
+	for _, col := range columns {
+		switch col {
+		case AggregateID:
+			values = append(values, o.AggregateID)
+		case CreatedAt:
+			values = append(values, o.CreatedAt)
+		case EventID:
+			values = append(values, o.EventID)
+		case EventType:
+			values = append(values, o.EventType)
+		case Payload:
+			values = append(values, o.Payload)
+		case Status:
+			values = append(values, o.Status)
+		case ProcessedAt:
+			values = append(values, nullTime(o.ProcessedAt))
+		}
+	}
+
+	return spanner.Update(TableName, columns, values)
+}
+
+// DeleteMut creates a Spanner delete mutation for an outbox event
+func (o *OutboxEvent) DeleteMut() *spanner.Mutation {
+	return spanner.Delete(TableName, spanner.Key{o.AggregateID, o.CreatedAt, o.EventID})
+}
+
+// nullTime converts an optional timestamp to a Spanner value, NULL when nil
+func nullTime(t *time.Time) spanner.NullTime {
+	if t == nil {
+		return spanner.NullTime{}
+	}
+	return spanner.NullTime{Time: *t, Valid: true}
+}
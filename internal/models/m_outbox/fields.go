@@ -1,12 +1,25 @@
 package m_outbox
 
-// Field name constants for the outbox table
-const (
-	EventID     = "event_id"
-	EventType   = "event_type"
-	AggregateID = "aggregate_id"
-	Payload     = "payload"
-	Status      = "status"
-	CreatedAt   = "created_at"
-	ProcessedAt = "processed_at"
-)
+import "github.com/google/uuid"
+
+// Shard is the generated column that buckets the commit-order index; it is not part of OutboxEvent
+const Shard = "shard"
+
+// Shards is the number of shard values, 0 to Shards-1 (see migrations/031_outbox_by_aggregate.sql)
+const Shards = 16
+
+// ShardValues lists every shard, for queries that read the commit-order index across all of them
+func ShardValues() []int64 {
+	shards := make([]int64, Shards)
+	for i := range shards {
+		shards[i] = int64(i)
+	}
+	return shards
+}
+
+// NewEventID returns a time-ordered (version 7) UUID for a new outbox event
+// Events written in one commit share created_at, so history and feeds order them by event_id;
+// IDs from this process increase monotonically, which keeps those events in emission order
+func NewEventID() string {
+	return uuid.Must(uuid.NewV7()).String()
+}
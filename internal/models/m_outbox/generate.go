@@ -0,0 +1,5 @@
+package m_outbox
+
+// The table name, field constants, OutboxEvent, AllColumns and the mutation builders are generated
+// from the migrations into data_gen.go
+//go:generate go run ../../../cmd/modelgen -table outbox_events_by_aggregate -type OutboxEvent
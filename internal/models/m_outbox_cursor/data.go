@@ -0,0 +1,33 @@
+package m_outbox_cursor
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// OutboxCursor represents the database model for a consumer's position in the outbox
+// CreatedAt and EventID are the last event the consumer processed, in commit order
+type OutboxCursor struct {
+	Consumer  string    `spanner:"consumer"`
+	CreatedAt time.Time `spanner:"created_at"`
+	EventID   string    `spanner:"event_id"`
+	UpdatedAt time.Time `spanner:"updated_at"`
+}
+
+// UpsertMut creates a Spanner insert-or-update mutation for an outbox cursor
+func (c *OutboxCursor) UpsertMut() *spanner.Mutation {
+	return spanner.InsertOrUpdate(
+		TableName,
+		AllColumns(),
+		[]interface{}{c.Consumer, c.CreatedAt, c.EventID, c.UpdatedAt},
+	)
+}
+
+// TableName is the Spanner table name for outbox cursors
+const TableName = "outbox_cursors"
+
+// AllColumns returns all column names in table order
+func AllColumns() []string {
+	return []string{Consumer, CreatedAt, EventID, UpdatedAt}
+}
@@ -0,0 +1,9 @@
+package m_outbox_cursor
+
+// Field name constants for the outbox_cursors table
+const (
+	Consumer  = "consumer"
+	CreatedAt = "created_at"
+	EventID   = "event_id"
+	UpdatedAt = "updated_at"
+)
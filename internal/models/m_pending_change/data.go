@@ -0,0 +1,59 @@
+package m_pending_change
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// PendingChange represents the database model for a change awaiting a second approver
+type PendingChange struct {
+	ChangeID            string     `spanner:"change_id"`
+	TenantID            string     `spanner:"tenant_id"`
+	ProductID           string     `spanner:"product_id"`
+	Kind                string     `spanner:"kind"`
+	OldPriceNumerator   int64      `spanner:"old_price_numerator"`
+	OldPriceDenominator int64      `spanner:"old_price_denominator"`
+	NewPriceNumerator   int64      `spanner:"new_price_numerator"`
+	NewPriceDenominator int64      `spanner:"new_price_denominator"`
+	RequestedBy         string     `spanner:"requested_by"`
+	RequestedAt         time.Time  `spanner:"requested_at"`
+	Status              string     `spanner:"status"`
+	DecidedBy           *string    `spanner:"decided_by"`
+	DecisionNote        *string    `spanner:"decision_note"`
+	DecidedAt           *time.Time `spanner:"decided_at"`
+}
+
+// InsertMut creates a Spanner insert mutation for a new pending change
+func (c *PendingChange) InsertMut() *spanner.Mutation {
+	return spanner.Insert(
+		TableName,
+		AllColumns(),
+		[]interface{}{
+			c.ChangeID, c.TenantID, c.ProductID, c.Kind,
+			c.OldPriceNumerator, c.OldPriceDenominator, c.NewPriceNumerator, c.NewPriceDenominator,
+			c.RequestedBy, c.RequestedAt, c.Status, c.DecidedBy, c.DecisionNote, c.DecidedAt,
+		},
+	)
+}
+
+// DecisionMut creates a Spanner update mutation recording the approver's decision
+func (c *PendingChange) DecisionMut() *spanner.Mutation {
+	return spanner.Update(
+		TableName,
+		[]string{ChangeID, Status, DecidedBy, DecisionNote, DecidedAt},
+		[]interface{}{c.ChangeID, c.Status, c.DecidedBy, c.DecisionNote, c.DecidedAt},
+	)
+}
+
+// TableName is the Spanner table name for pending changes
+const TableName = "pending_changes"
+
+// AllColumns returns all column names in table order
+func AllColumns() []string {
+	return []string{
+		ChangeID, TenantID, ProductID, Kind,
+		OldPriceNumerator, OldPriceDenominator, NewPriceNumerator, NewPriceDenominator,
+		RequestedBy, RequestedAt, Status, DecidedBy, DecisionNote, DecidedAt,
+	}
+}
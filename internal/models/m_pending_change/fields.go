@@ -0,0 +1,19 @@
+package m_pending_change
+
+// Field name constants for the pending_changes table
+const (
+	ChangeID            = "change_id"
+	TenantID            = "tenant_id"
+	ProductID           = "product_id"
+	Kind                = "kind"
+	OldPriceNumerator   = "old_price_numerator"
+	OldPriceDenominator = "old_price_denominator"
+	NewPriceNumerator   = "new_price_numerator"
+	NewPriceDenominator = "new_price_denominator"
+	RequestedBy         = "requested_by"
+	RequestedAt         = "requested_at"
+	Status              = "status"
+	DecidedBy           = "decided_by"
+	DecisionNote        = "decision_note"
+	DecidedAt           = "decided_at"
+)
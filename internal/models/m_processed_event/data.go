@@ -0,0 +1,40 @@
+package m_processed_event
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// ProcessedEvent represents the database model for an event handled by a consumer
+type ProcessedEvent struct {
+	Consumer    string    `spanner:"consumer"`
+	EventID     string    `spanner:"event_id"`
+	ProcessedAt time.Time `spanner:"processed_at"`
+}
+
+// InsertMut creates a Spanner insert mutation for a processed event
+func (p *ProcessedEvent) InsertMut() *spanner.Mutation {
+	return spanner.Insert(
+		TableName,
+		AllColumns(),
+		[]interface{}{p.Consumer, p.EventID, p.ProcessedAt},
+	)
+}
+
+// UpsertMut creates a Spanner insert-or-update mutation for a processed event
+func (p *ProcessedEvent) UpsertMut() *spanner.Mutation {
+	return spanner.InsertOrUpdate(
+		TableName,
+		AllColumns(),
+		[]interface{}{p.Consumer, p.EventID, p.ProcessedAt},
+	)
+}
+
+// TableName is the Spanner table name for processed events
+const TableName = "processed_events"
+
+// AllColumns returns all column names in table order
+func AllColumns() []string {
+	return []string{Consumer, EventID, ProcessedAt}
+}
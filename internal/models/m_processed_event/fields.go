@@ -0,0 +1,8 @@
+package m_processed_event
+
+// Field name constants for the processed_events table
+const (
+	Consumer    = "consumer"
+	EventID     = "event_id"
+	ProcessedAt = "processed_at"
+)
@@ -1,103 +1,62 @@
 package m_product
 
-import (
-	"math/big"
-	"time"
-
-	"cloud.google.com/go/spanner"
-)
+// The table name, field constants, Product, AllColumns and the mutation builders are generated
+// from the migrations into data_gen.go; this file holds the hand-written column groups
+//go:generate go run ../../../cmd/modelgen -table products -type Product -scanner
+
+// ClearDiscount drops the discount so every discount column is written as NULL
+func (p *Product) ClearDiscount() {
+	p.DiscountID = nil
+	p.DiscountAmount = nil
+	p.DiscountStartDate = nil
+	p.DiscountEndDate = nil
+}
 
-// Product represents the database model for products
-type Product struct {
-	ProductID            string     `spanner:"product_id"`
-	Name                 string     `spanner:"name"`
-	Description          string     `spanner:"description"`
-	Category             string     `spanner:"category"`
-	BasePriceNumerator   int64      `spanner:"base_price_numerator"`
-	BasePriceDenominator int64      `spanner:"base_price_denominator"`
-	DiscountID           *string    `spanner:"discount_id"`
-	DiscountAmount       *big.Rat   `spanner:"discount_amount"` // Stored as NUMERIC in Spanner
-	DiscountStartDate    *time.Time `spanner:"discount_start_date"`
-	DiscountEndDate      *time.Time `spanner:"discount_end_date"`
-	Status               string     `spanner:"status"`
-	ArchivedAt           *time.Time `spanner:"archived_at"`
-	CreatedAt            time.Time  `spanner:"created_at"`
-	UpdatedAt            time.Time  `spanner:"updated_at"`
+// DiscountColumns returns the columns holding a product's discount, which change together
+func DiscountColumns() []string {
+	return []string{DiscountID, DiscountAmount, DiscountStartDate, DiscountEndDate}
 }
 
-// InsertMut creates a Spanner insert mutation for a product
-func (p *Product) InsertMut() *spanner.Mutation {
-	return spanner.Insert(
-		TableName,
-		[]string{
-			ProductID, Name, Description, Category, BasePriceNumerator, BasePriceDenominator,
-			DiscountID, DiscountAmount, DiscountStartDate, DiscountEndDate,
-			Status, ArchivedAt, CreatedAt, UpdatedAt,
-		},
-		[]interface{}{
-			p.ProductID, p.Name, p.Description, p.Category, p.BasePriceNumerator, p.BasePriceDenominator,
-			p.DiscountID, p.DiscountAmount, p.DiscountStartDate, p.DiscountEndDate,
-			p.Status, p.ArchivedAt, p.CreatedAt, p.UpdatedAt,
-		},
-	)
+// EffectivePriceColumns returns the columns holding a product's persisted effective price, which change together
+func EffectivePriceColumns() []string {
+	return []string{EffectivePrice, EffectivePriceValidUntil}
 }
 
-// UpdateMut creates a Spanner update mutation for a product
-// Only updates fields that are provided (non-nil for optional fields)
-// Note: columns must include ProductID as the first column (primary key)
-func (p *Product) UpdateMut(columns []string) *spanner.Mutation {
-	values := make([]interface{}, 0, len(columns))
-	for _, col := range columns {
-		switch col {
-		case ProductID:
-			values = append(values, p.ProductID)
-		case Name:
-			values = append(values, p.Name)
-		case Description:
-			values = append(values, p.Description)
-		case Category:
-			values = append(values, p.Category)
-		case BasePriceNumerator:
-			values = append(values, p.BasePriceNumerator)
-		case BasePriceDenominator:
-			values = append(values, p.BasePriceDenominator)
-		case DiscountID:
-			values = append(values, p.DiscountID)
-		case DiscountAmount:
-			values = append(values, p.DiscountAmount)
-		case DiscountStartDate:
-			values = append(values, p.DiscountStartDate)
-		case DiscountEndDate:
-			values = append(values, p.DiscountEndDate)
-		case Status:
-			values = append(values, p.Status)
-		case ArchivedAt:
-			values = append(values, p.ArchivedAt)
-		case UpdatedAt:
-			values = append(values, p.UpdatedAt)
-		}
-	}
+// PriceFloorColumns returns the columns holding a product's price floor, which change together
+func PriceFloorColumns() []string {
+	return []string{FloorPrice, CostPrice, MinMarginPercent, MapPrice}
+}
 
-	return spanner.Update(
-		TableName,
-		columns,
-		values,
-	)
+// WeightColumns returns the columns holding a product's weight, which change together
+func WeightColumns() []string {
+	return []string{WeightValue, WeightUnit}
 }
 
-// DeleteMut creates a Spanner delete mutation for a product
-func (p *Product) DeleteMut() *spanner.Mutation {
-	return spanner.Delete(TableName, spanner.Key{p.ProductID})
+// DimensionColumns returns the columns holding a product's dimensions, which change together
+func DimensionColumns() []string {
+	return []string{Length, Width, Height, DimensionUnit}
 }
 
-// TableName is the Spanner table name for products
-const TableName = "products"
+// FullColumns returns the columns a full product view reads: every column but the persisted
+// effective price, which queries work out at read time instead
+func FullColumns() []string {
+	all := AllColumns()
+	columns := make([]string, 0, len(all))
+	for _, column := range all {
+		if column != EffectivePrice && column != EffectivePriceValidUntil {
+			columns = append(columns, column)
+		}
+	}
+	return columns
+}
 
-// AllColumns returns all column names for the products table
-func AllColumns() []string {
+// BasicColumns returns the columns a storefront grid needs: identity, status, channels and
+// everything the effective price depends on
+func BasicColumns() []string {
 	return []string{
-		ProductID, Name, Description, Category, BasePriceNumerator, BasePriceDenominator,
+		ProductID, TenantID, Name, Category, SKU, BasePriceNumerator, BasePriceDenominator,
 		DiscountID, DiscountAmount, DiscountStartDate, DiscountEndDate,
-		Status, ArchivedAt, CreatedAt, UpdatedAt,
+		Status, Channels, ProductType, MapPrice,
+		CreatedAt, UpdatedAt,
 	}
 }
@@ -0,0 +1,385 @@
+// Code generated by modelgen from ../../../migrations. DO NOT EDIT.
+
+package m_product
+
+import (
+	"math/big"
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// TableName is the Spanner table name for products
+const TableName = "products"
+
+// Field name constants for the products table
+const (
+	ProductID                = "product_id"
+	Name                     = "name"
+	Description              = "description"
+	Category                 = "category"
+	BasePriceNumerator       = "base_price_numerator"
+	BasePriceDenominator     = "base_price_denominator"
+	DiscountID               = "discount_id"
+	DiscountAmount           = "discount_amount"
+	DiscountStartDate        = "discount_start_date"
+	DiscountEndDate          = "discount_end_date"
+	Status                   = "status"
+	ArchivedAt               = "archived_at"
+	CreatedAt                = "created_at"
+	UpdatedAt                = "updated_at"
+	TenantID                 = "tenant_id"
+	SKU                      = "sku"
+	GTIN                     = "gtin"
+	LegalHold                = "legal_hold"
+	NameKey                  = "name_key"
+	Channels                 = "channels"
+	WeightValue              = "weight_value"
+	WeightUnit               = "weight_unit"
+	Length                   = "length"
+	Width                    = "width"
+	Height                   = "height"
+	DimensionUnit            = "dimension_unit"
+	ShippingClass            = "shipping_class"
+	ProductType              = "product_type"
+	DownloadURL              = "download_url"
+	LicenseTerms             = "license_terms"
+	AgeRestriction           = "age_restriction"
+	Hazardous                = "hazardous"
+	RequiresPrescription     = "requires_prescription"
+	Metadata                 = "metadata"
+	FloorPrice               = "floor_price"
+	CostPrice                = "cost_price"
+	MinMarginPercent         = "min_margin_percent"
+	MapPrice                 = "map_price"
+	DescriptionFormat        = "description_format"
+	Attributes               = "attributes"
+	EffectivePrice           = "effective_price"
+	EffectivePriceValidUntil = "effective_price_valid_until"
+)
+
+// Product represents the database model for products
+type Product struct {
+	ProductID                string     `spanner:"product_id"`
+	Name                     string     `spanner:"name"`
+	Description              string     `spanner:"description"`
+	Category                 string     `spanner:"category"`
+	BasePriceNumerator       int64      `spanner:"base_price_numerator"`
+	BasePriceDenominator     int64      `spanner:"base_price_denominator"`
+	DiscountID               *string    `spanner:"discount_id"`
+	DiscountAmount           *big.Rat   `spanner:"discount_amount"`
+	DiscountStartDate        *time.Time `spanner:"discount_start_date"`
+	DiscountEndDate          *time.Time `spanner:"discount_end_date"`
+	Status                   string     `spanner:"status"`
+	ArchivedAt               *time.Time `spanner:"archived_at"`
+	CreatedAt                time.Time  `spanner:"created_at"`
+	UpdatedAt                time.Time  `spanner:"updated_at"`
+	TenantID                 string     `spanner:"tenant_id"`
+	SKU                      *string    `spanner:"sku"`
+	GTIN                     *string    `spanner:"gtin"`
+	LegalHold                bool       `spanner:"legal_hold"`
+	NameKey                  *string    `spanner:"name_key"`
+	Channels                 []string   `spanner:"channels"`
+	WeightValue              *float64   `spanner:"weight_value"`
+	WeightUnit               *string    `spanner:"weight_unit"`
+	Length                   *float64   `spanner:"length"`
+	Width                    *float64   `spanner:"width"`
+	Height                   *float64   `spanner:"height"`
+	DimensionUnit            *string    `spanner:"dimension_unit"`
+	ShippingClass            *string    `spanner:"shipping_class"`
+	ProductType              *string    `spanner:"product_type"`
+	DownloadURL              *string    `spanner:"download_url"`
+	LicenseTerms             *string    `spanner:"license_terms"`
+	AgeRestriction           int64      `spanner:"age_restriction"`
+	Hazardous                bool       `spanner:"hazardous"`
+	RequiresPrescription     bool       `spanner:"requires_prescription"`
+	Metadata                 []string   `spanner:"metadata"`
+	FloorPrice               *big.Rat   `spanner:"floor_price"`
+	CostPrice                *big.Rat   `spanner:"cost_price"`
+	MinMarginPercent         int64      `spanner:"min_margin_percent"`
+	MapPrice                 *big.Rat   `spanner:"map_price"`
+	DescriptionFormat        *string    `spanner:"description_format"`
+	Attributes               []string   `spanner:"attributes"`
+	EffectivePrice           *big.Rat   `spanner:"effective_price"`
+	EffectivePriceValidUntil *time.Time `spanner:"effective_price_valid_until"`
+}
+
+// AllColumns returns all column names in table order
+func AllColumns() []string {
+	return []string{
+		ProductID,
+		Name,
+		Description,
+		Category,
+		BasePriceNumerator,
+		BasePriceDenominator,
+		DiscountID,
+		DiscountAmount,
+		DiscountStartDate,
+		DiscountEndDate,
+		Status,
+		ArchivedAt,
+		CreatedAt,
+		UpdatedAt,
+		TenantID,
+		SKU,
+		GTIN,
+		LegalHold,
+		NameKey,
+		Channels,
+		WeightValue,
+		WeightUnit,
+		Length,
+		Width,
+		Height,
+		DimensionUnit,
+		ShippingClass,
+		ProductType,
+		DownloadURL,
+		LicenseTerms,
+		AgeRestriction,
+		Hazardous,
+		RequiresPrescription,
+		Metadata,
+		FloorPrice,
+		CostPrice,
+		MinMarginPercent,
+		MapPrice,
+		DescriptionFormat,
+		Attributes,
+		EffectivePrice,
+		EffectivePriceValidUntil,
+	}
+}
+
+// InsertMut creates a Spanner insert mutation for a product
+// CreatedAt, UpdatedAt are written as the commit timestamp and only set on reads
+func (p *Product) InsertMut() *spanner.Mutation {
+	return spanner.Insert(TableName, AllColumns(), []interface{}{
+		p.ProductID,
+		p.Name,
+		p.Description,
+		p.Category,
+		p.BasePriceNumerator,
+		p.BasePriceDenominator,
+		p.DiscountID,
+		p.DiscountAmount,
+		p.DiscountStartDate,
+		p.DiscountEndDate,
+		p.Status,
+		p.ArchivedAt,
+		spanner.CommitTimestamp,
+		spanner.CommitTimestamp,
+		p.TenantID,
+		p.SKU,
+		p.GTIN,
+		p.LegalHold,
+		p.NameKey,
+		p.Channels,
+		p.WeightValue,
+		p.WeightUnit,
+		p.Length,
+		p.Width,
+		p.Height,
+		p.DimensionUnit,
+		p.ShippingClass,
+		p.ProductType,
+		p.DownloadURL,
+		p.LicenseTerms,
+		p.AgeRestriction,
+		p.Hazardous,
+		p.RequiresPrescription,
+		p.Metadata,
+		p.FloorPrice,
+		p.CostPrice,
+		p.MinMarginPercent,
+		p.MapPrice,
+		p.DescriptionFormat,
+		p.Attributes,
+		p.EffectivePrice,
+		p.EffectivePriceValidUntil,
+	})
+}
+
+// UpdateMut creates a Spanner update mutation for a product
+// Nil optional fields are written as explicit NULLs
+// CreatedAt, UpdatedAt are written as the commit timestamp
+// Note: columns must include the primary key (ProductID)
+func (p *Product) UpdateMut(columns []string) *spanner.Mutation {
+	values := make([]interface{}, 0, len(columns))
+	for _, col := range columns {
+		switch col {
+		case ProductID:
+			values = append(values, p.ProductID)
+		case Name:
+			values = append(values, p.Name)
+		case Description:
+			values = append(values, p.Description)
+		case Category:
+			values = append(values, p.Category)
+		case BasePriceNumerator:
+			values = append(values, p.BasePriceNumerator)
+		case BasePriceDenominator:
+			values = append(values, p.BasePriceDenominator)
+		case DiscountID:
+			values = append(values, nullString(p.DiscountID))
+		case DiscountAmount:
+			values = append(values, nullNumeric(p.DiscountAmount))
+		case DiscountStartDate:
+			values = append(values, nullTime(p.DiscountStartDate))
+		case DiscountEndDate:
+			values = append(values, nullTime(p.DiscountEndDate))
+		case Status:
+			values = append(values, p.Status)
+		case ArchivedAt:
+			values = append(values, nullTime(p.ArchivedAt))
+		case CreatedAt:
+			values = append(values, spanner.CommitTimestamp)
+		case UpdatedAt:
+			values = append(values, spanner.CommitTimestamp)
+		case TenantID:
+			values = append(values, p.TenantID)
+		case SKU:
+			values = append(values, nullString(p.SKU))
+		case GTIN:
+			values = append(values, nullString(p.GTIN))
+		case LegalHold:
+			values = append(values, p.LegalHold)
+		case NameKey:
+			values = append(values, nullString(p.NameKey))
+		case Channels:
+			values = append(values, p.Channels)
+		case WeightValue:
+			values = append(values, nullFloat(p.WeightValue))
+		case WeightUnit:
+			values = append(values, nullString(p.WeightUnit))
+		case Length:
+			values = append(values, nullFloat(p.Length))
+		case Width:
+			values = append(values, nullFloat(p.Width))
+		case Height:
+			values = append(values, nullFloat(p.Height))
+		case DimensionUnit:
+			values = append(values, nullString(p.DimensionUnit))
+		case ShippingClass:
+			values = append(values, nullString(p.ShippingClass))
+		case ProductType:
+			values = append(values, nullString(p.ProductType))
+		case DownloadURL:
+			values = append(values, nullString(p.DownloadURL))
+		case LicenseTerms:
+			values = append(values, nullString(p.LicenseTerms))
+		case AgeRestriction:
+			values = append(values, p.AgeRestriction)
+		case Hazardous:
+			values = append(values, p.Hazardous)
+		case RequiresPrescription:
+			values = append(values, p.RequiresPrescription)
+		case Metadata:
+			values = append(values, p.Metadata)
+		case FloorPrice:
+			values = append(values, nullNumeric(p.FloorPrice))
+		case CostPrice:
+			values = append(values, nullNumeric(p.CostPrice))
+		case MinMarginPercent:
+			values = append(values, p.MinMarginPercent)
+		case MapPrice:
+			values = append(values, nullNumeric(p.MapPrice))
+		case DescriptionFormat:
+			values = append(values, nullString(p.DescriptionFormat))
+		case Attributes:
+			values = append(values, p.Attributes)
+		case EffectivePrice:
+			values = append(values, nullNumeric(p.EffectivePrice))
+		case EffectivePriceValidUntil:
+			values = append(values, nullTime(p.EffectivePriceValidUntil))
+		}
+	}
+
+	return spanner.Update(TableName, columns, values)
+}
+
+// DeleteMut creates a Spanner delete mutation for a product
+func (p *Product) DeleteMut() *spanner.Mutation {
+	return spanner.Delete(TableName, spanner.Key{p.ProductID})
+}
+
+// fieldPointers returns a decode destination for every column, keyed by column name
+func (p *Product) fieldPointers() map[string]interface{} {
+	return map[string]interface{}{
+		ProductID:                &p.ProductID,
+		Name:                     &p.Name,
+		Description:              &p.Description,
+		Category:                 &p.Category,
+		BasePriceNumerator:       &p.BasePriceNumerator,
+		BasePriceDenominator:     &p.BasePriceDenominator,
+		DiscountID:               &p.DiscountID,
+		DiscountAmount:           &p.DiscountAmount,
+		DiscountStartDate:        &p.DiscountStartDate,
+		DiscountEndDate:          &p.DiscountEndDate,
+		Status:                   &p.Status,
+		ArchivedAt:               &p.ArchivedAt,
+		CreatedAt:                &p.CreatedAt,
+		UpdatedAt:                &p.UpdatedAt,
+		TenantID:                 &p.TenantID,
+		SKU:                      &p.SKU,
+		GTIN:                     &p.GTIN,
+		LegalHold:                &p.LegalHold,
+		NameKey:                  &p.NameKey,
+		Channels:                 &p.Channels,
+		WeightValue:              &p.WeightValue,
+		WeightUnit:               &p.WeightUnit,
+		Length:                   &p.Length,
+		Width:                    &p.Width,
+		Height:                   &p.Height,
+		DimensionUnit:            &p.DimensionUnit,
+		ShippingClass:            &p.ShippingClass,
+		ProductType:              &p.ProductType,
+		DownloadURL:              &p.DownloadURL,
+		LicenseTerms:             &p.LicenseTerms,
+		AgeRestriction:           &p.AgeRestriction,
+		Hazardous:                &p.Hazardous,
+		RequiresPrescription:     &p.RequiresPrescription,
+		Metadata:                 &p.Metadata,
+		FloorPrice:               &p.FloorPrice,
+		CostPrice:                &p.CostPrice,
+		MinMarginPercent:         &p.MinMarginPercent,
+		MapPrice:                 &p.MapPrice,
+		DescriptionFormat:        &p.DescriptionFormat,
+		Attributes:               &p.Attributes,
+		EffectivePrice:           &p.EffectivePrice,
+		EffectivePriceValidUntil: &p.EffectivePriceValidUntil,
+	}
+}
+
+// nullString converts an optional string to a Spanner value, NULL when nil
+func nullString(s *string) spanner.NullString {
+	if s == nil {
+		return spanner.NullString{}
+	}
+	return spanner.NullString{StringVal: *s, Valid: true}
+}
+
+// nullFloat converts an optional FLOAT64 to a Spanner value, NULL when nil
+func nullFloat(f *float64) spanner.NullFloat64 {
+	if f == nil {
+		return spanner.NullFloat64{}
+	}
+	return spanner.NullFloat64{Float64: *f, Valid: true}
+}
+
+// nullNumeric converts an optional NUMERIC to a Spanner value, NULL when nil
+func nullNumeric(r *big.Rat) spanner.NullNumeric {
+	if r == nil {
+		return spanner.NullNumeric{}
+	}
+	return spanner.NullNumeric{Numeric: *r, Valid: true}
+}
+
+// nullTime converts an optional timestamp to a Spanner value, NULL when nil
+func nullTime(t *time.Time) spanner.NullTime {
+	if t == nil {
+		return spanner.NullTime{}
+	}
+	return spanner.NullTime{Time: *t, Valid: true}
+}
@@ -1,19 +0,0 @@
-package m_product
-
-// Field name constants for the products table
-const (
-	ProductID            = "product_id"
-	Name                 = "name"
-	Description          = "description"
-	Category             = "category"
-	BasePriceNumerator   = "base_price_numerator"
-	BasePriceDenominator = "base_price_denominator"
-	DiscountID           = "discount_id"
-	DiscountAmount       = "discount_amount"
-	DiscountStartDate    = "discount_start_date"
-	DiscountEndDate      = "discount_end_date"
-	Status               = "status"
-	ArchivedAt           = "archived_at"
-	CreatedAt            = "created_at"
-	UpdatedAt            = "updated_at"
-)
@@ -0,0 +1,44 @@
+package m_product
+
+import (
+	"fmt"
+
+	"cloud.google.com/go/spanner"
+)
+
+// Scanner decodes rows read with a fixed column list into a Product without ToStruct's reflection
+// The decode destinations are built once and reused for every row, so one scanner serves a whole query
+// Spanner allocates fresh values for pointer, slice and NUMERIC columns, so the pointers of a
+// scanned Product stay valid after the next Scan; the Product itself is overwritten, and columns
+// that were not read stay zero
+type Scanner struct {
+	model Product
+	dest  []interface{}
+}
+
+// NewScanner creates a scanner for rows read with columns, such as AllColumns or BasicColumns
+// It panics on a column that is not in the products table
+func NewScanner(columns []string) *Scanner {
+	s := &Scanner{}
+	fields := s.model.fieldPointers()
+	s.dest = make([]interface{}, len(columns))
+	for i, column := range columns {
+		field, ok := fields[column]
+		if !ok {
+			panic(fmt.Sprintf("m_product: unknown column %q", column))
+		}
+		s.dest[i] = field
+	}
+	return s
+}
+
+// Scan decodes row into the scanner's Product and returns it; the next Scan overwrites it
+func (s *Scanner) Scan(row *spanner.Row) (*Product, error) {
+	if row.Size() != len(s.dest) {
+		return nil, fmt.Errorf("product row has %d columns, want %d", row.Size(), len(s.dest))
+	}
+	if err := row.Columns(s.dest...); err != nil {
+		return nil, err
+	}
+	return &s.model, nil
+}
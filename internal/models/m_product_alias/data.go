@@ -0,0 +1,42 @@
+package m_product_alias
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// ProductAlias represents the database model for an alias of a product
+type ProductAlias struct {
+	TenantID    string    `spanner:"tenant_id"`
+	Alias       string    `spanner:"alias"`
+	CanonicalID string    `spanner:"canonical_id"`
+	CreatedAt   time.Time `spanner:"created_at"`
+}
+
+// InsertOrUpdateMut creates a Spanner insert-or-update mutation for an alias
+// An alias that is reused simply moves to the new product
+func (a *ProductAlias) InsertOrUpdateMut() *spanner.Mutation {
+	return spanner.InsertOrUpdate(
+		TableName,
+		AllColumns(),
+		[]interface{}{a.TenantID, a.Alias, a.CanonicalID, a.CreatedAt},
+	)
+}
+
+// RepointMut creates a Spanner update mutation sending the alias to a new canonical product
+func (a *ProductAlias) RepointMut(canonicalID string) *spanner.Mutation {
+	return spanner.Update(
+		TableName,
+		[]string{TenantID, Alias, CanonicalID},
+		[]interface{}{a.TenantID, a.Alias, canonicalID},
+	)
+}
+
+// TableName is the Spanner table name for product aliases
+const TableName = "product_aliases"
+
+// AllColumns returns all column names in table order
+func AllColumns() []string {
+	return []string{TenantID, Alias, CanonicalID, CreatedAt}
+}
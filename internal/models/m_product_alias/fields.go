@@ -0,0 +1,9 @@
+package m_product_alias
+
+// Field name constants for the product_aliases table
+const (
+	TenantID    = "tenant_id"
+	Alias       = "alias"
+	CanonicalID = "canonical_id"
+	CreatedAt   = "created_at"
+)
@@ -0,0 +1,33 @@
+package m_product_count
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// ProductCount represents the database model for a cached product count
+type ProductCount struct {
+	TenantID     string    `spanner:"tenant_id"`
+	Category     string    `spanner:"category"`
+	Status       string    `spanner:"status"`
+	ProductCount int64     `spanner:"product_count"`
+	ComputedAt   time.Time `spanner:"computed_at"`
+}
+
+// InsertMut creates a Spanner insert mutation for a product count
+func (c *ProductCount) InsertMut() *spanner.Mutation {
+	return spanner.Insert(
+		TableName,
+		AllColumns(),
+		[]interface{}{c.TenantID, c.Category, c.Status, c.ProductCount, c.ComputedAt},
+	)
+}
+
+// TableName is the Spanner table name for product counts
+const TableName = "product_counts"
+
+// AllColumns returns all column names in table order
+func AllColumns() []string {
+	return []string{TenantID, Category, Status, Count, ComputedAt}
+}
@@ -0,0 +1,10 @@
+package m_product_count
+
+// Field name constants for the product_counts table
+const (
+	TenantID   = "tenant_id"
+	Category   = "category"
+	Status     = "status"
+	Count      = "product_count"
+	ComputedAt = "computed_at"
+)
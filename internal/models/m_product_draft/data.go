@@ -0,0 +1,40 @@
+package m_product_draft
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// ProductDraft represents the database model for a product's unpublished content
+type ProductDraft struct {
+	ProductID   string    `spanner:"product_id"`
+	TenantID    string    `spanner:"tenant_id"`
+	Name        string    `spanner:"name"`
+	Description string    `spanner:"description"`
+	Category    string    `spanner:"category"`
+	Metadata    []string  `spanner:"metadata"` // Sorted "key=value" entries, NULL when empty
+	UpdatedAt   time.Time `spanner:"updated_at"`
+}
+
+// SaveMut creates a Spanner insert-or-update mutation, replacing any earlier draft of the product
+func (d *ProductDraft) SaveMut() *spanner.Mutation {
+	return spanner.InsertOrUpdate(
+		TableName,
+		AllColumns(),
+		[]interface{}{d.ProductID, d.TenantID, d.Name, d.Description, d.Category, d.Metadata, d.UpdatedAt},
+	)
+}
+
+// DeleteMut creates a Spanner delete mutation for the draft
+func (d *ProductDraft) DeleteMut() *spanner.Mutation {
+	return spanner.Delete(TableName, spanner.Key{d.ProductID})
+}
+
+// TableName is the Spanner table name for product drafts
+const TableName = "product_drafts"
+
+// AllColumns returns all column names in table order
+func AllColumns() []string {
+	return []string{ProductID, TenantID, Name, Description, Category, Metadata, UpdatedAt}
+}
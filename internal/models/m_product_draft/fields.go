@@ -0,0 +1,12 @@
+package m_product_draft
+
+// Field name constants for the product_drafts table
+const (
+	ProductID   = "product_id"
+	TenantID    = "tenant_id"
+	Name        = "name"
+	Description = "description"
+	Category    = "category"
+	Metadata    = "metadata"
+	UpdatedAt   = "updated_at"
+)
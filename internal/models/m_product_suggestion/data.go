@@ -0,0 +1,34 @@
+package m_product_suggestion
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// ProductSuggestion represents the database model for a search suggestion
+type ProductSuggestion struct {
+	TenantID   string    `spanner:"tenant_id"`
+	SuggestKey string    `spanner:"suggest_key"`
+	Kind       string    `spanner:"kind"`
+	Text       string    `spanner:"text"`
+	Popularity int64     `spanner:"popularity"`
+	ComputedAt time.Time `spanner:"computed_at"`
+}
+
+// InsertMut creates a Spanner insert mutation for a search suggestion
+func (s *ProductSuggestion) InsertMut() *spanner.Mutation {
+	return spanner.Insert(
+		TableName,
+		AllColumns(),
+		[]interface{}{s.TenantID, s.SuggestKey, s.Kind, s.Text, s.Popularity, s.ComputedAt},
+	)
+}
+
+// TableName is the Spanner table name for search suggestions
+const TableName = "product_suggestions"
+
+// AllColumns returns all column names in table order
+func AllColumns() []string {
+	return []string{TenantID, SuggestKey, Kind, Text, Popularity, ComputedAt}
+}
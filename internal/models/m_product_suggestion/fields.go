@@ -0,0 +1,11 @@
+package m_product_suggestion
+
+// Field name constants for the product_suggestions table
+const (
+	TenantID   = "tenant_id"
+	SuggestKey = "suggest_key"
+	Kind       = "kind"
+	Text       = "text"
+	Popularity = "popularity"
+	ComputedAt = "computed_at"
+)
@@ -0,0 +1,38 @@
+package m_product_trend
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// ProductTrend represents the database model for a product's trending score
+type ProductTrend struct {
+	ProductID   string    `spanner:"product_id"`
+	TenantID    string    `spanner:"tenant_id"`
+	Score       float64   `spanner:"score"`
+	ScoredViews int64     `spanner:"scored_views"`
+	ScoredAt    time.Time `spanner:"scored_at"`
+}
+
+// UpsertMut creates a Spanner insert-or-update mutation for a trending score
+func (t *ProductTrend) UpsertMut() *spanner.Mutation {
+	return spanner.InsertOrUpdate(
+		TableName,
+		AllColumns(),
+		[]interface{}{t.ProductID, t.TenantID, t.Score, t.ScoredViews, t.ScoredAt},
+	)
+}
+
+// DeleteMut creates a Spanner delete mutation for a trending score
+func (t *ProductTrend) DeleteMut() *spanner.Mutation {
+	return spanner.Delete(TableName, spanner.Key{t.ProductID})
+}
+
+// TableName is the Spanner table name for trending scores
+const TableName = "product_trends"
+
+// AllColumns returns all column names in table order
+func AllColumns() []string {
+	return []string{ProductID, TenantID, Score, ScoredViews, ScoredAt}
+}
@@ -0,0 +1,10 @@
+package m_product_trend
+
+// Field name constants for the product_trends table
+const (
+	ProductID   = "product_id"
+	TenantID    = "tenant_id"
+	Score       = "score"
+	ScoredViews = "scored_views"
+	ScoredAt    = "scored_at"
+)
@@ -0,0 +1,36 @@
+package m_product_version
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// ProductVersion represents the database model for an immutable snapshot of a product's content
+type ProductVersion struct {
+	ProductID   string    `spanner:"product_id"`
+	VersionID   string    `spanner:"version_id"`
+	TenantID    string    `spanner:"tenant_id"`
+	Name        string    `spanner:"name"`
+	Description string    `spanner:"description"`
+	Category    string    `spanner:"category"`
+	Metadata    []string  `spanner:"metadata"` // Sorted "key=value" entries, NULL when empty
+	CreatedAt   time.Time `spanner:"created_at"`
+}
+
+// InsertMut creates a Spanner insert mutation for a new version
+func (v *ProductVersion) InsertMut() *spanner.Mutation {
+	return spanner.Insert(
+		TableName,
+		AllColumns(),
+		[]interface{}{v.ProductID, v.VersionID, v.TenantID, v.Name, v.Description, v.Category, v.Metadata, v.CreatedAt},
+	)
+}
+
+// TableName is the Spanner table name for product versions
+const TableName = "product_versions"
+
+// AllColumns returns all column names in table order
+func AllColumns() []string {
+	return []string{ProductID, VersionID, TenantID, Name, Description, Category, Metadata, CreatedAt}
+}
@@ -0,0 +1,13 @@
+package m_product_version
+
+// Field name constants for the product_versions table
+const (
+	ProductID   = "product_id"
+	VersionID   = "version_id"
+	TenantID    = "tenant_id"
+	Name        = "name"
+	Description = "description"
+	Category    = "category"
+	Metadata    = "metadata"
+	CreatedAt   = "created_at"
+)
@@ -0,0 +1,37 @@
+package m_product_view
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// ProductView represents the database model for a product's view counter
+type ProductView struct {
+	ProductID    string    `spanner:"product_id"`
+	TenantID     string    `spanner:"tenant_id"`
+	ViewCount    int64     `spanner:"view_count"`
+	LastViewedAt time.Time `spanner:"last_viewed_at"`
+}
+
+// UpsertMut creates a Spanner insert-or-update mutation for a view counter
+func (v *ProductView) UpsertMut() *spanner.Mutation {
+	return spanner.InsertOrUpdate(
+		TableName,
+		AllColumns(),
+		[]interface{}{v.ProductID, v.TenantID, v.ViewCount, v.LastViewedAt},
+	)
+}
+
+// DeleteMut creates a Spanner delete mutation for a view counter
+func (v *ProductView) DeleteMut() *spanner.Mutation {
+	return spanner.Delete(TableName, spanner.Key{v.ProductID})
+}
+
+// TableName is the Spanner table name for view counters
+const TableName = "product_views"
+
+// AllColumns returns all column names in table order
+func AllColumns() []string {
+	return []string{ProductID, TenantID, ViewCount, LastViewedAt}
+}
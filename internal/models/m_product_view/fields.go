@@ -0,0 +1,9 @@
+package m_product_view
+
+// Field name constants for the product_views table
+const (
+	ProductID    = "product_id"
+	TenantID     = "tenant_id"
+	ViewCount    = "view_count"
+	LastViewedAt = "last_viewed_at"
+)
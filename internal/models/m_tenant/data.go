@@ -0,0 +1,35 @@
+package m_tenant
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// Tenant represents the database model for a provisioned tenant
+type Tenant struct {
+	TenantID       string     `spanner:"tenant_id"`
+	RegistrationID string     `spanner:"registration_id"`
+	DisplayName    string     `spanner:"display_name"`
+	State          string     `spanner:"state"`
+	CreatedAt      time.Time  `spanner:"created_at"`
+	UpdatedAt      time.Time  `spanner:"updated_at"`
+	DeletedAt      *time.Time `spanner:"deleted_at"`
+}
+
+// UpsertMut creates a Spanner insert-or-update mutation for a tenant
+func (t *Tenant) UpsertMut() *spanner.Mutation {
+	return spanner.InsertOrUpdate(
+		TableName,
+		AllColumns(),
+		[]interface{}{t.TenantID, t.RegistrationID, t.DisplayName, t.State, t.CreatedAt, t.UpdatedAt, t.DeletedAt},
+	)
+}
+
+// TableName is the Spanner table name for tenants
+const TableName = "tenants"
+
+// AllColumns returns all column names in table order
+func AllColumns() []string {
+	return []string{TenantID, RegistrationID, DisplayName, State, CreatedAt, UpdatedAt, DeletedAt}
+}
@@ -0,0 +1,12 @@
+package m_tenant
+
+// Field name constants for the tenants table
+const (
+	TenantID       = "tenant_id"
+	RegistrationID = "registration_id"
+	DisplayName    = "display_name"
+	State          = "state"
+	CreatedAt      = "created_at"
+	UpdatedAt      = "updated_at"
+	DeletedAt      = "deleted_at"
+)
@@ -0,0 +1,39 @@
+package m_tenant_setting
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// TenantSetting represents the database model for a tenant's settings overrides
+type TenantSetting struct {
+	TenantID               string    `spanner:"tenant_id"`
+	DefaultCurrency        string    `spanner:"default_currency"`
+	MaxNameLength          int64     `spanner:"max_name_length"`
+	MinDiscountBasisPoints int64     `spanner:"min_discount_basis_points"`
+	MaxDiscountBasisPoints int64     `spanner:"max_discount_basis_points"`
+	UpdatedAt              time.Time `spanner:"updated_at"`
+}
+
+// UpsertMut creates a Spanner insert-or-update mutation for tenant settings
+func (s *TenantSetting) UpsertMut() *spanner.Mutation {
+	return spanner.InsertOrUpdate(
+		TableName,
+		AllColumns(),
+		[]interface{}{s.TenantID, s.DefaultCurrency, s.MaxNameLength, s.MinDiscountBasisPoints, s.MaxDiscountBasisPoints, s.UpdatedAt},
+	)
+}
+
+// DeleteMut creates a Spanner delete mutation for tenant settings
+func (s *TenantSetting) DeleteMut() *spanner.Mutation {
+	return spanner.Delete(TableName, spanner.Key{s.TenantID})
+}
+
+// TableName is the Spanner table name for tenant settings
+const TableName = "tenant_settings"
+
+// AllColumns returns all column names in table order
+func AllColumns() []string {
+	return []string{TenantID, DefaultCurrency, MaxNameLength, MinDiscountBasisPoints, MaxDiscountBasisPoints, UpdatedAt}
+}
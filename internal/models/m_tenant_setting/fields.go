@@ -0,0 +1,11 @@
+package m_tenant_setting
+
+// Field name constants for the tenant_settings table
+const (
+	TenantID               = "tenant_id"
+	DefaultCurrency        = "default_currency"
+	MaxNameLength          = "max_name_length"
+	MinDiscountBasisPoints = "min_discount_basis_points"
+	MaxDiscountBasisPoints = "max_discount_basis_points"
+	UpdatedAt              = "updated_at"
+)
@@ -0,0 +1,177 @@
+package apikey
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"catalog-proj/internal/models/m_api_key"
+	"catalog-proj/internal/pkg/clock"
+	"catalog-proj/internal/pkg/lru"
+	"catalog-proj/internal/pkg/tenant"
+
+	"github.com/google/uuid"
+)
+
+// Scope is a permission granted to an API key
+// Scopes are hierarchical: admin implies write, and write implies read
+type Scope string
+
+const (
+	ScopeRead  Scope = "read"
+	ScopeWrite Scope = "write"
+	ScopeAdmin Scope = "admin"
+)
+
+// scopeRank orders scopes so a key satisfies every scope ranked at or below its own
+var scopeRank = map[Scope]int{
+	ScopeRead:  1,
+	ScopeWrite: 2,
+	ScopeAdmin: 3,
+}
+
+// Valid reports whether s is a known scope
+func (s Scope) Valid() bool {
+	_, ok := scopeRank[s]
+	return ok
+}
+
+// Grants reports whether a key holding scopes may call a method requiring required
+func Grants(scopes []string, required Scope) bool {
+	for _, s := range scopes {
+		if scopeRank[Scope(s)] >= scopeRank[required] {
+			return true
+		}
+	}
+	return false
+}
+
+// secretPrefix marks catalog API keys so they are recognisable in logs and secret scanners
+const secretPrefix = "ck_"
+
+// maxCachedKeys bounds the authentication cache
+const maxCachedKeys = 10000
+
+// ErrInvalidKey is returned when a presented key is unknown or revoked
+var ErrInvalidKey = errors.New("invalid api key")
+
+// cachedKey is an authentication result remembered until expiresAt
+type cachedKey struct {
+	key       *m_api_key.APIKey
+	expiresAt time.Time
+}
+
+// Manager issues, revokes and authenticates API keys
+type Manager struct {
+	store    Store
+	clock    clock.Clock
+	cacheTTL time.Duration
+	cache    *lru.Cache[string, cachedKey]
+}
+
+// NewManager creates a new API key manager
+// Authenticated keys are cached for cacheTTL (0 disables caching), so a key revoked on
+// another server stays usable here for at most that long
+func NewManager(store Store, clock clock.Clock, cacheTTL time.Duration) *Manager {
+	return &Manager{
+		store:    store,
+		clock:    clock,
+		cacheTTL: cacheTTL,
+		cache:    lru.New[string, cachedKey](maxCachedKeys),
+	}
+}
+
+// Issue creates a key for the caller's tenant and returns it with its secret
+// The secret is only available here; the store keeps its hash
+func (m *Manager) Issue(ctx context.Context, name string, scopes []Scope) (*m_api_key.APIKey, string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, "", fmt.Errorf("failed to generate api key: %w", err)
+	}
+	secret := secretPrefix + base64.RawURLEncoding.EncodeToString(raw)
+
+	names := make([]string, len(scopes))
+	for i, s := range scopes {
+		names[i] = string(s)
+	}
+	key := &m_api_key.APIKey{
+		KeyID:     uuid.New().String(),
+		TenantID:  tenant.FromContext(ctx),
+		Name:      name,
+		KeyHash:   hash(secret),
+		Scopes:    names,
+		CreatedAt: m.clock.Now(),
+	}
+	if err := m.store.Create(ctx, key); err != nil {
+		return nil, "", err
+	}
+	return key, secret, nil
+}
+
+// Revoke revokes one of the caller's keys
+func (m *Manager) Revoke(ctx context.Context, keyID string) (*m_api_key.APIKey, error) {
+	key, err := m.get(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.store.Revoke(ctx, keyID, m.clock.Now()); err != nil {
+		return nil, err
+	}
+	m.cache.Remove(key.KeyHash)
+	return m.store.Get(ctx, keyID)
+}
+
+// List returns the caller's keys, newest first, including revoked ones
+func (m *Manager) List(ctx context.Context) ([]*m_api_key.APIKey, error) {
+	return m.store.List(ctx, tenant.FromContext(ctx))
+}
+
+// Authenticate resolves a presented secret to its key, failing with ErrInvalidKey for unknown or revoked keys
+func (m *Manager) Authenticate(ctx context.Context, secret string) (*m_api_key.APIKey, error) {
+	keyHash := hash(secret)
+	now := m.clock.Now()
+	if cached, ok := m.cache.Get(keyHash); ok && now.Before(cached.expiresAt) {
+		return cached.key, nil
+	}
+
+	key, err := m.store.GetByHash(ctx, keyHash)
+	if errors.Is(err, ErrNotFound) {
+		return nil, ErrInvalidKey
+	}
+	if err != nil {
+		return nil, err
+	}
+	if key.RevokedAt != nil {
+		m.cache.Remove(keyHash)
+		return nil, ErrInvalidKey
+	}
+
+	if m.cacheTTL > 0 {
+		m.cache.Add(keyHash, cachedKey{key: key, expiresAt: now.Add(m.cacheTTL)})
+	}
+	return key, nil
+}
+
+// get reads a key, hiding keys of other tenants
+func (m *Manager) get(ctx context.Context, keyID string) (*m_api_key.APIKey, error) {
+	key, err := m.store.Get(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+	if key.TenantID != tenant.FromContext(ctx) {
+		return nil, ErrNotFound
+	}
+	return key, nil
+}
+
+// hash returns the hex SHA-256 stored in place of a secret
+// Secrets carry 256 bits of randomness, so an unsalted fast hash is sufficient
+func hash(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,143 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"catalog-proj/internal/models/m_api_key"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+)
+
+// ErrNotFound is returned for unknown keys and keys of another tenant
+var ErrNotFound = errors.New("api key not found")
+
+// Store persists API keys
+type Store interface {
+	Create(ctx context.Context, key *m_api_key.APIKey) error
+	Get(ctx context.Context, keyID string) (*m_api_key.APIKey, error)
+	// GetByHash returns the key whose secret hashes to keyHash, revoked or not
+	GetByHash(ctx context.Context, keyHash string) (*m_api_key.APIKey, error)
+	List(ctx context.Context, tenantID string) ([]*m_api_key.APIKey, error)
+	// Revoke sets revoked_at unless the key is already revoked
+	Revoke(ctx context.Context, keyID string, now time.Time) error
+}
+
+// SpannerStore implements Store using the api_keys table
+type SpannerStore struct {
+	client *spanner.Client
+}
+
+// NewSpannerStore creates a new Spanner API key store
+func NewSpannerStore(client *spanner.Client) *SpannerStore {
+	return &SpannerStore{
+		client: client,
+	}
+}
+
+// Create inserts a new API key
+func (s *SpannerStore) Create(ctx context.Context, key *m_api_key.APIKey) error {
+	if _, err := s.client.Apply(ctx, []*spanner.Mutation{key.InsertMut()}); err != nil {
+		return fmt.Errorf("failed to create api key: %w", err)
+	}
+	return nil
+}
+
+// Get reads an API key by ID
+func (s *SpannerStore) Get(ctx context.Context, keyID string) (*m_api_key.APIKey, error) {
+	row, err := s.client.Single().ReadRow(ctx, m_api_key.TableName, spanner.Key{keyID}, m_api_key.AllColumns())
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get api key: %w", err)
+	}
+
+	key := &m_api_key.APIKey{}
+	if err := row.ToStruct(key); err != nil {
+		return nil, fmt.Errorf("failed to parse api key row: %w", err)
+	}
+	return key, nil
+}
+
+// GetByHash reads an API key through the unique key_hash index
+func (s *SpannerStore) GetByHash(ctx context.Context, keyHash string) (*m_api_key.APIKey, error) {
+	row, err := s.client.Single().ReadRowUsingIndex(ctx, m_api_key.TableName, "idx_api_keys_hash", spanner.Key{keyHash}, []string{m_api_key.KeyID})
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to look up api key: %w", err)
+	}
+
+	var keyID string
+	if err := row.Columns(&keyID); err != nil {
+		return nil, fmt.Errorf("failed to parse api key row: %w", err)
+	}
+	return s.Get(ctx, keyID)
+}
+
+// List returns all of the tenant's keys, newest first
+func (s *SpannerStore) List(ctx context.Context, tenantID string) ([]*m_api_key.APIKey, error) {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(`SELECT * FROM %s
+			WHERE tenant_id = @tenant
+			ORDER BY created_at DESC`, m_api_key.TableName),
+		Params: map[string]interface{}{"tenant": tenantID},
+	}
+
+	iter := s.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var keys []*m_api_key.APIKey
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list api keys: %w", err)
+		}
+
+		key := &m_api_key.APIKey{}
+		if err := row.ToStruct(key); err != nil {
+			return nil, fmt.Errorf("failed to parse api key row: %w", err)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// Revoke sets revoked_at; revoking a revoked key keeps the original time
+func (s *SpannerStore) Revoke(ctx context.Context, keyID string, now time.Time) error {
+	_, err := s.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		row, err := txn.ReadRow(ctx, m_api_key.TableName, spanner.Key{keyID}, []string{m_api_key.RevokedAt})
+		if err != nil {
+			if spanner.ErrCode(err) == codes.NotFound {
+				return ErrNotFound
+			}
+			return err
+		}
+		var revokedAt spanner.NullTime
+		if err := row.Columns(&revokedAt); err != nil {
+			return err
+		}
+		if revokedAt.Valid {
+			return nil
+		}
+		return txn.BufferWrite([]*spanner.Mutation{
+			spanner.Update(m_api_key.TableName, []string{m_api_key.KeyID, m_api_key.RevokedAt}, []interface{}{keyID, now}),
+		})
+	})
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("failed to revoke api key: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package breaker
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"catalog-proj/internal/pkg/clock"
+	"catalog-proj/internal/pkg/metrics"
+)
+
+// ErrOpen is returned when the breaker rejects a call without executing it
+var ErrOpen = errors.New("circuit breaker is open")
+
+// State is the current breaker state
+type State int
+
+const (
+	StateClosed State = iota
+	StateOpen
+	StateHalfOpen
+)
+
+// String returns the metric/log label for the state
+func (s State) String() string {
+	switch s {
+	case StateOpen:
+		return "open"
+	case StateHalfOpen:
+		return "half_open"
+	default:
+		return "closed"
+	}
+}
+
+// Settings configures when the breaker opens and how it probes for recovery
+type Settings struct {
+	// FailureThreshold is the number of consecutive failures that opens the breaker
+	FailureThreshold int
+	// OpenTimeout is how long the breaker stays open before allowing probe calls
+	OpenTimeout time.Duration
+	// HalfOpenMaxProbes is the number of concurrent probe calls allowed while half-open
+	HalfOpenMaxProbes int
+}
+
+// Breaker is a consecutive-failure circuit breaker with half-open probing
+type Breaker struct {
+	name     string
+	settings Settings
+	clock    clock.Clock
+
+	mu       sync.Mutex
+	state    State
+	failures int
+	openedAt time.Time
+	probes   int
+}
+
+// New creates a closed breaker; name labels the emitted metrics
+func New(name string, settings Settings, clock clock.Clock) *Breaker {
+	if settings.FailureThreshold < 1 {
+		settings.FailureThreshold = 1
+	}
+	if settings.HalfOpenMaxProbes < 1 {
+		settings.HalfOpenMaxProbes = 1
+	}
+	return &Breaker{
+		name:     name,
+		settings: settings,
+		clock:    clock,
+		state:    StateClosed,
+	}
+}
+
+// State returns the current state, moving open → half-open when the timeout elapsed
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.advance()
+	return b.state
+}
+
+// Execute runs fn if the breaker allows it and records the outcome
+// isFailure decides which errors count against the breaker (e.g. not-found does not)
+func (b *Breaker) Execute(fn func() error, isFailure func(error) bool) error {
+	if err := b.allow(); err != nil {
+		return err
+	}
+
+	err := fn()
+	b.record(err != nil && isFailure(err))
+	return err
+}
+
+// allow reports whether a call may proceed, reserving a probe slot when half-open
+func (b *Breaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.advance()
+	switch b.state {
+	case StateOpen:
+		metrics.Labeled("circuit_breaker_rejected_total").Add(b.name, 1)
+		return ErrOpen
+	case StateHalfOpen:
+		if b.probes >= b.settings.HalfOpenMaxProbes {
+			metrics.Labeled("circuit_breaker_rejected_total").Add(b.name, 1)
+			return ErrOpen
+		}
+		b.probes++
+	}
+	return nil
+}
+
+// record updates the breaker with the outcome of an allowed call
+func (b *Breaker) record(failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case StateHalfOpen:
+		b.probes--
+		if failed {
+			b.transition(StateOpen)
+			return
+		}
+		b.transition(StateClosed)
+	case StateClosed:
+		if !failed {
+			b.failures = 0
+			return
+		}
+		b.failures++
+		if b.failures >= b.settings.FailureThreshold {
+			b.transition(StateOpen)
+		}
+	}
+}
+
+// advance moves an open breaker to half-open once the open timeout has elapsed
+// Caller must hold b.mu
+func (b *Breaker) advance() {
+	if b.state == StateOpen && b.clock.Now().Sub(b.openedAt) >= b.settings.OpenTimeout {
+		b.transition(StateHalfOpen)
+	}
+}
+
+// transition changes state and resets counters; caller must hold b.mu
+func (b *Breaker) transition(to State) {
+	if b.state == to {
+		return
+	}
+	b.state = to
+	b.failures = 0
+	b.probes = 0
+	if to == StateOpen {
+		b.openedAt = b.clock.Now()
+	}
+	metrics.Labeled("circuit_breaker_transitions_total").Add(b.name+"."+to.String(), 1)
+}
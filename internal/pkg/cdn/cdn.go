@@ -0,0 +1,60 @@
+package cdn
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// requestTimeout bounds a single purge call
+const requestTimeout = 10 * time.Second
+
+// api sends purge requests to one provider's REST API
+type api struct {
+	http     *http.Client
+	name     string
+	endpoint string
+}
+
+func newAPI(httpClient *http.Client, name, endpoint string) api {
+	httpClient.Timeout = requestTimeout
+	return api{
+		http:     httpClient,
+		name:     name,
+		endpoint: strings.TrimRight(endpoint, "/"),
+	}
+}
+
+// post sends body (if any) to path with the extra headers and fails on a non-2xx response
+func (a api) post(ctx context.Context, path string, headers map[string]string, body []byte) error {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.endpoint+path, reader)
+	if err != nil {
+		return fmt.Errorf("failed to build %s purge: %w", a.name, err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := a.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call %s: %w", a.name, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	// Only the start of the error body is kept
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	return fmt.Errorf("%s purge failed with status %d: %s", a.name, resp.StatusCode, respBody)
+}
@@ -0,0 +1,52 @@
+package cdn
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// CloudflareEndpoint is the Cloudflare API
+const CloudflareEndpoint = "https://api.cloudflare.com"
+
+// cloudflareMaxFiles is the most URLs one purge_cache call may list on every plan
+const cloudflareMaxFiles = 30
+
+// Cloudflare purges URLs from one Cloudflare zone
+type Cloudflare struct {
+	api   api
+	zone  string
+	token string
+}
+
+// NewCloudflare creates a purger for the zone, authenticated with an API token that has Cache Purge permission
+// An empty endpoint means CloudflareEndpoint
+func NewCloudflare(endpoint, zone, token string) *Cloudflare {
+	if endpoint == "" {
+		endpoint = CloudflareEndpoint
+	}
+	return &Cloudflare{
+		api:   newAPI(&http.Client{}, "Cloudflare", endpoint),
+		zone:  zone,
+		token: token,
+	}
+}
+
+// Purge lists the URLs in purge_cache calls of up to cloudflareMaxFiles each
+func (c *Cloudflare) Purge(ctx context.Context, urls []string) error {
+	path := fmt.Sprintf("/client/v4/zones/%s/purge_cache", url.PathEscape(c.zone))
+	headers := map[string]string{"Authorization": "Bearer " + c.token}
+	for start := 0; start < len(urls); start += cloudflareMaxFiles {
+		end := min(start+cloudflareMaxFiles, len(urls))
+		body, err := json.Marshal(map[string][]string{"files": urls[start:end]})
+		if err != nil {
+			return fmt.Errorf("failed to encode Cloudflare purge: %w", err)
+		}
+		if err := c.api.post(ctx, path, headers, body); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package cdn
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// FastlyEndpoint is the Fastly API
+const FastlyEndpoint = "https://api.fastly.com"
+
+// Fastly purges single URLs from every Fastly service that caches them
+type Fastly struct {
+	api   api
+	token string
+}
+
+// NewFastly creates a purger authenticated with an API token that has purge_select scope
+// An empty endpoint means FastlyEndpoint
+func NewFastly(endpoint, token string) *Fastly {
+	if endpoint == "" {
+		endpoint = FastlyEndpoint
+	}
+	return &Fastly{
+		api:   newAPI(&http.Client{}, "Fastly", endpoint),
+		token: token,
+	}
+}
+
+// Purge sends one URL purge per URL; Fastly addresses a cached URL by host and path without the scheme
+func (f *Fastly) Purge(ctx context.Context, urls []string) error {
+	headers := map[string]string{"Fastly-Key": f.token}
+	for _, raw := range urls {
+		u, err := url.Parse(raw)
+		if err != nil {
+			return fmt.Errorf("invalid purge URL %q: %w", raw, err)
+		}
+		target := u.Host + u.EscapedPath()
+		if u.RawQuery != "" {
+			target += "?" + u.RawQuery
+		}
+		if err := f.api.post(ctx, "/purge/"+strings.TrimPrefix(target, "/"), headers, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
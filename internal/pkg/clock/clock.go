@@ -1,6 +1,9 @@
 package clock
 
-import "time"
+import (
+	"sync"
+	"time"
+)
 
 // Clock provides an abstraction for time operations
 type Clock interface {
@@ -19,3 +22,35 @@ func NewRealClock() *RealClock {
 func (r *RealClock) Now() time.Time {
 	return time.Now()
 }
+
+// FixedClock is a settable clock for tests and tools that replay history
+type FixedClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewFixedClock creates a clock that always reports t until changed
+func NewFixedClock(t time.Time) *FixedClock {
+	return &FixedClock{now: t}
+}
+
+// Now returns the clock's current time
+func (c *FixedClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Set moves the clock to t
+func (c *FixedClock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = t
+}
+
+// Advance moves the clock forward by d
+func (c *FixedClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
@@ -0,0 +1,109 @@
+package coalesce
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"catalog-proj/internal/pkg/logging"
+	"catalog-proj/internal/pkg/metrics"
+)
+
+// FlushFunc writes a batch of coalesced increments
+type FlushFunc[K comparable] func(ctx context.Context, counts map[K]int64) error
+
+// Buffer sums increments per key in memory and writes them in batches, so a hot key costs
+// one write per flush instead of one per increment
+// Increments still buffered when the process dies are lost; use it only for counters that tolerate that
+type Buffer[K comparable] struct {
+	mu      sync.Mutex
+	name    string
+	flush   FlushFunc[K]
+	maxKeys int
+	pending map[K]int64
+	full    chan struct{}
+	log     *slog.Logger
+	// flushing serializes flushes so a failed batch is merged back before the next one starts
+	flushing sync.Mutex
+}
+
+// NewBuffer creates a buffer that flushes early once maxKeys distinct keys are pending (minimum 1)
+// name labels the buffer's metrics
+func NewBuffer[K comparable](name string, maxKeys int, flush FlushFunc[K]) *Buffer[K] {
+	if maxKeys < 1 {
+		maxKeys = 1
+	}
+	return &Buffer[K]{
+		name:    name,
+		flush:   flush,
+		maxKeys: maxKeys,
+		pending: make(map[K]int64),
+		full:    make(chan struct{}, 1),
+		log:     logging.Component("coalesce"),
+	}
+}
+
+// Add buffers an increment of n for key
+func (b *Buffer[K]) Add(key K, n int64) {
+	b.mu.Lock()
+	b.pending[key] += n
+	full := len(b.pending) >= b.maxKeys
+	b.mu.Unlock()
+
+	if full {
+		select {
+		case b.full <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Flush writes everything buffered so far
+// A failed batch is merged back for the next flush unless that would hold more than
+// four times maxKeys keys, in which case it is dropped
+func (b *Buffer[K]) Flush(ctx context.Context) error {
+	b.flushing.Lock()
+	defer b.flushing.Unlock()
+
+	b.mu.Lock()
+	batch := b.pending
+	b.pending = make(map[K]int64, len(batch))
+	b.mu.Unlock()
+	if len(batch) == 0 {
+		return nil
+	}
+
+	if err := b.flush(ctx, batch); err != nil {
+		b.mu.Lock()
+		if len(b.pending)+len(batch) <= 4*b.maxKeys {
+			for key, n := range batch {
+				b.pending[key] += n
+			}
+		} else {
+			metrics.Labeled("coalesce_dropped_keys_total").Add(b.name, int64(len(batch)))
+		}
+		b.mu.Unlock()
+		return err
+	}
+	metrics.Labeled("coalesce_flushed_keys_total").Add(b.name, int64(len(batch)))
+	return nil
+}
+
+// Run flushes every interval, and early when the buffer fills, until ctx is done
+// Callers flush once more after Run returns to write the increments buffered since the last tick
+func (b *Buffer[K]) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-b.full:
+		}
+		if err := b.Flush(ctx); err != nil && ctx.Err() == nil {
+			b.log.Error("Failed to flush buffered counters", "buffer", b.name, "error", err)
+		}
+	}
+}
@@ -2,16 +2,15 @@ package committer
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"cloud.google.com/go/spanner"
+	"github.com/wuyiadepoju/commitplan"
 )
 
-// Committer defines the interface for committing transaction plans
-type Committer interface {
-	Apply(ctx context.Context, plan *Plan) error
-}
-
-// SpannerCommitter implements Committer using Spanner client
+// SpannerCommitter applies plans with the Spanner client and records each commit's timestamp
+// for callers that asked for it with WithCommitTimestamp
 type SpannerCommitter struct {
 	client *spanner.Client
 }
@@ -24,11 +23,51 @@ func NewSpannerCommitter(client *spanner.Client) *SpannerCommitter {
 }
 
 // Apply executes all mutations in the plan atomically
-func (c *SpannerCommitter) Apply(ctx context.Context, plan *Plan) error {
+func (c *SpannerCommitter) Apply(ctx context.Context, plan *commitplan.Plan) error {
 	if plan == nil || len(plan.Mutations()) == 0 {
 		return nil
 	}
 
-	_, err := c.client.Apply(ctx, plan.Mutations())
-	return err
+	commitTS, err := c.client.Apply(ctx, plan.Mutations())
+	if err != nil {
+		return err
+	}
+	if r, ok := ctx.Value(commitTimestampKey{}).(*commitTimestamp); ok {
+		r.set(commitTS)
+	}
+	return nil
+}
+
+type commitTimestampKey struct{}
+
+// commitTimestamp holds the timestamp of the latest commit made with a context
+type commitTimestamp struct {
+	mu sync.Mutex
+	at time.Time
+}
+
+func (r *commitTimestamp) set(at time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if at.After(r.at) {
+		r.at = at
+	}
+}
+
+// WithCommitTimestamp returns a context in which SpannerCommitter records the timestamp of its
+// latest commit, read back with CommitTimestamp
+func WithCommitTimestamp(ctx context.Context) context.Context {
+	return context.WithValue(ctx, commitTimestampKey{}, &commitTimestamp{})
+}
+
+// CommitTimestamp returns the timestamp of the latest plan committed with ctx, or false when none
+// was, e.g. because the change was a no-op or ctx did not come from WithCommitTimestamp
+func CommitTimestamp(ctx context.Context) (time.Time, bool) {
+	r, ok := ctx.Value(commitTimestampKey{}).(*commitTimestamp)
+	if !ok {
+		return time.Time{}, false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.at, !r.at.IsZero()
 }
@@ -0,0 +1,32 @@
+package committer
+
+import (
+	"context"
+
+	"catalog-proj/internal/pkg/faults"
+
+	"github.com/wuyiadepoju/commitplan"
+)
+
+// FaultyCommitter injects latency and failures before applying a plan, for resilience testing
+// A failed attempt applies nothing, like a real aborted commit
+type FaultyCommitter struct {
+	inner    commitplan.Committer
+	injector *faults.Injector
+}
+
+// NewFaultyCommitter wraps a committer with fault injection
+func NewFaultyCommitter(inner commitplan.Committer, injector *faults.Injector) *FaultyCommitter {
+	return &FaultyCommitter{
+		inner:    inner,
+		injector: injector,
+	}
+}
+
+// Apply applies the plan unless an injected fault fails it first
+func (c *FaultyCommitter) Apply(ctx context.Context, plan *commitplan.Plan) error {
+	if err := c.injector.Inject(ctx, "commit", true); err != nil {
+		return err
+	}
+	return c.inner.Apply(ctx, plan)
+}
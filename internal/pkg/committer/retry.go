@@ -0,0 +1,32 @@
+package committer
+
+import (
+	"context"
+
+	"catalog-proj/internal/pkg/retry"
+
+	"github.com/wuyiadepoju/commitplan"
+)
+
+// RetryingCommitter retries transient Spanner failures when applying a plan
+// Plans are applied atomically, so a failed attempt leaves no partial writes behind
+type RetryingCommitter struct {
+	inner   commitplan.Committer
+	retrier *retry.Retrier
+}
+
+// NewRetryingCommitter wraps a committer with retry/backoff
+func NewRetryingCommitter(inner commitplan.Committer, retrier *retry.Retrier) *RetryingCommitter {
+	return &RetryingCommitter{
+		inner:   inner,
+		retrier: retrier,
+	}
+}
+
+// Apply applies the plan, retrying ABORTED/RESOURCE_EXHAUSTED errors
+// UNAVAILABLE is returned as is, since the failed attempt may already have committed
+func (c *RetryingCommitter) Apply(ctx context.Context, plan *commitplan.Plan) error {
+	return c.retrier.DoIf(ctx, "commit", retry.IsRetryableCommit, func(ctx context.Context) error {
+		return c.inner.Apply(ctx, plan)
+	})
+}
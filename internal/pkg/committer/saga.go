@@ -0,0 +1,154 @@
+package committer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"catalog-proj/internal/pkg/metrics"
+
+	"github.com/wuyiadepoju/commitplan"
+)
+
+// Step is an external side effect that takes part in a saga, such as a cache
+// invalidation or a search index update
+type Step struct {
+	Name string
+	// Do performs the side effect; it may run more than once, so it must be idempotent
+	Do func(ctx context.Context) error
+	// Compensate undoes a completed Do; nil when the step cannot be undone
+	Compensate func(ctx context.Context) error
+}
+
+// Saga is a commit plan together with the external steps around it
+// Before steps run ahead of the commit and are compensated, newest first, when a later
+// step or the commit fails. After steps run once the commit succeeded; the commit cannot
+// be undone, so they are retried instead of compensated
+type Saga struct {
+	plan   *commitplan.Plan
+	before []Step
+	after  []Step
+}
+
+// NewSaga creates a saga around a commit plan
+func NewSaga(plan *commitplan.Plan) *Saga {
+	return &Saga{plan: plan}
+}
+
+// Before adds a step that must succeed before the plan is committed
+func (s *Saga) Before(step Step) {
+	s.before = append(s.before, step)
+}
+
+// After adds a step that runs once the plan is committed
+func (s *Saga) After(step Step) {
+	s.after = append(s.after, step)
+}
+
+// SagaError reports a saga that did not complete
+type SagaError struct {
+	Step      string // Step that failed, or "commit"
+	Committed bool   // Whether the plan's mutations were committed
+	// CompensationErrs holds the compensations that failed too; those side effects are left in place
+	CompensationErrs []error
+	Err              error
+}
+
+func (e *SagaError) Error() string {
+	msg := fmt.Sprintf("saga step %s failed (committed: %t): %v", e.Step, e.Committed, e.Err)
+	if len(e.CompensationErrs) > 0 {
+		msg += fmt.Sprintf("; %d compensations failed: %v", len(e.CompensationErrs), errors.Join(e.CompensationErrs...))
+	}
+	return msg
+}
+
+func (e *SagaError) Unwrap() error {
+	return e.Err
+}
+
+// SagaCommitter runs sagas on top of a commitplan committer, e.g. RetryingCommitter
+type SagaCommitter struct {
+	inner    commitplan.Committer
+	attempts int
+	backoff  time.Duration
+}
+
+// NewSagaCommitter creates a saga committer; steps get up to attempts tries each,
+// starting backoff apart and doubling
+func NewSagaCommitter(inner commitplan.Committer, attempts int, backoff time.Duration) *SagaCommitter {
+	if attempts < 1 {
+		attempts = 1
+	}
+	return &SagaCommitter{
+		inner:    inner,
+		attempts: attempts,
+		backoff:  backoff,
+	}
+}
+
+// Run executes the before steps, commits the plan and executes the after steps
+// On failure before the commit, completed before steps are compensated and the plan is
+// not committed. On failure after the commit, the returned SagaError has Committed set
+func (c *SagaCommitter) Run(ctx context.Context, saga *Saga) error {
+	for i, step := range saga.before {
+		if err := c.do(ctx, step); err != nil {
+			return c.compensate(ctx, saga.before[:i], step.Name, err)
+		}
+	}
+
+	if err := c.inner.Apply(ctx, saga.plan); err != nil {
+		return c.compensate(ctx, saga.before, "commit", err)
+	}
+
+	for _, step := range saga.after {
+		if err := c.do(ctx, step); err != nil {
+			metrics.Labeled("saga_after_step_failures_total").Add(step.Name, 1)
+			return &SagaError{Step: step.Name, Committed: true, Err: err}
+		}
+	}
+	return nil
+}
+
+// compensate undoes completed steps newest first and builds the error for the failed step
+// Compensations use a context detached from cancellation so a cancelled request still cleans up
+func (c *SagaCommitter) compensate(ctx context.Context, completed []Step, failed string, err error) error {
+	sagaErr := &SagaError{Step: failed, Err: err}
+	ctx = context.WithoutCancel(ctx)
+	for i := len(completed) - 1; i >= 0; i-- {
+		step := completed[i]
+		if step.Compensate == nil {
+			continue
+		}
+		metrics.Labeled("saga_compensations_total").Add(step.Name, 1)
+		if cerr := c.retry(ctx, step.Compensate); cerr != nil {
+			sagaErr.CompensationErrs = append(sagaErr.CompensationErrs, fmt.Errorf("compensate %s: %w", step.Name, cerr))
+		}
+	}
+	return sagaErr
+}
+
+// do runs a step's action with retries
+func (c *SagaCommitter) do(ctx context.Context, step Step) error {
+	return c.retry(ctx, step.Do)
+}
+
+// retry calls fn until it succeeds, the attempts are exhausted or ctx is done
+func (c *SagaCommitter) retry(ctx context.Context, fn func(ctx context.Context) error) error {
+	backoff := c.backoff
+	for attempt := 1; ; attempt++ {
+		err := fn(ctx)
+		if err == nil || attempt >= c.attempts {
+			return err
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		backoff *= 2
+	}
+}
@@ -0,0 +1,32 @@
+package committer
+
+import (
+	"context"
+
+	"catalog-proj/internal/pkg/usage"
+
+	"github.com/wuyiadepoju/commitplan"
+)
+
+// UsageCommitter records the mutations of each applied plan against the calling request's usage
+type UsageCommitter struct {
+	inner commitplan.Committer
+}
+
+// NewUsageCommitter wraps a committer with usage accounting
+func NewUsageCommitter(inner commitplan.Committer) *UsageCommitter {
+	return &UsageCommitter{
+		inner: inner,
+	}
+}
+
+// Apply applies the plan and, once it is committed, counts its mutations
+func (c *UsageCommitter) Apply(ctx context.Context, plan *commitplan.Plan) error {
+	if err := c.inner.Apply(ctx, plan); err != nil {
+		return err
+	}
+	if plan != nil {
+		usage.AddMutations(ctx, len(plan.Mutations()))
+	}
+	return nil
+}
@@ -0,0 +1,1549 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Config holds all externalized service configuration
+// Values start from Default() and can be overridden by CATALOG_* environment variables
+type Config struct {
+	Server    ServerConfig
+	Spanner   SpannerConfig
+	Retry     RetryConfig
+	Breaker   BreakerConfig
+	Quota     QuotaConfig
+	Catalog   CatalogConfig
+	Retention RetentionConfig
+	Jobs      JobsConfig
+	Paging    PagingConfig
+	Counts    CountsConfig
+	Approval  ApprovalConfig
+	Pricing   PricingConfig
+	Search    SearchConfig
+	Suggest   SuggestConfig
+	Views     ViewsConfig
+	Curated   CuratedConfig
+	Feeds     FeedsConfig
+	CDN       CDNConfig
+	Tenants   TenantsConfig
+	Usage     UsageConfig
+	Shadow    ShadowConfig
+	Flags     FlagsConfig
+	Faults    FaultsConfig
+	Logging   LoggingConfig
+}
+
+// ServerConfig holds gRPC server settings
+type ServerConfig struct {
+	GRPCPort string
+
+	// Environment names the deployment (such as development, staging or production)
+	Environment string
+
+	// MetricsPort serves expvar metrics at /debug/vars (empty disables the endpoint)
+	MetricsPort string
+
+	// GzipLevel is the compression level of gzip-encoded responses, sent to clients that compress
+	// their requests with gzip; -1 is the gzip default, otherwise 1 (fastest) to 9 (smallest)
+	GzipLevel int
+
+	// TLS terminates TLS on the gRPC port; without a certificate the server listens in plaintext
+	TLS TLSConfig
+
+	// APIKeys authenticates machine clients by the x-api-key metadata
+	APIKeys APIKeysConfig
+
+	// PreviewTokens lets read keys preview drafts with a token from GeneratePreviewToken
+	PreviewTokens PreviewTokensConfig
+
+	// GRPCWeb serves the gRPC services to browsers over gRPC-Web on a separate HTTP port
+	GRPCWeb GRPCWebConfig
+
+	// HTTP holds the CORS and security header settings of the HTTP ports browsers call, such as gRPC-Web
+	HTTP HTTPConfig
+}
+
+// GRPCWebConfig holds gRPC-Web settings
+type GRPCWebConfig struct {
+	// Port serves gRPC-Web, with the gRPC port's TLS settings (empty disables gRPC-Web)
+	Port string
+}
+
+// HTTPConfig holds the middleware settings of the HTTP ports
+type HTTPConfig struct {
+	CORS CORSConfig
+	// HSTSMaxAge is the Strict-Transport-Security max-age sent over TLS (0 disables HSTS)
+	HSTSMaxAge time.Duration
+	// HSTSIncludeSubdomains extends HSTS to every subdomain
+	HSTSIncludeSubdomains bool
+}
+
+// CORSConfig holds the cross-origin settings of the HTTP ports
+type CORSConfig struct {
+	// AllowedOrigins are the browser origins, such as https://admin.example.com, allowed to call
+	// cross-origin; "*" allows any origin. Requests without an Origin header are always served
+	AllowedOrigins []string
+	// AllowedMethods are the methods preflights allow
+	AllowedMethods []string
+	// AllowedHeaders are the request headers preflights allow (empty allows whatever is requested)
+	AllowedHeaders []string
+	// MaxAge is how long browsers may cache a preflight
+	MaxAge time.Duration
+}
+
+// APIKeysConfig holds API key authentication settings
+type APIKeysConfig struct {
+	// Required rejects requests without an API key; otherwise only presented keys are checked
+	Required bool
+	// CacheTTL is how long an authenticated key is reused without a Spanner read (0 disables caching)
+	// and so bounds how long a key revoked on another server keeps working
+	CacheTTL time.Duration
+}
+
+// PreviewTokensConfig holds preview token settings
+type PreviewTokensConfig struct {
+	// Secret signs and verifies tokens and must be shared by every server; empty disables preview tokens
+	Secret string
+	// DefaultTTL is the lifetime of tokens requested without one; MaxTTL caps requested lifetimes
+	DefaultTTL time.Duration
+	MaxTTL     time.Duration
+}
+
+// TLSConfig holds the gRPC server's TLS and mutual TLS settings
+type TLSConfig struct {
+	CertFile string
+	KeyFile  string
+	// ClientCAFile enables client certificates, verified against the CAs in this PEM file
+	ClientCAFile string
+	// RequireClientCert enforces mutual TLS: clients without a verified certificate are rejected
+	RequireClientCert bool
+	// AllowedSPIFFEIDs limits client certificates to these SPIFFE IDs (empty allows any verified client)
+	// A non-empty list implies RequireClientCert
+	AllowedSPIFFEIDs []string
+	// ReloadInterval is how often the files are re-read so rotated certificates apply without a restart (0 disables)
+	ReloadInterval time.Duration
+}
+
+// Enabled reports whether the server terminates TLS
+func (c TLSConfig) Enabled() bool {
+	return c.CertFile != ""
+}
+
+// SpannerConfig holds Spanner client settings
+type SpannerConfig struct {
+	// Database in the format projects/{project}/instances/{instance}/databases/{database}
+	Database string
+
+	// NumChannels is the number of gRPC channels opened to Spanner (0 uses the client default of 4)
+	NumChannels int
+
+	// EnableMetrics turns on the client's built-in OpenTelemetry metrics (such as GFE latency)
+	EnableMetrics bool
+
+	// AutoProvision creates a missing instance and database and applies MigrationsDir on startup
+	// Only honoured when SPANNER_EMULATOR_HOST is set; an existing database is never touched
+	AutoProvision bool
+
+	// MigrationsDir holds the .sql files applied when auto-provisioning
+	MigrationsDir string
+
+	// SchemaCheck compares the live columns with the models on startup: fail, warn or off
+	SchemaCheck string
+
+	// IDStrategy generates product and pending change IDs: random, uuidv7 or bit_reversed
+	IDStrategy string
+
+	// SlowQueryThreshold logs queries and reads taking at least this long, with their scrubbed
+	// parameters and request tag (0 disables the log)
+	SlowQueryThreshold time.Duration
+}
+
+// RetryConfig holds retry/backoff settings for transient Spanner errors
+type RetryConfig struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// BudgetTokens bounds retries across all callers; 0 disables the budget
+	BudgetTokens float64
+}
+
+// BreakerConfig holds the read model circuit breaker settings
+type BreakerConfig struct {
+	FailureThreshold  int
+	OpenTimeout       time.Duration
+	HalfOpenMaxProbes int
+
+	// ServeStale serves cached Get/List results (up to StaleMaxAge old) while the breaker is open
+	ServeStale      bool
+	StaleMaxAge     time.Duration
+	StaleMaxEntries int
+}
+
+// QuotaConfig holds catalog size limits; zero means unlimited
+type QuotaConfig struct {
+	MaxProductsPerTenant        int64
+	MaxProductsPerCategory      int64
+	MaxActiveDiscountsPerTenant int64
+}
+
+// CatalogConfig holds per-tenant catalog rules
+type CatalogConfig struct {
+	// UniqueNameTenants lists tenants whose product names must be unique within a category ("*" for all)
+	UniqueNameTenants []string
+
+	// ValidationRules maps a category ("*" for every category) to the rules its products must satisfy
+	// Loaded from the JSON file named by CATALOG_VALIDATION_RULES_FILE
+	ValidationRules map[string][]ValidationRule
+
+	// NormalizeUnicode converts names, categories and descriptions to Unicode NFC before validation
+	NormalizeUnicode bool
+	// CollapseWhitespace collapses repeated whitespace; names and categories become a single line
+	CollapseWhitespace bool
+	// DescriptionHTML selects what HTML survives in descriptions: strip removes every tag, markdown
+	// keeps the tags markdown renders to; script and style content is dropped either way
+	DescriptionHTML string
+
+	// DefaultCurrency is the ISO 4217 code of tenants whose settings do not name one
+	DefaultCurrency string
+	// TenantSettingsCacheTTL is how long a tenant's settings are reused without a Spanner read
+	// (0 disables caching) and so bounds how long a change made on another server takes to apply
+	TenantSettingsCacheTTL time.Duration
+}
+
+// ValidationRule constrains one product field (name, description, sku, gtin or base_price)
+type ValidationRule struct {
+	Field     string `json:"field"`
+	Required  bool   `json:"required"`
+	Pattern   string `json:"pattern"`
+	MinLength int    `json:"min_length"`
+	MaxLength int    `json:"max_length"`
+	// MinPrice and MaxPrice are decimal amounts and apply to base_price only
+	MinPrice string `json:"min_price"`
+	MaxPrice string `json:"max_price"`
+	// Message replaces the generated violation description
+	Message string `json:"message"`
+}
+
+// RetentionConfig holds the archived product purge job settings
+type RetentionConfig struct {
+	// Enabled runs the purge job in the server every Interval
+	Enabled bool
+	// ArchivedDays is how long archived products are kept before they are purged
+	ArchivedDays int
+	Interval     time.Duration
+	// BatchSize bounds the number of products purged per run
+	BatchSize int
+}
+
+// JobsConfig holds the background job worker settings
+type JobsConfig struct {
+	// Enabled runs a job worker in the server; disable it on instances that should only serve RPCs
+	Enabled     bool
+	Concurrency int
+	// PollInterval is how often an idle worker checks for due jobs
+	PollInterval time.Duration
+	// LeaseDuration is how long a job stays claimed without a heartbeat before another worker takes it over
+	LeaseDuration time.Duration
+	// MaxAttempts is the default number of attempts before a job is marked failed
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// PagingConfig holds the page size limits for list RPCs
+type PagingConfig struct {
+	// DefaultPageSize applies when a request sets no limit
+	DefaultPageSize int
+	// MaxPageSize is the largest limit a request may ask for; larger limits are rejected
+	MaxPageSize int
+	// MaxScanRows is the deepest offset plus limit a list without a category filter may read,
+	// and the largest search limit; admin exports can override it (0 disables the guard)
+	MaxScanRows int
+}
+
+// CountsConfig holds the product count refresh job behind approximate list totals
+type CountsConfig struct {
+	// Enabled runs the refresh job every Interval; approximate totals fall back to exact counts until it has run
+	Enabled  bool
+	Interval time.Duration
+}
+
+// ApprovalConfig holds the four-eyes policy for base price changes
+type ApprovalConfig struct {
+	// PriceChangeThresholdPercent is the largest base price change, as a percentage of the current price,
+	// applied without a second approver; zero disables the policy
+	PriceChangeThresholdPercent float64
+}
+
+// PricingConfig holds how prices are calculated for display
+type PricingConfig struct {
+	// EnforceMAP shows a product's minimum advertised price in place of any lower effective price
+	EnforceMAP bool
+	// Workers bounds the goroutines pricing one ListProducts page; 0 uses GOMAXPROCS and 1 prices serially
+	// Small pages are always priced serially
+	Workers int
+	// CacheSize is how many display prices are cached by product version and minute; 0 disables the cache
+	CacheSize int
+	// RefreshEnabled runs the effective price refresh job every RefreshInterval, repricing products whose
+	// discount started or ended so lists sorted or filtered by effective price stay current
+	RefreshEnabled  bool
+	RefreshInterval time.Duration
+	// RefreshBatchSize bounds the products repriced per transaction
+	RefreshBatchSize int
+}
+
+// SearchConfig holds how SearchProducts matches query words
+type SearchConfig struct {
+	// Synonyms are groups of interchangeable words, loaded from the JSON file named by CATALOG_SEARCH_SYNONYMS_FILE
+	Synonyms [][]string
+	// Fuzzy lets query words of four or more letters match words with a typo (two from eight letters)
+	Fuzzy bool
+	// Backend selects where candidates are matched: "spanner" scans the products table,
+	// "opensearch" queries an index kept up to date from the outbox by the search index job
+	Backend         string
+	OpenSearchURL   string
+	OpenSearchIndex string
+	// IndexInterval is how often the search index job applies new outbox events
+	IndexInterval time.Duration
+}
+
+// SuggestConfig holds the refresh job behind SuggestProducts
+type SuggestConfig struct {
+	// Enabled runs the refresh job every Interval; SuggestProducts returns nothing until it has run
+	Enabled  bool
+	Interval time.Duration
+}
+
+// ViewsConfig holds how recorded product views are batched before they are written
+type ViewsConfig struct {
+	// FlushInterval is how often buffered views are written; views buffered when a server crashes are lost
+	FlushInterval time.Duration
+	// MaxPendingProducts triggers an early flush once this many distinct products have buffered views
+	MaxPendingProducts int
+}
+
+// UsageConfig holds per-caller usage accounting behind GetUsage
+type UsageConfig struct {
+	// Enabled records requests, returned rows and committed mutations per tenant and API key
+	Enabled bool
+	// FlushInterval is how often buffered usage is written; usage buffered when a server crashes is lost
+	FlushInterval time.Duration
+	// MaxPendingKeys triggers an early flush once this many distinct counters are buffered
+	MaxPendingKeys int
+}
+
+// ShadowConfig holds request shadowing: sampled read calls are repeated against an alternate
+// implementation (such as the v2 API) and the results compared, to de-risk migrations
+type ShadowConfig struct {
+	// Percent of shadowable read calls that are repeated (0 disables shadowing)
+	Percent float64
+	// MaxInFlight bounds concurrent shadow calls per server; calls over the bound are dropped
+	MaxInFlight int
+	// Timeout bounds each shadow call
+	Timeout time.Duration
+}
+
+// LoggingConfig holds the process-wide slog settings
+type LoggingConfig struct {
+	// Format is text, json, or cloud (JSON with Cloud Logging's severity and message fields)
+	Format string
+	// Level is the minimum level logged: debug, info, warn or error
+	Level string
+	// ComponentLevels overrides Level per component, such as jobs=debug or shadow=warn
+	ComponentLevels map[string]string
+	// DebugSampleFirst debug records per message are logged each second, then every DebugSampleThereafter-th
+	// (0 logs every debug record)
+	DebugSampleFirst      int
+	DebugSampleThereafter int
+}
+
+// FaultsConfig holds fault injection for resilience testing: Spanner commits and read model queries
+// are delayed and failed so retries, circuit breaking and timeouts can be observed; never allowed in production
+type FaultsConfig struct {
+	// Enabled wraps Spanner access with the injector and enables the fault injection admin RPCs
+	Enabled bool
+	// Latency delays every injected call
+	Latency time.Duration
+	// Percentages of calls failing with ABORTED, UNAVAILABLE and not found (together at most 100)
+	AbortPercent       float64
+	UnavailablePercent float64
+	NotFoundPercent    float64
+}
+
+// FlagsConfig holds feature flags for gradual, per-tenant rollouts
+type FlagsConfig struct {
+	// Rules by flag name; flags without a rule keep their built-in default
+	Rules map[string]FlagRule
+}
+
+// FlagRule is one flag's rollout, evaluated per tenant: excluded tenants are off, listed tenants
+// are on, then percent of the remaining tenants, or all of them when enabled
+type FlagRule struct {
+	Enabled        bool     `json:"enabled"`
+	Tenants        []string `json:"tenants"`
+	ExcludeTenants []string `json:"exclude_tenants"`
+	Percent        float64  `json:"percent"`
+}
+
+// validFlagName restricts flag names so they map to CATALOG_FLAG_* variables
+var validFlagName = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
+// validCurrency matches an ISO 4217 currency code
+var validCurrency = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// validTenantID matches the tenant IDs accepted in x-tenant-id metadata
+var validTenantID = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,63}$`)
+
+// CuratedConfig holds the refresh job behind ListNewArrivals and ListTrendingProducts
+type CuratedConfig struct {
+	// Enabled runs the refresh job every Interval; both lists are empty until it has run
+	Enabled  bool
+	Interval time.Duration
+	// Size is the number of products kept per tenant and list
+	Size int
+	// NewArrivalsWindow bounds how long ago a new arrival may have been created
+	NewArrivalsWindow time.Duration
+	// TrendingHalfLife is how long it takes a view's weight in the trending score to halve
+	TrendingHalfLife time.Duration
+}
+
+// FeedsConfig holds the job that uploads sales-channel product feeds to Cloud Storage
+type FeedsConfig struct {
+	// Enabled runs the feed job every Interval
+	Enabled  bool
+	Interval time.Duration
+	Bucket   string
+	// Endpoint overrides the Cloud Storage endpoint, e.g. with a local fake; no credentials are sent to it
+	Endpoint string
+	// Tenants maps a tenant to its feed settings; tenants without an entry get no feeds
+	// Loaded from the JSON file named by CATALOG_FEEDS_FILE
+	Tenants map[string]FeedTenant
+}
+
+// FeedTenant holds one tenant's feed settings
+type FeedTenant struct {
+	// Formats lists the feeds to generate: google_merchant (XML) and facebook (CSV)
+	Formats []string `json:"formats"`
+	// Channel limits the feeds to products visible on it; defaults to marketplace
+	Channel  string `json:"channel"`
+	Currency string `json:"currency"`
+	Brand    string `json:"brand"`
+	// ProductURL is the storefront link of a product, with "{id}" standing for the product ID
+	ProductURL string `json:"product_url"`
+	// ImageMetadataKey names the metadata entry holding a product's image URL; defaults to image_url
+	ImageMetadataKey string `json:"image_metadata_key"`
+}
+
+// TenantsConfig holds tenant provisioning with CreateTenant and DeleteTenant
+type TenantsConfig struct {
+	// OperatorTenant is the only tenant allowed to create and delete tenants and to clone products
+	// into other tenants; empty disables both. It is only accepted with Server.APIKeys.Required, so
+	// the tenant comes from an admin key
+	OperatorTenant string
+	// ExportBucket is the Cloud Storage bucket DeleteTenant exports products to; empty refuses exports
+	ExportBucket string
+	// ExportEndpoint overrides the Cloud Storage endpoint, e.g. with a local fake; no credentials are sent to it
+	ExportEndpoint string
+}
+
+// CDNConfig holds the job that purges changed product and category pages from a CDN
+type CDNConfig struct {
+	// Enabled runs the purge job every Interval
+	Enabled  bool
+	Interval time.Duration
+	// Provider selects the CDN: "cloud_cdn", "fastly" or "cloudflare"
+	Provider string
+	// Endpoint overrides the provider's API endpoint, e.g. with a local fake; Cloud CDN sends no credentials to it
+	Endpoint string
+	// ProductURLs are the cached pages of a product, with "{tenant}" and "{id}" standing for its tenant and ID
+	ProductURLs []string
+	// CategoryURLs are the cached pages of a category, with "{tenant}" and "{category}" standing for the tenant and category
+	CategoryURLs []string
+
+	// CloudCDNProject and CloudCDNURLMap name the URL map fronted by Cloud CDN
+	CloudCDNProject string
+	CloudCDNURLMap  string
+	// FastlyToken is an API token with purge_select scope
+	FastlyToken string
+	// CloudflareZone and CloudflareToken name the zone and an API token with Cache Purge permission
+	CloudflareZone  string
+	CloudflareToken string
+}
+
+// CDN providers
+const (
+	CDNProviderCloudCDN   = "cloud_cdn"
+	CDNProviderFastly     = "fastly"
+	CDNProviderCloudflare = "cloudflare"
+)
+
+// Feed formats
+const (
+	FeedFormatGoogleMerchant = "google_merchant"
+	FeedFormatFacebook       = "facebook"
+)
+
+// Log formats
+const (
+	LogFormatText  = "text"
+	LogFormatJSON  = "json"
+	LogFormatCloud = "cloud"
+)
+
+// logLevels are the accepted log levels
+var logLevels = map[string]bool{"debug": true, "info": true, "warn": true, "error": true}
+
+// Schema check modes
+const (
+	SchemaCheckFail = "fail"
+	SchemaCheckWarn = "warn"
+	SchemaCheckOff  = "off"
+)
+
+// Description HTML policies
+const (
+	DescriptionHTMLStrip    = "strip"
+	DescriptionHTMLMarkdown = "markdown"
+)
+
+// ID generation strategies
+const (
+	IDStrategyRandom      = "random"
+	IDStrategyUUIDv7      = "uuidv7"
+	IDStrategyBitReversed = "bit_reversed"
+)
+
+// Search backends
+const (
+	SearchBackendSpanner    = "spanner"
+	SearchBackendOpenSearch = "opensearch"
+)
+
+// Default returns the configuration used when no overrides are provided
+func Default() *Config {
+	return &Config{
+		Server: ServerConfig{
+			GRPCPort:    "50051",
+			Environment: "development",
+			GzipLevel:   -1,
+			TLS: TLSConfig{
+				ReloadInterval: time.Minute,
+			},
+			APIKeys: APIKeysConfig{
+				Required: false,
+				CacheTTL: 30 * time.Second,
+			},
+			PreviewTokens: PreviewTokensConfig{
+				DefaultTTL: time.Hour,
+				MaxTTL:     7 * 24 * time.Hour,
+			},
+			HTTP: HTTPConfig{
+				CORS: CORSConfig{
+					AllowedMethods: []string{"GET", "POST"},
+					MaxAge:         10 * time.Minute,
+				},
+				HSTSMaxAge: 365 * 24 * time.Hour,
+			},
+		},
+		Spanner: SpannerConfig{
+			NumChannels:        4,
+			EnableMetrics:      false,
+			AutoProvision:      true,
+			MigrationsDir:      "migrations",
+			SchemaCheck:        SchemaCheckFail,
+			IDStrategy:         IDStrategyRandom,
+			SlowQueryThreshold: 500 * time.Millisecond,
+		},
+		Retry: RetryConfig{
+			MaxAttempts:    4,
+			InitialBackoff: 50 * time.Millisecond,
+			MaxBackoff:     2 * time.Second,
+			BudgetTokens:   20,
+		},
+		Breaker: BreakerConfig{
+			FailureThreshold:  5,
+			OpenTimeout:       10 * time.Second,
+			HalfOpenMaxProbes: 1,
+			ServeStale:        false,
+			StaleMaxAge:       5 * time.Minute,
+			StaleMaxEntries:   10000,
+		},
+		Quota: QuotaConfig{},
+		Catalog: CatalogConfig{
+			NormalizeUnicode:       true,
+			CollapseWhitespace:     true,
+			DescriptionHTML:        DescriptionHTMLStrip,
+			DefaultCurrency:        "USD",
+			TenantSettingsCacheTTL: 30 * time.Second,
+		},
+		Retention: RetentionConfig{
+			Enabled:      false,
+			ArchivedDays: 365,
+			Interval:     24 * time.Hour,
+			BatchSize:    500,
+		},
+		Jobs: JobsConfig{
+			Enabled:        true,
+			Concurrency:    4,
+			PollInterval:   time.Second,
+			LeaseDuration:  30 * time.Second,
+			MaxAttempts:    5,
+			InitialBackoff: time.Second,
+			MaxBackoff:     5 * time.Minute,
+		},
+		Paging: PagingConfig{
+			DefaultPageSize: 50,
+			MaxPageSize:     1000,
+			MaxScanRows:     10000,
+		},
+		Counts: CountsConfig{
+			Enabled:  false,
+			Interval: 15 * time.Minute,
+		},
+		Approval: ApprovalConfig{},
+		Pricing: PricingConfig{
+			EnforceMAP:       true,
+			CacheSize:        10000,
+			Workers:          0,
+			RefreshEnabled:   true,
+			RefreshInterval:  time.Minute,
+			RefreshBatchSize: 500,
+		},
+		Search: SearchConfig{
+			Fuzzy:           true,
+			Backend:         SearchBackendSpanner,
+			OpenSearchIndex: "products",
+			IndexInterval:   5 * time.Second,
+		},
+		Suggest: SuggestConfig{
+			Enabled:  false,
+			Interval: 15 * time.Minute,
+		},
+		Views: ViewsConfig{
+			FlushInterval:      time.Second,
+			MaxPendingProducts: 1000,
+		},
+		Usage: UsageConfig{
+			Enabled:        true,
+			FlushInterval:  30 * time.Second,
+			MaxPendingKeys: 1000,
+		},
+		Flags: FlagsConfig{
+			Rules: map[string]FlagRule{},
+		},
+		Faults: FaultsConfig{
+			Enabled: false,
+		},
+		Logging: LoggingConfig{
+			Format:                LogFormatText,
+			Level:                 "info",
+			ComponentLevels:       map[string]string{},
+			DebugSampleThereafter: 100,
+		},
+		Shadow: ShadowConfig{
+			Percent:     0,
+			MaxInFlight: 16,
+			Timeout:     2 * time.Second,
+		},
+		Curated: CuratedConfig{
+			Enabled:           false,
+			Interval:          10 * time.Minute,
+			Size:              50,
+			NewArrivalsWindow: 30 * 24 * time.Hour,
+			TrendingHalfLife:  24 * time.Hour,
+		},
+		Feeds: FeedsConfig{
+			Enabled:  false,
+			Interval: 6 * time.Hour,
+		},
+		CDN: CDNConfig{
+			Enabled:  false,
+			Interval: 10 * time.Second,
+		},
+	}
+}
+
+// Load returns the default configuration overridden by environment variables
+func Load() (*Config, error) {
+	cfg := Default()
+
+	cfg.Server.GRPCPort = envString("CATALOG_GRPC_PORT", cfg.Server.GRPCPort)
+	cfg.Server.MetricsPort = envString("CATALOG_METRICS_PORT", cfg.Server.MetricsPort)
+	cfg.Server.GRPCWeb.Port = envString("CATALOG_GRPC_WEB_PORT", cfg.Server.GRPCWeb.Port)
+	cfg.Server.HTTP.CORS.AllowedOrigins = envList("CATALOG_HTTP_CORS_ALLOWED_ORIGINS", cfg.Server.HTTP.CORS.AllowedOrigins)
+	cfg.Server.HTTP.CORS.AllowedMethods = envList("CATALOG_HTTP_CORS_ALLOWED_METHODS", cfg.Server.HTTP.CORS.AllowedMethods)
+	cfg.Server.HTTP.CORS.AllowedHeaders = envList("CATALOG_HTTP_CORS_ALLOWED_HEADERS", cfg.Server.HTTP.CORS.AllowedHeaders)
+	cfg.Server.Environment = envString("CATALOG_ENVIRONMENT", cfg.Server.Environment)
+	cfg.Server.TLS.CertFile = envString("CATALOG_TLS_CERT_FILE", cfg.Server.TLS.CertFile)
+	cfg.Server.TLS.KeyFile = envString("CATALOG_TLS_KEY_FILE", cfg.Server.TLS.KeyFile)
+	cfg.Server.TLS.ClientCAFile = envString("CATALOG_TLS_CLIENT_CA_FILE", cfg.Server.TLS.ClientCAFile)
+	cfg.Server.TLS.AllowedSPIFFEIDs = envList("CATALOG_TLS_ALLOWED_SPIFFE_IDS", cfg.Server.TLS.AllowedSPIFFEIDs)
+	cfg.Spanner.Database = envString("CATALOG_SPANNER_DATABASE", cfg.Spanner.Database)
+
+	var err error
+	if cfg.Server.TLS.RequireClientCert, err = envBool("CATALOG_TLS_REQUIRE_CLIENT_CERT", cfg.Server.TLS.RequireClientCert); err != nil {
+		return nil, err
+	}
+	if cfg.Server.TLS.ReloadInterval, err = envDuration("CATALOG_TLS_RELOAD_INTERVAL", cfg.Server.TLS.ReloadInterval); err != nil {
+		return nil, err
+	}
+	if cfg.Server.GzipLevel, err = envInt("CATALOG_GRPC_GZIP_LEVEL", cfg.Server.GzipLevel); err != nil {
+		return nil, err
+	}
+	if cfg.Server.APIKeys.Required, err = envBool("CATALOG_API_KEYS_REQUIRED", cfg.Server.APIKeys.Required); err != nil {
+		return nil, err
+	}
+	if cfg.Server.APIKeys.CacheTTL, err = envDuration("CATALOG_API_KEYS_CACHE_TTL", cfg.Server.APIKeys.CacheTTL); err != nil {
+		return nil, err
+	}
+	if cfg.Server.HTTP.CORS.MaxAge, err = envDuration("CATALOG_HTTP_CORS_MAX_AGE", cfg.Server.HTTP.CORS.MaxAge); err != nil {
+		return nil, err
+	}
+	if cfg.Server.HTTP.HSTSMaxAge, err = envDuration("CATALOG_HTTP_HSTS_MAX_AGE", cfg.Server.HTTP.HSTSMaxAge); err != nil {
+		return nil, err
+	}
+	if cfg.Server.HTTP.HSTSIncludeSubdomains, err = envBool("CATALOG_HTTP_HSTS_INCLUDE_SUBDOMAINS", cfg.Server.HTTP.HSTSIncludeSubdomains); err != nil {
+		return nil, err
+	}
+	cfg.Server.PreviewTokens.Secret = envString("CATALOG_PREVIEW_TOKEN_SECRET", cfg.Server.PreviewTokens.Secret)
+	if cfg.Server.PreviewTokens.DefaultTTL, err = envDuration("CATALOG_PREVIEW_TOKEN_TTL", cfg.Server.PreviewTokens.DefaultTTL); err != nil {
+		return nil, err
+	}
+	if cfg.Server.PreviewTokens.MaxTTL, err = envDuration("CATALOG_PREVIEW_TOKEN_MAX_TTL", cfg.Server.PreviewTokens.MaxTTL); err != nil {
+		return nil, err
+	}
+	if cfg.Spanner.NumChannels, err = envInt("CATALOG_SPANNER_NUM_CHANNELS", cfg.Spanner.NumChannels); err != nil {
+		return nil, err
+	}
+	if cfg.Spanner.EnableMetrics, err = envBool("CATALOG_SPANNER_ENABLE_METRICS", cfg.Spanner.EnableMetrics); err != nil {
+		return nil, err
+	}
+	if cfg.Spanner.AutoProvision, err = envBool("CATALOG_SPANNER_AUTO_PROVISION", cfg.Spanner.AutoProvision); err != nil {
+		return nil, err
+	}
+	cfg.Spanner.MigrationsDir = envString("CATALOG_SPANNER_MIGRATIONS_DIR", cfg.Spanner.MigrationsDir)
+	cfg.Spanner.SchemaCheck = envString("CATALOG_SPANNER_SCHEMA_CHECK", cfg.Spanner.SchemaCheck)
+	cfg.Spanner.IDStrategy = envString("CATALOG_SPANNER_ID_STRATEGY", cfg.Spanner.IDStrategy)
+	if cfg.Spanner.SlowQueryThreshold, err = envDuration("CATALOG_SPANNER_SLOW_QUERY_THRESHOLD", cfg.Spanner.SlowQueryThreshold); err != nil {
+		return nil, err
+	}
+
+	if cfg.Retry.MaxAttempts, err = envInt("CATALOG_RETRY_MAX_ATTEMPTS", cfg.Retry.MaxAttempts); err != nil {
+		return nil, err
+	}
+	if cfg.Retry.InitialBackoff, err = envDuration("CATALOG_RETRY_INITIAL_BACKOFF", cfg.Retry.InitialBackoff); err != nil {
+		return nil, err
+	}
+	if cfg.Retry.MaxBackoff, err = envDuration("CATALOG_RETRY_MAX_BACKOFF", cfg.Retry.MaxBackoff); err != nil {
+		return nil, err
+	}
+	if cfg.Retry.BudgetTokens, err = envFloat("CATALOG_RETRY_BUDGET_TOKENS", cfg.Retry.BudgetTokens); err != nil {
+		return nil, err
+	}
+
+	if cfg.Breaker.FailureThreshold, err = envInt("CATALOG_BREAKER_FAILURE_THRESHOLD", cfg.Breaker.FailureThreshold); err != nil {
+		return nil, err
+	}
+	if cfg.Breaker.OpenTimeout, err = envDuration("CATALOG_BREAKER_OPEN_TIMEOUT", cfg.Breaker.OpenTimeout); err != nil {
+		return nil, err
+	}
+	if cfg.Breaker.HalfOpenMaxProbes, err = envInt("CATALOG_BREAKER_HALF_OPEN_PROBES", cfg.Breaker.HalfOpenMaxProbes); err != nil {
+		return nil, err
+	}
+	if cfg.Breaker.ServeStale, err = envBool("CATALOG_BREAKER_SERVE_STALE", cfg.Breaker.ServeStale); err != nil {
+		return nil, err
+	}
+	if cfg.Breaker.StaleMaxAge, err = envDuration("CATALOG_BREAKER_STALE_MAX_AGE", cfg.Breaker.StaleMaxAge); err != nil {
+		return nil, err
+	}
+	if cfg.Breaker.StaleMaxEntries, err = envInt("CATALOG_BREAKER_STALE_MAX_ENTRIES", cfg.Breaker.StaleMaxEntries); err != nil {
+		return nil, err
+	}
+
+	if cfg.Quota.MaxProductsPerTenant, err = envInt64("CATALOG_QUOTA_MAX_PRODUCTS_PER_TENANT", cfg.Quota.MaxProductsPerTenant); err != nil {
+		return nil, err
+	}
+	if cfg.Quota.MaxProductsPerCategory, err = envInt64("CATALOG_QUOTA_MAX_PRODUCTS_PER_CATEGORY", cfg.Quota.MaxProductsPerCategory); err != nil {
+		return nil, err
+	}
+	if cfg.Quota.MaxActiveDiscountsPerTenant, err = envInt64("CATALOG_QUOTA_MAX_ACTIVE_DISCOUNTS_PER_TENANT", cfg.Quota.MaxActiveDiscountsPerTenant); err != nil {
+		return nil, err
+	}
+
+	cfg.Catalog.UniqueNameTenants = envList("CATALOG_UNIQUE_NAME_TENANTS", cfg.Catalog.UniqueNameTenants)
+	if path := envString("CATALOG_VALIDATION_RULES_FILE", ""); path != "" {
+		if cfg.Catalog.ValidationRules, err = loadValidationRules(path); err != nil {
+			return nil, err
+		}
+	}
+	if cfg.Catalog.NormalizeUnicode, err = envBool("CATALOG_TEXT_NORMALIZE_UNICODE", cfg.Catalog.NormalizeUnicode); err != nil {
+		return nil, err
+	}
+	if cfg.Catalog.CollapseWhitespace, err = envBool("CATALOG_TEXT_COLLAPSE_WHITESPACE", cfg.Catalog.CollapseWhitespace); err != nil {
+		return nil, err
+	}
+	cfg.Catalog.DescriptionHTML = envString("CATALOG_TEXT_DESCRIPTION_HTML", cfg.Catalog.DescriptionHTML)
+	cfg.Catalog.DefaultCurrency = envString("CATALOG_DEFAULT_CURRENCY", cfg.Catalog.DefaultCurrency)
+	if cfg.Catalog.TenantSettingsCacheTTL, err = envDuration("CATALOG_TENANT_SETTINGS_CACHE_TTL", cfg.Catalog.TenantSettingsCacheTTL); err != nil {
+		return nil, err
+	}
+
+	if cfg.Retention.Enabled, err = envBool("CATALOG_RETENTION_ENABLED", cfg.Retention.Enabled); err != nil {
+		return nil, err
+	}
+	if cfg.Retention.ArchivedDays, err = envInt("CATALOG_RETENTION_ARCHIVED_DAYS", cfg.Retention.ArchivedDays); err != nil {
+		return nil, err
+	}
+	if cfg.Retention.Interval, err = envDuration("CATALOG_RETENTION_INTERVAL", cfg.Retention.Interval); err != nil {
+		return nil, err
+	}
+	if cfg.Retention.BatchSize, err = envInt("CATALOG_RETENTION_BATCH_SIZE", cfg.Retention.BatchSize); err != nil {
+		return nil, err
+	}
+
+	if cfg.Jobs.Enabled, err = envBool("CATALOG_JOBS_ENABLED", cfg.Jobs.Enabled); err != nil {
+		return nil, err
+	}
+	if cfg.Jobs.Concurrency, err = envInt("CATALOG_JOBS_CONCURRENCY", cfg.Jobs.Concurrency); err != nil {
+		return nil, err
+	}
+	if cfg.Jobs.PollInterval, err = envDuration("CATALOG_JOBS_POLL_INTERVAL", cfg.Jobs.PollInterval); err != nil {
+		return nil, err
+	}
+	if cfg.Jobs.LeaseDuration, err = envDuration("CATALOG_JOBS_LEASE_DURATION", cfg.Jobs.LeaseDuration); err != nil {
+		return nil, err
+	}
+	if cfg.Jobs.MaxAttempts, err = envInt("CATALOG_JOBS_MAX_ATTEMPTS", cfg.Jobs.MaxAttempts); err != nil {
+		return nil, err
+	}
+	if cfg.Jobs.InitialBackoff, err = envDuration("CATALOG_JOBS_INITIAL_BACKOFF", cfg.Jobs.InitialBackoff); err != nil {
+		return nil, err
+	}
+	if cfg.Jobs.MaxBackoff, err = envDuration("CATALOG_JOBS_MAX_BACKOFF", cfg.Jobs.MaxBackoff); err != nil {
+		return nil, err
+	}
+
+	if cfg.Paging.DefaultPageSize, err = envInt("CATALOG_LIST_DEFAULT_PAGE_SIZE", cfg.Paging.DefaultPageSize); err != nil {
+		return nil, err
+	}
+	if cfg.Paging.MaxPageSize, err = envInt("CATALOG_LIST_MAX_PAGE_SIZE", cfg.Paging.MaxPageSize); err != nil {
+		return nil, err
+	}
+	if cfg.Paging.MaxScanRows, err = envInt("CATALOG_LIST_MAX_SCAN_ROWS", cfg.Paging.MaxScanRows); err != nil {
+		return nil, err
+	}
+
+	if cfg.Counts.Enabled, err = envBool("CATALOG_COUNTS_ENABLED", cfg.Counts.Enabled); err != nil {
+		return nil, err
+	}
+	if cfg.Counts.Interval, err = envDuration("CATALOG_COUNTS_INTERVAL", cfg.Counts.Interval); err != nil {
+		return nil, err
+	}
+
+	if cfg.Approval.PriceChangeThresholdPercent, err = envFloat("CATALOG_APPROVAL_PRICE_CHANGE_THRESHOLD_PERCENT", cfg.Approval.PriceChangeThresholdPercent); err != nil {
+		return nil, err
+	}
+
+	if cfg.Pricing.EnforceMAP, err = envBool("CATALOG_PRICING_ENFORCE_MAP", cfg.Pricing.EnforceMAP); err != nil {
+		return nil, err
+	}
+	if cfg.Pricing.Workers, err = envInt("CATALOG_PRICING_WORKERS", cfg.Pricing.Workers); err != nil {
+		return nil, err
+	}
+	if cfg.Pricing.CacheSize, err = envInt("CATALOG_PRICING_CACHE_SIZE", cfg.Pricing.CacheSize); err != nil {
+		return nil, err
+	}
+	if cfg.Pricing.RefreshEnabled, err = envBool("CATALOG_PRICING_REFRESH_ENABLED", cfg.Pricing.RefreshEnabled); err != nil {
+		return nil, err
+	}
+	if cfg.Pricing.RefreshInterval, err = envDuration("CATALOG_PRICING_REFRESH_INTERVAL", cfg.Pricing.RefreshInterval); err != nil {
+		return nil, err
+	}
+	if cfg.Pricing.RefreshBatchSize, err = envInt("CATALOG_PRICING_REFRESH_BATCH_SIZE", cfg.Pricing.RefreshBatchSize); err != nil {
+		return nil, err
+	}
+
+	if path := envString("CATALOG_SEARCH_SYNONYMS_FILE", ""); path != "" {
+		if cfg.Search.Synonyms, err = loadSynonyms(path); err != nil {
+			return nil, err
+		}
+	}
+	if cfg.Search.Fuzzy, err = envBool("CATALOG_SEARCH_FUZZY", cfg.Search.Fuzzy); err != nil {
+		return nil, err
+	}
+	cfg.Search.Backend = envString("CATALOG_SEARCH_BACKEND", cfg.Search.Backend)
+	cfg.Search.OpenSearchURL = envString("CATALOG_SEARCH_OPENSEARCH_URL", cfg.Search.OpenSearchURL)
+	cfg.Search.OpenSearchIndex = envString("CATALOG_SEARCH_OPENSEARCH_INDEX", cfg.Search.OpenSearchIndex)
+	if cfg.Search.IndexInterval, err = envDuration("CATALOG_SEARCH_INDEX_INTERVAL", cfg.Search.IndexInterval); err != nil {
+		return nil, err
+	}
+
+	if cfg.Suggest.Enabled, err = envBool("CATALOG_SUGGEST_ENABLED", cfg.Suggest.Enabled); err != nil {
+		return nil, err
+	}
+	if cfg.Suggest.Interval, err = envDuration("CATALOG_SUGGEST_INTERVAL", cfg.Suggest.Interval); err != nil {
+		return nil, err
+	}
+
+	if cfg.Views.FlushInterval, err = envDuration("CATALOG_VIEWS_FLUSH_INTERVAL", cfg.Views.FlushInterval); err != nil {
+		return nil, err
+	}
+	if cfg.Views.MaxPendingProducts, err = envInt("CATALOG_VIEWS_MAX_PENDING_PRODUCTS", cfg.Views.MaxPendingProducts); err != nil {
+		return nil, err
+	}
+
+	if cfg.Usage.Enabled, err = envBool("CATALOG_USAGE_ENABLED", cfg.Usage.Enabled); err != nil {
+		return nil, err
+	}
+	if cfg.Usage.FlushInterval, err = envDuration("CATALOG_USAGE_FLUSH_INTERVAL", cfg.Usage.FlushInterval); err != nil {
+		return nil, err
+	}
+	if cfg.Usage.MaxPendingKeys, err = envInt("CATALOG_USAGE_MAX_PENDING_KEYS", cfg.Usage.MaxPendingKeys); err != nil {
+		return nil, err
+	}
+
+	if cfg.Shadow.Percent, err = envFloat("CATALOG_SHADOW_PERCENT", cfg.Shadow.Percent); err != nil {
+		return nil, err
+	}
+	if cfg.Shadow.MaxInFlight, err = envInt("CATALOG_SHADOW_MAX_IN_FLIGHT", cfg.Shadow.MaxInFlight); err != nil {
+		return nil, err
+	}
+	if cfg.Shadow.Timeout, err = envDuration("CATALOG_SHADOW_TIMEOUT", cfg.Shadow.Timeout); err != nil {
+		return nil, err
+	}
+
+	if cfg.Faults.Enabled, err = envBool("CATALOG_FAULTS_ENABLED", cfg.Faults.Enabled); err != nil {
+		return nil, err
+	}
+	if cfg.Faults.Latency, err = envDuration("CATALOG_FAULTS_LATENCY", cfg.Faults.Latency); err != nil {
+		return nil, err
+	}
+	if cfg.Faults.AbortPercent, err = envFloat("CATALOG_FAULTS_ABORT_PERCENT", cfg.Faults.AbortPercent); err != nil {
+		return nil, err
+	}
+	if cfg.Faults.UnavailablePercent, err = envFloat("CATALOG_FAULTS_UNAVAILABLE_PERCENT", cfg.Faults.UnavailablePercent); err != nil {
+		return nil, err
+	}
+	if cfg.Faults.NotFoundPercent, err = envFloat("CATALOG_FAULTS_NOT_FOUND_PERCENT", cfg.Faults.NotFoundPercent); err != nil {
+		return nil, err
+	}
+
+	if cfg.Curated.Enabled, err = envBool("CATALOG_CURATED_ENABLED", cfg.Curated.Enabled); err != nil {
+		return nil, err
+	}
+	if cfg.Curated.Interval, err = envDuration("CATALOG_CURATED_INTERVAL", cfg.Curated.Interval); err != nil {
+		return nil, err
+	}
+	if cfg.Curated.Size, err = envInt("CATALOG_CURATED_SIZE", cfg.Curated.Size); err != nil {
+		return nil, err
+	}
+	if cfg.Curated.NewArrivalsWindow, err = envDuration("CATALOG_CURATED_NEW_ARRIVALS_WINDOW", cfg.Curated.NewArrivalsWindow); err != nil {
+		return nil, err
+	}
+	if cfg.Curated.TrendingHalfLife, err = envDuration("CATALOG_CURATED_TRENDING_HALF_LIFE", cfg.Curated.TrendingHalfLife); err != nil {
+		return nil, err
+	}
+
+	if cfg.Feeds.Enabled, err = envBool("CATALOG_FEEDS_ENABLED", cfg.Feeds.Enabled); err != nil {
+		return nil, err
+	}
+	if cfg.Feeds.Interval, err = envDuration("CATALOG_FEEDS_INTERVAL", cfg.Feeds.Interval); err != nil {
+		return nil, err
+	}
+	cfg.Feeds.Bucket = envString("CATALOG_FEEDS_BUCKET", cfg.Feeds.Bucket)
+	cfg.Feeds.Endpoint = envString("CATALOG_FEEDS_ENDPOINT", cfg.Feeds.Endpoint)
+	if path := envString("CATALOG_FEEDS_FILE", ""); path != "" {
+		if cfg.Feeds.Tenants, err = loadFeedTenants(path); err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.CDN.Enabled, err = envBool("CATALOG_CDN_ENABLED", cfg.CDN.Enabled); err != nil {
+		return nil, err
+	}
+	if cfg.CDN.Interval, err = envDuration("CATALOG_CDN_INTERVAL", cfg.CDN.Interval); err != nil {
+		return nil, err
+	}
+	cfg.CDN.Provider = envString("CATALOG_CDN_PROVIDER", cfg.CDN.Provider)
+	cfg.CDN.Endpoint = envString("CATALOG_CDN_ENDPOINT", cfg.CDN.Endpoint)
+	cfg.CDN.ProductURLs = envList("CATALOG_CDN_PRODUCT_URLS", cfg.CDN.ProductURLs)
+	cfg.CDN.CategoryURLs = envList("CATALOG_CDN_CATEGORY_URLS", cfg.CDN.CategoryURLs)
+	cfg.CDN.CloudCDNProject = envString("CATALOG_CDN_CLOUD_CDN_PROJECT", cfg.CDN.CloudCDNProject)
+	cfg.CDN.CloudCDNURLMap = envString("CATALOG_CDN_CLOUD_CDN_URL_MAP", cfg.CDN.CloudCDNURLMap)
+	cfg.CDN.FastlyToken = envString("CATALOG_CDN_FASTLY_TOKEN", cfg.CDN.FastlyToken)
+	cfg.CDN.CloudflareZone = envString("CATALOG_CDN_CLOUDFLARE_ZONE", cfg.CDN.CloudflareZone)
+	cfg.CDN.CloudflareToken = envString("CATALOG_CDN_CLOUDFLARE_TOKEN", cfg.CDN.CloudflareToken)
+
+	cfg.Tenants.OperatorTenant = envString("CATALOG_OPERATOR_TENANT", cfg.Tenants.OperatorTenant)
+	cfg.Tenants.ExportBucket = envString("CATALOG_TENANT_EXPORT_BUCKET", cfg.Tenants.ExportBucket)
+	cfg.Tenants.ExportEndpoint = envString("CATALOG_TENANT_EXPORT_ENDPOINT", cfg.Tenants.ExportEndpoint)
+
+	if path := envString("CATALOG_FEATURE_FLAGS_FILE", ""); path != "" {
+		if cfg.Flags.Rules, err = loadFlagRules(path); err != nil {
+			return nil, err
+		}
+	}
+	if err := applyFlagEnv(cfg.Flags.Rules); err != nil {
+		return nil, err
+	}
+
+	cfg.Logging.Format = envString("CATALOG_LOG_FORMAT", cfg.Logging.Format)
+	cfg.Logging.Level = strings.ToLower(envString("CATALOG_LOG_LEVEL", cfg.Logging.Level))
+	if cfg.Logging.ComponentLevels, err = envKeyValues("CATALOG_LOG_COMPONENT_LEVELS", cfg.Logging.ComponentLevels); err != nil {
+		return nil, err
+	}
+	if cfg.Logging.DebugSampleFirst, err = envInt("CATALOG_LOG_DEBUG_SAMPLE_FIRST", cfg.Logging.DebugSampleFirst); err != nil {
+		return nil, err
+	}
+	if cfg.Logging.DebugSampleThereafter, err = envInt("CATALOG_LOG_DEBUG_SAMPLE_THEREAFTER", cfg.Logging.DebugSampleThereafter); err != nil {
+		return nil, err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// Validate checks that configuration values are within acceptable ranges
+func (c *Config) Validate() error {
+	if err := c.Server.TLS.validate(); err != nil {
+		return err
+	}
+	if c.Server.GRPCWeb.Port != "" && c.Server.GRPCWeb.Port == c.Server.GRPCPort {
+		return fmt.Errorf("grpc-web port must differ from the grpc port %s", c.Server.GRPCPort)
+	}
+	if err := c.Server.HTTP.validate(); err != nil {
+		return err
+	}
+	if c.Server.GzipLevel != -1 && (c.Server.GzipLevel < 1 || c.Server.GzipLevel > 9) {
+		return fmt.Errorf("grpc gzip level must be -1 or between 1 and 9, got %d", c.Server.GzipLevel)
+	}
+	if c.Server.APIKeys.CacheTTL < 0 {
+		return fmt.Errorf("api key cache ttl must be non-negative, got %s", c.Server.APIKeys.CacheTTL)
+	}
+	if c.Server.PreviewTokens.DefaultTTL <= 0 || c.Server.PreviewTokens.DefaultTTL > c.Server.PreviewTokens.MaxTTL {
+		return fmt.Errorf("preview token ttl must be positive and at most the max ttl %s, got %s", c.Server.PreviewTokens.MaxTTL, c.Server.PreviewTokens.DefaultTTL)
+	}
+	if c.Server.PreviewTokens.Secret != "" && len(c.Server.PreviewTokens.Secret) < 32 {
+		return fmt.Errorf("preview token secret must be at least 32 characters")
+	}
+	if c.Spanner.NumChannels < 0 {
+		return fmt.Errorf("spanner num channels must be non-negative, got %d", c.Spanner.NumChannels)
+	}
+	if c.Spanner.AutoProvision && c.Spanner.MigrationsDir == "" {
+		return fmt.Errorf("spanner migrations dir is required when auto-provisioning")
+	}
+	if err := c.Logging.validate(); err != nil {
+		return err
+	}
+	switch c.Spanner.SchemaCheck {
+	case SchemaCheckFail, SchemaCheckWarn, SchemaCheckOff:
+	default:
+		return fmt.Errorf("spanner schema check must be %q, %q or %q, got %q", SchemaCheckFail, SchemaCheckWarn, SchemaCheckOff, c.Spanner.SchemaCheck)
+	}
+	switch c.Spanner.IDStrategy {
+	case IDStrategyRandom, IDStrategyUUIDv7, IDStrategyBitReversed:
+	default:
+		return fmt.Errorf("spanner ID strategy must be %q, %q or %q, got %q", IDStrategyRandom, IDStrategyUUIDv7, IDStrategyBitReversed, c.Spanner.IDStrategy)
+	}
+	if c.Spanner.SlowQueryThreshold < 0 {
+		return fmt.Errorf("spanner slow query threshold must not be negative, got %s", c.Spanner.SlowQueryThreshold)
+	}
+	if c.Retry.MaxAttempts < 1 {
+		return fmt.Errorf("retry max attempts must be at least 1, got %d", c.Retry.MaxAttempts)
+	}
+	if c.Retry.InitialBackoff < 0 || c.Retry.MaxBackoff < c.Retry.InitialBackoff {
+		return fmt.Errorf("retry backoff must satisfy 0 <= initial (%s) <= max (%s)", c.Retry.InitialBackoff, c.Retry.MaxBackoff)
+	}
+	if c.Retry.BudgetTokens < 0 {
+		return fmt.Errorf("retry budget tokens must be non-negative, got %v", c.Retry.BudgetTokens)
+	}
+	if c.Breaker.FailureThreshold < 1 {
+		return fmt.Errorf("breaker failure threshold must be at least 1, got %d", c.Breaker.FailureThreshold)
+	}
+	if c.Breaker.OpenTimeout <= 0 {
+		return fmt.Errorf("breaker open timeout must be positive, got %s", c.Breaker.OpenTimeout)
+	}
+	if c.Quota.MaxProductsPerTenant < 0 || c.Quota.MaxProductsPerCategory < 0 || c.Quota.MaxActiveDiscountsPerTenant < 0 {
+		return fmt.Errorf("quota limits must be non-negative")
+	}
+	switch c.Catalog.DescriptionHTML {
+	case DescriptionHTMLStrip, DescriptionHTMLMarkdown:
+	default:
+		return fmt.Errorf("description HTML policy must be %q or %q, got %q", DescriptionHTMLStrip, DescriptionHTMLMarkdown, c.Catalog.DescriptionHTML)
+	}
+	if !validCurrency.MatchString(c.Catalog.DefaultCurrency) {
+		return fmt.Errorf("default currency must be a three-letter ISO 4217 code, got %q", c.Catalog.DefaultCurrency)
+	}
+	if c.Catalog.TenantSettingsCacheTTL < 0 {
+		return fmt.Errorf("tenant settings cache ttl must be non-negative, got %s", c.Catalog.TenantSettingsCacheTTL)
+	}
+	if c.Retention.Enabled {
+		if c.Retention.ArchivedDays < 1 {
+			return fmt.Errorf("retention archived days must be at least 1, got %d", c.Retention.ArchivedDays)
+		}
+		if c.Retention.Interval <= 0 {
+			return fmt.Errorf("retention interval must be positive, got %s", c.Retention.Interval)
+		}
+	}
+	if c.Jobs.Concurrency < 1 {
+		return fmt.Errorf("jobs concurrency must be at least 1, got %d", c.Jobs.Concurrency)
+	}
+	if c.Jobs.PollInterval <= 0 {
+		return fmt.Errorf("jobs poll interval must be positive, got %s", c.Jobs.PollInterval)
+	}
+	if c.Jobs.LeaseDuration < 3*time.Second {
+		return fmt.Errorf("jobs lease duration must be at least 3s, got %s", c.Jobs.LeaseDuration)
+	}
+	if c.Jobs.MaxAttempts < 1 {
+		return fmt.Errorf("jobs max attempts must be at least 1, got %d", c.Jobs.MaxAttempts)
+	}
+	if c.Jobs.InitialBackoff <= 0 || c.Jobs.MaxBackoff < c.Jobs.InitialBackoff {
+		return fmt.Errorf("jobs backoff must satisfy 0 < initial (%s) <= max (%s)", c.Jobs.InitialBackoff, c.Jobs.MaxBackoff)
+	}
+	if c.Paging.DefaultPageSize < 1 || c.Paging.MaxPageSize < c.Paging.DefaultPageSize {
+		return fmt.Errorf("page sizes must satisfy 1 <= default (%d) <= max (%d)", c.Paging.DefaultPageSize, c.Paging.MaxPageSize)
+	}
+	// A smaller scan limit would refuse the first full page of an unfiltered list
+	if c.Paging.MaxScanRows != 0 && c.Paging.MaxScanRows < c.Paging.MaxPageSize {
+		return fmt.Errorf("max scan rows (%d) must be 0 or at least the max page size (%d)", c.Paging.MaxScanRows, c.Paging.MaxPageSize)
+	}
+	if c.Pricing.CacheSize < 0 {
+		return fmt.Errorf("pricing cache size must not be negative, got %d", c.Pricing.CacheSize)
+	}
+	if c.Pricing.Workers < 0 {
+		return fmt.Errorf("pricing workers must not be negative, got %d", c.Pricing.Workers)
+	}
+	if c.Pricing.RefreshEnabled && (c.Pricing.RefreshInterval <= 0 || c.Pricing.RefreshBatchSize <= 0) {
+		return fmt.Errorf("pricing refresh interval (%s) and batch size (%d) must be positive", c.Pricing.RefreshInterval, c.Pricing.RefreshBatchSize)
+	}
+	if c.Counts.Enabled && c.Counts.Interval <= 0 {
+		return fmt.Errorf("counts interval must be positive, got %s", c.Counts.Interval)
+	}
+	if c.Approval.PriceChangeThresholdPercent < 0 {
+		return fmt.Errorf("price change approval threshold must be non-negative, got %v", c.Approval.PriceChangeThresholdPercent)
+	}
+	switch c.Search.Backend {
+	case SearchBackendSpanner:
+	case SearchBackendOpenSearch:
+		if c.Search.OpenSearchURL == "" || c.Search.OpenSearchIndex == "" {
+			return fmt.Errorf("search backend opensearch needs an OpenSearch URL and index")
+		}
+		if c.Search.IndexInterval <= 0 {
+			return fmt.Errorf("search index interval must be positive, got %s", c.Search.IndexInterval)
+		}
+	default:
+		return fmt.Errorf("search backend must be %q or %q, got %q", SearchBackendSpanner, SearchBackendOpenSearch, c.Search.Backend)
+	}
+	if c.Suggest.Enabled && c.Suggest.Interval <= 0 {
+		return fmt.Errorf("suggest interval must be positive, got %s", c.Suggest.Interval)
+	}
+	if c.Views.FlushInterval <= 0 {
+		return fmt.Errorf("views flush interval must be positive, got %s", c.Views.FlushInterval)
+	}
+	// Each product is one mutation of four columns in the flush transaction
+	if c.Views.MaxPendingProducts < 1 || c.Views.MaxPendingProducts > 5000 {
+		return fmt.Errorf("views max pending products must be between 1 and 5000, got %d", c.Views.MaxPendingProducts)
+	}
+	if c.Usage.FlushInterval <= 0 {
+		return fmt.Errorf("usage flush interval must be positive, got %s", c.Usage.FlushInterval)
+	}
+	if c.Usage.MaxPendingKeys < 1 || c.Usage.MaxPendingKeys > 5000 {
+		return fmt.Errorf("usage max pending keys must be between 1 and 5000, got %d", c.Usage.MaxPendingKeys)
+	}
+	if c.Shadow.Percent < 0 || c.Shadow.Percent > 100 {
+		return fmt.Errorf("shadow percent must be between 0 and 100, got %g", c.Shadow.Percent)
+	}
+	if c.Shadow.MaxInFlight < 1 {
+		return fmt.Errorf("shadow max in flight must be at least 1, got %d", c.Shadow.MaxInFlight)
+	}
+	if c.Shadow.Timeout <= 0 {
+		return fmt.Errorf("shadow timeout must be positive, got %s", c.Shadow.Timeout)
+	}
+	for name, rule := range c.Flags.Rules {
+		if !validFlagName.MatchString(name) {
+			return fmt.Errorf("feature flag name %q must match %s", name, validFlagName)
+		}
+		if rule.Percent < 0 || rule.Percent > 100 {
+			return fmt.Errorf("feature flag %s: percent must be between 0 and 100, got %g", name, rule.Percent)
+		}
+	}
+	if c.Faults.Enabled {
+		if c.Server.Environment == "production" {
+			return fmt.Errorf("fault injection cannot be enabled in production")
+		}
+		if c.Faults.Latency < 0 {
+			return fmt.Errorf("faults latency must not be negative, got %s", c.Faults.Latency)
+		}
+		for _, percent := range []float64{c.Faults.AbortPercent, c.Faults.UnavailablePercent, c.Faults.NotFoundPercent} {
+			if percent < 0 || percent > 100 {
+				return fmt.Errorf("faults percentages must be between 0 and 100, got %g", percent)
+			}
+		}
+		if total := c.Faults.AbortPercent + c.Faults.UnavailablePercent + c.Faults.NotFoundPercent; total > 100 {
+			return fmt.Errorf("faults percentages must add up to at most 100, got %g", total)
+		}
+	}
+	if c.Curated.Enabled {
+		if c.Curated.Interval <= 0 {
+			return fmt.Errorf("curated interval must be positive, got %s", c.Curated.Interval)
+		}
+		// Both lists of every tenant are rewritten in one transaction
+		if c.Curated.Size < 1 || c.Curated.Size > 200 {
+			return fmt.Errorf("curated size must be between 1 and 200, got %d", c.Curated.Size)
+		}
+		if c.Curated.NewArrivalsWindow <= 0 {
+			return fmt.Errorf("curated new arrivals window must be positive, got %s", c.Curated.NewArrivalsWindow)
+		}
+		if c.Curated.TrendingHalfLife <= 0 {
+			return fmt.Errorf("curated trending half-life must be positive, got %s", c.Curated.TrendingHalfLife)
+		}
+	}
+	if c.Feeds.Enabled {
+		if c.Feeds.Interval <= 0 {
+			return fmt.Errorf("feeds interval must be positive, got %s", c.Feeds.Interval)
+		}
+		if c.Feeds.Bucket == "" {
+			return fmt.Errorf("feeds bucket is required when feeds are enabled")
+		}
+		for tenantID, feed := range c.Feeds.Tenants {
+			if err := feed.validate(); err != nil {
+				return fmt.Errorf("feeds of tenant %q: %w", tenantID, err)
+			}
+		}
+	}
+	if c.CDN.Enabled {
+		if err := c.CDN.validate(); err != nil {
+			return err
+		}
+	}
+	if c.Tenants.OperatorTenant != "" && !validTenantID.MatchString(c.Tenants.OperatorTenant) {
+		return fmt.Errorf("operator tenant must match %s, got %q", validTenantID.String(), c.Tenants.OperatorTenant)
+	}
+	if c.Tenants.OperatorTenant != "" && !c.Server.APIKeys.Required {
+		// Without required keys the tenant is whatever x-tenant-id the caller sends
+		return fmt.Errorf("operator tenant requires api keys to be required (CATALOG_API_KEYS_REQUIRED=true)")
+	}
+	return nil
+}
+
+// validate checks that the purge job has pages to purge and the selected provider's settings
+func (c CDNConfig) validate() error {
+	if c.Interval <= 0 {
+		return fmt.Errorf("cdn interval must be positive, got %s", c.Interval)
+	}
+	if len(c.ProductURLs) == 0 && len(c.CategoryURLs) == 0 {
+		return fmt.Errorf("cdn product or category urls are required when cdn purging is enabled")
+	}
+	for _, u := range append(append([]string{}, c.ProductURLs...), c.CategoryURLs...) {
+		if !strings.HasPrefix(u, "https://") && !strings.HasPrefix(u, "http://") {
+			return fmt.Errorf("cdn urls must be absolute http(s) urls, got %q", u)
+		}
+	}
+	switch c.Provider {
+	case CDNProviderCloudCDN:
+		if c.CloudCDNProject == "" || c.CloudCDNURLMap == "" {
+			return fmt.Errorf("cloud cdn project and url map are required")
+		}
+	case CDNProviderFastly:
+		if c.FastlyToken == "" {
+			return fmt.Errorf("fastly token is required")
+		}
+	case CDNProviderCloudflare:
+		if c.CloudflareZone == "" || c.CloudflareToken == "" {
+			return fmt.Errorf("cloudflare zone and token are required")
+		}
+	default:
+		return fmt.Errorf("cdn provider must be %q, %q or %q, got %q", CDNProviderCloudCDN, CDNProviderFastly, CDNProviderCloudflare, c.Provider)
+	}
+	return nil
+}
+
+// loadValidationRules reads category validation rules from a JSON file
+func loadValidationRules(path string) (map[string][]ValidationRule, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read validation rules: %w", err)
+	}
+	var rules map[string][]ValidationRule
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("invalid validation rules file %s: %w", path, err)
+	}
+	return rules, nil
+}
+
+// validate checks that the TLS files come in usable combinations
+func (c TLSConfig) validate() error {
+	if (c.CertFile == "") != (c.KeyFile == "") {
+		return fmt.Errorf("tls cert file and key file must be set together")
+	}
+	if c.ClientCAFile != "" && !c.Enabled() {
+		return fmt.Errorf("tls client CA file requires a server certificate")
+	}
+	if c.RequireClientCert && c.ClientCAFile == "" {
+		return fmt.Errorf("requiring client certificates needs a tls client CA file")
+	}
+	if len(c.AllowedSPIFFEIDs) > 0 && c.ClientCAFile == "" {
+		return fmt.Errorf("tls allowed SPIFFE IDs need a tls client CA file")
+	}
+	for _, id := range c.AllowedSPIFFEIDs {
+		if !strings.HasPrefix(id, "spiffe://") {
+			return fmt.Errorf("tls allowed SPIFFE IDs must start with spiffe://, got %q", id)
+		}
+	}
+	if c.ReloadInterval < 0 {
+		return fmt.Errorf("tls reload interval must be non-negative, got %s", c.ReloadInterval)
+	}
+	return nil
+}
+
+// validate checks the log format, levels and sampling
+func (c LoggingConfig) validate() error {
+	switch c.Format {
+	case LogFormatText, LogFormatJSON, LogFormatCloud:
+	default:
+		return fmt.Errorf("log format must be %q, %q or %q, got %q", LogFormatText, LogFormatJSON, LogFormatCloud, c.Format)
+	}
+	if !logLevels[c.Level] {
+		return fmt.Errorf("log level must be debug, info, warn or error, got %q", c.Level)
+	}
+	for component, level := range c.ComponentLevels {
+		if !logLevels[level] {
+			return fmt.Errorf("log level of component %q must be debug, info, warn or error, got %q", component, level)
+		}
+	}
+	if c.DebugSampleFirst < 0 || c.DebugSampleThereafter < 1 {
+		return fmt.Errorf("log debug sampling needs a non-negative first and a positive thereafter, got %d and %d", c.DebugSampleFirst, c.DebugSampleThereafter)
+	}
+	return nil
+}
+
+// corsMethods are the methods CORS preflights may allow
+var corsMethods = map[string]bool{"GET": true, "HEAD": true, "POST": true, "PUT": true, "PATCH": true, "DELETE": true}
+
+// validate checks that allowed origins are bare scheme://host[:port] origins and methods are known
+func (c HTTPConfig) validate() error {
+	for _, origin := range c.CORS.AllowedOrigins {
+		if origin == "*" {
+			continue
+		}
+		u, err := url.Parse(origin)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || strings.TrimSuffix(u.Path, "/") != "" || u.RawQuery != "" {
+			return fmt.Errorf("http cors allowed origins must be \"*\" or scheme://host[:port], got %q", origin)
+		}
+	}
+	for _, method := range c.CORS.AllowedMethods {
+		if !corsMethods[method] {
+			return fmt.Errorf("http cors allowed methods must be upper-case HTTP methods such as GET or POST, got %q", method)
+		}
+	}
+	if c.CORS.MaxAge < 0 {
+		return fmt.Errorf("http cors max age must be non-negative, got %s", c.CORS.MaxAge)
+	}
+	if c.HSTSMaxAge < 0 {
+		return fmt.Errorf("http hsts max age must be non-negative, got %s", c.HSTSMaxAge)
+	}
+	return nil
+}
+
+// loadFeedTenants reads per-tenant feed settings from a JSON file and fills in their defaults
+func loadFeedTenants(path string) (map[string]FeedTenant, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read feeds file: %w", err)
+	}
+	var tenants map[string]FeedTenant
+	if err := json.Unmarshal(data, &tenants); err != nil {
+		return nil, fmt.Errorf("invalid feeds file %s: %w", path, err)
+	}
+	for tenantID, feed := range tenants {
+		if feed.Channel == "" {
+			feed.Channel = "marketplace"
+		}
+		if feed.ImageMetadataKey == "" {
+			feed.ImageMetadataKey = "image_url"
+		}
+		tenants[tenantID] = feed
+	}
+	return tenants, nil
+}
+
+// validate checks a tenant's feed settings
+func (f FeedTenant) validate() error {
+	if len(f.Formats) == 0 {
+		return fmt.Errorf("at least one format is required")
+	}
+	for _, format := range f.Formats {
+		if format != FeedFormatGoogleMerchant && format != FeedFormatFacebook {
+			return fmt.Errorf("format must be %q or %q, got %q", FeedFormatGoogleMerchant, FeedFormatFacebook, format)
+		}
+	}
+	if len(f.Currency) != 3 {
+		return fmt.Errorf("currency must be an ISO 4217 code, got %q", f.Currency)
+	}
+	if !strings.Contains(f.ProductURL, "{id}") {
+		return fmt.Errorf("product_url must contain {id}, got %q", f.ProductURL)
+	}
+	return nil
+}
+
+// loadFlagRules reads feature flag rules from a JSON file keyed by flag name,
+// e.g. {"search_fuzzy": {"percent": 25, "exclude_tenants": ["acme"]}}
+func loadFlagRules(path string) (map[string]FlagRule, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read feature flags file: %w", err)
+	}
+	var rules map[string]FlagRule
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("invalid feature flags file %s: %w", path, err)
+	}
+	if rules == nil {
+		rules = map[string]FlagRule{}
+	}
+	return rules, nil
+}
+
+// applyFlagEnv switches flags on or off for every tenant from CATALOG_FLAG_<NAME>=true|false,
+// overriding the rule's tenant lists and percentage
+func applyFlagEnv(rules map[string]FlagRule) error {
+	const prefix = "CATALOG_FLAG_"
+	for _, kv := range os.Environ() {
+		key, _, _ := strings.Cut(kv, "=")
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		enabled, err := envBool(key, false)
+		if err != nil {
+			return err
+		}
+		rules[strings.ToLower(strings.TrimPrefix(key, prefix))] = FlagRule{Enabled: enabled}
+	}
+	return nil
+}
+
+// loadSynonyms reads search synonym groups from a JSON file, e.g. [["t-shirt", "tshirt", "tee"]]
+func loadSynonyms(path string) ([][]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read search synonyms: %w", err)
+	}
+	var groups [][]string
+	if err := json.Unmarshal(data, &groups); err != nil {
+		return nil, fmt.Errorf("invalid search synonyms file %s: %w", path, err)
+	}
+	return groups, nil
+}
+
+// envString returns the environment variable value or the fallback if unset
+func envString(key, fallback string) string {
+	if v, ok := os.LookupEnv(key); ok && v != "" {
+		return v
+	}
+	return fallback
+}
+
+// envList splits a comma-separated environment variable, or returns the fallback if unset
+func envList(key string, fallback []string) []string {
+	v, ok := os.LookupEnv(key)
+	if !ok || strings.TrimSpace(v) == "" {
+		return fallback
+	}
+	var values []string
+	for _, item := range strings.Split(v, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			values = append(values, item)
+		}
+	}
+	return values
+}
+
+// envKeyValues parses a comma-separated list of key=value pairs, lower-casing the values, or returns the fallback if unset
+func envKeyValues(key string, fallback map[string]string) (map[string]string, error) {
+	items := envList(key, nil)
+	if items == nil {
+		return fallback, nil
+	}
+	values := make(map[string]string, len(items))
+	for _, item := range items {
+		k, v, ok := strings.Cut(item, "=")
+		if !ok || strings.TrimSpace(k) == "" {
+			return nil, fmt.Errorf("%s must be comma-separated key=value pairs, got %q", key, item)
+		}
+		values[strings.TrimSpace(k)] = strings.ToLower(strings.TrimSpace(v))
+	}
+	return values, nil
+}
+
+// envInt parses an integer environment variable
+func envInt(key string, fallback int) (int, error) {
+	v, ok := os.LookupEnv(key)
+	if !ok || v == "" {
+		return fallback, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	return n, nil
+}
+
+// envInt64 parses a 64-bit integer environment variable
+func envInt64(key string, fallback int64) (int64, error) {
+	v, ok := os.LookupEnv(key)
+	if !ok || v == "" {
+		return fallback, nil
+	}
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	return n, nil
+}
+
+// envFloat parses a floating point environment variable
+func envFloat(key string, fallback float64) (float64, error) {
+	v, ok := os.LookupEnv(key)
+	if !ok || v == "" {
+		return fallback, nil
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	return f, nil
+}
+
+// envDuration parses a duration environment variable (e.g. "30s", "5m")
+func envDuration(key string, fallback time.Duration) (time.Duration, error) {
+	v, ok := os.LookupEnv(key)
+	if !ok || v == "" {
+		return fallback, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	return d, nil
+}
+
+// envBool parses a boolean environment variable
+func envBool(key string, fallback bool) (bool, error) {
+	v, ok := os.LookupEnv(key)
+	if !ok || v == "" {
+		return fallback, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	return b, nil
+}
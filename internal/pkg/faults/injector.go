@@ -0,0 +1,103 @@
+package faults
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"catalog-proj/internal/pkg/metrics"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrNotFound is injected into calls that can miss; decorators translate it to their layer's not-found error
+var ErrNotFound = status.Error(codes.NotFound, "injected fault: not found")
+
+// Settings are the faults injected into each call
+type Settings struct {
+	// Latency delays every call
+	Latency time.Duration
+	// Percentages of calls failing with ABORTED, UNAVAILABLE and not found (together at most 100)
+	AbortPercent       float64
+	UnavailablePercent float64
+	NotFoundPercent    float64
+}
+
+// Validate checks the latency is not negative and the percentages add up to at most 100
+func (s Settings) Validate() error {
+	if s.Latency < 0 {
+		return fmt.Errorf("latency must not be negative, got %s", s.Latency)
+	}
+	for _, percent := range []float64{s.AbortPercent, s.UnavailablePercent, s.NotFoundPercent} {
+		if percent < 0 || percent > 100 {
+			return fmt.Errorf("percentages must be between 0 and 100, got %g", percent)
+		}
+	}
+	if total := s.AbortPercent + s.UnavailablePercent + s.NotFoundPercent; total > 100 {
+		return fmt.Errorf("percentages must add up to at most 100, got %g", total)
+	}
+	return nil
+}
+
+// Injector adds latency and failures to calls so retries, circuit breaking and timeouts can be
+// exercised against a real backend; it is meant for test environments only
+// Settings can be changed while the server runs
+type Injector struct {
+	mu       sync.RWMutex
+	settings Settings
+}
+
+// New creates an injector with the initial settings
+func New(settings Settings) *Injector {
+	return &Injector{
+		settings: settings,
+	}
+}
+
+// Settings returns the current settings
+func (i *Injector) Settings() Settings {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return i.settings
+}
+
+// Set replaces the settings for subsequent calls
+func (i *Injector) Set(settings Settings) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.settings = settings
+}
+
+// Inject delays a call by the configured latency and then decides whether it fails
+// Aborted and unavailable faults are Spanner-style status errors, so they are retried like real ones
+// (an unavailable commit is not retried); calls that cannot miss (canNotFound false) never get ErrNotFound
+func (i *Injector) Inject(ctx context.Context, op string, canNotFound bool) error {
+	settings := i.Settings()
+	if settings.Latency > 0 {
+		timer := time.NewTimer(settings.Latency)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			metrics.Labeled("faults_injected_total").Add(op+".latency", 1)
+			return status.FromContextError(ctx.Err()).Err()
+		case <-timer.C:
+		}
+	}
+
+	roll := rand.Float64() * 100
+	switch {
+	case roll < settings.AbortPercent:
+		metrics.Labeled("faults_injected_total").Add(op+".aborted", 1)
+		return status.Error(codes.Aborted, "injected fault: transaction aborted")
+	case roll < settings.AbortPercent+settings.UnavailablePercent:
+		metrics.Labeled("faults_injected_total").Add(op+".unavailable", 1)
+		return status.Error(codes.Unavailable, "injected fault: backend unavailable")
+	case canNotFound && roll < settings.AbortPercent+settings.UnavailablePercent+settings.NotFoundPercent:
+		metrics.Labeled("faults_injected_total").Add(op+".not_found", 1)
+		return ErrNotFound
+	}
+	return nil
+}
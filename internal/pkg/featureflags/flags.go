@@ -0,0 +1,70 @@
+package featureflags
+
+import (
+	"context"
+	"hash/fnv"
+	"slices"
+
+	"catalog-proj/internal/pkg/tenant"
+)
+
+// Known flags
+const (
+	// SearchFuzzy lets search query words match words a typo or two away
+	SearchFuzzy = "search_fuzzy"
+)
+
+// Flags evaluates feature flags for the tenant of a request
+// Static covers config-driven rollouts; a hosted service such as LaunchDarkly can implement it later
+type Flags interface {
+	Enabled(ctx context.Context, name string) bool
+}
+
+// Rule is a flag's rollout, evaluated per tenant in this order:
+// excluded tenants are off, listed tenants are on, then Percent of the remaining tenants
+// (chosen by a stable hash, so a tenant stays in as the percentage grows), or all of them when Enabled
+type Rule struct {
+	Enabled        bool
+	Tenants        []string
+	ExcludeTenants []string
+	// Percent rolls the flag out to this share of tenants (0-100); 0 leaves it to Enabled
+	Percent float64
+}
+
+// Static evaluates rules fixed at startup; unknown flags are off
+type Static struct {
+	rules map[string]Rule
+}
+
+// NewStatic creates flags from rules keyed by flag name
+func NewStatic(rules map[string]Rule) *Static {
+	return &Static{
+		rules: rules,
+	}
+}
+
+// Enabled reports whether the flag is on for the tenant in ctx
+func (s *Static) Enabled(ctx context.Context, name string) bool {
+	rule, ok := s.rules[name]
+	if !ok {
+		return false
+	}
+	tenantID := tenant.FromContext(ctx)
+	switch {
+	case slices.Contains(rule.ExcludeTenants, tenantID):
+		return false
+	case slices.Contains(rule.Tenants, tenantID):
+		return true
+	case rule.Percent > 0:
+		return bucket(name, tenantID) < rule.Percent
+	default:
+		return rule.Enabled
+	}
+}
+
+// bucket places a tenant in [0, 100) for a flag; each flag buckets tenants independently
+func bucket(name, tenantID string) float64 {
+	h := fnv.New32a()
+	h.Write([]byte(name + "/" + tenantID))
+	return float64(h.Sum32()%10000) / 100
+}
@@ -0,0 +1,78 @@
+package gcs
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2/google"
+)
+
+// DefaultEndpoint is the Cloud Storage JSON API
+const DefaultEndpoint = "https://storage.googleapis.com"
+
+// requestTimeout bounds a single upload
+const requestTimeout = 60 * time.Second
+
+// writeScope lets the client create and replace objects
+const writeScope = "https://www.googleapis.com/auth/devstorage.read_write"
+
+// Client uploads objects to one Cloud Storage bucket over the JSON API
+type Client struct {
+	http     *http.Client
+	endpoint string
+	bucket   string
+}
+
+// NewClient creates a client for bucket
+// With endpoint empty or DefaultEndpoint, requests carry Application Default Credentials; any other
+// endpoint, such as a local fake-gcs-server, is called without credentials
+func NewClient(ctx context.Context, endpoint, bucket string) (*Client, error) {
+	if endpoint == "" {
+		endpoint = DefaultEndpoint
+	}
+	endpoint = strings.TrimRight(endpoint, "/")
+
+	httpClient := &http.Client{}
+	if endpoint == DefaultEndpoint {
+		var err error
+		if httpClient, err = google.DefaultClient(ctx, writeScope); err != nil {
+			return nil, fmt.Errorf("failed to find Cloud Storage credentials: %w", err)
+		}
+	}
+	httpClient.Timeout = requestTimeout
+
+	return &Client{
+		http:     httpClient,
+		endpoint: endpoint,
+		bucket:   bucket,
+	}, nil
+}
+
+// Upload writes data to the object, replacing any previous version
+func (c *Client) Upload(ctx context.Context, object, contentType string, data []byte) error {
+	target := fmt.Sprintf("%s/upload/storage/v1/b/%s/o?uploadType=media&name=%s",
+		c.endpoint, url.PathEscape(c.bucket), url.QueryEscape(object))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to build upload of %s: %w", object, err)
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call Cloud Storage: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	// Only the start of the error body is kept
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	return fmt.Errorf("Cloud Storage upload of %s failed with status %d: %s", object, resp.StatusCode, body)
+}
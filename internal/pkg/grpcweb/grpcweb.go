@@ -0,0 +1,163 @@
+package grpcweb
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"strings"
+
+	"golang.org/x/net/http2"
+	"google.golang.org/grpc"
+)
+
+const (
+	contentTypeWeb     = "application/grpc-web"
+	contentTypeWebText = "application/grpc-web-text"
+	contentTypeGRPC    = "application/grpc"
+
+	// trailerFrame flags the length-prefixed frame that carries the trailers at the end of the body
+	trailerFrame byte = 0x80
+)
+
+// ExposedHeaders are the response headers gRPC-Web clients read, for CORS to expose
+var ExposedHeaders = []string{"Grpc-Status", "Grpc-Message", "Grpc-Status-Details-Bin", "Grpc-Encoding"}
+
+// Handler serves a gRPC server's services to browsers over gRPC-Web, so a browser app can call them
+// without a proxy in front. Requests are translated into gRPC requests for the server's ServeHTTP,
+// so every interceptor applies, and the trailers are returned in the response body
+// Both the binary (application/grpc-web) and base64 (application/grpc-web-text) encodings are accepted
+// CORS is left to the HTTP gateway middleware
+type Handler struct {
+	server *grpc.Server
+}
+
+// New returns a gRPC-Web handler for server
+func New(server *grpc.Server) *Handler {
+	return &Handler{server: server}
+}
+
+// ServeHTTP serves gRPC-Web calls; anything else is refused
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	contentType := r.Header.Get("Content-Type")
+	if r.Method != http.MethodPost || !strings.HasPrefix(contentType, contentTypeWeb) {
+		http.Error(w, "gRPC-Web requests must be POSTs with an application/grpc-web content type", http.StatusUnsupportedMediaType)
+		return
+	}
+	text := strings.HasPrefix(contentType, contentTypeWebText)
+
+	// The gRPC server only serves HTTP/2 gRPC requests, so the request is presented as one
+	req := r.Clone(r.Context())
+	req.ProtoMajor, req.ProtoMinor, req.Proto = 2, 0, "HTTP/2.0"
+	req.Header.Set("Content-Type", contentTypeGRPC+subtype(contentType, text))
+	req.Header.Del("Content-Length")
+	if text {
+		req.Body = struct {
+			io.Reader
+			io.Closer
+		}{base64.NewDecoder(base64.StdEncoding, r.Body), r.Body}
+	}
+
+	rw := newResponseWriter(w, contentType, text)
+	h.server.ServeHTTP(rw, req)
+	rw.finish()
+}
+
+// subtype returns what follows the gRPC-Web content type, such as "+proto"
+func subtype(contentType string, text bool) string {
+	if text {
+		return strings.TrimPrefix(contentType, contentTypeWebText)
+	}
+	return strings.TrimPrefix(contentType, contentTypeWeb)
+}
+
+// responseWriter turns the gRPC server's HTTP/2 response into a gRPC-Web one: the headers are sent
+// as they are, while the trailers, which the server sets on the header map after the body, are
+// collected and written as a trailer frame by finish
+type responseWriter struct {
+	w           http.ResponseWriter
+	header      http.Header
+	body        io.Writer
+	encoder     io.WriteCloser // Set for grpc-web-text, where the body is base64-encoded
+	contentType string
+	status      int
+	sent        map[string]bool
+}
+
+func newResponseWriter(w http.ResponseWriter, contentType string, text bool) *responseWriter {
+	rw := &responseWriter{w: w, header: make(http.Header), body: w, contentType: contentType}
+	if text {
+		rw.encoder = base64.NewEncoder(base64.StdEncoding, w)
+		rw.body = rw.encoder
+	}
+	return rw
+}
+
+func (rw *responseWriter) Header() http.Header {
+	return rw.header
+}
+
+func (rw *responseWriter) WriteHeader(status int) {
+	if rw.status != 0 {
+		return
+	}
+	rw.status = status
+	rw.sent = make(map[string]bool, len(rw.header))
+	for key, values := range rw.header {
+		if key == "Trailer" || strings.HasPrefix(key, http2.TrailerPrefix) {
+			continue
+		}
+		rw.sent[key] = true
+		if key == "Content-Type" && status == http.StatusOK {
+			rw.w.Header().Set(key, rw.contentType)
+			continue
+		}
+		rw.w.Header()[key] = values
+	}
+	rw.w.WriteHeader(status)
+}
+
+func (rw *responseWriter) Write(b []byte) (int, error) {
+	rw.WriteHeader(http.StatusOK)
+	if rw.status != http.StatusOK {
+		// Errors from outside a call, such as a bad content type, are plain text
+		return rw.w.Write(b)
+	}
+	return rw.body.Write(b)
+}
+
+// Flush sends what has been written; grpc-web-text holds back the bytes that do not fill a base64 quantum
+func (rw *responseWriter) Flush() {
+	rw.WriteHeader(http.StatusOK)
+	if f, ok := rw.w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// finish writes the trailers set after the headers were sent as the final frame of the body
+func (rw *responseWriter) finish() {
+	rw.WriteHeader(http.StatusOK)
+	if rw.status != http.StatusOK {
+		return
+	}
+
+	var trailers bytes.Buffer
+	for key, values := range rw.header {
+		name := strings.TrimPrefix(key, http2.TrailerPrefix)
+		if key == "Trailer" || (name == key && rw.sent[key]) {
+			continue
+		}
+		for _, value := range values {
+			trailers.WriteString(strings.ToLower(name) + ": " + value + "\r\n")
+		}
+	}
+	frame := make([]byte, 5, 5+trailers.Len())
+	frame[0] = trailerFrame
+	binary.BigEndian.PutUint32(frame[1:], uint32(trailers.Len()))
+	_, _ = rw.body.Write(append(frame, trailers.Bytes()...))
+	if rw.encoder != nil {
+		_ = rw.encoder.Close()
+	}
+	rw.Flush()
+}
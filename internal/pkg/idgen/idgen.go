@@ -0,0 +1,90 @@
+package idgen
+
+import (
+	"math/bits"
+
+	"github.com/google/uuid"
+)
+
+// Generator creates IDs for rows keyed by a generated ID
+// Every strategy returns a 36-character UUID string, so IDs from different strategies can share a table
+type Generator interface {
+	NewID() string
+}
+
+// Random generates random (version 4) UUIDs, which spread inserts evenly across the key space
+type Random struct{}
+
+// NewRandom creates a random UUID generator
+func NewRandom() *Random {
+	return &Random{}
+}
+
+// NewID returns a new random UUID
+func (Random) NewID() string {
+	return uuid.New().String()
+}
+
+// UUIDv7 generates time-ordered (version 7) UUIDs
+// IDs sort by creation time, which suits IDs that are not the leading key column; as the leading
+// key of a busy table they send every insert to the split holding the newest keys
+type UUIDv7 struct{}
+
+// NewUUIDv7 creates a time-ordered UUID generator
+func NewUUIDv7() *UUIDv7 {
+	return &UUIDv7{}
+}
+
+// NewID returns a new time-ordered UUID
+func (UUIDv7) NewID() string {
+	return uuid.Must(uuid.NewV7()).String()
+}
+
+// BitReversed generates UUIDv7s whose 48-bit millisecond timestamp is bit-reversed
+// The fastest-changing timestamp bits lead, so inserts a millisecond or more apart land far apart in the key space,
+// as with Spanner's bit_reversed_positive sequences; the creation time stays recoverable with
+// Timestamp, and the version and variant bits are kept so the ID is still a well-formed UUID
+type BitReversed struct{}
+
+// NewBitReversed creates a bit-reversed UUIDv7 generator
+func NewBitReversed() *BitReversed {
+	return &BitReversed{}
+}
+
+// NewID returns a new UUIDv7 with its timestamp bits reversed
+func (BitReversed) NewID() string {
+	id := uuid.Must(uuid.NewV7())
+	putTimestamp(&id, reverse48(timestamp(id)))
+	return id.String()
+}
+
+// Timestamp returns the Unix millisecond creation time of a BitReversed ID
+func (BitReversed) Timestamp(id string) (int64, error) {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return 0, err
+	}
+	return int64(reverse48(timestamp(parsed))), nil
+}
+
+// timestamp reads the 48-bit big-endian timestamp at the start of a UUIDv7
+func timestamp(id uuid.UUID) uint64 {
+	var ms uint64
+	for _, b := range id[:6] {
+		ms = ms<<8 | uint64(b)
+	}
+	return ms
+}
+
+// putTimestamp writes a 48-bit timestamp to the start of a UUID
+func putTimestamp(id *uuid.UUID, ms uint64) {
+	for i := 5; i >= 0; i-- {
+		id[i] = byte(ms)
+		ms >>= 8
+	}
+}
+
+// reverse48 reverses the low 48 bits of v
+func reverse48(v uint64) uint64 {
+	return bits.Reverse64(v) >> 16
+}
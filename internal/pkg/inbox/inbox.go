@@ -0,0 +1,129 @@
+package inbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"catalog-proj/internal/models/m_processed_event"
+	"catalog-proj/internal/pkg/clock"
+	"catalog-proj/internal/pkg/metrics"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
+)
+
+// Handler applies a consumer's side effects for one event inside the inbox transaction
+// It may be called more than once if the transaction is retried, so it must only
+// buffer mutations or read through txn
+type Handler func(ctx context.Context, txn *spanner.ReadWriteTransaction) error
+
+// Inbox records the outbox events a consumer has processed in the processed_events table
+// Consumers that write to Spanner use Process, so the side effects and the inbox row
+// commit together and a redelivered event is skipped. Consumers with external side
+// effects (e.g. webhooks) check Seen before delivering and MarkProcessed after, which
+// narrows duplicates to deliveries that fail between the two calls
+type Inbox struct {
+	client   *spanner.Client
+	clock    clock.Clock
+	consumer string
+}
+
+// NewInbox creates an inbox for the named consumer
+// The name keys the consumer's rows, so it must stay stable across deployments
+func NewInbox(client *spanner.Client, clock clock.Clock, consumer string) *Inbox {
+	return &Inbox{
+		client:   client,
+		clock:    clock,
+		consumer: consumer,
+	}
+}
+
+// Process runs handler for the event unless the consumer has already processed it,
+// and reports whether handler's changes were committed
+func (i *Inbox) Process(ctx context.Context, eventID string, handler Handler) (bool, error) {
+	var processed bool
+	_, err := i.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		processed = false
+		seen, err := i.seen(ctx, txn, eventID)
+		if err != nil {
+			return err
+		}
+		if seen {
+			return nil
+		}
+		if err := handler(ctx, txn); err != nil {
+			return err
+		}
+		processed = true
+		return txn.BufferWrite([]*spanner.Mutation{i.row(eventID).InsertMut()})
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to process event %s: %w", eventID, err)
+	}
+	if !processed {
+		metrics.Labeled("inbox_duplicates_skipped_total").Add(i.consumer, 1)
+	}
+	return processed, nil
+}
+
+// Seen reports whether the consumer has already processed the event
+func (i *Inbox) Seen(ctx context.Context, eventID string) (bool, error) {
+	seen, err := i.seen(ctx, i.client.Single(), eventID)
+	if err != nil {
+		return false, err
+	}
+	if seen {
+		metrics.Labeled("inbox_duplicates_skipped_total").Add(i.consumer, 1)
+	}
+	return seen, nil
+}
+
+// MarkProcessed records that the consumer has processed the event
+// Marking an event twice is not an error
+func (i *Inbox) MarkProcessed(ctx context.Context, eventID string) error {
+	_, err := i.client.Apply(ctx, []*spanner.Mutation{i.row(eventID).InsertMut()})
+	if err != nil && spanner.ErrCode(err) != codes.AlreadyExists {
+		return fmt.Errorf("failed to mark event %s processed: %w", eventID, err)
+	}
+	return nil
+}
+
+// Purge deletes the consumer's inbox rows processed before the cutoff and returns how many were deleted
+// Events older than the cutoff must no longer be redelivered, so keep it well beyond
+// the outbox publisher's retry window
+func (i *Inbox) Purge(ctx context.Context, before time.Time) (int64, error) {
+	count, err := i.client.PartitionedUpdate(ctx, spanner.Statement{
+		SQL: fmt.Sprintf(`DELETE FROM %s WHERE %s = @consumer AND %s < @before`,
+			m_processed_event.TableName, m_processed_event.Consumer, m_processed_event.ProcessedAt),
+		Params: map[string]interface{}{"consumer": i.consumer, "before": before},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge processed events: %w", err)
+	}
+	return count, nil
+}
+
+// rowReader is satisfied by both single-use and read-write transactions
+type rowReader interface {
+	ReadRow(ctx context.Context, table string, key spanner.Key, columns []string) (*spanner.Row, error)
+}
+
+func (i *Inbox) seen(ctx context.Context, r rowReader, eventID string) (bool, error) {
+	_, err := r.ReadRow(ctx, m_processed_event.TableName, spanner.Key{i.consumer, eventID}, []string{m_processed_event.EventID})
+	if err == nil {
+		return true, nil
+	}
+	if spanner.ErrCode(err) == codes.NotFound {
+		return false, nil
+	}
+	return false, fmt.Errorf("failed to read processed event %s: %w", eventID, err)
+}
+
+func (i *Inbox) row(eventID string) *m_processed_event.ProcessedEvent {
+	return &m_processed_event.ProcessedEvent{
+		Consumer:    i.consumer,
+		EventID:     eventID,
+		ProcessedAt: i.clock.Now(),
+	}
+}
@@ -0,0 +1,79 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"catalog-proj/internal/models/m_job"
+	"catalog-proj/internal/pkg/clock"
+	"catalog-proj/internal/pkg/tenant"
+
+	"cloud.google.com/go/spanner"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+)
+
+// ErrAlreadyQueued is returned when a pending job with the same unique key exists
+var ErrAlreadyQueued = errors.New("a job with this unique key is already queued")
+
+// EnqueueOptions tunes a single job
+type EnqueueOptions struct {
+	// RunAt delays the job until the given time (zero runs it as soon as possible)
+	RunAt time.Time
+	// MaxAttempts overrides the queue default; use 1 for work that must not be repeated
+	MaxAttempts int
+	// UniqueKey deduplicates pending jobs; it is released once the job starts running
+	UniqueKey string
+}
+
+// Queue enqueues jobs into the jobs table for workers to pick up
+type Queue struct {
+	client             *spanner.Client
+	clock              clock.Clock
+	defaultMaxAttempts int
+}
+
+// NewQueue creates a new job queue
+func NewQueue(client *spanner.Client, clock clock.Clock, defaultMaxAttempts int) *Queue {
+	return &Queue{
+		client:             client,
+		clock:              clock,
+		defaultMaxAttempts: defaultMaxAttempts,
+	}
+}
+
+// Enqueue adds a job of the given kind for the caller's tenant and returns its ID
+func (q *Queue) Enqueue(ctx context.Context, kind string, payload []byte, opts EnqueueOptions) (string, error) {
+	now := q.clock.Now()
+
+	job := &m_job.Job{
+		JobID:       uuid.New().String(),
+		Kind:        kind,
+		TenantID:    tenant.FromContext(ctx),
+		Payload:     payload,
+		Status:      m_job.StatusPending,
+		MaxAttempts: int64(q.defaultMaxAttempts),
+		RunAt:       now,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if opts.MaxAttempts > 0 {
+		job.MaxAttempts = int64(opts.MaxAttempts)
+	}
+	if !opts.RunAt.IsZero() {
+		job.RunAt = opts.RunAt
+	}
+	if opts.UniqueKey != "" {
+		job.UniqueKey = &opts.UniqueKey
+	}
+
+	if _, err := q.client.Apply(ctx, []*spanner.Mutation{job.InsertMut()}); err != nil {
+		if opts.UniqueKey != "" && spanner.ErrCode(err) == codes.AlreadyExists {
+			return "", ErrAlreadyQueued
+		}
+		return "", fmt.Errorf("failed to enqueue %s job: %w", kind, err)
+	}
+	return job.JobID, nil
+}
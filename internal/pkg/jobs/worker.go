@@ -0,0 +1,344 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"os"
+	"sync"
+	"time"
+
+	"catalog-proj/internal/models/m_job"
+	"catalog-proj/internal/pkg/clock"
+	"catalog-proj/internal/pkg/logging"
+	"catalog-proj/internal/pkg/metrics"
+	"catalog-proj/internal/pkg/spannertag"
+	"catalog-proj/internal/pkg/tenant"
+
+	"cloud.google.com/go/spanner"
+	"github.com/google/uuid"
+	"google.golang.org/api/iterator"
+)
+
+// Handler runs one job; returning an error schedules a retry unless it is Permanent
+// or the job has used all its attempts
+type Handler func(ctx context.Context, job *m_job.Job) error
+
+// permanentError marks a job failure that retrying cannot fix
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent wraps err so the job fails immediately instead of being retried
+func Permanent(err error) error {
+	return &permanentError{err: err}
+}
+
+// WorkerConfig tunes the worker loop
+type WorkerConfig struct {
+	// Concurrency is the number of jobs run in parallel by one worker
+	Concurrency int
+	// PollInterval is how often the worker looks for due jobs when idle
+	PollInterval time.Duration
+	// LeaseDuration is how long a claimed job is reserved; running jobs renew it
+	// every third of the duration, and jobs whose lease expires are picked up again
+	LeaseDuration time.Duration
+	// InitialBackoff and MaxBackoff bound the jittered exponential retry delay
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// Worker claims due jobs from the jobs table and runs the registered handlers
+type Worker struct {
+	client *spanner.Client
+	clock  clock.Clock
+	cfg    WorkerConfig
+	owner  string
+	log    *slog.Logger
+
+	mu       sync.RWMutex
+	handlers map[string]Handler
+}
+
+// NewWorker creates a new worker; every worker process gets a unique lease owner ID
+func NewWorker(client *spanner.Client, clock clock.Clock, cfg WorkerConfig) *Worker {
+	if cfg.Concurrency < 1 {
+		cfg.Concurrency = 1
+	}
+	host, _ := os.Hostname()
+	return &Worker{
+		client:   client,
+		clock:    clock,
+		cfg:      cfg,
+		owner:    fmt.Sprintf("%.27s-%s", host, uuid.New().String()),
+		log:      logging.Component("jobs"),
+		handlers: make(map[string]Handler),
+	}
+}
+
+// Register sets the handler for a job kind; only registered kinds are claimed
+func (w *Worker) Register(kind string, handler Handler) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.handlers[kind] = handler
+}
+
+// Run claims and executes jobs until ctx is canceled, then waits for running jobs
+// Jobs interrupted by shutdown are released without consuming an attempt
+func (w *Worker) Run(ctx context.Context) {
+	slots := make(chan struct{}, w.cfg.Concurrency)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	ticker := time.NewTicker(w.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		free := cap(slots) - len(slots)
+		if free > 0 {
+			claimed, err := w.claim(ctx, free)
+			if err != nil && ctx.Err() == nil {
+				w.log.Error("Failed to claim jobs", "error", err)
+			}
+			for _, job := range claimed {
+				slots <- struct{}{}
+				wg.Add(1)
+				go func(job *m_job.Job) {
+					defer wg.Done()
+					defer func() { <-slots }()
+					w.execute(ctx, job)
+				}(job)
+			}
+			// Keep draining the queue while it yields full batches
+			if len(claimed) == free {
+				continue
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// claim leases up to limit due jobs of the registered kinds
+// Jobs whose lease expired after their last attempt are failed instead of claimed
+func (w *Worker) claim(ctx context.Context, limit int) ([]*m_job.Job, error) {
+	w.mu.RLock()
+	kinds := make([]string, 0, len(w.handlers))
+	for kind := range w.handlers {
+		kinds = append(kinds, kind)
+	}
+	w.mu.RUnlock()
+	if len(kinds) == 0 {
+		return nil, nil
+	}
+
+	var claimed []*m_job.Job
+	_, err := w.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		claimed = nil
+		now := w.clock.Now()
+
+		iter := txn.Query(ctx, spanner.Statement{
+			SQL: fmt.Sprintf(`SELECT * FROM %s
+				WHERE kind IN UNNEST(@kinds)
+				  AND ((status = @pending AND run_at <= @now) OR (status = @running AND lease_expires_at < @now))
+				ORDER BY run_at
+				LIMIT @limit`, m_job.TableName),
+			Params: map[string]interface{}{
+				"kinds":   kinds,
+				"pending": m_job.StatusPending,
+				"running": m_job.StatusRunning,
+				"now":     now,
+				"limit":   int64(limit),
+			},
+		})
+		defer iter.Stop()
+
+		var mutations []*spanner.Mutation
+		for {
+			row, err := iter.Next()
+			if err == iterator.Done {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			job := &m_job.Job{}
+			if err := row.ToStruct(job); err != nil {
+				return err
+			}
+
+			if job.Status == m_job.StatusRunning && job.Attempts >= job.MaxAttempts {
+				mutations = append(mutations, w.finishMut(job, m_job.StatusFailed, "lease expired on the last attempt", now, now))
+				metrics.Labeled("jobs_failed").Add(job.Kind, 1)
+				continue
+			}
+
+			leaseExpiresAt := now.Add(w.cfg.LeaseDuration)
+			job.Status = m_job.StatusRunning
+			job.Attempts++
+			job.LeaseOwner = &w.owner
+			job.LeaseExpiresAt = &leaseExpiresAt
+			job.UniqueKey = nil
+			mutations = append(mutations, spanner.Update(m_job.TableName,
+				[]string{m_job.JobID, m_job.Status, m_job.Attempts, m_job.LeaseOwner, m_job.LeaseExpiresAt, m_job.UniqueKey, m_job.UpdatedAt},
+				[]interface{}{job.JobID, job.Status, job.Attempts, job.LeaseOwner, job.LeaseExpiresAt, nil, now},
+			))
+			claimed = append(claimed, job)
+		}
+		return txn.BufferWrite(mutations)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim jobs: %w", err)
+	}
+	return claimed, nil
+}
+
+// execute runs a claimed job, renewing its lease until the handler returns
+func (w *Worker) execute(ctx context.Context, job *m_job.Job) {
+	w.mu.RLock()
+	handler := w.handlers[job.Kind]
+	w.mu.RUnlock()
+
+	jobCtx, cancel := context.WithCancel(spannertag.WithTag(tenant.WithID(ctx, job.TenantID), spannertag.ForJob(job.Kind)))
+	defer cancel()
+
+	stopped := make(chan struct{})
+	renewDone := make(chan struct{})
+	go func() {
+		defer close(renewDone)
+		ticker := time.NewTicker(w.cfg.LeaseDuration / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stopped:
+				return
+			case <-ticker.C:
+				owned, err := w.renew(ctx, job.JobID)
+				if err == nil && !owned {
+					// Another worker took the job over; stop duplicating its work
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+
+	start := w.clock.Now()
+	err := runHandler(jobCtx, handler, job)
+	close(stopped)
+	<-renewDone
+
+	metrics.Labeled("jobs_run_seconds").Add(job.Kind, int64(w.clock.Now().Sub(start).Seconds()))
+	if err := w.complete(context.WithoutCancel(ctx), job, err, ctx.Err() != nil); err != nil {
+		w.log.Error("Failed to record job outcome", "job_id", job.JobID, "kind", job.Kind, "error", err)
+	}
+}
+
+// runHandler runs handler, turning a panic into a permanent failure
+func runHandler(ctx context.Context, handler Handler, job *m_job.Job) (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = Permanent(fmt.Errorf("job panicked: %v", p))
+		}
+	}()
+	return handler(ctx, job)
+}
+
+// renew extends the lease of a job this worker still owns
+func (w *Worker) renew(ctx context.Context, jobID string) (bool, error) {
+	owned := false
+	_, err := w.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		owned = false
+		current, err := w.readLease(ctx, txn, jobID)
+		if err != nil || current != w.owner {
+			return err
+		}
+		owned = true
+		now := w.clock.Now()
+		return txn.BufferWrite([]*spanner.Mutation{spanner.Update(m_job.TableName,
+			[]string{m_job.JobID, m_job.LeaseExpiresAt, m_job.UpdatedAt},
+			[]interface{}{jobID, now.Add(w.cfg.LeaseDuration), now},
+		)})
+	})
+	return owned, err
+}
+
+// complete records the handler outcome if this worker still owns the job
+func (w *Worker) complete(ctx context.Context, job *m_job.Job, runErr error, shuttingDown bool) error {
+	_, err := w.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		current, err := w.readLease(ctx, txn, job.JobID)
+		if err != nil || current != w.owner {
+			return err
+		}
+
+		now := w.clock.Now()
+		var permanent *permanentError
+		switch {
+		case runErr == nil:
+			metrics.Labeled("jobs_succeeded").Add(job.Kind, 1)
+			return txn.BufferWrite([]*spanner.Mutation{w.finishMut(job, m_job.StatusSucceeded, "", now, now)})
+		case shuttingDown && errors.Is(runErr, context.Canceled):
+			// Interrupted by shutdown: hand the job back without spending the attempt
+			return txn.BufferWrite([]*spanner.Mutation{spanner.Update(m_job.TableName,
+				[]string{m_job.JobID, m_job.Status, m_job.Attempts, m_job.LeaseOwner, m_job.LeaseExpiresAt, m_job.UpdatedAt},
+				[]interface{}{job.JobID, m_job.StatusPending, job.Attempts - 1, nil, nil, now},
+			)})
+		case errors.As(runErr, &permanent) || job.Attempts >= job.MaxAttempts:
+			metrics.Labeled("jobs_failed").Add(job.Kind, 1)
+			w.log.Error("Job failed", "job_id", job.JobID, "kind", job.Kind, "attempts", job.Attempts, "error", runErr)
+			return txn.BufferWrite([]*spanner.Mutation{w.finishMut(job, m_job.StatusFailed, runErr.Error(), now, now)})
+		default:
+			metrics.Labeled("jobs_retried").Add(job.Kind, 1)
+			return txn.BufferWrite([]*spanner.Mutation{w.finishMut(job, m_job.StatusPending, runErr.Error(), now.Add(w.backoff(job.Attempts)), now)})
+		}
+	})
+	return err
+}
+
+// finishMut moves a job to status, clearing its lease
+func (w *Worker) finishMut(job *m_job.Job, status, lastError string, runAt, now time.Time) *spanner.Mutation {
+	var errValue *string
+	if lastError != "" {
+		errValue = &lastError
+	}
+	return spanner.Update(m_job.TableName,
+		[]string{m_job.JobID, m_job.Status, m_job.RunAt, m_job.LeaseOwner, m_job.LeaseExpiresAt, m_job.LastError, m_job.UpdatedAt},
+		[]interface{}{job.JobID, status, runAt, nil, nil, errValue, now},
+	)
+}
+
+// readLease returns the current lease owner of a job ("" when unleased)
+func (w *Worker) readLease(ctx context.Context, txn *spanner.ReadWriteTransaction, jobID string) (string, error) {
+	row, err := txn.ReadRow(ctx, m_job.TableName, spanner.Key{jobID}, []string{m_job.LeaseOwner})
+	if err != nil {
+		return "", err
+	}
+	var owner spanner.NullString
+	if err := row.Columns(&owner); err != nil {
+		return "", err
+	}
+	return owner.StringVal, nil
+}
+
+// backoff returns the jittered delay before retrying after the given attempt
+func (w *Worker) backoff(attempt int64) time.Duration {
+	delay := w.cfg.InitialBackoff
+	for i := int64(1); i < attempt && delay < w.cfg.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > w.cfg.MaxBackoff {
+		delay = w.cfg.MaxBackoff
+	}
+	// Full jitter over the upper half spreads retries of jobs that failed together
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
@@ -0,0 +1,179 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// ComponentKey is the attribute naming the component a logger belongs to; per-component levels match its value
+const ComponentKey = "component"
+
+// Output formats
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+	// FormatCloud is JSON with the field names Cloud Logging reads: severity and message
+	FormatCloud = "cloud"
+)
+
+// Settings controls the process-wide logger
+type Settings struct {
+	Format string
+	// Level is the minimum level logged by components without their own level
+	Level slog.Level
+	// ComponentLevels overrides Level for loggers created with Component
+	ComponentLevels map[string]slog.Level
+	// SampleFirst and SampleThereafter sample debug records so hot paths cannot flood the output:
+	// each message's first SampleFirst records in a second are logged, then every SampleThereafter-th
+	// SampleFirst 0 disables sampling
+	SampleFirst      int
+	SampleThereafter int
+}
+
+// ParseLevel parses debug, info, warn or error, case-insensitively
+func ParseLevel(s string) (slog.Level, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(s)); err != nil {
+		return 0, fmt.Errorf("unknown log level %q", s)
+	}
+	return level, nil
+}
+
+// New returns a logger writing to w with settings
+func New(w io.Writer, settings Settings) *slog.Logger {
+	// The inner handler takes the lowest configured level; each component's own level is checked in front of it
+	minLevel := settings.Level
+	for _, level := range settings.ComponentLevels {
+		minLevel = min(minLevel, level)
+	}
+	opts := &slog.HandlerOptions{Level: minLevel}
+
+	var inner slog.Handler
+	switch settings.Format {
+	case FormatJSON:
+		inner = slog.NewJSONHandler(w, opts)
+	case FormatCloud:
+		opts.ReplaceAttr = cloudAttr
+		inner = slog.NewJSONHandler(w, opts)
+	default:
+		inner = slog.NewTextHandler(w, opts)
+	}
+
+	var s *sampler
+	if settings.SampleFirst > 0 {
+		s = &sampler{first: settings.SampleFirst, thereafter: max(settings.SampleThereafter, 1), counts: make(map[string]*sampleCount)}
+	}
+	return slog.New(&handler{inner: inner, level: settings.Level, levels: settings.ComponentLevels, sampler: s})
+}
+
+// Component returns a logger of the default logger tagged with the component's name, so it gets the
+// component's level. It must be called after the default logger is set up, e.g. in a constructor
+func Component(name string) *slog.Logger {
+	return slog.Default().With(ComponentKey, name)
+}
+
+// handler filters records by their component's level and samples debug records
+type handler struct {
+	inner   slog.Handler
+	level   slog.Level
+	levels  map[string]slog.Level
+	sampler *sampler
+}
+
+func (h *handler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= h.level && h.inner.Enabled(ctx, level)
+}
+
+func (h *handler) Handle(ctx context.Context, r slog.Record) error {
+	if r.Level <= slog.LevelDebug && h.sampler != nil && !h.sampler.keep(r.Message, r.Time) {
+		return nil
+	}
+	return h.inner.Handle(ctx, r)
+}
+
+func (h *handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	next := *h
+	next.inner = h.inner.WithAttrs(attrs)
+	for _, attr := range attrs {
+		if attr.Key != ComponentKey {
+			continue
+		}
+		if level, ok := h.levels[attr.Value.String()]; ok {
+			next.level = level
+		}
+	}
+	return &next
+}
+
+func (h *handler) WithGroup(name string) slog.Handler {
+	next := *h
+	next.inner = h.inner.WithGroup(name)
+	return &next
+}
+
+// sampler counts records per message in one-second windows
+type sampler struct {
+	first      int
+	thereafter int
+
+	mu     sync.Mutex
+	counts map[string]*sampleCount
+}
+
+type sampleCount struct {
+	second int64
+	n      int
+}
+
+// keep reports whether a record with msg logged at t is kept
+func (s *sampler) keep(msg string, t time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	second := t.Unix()
+	c, ok := s.counts[msg]
+	if !ok {
+		c = &sampleCount{}
+		s.counts[msg] = c
+	}
+	if c.second != second {
+		c.second, c.n = second, 0
+	}
+	c.n++
+	return c.n <= s.first || (c.n-s.first)%s.thereafter == 0
+}
+
+// cloudAttr renames the level and message for Cloud Logging, which reads severity and message from JSON logs
+func cloudAttr(groups []string, a slog.Attr) slog.Attr {
+	if len(groups) > 0 {
+		return a
+	}
+	switch a.Key {
+	case slog.LevelKey:
+		level, _ := a.Value.Any().(slog.Level)
+		return slog.String("severity", cloudSeverity(level))
+	case slog.MessageKey:
+		return slog.Attr{Key: "message", Value: a.Value}
+	}
+	return a
+}
+
+// cloudSeverity maps a level onto Cloud Logging's LogSeverity names
+func cloudSeverity(level slog.Level) string {
+	switch {
+	case level < slog.LevelInfo:
+		return "DEBUG"
+	case level < slog.LevelWarn:
+		return "INFO"
+	case level < slog.LevelError:
+		return "WARNING"
+	case level < slog.LevelError+4:
+		return "ERROR"
+	default:
+		return "CRITICAL"
+	}
+}
@@ -0,0 +1,340 @@
+package lro
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"catalog-proj/internal/models/m_job"
+	"catalog-proj/internal/models/m_operation"
+	"catalog-proj/internal/pkg/clock"
+	"catalog-proj/internal/pkg/jobs"
+	"catalog-proj/internal/pkg/metrics"
+	"catalog-proj/internal/pkg/tenant"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	// namePrefix is the collection of operation resource names: operations/{id}
+	namePrefix = "operations/"
+	// heartbeatInterval is how often running operations persist progress and check for cancellation
+	heartbeatInterval = 2 * time.Second
+	// staleAfter is how long an operation may go without a heartbeat before it is reported
+	// as aborted; it also covers time spent queued behind other jobs
+	staleAfter = 5 * time.Minute
+
+	// JobKind is the job kind operations are executed under; register HandleJob for it
+	JobKind = "operation"
+)
+
+// ErrInvalidName is returned for names not of the form operations/{id}
+var ErrInvalidName = errors.New("operation name must have the form operations/{operation}")
+
+// Task is the work behind an operation kind; it receives the request passed to Start,
+// reports per-item progress and returns the operation response, or an error (a gRPC
+// status error keeps its code)
+type Task func(ctx context.Context, request proto.Message, progress *Progress) (proto.Message, error)
+
+// Progress counts processed items of a running task; safe for concurrent use
+type Progress struct {
+	processed  atomic.Int64
+	failed     atomic.Int64
+	checkpoint atomic.Pointer[string]
+}
+
+// Item records one processed item
+func (p *Progress) Item(ok bool) {
+	p.processed.Add(1)
+	if !ok {
+		p.failed.Add(1)
+	}
+}
+
+// Checkpoint records where the task got to; it is persisted with the progress counters
+// and reported in the operation metadata, so a failed run can be resumed from it
+func (p *Progress) Checkpoint(checkpoint string) {
+	p.checkpoint.Store(&checkpoint)
+}
+
+// snapshot reads the progress for persisting
+func (p *Progress) snapshot() Snapshot {
+	s := Snapshot{Processed: p.processed.Load(), Failed: p.failed.Load()}
+	if cp := p.checkpoint.Load(); cp != nil {
+		s.Checkpoint = *cp
+	}
+	return s
+}
+
+// jobPayload is what an operation job carries through the queue
+type jobPayload struct {
+	OperationID string `json:"operation_id"`
+	// Request is the task input, serialized as a google.protobuf.Any
+	Request []byte `json:"request"`
+}
+
+// Runner runs tasks on the job queue and tracks them as persisted operations
+type Runner struct {
+	store Store
+	queue *jobs.Queue
+	clock clock.Clock
+
+	mu    sync.RWMutex
+	tasks map[string]Task
+}
+
+// NewRunner creates a new operation runner
+func NewRunner(store Store, queue *jobs.Queue, clock clock.Clock) *Runner {
+	return &Runner{
+		store: store,
+		queue: queue,
+		clock: clock,
+		tasks: make(map[string]Task),
+	}
+}
+
+// Register sets the task run for operations of the given kind
+func (r *Runner) Register(kind string, task Task) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.tasks[kind] = task
+}
+
+// Name formats the resource name of an operation
+func Name(id string) string {
+	return namePrefix + id
+}
+
+// ParseName extracts the operation ID from a resource name
+func ParseName(name string) (string, error) {
+	id, ok := strings.CutPrefix(name, namePrefix)
+	if !ok || id == "" || strings.Contains(id, "/") {
+		return "", ErrInvalidName
+	}
+	return id, nil
+}
+
+// Start persists a new operation for the caller's tenant and enqueues its task
+// The request is stored with the job, so any worker can run it; operations are
+// attempted once since their tasks are not assumed to be idempotent
+func (r *Runner) Start(ctx context.Context, kind string, total int, request proto.Message) (string, error) {
+	r.mu.RLock()
+	_, ok := r.tasks[kind]
+	r.mu.RUnlock()
+	if !ok {
+		return "", fmt.Errorf("no task registered for operation kind %q", kind)
+	}
+
+	requestAny, err := marshalAny(request)
+	if err != nil {
+		return "", err
+	}
+
+	now := r.clock.Now()
+	op := &m_operation.Operation{
+		OperationID: uuid.New().String(),
+		TenantID:    tenant.FromContext(ctx),
+		Kind:        kind,
+		TotalItems:  int64(total),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	payload, err := json.Marshal(jobPayload{OperationID: op.OperationID, Request: requestAny})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode operation job: %w", err)
+	}
+
+	if err := r.store.Create(ctx, op); err != nil {
+		return "", err
+	}
+	if _, err := r.queue.Enqueue(ctx, JobKind, payload, jobs.EnqueueOptions{MaxAttempts: 1}); err != nil {
+		_ = r.store.Finish(ctx, op.OperationID, Snapshot{}, codes.Unavailable, "failed to queue operation", nil, r.clock.Now())
+		return "", err
+	}
+
+	metrics.Labeled("operations_started").Add(kind, 1)
+	return Name(op.OperationID), nil
+}
+
+// HandleJob is the job handler for JobKind: it runs the operation's task, heartbeating
+// progress until it returns, then records the outcome on the operation
+func (r *Runner) HandleJob(ctx context.Context, job *m_job.Job) error {
+	var payload jobPayload
+	if err := json.Unmarshal(job.Payload, &payload); err != nil {
+		return jobs.Permanent(fmt.Errorf("invalid operation job payload: %w", err))
+	}
+	op, err := r.store.Get(ctx, payload.OperationID)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			// Deleted before it ran; nothing left to report to
+			return nil
+		}
+		return err
+	}
+	if op.Done {
+		return nil
+	}
+
+	r.mu.RLock()
+	task, ok := r.tasks[op.Kind]
+	r.mu.RUnlock()
+	if !ok {
+		return jobs.Permanent(fmt.Errorf("no task registered for operation kind %q", op.Kind))
+	}
+
+	var requestAny anypb.Any
+	if err := proto.Unmarshal(payload.Request, &requestAny); err != nil {
+		return jobs.Permanent(fmt.Errorf("invalid operation request: %w", err))
+	}
+	request, err := requestAny.UnmarshalNew()
+	if err != nil {
+		return jobs.Permanent(fmt.Errorf("invalid operation request: %w", err))
+	}
+
+	taskCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	progress := &Progress{}
+	stopped := make(chan struct{})
+	heartbeatDone := make(chan struct{})
+	go func() {
+		defer close(heartbeatDone)
+		ticker := time.NewTicker(heartbeatInterval)
+		defer ticker.Stop()
+		for {
+			cancelRequested, err := r.store.Heartbeat(ctx, op.OperationID, progress.snapshot(), r.clock.Now())
+			if err == nil && cancelRequested {
+				cancel()
+			}
+			select {
+			case <-stopped:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	result, err := runTask(taskCtx, task, request, progress)
+	close(stopped)
+	<-heartbeatDone
+
+	code, message := codes.OK, ""
+	var resultAny []byte
+	if err != nil {
+		st := status.Convert(err)
+		switch {
+		case ctx.Err() != nil:
+			// The worker is shutting down; the task may have been partially applied, so
+			// the operation is aborted rather than silently re-run
+			st = status.New(codes.Aborted, "operation was interrupted by a server shutdown; retry the request")
+		case errors.Is(err, context.Canceled):
+			st = status.New(codes.Canceled, "operation was cancelled")
+		}
+		code, message = st.Code(), st.Message()
+	} else if result != nil {
+		if resultAny, err = marshalAny(result); err != nil {
+			code, message = codes.Internal, err.Error()
+		}
+	}
+
+	finishCtx := context.WithoutCancel(ctx)
+	if err := r.store.Finish(finishCtx, op.OperationID, progress.snapshot(), code, message, resultAny, r.clock.Now()); err != nil {
+		metrics.Labeled("operations_finish_errors").Add(op.Kind, 1)
+		return err
+	}
+	metrics.Labeled("operations_finished").Add(code.String(), 1)
+	return nil
+}
+
+// runTask runs task, turning a panic into an Internal error so the operation still finishes
+func runTask(ctx context.Context, task Task, request proto.Message, progress *Progress) (result proto.Message, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = status.Errorf(codes.Internal, "operation panicked: %v", p)
+		}
+	}()
+	return task(ctx, request, progress)
+}
+
+// Get returns the caller's operation; running operations without a recent heartbeat are
+// finished as ABORTED so clients never poll forever after a server restart
+func (r *Runner) Get(ctx context.Context, name string) (*m_operation.Operation, error) {
+	op, err := r.get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	now := r.clock.Now()
+	if !op.Done && now.Sub(op.UpdatedAt) > staleAfter {
+		message := fmt.Sprintf("operation made no progress for %s and was abandoned; retry the request", staleAfter)
+		progress := Snapshot{Processed: op.ProcessedItems, Failed: op.FailedItems}
+		if op.Checkpoint != nil {
+			progress.Checkpoint = *op.Checkpoint
+		}
+		if err := r.store.Finish(ctx, op.OperationID, progress, codes.Aborted, message, nil, now); err != nil {
+			return nil, err
+		}
+		return r.store.Get(ctx, op.OperationID)
+	}
+	return op, nil
+}
+
+// List returns a page of the caller's operations, newest first
+func (r *Runner) List(ctx context.Context, limit, offset int) ([]*m_operation.Operation, error) {
+	return r.store.List(ctx, tenant.FromContext(ctx), limit, offset)
+}
+
+// Cancel requests cancellation of a running operation; done operations are left unchanged
+func (r *Runner) Cancel(ctx context.Context, name string) error {
+	op, err := r.get(ctx, name)
+	if err != nil {
+		return err
+	}
+	if op.Done {
+		return nil
+	}
+	return r.store.RequestCancel(ctx, op.OperationID)
+}
+
+// Delete removes the caller's operation record (a running task is not stopped)
+func (r *Runner) Delete(ctx context.Context, name string) error {
+	op, err := r.get(ctx, name)
+	if err != nil {
+		return err
+	}
+	return r.store.Delete(ctx, op.OperationID)
+}
+
+// get reads an operation by name, hiding operations of other tenants
+func (r *Runner) get(ctx context.Context, name string) (*m_operation.Operation, error) {
+	id, err := ParseName(name)
+	if err != nil {
+		return nil, err
+	}
+	op, err := r.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if op.TenantID != tenant.FromContext(ctx) {
+		return nil, ErrNotFound
+	}
+	return op, nil
+}
+
+// marshalAny serializes msg wrapped in a google.protobuf.Any
+func marshalAny(msg proto.Message) ([]byte, error) {
+	a, err := anypb.New(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wrap %s: %w", msg.ProtoReflect().Descriptor().FullName(), err)
+	}
+	return proto.Marshal(a)
+}
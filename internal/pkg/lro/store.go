@@ -0,0 +1,195 @@
+package lro
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"catalog-proj/internal/models/m_operation"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+)
+
+// ErrNotFound is returned for unknown operations and operations of another tenant
+var ErrNotFound = errors.New("operation not found")
+
+// Store persists operation state
+type Store interface {
+	Create(ctx context.Context, op *m_operation.Operation) error
+	Get(ctx context.Context, id string) (*m_operation.Operation, error)
+	List(ctx context.Context, tenantID string, limit, offset int) ([]*m_operation.Operation, error)
+	// Heartbeat records progress of a running operation and reports whether cancellation was requested
+	Heartbeat(ctx context.Context, id string, progress Snapshot, now time.Time) (bool, error)
+	// Finish marks the operation done with either an error (code != OK) or a result
+	Finish(ctx context.Context, id string, progress Snapshot, code codes.Code, message string, result []byte, now time.Time) error
+	RequestCancel(ctx context.Context, id string) error
+	Delete(ctx context.Context, id string) error
+}
+
+// Snapshot is the progress of an operation as persisted by the store
+type Snapshot struct {
+	Processed int64
+	Failed    int64
+	// Checkpoint is empty until the task reports one
+	Checkpoint string
+}
+
+// SpannerStore implements Store using the operations table
+type SpannerStore struct {
+	client *spanner.Client
+}
+
+// NewSpannerStore creates a new Spanner operation store
+func NewSpannerStore(client *spanner.Client) *SpannerStore {
+	return &SpannerStore{
+		client: client,
+	}
+}
+
+// Create inserts a new operation
+func (s *SpannerStore) Create(ctx context.Context, op *m_operation.Operation) error {
+	if _, err := s.client.Apply(ctx, []*spanner.Mutation{op.InsertMut()}); err != nil {
+		return fmt.Errorf("failed to create operation: %w", err)
+	}
+	return nil
+}
+
+// Get reads an operation by ID
+func (s *SpannerStore) Get(ctx context.Context, id string) (*m_operation.Operation, error) {
+	row, err := s.client.Single().ReadRow(ctx, m_operation.TableName, spanner.Key{id}, m_operation.AllColumns())
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get operation: %w", err)
+	}
+
+	op := &m_operation.Operation{}
+	if err := row.ToStruct(op); err != nil {
+		return nil, fmt.Errorf("failed to parse operation row: %w", err)
+	}
+	return op, nil
+}
+
+// List returns a page of the tenant's operations, newest first
+func (s *SpannerStore) List(ctx context.Context, tenantID string, limit, offset int) ([]*m_operation.Operation, error) {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(`SELECT * FROM %s
+			WHERE tenant_id = @tenant
+			ORDER BY created_at DESC
+			LIMIT @limit OFFSET @offset`, m_operation.TableName),
+		Params: map[string]interface{}{"tenant": tenantID, "limit": int64(limit), "offset": int64(offset)},
+	}
+
+	iter := s.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var ops []*m_operation.Operation
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list operations: %w", err)
+		}
+
+		op := &m_operation.Operation{}
+		if err := row.ToStruct(op); err != nil {
+			return nil, fmt.Errorf("failed to parse operation row: %w", err)
+		}
+		ops = append(ops, op)
+	}
+	return ops, nil
+}
+
+// Heartbeat updates the progress counters, checkpoint and updated_at of a running operation
+func (s *SpannerStore) Heartbeat(ctx context.Context, id string, progress Snapshot, now time.Time) (bool, error) {
+	var cancelRequested bool
+	_, err := s.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		row, err := txn.ReadRow(ctx, m_operation.TableName, spanner.Key{id}, []string{m_operation.Done, m_operation.CancelRequested})
+		if err != nil {
+			return err
+		}
+		var done bool
+		if err := row.Columns(&done, &cancelRequested); err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+		return txn.BufferWrite([]*spanner.Mutation{spanner.Update(m_operation.TableName,
+			[]string{m_operation.OperationID, m_operation.ProcessedItems, m_operation.FailedItems, m_operation.Checkpoint, m_operation.UpdatedAt},
+			[]interface{}{id, progress.Processed, progress.Failed, checkpointValue(progress.Checkpoint), now},
+		)})
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to record operation progress: %w", err)
+	}
+	return cancelRequested, nil
+}
+
+// Finish marks an operation done; it is a no-op for operations that are already done
+func (s *SpannerStore) Finish(ctx context.Context, id string, progress Snapshot, code codes.Code, message string, result []byte, now time.Time) error {
+	var errorCode *int64
+	var errorMessage *string
+	if code != codes.OK {
+		c := int64(code)
+		errorCode = &c
+		errorMessage = &message
+		result = nil
+	}
+
+	_, err := s.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		row, err := txn.ReadRow(ctx, m_operation.TableName, spanner.Key{id}, []string{m_operation.Done})
+		if err != nil {
+			return err
+		}
+		var done bool
+		if err := row.Columns(&done); err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+		return txn.BufferWrite([]*spanner.Mutation{spanner.Update(m_operation.TableName,
+			[]string{
+				m_operation.OperationID, m_operation.Done, m_operation.ProcessedItems, m_operation.FailedItems,
+				m_operation.Checkpoint, m_operation.ErrorCode, m_operation.ErrorMessage, m_operation.Result, m_operation.UpdatedAt,
+			},
+			[]interface{}{id, true, progress.Processed, progress.Failed, checkpointValue(progress.Checkpoint), errorCode, errorMessage, result, now},
+		)})
+	})
+	if err != nil {
+		return fmt.Errorf("failed to finish operation: %w", err)
+	}
+	return nil
+}
+
+// RequestCancel flags an operation for cancellation; the runner stops it at its next heartbeat
+func (s *SpannerStore) RequestCancel(ctx context.Context, id string) error {
+	_, err := s.client.Apply(ctx, []*spanner.Mutation{spanner.Update(m_operation.TableName,
+		[]string{m_operation.OperationID, m_operation.CancelRequested},
+		[]interface{}{id, true},
+	)})
+	if err != nil {
+		return fmt.Errorf("failed to cancel operation: %w", err)
+	}
+	return nil
+}
+
+// Delete removes an operation
+func (s *SpannerStore) Delete(ctx context.Context, id string) error {
+	if _, err := s.client.Apply(ctx, []*spanner.Mutation{spanner.Delete(m_operation.TableName, spanner.Key{id})}); err != nil {
+		return fmt.Errorf("failed to delete operation: %w", err)
+	}
+	return nil
+}
+
+// checkpointValue stores an empty checkpoint as NULL
+func checkpointValue(checkpoint string) spanner.NullString {
+	return spanner.NullString{StringVal: checkpoint, Valid: checkpoint != ""}
+}
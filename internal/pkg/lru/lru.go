@@ -0,0 +1,82 @@
+package lru
+
+import (
+	"container/list"
+	"sync"
+)
+
+// Cache is a fixed-size, concurrency-safe least-recently-used cache
+type Cache[K comparable, V any] struct {
+	mu         sync.Mutex
+	maxEntries int
+	ll         *list.List
+	items      map[K]*list.Element
+}
+
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// New creates a cache holding at most maxEntries items (minimum 1)
+func New[K comparable, V any](maxEntries int) *Cache[K, V] {
+	if maxEntries < 1 {
+		maxEntries = 1
+	}
+	return &Cache[K, V]{
+		maxEntries: maxEntries,
+		ll:         list.New(),
+		items:      make(map[K]*list.Element),
+	}
+}
+
+// Get returns the cached value for key and marks it as recently used
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		c.ll.MoveToFront(el)
+		return el.Value.(*entry[K, V]).value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Add stores value under key, evicting the least recently used entry when full
+func (c *Cache[K, V]) Add(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		c.ll.MoveToFront(el)
+		el.Value.(*entry[K, V]).value = value
+		return
+	}
+
+	c.items[key] = c.ll.PushFront(&entry[K, V]{key: key, value: value})
+	if c.ll.Len() > c.maxEntries {
+		oldest := c.ll.Back()
+		c.ll.Remove(oldest)
+		delete(c.items, oldest.Value.(*entry[K, V]).key)
+	}
+}
+
+// Remove deletes key from the cache
+func (c *Cache[K, V]) Remove(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		c.ll.Remove(el)
+		delete(c.items, key)
+	}
+}
+
+// Len returns the number of cached entries
+func (c *Cache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.ll.Len()
+}
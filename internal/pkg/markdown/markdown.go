@@ -0,0 +1,327 @@
+package markdown
+
+import (
+	"errors"
+	"html"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// MaxListDepth bounds how deeply lists may nest
+const MaxListDepth = 3
+
+var (
+	// ErrUnclosedCodeBlock is returned for a ``` fence without a closing fence
+	ErrUnclosedCodeBlock = errors.New("markdown code block is not closed")
+	// ErrListTooDeep is returned for lists nested deeper than MaxListDepth
+	ErrListTooDeep = errors.New("markdown lists nest too deeply")
+	// ErrEmptyHeading is returned for a heading marker without text
+	ErrEmptyHeading = errors.New("markdown heading has no text")
+	// ErrUnsafeLink is returned for a link whose target is not an http, https or mailto URL
+	ErrUnsafeLink = errors.New("markdown link target must be an http, https or mailto URL")
+)
+
+// Validate reports the first structural problem in src: an unclosed code block, lists nested
+// deeper than MaxListDepth, an empty heading or an unsafe link
+func Validate(src string) error {
+	r := &renderer{}
+	r.render(r.parse(src))
+	return r.err
+}
+
+// ToHTML renders src as HTML
+// The supported subset is paragraphs, headings, fenced code blocks, block quotes, rules, lists,
+// emphasis, inline code and links; all other text, including HTML, is escaped, and unsafe links
+// are rendered as their text, so the output is safe to embed even when src does not validate
+func ToHTML(src string) string {
+	r := &renderer{}
+	r.render(r.parse(src))
+	return strings.TrimSuffix(r.b.String(), "\n")
+}
+
+// PlainToHTML renders plain text as escaped paragraphs, split at blank lines, with line breaks kept
+func PlainToHTML(src string) string {
+	var b strings.Builder
+	for _, para := range blankLines.Split(strings.ReplaceAll(strings.TrimSpace(src), "\r\n", "\n"), -1) {
+		if para = strings.TrimSpace(para); para == "" {
+			continue
+		}
+		b.WriteString("<p>" + strings.ReplaceAll(html.EscapeString(para), "\n", "<br>\n") + "</p>\n")
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+type kind int
+
+const (
+	paragraph kind = iota
+	heading
+	codeBlock
+	quote
+	rule
+	listItem
+)
+
+// block is one block-level element; text is inline markdown, or raw text for code blocks
+type block struct {
+	kind    kind
+	level   int  // heading level, or list depth counted from 1
+	ordered bool // list items of numbered lists
+	text    string
+}
+
+type renderer struct {
+	b   strings.Builder
+	err error
+}
+
+// fail records the first problem found
+func (r *renderer) fail(err error) {
+	if r.err == nil {
+		r.err = err
+	}
+}
+
+var (
+	blankLines     = regexp.MustCompile(`\n[ \t]*\n`)
+	headingPattern = regexp.MustCompile(`^(#{1,6})(?:[ \t]+(.*?))?(?:[ \t]+#+)?$`)
+	rulePattern    = regexp.MustCompile(`^(?:(?:-[ \t]*){3,}|(?:\*[ \t]*){3,}|(?:_[ \t]*){3,})$`)
+	itemPattern    = regexp.MustCompile(`^(?:([-*+])|(\d{1,9})\.)[ \t]+(.*)$`)
+)
+
+// parse splits src into blocks, recording structural problems
+func (r *renderer) parse(src string) []block {
+	var blocks []block
+	var indents []int // marker indentation of the open lists, outermost first
+	blank := false
+	lines := strings.Split(strings.ReplaceAll(src, "\r\n", "\n"), "\n")
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimRight(lines[i], " \t")
+		trimmed := strings.TrimLeft(line, " \t")
+		indent := len(line) - len(trimmed)
+		var last *block
+		if len(blocks) > 0 {
+			last = &blocks[len(blocks)-1]
+		}
+
+		if trimmed == "" {
+			blank = true
+			continue
+		}
+		next := block{kind: paragraph, text: trimmed}
+		switch m := headingPattern.FindStringSubmatch(trimmed); {
+		case strings.HasPrefix(trimmed, "```"):
+			var code []string
+			closed := false
+			for i++; i < len(lines); i++ {
+				if strings.HasPrefix(strings.TrimSpace(lines[i]), "```") {
+					closed = true
+					break
+				}
+				code = append(code, lines[i])
+			}
+			if !closed {
+				r.fail(ErrUnclosedCodeBlock)
+			}
+			next = block{kind: codeBlock, text: strings.Join(code, "\n")}
+		case m != nil:
+			if m[2] == "" {
+				r.fail(ErrEmptyHeading)
+			}
+			next = block{kind: heading, level: len(m[1]), text: m[2]}
+		case rulePattern.MatchString(trimmed):
+			next = block{kind: rule}
+		case strings.HasPrefix(trimmed, ">"):
+			text := strings.TrimPrefix(strings.TrimPrefix(trimmed, ">"), " ")
+			if last != nil && last.kind == quote && !blank {
+				last.text += "\n" + text
+				continue
+			}
+			next = block{kind: quote, text: text}
+		default:
+			if m := itemPattern.FindStringSubmatch(trimmed); m != nil {
+				for len(indents) > 0 && indent < indents[len(indents)-1] {
+					indents = indents[:len(indents)-1]
+				}
+				if len(indents) == 0 || indent > indents[len(indents)-1] {
+					indents = append(indents, indent)
+				}
+				if len(indents) > MaxListDepth {
+					r.fail(ErrListTooDeep)
+				}
+				blocks = append(blocks, block{kind: listItem, level: len(indents), ordered: m[2] != "", text: m[3]})
+				blank = false
+				continue
+			}
+			// Lines that start no block continue the paragraph, list item or quote above them
+			if last != nil && !blank && (last.kind == paragraph || last.kind == listItem || last.kind == quote) {
+				last.text += "\n" + trimmed
+				continue
+			}
+		}
+		blocks = append(blocks, next)
+		indents = nil
+		blank = false
+	}
+	return blocks
+}
+
+// render writes blocks as HTML, checking links as it goes
+func (r *renderer) render(blocks []block) {
+	var lists []bool // open lists, innermost last; true for numbered lists
+	closeLists := func(depth int) {
+		for len(lists) > depth {
+			r.b.WriteString("</li></" + listTag(lists[len(lists)-1]) + ">")
+			lists = lists[:len(lists)-1]
+			if len(lists) == 0 {
+				r.b.WriteString("\n")
+			}
+		}
+	}
+
+	for _, b := range blocks {
+		if b.kind != listItem {
+			closeLists(0)
+		}
+		switch b.kind {
+		case paragraph:
+			r.b.WriteString("<p>")
+			r.inline(b.text)
+			r.b.WriteString("</p>\n")
+		case heading:
+			tag := "h" + string(rune('0'+b.level))
+			r.b.WriteString("<" + tag + ">")
+			r.inline(b.text)
+			r.b.WriteString("</" + tag + ">\n")
+		case codeBlock:
+			r.b.WriteString("<pre><code>" + html.EscapeString(b.text) + "</code></pre>\n")
+		case quote:
+			r.b.WriteString("<blockquote><p>")
+			r.inline(b.text)
+			r.b.WriteString("</p></blockquote>\n")
+		case rule:
+			r.b.WriteString("<hr>\n")
+		case listItem:
+			closeLists(b.level)
+			if len(lists) == b.level && lists[b.level-1] != b.ordered {
+				closeLists(b.level - 1)
+			}
+			if len(lists) == b.level {
+				r.b.WriteString("</li><li>")
+			}
+			for len(lists) < b.level {
+				r.b.WriteString("<" + listTag(b.ordered) + "><li>")
+				lists = append(lists, b.ordered)
+			}
+			r.inline(b.text)
+		}
+	}
+	closeLists(0)
+}
+
+func listTag(ordered bool) string {
+	if ordered {
+		return "ol"
+	}
+	return "ul"
+}
+
+// inline writes emphasis, code spans and links; everything else is escaped
+func (r *renderer) inline(s string) {
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == '\\' && i+1 < len(s) && strings.IndexByte(`\`+"`*_[]()#+-.!>", s[i+1]) >= 0:
+			r.b.WriteString(html.EscapeString(s[i+1 : i+2]))
+			i += 2
+		case c == '`':
+			end := strings.IndexByte(s[i+1:], '`')
+			if end < 0 {
+				r.b.WriteString("`")
+				i++
+				continue
+			}
+			r.b.WriteString("<code>" + html.EscapeString(s[i+1:i+1+end]) + "</code>")
+			i += end + 2
+		case c == '*' || (c == '_' && (i == 0 || !isWordByte(s[i-1]))):
+			delim := s[i : i+1]
+			if i+1 < len(s) && s[i+1] == c {
+				delim = s[i : i+2]
+			}
+			end := strings.Index(s[i+len(delim):], delim)
+			if end <= 0 {
+				r.b.WriteString(delim)
+				i += len(delim)
+				continue
+			}
+			tag := "em"
+			if len(delim) == 2 {
+				tag = "strong"
+			}
+			r.b.WriteString("<" + tag + ">")
+			r.inline(s[i+len(delim) : i+len(delim)+end])
+			r.b.WriteString("</" + tag + ">")
+			i += end + 2*len(delim)
+		case c == '[':
+			text, href, n, ok := link(s[i:])
+			if !ok {
+				r.b.WriteString("[")
+				i++
+				continue
+			}
+			if safeLink(href) {
+				r.b.WriteString(`<a href="` + html.EscapeString(href) + `">`)
+				r.inline(text)
+				r.b.WriteString("</a>")
+			} else {
+				r.fail(ErrUnsafeLink)
+				r.inline(text)
+			}
+			i += n
+		default:
+			j := i + 1
+			for j < len(s) && strings.IndexByte("\\`*_[", s[j]) < 0 {
+				j++
+			}
+			r.b.WriteString(html.EscapeString(s[i:j]))
+			i = j
+		}
+	}
+}
+
+// link parses [text](href) at the start of s, returning the bytes it spans
+func link(s string) (text, href string, n int, ok bool) {
+	closeText := strings.IndexByte(s, ']')
+	if closeText < 0 || closeText+1 >= len(s) || s[closeText+1] != '(' {
+		return "", "", 0, false
+	}
+	closeHref := strings.IndexByte(s[closeText+2:], ')')
+	if closeHref < 0 {
+		return "", "", 0, false
+	}
+	href = strings.TrimSpace(s[closeText+2 : closeText+2+closeHref])
+	if href == "" {
+		return "", "", 0, false
+	}
+	return s[1:closeText], href, closeText + 3 + closeHref, true
+}
+
+// safeLink reports whether href is an absolute http, https or mailto URL
+func safeLink(href string) bool {
+	u, err := url.Parse(href)
+	if err != nil {
+		return false
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "http", "https":
+		return u.Host != ""
+	case "mailto":
+		return u.Opaque != ""
+	}
+	return false
+}
+
+func isWordByte(c byte) bool {
+	return c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
@@ -0,0 +1,38 @@
+package metrics
+
+import (
+	"expvar"
+	"net/http"
+	"sync"
+)
+
+var mu sync.Mutex
+
+// Counter returns the process-wide counter registered under name, creating it on first use
+// Counters are published through expvar and served at /debug/vars by Handler
+func Counter(name string) *expvar.Int {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if v, ok := expvar.Get(name).(*expvar.Int); ok {
+		return v
+	}
+	return expvar.NewInt(name)
+}
+
+// Labeled returns the process-wide labeled counter set registered under name
+// Use Add(label, delta) to increment the counter for a single label value
+func Labeled(name string) *expvar.Map {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if v, ok := expvar.Get(name).(*expvar.Map); ok {
+		return v
+	}
+	return expvar.NewMap(name)
+}
+
+// Handler serves all published metrics as JSON
+func Handler() http.Handler {
+	return expvar.Handler()
+}
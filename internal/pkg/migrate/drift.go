@@ -0,0 +1,93 @@
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+)
+
+// Drift is the difference between the columns the code reads and writes and the live schema
+type Drift struct {
+	// MissingTables are expected tables that do not exist
+	MissingTables []string
+	// MissingColumns maps an existing table to its expected columns that do not exist
+	MissingColumns map[string][]string
+}
+
+// Empty reports whether the live schema has every expected table and column
+func (d Drift) Empty() bool {
+	return len(d.MissingTables) == 0 && len(d.MissingColumns) == 0
+}
+
+// String lists the drift one table per clause, e.g. "products: missing columns map_price, cost_price"
+func (d Drift) String() string {
+	var parts []string
+	for _, table := range d.MissingTables {
+		parts = append(parts, fmt.Sprintf("%s: missing table", table))
+	}
+	tables := make([]string, 0, len(d.MissingColumns))
+	for table := range d.MissingColumns {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	for _, table := range tables {
+		parts = append(parts, fmt.Sprintf("%s: missing columns %s", table, strings.Join(d.MissingColumns[table], ", ")))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// CheckColumns compares expected table columns with INFORMATION_SCHEMA.COLUMNS
+// Extra live columns are not drift: reads and writes always name their columns
+func CheckColumns(ctx context.Context, client *spanner.Client, expected map[string][]string) (Drift, error) {
+	tables := make([]string, 0, len(expected))
+	for table := range expected {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+
+	stmt := spanner.Statement{
+		SQL: `SELECT table_name, column_name FROM information_schema.columns
+			WHERE table_schema = '' AND table_name IN UNNEST(@tables)`,
+		Params: map[string]interface{}{"tables": tables},
+	}
+	iter := client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	live := map[string]map[string]bool{}
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return Drift{}, fmt.Errorf("failed to read information_schema.columns: %w", err)
+		}
+		var table, column string
+		if err := row.Columns(&table, &column); err != nil {
+			return Drift{}, fmt.Errorf("failed to decode information_schema.columns row: %w", err)
+		}
+		if live[table] == nil {
+			live[table] = map[string]bool{}
+		}
+		live[table][column] = true
+	}
+
+	drift := Drift{MissingColumns: map[string][]string{}}
+	for _, table := range tables {
+		columns, ok := live[table]
+		if !ok {
+			drift.MissingTables = append(drift.MissingTables, table)
+			continue
+		}
+		for _, column := range expected[table] {
+			if !columns[column] {
+				drift.MissingColumns[table] = append(drift.MissingColumns[table], column)
+			}
+		}
+	}
+	return drift, nil
+}
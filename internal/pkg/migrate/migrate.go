@@ -0,0 +1,292 @@
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"cloud.google.com/go/spanner"
+	admin "cloud.google.com/go/spanner/admin/database/apiv1"
+	"cloud.google.com/go/spanner/admin/database/apiv1/databasepb"
+	instanceadmin "cloud.google.com/go/spanner/admin/instance/apiv1"
+	"cloud.google.com/go/spanner/admin/instance/apiv1/instancepb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Database is a parsed Spanner database path
+type Database struct {
+	Project  string
+	Instance string
+	Name     string
+}
+
+// ParseDatabase splits projects/{project}/instances/{instance}/databases/{database} into its parts
+func ParseDatabase(database string) (Database, error) {
+	parts := strings.Split(database, "/")
+	if len(parts) != 6 || parts[0] != "projects" || parts[2] != "instances" || parts[4] != "databases" {
+		return Database{}, fmt.Errorf("invalid database format: %s (expected: projects/{project}/instances/{instance}/databases/{database})", database)
+	}
+	return Database{Project: parts[1], Instance: parts[3], Name: parts[5]}, nil
+}
+
+// ProjectPath returns projects/{project}
+func (d Database) ProjectPath() string {
+	return fmt.Sprintf("projects/%s", d.Project)
+}
+
+// InstancePath returns projects/{project}/instances/{instance}
+func (d Database) InstancePath() string {
+	return fmt.Sprintf("projects/%s/instances/%s", d.Project, d.Instance)
+}
+
+// Path returns the full database path
+func (d Database) Path() string {
+	return fmt.Sprintf("%s/databases/%s", d.InstancePath(), d.Name)
+}
+
+// EnsureInstance creates the instance if it does not exist
+// Intended for the emulator: the instance is created with no config or node count
+func EnsureInstance(ctx context.Context, db Database) error {
+	client, err := instanceadmin.NewInstanceAdminClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create instance admin client: %w", err)
+	}
+	defer client.Close()
+
+	_, err = client.GetInstance(ctx, &instancepb.GetInstanceRequest{Name: db.InstancePath()})
+	if err == nil {
+		return nil
+	}
+	if status.Code(err) != codes.NotFound {
+		return fmt.Errorf("failed to check instance existence: %w", err)
+	}
+
+	slog.Info("Instance does not exist, creating", "instance", db.InstancePath())
+	op, err := client.CreateInstance(ctx, &instancepb.CreateInstanceRequest{
+		Parent:     db.ProjectPath(),
+		InstanceId: db.Instance,
+		Instance: &instancepb.Instance{
+			DisplayName: db.Instance,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create instance: %w", err)
+	}
+	if _, err := op.Wait(ctx); err != nil {
+		return fmt.Errorf("instance creation failed: %w", err)
+	}
+	slog.Info("Successfully created instance", "instance", db.InstancePath())
+	return nil
+}
+
+// EnsureDatabase creates the database with every migration in dir if it does not exist
+// An existing database is left untouched; it reports whether the database was created
+func EnsureDatabase(ctx context.Context, db Database, dir string) (bool, error) {
+	client, err := admin.NewDatabaseAdminClient(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to create database admin client: %w", err)
+	}
+	defer client.Close()
+
+	_, err = client.GetDatabase(ctx, &databasepb.GetDatabaseRequest{Name: db.Path()})
+	if err == nil {
+		return false, nil
+	}
+	if status.Code(err) != codes.NotFound {
+		return false, fmt.Errorf("failed to check database existence: %w", err)
+	}
+
+	slog.Info("Database does not exist, creating", "database", db.Path())
+	if err := createDatabase(ctx, client, db, dir); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// RecreateDatabase drops the database if it exists and creates it again with every migration in dir
+// There is no migration versioning, so this is how the emulator picks up new migrations
+func RecreateDatabase(ctx context.Context, db Database, dir string) error {
+	client, err := admin.NewDatabaseAdminClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create database admin client: %w", err)
+	}
+	defer client.Close()
+
+	_, err = client.GetDatabase(ctx, &databasepb.GetDatabaseRequest{Name: db.Path()})
+	switch {
+	case err == nil:
+		slog.Info("Database exists, dropping and recreating for clean migration", "database", db.Path())
+		if err := client.DropDatabase(ctx, &databasepb.DropDatabaseRequest{Database: db.Path()}); err != nil {
+			slog.Warn("Failed to drop database (may not exist or already dropped)", "error", err)
+		} else {
+			slog.Info("Successfully dropped database")
+		}
+	case status.Code(err) == codes.NotFound:
+		slog.Info("Database does not exist, creating", "database", db.Path())
+	default:
+		return fmt.Errorf("failed to check database existence: %w", err)
+	}
+	return createDatabase(ctx, client, db, dir)
+}
+
+// createDatabase creates the database with the migrations in dir as its initial DDL
+// Every version is recorded in schema_migrations, so plan and status never rely on inferring them
+// from the schema
+func createDatabase(ctx context.Context, client *admin.DatabaseAdminClient, db Database, dir string) error {
+	migrations, err := LoadMigrations(dir)
+	if err != nil {
+		return err
+	}
+	var statements []string
+	for _, m := range migrations {
+		for _, stmt := range m.Statements {
+			// DML only backfills tables from earlier ones, and a new database has no rows to copy
+			if IsDML(stmt) {
+				continue
+			}
+			statements = append(statements, stmt)
+		}
+	}
+
+	op, err := client.CreateDatabase(ctx, &databasepb.CreateDatabaseRequest{
+		Parent:          db.InstancePath(),
+		CreateStatement: fmt.Sprintf("CREATE DATABASE `%s`", db.Name),
+		ExtraStatements: statements,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create database: %w", err)
+	}
+	created, err := op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("database creation failed: %w", err)
+	}
+	slog.Info("Successfully created database", "database", created.Name)
+
+	if newSchema(statements)["table:"+versionsTable] {
+		if err := recordVersions(ctx, db, migrations); err != nil {
+			return err
+		}
+	}
+	slog.Info("Successfully applied migrations to database", "database", db.Path(), "statements", len(statements))
+	return nil
+}
+
+// recordVersions marks every migration as applied in schema_migrations
+func recordVersions(ctx context.Context, db Database, migrations []Migration) error {
+	client, err := spanner.NewClient(ctx, db.Path())
+	if err != nil {
+		return fmt.Errorf("failed to create Spanner client: %w", err)
+	}
+	defer client.Close()
+
+	mutations := make([]*spanner.Mutation, 0, len(migrations))
+	for _, m := range migrations {
+		mutations = append(mutations, spanner.InsertOrUpdate(versionsTable,
+			[]string{"version", "applied_at"},
+			[]any{m.Version, spanner.CommitTimestamp}))
+	}
+	if _, err := client.Apply(ctx, mutations); err != nil {
+		return fmt.Errorf("failed to record migration versions: %w", err)
+	}
+	return nil
+}
+
+// Migration is one migration file's statements: DDL, plus any DML that backfills a new table
+type Migration struct {
+	// Version is the file name without .sql, e.g. 029_product_drafts
+	Version    string
+	Statements []string
+}
+
+// LoadMigrations reads every .sql file in dir in name order
+func LoadMigrations(dir string) ([]Migration, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.sql"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list migration files: %w", err)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no migration files found in %s", dir)
+	}
+	sort.Strings(files)
+
+	migrations := make([]Migration, 0, len(files))
+	for _, file := range files {
+		migrationSQL, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration file %s: %w", file, err)
+		}
+		migrations = append(migrations, Migration{
+			Version:    strings.TrimSuffix(filepath.Base(file), ".sql"),
+			Statements: ParseDDLStatements(string(migrationSQL)),
+		})
+	}
+	return migrations, nil
+}
+
+// ReadMigrations reads every .sql file in dir in name order and returns their DDL statements
+func ReadMigrations(dir string) ([]string, error) {
+	migrations, err := LoadMigrations(dir)
+	if err != nil {
+		return nil, err
+	}
+	var statements []string
+	for _, m := range migrations {
+		for _, stmt := range m.Statements {
+			if !IsDML(stmt) {
+				statements = append(statements, stmt)
+			}
+		}
+	}
+	return statements, nil
+}
+
+// ParseDDLStatements splits a SQL file into individual DDL statements
+// Full-line comments are dropped and statements end at a trailing semicolon
+func ParseDDLStatements(sql string) []string {
+	var statements []string
+	var currentStatement strings.Builder
+
+	for _, line := range strings.Split(sql, "\n") {
+		trimmed := strings.TrimSpace(line)
+
+		// Skip empty lines and full-line comments
+		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+
+		if currentStatement.Len() > 0 {
+			currentStatement.WriteString(" ")
+		}
+		currentStatement.WriteString(trimmed)
+
+		// If line ends with semicolon, finalize the statement
+		if strings.HasSuffix(trimmed, ";") {
+			stmt := strings.TrimSuffix(strings.TrimSpace(currentStatement.String()), ";")
+			if stmt != "" {
+				statements = append(statements, stmt)
+			}
+			currentStatement.Reset()
+		}
+	}
+
+	// Handle any remaining statement without trailing semicolon
+	if stmt := strings.TrimSpace(currentStatement.String()); stmt != "" {
+		statements = append(statements, stmt)
+	}
+	return statements
+}
+
+// IsDML reports whether a migration statement is INSERT, UPDATE or DELETE rather than DDL
+func IsDML(stmt string) bool {
+	verb, _, _ := strings.Cut(stmt, " ")
+	switch strings.ToUpper(verb) {
+	case "INSERT", "UPDATE", "DELETE":
+		return true
+	}
+	return false
+}
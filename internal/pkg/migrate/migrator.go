@@ -0,0 +1,310 @@
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	admin "cloud.google.com/go/spanner/admin/database/apiv1"
+	"cloud.google.com/go/spanner/admin/database/apiv1/databasepb"
+	"google.golang.org/api/iterator"
+)
+
+// versionsTable records applied migration versions (see migrations/030_schema_migrations.sql)
+const versionsTable = "schema_migrations"
+
+// Migration states reported by Status
+const (
+	StateApplied  = "applied"
+	StateInferred = "applied (inferred)"
+	StatePending  = "pending"
+)
+
+// VersionStatus is one migration's state in the live database
+type VersionStatus struct {
+	Version string
+	State   string
+	// AppliedAt is set when the version is recorded in schema_migrations
+	AppliedAt time.Time
+}
+
+// Step is the statements a pending migration would run
+// Skipped holds its statements whose object already exists in the live schema
+type Step struct {
+	Version    string
+	Statements []string
+	Skipped    []string
+}
+
+// Migrator plans and applies migrations against a live database
+// The live schema comes from GetDatabaseDdl; applied versions come from schema_migrations,
+// or are inferred from the schema for databases created before that table existed
+type Migrator struct {
+	db     Database
+	dir    string
+	admin  *admin.DatabaseAdminClient
+	client *spanner.Client
+}
+
+// NewMigrator opens admin and data clients for the database
+func NewMigrator(ctx context.Context, database, dir string) (*Migrator, error) {
+	db, err := ParseDatabase(database)
+	if err != nil {
+		return nil, err
+	}
+	adminClient, err := admin.NewDatabaseAdminClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create database admin client: %w", err)
+	}
+	client, err := spanner.NewClient(ctx, database)
+	if err != nil {
+		adminClient.Close()
+		return nil, fmt.Errorf("failed to create Spanner client: %w", err)
+	}
+	return &Migrator{db: db, dir: dir, admin: adminClient, client: client}, nil
+}
+
+// Close releases the clients
+func (m *Migrator) Close() {
+	m.client.Close()
+	m.admin.Close()
+}
+
+// Status lists every migration file with its state
+func (m *Migrator) Status(ctx context.Context) ([]VersionStatus, error) {
+	state, err := m.inspect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return state.statuses, nil
+}
+
+// Plan returns the statements each pending migration would run, diffed against the live schema
+// A non-empty to stops the plan after that version
+func (m *Migrator) Plan(ctx context.Context, to string) ([]Step, error) {
+	state, err := m.inspect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	last, err := state.through(to)
+	if err != nil {
+		return nil, err
+	}
+	return state.plan(last), nil
+}
+
+// Apply runs the planned statements and records the versions
+// DDL runs in one schema update per stretch between DML statements; each DML statement runs in its
+// own read-write transaction after the DDL before it. Versions inferred from the schema are
+// recorded too, so later runs rely on schema_migrations alone. A non-empty to stops after that
+// version, so a migration that drops what older servers still use can wait for a later run
+func (m *Migrator) Apply(ctx context.Context, to string) ([]Step, error) {
+	state, err := m.inspect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	last, err := state.through(to)
+	if err != nil {
+		return nil, err
+	}
+	steps := state.plan(last)
+
+	var statements []string
+	for _, step := range steps {
+		statements = append(statements, step.Statements...)
+	}
+	if err := m.run(ctx, statements); err != nil {
+		return nil, err
+	}
+
+	var mutations []*spanner.Mutation
+	for _, s := range state.statuses[:last+1] {
+		if s.State == StateApplied {
+			continue
+		}
+		mutations = append(mutations, spanner.InsertOrUpdate(versionsTable,
+			[]string{"version", "applied_at"},
+			[]any{s.Version, spanner.CommitTimestamp}))
+	}
+	if len(mutations) > 0 {
+		if _, err := m.client.Apply(ctx, mutations); err != nil {
+			return nil, fmt.Errorf("failed to record migration versions: %w", err)
+		}
+	}
+	return steps, nil
+}
+
+// run executes statements in order, batching consecutive DDL into one schema update
+func (m *Migrator) run(ctx context.Context, statements []string) error {
+	var ddl []string
+	flush := func() error {
+		if len(ddl) == 0 {
+			return nil
+		}
+		op, err := m.admin.UpdateDatabaseDdl(ctx, &databasepb.UpdateDatabaseDdlRequest{
+			Database:   m.db.Path(),
+			Statements: ddl,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update database DDL: %w", err)
+		}
+		if err := op.Wait(ctx); err != nil {
+			return fmt.Errorf("database DDL update failed: %w", err)
+		}
+		ddl = nil
+		return nil
+	}
+
+	for _, stmt := range statements {
+		if !IsDML(stmt) {
+			ddl = append(ddl, stmt)
+			continue
+		}
+		if err := flush(); err != nil {
+			return err
+		}
+		_, err := m.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+			_, err := txn.Update(ctx, spanner.Statement{SQL: stmt})
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("failed to run %q: %w", stmt, err)
+		}
+	}
+	return flush()
+}
+
+// inspection is the migration files and the live database they are compared against
+type inspection struct {
+	migrations []Migration
+	live       schema
+	statuses   []VersionStatus
+}
+
+// through returns the index of version to, or of the last migration when to is empty
+func (s *inspection) through(to string) (int, error) {
+	if to == "" {
+		return len(s.migrations) - 1, nil
+	}
+	for i, mig := range s.migrations {
+		if mig.Version == to {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("migration %s not found", to)
+}
+
+// plan simulates the pending migrations up to index last on a copy of the live schema, skipping
+// statements already reflected in it. DML is never skipped
+func (s *inspection) plan(last int) []Step {
+	sim := s.live.clone()
+	var steps []Step
+	for i, mig := range s.migrations[:last+1] {
+		if s.statuses[i].State != StatePending {
+			continue
+		}
+		step := Step{Version: mig.Version}
+		for _, stmt := range mig.Statements {
+			e := parseEffect(stmt)
+			if e.known() && sim.satisfied(e) {
+				step.Skipped = append(step.Skipped, stmt)
+				continue
+			}
+			step.Statements = append(step.Statements, stmt)
+			sim.apply(e)
+		}
+		steps = append(steps, step)
+	}
+	return steps
+}
+
+// inspect loads the migration files, the live schema and the recorded versions
+func (m *Migrator) inspect(ctx context.Context) (*inspection, error) {
+	migrations, err := LoadMigrations(m.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	ddl, err := m.admin.GetDatabaseDdl(ctx, &databasepb.GetDatabaseDdlRequest{Database: m.db.Path()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read database DDL: %w", err)
+	}
+	live := newSchema(ddl.GetStatements())
+
+	recorded := map[string]time.Time{}
+	if live["table:"+versionsTable] {
+		if recorded, err = m.recordedVersions(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	baseline := inferBaseline(migrations, live)
+	statuses := make([]VersionStatus, len(migrations))
+	for i, mig := range migrations {
+		statuses[i] = VersionStatus{Version: mig.Version, State: StatePending}
+		if at, ok := recorded[mig.Version]; ok {
+			statuses[i].State, statuses[i].AppliedAt = StateApplied, at
+		} else if i <= baseline {
+			statuses[i].State = StateInferred
+		}
+	}
+	return &inspection{migrations: migrations, live: live, statuses: statuses}, nil
+}
+
+// recordedVersions reads schema_migrations
+func (m *Migrator) recordedVersions(ctx context.Context) (map[string]time.Time, error) {
+	iter := m.client.Single().Read(ctx, versionsTable, spanner.AllKeys(), []string{"version", "applied_at"})
+	defer iter.Stop()
+
+	versions := map[string]time.Time{}
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return versions, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", versionsTable, err)
+		}
+		var version string
+		var appliedAt time.Time
+		if err := row.Columns(&version, &appliedAt); err != nil {
+			return nil, fmt.Errorf("failed to decode %s row: %w", versionsTable, err)
+		}
+		versions[version] = appliedAt
+	}
+}
+
+// inferBaseline returns the index of the newest migration whose objects all match the live schema, or -1
+// Migrations run in order, so every migration up to it is taken as applied. Only a migration's net
+// effect is checked: a table it drops and recreates must exist. A migration that only touches
+// objects the diff cannot track is never a baseline on its own
+func inferBaseline(migrations []Migration, live schema) int {
+	for i := len(migrations) - 1; i >= 0; i-- {
+		// created records each object's last action in the migration: true for create, false for drop
+		created := map[string]bool{}
+		for _, stmt := range migrations[i].Statements {
+			e := parseEffect(stmt)
+			if e.create != "" {
+				created[e.create] = true
+			}
+			if e.drop != "" {
+				created[e.drop] = false
+			}
+		}
+		if len(created) == 0 {
+			continue
+		}
+		satisfied := true
+		for object, create := range created {
+			if live[object] != create {
+				satisfied = false
+				break
+			}
+		}
+		if satisfied {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,193 @@
+package migrate
+
+import (
+	"strings"
+)
+
+// effect is the schema object a DDL statement creates or drops
+// Objects are keyed as table:<name>, column:<table>.<name> or index:<name>, all lower case
+type effect struct {
+	create  string
+	drop    string
+	columns []string // columns created along with a table
+}
+
+// known reports whether the statement touches an object the diff can track
+func (e effect) known() bool {
+	return e.create != "" || e.drop != ""
+}
+
+// schema is the set of objects present in a database
+type schema map[string]bool
+
+// newSchema builds the schema produced by running statements in order
+func newSchema(statements []string) schema {
+	s := schema{}
+	for _, stmt := range statements {
+		s.apply(parseEffect(stmt))
+	}
+	return s
+}
+
+// clone copies the schema so a plan can be simulated without touching the live view
+func (s schema) clone() schema {
+	c := make(schema, len(s))
+	for k := range s {
+		c[k] = true
+	}
+	return c
+}
+
+// satisfied reports whether the effect is already reflected in the schema
+func (s schema) satisfied(e effect) bool {
+	switch {
+	case e.create != "":
+		return s[e.create]
+	case e.drop != "":
+		return !s[e.drop]
+	}
+	return false
+}
+
+// apply records the effect in the schema
+func (s schema) apply(e effect) {
+	if e.create != "" {
+		s[e.create] = true
+		for _, col := range e.columns {
+			s[col] = true
+		}
+	}
+	if e.drop != "" {
+		delete(s, e.drop)
+		if table, ok := strings.CutPrefix(e.drop, "table:"); ok {
+			for k := range s {
+				if strings.HasPrefix(k, "column:"+table+".") {
+					delete(s, k)
+				}
+			}
+		}
+	}
+}
+
+// parseEffect recognises CREATE/DROP TABLE, CREATE/DROP INDEX and ALTER TABLE ADD/DROP COLUMN
+// Anything else returns an empty effect and is always treated as pending
+func parseEffect(stmt string) effect {
+	fields := strings.Fields(stmt)
+	upper := make([]string, len(fields))
+	for i, f := range fields {
+		upper[i] = strings.ToUpper(f)
+	}
+	at := func(i int) string {
+		if i < len(upper) {
+			return upper[i]
+		}
+		return ""
+	}
+	name := func(i int) string {
+		if i >= len(fields) {
+			return ""
+		}
+		n, _, _ := strings.Cut(fields[i], "(")
+		return strings.ToLower(strings.Trim(n, "`"))
+	}
+	skipIfExists := func(i int) int {
+		if at(i) == "IF" && at(i+1) == "NOT" && at(i+2) == "EXISTS" {
+			return i + 3
+		}
+		if at(i) == "IF" && at(i+1) == "EXISTS" {
+			return i + 2
+		}
+		return i
+	}
+
+	switch {
+	case at(0) == "CREATE" && at(1) == "TABLE":
+		table := name(skipIfExists(2))
+		e := effect{create: "table:" + table}
+		for _, col := range tableColumns(stmt) {
+			e.columns = append(e.columns, "column:"+table+"."+col)
+		}
+		return e
+	case at(0) == "CREATE":
+		i := 1
+		for at(i) == "UNIQUE" || at(i) == "NULL_FILTERED" {
+			i++
+		}
+		if at(i) == "INDEX" {
+			return effect{create: "index:" + name(skipIfExists(i+1))}
+		}
+	case at(0) == "DROP" && at(1) == "TABLE":
+		return effect{drop: "table:" + name(skipIfExists(2))}
+	case at(0) == "DROP" && at(1) == "INDEX":
+		return effect{drop: "index:" + name(skipIfExists(2))}
+	case at(0) == "ALTER" && at(1) == "TABLE":
+		table := name(2)
+		switch {
+		case at(3) == "ADD" && at(4) == "COLUMN":
+			return effect{create: "column:" + table + "." + name(skipIfExists(5))}
+		case at(3) == "DROP" && at(4) == "COLUMN":
+			return effect{drop: "column:" + table + "." + name(5)}
+		}
+	}
+	return effect{}
+}
+
+// tableColumns returns the lower case column names declared in a CREATE TABLE statement
+func tableColumns(stmt string) []string {
+	var columns []string
+	for _, def := range columnDefinitions(stmt) {
+		columns = append(columns, strings.ToLower(strings.Trim(strings.Fields(def)[0], "`")))
+	}
+	return columns
+}
+
+// columnDefinitions returns the column definitions of a CREATE TABLE statement, without constraints
+func columnDefinitions(stmt string) []string {
+	defs, _ := splitTableBody(stmt)
+	return defs
+}
+
+// splitTableBody splits a CREATE TABLE statement's column list on top-level commas, ignoring those
+// inside types and defaults; it also returns the text after the list, e.g. "PRIMARY KEY (product_id), ..."
+func splitTableBody(stmt string) ([]string, string) {
+	start := strings.Index(stmt, "(")
+	if start < 0 {
+		return nil, ""
+	}
+
+	var parts []string
+	rest := ""
+	depth, from := 0, start+1
+	for i := start; i < len(stmt); i++ {
+		switch stmt[i] {
+		case '(', '<':
+			depth++
+		case ')', '>':
+			depth--
+			if depth == 0 {
+				parts = append(parts, stmt[from:i])
+				rest = stmt[i+1:]
+				i = len(stmt)
+			}
+		case ',':
+			if depth == 1 {
+				parts = append(parts, stmt[from:i])
+				from = i + 1
+			}
+		}
+	}
+
+	var defs []string
+	for _, part := range parts {
+		fields := strings.Fields(part)
+		if len(fields) == 0 {
+			continue
+		}
+		switch strings.ToUpper(fields[0]) {
+		case "CONSTRAINT", "FOREIGN", "CHECK", "PRIMARY":
+			continue
+		}
+		defs = append(defs, strings.TrimSpace(part))
+	}
+	return defs, rest
+}
@@ -0,0 +1,157 @@
+package migrate
+
+import (
+	"strings"
+)
+
+// Column is a column as the migrations leave it
+type Column struct {
+	Name string
+	// Type is the Spanner type in upper case, e.g. STRING(36), NUMERIC or ARRAY<STRING(MAX)>
+	Type    string
+	NotNull bool
+	// CommitTimestamp is set for OPTIONS (allow_commit_timestamp=true)
+	CommitTimestamp bool
+	// Generated is set for columns computed by Spanner, e.g. "AS (...) STORED"; they cannot be written
+	Generated bool
+}
+
+// BaseType returns the type without its length, e.g. STRING for STRING(36) and ARRAY<STRING> for ARRAY<STRING(MAX)>
+func (c Column) BaseType() string {
+	if elem, ok := strings.CutPrefix(c.Type, "ARRAY<"); ok {
+		return "ARRAY<" + (Column{Type: strings.TrimSuffix(elem, ">")}).BaseType() + ">"
+	}
+	base, _, _ := strings.Cut(c.Type, "(")
+	return base
+}
+
+// Table is a table as the migrations leave it, with columns in the order they were added
+type Table struct {
+	Name       string
+	Columns    []Column
+	PrimaryKey []string
+}
+
+// Column returns the named column
+func (t *Table) Column(name string) (Column, bool) {
+	for _, c := range t.Columns {
+		if c.Name == name {
+			return c, true
+		}
+	}
+	return Column{}, false
+}
+
+// BuildTables replays DDL statements and returns the resulting tables keyed by lower case name
+// Only CREATE/DROP TABLE, ALTER TABLE ADD/DROP COLUMN and ALTER COLUMN SET OPTIONS shape tables;
+// other statements are ignored
+func BuildTables(statements []string) map[string]*Table {
+	tables := map[string]*Table{}
+	for _, stmt := range statements {
+		if tableName, column, options, ok := columnOptions(stmt); ok {
+			if table := tables[tableName]; table != nil {
+				for i := range table.Columns {
+					if table.Columns[i].Name == column {
+						table.Columns[i].CommitTimestamp = commitTimestampOption(options)
+					}
+				}
+			}
+			continue
+		}
+
+		e := parseEffect(stmt)
+		switch {
+		case strings.HasPrefix(e.create, "table:"):
+			table := &Table{Name: strings.TrimPrefix(e.create, "table:")}
+			defs, rest := splitTableBody(stmt)
+			for _, def := range defs {
+				table.Columns = append(table.Columns, parseColumn(def))
+			}
+			table.PrimaryKey = primaryKey(rest)
+			tables[table.Name] = table
+		case strings.HasPrefix(e.create, "column:"):
+			name, _ := strings.CutPrefix(e.create, "column:")
+			tableName, _, _ := strings.Cut(name, ".")
+			if table := tables[tableName]; table != nil {
+				_, def, _ := strings.Cut(strings.ToUpper(stmt), " COLUMN ")
+				def = stmt[len(stmt)-len(def):]
+				if strings.HasPrefix(strings.ToUpper(def), "IF NOT EXISTS ") {
+					def = def[len("IF NOT EXISTS "):]
+				}
+				table.Columns = append(table.Columns, parseColumn(def))
+			}
+		case strings.HasPrefix(e.drop, "table:"):
+			delete(tables, strings.TrimPrefix(e.drop, "table:"))
+		case strings.HasPrefix(e.drop, "column:"):
+			name, _ := strings.CutPrefix(e.drop, "column:")
+			tableName, column, _ := strings.Cut(name, ".")
+			if table := tables[tableName]; table != nil {
+				for i, c := range table.Columns {
+					if c.Name == column {
+						table.Columns = append(table.Columns[:i], table.Columns[i+1:]...)
+						break
+					}
+				}
+			}
+		}
+	}
+	return tables
+}
+
+// parseColumn parses a column definition such as "sku STRING(64) NOT NULL DEFAULT ("")"
+func parseColumn(def string) Column {
+	fields := strings.Fields(def)
+	c := Column{Name: strings.ToLower(strings.Trim(fields[0], "`"))}
+	if len(fields) > 1 {
+		c.Type = strings.ToUpper(fields[1])
+	}
+	upper := strings.ToUpper(def)
+	c.NotNull = strings.Contains(upper, "NOT NULL")
+	c.CommitTimestamp = commitTimestampOption(def)
+	c.Generated = strings.Contains(upper, " AS (") || strings.Contains(upper, " AS(")
+	return c
+}
+
+// commitTimestampOption reports whether column options set allow_commit_timestamp=true
+func commitTimestampOption(options string) bool {
+	return strings.Contains(strings.ReplaceAll(strings.ToUpper(options), " ", ""), "ALLOW_COMMIT_TIMESTAMP=TRUE")
+}
+
+// columnOptions parses "ALTER TABLE t ALTER COLUMN c SET OPTIONS (...)", returning the lower case
+// table and column names and the options text
+func columnOptions(stmt string) (string, string, string, bool) {
+	fields := strings.Fields(stmt)
+	if len(fields) < 8 {
+		return "", "", "", false
+	}
+	for i, want := range []string{"ALTER", "TABLE", "", "ALTER", "COLUMN", "", "SET", "OPTIONS"} {
+		if want != "" && strings.ToUpper(fields[i]) != want {
+			return "", "", "", false
+		}
+	}
+	table := strings.ToLower(strings.Trim(fields[2], "`"))
+	column := strings.ToLower(strings.Trim(fields[5], "`"))
+	return table, column, strings.Join(fields[7:], " "), true
+}
+
+// primaryKey parses the key columns from the text after a CREATE TABLE column list
+func primaryKey(rest string) []string {
+	_, after, ok := strings.Cut(strings.ToUpper(rest), "PRIMARY KEY")
+	if !ok {
+		return nil
+	}
+	after = rest[len(rest)-len(after):]
+	open := strings.Index(after, "(")
+	end := strings.Index(after, ")")
+	if open < 0 || end < open {
+		return nil
+	}
+	var key []string
+	for _, part := range strings.Split(after[open+1:end], ",") {
+		fields := strings.Fields(part)
+		if len(fields) > 0 {
+			key = append(key, strings.ToLower(strings.Trim(fields[0], "`")))
+		}
+	}
+	return key
+}
@@ -0,0 +1,140 @@
+package opensearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// requestTimeout bounds a single call to the cluster
+const requestTimeout = 10 * time.Second
+
+// Client talks to one OpenSearch (or Elasticsearch) index over its REST API
+type Client struct {
+	http    *http.Client
+	baseURL string
+	index   string
+}
+
+// NewClient creates a client for index on the cluster at baseURL, e.g. "http://localhost:9200"
+// Credentials can be given in the URL as user:password@host
+func NewClient(baseURL, index string) *Client {
+	return &Client{
+		http:    &http.Client{Timeout: requestTimeout},
+		baseURL: strings.TrimRight(baseURL, "/"),
+		index:   index,
+	}
+}
+
+// EnsureIndex creates the index with mapping unless it already exists
+// An existing index keeps its mapping; changing the mapping needs a new index name and a reindex
+func (c *Client) EnsureIndex(ctx context.Context, mapping []byte) error {
+	status, _, err := c.do(ctx, http.MethodHead, "/"+url.PathEscape(c.index), nil)
+	if err != nil {
+		return err
+	}
+	if status == http.StatusOK {
+		return nil
+	}
+
+	status, body, err := c.do(ctx, http.MethodPut, "/"+url.PathEscape(c.index), mapping)
+	if err != nil {
+		return err
+	}
+	// Another server may have created it in the meantime
+	if status == http.StatusBadRequest && bytes.Contains(body, []byte("resource_already_exists_exception")) {
+		return nil
+	}
+	return checkStatus("create index", status, body)
+}
+
+// Put indexes doc under id, replacing any previous version
+func (c *Client) Put(ctx context.Context, id string, doc any) error {
+	body, err := json.Marshal(doc)
+	if err != nil {
+		return fmt.Errorf("failed to encode document %s: %w", id, err)
+	}
+	status, resp, err := c.do(ctx, http.MethodPut, c.docPath(id), body)
+	if err != nil {
+		return err
+	}
+	return checkStatus("index document", status, resp)
+}
+
+// Delete removes the document with id; a missing document is not an error
+func (c *Client) Delete(ctx context.Context, id string) error {
+	status, resp, err := c.do(ctx, http.MethodDelete, c.docPath(id), nil)
+	if err != nil {
+		return err
+	}
+	if status == http.StatusNotFound {
+		return nil
+	}
+	return checkStatus("delete document", status, resp)
+}
+
+// Search runs a query DSL request against the index and decodes the response into out
+func (c *Client) Search(ctx context.Context, query any, out any) error {
+	body, err := json.Marshal(query)
+	if err != nil {
+		return fmt.Errorf("failed to encode search: %w", err)
+	}
+	status, resp, err := c.do(ctx, http.MethodPost, "/"+url.PathEscape(c.index)+"/_search", body)
+	if err != nil {
+		return err
+	}
+	if err := checkStatus("search", status, resp); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(resp, out); err != nil {
+		return fmt.Errorf("failed to decode search response: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) docPath(id string) string {
+	return "/" + url.PathEscape(c.index) + "/_doc/" + url.PathEscape(id)
+}
+
+// do sends one request and returns the status and body
+func (c *Client) do(ctx context.Context, method, path string, body []byte) (int, []byte, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reader)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to build %s %s: %w", method, path, err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to call OpenSearch: %w", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to read OpenSearch response: %w", err)
+	}
+	return resp.StatusCode, respBody, nil
+}
+
+// checkStatus turns a non-2xx response into an error carrying the start of the body
+func checkStatus(op string, status int, body []byte) error {
+	if status >= 200 && status < 300 {
+		return nil
+	}
+	if len(body) > 512 {
+		body = body[:512]
+	}
+	return fmt.Errorf("OpenSearch %s failed with status %d: %s", op, status, body)
+}
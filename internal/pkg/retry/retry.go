@@ -0,0 +1,180 @@
+package retry
+
+import (
+	"context"
+	"expvar"
+	"math/rand"
+	"sync"
+	"time"
+
+	"catalog-proj/internal/pkg/metrics"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
+)
+
+// Policy configures retry behavior for transient Spanner errors
+type Policy struct {
+	// MaxAttempts is the total number of attempts including the first one
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between attempts
+	MaxBackoff time.Duration
+	// Multiplier grows the backoff after every attempt
+	Multiplier float64
+	// BudgetTokens is the size of the shared retry budget (0 disables budgeting)
+	// Every retry spends one token and every success refunds BudgetRefill tokens;
+	// retries stop once the budget drops to half, so a degraded backend is not hammered
+	BudgetTokens float64
+	// BudgetRefill is the number of tokens refunded per successful call
+	BudgetRefill float64
+}
+
+// DefaultPolicy returns the policy used when none is configured
+func DefaultPolicy() Policy {
+	return Policy{
+		MaxAttempts:    4,
+		InitialBackoff: 50 * time.Millisecond,
+		MaxBackoff:     2 * time.Second,
+		Multiplier:     2,
+		BudgetTokens:   20,
+		BudgetRefill:   0.2,
+	}
+}
+
+// Retrier executes operations with jittered exponential backoff and a shared retry budget
+type Retrier struct {
+	policy Policy
+
+	mu     sync.Mutex
+	tokens float64
+
+	attempts        *expvar.Map
+	exhausted       *expvar.Map
+	budgetExhausted *expvar.Map
+}
+
+// NewRetrier creates a new retrier for the given policy
+func NewRetrier(policy Policy) *Retrier {
+	if policy.MaxAttempts < 1 {
+		policy.MaxAttempts = 1
+	}
+	if policy.Multiplier < 1 {
+		policy.Multiplier = 1
+	}
+	return &Retrier{
+		policy:          policy,
+		tokens:          policy.BudgetTokens,
+		attempts:        metrics.Labeled("spanner_retry_attempts_total"),
+		exhausted:       metrics.Labeled("spanner_retry_exhausted_total"),
+		budgetExhausted: metrics.Labeled("spanner_retry_budget_exhausted_total"),
+	}
+}
+
+// Do runs fn, retrying transient Spanner errors until it succeeds, the attempts are
+// exhausted, the retry budget runs out, or ctx is done
+// op labels the emitted metrics (e.g. "commit", "read_model.list")
+func (r *Retrier) Do(ctx context.Context, op string, fn func(ctx context.Context) error) error {
+	return r.DoIf(ctx, op, IsRetryable, fn)
+}
+
+// DoIf is like Do, but only retries errors for which retryable reports true
+func (r *Retrier) DoIf(ctx context.Context, op string, retryable func(error) bool, fn func(ctx context.Context) error) error {
+	backoff := r.policy.InitialBackoff
+
+	for attempt := 1; ; attempt++ {
+		err := fn(ctx)
+		if err == nil {
+			r.refill()
+			return nil
+		}
+		if !retryable(err) {
+			return err
+		}
+		if attempt >= r.policy.MaxAttempts {
+			r.exhausted.Add(op, 1)
+			return err
+		}
+		if !r.spend() {
+			r.budgetExhausted.Add(op, 1)
+			return err
+		}
+
+		r.attempts.Add(op, 1)
+		timer := time.NewTimer(jitter(backoff))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+
+		backoff = time.Duration(float64(backoff) * r.policy.Multiplier)
+		if backoff > r.policy.MaxBackoff {
+			backoff = r.policy.MaxBackoff
+		}
+	}
+}
+
+// IsRetryable reports whether err is a transient Spanner error worth retrying
+func IsRetryable(err error) bool {
+	switch spanner.ErrCode(err) {
+	case codes.Aborted, codes.Unavailable, codes.ResourceExhausted:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsRetryableCommit reports whether a failed blind commit is safe to retry
+// UNAVAILABLE is left out: the commit may have landed before the response was lost,
+// and a retried insert would then fail with AlreadyExists for a write that succeeded
+func IsRetryableCommit(err error) bool {
+	switch spanner.ErrCode(err) {
+	case codes.Aborted, codes.ResourceExhausted:
+		return true
+	default:
+		return false
+	}
+}
+
+// spend takes one token from the retry budget, reporting whether a retry is allowed
+func (r *Retrier) spend() bool {
+	if r.policy.BudgetTokens <= 0 {
+		return true
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.tokens-1 < r.policy.BudgetTokens/2 {
+		return false
+	}
+	r.tokens--
+	return true
+}
+
+// refill returns tokens to the retry budget after a successful call
+func (r *Retrier) refill() {
+	if r.policy.BudgetTokens <= 0 {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.tokens += r.policy.BudgetRefill
+	if r.tokens > r.policy.BudgetTokens {
+		r.tokens = r.policy.BudgetTokens
+	}
+}
+
+// jitter returns a random duration in [d/2, d) ("equal jitter")
+func jitter(d time.Duration) time.Duration {
+	if d <= 0 {
+		return 0
+	}
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(d-half)))
+}
@@ -0,0 +1,123 @@
+package shadow
+
+import (
+	"context"
+	"log/slog"
+	"math/rand"
+	"time"
+
+	"catalog-proj/internal/pkg/logging"
+	"catalog-proj/internal/pkg/metrics"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Target is an alternate implementation of one read RPC, such as a new read model or API version
+type Target struct {
+	// Call serves the primary's request with the alternate implementation
+	Call func(ctx context.Context, req interface{}) (interface{}, error)
+	// Equal reports whether the alternate response matches the primary one; nil compares with proto.Equal
+	Equal func(primary, shadow interface{}) bool
+}
+
+// Settings controls how much traffic is shadowed
+type Settings struct {
+	// Percent of calls to a method with a target that are also sent to the target (0-100)
+	Percent float64
+	// MaxInFlight bounds concurrent shadow calls; calls over the bound are dropped, never queued
+	MaxInFlight int
+	// Timeout bounds each shadow call
+	Timeout time.Duration
+}
+
+// Shadower duplicates sampled read calls to alternate implementations and compares the results
+// Shadow calls run after the primary has answered, on their own goroutine, so they never add latency
+// or change the response; outcomes are only visible as metrics
+type Shadower struct {
+	settings Settings
+	targets  map[string]Target
+	inFlight chan struct{}
+	log      *slog.Logger
+}
+
+// New creates a shadower for the targets, keyed by full method name
+func New(settings Settings, targets map[string]Target) *Shadower {
+	if settings.MaxInFlight < 1 {
+		settings.MaxInFlight = 1
+	}
+	return &Shadower{
+		settings: settings,
+		targets:  targets,
+		inFlight: make(chan struct{}, settings.MaxInFlight),
+		log:      logging.Component("shadow"),
+	}
+}
+
+// UnaryServerInterceptor shadows sampled calls to methods that have a target
+// It must run after the tenant and API key interceptors so shadow calls act for the same caller
+func (s *Shadower) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+
+		target, ok := s.targets[info.FullMethod]
+		if !ok || rand.Float64()*100 >= s.settings.Percent {
+			return resp, err
+		}
+		select {
+		case s.inFlight <- struct{}{}:
+		default:
+			metrics.Labeled("shadow_dropped_total").Add(info.FullMethod, 1)
+			return resp, err
+		}
+
+		// The shadow call keeps the caller's tenant but outlives the primary's deadline
+		shadowCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), s.settings.Timeout)
+		go func() {
+			defer func() { <-s.inFlight }()
+			defer cancel()
+			s.compare(shadowCtx, info.FullMethod, target, req, resp, err)
+		}()
+		return resp, err
+	}
+}
+
+// compare calls the target and records whether it agreed with the primary
+func (s *Shadower) compare(ctx context.Context, method string, target Target, req, primary interface{}, primaryErr error) {
+	defer func() {
+		if r := recover(); r != nil {
+			metrics.Labeled("shadow_errors_total").Add(method, 1)
+			s.log.Error("Shadow call panicked", "method", method, "panic", r)
+		}
+	}()
+
+	metrics.Labeled("shadow_requests_total").Add(method, 1)
+	resp, err := target.Call(ctx, req)
+
+	switch {
+	case primaryErr != nil || err != nil:
+		// Errors agree when both sides fail with the same status code
+		if status.Code(primaryErr) == status.Code(err) {
+			return
+		}
+		if primaryErr == nil {
+			metrics.Labeled("shadow_errors_total").Add(method, 1)
+		}
+		metrics.Labeled("shadow_mismatches_total").Add(method, 1)
+		s.log.Debug("Shadow result differs", "method", method, "primary_code", status.Code(primaryErr), "shadow_code", status.Code(err))
+	case !s.equal(target, primary, resp):
+		metrics.Labeled("shadow_mismatches_total").Add(method, 1)
+		s.log.Debug("Shadow response differs", "method", method)
+	}
+}
+
+// equal compares responses with the target's comparison, or proto.Equal without one
+func (s *Shadower) equal(target Target, primary, shadow interface{}) bool {
+	if target.Equal != nil {
+		return target.Equal(primary, shadow)
+	}
+	p, ok1 := primary.(proto.Message)
+	sh, ok2 := shadow.(proto.Message)
+	return ok1 && ok2 && proto.Equal(p, sh)
+}
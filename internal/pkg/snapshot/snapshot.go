@@ -0,0 +1,300 @@
+package snapshot
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"cloud.google.com/go/spanner/apiv1/spannerpb"
+	"google.golang.org/api/iterator"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// Tables are the catalog tables included in a snapshot, in restore order
+var Tables = []string{"products", "outbox_events_by_aggregate"}
+
+// manifestFile is written last, so a snapshot without it is incomplete
+const manifestFile = "manifest.json"
+
+// restoreBatchRows bounds rows per restore commit (well under Spanner's mutation limit)
+const restoreBatchRows = 500
+
+// Manifest describes a snapshot
+type Manifest struct {
+	CreatedAt     time.Time       `json:"created_at"`
+	ReadTimestamp time.Time       `json:"read_timestamp"`
+	Tables        []TableManifest `json:"tables"`
+}
+
+// TableManifest describes one table in a snapshot
+type TableManifest struct {
+	Name    string   `json:"name"`
+	File    string   `json:"file"`
+	Columns []string `json:"columns"`
+	// Types are the Spanner column types (protojson), aligned with Columns; empty when the table has no rows
+	Types []json.RawMessage `json:"types,omitempty"`
+	Rows  int64             `json:"rows"`
+}
+
+// Create writes every row of Tables to the store, read at a single timestamp
+// Each table is stored as JSON lines of column values, followed by the manifest
+func Create(ctx context.Context, client *spanner.Client, store BlobStore) (*Manifest, error) {
+	txn := client.ReadOnlyTransaction()
+	defer txn.Close()
+
+	manifest := &Manifest{CreatedAt: time.Now().UTC()}
+	for _, table := range Tables {
+		tm, err := dumpTable(ctx, txn, store, table)
+		if err != nil {
+			return nil, err
+		}
+		manifest.Tables = append(manifest.Tables, *tm)
+	}
+
+	readAt, err := txn.Timestamp()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get read timestamp: %w", err)
+	}
+	manifest.ReadTimestamp = readAt
+
+	w, err := store.NewWriter(ctx, manifestFile)
+	if err != nil {
+		return nil, err
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(manifest); err != nil {
+		w.Close()
+		return nil, fmt.Errorf("failed to write manifest: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write manifest: %w", err)
+	}
+	return manifest, nil
+}
+
+// dumpTable streams one table into <table>.jsonl
+func dumpTable(ctx context.Context, txn *spanner.ReadOnlyTransaction, store BlobStore, table string) (*TableManifest, error) {
+	columns, err := tableColumns(ctx, txn, table)
+	if err != nil {
+		return nil, err
+	}
+
+	tm := &TableManifest{Name: table, File: table + ".jsonl", Columns: columns}
+	w, err := store.NewWriter(ctx, tm.File)
+	if err != nil {
+		return nil, err
+	}
+	buf := bufio.NewWriter(w)
+
+	iter := txn.Read(ctx, table, spanner.AllKeys(), columns)
+	defer iter.Stop()
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			w.Close()
+			return nil, fmt.Errorf("failed to read %s: %w", table, err)
+		}
+
+		values := make([]json.RawMessage, len(columns))
+		for i := range columns {
+			var col spanner.GenericColumnValue
+			if err := row.Column(i, &col); err != nil {
+				w.Close()
+				return nil, fmt.Errorf("failed to decode %s.%s: %w", table, columns[i], err)
+			}
+			if len(tm.Types) < len(columns) {
+				typ, err := protojson.Marshal(col.Type)
+				if err != nil {
+					w.Close()
+					return nil, err
+				}
+				tm.Types = append(tm.Types, typ)
+			}
+			if values[i], err = protojson.Marshal(col.Value); err != nil {
+				w.Close()
+				return nil, err
+			}
+		}
+
+		line, err := json.Marshal(values)
+		if err != nil {
+			w.Close()
+			return nil, err
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+		tm.Rows++
+	}
+
+	if err := buf.Flush(); err != nil {
+		w.Close()
+		return nil, fmt.Errorf("failed to write %s: %w", tm.File, err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write %s: %w", tm.File, err)
+	}
+	return tm, nil
+}
+
+// tableColumns lists a table's columns in schema order
+// Generated columns are left out: Spanner computes them again on restore and rejects writes to them
+func tableColumns(ctx context.Context, txn *spanner.ReadOnlyTransaction, table string) ([]string, error) {
+	iter := txn.Query(ctx, spanner.Statement{
+		SQL: `SELECT column_name FROM information_schema.columns
+			WHERE table_schema = '' AND table_name = @table AND is_generated = 'NEVER'
+			ORDER BY ordinal_position`,
+		Params: map[string]interface{}{"table": table},
+	})
+	defer iter.Stop()
+
+	var columns []string
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list columns of %s: %w", table, err)
+		}
+		var name string
+		if err := row.Columns(&name); err != nil {
+			return nil, err
+		}
+		columns = append(columns, name)
+	}
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("table %s not found", table)
+	}
+	return columns, nil
+}
+
+// ReadManifest loads a snapshot's manifest
+func ReadManifest(ctx context.Context, store BlobStore) (*Manifest, error) {
+	r, err := store.NewReader(ctx, manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open manifest (incomplete snapshot?): %w", err)
+	}
+	defer r.Close()
+
+	var manifest Manifest
+	if err := json.NewDecoder(r).Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+	return &manifest, nil
+}
+
+// Restore loads a snapshot into a freshly migrated, empty database
+// It refuses to write into tables that already contain rows
+func Restore(ctx context.Context, client *spanner.Client, store BlobStore) (*Manifest, error) {
+	manifest, err := ReadManifest(ctx, store)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tm := range manifest.Tables {
+		var count int64
+		row, err := client.Single().Query(ctx, spanner.Statement{SQL: fmt.Sprintf("SELECT COUNT(*) FROM %s", tm.Name)}).Next()
+		if err != nil {
+			return nil, fmt.Errorf("failed to check target table %s: %w", tm.Name, err)
+		}
+		if err := row.Columns(&count); err != nil {
+			return nil, err
+		}
+		if count > 0 {
+			return nil, fmt.Errorf("target table %s is not empty (%d rows); restore requires a fresh database", tm.Name, count)
+		}
+	}
+
+	for _, tm := range manifest.Tables {
+		if err := restoreTable(ctx, client, store, tm); err != nil {
+			return nil, err
+		}
+	}
+	return manifest, nil
+}
+
+// restoreTable inserts one table's rows in batches
+func restoreTable(ctx context.Context, client *spanner.Client, store BlobStore, tm TableManifest) error {
+	if tm.Rows == 0 {
+		return nil
+	}
+
+	types := make([]*spannerpb.Type, len(tm.Types))
+	for i, raw := range tm.Types {
+		types[i] = &spannerpb.Type{}
+		if err := protojson.Unmarshal(raw, types[i]); err != nil {
+			return fmt.Errorf("invalid type for %s.%s: %w", tm.Name, tm.Columns[i], err)
+		}
+	}
+
+	r, err := store.NewReader(ctx, tm.File)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 1024*1024), 64*1024*1024)
+
+	var (
+		batch    []*spanner.Mutation
+		restored int64
+	)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if _, err := client.Apply(ctx, batch); err != nil {
+			return fmt.Errorf("failed to restore %s: %w", tm.Name, err)
+		}
+		restored += int64(len(batch))
+		batch = batch[:0]
+		return nil
+	}
+
+	for scanner.Scan() {
+		var raw []json.RawMessage
+		if err := json.Unmarshal(scanner.Bytes(), &raw); err != nil {
+			return fmt.Errorf("corrupt row in %s: %w", tm.File, err)
+		}
+		if len(raw) != len(tm.Columns) {
+			return fmt.Errorf("corrupt row in %s: %d values for %d columns", tm.File, len(raw), len(tm.Columns))
+		}
+
+		values := make([]interface{}, len(raw))
+		for i, v := range raw {
+			value := &structpb.Value{}
+			if err := protojson.Unmarshal(v, value); err != nil {
+				return fmt.Errorf("corrupt value for %s.%s: %w", tm.Name, tm.Columns[i], err)
+			}
+			values[i] = spanner.GenericColumnValue{Type: types[i], Value: value}
+		}
+		batch = append(batch, spanner.Insert(tm.Name, tm.Columns, values))
+
+		if len(batch) >= restoreBatchRows {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil && err != io.EOF {
+		return fmt.Errorf("failed to read %s: %w", tm.File, err)
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+
+	if restored != tm.Rows {
+		return fmt.Errorf("restored %d rows into %s, manifest lists %d", restored, tm.Name, tm.Rows)
+	}
+	return nil
+}
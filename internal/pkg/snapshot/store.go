@@ -0,0 +1,110 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	storage "google.golang.org/api/storage/v1"
+)
+
+// BlobStore reads and writes snapshot files under a location
+type BlobStore interface {
+	NewWriter(ctx context.Context, name string) (io.WriteCloser, error)
+	NewReader(ctx context.Context, name string) (io.ReadCloser, error)
+}
+
+// OpenBlobStore opens a gs://bucket/prefix or file:///dir (or plain directory) location
+// GCS access uses Application Default Credentials
+func OpenBlobStore(ctx context.Context, location string) (BlobStore, error) {
+	u, err := url.Parse(location)
+	if err != nil {
+		return nil, fmt.Errorf("invalid snapshot location %q: %w", location, err)
+	}
+
+	switch u.Scheme {
+	case "gs":
+		if u.Host == "" {
+			return nil, fmt.Errorf("invalid snapshot location %q: missing bucket", location)
+		}
+		svc, err := storage.NewService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create GCS client: %w", err)
+		}
+		return &gcsStore{svc: svc, bucket: u.Host, prefix: strings.Trim(u.Path, "/")}, nil
+	case "file":
+		return &fileStore{dir: u.Path}, nil
+	case "":
+		return &fileStore{dir: location}, nil
+	default:
+		return nil, fmt.Errorf("unsupported snapshot location scheme %q (use gs:// or file://)", u.Scheme)
+	}
+}
+
+// gcsStore stores snapshot files as objects in a GCS bucket
+type gcsStore struct {
+	svc    *storage.Service
+	bucket string
+	prefix string
+}
+
+func (s *gcsStore) NewWriter(ctx context.Context, name string) (io.WriteCloser, error) {
+	pr, pw := io.Pipe()
+	done := make(chan error, 1)
+	go func() {
+		_, err := s.svc.Objects.Insert(s.bucket, &storage.Object{Name: path.Join(s.prefix, name)}).
+			Media(pr).
+			Context(ctx).
+			Do()
+		pr.CloseWithError(err)
+		done <- err
+	}()
+	return &gcsWriter{pw: pw, done: done}, nil
+}
+
+func (s *gcsStore) NewReader(ctx context.Context, name string) (io.ReadCloser, error) {
+	resp, err := s.svc.Objects.Get(s.bucket, path.Join(s.prefix, name)).Context(ctx).Download()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read gs://%s/%s: %w", s.bucket, path.Join(s.prefix, name), err)
+	}
+	return resp.Body, nil
+}
+
+// gcsWriter streams writes into an object upload; Close waits for the upload to finish
+type gcsWriter struct {
+	pw   *io.PipeWriter
+	done chan error
+}
+
+func (w *gcsWriter) Write(p []byte) (int, error) {
+	return w.pw.Write(p)
+}
+
+func (w *gcsWriter) Close() error {
+	if err := w.pw.Close(); err != nil {
+		return err
+	}
+	return <-w.done
+}
+
+// fileStore stores snapshot files in a local directory (useful with the emulator)
+type fileStore struct {
+	dir string
+}
+
+func (s *fileStore) NewWriter(_ context.Context, name string) (io.WriteCloser, error) {
+	p := filepath.Join(s.dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		return nil, err
+	}
+	return os.Create(p)
+}
+
+func (s *fileStore) NewReader(_ context.Context, name string) (io.ReadCloser, error) {
+	return os.Open(filepath.Join(s.dir, filepath.FromSlash(name)))
+}
@@ -0,0 +1,197 @@
+package spannertag
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner/apiv1/spannerpb"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"catalog-proj/internal/pkg/logging"
+	"catalog-proj/internal/pkg/metrics"
+	"catalog-proj/internal/pkg/tenant"
+)
+
+// Settings controls the Spanner client interceptors
+type Settings struct {
+	// SlowQueryThreshold is the latency from which queries and reads are logged; 0 disables the log
+	SlowQueryThreshold time.Duration
+}
+
+// Interceptor sets the context's tag on the requests the Spanner client sends and logs slow queries
+// It is installed on the client's gRPC connections, so every repository is covered without passing
+// request options at each call site. Tags the caller set explicitly are kept
+type Interceptor struct {
+	threshold time.Duration
+	log       *slog.Logger
+}
+
+// New returns an interceptor with settings
+func New(settings Settings) *Interceptor {
+	return &Interceptor{threshold: settings.SlowQueryThreshold, log: logging.Component("spanner")}
+}
+
+// DialOptions returns the options that install the interceptor on a gRPC connection to Spanner
+func (i *Interceptor) DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(i.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(i.StreamClientInterceptor()),
+	}
+}
+
+// UnaryClientInterceptor tags unary Spanner requests such as DML, batch DML and commits
+func (i *Interceptor) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		tag := FromContext(ctx)
+		setTag(req, tag)
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		i.observe(ctx, tag, req, time.Since(start))
+		return err
+	}
+}
+
+// StreamClientInterceptor tags streamed queries and reads, timing them until the last result is received
+func (i *Interceptor) StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return &clientStream{ClientStream: stream, interceptor: i, ctx: ctx, tag: FromContext(ctx), start: time.Now()}, nil
+	}
+}
+
+// clientStream tags the request sent on a stream and observes the stream once it ends
+type clientStream struct {
+	grpc.ClientStream
+	interceptor *Interceptor
+	ctx         context.Context
+	tag         string
+	start       time.Time
+	req         interface{}
+	done        bool
+}
+
+func (s *clientStream) SendMsg(m interface{}) error {
+	setTag(m, s.tag)
+	s.req = m
+	return s.ClientStream.SendMsg(m)
+}
+
+func (s *clientStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil && !s.done {
+		// io.EOF ends a complete stream; any other error ends it too
+		s.done = true
+		s.interceptor.observe(s.ctx, s.tag, s.req, time.Since(s.start))
+	}
+	return err
+}
+
+// setTag sets tag as the request tag, and as the transaction tag on requests that begin a read-write
+// transaction, which is where Spanner takes a transaction's tag from
+func setTag(req interface{}, tag string) {
+	if tag == "" {
+		return
+	}
+	var options **spannerpb.RequestOptions
+	var begin *spannerpb.TransactionOptions
+	taggable := true
+	switch r := req.(type) {
+	case *spannerpb.ExecuteSqlRequest:
+		options, begin = &r.RequestOptions, r.GetTransaction().GetBegin()
+	case *spannerpb.ReadRequest:
+		options, begin = &r.RequestOptions, r.GetTransaction().GetBegin()
+	case *spannerpb.ExecuteBatchDmlRequest:
+		options, begin = &r.RequestOptions, r.GetTransaction().GetBegin()
+	case *spannerpb.BeginTransactionRequest:
+		options, begin, taggable = &r.RequestOptions, r.GetOptions(), false
+	case *spannerpb.CommitRequest:
+		options, begin, taggable = &r.RequestOptions, r.GetSingleUseTransaction(), false
+	default:
+		return
+	}
+	if *options == nil {
+		*options = &spannerpb.RequestOptions{}
+	}
+	if taggable && (*options).RequestTag == "" {
+		(*options).RequestTag = tag
+	}
+	if begin.GetReadWrite() != nil && (*options).TransactionTag == "" {
+		(*options).TransactionTag = tag
+	}
+}
+
+// observe logs req if it is a query or read that took at least the slow query threshold
+func (i *Interceptor) observe(ctx context.Context, tag string, req interface{}, elapsed time.Duration) {
+	if i.threshold <= 0 || elapsed < i.threshold {
+		return
+	}
+	attrs := []any{"tag", tag, "tenant_id", tenant.FromContext(ctx), "elapsed", elapsed}
+	switch r := req.(type) {
+	case *spannerpb.ExecuteSqlRequest:
+		attrs = append(attrs, "sql", r.GetSql(), "params", scrub(r.GetParams(), r.GetParamTypes()))
+	case *spannerpb.ExecuteBatchDmlRequest:
+		statements := make([]string, 0, len(r.GetStatements()))
+		for _, statement := range r.GetStatements() {
+			statements = append(statements, statement.GetSql())
+		}
+		attrs = append(attrs, "sql", strings.Join(statements, "; "))
+	case *spannerpb.ReadRequest:
+		attrs = append(attrs, "table", r.GetTable(), "index", r.GetIndex(), "columns", r.GetColumns())
+	default:
+		return
+	}
+
+	label := tag
+	if label == "" {
+		label = "untagged"
+	}
+	metrics.Labeled("spanner_slow_queries").Add(label, 1)
+	i.log.Warn("Slow Spanner query", attrs...)
+}
+
+// scrub returns the parameters of a query for logging: numbers, booleans and times are kept, while
+// strings, bytes, JSON and composite values, which may hold customer data, are redacted
+func scrub(params *structpb.Struct, types map[string]*spannerpb.Type) map[string]string {
+	scrubbed := make(map[string]string, len(params.GetFields()))
+	for name, value := range params.GetFields() {
+		switch {
+		case value.GetKind() == nil:
+			continue
+		case isNull(value):
+			scrubbed[name] = "NULL"
+		case loggable(types[name].GetCode()):
+			scrubbed[name] = logValue(value)
+		default:
+			scrubbed[name] = "<redacted>"
+		}
+	}
+	return scrubbed
+}
+
+func isNull(value *structpb.Value) bool {
+	_, ok := value.GetKind().(*structpb.Value_NullValue)
+	return ok
+}
+
+// loggable reports whether values of code are safe to log
+func loggable(code spannerpb.TypeCode) bool {
+	switch code {
+	case spannerpb.TypeCode_INT64, spannerpb.TypeCode_FLOAT32, spannerpb.TypeCode_FLOAT64,
+		spannerpb.TypeCode_BOOL, spannerpb.TypeCode_TIMESTAMP, spannerpb.TypeCode_DATE:
+		return true
+	default:
+		return false
+	}
+}
+
+// logValue formats a scalar value; INT64 and TIMESTAMP travel as strings, floats as numbers
+func logValue(value *structpb.Value) string {
+	return fmt.Sprint(value.AsInterface())
+}
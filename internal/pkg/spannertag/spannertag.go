@@ -0,0 +1,54 @@
+package spannertag
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+)
+
+// maxLength is the longest tag Spanner keeps; longer tags are truncated by Spanner anyway
+const maxLength = 50
+
+type contextKey struct{}
+
+// WithTag returns a copy of ctx whose Spanner requests are tagged with tag, such as rpc=product.v1.ListProducts
+// Tags show up in Spanner's query, read, lock and transaction statistics, so they tie Spanner-side
+// costs back to the endpoint or job that caused them
+func WithTag(ctx context.Context, tag string) context.Context {
+	if len(tag) > maxLength {
+		tag = tag[:maxLength]
+	}
+	return context.WithValue(ctx, contextKey{}, tag)
+}
+
+// FromContext returns the tag carried by ctx, or "" if none is set
+func FromContext(ctx context.Context) string {
+	tag, _ := ctx.Value(contextKey{}).(string)
+	return tag
+}
+
+// ForMethod returns the tag of a gRPC method: /product.v1.ProductService/ListProducts becomes
+// rpc=product.v1.ListProducts, short enough to fit Spanner's tag length
+func ForMethod(fullMethod string) string {
+	service, method, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if !ok {
+		return "rpc=" + fullMethod
+	}
+	if i := strings.LastIndex(service, "."); i >= 0 {
+		return "rpc=" + service[:i] + "." + method
+	}
+	return "rpc=" + method
+}
+
+// ForJob returns the tag of a background job of kind
+func ForJob(kind string) string {
+	return "job=" + kind
+}
+
+// UnaryServerInterceptor tags the Spanner requests made while serving a call with the call's method
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(WithTag(ctx, ForMethod(info.FullMethod)), req)
+	}
+}
@@ -0,0 +1,61 @@
+package tenant
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultID is the tenant used when a request does not identify one
+const DefaultID = "default"
+
+// MetadataKey is the gRPC metadata key carrying the tenant ID
+const MetadataKey = "x-tenant-id"
+
+// validID restricts tenant IDs to the characters allowed in the tenant_id column
+var validID = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,63}$`)
+
+type contextKey struct{}
+
+// WithID returns a copy of ctx carrying the tenant ID
+func WithID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the tenant ID carried by ctx, or DefaultID if none is set
+func FromContext(ctx context.Context) string {
+	if id, ok := ctx.Value(contextKey{}).(string); ok && id != "" {
+		return id
+	}
+	return DefaultID
+}
+
+// IsValidID reports whether id is a well-formed tenant ID
+func IsValidID(id string) bool {
+	return validID.MatchString(id)
+}
+
+// UnaryServerInterceptor resolves the tenant from x-tenant-id metadata into the request context
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return handler(ctx, req)
+		}
+		values := md.Get(MetadataKey)
+		if len(values) == 0 {
+			return handler(ctx, req)
+		}
+
+		id := strings.ToLower(strings.TrimSpace(values[0]))
+		if !IsValidID(id) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid %s: must match %s", MetadataKey, validID.String())
+		}
+		return handler(WithID(ctx, id), req)
+	}
+}
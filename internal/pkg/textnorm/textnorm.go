@@ -0,0 +1,193 @@
+package textnorm
+
+import (
+	"fmt"
+	"html"
+	"net/url"
+	"strings"
+	"unicode"
+
+	nethtml "golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+	"golang.org/x/text/unicode/norm"
+)
+
+// HTMLPolicy selects what HTML survives in descriptions
+type HTMLPolicy string
+
+const (
+	// HTMLStrip removes every tag and keeps the text between them
+	HTMLStrip HTMLPolicy = "strip"
+	// HTMLMarkdown keeps the tags markdown renders to, without attributes other than safe link targets
+	HTMLMarkdown HTMLPolicy = "markdown"
+)
+
+// Policy configures a Normalizer
+type Policy struct {
+	// Unicode converts text to NFC, so visually equal names compare equal
+	Unicode bool
+	// Whitespace collapses runs of spaces; names and categories become a single line
+	Whitespace bool
+	// DescriptionHTML is applied to descriptions; script, style and embedded content are always dropped
+	DescriptionHTML HTMLPolicy
+}
+
+// Normalizer cleans textual product fields before they are validated and stored
+// A nil Normalizer only trims surrounding whitespace
+type Normalizer struct {
+	policy Policy
+}
+
+// New creates a normalizer for the policy
+func New(policy Policy) (*Normalizer, error) {
+	switch policy.DescriptionHTML {
+	case HTMLStrip, HTMLMarkdown:
+	default:
+		return nil, fmt.Errorf("unknown description HTML policy %q", policy.DescriptionHTML)
+	}
+	return &Normalizer{policy: policy}, nil
+}
+
+// Line normalizes single-line text such as names and categories
+func (n *Normalizer) Line(s string) string {
+	if n == nil {
+		return strings.TrimSpace(s)
+	}
+	if n.policy.Unicode {
+		s = norm.NFC.String(s)
+	}
+	if n.policy.Whitespace {
+		return strings.Join(strings.Fields(s), " ")
+	}
+	return strings.TrimSpace(s)
+}
+
+// Description normalizes multi-line text: HTML is cleaned by the policy and, with Whitespace,
+// trailing spaces, repeated spaces within a line and runs of blank lines are removed while
+// leading indentation, which markdown relies on, is kept
+func (n *Normalizer) Description(s string) string {
+	if n == nil {
+		return strings.TrimSpace(s)
+	}
+	if n.policy.Unicode {
+		s = norm.NFC.String(s)
+	}
+	s = cleanHTML(s, n.policy.DescriptionHTML)
+	if n.policy.Whitespace {
+		s = collapseLines(s)
+	}
+	return strings.TrimSpace(s)
+}
+
+// droppedElements are removed together with their content
+var droppedElements = map[atom.Atom]bool{
+	atom.Script: true, atom.Style: true, atom.Iframe: true, atom.Object: true, atom.Embed: true,
+	atom.Noscript: true, atom.Template: true, atom.Svg: true, atom.Math: true, atom.Frameset: true,
+}
+
+// markdownElements are the tags markdown renders to
+var markdownElements = map[atom.Atom]bool{
+	atom.P: true, atom.Br: true, atom.Hr: true, atom.Em: true, atom.Strong: true, atom.B: true, atom.I: true,
+	atom.Ul: true, atom.Ol: true, atom.Li: true, atom.Code: true, atom.Pre: true, atom.Blockquote: true,
+	atom.H1: true, atom.H2: true, atom.H3: true, atom.H4: true, atom.H5: true, atom.H6: true, atom.A: true,
+}
+
+// cleanHTML removes tags the policy does not keep; text between tags is kept as written, so
+// entities stay escaped and cannot turn into markup
+func cleanHTML(s string, policy HTMLPolicy) string {
+	if !strings.ContainsRune(s, '<') {
+		return s
+	}
+
+	var b strings.Builder
+	z := nethtml.NewTokenizer(strings.NewReader(s))
+	dropping := atom.Atom(0) // the element whose content is being dropped
+	depth := 0
+	for {
+		tt := z.Next()
+		if tt == nethtml.ErrorToken {
+			return b.String()
+		}
+		// Raw must be copied first: Token unescapes the tokenizer's buffer in place
+		raw := append([]byte(nil), z.Raw()...)
+		tok := z.Token()
+		if dropping != 0 {
+			switch {
+			case tt == nethtml.StartTagToken && tok.DataAtom == dropping:
+				depth++
+			case tt == nethtml.EndTagToken && tok.DataAtom == dropping:
+				if depth--; depth == 0 {
+					dropping = 0
+				}
+			}
+			continue
+		}
+
+		switch tt {
+		case nethtml.TextToken:
+			b.Write(raw)
+		case nethtml.StartTagToken, nethtml.SelfClosingTagToken, nethtml.EndTagToken:
+			if droppedElements[tok.DataAtom] {
+				if tt == nethtml.StartTagToken {
+					dropping, depth = tok.DataAtom, 1
+				}
+				continue
+			}
+			if policy == HTMLMarkdown && markdownElements[tok.DataAtom] {
+				b.WriteString(renderTag(tt, tok))
+			}
+		}
+		// Comments and doctypes are dropped
+	}
+}
+
+// renderTag writes an allowed tag without attributes, except a safe href on links
+func renderTag(tt nethtml.TokenType, tok nethtml.Token) string {
+	if tt == nethtml.EndTagToken {
+		return "</" + tok.Data + ">"
+	}
+	if tok.DataAtom == atom.A {
+		for _, attr := range tok.Attr {
+			if attr.Key == "href" && safeLink(attr.Val) {
+				return `<a href="` + html.EscapeString(attr.Val) + `">`
+			}
+		}
+	}
+	return "<" + tok.Data + ">"
+}
+
+// safeLink reports whether href is an http, https or mailto URL
+func safeLink(href string) bool {
+	u, err := url.Parse(strings.TrimSpace(href))
+	if err != nil {
+		return false
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "http", "https", "mailto":
+		return true
+	}
+	return false
+}
+
+// collapseLines trims trailing spaces, collapses repeated spaces after each line's indentation
+// and keeps at most one blank line in a row
+func collapseLines(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	lines := strings.Split(s, "\n")
+	out := make([]string, 0, len(lines))
+	blank := false
+	for _, line := range lines {
+		body := strings.TrimLeftFunc(line, unicode.IsSpace)
+		if body == "" {
+			if !blank && len(out) > 0 {
+				out = append(out, "")
+			}
+			blank = true
+			continue
+		}
+		blank = false
+		indent := line[:len(line)-len(body)]
+		out = append(out, indent+strings.Join(strings.Fields(body), " "))
+	}
+	return strings.Join(out, "\n")
+}
@@ -0,0 +1,188 @@
+package tlsreload
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"catalog-proj/internal/pkg/logging"
+	"catalog-proj/internal/pkg/metrics"
+)
+
+// Settings names the files behind a server TLS configuration
+type Settings struct {
+	CertFile string
+	KeyFile  string
+	// ClientCAFile enables client certificates, verified against these CAs ("" for server-only TLS)
+	ClientCAFile string
+	// RequireClientCert rejects clients that present no certificate; needs ClientCAFile
+	RequireClientCert bool
+	// AllowedSPIFFEIDs limits client certificates to these SPIFFE IDs ("" or empty for any verified client)
+	// A non-empty list requires a client certificate, as RequireClientCert does
+	AllowedSPIFFEIDs []string
+}
+
+// Reloader serves a TLS configuration whose certificate and client CAs are re-read when their files change
+// Connections already established keep the credentials they were set up with
+type Reloader struct {
+	settings Settings
+
+	mu       sync.RWMutex
+	cert     *tls.Certificate
+	clientCA *x509.CertPool
+	// loaded holds the file contents behind cert and clientCA, to tell whether a reload changes anything
+	loaded [][]byte
+}
+
+// New loads the files once; a missing or invalid file is an error
+func New(settings Settings) (*Reloader, error) {
+	r := &Reloader{settings: settings}
+	if _, err := r.reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ServerConfig returns a TLS configuration that picks up reloaded files on every new handshake
+func (r *Reloader) ServerConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return r.current(), nil
+		},
+	}
+}
+
+// current builds the configuration for one handshake from the loaded files
+func (r *Reloader) current() *tls.Config {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	cfg := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{*r.cert},
+		// gRPC needs HTTP/2 negotiated over ALPN
+		NextProtos: []string{"h2"},
+	}
+	if r.clientCA != nil {
+		cfg.ClientCAs = r.clientCA
+		cfg.ClientAuth = tls.VerifyClientCertIfGiven
+		// An allowlist only means something if every client has to present a certificate
+		if r.settings.RequireClientCert || len(r.settings.AllowedSPIFFEIDs) > 0 {
+			cfg.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+		if len(r.settings.AllowedSPIFFEIDs) > 0 {
+			cfg.VerifyConnection = r.verifySPIFFEID
+		}
+	}
+	return cfg
+}
+
+// verifySPIFFEID rejects clients without a verified certificate carrying an allowed SPIFFE ID
+func (r *Reloader) verifySPIFFEID(cs tls.ConnectionState) error {
+	if len(cs.PeerCertificates) == 0 {
+		metrics.Counter("tls_spiffe_rejections_total").Add(1)
+		return fmt.Errorf("a client certificate with an allowed SPIFFE ID is required")
+	}
+	id, err := SPIFFEID(cs.PeerCertificates[0])
+	if err != nil {
+		metrics.Counter("tls_spiffe_rejections_total").Add(1)
+		return err
+	}
+	if !slices.Contains(r.settings.AllowedSPIFFEIDs, id) {
+		metrics.Counter("tls_spiffe_rejections_total").Add(1)
+		return fmt.Errorf("SPIFFE ID %s is not allowed", id)
+	}
+	return nil
+}
+
+// SPIFFEID returns the SPIFFE ID of an X.509 SVID: its single spiffe:// URI SAN
+func SPIFFEID(cert *x509.Certificate) (string, error) {
+	var id string
+	for _, uri := range cert.URIs {
+		if uri.Scheme != "spiffe" {
+			continue
+		}
+		if id != "" {
+			return "", errors.New("certificate has more than one SPIFFE ID")
+		}
+		id = uri.String()
+	}
+	if id == "" {
+		return "", errors.New("certificate has no SPIFFE ID")
+	}
+	return id, nil
+}
+
+// Run re-reads the files every interval until ctx is done
+// A failed reload is logged and the previous credentials stay in use
+func (r *Reloader) Run(ctx context.Context, interval time.Duration) {
+	log := logging.Component("tls")
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			changed, err := r.reload()
+			if err != nil {
+				metrics.Counter("tls_reload_failures_total").Add(1)
+				log.Error("Failed to reload TLS certificates", "error", err)
+				continue
+			}
+			if changed {
+				metrics.Counter("tls_reloads_total").Add(1)
+				log.Info("Reloaded TLS certificates", "cert_file", r.settings.CertFile)
+			}
+		}
+	}
+}
+
+// reload reads the files and swaps in new credentials when any of them changed
+func (r *Reloader) reload() (bool, error) {
+	files := []string{r.settings.CertFile, r.settings.KeyFile}
+	if r.settings.ClientCAFile != "" {
+		files = append(files, r.settings.ClientCAFile)
+	}
+	contents := make([][]byte, len(files))
+	for i, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return false, fmt.Errorf("failed to read %s: %w", file, err)
+		}
+		contents[i] = data
+	}
+
+	r.mu.RLock()
+	unchanged := slices.EqualFunc(contents, r.loaded, bytes.Equal)
+	r.mu.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	// Certificate and key are rotated together; a half-written pair fails here and is retried next time
+	cert, err := tls.X509KeyPair(contents[0], contents[1])
+	if err != nil {
+		return false, fmt.Errorf("failed to load certificate %s: %w", r.settings.CertFile, err)
+	}
+	var clientCA *x509.CertPool
+	if r.settings.ClientCAFile != "" {
+		clientCA = x509.NewCertPool()
+		if !clientCA.AppendCertsFromPEM(contents[2]) {
+			return false, fmt.Errorf("no certificates found in client CA file %s", r.settings.ClientCAFile)
+		}
+	}
+
+	r.mu.Lock()
+	r.cert, r.clientCA, r.loaded = &cert, clientCA, contents
+	r.mu.Unlock()
+	return true, nil
+}
@@ -0,0 +1,238 @@
+package tlsreload
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// testCA issues certificates for the tests
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate CA key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Failed to create CA certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("Failed to parse CA certificate: %v", err)
+	}
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue returns a PEM certificate and key for localhost, carrying spiffeID as a URI SAN if set
+func (ca *testCA) issue(t *testing.T, serial int64, spiffeID string) ([]byte, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	if spiffeID != "" {
+		uri, err := url.Parse(spiffeID)
+		if err != nil {
+			t.Fatalf("Failed to parse SPIFFE ID: %v", err)
+		}
+		template.URIs = []*url.URL{uri}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatalf("Failed to create certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("Failed to marshal key: %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+// clientCert issues a client certificate for spiffeID
+func (ca *testCA) clientCert(t *testing.T, spiffeID string) *tls.Certificate {
+	t.Helper()
+	certPEM, keyPEM := ca.issue(t, 100, spiffeID)
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		t.Fatalf("Failed to load client certificate: %v", err)
+	}
+	return &cert
+}
+
+// writeFile writes data to name in dir and returns its path
+func writeFile(t *testing.T, dir, name string, data []byte) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("Failed to write %s: %v", name, err)
+	}
+	return path
+}
+
+// newReloader writes a server certificate and the CA to dir and loads them with the given client settings
+func newReloader(t *testing.T, ca *testCA, dir string, settings Settings) *Reloader {
+	t.Helper()
+	certPEM, keyPEM := ca.issue(t, 2, "")
+	settings.CertFile = writeFile(t, dir, "server.crt", certPEM)
+	settings.KeyFile = writeFile(t, dir, "server.key", keyPEM)
+	settings.ClientCAFile = writeFile(t, dir, "ca.crt", ca.pem)
+	r, err := New(settings)
+	if err != nil {
+		t.Fatalf("Failed to create reloader: %v", err)
+	}
+	return r
+}
+
+// handshake connects a client presenting cert (nil for none) to a server using r
+// It returns the certificate the server presented and the server's handshake error
+func handshake(t *testing.T, r *Reloader, ca *testCA, cert *tls.Certificate) (*x509.Certificate, error) {
+	t.Helper()
+	ln, err := tls.Listen("tcp", "127.0.0.1:0", r.ServerConfig())
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	defer ln.Close()
+
+	serverErr := make(chan error, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			serverErr <- err
+			return
+		}
+		defer conn.Close()
+		serverErr <- conn.(*tls.Conn).Handshake()
+	}()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	config := &tls.Config{RootCAs: roots, ServerName: "localhost", NextProtos: []string{"h2"}}
+	if cert != nil {
+		config.Certificates = []tls.Certificate{*cert}
+	}
+	conn, err := tls.Dial("tcp", ln.Addr().String(), config)
+	if err != nil {
+		return nil, <-serverErr
+	}
+	defer conn.Close()
+	// TLS 1.3 servers verify the client after the client's handshake is done; reading sees the outcome
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	go func() { _, _ = io.Copy(io.Discard, conn) }()
+	served := conn.ConnectionState().PeerCertificates[0]
+	return served, <-serverErr
+}
+
+func TestSPIFFEAllowlist(t *testing.T) {
+	ca := newTestCA(t)
+	r := newReloader(t, ca, t.TempDir(), Settings{AllowedSPIFFEIDs: []string{"spiffe://example.org/checkout"}})
+
+	if _, err := handshake(t, r, ca, ca.clientCert(t, "spiffe://example.org/checkout")); err != nil {
+		t.Errorf("Expected an allowlisted SPIFFE ID to be accepted, got %v", err)
+	}
+	if _, err := handshake(t, r, ca, ca.clientCert(t, "spiffe://example.org/reporting")); err == nil {
+		t.Error("Expected a SPIFFE ID off the allowlist to be rejected")
+	}
+	if _, err := handshake(t, r, ca, ca.clientCert(t, "")); err == nil {
+		t.Error("Expected a client certificate without a SPIFFE ID to be rejected")
+	}
+	// The allowlist requires a certificate even though RequireClientCert is not set
+	if _, err := handshake(t, r, ca, nil); err == nil {
+		t.Error("Expected a client without a certificate to be rejected")
+	}
+}
+
+func TestOptionalClientCert(t *testing.T) {
+	ca := newTestCA(t)
+	r := newReloader(t, ca, t.TempDir(), Settings{})
+
+	if _, err := handshake(t, r, ca, nil); err != nil {
+		t.Errorf("Expected a client without a certificate to be accepted, got %v", err)
+	}
+	if _, err := handshake(t, r, ca, newTestCA(t).clientCert(t, "")); err == nil {
+		t.Error("Expected a certificate from another CA to be rejected")
+	}
+}
+
+func TestReloadRotatesCertificate(t *testing.T) {
+	ca := newTestCA(t)
+	dir := t.TempDir()
+	r := newReloader(t, ca, dir, Settings{})
+
+	if changed, err := r.reload(); err != nil || changed {
+		t.Errorf("Expected unchanged files not to reload, got changed %v (err %v)", changed, err)
+	}
+
+	certPEM, keyPEM := ca.issue(t, 3, "")
+	writeFile(t, dir, "server.crt", certPEM)
+	writeFile(t, dir, "server.key", keyPEM)
+	if changed, err := r.reload(); err != nil || !changed {
+		t.Fatalf("Expected the rotated certificate to reload, got changed %v (err %v)", changed, err)
+	}
+	if served, _ := handshake(t, r, ca, nil); served == nil || served.SerialNumber.Int64() != 3 {
+		t.Errorf("Expected new handshakes to get the rotated certificate, got %v", served)
+	}
+
+	// A half-written pair is refused and the previous certificate stays in use
+	writeFile(t, dir, "server.key", []byte("not a key"))
+	if _, err := r.reload(); err == nil {
+		t.Error("Expected a mismatched key to fail the reload")
+	}
+	if served, _ := handshake(t, r, ca, nil); served == nil || served.SerialNumber.Int64() != 3 {
+		t.Errorf("Expected the previous certificate after a failed reload, got %v", served)
+	}
+}
+
+func TestSPIFFEID(t *testing.T) {
+	ca := newTestCA(t)
+	cert := ca.clientCert(t, "spiffe://example.org/checkout")
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		t.Fatalf("Failed to parse certificate: %v", err)
+	}
+	if id, err := SPIFFEID(leaf); err != nil || id != "spiffe://example.org/checkout" {
+		t.Errorf("Expected spiffe://example.org/checkout, got %q (err %v)", id, err)
+	}
+
+	other, _ := url.Parse("spiffe://example.org/reporting")
+	leaf.URIs = append(leaf.URIs, other)
+	if _, err := SPIFFEID(leaf); err == nil {
+		t.Error("Expected a certificate with two SPIFFE IDs to be rejected")
+	}
+}
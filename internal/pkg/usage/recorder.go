@@ -0,0 +1,170 @@
+package usage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"catalog-proj/internal/models/m_api_usage"
+	"catalog-proj/internal/pkg/apikey"
+	"catalog-proj/internal/pkg/clock"
+	"catalog-proj/internal/pkg/coalesce"
+	"catalog-proj/internal/pkg/tenant"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Counter is one of the usage counters kept per caller and day
+type Counter int
+
+const (
+	CounterRequests Counter = iota
+	CounterRows
+	CounterMutations
+)
+
+// Key identifies one counter of a caller's usage on a UTC day
+type Key struct {
+	TenantID string
+	KeyID    string // Empty for requests made without an API key
+	Day      time.Time
+	Counter  Counter
+}
+
+const (
+	day = 24 * time.Hour
+	// defaultWindow is the range List covers without a start
+	defaultWindow = 30 * day
+	// MaxWindow bounds the range of one List call
+	MaxWindow = 366 * day
+)
+
+// ErrInvalidRange is returned by List for empty or oversized day ranges
+var ErrInvalidRange = errors.New("invalid usage range")
+
+// Recorder counts each caller's requests, returned rows and committed mutations and
+// writes them to the usage table in batches
+// Usage buffered when a server crashes is lost, so totals are a lower bound
+type Recorder struct {
+	buffer *coalesce.Buffer[Key]
+	store  Store
+	clock  clock.Clock
+}
+
+// NewRecorder creates a recorder that flushes early once maxPendingKeys counters are buffered
+func NewRecorder(store Store, clock clock.Clock, maxPendingKeys int) *Recorder {
+	return &Recorder{
+		buffer: coalesce.NewBuffer("api_usage", maxPendingKeys, func(ctx context.Context, counts map[Key]int64) error {
+			return store.Add(ctx, counts, clock.Now())
+		}),
+		store: store,
+		clock: clock,
+	}
+}
+
+// Run writes buffered usage every interval until ctx is done
+func (r *Recorder) Run(ctx context.Context, interval time.Duration) {
+	r.buffer.Run(ctx, interval)
+}
+
+// Flush writes everything buffered so far
+func (r *Recorder) Flush(ctx context.Context) error {
+	return r.buffer.Flush(ctx)
+}
+
+// List returns the caller's tenant's usage for the UTC days in [start, end), oldest first
+// Both times are truncated to the day; a zero end means the end of today and a zero start
+// 30 days before end. Usage still buffered in memory is not included
+func (r *Recorder) List(ctx context.Context, start, end time.Time, keyID string) ([]*m_api_usage.Usage, error) {
+	if end.IsZero() {
+		end = r.clock.Now().UTC().Truncate(day).Add(day)
+	}
+	end = end.UTC().Truncate(day)
+	if start.IsZero() {
+		start = end.Add(-defaultWindow)
+	}
+	start = start.UTC().Truncate(day)
+	if !start.Before(end) {
+		return nil, fmt.Errorf("%w: start must be before end", ErrInvalidRange)
+	}
+	if end.Sub(start) > MaxWindow {
+		return nil, fmt.Errorf("%w: at most %d days", ErrInvalidRange, MaxWindow/day)
+	}
+	return r.store.List(ctx, tenant.FromContext(ctx), start, end, keyID)
+}
+
+// meter collects the mutations committed while a request is served
+type meter struct {
+	mutations atomic.Int64
+}
+
+type contextKey struct{}
+
+// AddMutations records n committed Spanner mutations against the request in ctx
+// It does nothing outside a request metered by UnaryServerInterceptor
+func AddMutations(ctx context.Context, n int) {
+	if m, ok := ctx.Value(contextKey{}).(*meter); ok {
+		m.mutations.Add(int64(n))
+	}
+}
+
+// UnaryServerInterceptor records a request against its tenant and API key, with the rows it
+// returned and the mutations it committed
+// It must run after the tenant and API key interceptors so the caller is known
+func (r *Recorder) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		m := &meter{}
+		resp, err := handler(context.WithValue(ctx, contextKey{}, m), req)
+
+		key := Key{
+			TenantID: tenant.FromContext(ctx),
+			KeyID:    apikey.KeyIDFromContext(ctx),
+			Day:      r.clock.Now().UTC().Truncate(day),
+		}
+		r.add(key, CounterRequests, 1)
+		if msg, ok := resp.(proto.Message); ok && err == nil {
+			r.add(key, CounterRows, countRows(msg.ProtoReflect()))
+		}
+		r.add(key, CounterMutations, m.mutations.Load())
+		return resp, err
+	}
+}
+
+// add buffers a non-zero counter increment
+func (r *Recorder) add(key Key, counter Counter, n int64) {
+	if n == 0 {
+		return
+	}
+	key.Counter = counter
+	r.buffer.Add(key, n)
+}
+
+// countRows counts the entries of a response's top-level repeated message fields,
+// or 1 for a response that is or carries a single product
+func countRows(msg protoreflect.Message) int64 {
+	if msg.Descriptor().Name() == "Product" {
+		return 1
+	}
+
+	var rows, products int64
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Kind() != protoreflect.MessageKind {
+			return true
+		}
+		switch {
+		case fd.IsList():
+			rows += int64(v.List().Len())
+		case !fd.IsMap() && fd.Message().Name() == "Product":
+			products++
+		}
+		return true
+	})
+	if rows > 0 {
+		return rows
+	}
+	return products
+}
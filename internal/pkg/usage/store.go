@@ -0,0 +1,129 @@
+package usage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"catalog-proj/internal/models/m_api_usage"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+)
+
+// Store persists per-caller usage rows
+type Store interface {
+	// Add adds coalesced counts to their usage rows in one transaction, stamping them with now
+	Add(ctx context.Context, counts map[Key]int64, now time.Time) error
+	// List returns the tenant's usage rows for days in [from, to), oldest first,
+	// limited to one API key unless keyID is empty
+	List(ctx context.Context, tenantID string, from, to time.Time, keyID string) ([]*m_api_usage.Usage, error)
+}
+
+// SpannerStore implements Store using the api_usage table
+type SpannerStore struct {
+	client *spanner.Client
+}
+
+// NewSpannerStore creates a new Spanner usage store
+func NewSpannerStore(client *spanner.Client) *SpannerStore {
+	return &SpannerStore{
+		client: client,
+	}
+}
+
+// rowKey identifies a usage row
+type rowKey struct {
+	tenantID string
+	day      time.Time
+	keyID    string
+}
+
+// Add adds coalesced counts to their usage rows
+func (s *SpannerStore) Add(ctx context.Context, counts map[Key]int64, now time.Time) error {
+	deltas := make(map[rowKey]*m_api_usage.Usage, len(counts))
+	for key, n := range counts {
+		rk := rowKey{tenantID: key.TenantID, day: key.Day, keyID: key.KeyID}
+		row, ok := deltas[rk]
+		if !ok {
+			row = &m_api_usage.Usage{TenantID: key.TenantID, Day: key.Day, KeyID: key.KeyID}
+			deltas[rk] = row
+		}
+		switch key.Counter {
+		case CounterRequests:
+			row.RequestCount += n
+		case CounterRows:
+			row.RowsReturned += n
+		case CounterMutations:
+			row.MutationCount += n
+		}
+	}
+
+	keys := make([]spanner.KeySet, 0, len(deltas))
+	for rk := range deltas {
+		keys = append(keys, spanner.Key{rk.tenantID, rk.day, rk.keyID})
+	}
+
+	_, err := s.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		current := make(map[rowKey]*m_api_usage.Usage, len(deltas))
+		err := txn.Read(ctx, m_api_usage.TableName, spanner.KeySets(keys...), m_api_usage.AllColumns()).Do(func(row *spanner.Row) error {
+			usage := &m_api_usage.Usage{}
+			if err := row.ToStruct(usage); err != nil {
+				return err
+			}
+			current[rowKey{tenantID: usage.TenantID, day: usage.Day.UTC(), keyID: usage.KeyID}] = usage
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to read usage rows: %w", err)
+		}
+
+		mutations := make([]*spanner.Mutation, 0, len(deltas))
+		for rk, delta := range deltas {
+			if existing, ok := current[rk]; ok {
+				delta.RequestCount += existing.RequestCount
+				delta.RowsReturned += existing.RowsReturned
+				delta.MutationCount += existing.MutationCount
+			}
+			delta.UpdatedAt = now
+			mutations = append(mutations, delta.UpsertMut())
+		}
+		return txn.BufferWrite(mutations)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add usage: %w", err)
+	}
+	return nil
+}
+
+// List returns the tenant's usage rows for days in [from, to)
+func (s *SpannerStore) List(ctx context.Context, tenantID string, from, to time.Time, keyID string) ([]*m_api_usage.Usage, error) {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(`SELECT * FROM %s
+			WHERE tenant_id = @tenant AND day >= @from AND day < @to
+			AND (@key = '' OR key_id = @key)
+			ORDER BY day, key_id`, m_api_usage.TableName),
+		Params: map[string]interface{}{"tenant": tenantID, "from": from, "to": to, "key": keyID},
+	}
+
+	iter := s.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var rows []*m_api_usage.Usage
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list usage: %w", err)
+		}
+
+		usage := &m_api_usage.Usage{}
+		if err := row.ToStruct(usage); err != nil {
+			return nil, fmt.Errorf("failed to parse usage row: %w", err)
+		}
+		rows = append(rows, usage)
+	}
+	return rows, nil
+}
@@ -0,0 +1,77 @@
+package services
+
+import (
+	"catalog-proj/internal/pkg/apikey"
+	pb "catalog-proj/proto/product/v1"
+	pbv2 "catalog-proj/proto/product/v2"
+
+	"cloud.google.com/go/longrunning/autogen/longrunningpb"
+)
+
+// apiKeyMethodScopes is the scope an API key needs for each RPC
+// RPCs missing here require admin, so a new RPC stays closed to read and write keys until it is listed
+var apiKeyMethodScopes = map[string]apikey.Scope{
+	// Reads; RecordProductView is storefront telemetry and is open to read keys
+	pb.ProductService_GetProduct_FullMethodName:              apikey.ScopeRead,
+	pb.ProductService_ListProducts_FullMethodName:            apikey.ScopeRead,
+	pb.ProductService_FindSimilarProducts_FullMethodName:     apikey.ScopeRead,
+	pb.ProductService_CompareProducts_FullMethodName:         apikey.ScopeRead,
+	pb.ProductService_ValidateProduct_FullMethodName:         apikey.ScopeRead,
+	pb.ProductService_GetProductHistory_FullMethodName:       apikey.ScopeRead,
+	pb.ProductService_GetProductByExternalRef_FullMethodName: apikey.ScopeRead,
+	pb.ProductService_SearchProducts_FullMethodName:          apikey.ScopeRead,
+	pb.ProductService_SuggestProducts_FullMethodName:         apikey.ScopeRead,
+	pb.ProductService_RecordProductView_FullMethodName:       apikey.ScopeRead,
+	pb.ProductService_GetProductStats_FullMethodName:         apikey.ScopeRead,
+	pb.ProductService_ListNewArrivals_FullMethodName:         apikey.ScopeRead,
+	pb.ProductService_ListTrendingProducts_FullMethodName:    apikey.ScopeRead,
+	pb.ProductService_GetRecommendations_FullMethodName:      apikey.ScopeRead,
+	pb.ProductService_GetProductJsonLd_FullMethodName:        apikey.ScopeRead,
+	pb.ProductService_ListProductVersions_FullMethodName:     apikey.ScopeRead,
+	pb.ProductService_GetCategoryTemplate_FullMethodName:     apikey.ScopeRead,
+	pb.ProductService_ListCategories_FullMethodName:          apikey.ScopeRead,
+	pbv2.ProductService_GetProduct_FullMethodName:            apikey.ScopeRead,
+	pbv2.ProductService_ListProducts_FullMethodName:          apikey.ScopeRead,
+	longrunningpb.Operations_GetOperation_FullMethodName:     apikey.ScopeRead,
+	longrunningpb.Operations_ListOperations_FullMethodName:   apikey.ScopeRead,
+	longrunningpb.Operations_WaitOperation_FullMethodName:    apikey.ScopeRead,
+
+	// Writes
+	pb.ProductService_CreateProduct_FullMethodName:           apikey.ScopeWrite,
+	pb.ProductService_UpdateProduct_FullMethodName:           apikey.ScopeWrite,
+	pb.ProductService_ApplyDiscount_FullMethodName:           apikey.ScopeWrite,
+	pb.ProductService_RemoveDiscount_FullMethodName:          apikey.ScopeWrite,
+	pb.ProductService_ActivateProduct_FullMethodName:         apikey.ScopeWrite,
+	pb.ProductService_DeactivateProduct_FullMethodName:       apikey.ScopeWrite,
+	pb.ProductService_ArchiveProduct_FullMethodName:          apikey.ScopeWrite,
+	pb.ProductService_BatchImportProducts_FullMethodName:     apikey.ScopeWrite,
+	pb.ProductService_ReviewProduct_FullMethodName:           apikey.ScopeWrite,
+	pb.ProductService_SetChannels_FullMethodName:             apikey.ScopeWrite,
+	pb.ProductService_SetMetadata_FullMethodName:             apikey.ScopeWrite,
+	pb.ProductService_SetAttributes_FullMethodName:           apikey.ScopeWrite,
+	pb.ProductService_LinkExternalRef_FullMethodName:         apikey.ScopeWrite,
+	pb.ProductService_UnlinkExternalRef_FullMethodName:       apikey.ScopeWrite,
+	pb.ProductService_BatchActivateProducts_FullMethodName:   apikey.ScopeWrite,
+	pb.ProductService_BatchDeactivateProducts_FullMethodName: apikey.ScopeWrite,
+	pb.ProductService_BatchArchiveProducts_FullMethodName:    apikey.ScopeWrite,
+	pb.ProductService_ChangeBasePrice_FullMethodName:         apikey.ScopeWrite,
+	pb.ProductService_SetPriceFloor_FullMethodName:           apikey.ScopeWrite,
+	pb.ProductService_RollbackToVersion_FullMethodName:       apikey.ScopeWrite,
+	pb.ProductService_SaveDraft_FullMethodName:               apikey.ScopeWrite,
+	pb.ProductService_PublishDraft_FullMethodName:            apikey.ScopeWrite,
+	pb.ProductService_DiscardDraft_FullMethodName:            apikey.ScopeWrite,
+	pbv2.ProductService_CreateProduct_FullMethodName:         apikey.ScopeWrite,
+	pbv2.ProductService_UpdateProduct_FullMethodName:         apikey.ScopeWrite,
+	pbv2.ProductService_DeleteProduct_FullMethodName:         apikey.ScopeWrite,
+	pbv2.ProductService_ActivateProduct_FullMethodName:       apikey.ScopeWrite,
+	pbv2.ProductService_DeactivateProduct_FullMethodName:     apikey.ScopeWrite,
+	pbv2.ProductService_ApplyDiscount_FullMethodName:         apikey.ScopeWrite,
+	pbv2.ProductService_RemoveDiscount_FullMethodName:        apikey.ScopeWrite,
+	longrunningpb.Operations_CancelOperation_FullMethodName:  apikey.ScopeWrite,
+	longrunningpb.Operations_DeleteOperation_FullMethodName:  apikey.ScopeWrite,
+
+	// Admin: SetLegalHold, PurgeArchivedProducts, ExportProductData, RebuildProjection,
+	// ReassignCategory, MergeProducts, CloneProduct, CreateTenant, DeleteTenant, the merchandising rule, category template, tenant settings and API key RPCs
+	// are left unlisted. ApproveChange and RejectChange are too: a write key may request a held
+	// price change, but only an admin key may decide one
+}
@@ -0,0 +1,23 @@
+package services
+
+import (
+	"catalog-proj/internal/pkg/config"
+	"catalog-proj/internal/pkg/featureflags"
+)
+
+// newFeatureFlags builds the flag provider from the configured rules
+// Flags without a rule default to the setting they roll out, e.g. search_fuzzy to CATALOG_SEARCH_FUZZY
+func newFeatureFlags(cfg *config.Config) featureflags.Flags {
+	rules := map[string]featureflags.Rule{
+		featureflags.SearchFuzzy: {Enabled: cfg.Search.Fuzzy},
+	}
+	for name, rule := range cfg.Flags.Rules {
+		rules[name] = featureflags.Rule{
+			Enabled:        rule.Enabled,
+			Tenants:        rule.Tenants,
+			ExcludeTenants: rule.ExcludeTenants,
+			Percent:        rule.Percent,
+		}
+	}
+	return featureflags.NewStatic(rules)
+}
@@ -0,0 +1,419 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"catalog-proj/internal/app/product/usecases/generate_product_feeds"
+	"catalog-proj/internal/app/product/usecases/purge_archived_products"
+	"catalog-proj/internal/app/product/usecases/purge_cdn_cache"
+	"catalog-proj/internal/app/product/usecases/refresh_curated_lists"
+	"catalog-proj/internal/app/product/usecases/refresh_effective_prices"
+	"catalog-proj/internal/app/product/usecases/refresh_product_counts"
+	"catalog-proj/internal/app/product/usecases/refresh_product_suggestions"
+	"catalog-proj/internal/app/product/usecases/sync_search_index"
+	"catalog-proj/internal/models/m_job"
+	"catalog-proj/internal/pkg/config"
+	"catalog-proj/internal/pkg/jobs"
+	"catalog-proj/internal/pkg/logging"
+)
+
+const (
+	// purgeArchivedProductsJobKind purges archived products past the retention period
+	purgeArchivedProductsJobKind = "purge_archived_products"
+	// purgeArchivedProductsUniqueKey keeps a single retention job queued across all servers
+	purgeArchivedProductsUniqueKey = "retention:purge_archived_products"
+
+	// refreshProductCountsJobKind recounts the products behind approximate list totals
+	refreshProductCountsJobKind = "refresh_product_counts"
+	// refreshProductCountsUniqueKey keeps a single count refresh job queued across all servers
+	refreshProductCountsUniqueKey = "counts:refresh_product_counts"
+
+	// refreshEffectivePricesJobKind reprices products whose discount started or ended
+	refreshEffectivePricesJobKind = "refresh_effective_prices"
+	// refreshEffectivePricesUniqueKey keeps a single effective price refresh job queued across all servers
+	refreshEffectivePricesUniqueKey = "pricing:refresh_effective_prices"
+
+	// syncSearchIndexJobKind applies new outbox events to the OpenSearch index
+	syncSearchIndexJobKind = "sync_search_index"
+	// syncSearchIndexUniqueKey keeps a single index sync job queued across all servers
+	syncSearchIndexUniqueKey = "search:sync_search_index"
+
+	// refreshProductSuggestionsJobKind rebuilds the search-as-you-type suggestions
+	refreshProductSuggestionsJobKind = "refresh_product_suggestions"
+	// refreshProductSuggestionsUniqueKey keeps a single suggestions refresh job queued across all servers
+	refreshProductSuggestionsUniqueKey = "suggest:refresh_product_suggestions"
+
+	// refreshCuratedListsJobKind rescores trending products and rebuilds the curated lists
+	refreshCuratedListsJobKind = "refresh_curated_lists"
+	// refreshCuratedListsUniqueKey keeps a single curated lists refresh job queued across all servers
+	refreshCuratedListsUniqueKey = "curated:refresh_curated_lists"
+
+	// generateProductFeedsJobKind uploads the sales-channel product feeds
+	generateProductFeedsJobKind = "generate_product_feeds"
+	// generateProductFeedsUniqueKey keeps a single feed job queued across all servers
+	generateProductFeedsUniqueKey = "feeds:generate_product_feeds"
+
+	// purgeCDNCacheJobKind purges the CDN pages of products changed by new outbox events
+	purgeCDNCacheJobKind = "purge_cdn_cache"
+	// purgeCDNCacheUniqueKey keeps a single purge job queued across all servers
+	purgeCDNCacheUniqueKey = "cdn:purge_cdn_cache"
+)
+
+// ScheduleRetention queues the retention job unless one is already pending
+// Every server calls it at startup; the unique key makes all but the first a no-op
+func (o *Options) ScheduleRetention(ctx context.Context) error {
+	if !o.retention.Enabled {
+		return nil
+	}
+	_, err := o.JobQueue.Enqueue(ctx, purgeArchivedProductsJobKind, nil, jobs.EnqueueOptions{
+		UniqueKey: purgeArchivedProductsUniqueKey,
+	})
+	if errors.Is(err, jobs.ErrAlreadyQueued) {
+		return nil
+	}
+	return err
+}
+
+// purgeArchivedProductsJob queues the next retention run an interval later and purges once
+func purgeArchivedProductsJob(purge *purge_archived_products.Interactor, queue *jobs.Queue, cfg config.RetentionConfig) jobs.Handler {
+	log := logging.Component("jobs")
+	return func(ctx context.Context, job *m_job.Job) error {
+		if !cfg.Enabled {
+			// Retention was turned off after the job was queued; let the chain end here
+			return nil
+		}
+
+		// Queue the next run first so a failing purge never breaks the schedule
+		_, err := queue.Enqueue(ctx, purgeArchivedProductsJobKind, nil, jobs.EnqueueOptions{
+			RunAt:     job.RunAt.Add(cfg.Interval),
+			UniqueKey: purgeArchivedProductsUniqueKey,
+		})
+		if err != nil && !errors.Is(err, jobs.ErrAlreadyQueued) {
+			return err
+		}
+
+		resp, err := purge.Execute(ctx, &purge_archived_products.Request{
+			RetentionDays: cfg.ArchivedDays,
+			Limit:         cfg.BatchSize,
+		})
+		if err != nil {
+			return err
+		}
+		log.Info("Retention purge completed",
+			"purged", len(resp.Purged),
+			"held", len(resp.Held),
+			"skipped", len(resp.Skipped),
+			"events_deleted", resp.EventsDeleted)
+		return nil
+	}
+}
+
+// ScheduleCountRefresh queues the product count refresh job unless one is already pending
+func (o *Options) ScheduleCountRefresh(ctx context.Context) error {
+	if !o.counts.Enabled {
+		return nil
+	}
+	_, err := o.JobQueue.Enqueue(ctx, refreshProductCountsJobKind, nil, jobs.EnqueueOptions{
+		UniqueKey: refreshProductCountsUniqueKey,
+	})
+	if errors.Is(err, jobs.ErrAlreadyQueued) {
+		return nil
+	}
+	return err
+}
+
+// refreshProductCountsJob queues the next refresh an interval later and recounts once
+func refreshProductCountsJob(refresh *refresh_product_counts.Interactor, queue *jobs.Queue, cfg config.CountsConfig) jobs.Handler {
+	log := logging.Component("jobs")
+	return func(ctx context.Context, job *m_job.Job) error {
+		if !cfg.Enabled {
+			return nil
+		}
+
+		_, err := queue.Enqueue(ctx, refreshProductCountsJobKind, nil, jobs.EnqueueOptions{
+			RunAt:     job.RunAt.Add(cfg.Interval),
+			UniqueKey: refreshProductCountsUniqueKey,
+		})
+		if err != nil && !errors.Is(err, jobs.ErrAlreadyQueued) {
+			return err
+		}
+
+		resp, err := refresh.Execute(ctx)
+		if err != nil {
+			return err
+		}
+		log.Info("Product counts refreshed", "groups", resp.Groups)
+		return nil
+	}
+}
+
+// ScheduleEffectivePriceRefresh queues the effective price refresh job unless one is already pending
+func (o *Options) ScheduleEffectivePriceRefresh(ctx context.Context) error {
+	if !o.pricing.RefreshEnabled {
+		return nil
+	}
+	_, err := o.JobQueue.Enqueue(ctx, refreshEffectivePricesJobKind, nil, jobs.EnqueueOptions{
+		UniqueKey: refreshEffectivePricesUniqueKey,
+	})
+	if errors.Is(err, jobs.ErrAlreadyQueued) {
+		return nil
+	}
+	return err
+}
+
+// refreshEffectivePricesJob queues the next refresh an interval later and reprices expired effective prices once
+func refreshEffectivePricesJob(refresh *refresh_effective_prices.Interactor, queue *jobs.Queue, cfg config.PricingConfig) jobs.Handler {
+	log := logging.Component("jobs")
+	return func(ctx context.Context, job *m_job.Job) error {
+		if !cfg.RefreshEnabled {
+			return nil
+		}
+
+		_, err := queue.Enqueue(ctx, refreshEffectivePricesJobKind, nil, jobs.EnqueueOptions{
+			RunAt:     job.RunAt.Add(cfg.RefreshInterval),
+			UniqueKey: refreshEffectivePricesUniqueKey,
+		})
+		if err != nil && !errors.Is(err, jobs.ErrAlreadyQueued) {
+			return err
+		}
+
+		resp, err := refresh.Execute(ctx, &refresh_effective_prices.Request{BatchSize: cfg.RefreshBatchSize})
+		if err != nil {
+			return err
+		}
+		if resp.Repriced > 0 {
+			log.Info("Effective prices refreshed", "repriced", resp.Repriced)
+		}
+		return nil
+	}
+}
+
+// ScheduleSearchIndexSync creates the OpenSearch index if missing and queues the index sync job
+// unless one is already pending; it does nothing unless search is backed by OpenSearch
+func (o *Options) ScheduleSearchIndexSync(ctx context.Context) error {
+	if o.searchIndex == nil {
+		return nil
+	}
+	if err := o.searchIndex.EnsureIndex(ctx); err != nil {
+		return err
+	}
+	_, err := o.JobQueue.Enqueue(ctx, syncSearchIndexJobKind, nil, jobs.EnqueueOptions{
+		UniqueKey: syncSearchIndexUniqueKey,
+	})
+	if errors.Is(err, jobs.ErrAlreadyQueued) {
+		return nil
+	}
+	return err
+}
+
+// syncSearchIndexJob queues the next sync an interval later and applies pending events once
+func syncSearchIndexJob(sync *sync_search_index.Interactor, queue *jobs.Queue, cfg config.SearchConfig) jobs.Handler {
+	log := logging.Component("jobs")
+	return func(ctx context.Context, job *m_job.Job) error {
+		if cfg.Backend != config.SearchBackendOpenSearch {
+			return nil
+		}
+
+		_, err := queue.Enqueue(ctx, syncSearchIndexJobKind, nil, jobs.EnqueueOptions{
+			RunAt:     job.RunAt.Add(cfg.IndexInterval),
+			UniqueKey: syncSearchIndexUniqueKey,
+		})
+		if err != nil && !errors.Is(err, jobs.ErrAlreadyQueued) {
+			return err
+		}
+
+		resp, err := sync.Execute(ctx, &sync_search_index.Request{})
+		if err != nil {
+			return err
+		}
+		if resp.Events > 0 {
+			log.Info("Search index synced", "events", resp.Events, "indexed", resp.Indexed, "removed", resp.Removed)
+		}
+		return nil
+	}
+}
+
+// ScheduleSuggestionRefresh queues the suggestions refresh job unless one is already pending
+func (o *Options) ScheduleSuggestionRefresh(ctx context.Context) error {
+	if !o.suggest.Enabled {
+		return nil
+	}
+	_, err := o.JobQueue.Enqueue(ctx, refreshProductSuggestionsJobKind, nil, jobs.EnqueueOptions{
+		UniqueKey: refreshProductSuggestionsUniqueKey,
+	})
+	if errors.Is(err, jobs.ErrAlreadyQueued) {
+		return nil
+	}
+	return err
+}
+
+// refreshProductSuggestionsJob queues the next refresh an interval later and rebuilds the suggestions once
+func refreshProductSuggestionsJob(refresh *refresh_product_suggestions.Interactor, queue *jobs.Queue, cfg config.SuggestConfig) jobs.Handler {
+	log := logging.Component("jobs")
+	return func(ctx context.Context, job *m_job.Job) error {
+		if !cfg.Enabled {
+			return nil
+		}
+
+		_, err := queue.Enqueue(ctx, refreshProductSuggestionsJobKind, nil, jobs.EnqueueOptions{
+			RunAt:     job.RunAt.Add(cfg.Interval),
+			UniqueKey: refreshProductSuggestionsUniqueKey,
+		})
+		if err != nil && !errors.Is(err, jobs.ErrAlreadyQueued) {
+			return err
+		}
+
+		resp, err := refresh.Execute(ctx)
+		if err != nil {
+			return err
+		}
+		log.Info("Product suggestions refreshed", "suggestions", resp.Suggestions)
+		return nil
+	}
+}
+
+// ScheduleCuratedListRefresh queues the curated lists refresh job unless one is already pending
+func (o *Options) ScheduleCuratedListRefresh(ctx context.Context) error {
+	if !o.curated.Enabled {
+		return nil
+	}
+	_, err := o.JobQueue.Enqueue(ctx, refreshCuratedListsJobKind, nil, jobs.EnqueueOptions{
+		UniqueKey: refreshCuratedListsUniqueKey,
+	})
+	if errors.Is(err, jobs.ErrAlreadyQueued) {
+		return nil
+	}
+	return err
+}
+
+// refreshCuratedListsJob queues the next refresh an interval later and rebuilds the curated lists once
+func refreshCuratedListsJob(refresh *refresh_curated_lists.Interactor, queue *jobs.Queue, cfg config.CuratedConfig) jobs.Handler {
+	log := logging.Component("jobs")
+	return func(ctx context.Context, job *m_job.Job) error {
+		if !cfg.Enabled {
+			return nil
+		}
+
+		_, err := queue.Enqueue(ctx, refreshCuratedListsJobKind, nil, jobs.EnqueueOptions{
+			RunAt:     job.RunAt.Add(cfg.Interval),
+			UniqueKey: refreshCuratedListsUniqueKey,
+		})
+		if err != nil && !errors.Is(err, jobs.ErrAlreadyQueued) {
+			return err
+		}
+
+		resp, err := refresh.Execute(ctx, &refresh_curated_lists.Request{
+			Size:              cfg.Size,
+			NewArrivalsWindow: cfg.NewArrivalsWindow,
+			TrendingHalfLife:  cfg.TrendingHalfLife,
+		})
+		if err != nil {
+			return err
+		}
+		log.Info("Curated lists refreshed", "trends_updated", resp.TrendsUpdated, "entries", resp.Entries)
+		return nil
+	}
+}
+
+// ScheduleFeedGeneration queues the product feed job unless one is already pending
+func (o *Options) ScheduleFeedGeneration(ctx context.Context) error {
+	if !o.feeds.Enabled {
+		return nil
+	}
+	_, err := o.JobQueue.Enqueue(ctx, generateProductFeedsJobKind, nil, jobs.EnqueueOptions{
+		UniqueKey: generateProductFeedsUniqueKey,
+	})
+	if errors.Is(err, jobs.ErrAlreadyQueued) {
+		return nil
+	}
+	return err
+}
+
+// generateProductFeedsJob queues the next run an interval later and uploads every configured feed once
+func generateProductFeedsJob(generate *generate_product_feeds.Interactor, queue *jobs.Queue, cfg config.FeedsConfig, pageSize int) jobs.Handler {
+	log := logging.Component("jobs")
+	// Tenants run in a stable order so their logs line up from run to run
+	feeds := make([]generate_product_feeds.TenantFeed, 0, len(cfg.Tenants))
+	for tenantID, feed := range cfg.Tenants {
+		feeds = append(feeds, generate_product_feeds.TenantFeed{
+			TenantID:         tenantID,
+			Formats:          feed.Formats,
+			Channel:          feed.Channel,
+			Currency:         feed.Currency,
+			Brand:            feed.Brand,
+			ProductURL:       feed.ProductURL,
+			ImageMetadataKey: feed.ImageMetadataKey,
+		})
+	}
+	sort.Slice(feeds, func(i, j int) bool { return feeds[i].TenantID < feeds[j].TenantID })
+
+	return func(ctx context.Context, job *m_job.Job) error {
+		if !cfg.Enabled {
+			return nil
+		}
+
+		_, err := queue.Enqueue(ctx, generateProductFeedsJobKind, nil, jobs.EnqueueOptions{
+			RunAt:     job.RunAt.Add(cfg.Interval),
+			UniqueKey: generateProductFeedsUniqueKey,
+		})
+		if err != nil && !errors.Is(err, jobs.ErrAlreadyQueued) {
+			return err
+		}
+
+		resp, err := generate.Execute(ctx, &generate_product_feeds.Request{
+			Feeds:    feeds,
+			PageSize: pageSize,
+		})
+		if err != nil {
+			return err
+		}
+		log.Info("Product feeds generated", "products", resp.Products, "skipped", resp.Skipped, "uploaded", resp.Uploaded)
+		return nil
+	}
+}
+
+// ScheduleCDNPurge queues the CDN purge job unless one is already pending
+func (o *Options) ScheduleCDNPurge(ctx context.Context) error {
+	if !o.cdn.Enabled {
+		return nil
+	}
+	_, err := o.JobQueue.Enqueue(ctx, purgeCDNCacheJobKind, nil, jobs.EnqueueOptions{
+		UniqueKey: purgeCDNCacheUniqueKey,
+	})
+	if errors.Is(err, jobs.ErrAlreadyQueued) {
+		return nil
+	}
+	return err
+}
+
+// purgeCDNCacheJob queues the next purge an interval later and purges the pages behind pending events once
+func purgeCDNCacheJob(purge *purge_cdn_cache.Interactor, queue *jobs.Queue, cfg config.CDNConfig) jobs.Handler {
+	log := logging.Component("jobs")
+	return func(ctx context.Context, job *m_job.Job) error {
+		if !cfg.Enabled {
+			return nil
+		}
+
+		_, err := queue.Enqueue(ctx, purgeCDNCacheJobKind, nil, jobs.EnqueueOptions{
+			RunAt:     job.RunAt.Add(cfg.Interval),
+			UniqueKey: purgeCDNCacheUniqueKey,
+		})
+		if err != nil && !errors.Is(err, jobs.ErrAlreadyQueued) {
+			return err
+		}
+
+		resp, err := purge.Execute(ctx, &purge_cdn_cache.Request{
+			ProductURLs:  cfg.ProductURLs,
+			CategoryURLs: cfg.CategoryURLs,
+		})
+		if err != nil {
+			return err
+		}
+		if resp.Events > 0 {
+			log.Info("CDN cache purged", "events", resp.Events, "urls", resp.URLs)
+		}
+		return nil
+	}
+}
@@ -3,72 +3,310 @@ package services
 import (
 	"context"
 	"fmt"
+	"errors"
+	"log/slog"
+	"net/http"
 	"os"
+	"time"
 
+	"catalog-proj/internal/app/product/contracts"
+	"catalog-proj/internal/app/product/domain"
+	domainServices "catalog-proj/internal/app/product/domain/services"
+	"catalog-proj/internal/app/product/queries/compare_products"
+	"catalog-proj/internal/app/product/queries/export_product_data"
+	"catalog-proj/internal/app/product/queries/find_similar_products"
+	"catalog-proj/internal/app/product/queries/get_category_template"
 	"catalog-proj/internal/app/product/queries/get_product"
+	"catalog-proj/internal/app/product/queries/get_product_by_external_ref"
+	"catalog-proj/internal/app/product/queries/get_product_history"
+	"catalog-proj/internal/app/product/queries/get_product_json_ld"
+	"catalog-proj/internal/app/product/queries/get_product_stats"
+	"catalog-proj/internal/app/product/queries/get_recommendations"
+	"catalog-proj/internal/app/product/queries/get_tenant_settings"
+	"catalog-proj/internal/app/product/queries/list_categories"
+	"catalog-proj/internal/app/product/queries/list_curated_products"
+	"catalog-proj/internal/app/product/queries/list_merch_rules"
+	"catalog-proj/internal/app/product/queries/list_product_versions"
 	"catalog-proj/internal/app/product/queries/list_products"
-	domainServices "catalog-proj/internal/app/product/domain/services"
+	"catalog-proj/internal/app/product/queries/search_products"
+	"catalog-proj/internal/app/product/queries/suggest_products"
+	"catalog-proj/internal/app/product/queries/validate_product"
 	"catalog-proj/internal/app/product/repo"
+	"catalog-proj/internal/app/product/tenant_config"
 	"catalog-proj/internal/app/product/usecases/activate_product"
 	"catalog-proj/internal/app/product/usecases/apply_discount"
 	"catalog-proj/internal/app/product/usecases/archive_product"
+	"catalog-proj/internal/app/product/usecases/batch_transition"
+	"catalog-proj/internal/app/product/usecases/change_base_price"
+	"catalog-proj/internal/app/product/usecases/clone_product"
+	"catalog-proj/internal/app/product/usecases/create_merch_rule"
 	"catalog-proj/internal/app/product/usecases/create_product"
+	"catalog-proj/internal/app/product/usecases/create_tenant"
 	"catalog-proj/internal/app/product/usecases/deactivate_product"
+	"catalog-proj/internal/app/product/usecases/decide_change"
+	"catalog-proj/internal/app/product/usecases/delete_category_template"
+	"catalog-proj/internal/app/product/usecases/delete_merch_rule"
+	"catalog-proj/internal/app/product/usecases/delete_tenant"
+	"catalog-proj/internal/app/product/usecases/delete_tenant_settings"
+	"catalog-proj/internal/app/product/usecases/discard_draft"
+	"catalog-proj/internal/app/product/usecases/generate_product_feeds"
+	"catalog-proj/internal/app/product/usecases/link_external_ref"
+	"catalog-proj/internal/app/product/usecases/merge_products"
+	"catalog-proj/internal/app/product/usecases/publish_draft"
+	"catalog-proj/internal/app/product/usecases/purge_archived_products"
+	"catalog-proj/internal/app/product/usecases/purge_cdn_cache"
+	"catalog-proj/internal/app/product/usecases/put_category_template"
+	"catalog-proj/internal/app/product/usecases/put_tenant_settings"
+	"catalog-proj/internal/app/product/usecases/reassign_category"
+	"catalog-proj/internal/app/product/usecases/rebuild_projection"
+	"catalog-proj/internal/app/product/usecases/record_product_view"
+	"catalog-proj/internal/app/product/usecases/refresh_curated_lists"
+	"catalog-proj/internal/app/product/usecases/refresh_effective_prices"
+	"catalog-proj/internal/app/product/usecases/refresh_product_counts"
+	"catalog-proj/internal/app/product/usecases/refresh_product_suggestions"
 	"catalog-proj/internal/app/product/usecases/remove_discount"
+	"catalog-proj/internal/app/product/usecases/review_product"
+	"catalog-proj/internal/app/product/usecases/rollback_to_version"
+	"catalog-proj/internal/app/product/usecases/save_draft"
+	"catalog-proj/internal/app/product/usecases/set_attributes"
+	"catalog-proj/internal/app/product/usecases/set_channels"
+	"catalog-proj/internal/app/product/usecases/set_legal_hold"
+	"catalog-proj/internal/app/product/usecases/set_metadata"
+	"catalog-proj/internal/app/product/usecases/set_price_floor"
+	"catalog-proj/internal/app/product/usecases/sync_search_index"
+	"catalog-proj/internal/app/product/usecases/unlink_external_ref"
 	"catalog-proj/internal/app/product/usecases/update_product"
+	"catalog-proj/internal/models/m_outbox"
+	"catalog-proj/internal/models/m_product"
+	"catalog-proj/internal/pkg/breaker"
+	"catalog-proj/internal/pkg/cdn"
 	"catalog-proj/internal/pkg/clock"
-	spannerdriver "github.com/wuyiadepoju/commitplan/drivers/spanner"
+	"catalog-proj/internal/pkg/coalesce"
+	"catalog-proj/internal/pkg/committer"
+	"catalog-proj/internal/pkg/config"
+	"catalog-proj/internal/pkg/faults"
+	"catalog-proj/internal/pkg/gcs"
+	"catalog-proj/internal/pkg/grpcweb"
+	"catalog-proj/internal/pkg/idgen"
+	"catalog-proj/internal/pkg/jobs"
+	"catalog-proj/internal/pkg/apikey"
+	"catalog-proj/internal/pkg/lro"
+	"catalog-proj/internal/pkg/migrate"
+	"catalog-proj/internal/pkg/opensearch"
+	"catalog-proj/internal/pkg/preview"
+	"catalog-proj/internal/pkg/retry"
+	"catalog-proj/internal/pkg/shadow"
+	"catalog-proj/internal/pkg/spannertag"
+	"catalog-proj/internal/pkg/tenant"
+	"catalog-proj/internal/pkg/textnorm"
+	"catalog-proj/internal/pkg/tlsreload"
+	"catalog-proj/internal/pkg/usage"
+	"catalog-proj/internal/transport/gateway"
+	"catalog-proj/internal/transport/grpc/operations"
 	"catalog-proj/internal/transport/grpc/product"
+	"catalog-proj/internal/transport/grpc/productv2"
+	"github.com/wuyiadepoju/commitplan"
 
 	"cloud.google.com/go/spanner"
+	"google.golang.org/api/option"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/encoding/gzip"
 )
 
 // Options holds all service dependencies
 type Options struct {
-	SpannerClient *spanner.Client
-	GRPCServer    *grpc.Server
+	SpannerClient  *spanner.Client
+	GRPCServer     *grpc.Server
 	ProductHandler *product.Handler
+
+	// ProductV2Handler serves product.v2 by adapting it onto ProductHandler
+	ProductV2Handler *productv2.Handler
+
+	// OperationsHandler serves google.longrunning.Operations for bulk RPCs
+	OperationsHandler *operations.Handler
+
+	// PurgeArchivedProducts is run periodically by the retention job
+	PurgeArchivedProducts *purge_archived_products.Interactor
+
+	// JobQueue and JobWorker run background work (operations, retention) from the jobs table
+	JobQueue  *jobs.Queue
+	JobWorker *jobs.Worker
+
+	// ViewBuffer coalesces recorded product views until RunViewFlusher writes them
+	ViewBuffer *coalesce.Buffer[contracts.ViewKey]
+
+	// UsageRecorder buffers per-caller usage until RunUsageFlusher writes it
+	UsageRecorder *usage.Recorder
+
+	retention config.RetentionConfig
+	counts    config.CountsConfig
+	pricing   config.PricingConfig
+	suggest   config.SuggestConfig
+	views     config.ViewsConfig
+	usage     config.UsageConfig
+	curated   config.CuratedConfig
+	feeds     config.FeedsConfig
+	cdn       config.CDNConfig
+	tls       config.TLSConfig
+	grpcWeb   config.GRPCWebConfig
+	http      config.HTTPConfig
+	// certs is set when the gRPC server terminates TLS
+	certs *tlsreload.Reloader
+	// searchIndex is set when search is backed by OpenSearch
+	searchIndex *repo.OpenSearchIndex
 }
 
 // NewOptions creates and wires all dependencies
-func NewOptions(ctx context.Context, spannerDatabase string) (*Options, error) {
-	// 1. Create Spanner client
-	spannerClient, err := createSpannerClient(ctx, spannerDatabase)
+func NewOptions(ctx context.Context, cfg *config.Config) (*Options, error) {
+	// 1. Create Spanner client, provisioning a fresh emulator first
+	if err := provisionEmulator(ctx, cfg.Spanner); err != nil {
+		return nil, fmt.Errorf("failed to provision Spanner emulator: %w", err)
+	}
+	spannerClient, err := createSpannerClient(ctx, cfg.Spanner)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create Spanner client: %w", err)
 	}
+	if err := checkSchema(ctx, spannerClient, cfg.Spanner.SchemaCheck); err != nil {
+		spannerClient.Close()
+		return nil, err
+	}
 
-	// 2. Create clock
+	// 2. Create clock and the ID generator for rows keyed by a generated ID
 	clock := clock.NewRealClock()
+	ids := newIDGenerator(cfg.Spanner.IDStrategy)
+
+	// Feature flags roll behaviors out per tenant; the static provider reads config and CATALOG_FLAG_* variables
+	flags := newFeatureFlags(cfg)
 
-	// 3. Create committer
-	spannerCommitter := spannerdriver.NewCommitter(spannerClient)
+	// 3. Create committer (transient Spanner errors are retried with jittered backoff)
+	retryPolicy := retry.DefaultPolicy()
+	retryPolicy.MaxAttempts = cfg.Retry.MaxAttempts
+	retryPolicy.InitialBackoff = cfg.Retry.InitialBackoff
+	retryPolicy.MaxBackoff = cfg.Retry.MaxBackoff
+	retryPolicy.BudgetTokens = cfg.Retry.BudgetTokens
+	retrier := retry.NewRetrier(retryPolicy)
+
+	// Fault injection (never in production) sits innermost, so retries and the breaker see injected faults
+	// The base committer records commit timestamps for handlers that return the committed product
+	var baseCommitter commitplan.Committer = committer.NewSpannerCommitter(spannerClient)
+	var baseReadModel contracts.ReadModel = repo.NewSpannerReadModel(spannerClient, cfg.Paging.MaxScanRows)
+	var faultInjector *faults.Injector
+	if cfg.Faults.Enabled {
+		faultInjector = faults.New(faults.Settings{
+			Latency:            cfg.Faults.Latency,
+			AbortPercent:       cfg.Faults.AbortPercent,
+			UnavailablePercent: cfg.Faults.UnavailablePercent,
+			NotFoundPercent:    cfg.Faults.NotFoundPercent,
+		})
+		baseCommitter = committer.NewFaultyCommitter(baseCommitter, faultInjector)
+		baseReadModel = repo.NewFaultyReadModel(baseReadModel, faultInjector)
+	}
+
+	// Committed mutations are counted against the calling request's usage
+	spannerCommitter := committer.NewUsageCommitter(
+		committer.NewRetryingCommitter(baseCommitter, retrier),
+	)
+	usageRecorder := usage.NewRecorder(usage.NewSpannerStore(spannerClient), clock, cfg.Usage.MaxPendingKeys)
 
 	// 4. Create repositories
-	productRepo := repo.NewSpannerProductRepository(spannerClient)
-	spannerReadModel := repo.NewSpannerReadModel(spannerClient)
+	productRepo := repo.NewRetryingProductRepository(repo.NewSpannerProductRepository(spannerClient), retrier)
+	// Read model: retries inside, circuit breaker outside so the breaker sees post-retry outcomes
+	readModelBreaker := breaker.New("read_model", breaker.Settings{
+		FailureThreshold:  cfg.Breaker.FailureThreshold,
+		OpenTimeout:       cfg.Breaker.OpenTimeout,
+		HalfOpenMaxProbes: cfg.Breaker.HalfOpenMaxProbes,
+	}, clock)
+	spannerReadModel := repo.NewBreakerReadModel(
+		repo.NewRetryingReadModel(baseReadModel, retrier),
+		readModelBreaker,
+		clock,
+		repo.StaleCacheOptions{
+			Enabled:    cfg.Breaker.ServeStale,
+			MaxAge:     cfg.Breaker.StaleMaxAge,
+			MaxEntries: cfg.Breaker.StaleMaxEntries,
+		},
+	)
+
+	quotaCounter := repo.NewSpannerQuotaCounter(spannerClient)
+	retentionStore := repo.NewSpannerRetentionStore(spannerClient)
+	aliasStore := repo.NewSpannerAliasStore(spannerClient)
+	externalRefStore := repo.NewSpannerExternalRefStore(spannerClient)
+	pendingChangeStore := repo.NewSpannerPendingChangeStore(spannerClient)
+	merchRuleStore := repo.NewSpannerMerchRuleStore(spannerClient)
+	categoryTemplateStore := repo.NewSpannerCategoryTemplateStore(spannerClient)
+	tenantSettingsStore := repo.NewSpannerTenantSettingsStore(spannerClient)
+	suggestionStore := repo.NewSpannerSuggestionStore(spannerClient)
+	viewStore := repo.NewSpannerViewStore(spannerClient)
+	curatedListStore := repo.NewSpannerCuratedListStore(spannerClient)
+	nameLookup := repo.NewSpannerNameLookup(spannerClient)
+	versionStore := repo.NewSpannerVersionStore(spannerClient)
+	draftStore := repo.NewSpannerDraftStore(spannerClient)
 
 	// 5. Create domain services
-	pricingCalculator := domainServices.NewPricingCalculator()
+	pricingCalculator := domainServices.NewCachingPricingCalculator(cfg.Pricing.EnforceMAP, cfg.Pricing.CacheSize)
+	quotaPolicy := domainServices.NewQuotaPolicy(domainServices.QuotaLimits{
+		MaxProductsPerTenant:        cfg.Quota.MaxProductsPerTenant,
+		MaxProductsPerCategory:      cfg.Quota.MaxProductsPerCategory,
+		MaxActiveDiscountsPerTenant: cfg.Quota.MaxActiveDiscountsPerTenant,
+	})
+	uniqueNamePolicy := domainServices.NewUniqueNamePolicy(cfg.Catalog.UniqueNameTenants)
+	validationRules, err := newValidationRules(cfg.Catalog.ValidationRules)
+	if err != nil {
+		spannerClient.Close()
+		return nil, fmt.Errorf("failed to load validation rules: %w", err)
+	}
+	// Tenants without their own settings get the service defaults
+	tenantDefaults := domain.TenantSettings{
+		DefaultCurrency:        cfg.Catalog.DefaultCurrency,
+		MaxNameLength:          domain.MaxProductNameLength,
+		MaxDiscountBasisPoints: domain.MaxDiscountBasisPoints,
+	}
+	tenantConfig := tenant_config.NewTenantConfig(tenantSettingsStore, tenantDefaults, clock, cfg.Catalog.TenantSettingsCacheTTL)
+	synonyms, err := domainServices.NewSynonymTable(cfg.Search.Synonyms)
+	if err != nil {
+		spannerClient.Close()
+		return nil, fmt.Errorf("failed to load search synonyms: %w", err)
+	}
+
+	// Duplicate detection on create is backed by the find similar products query
+	var readModelForSimilar find_similar_products.ReadModel = spannerReadModel
+	findSimilarProductsQuery := find_similar_products.NewQuery(readModelForSimilar)
 
 	// 6. Create use cases
 	createProductInteractor := create_product.NewInteractor(
 		productRepo,
+		versionStore,
 		spannerCommitter,
 		clock,
+		quotaCounter,
+		quotaPolicy,
+		findSimilarProductsQuery,
+		uniqueNamePolicy,
+		nameLookup,
+		validationRules,
+		ids,
+		tenantConfig,
 	)
 
 	updateProductInteractor := update_product.NewInteractor(
 		productRepo,
+		versionStore,
 		spannerCommitter,
 		clock,
+		uniqueNamePolicy,
+		nameLookup,
+		validationRules,
+	tenantConfig,
 	)
 
 	applyDiscountInteractor := apply_discount.NewInteractor(
 		productRepo,
 		spannerCommitter,
 		clock,
+		quotaCounter,
+		quotaPolicy,
+		tenantConfig,
 	)
 
 	removeDiscountInteractor := remove_discount.NewInteractor(
@@ -95,24 +333,424 @@ func NewOptions(ctx context.Context, spannerDatabase string) (*Options, error) {
 		clock,
 	)
 
+	setLegalHoldInteractor := set_legal_hold.NewInteractor(
+		productRepo,
+		spannerCommitter,
+		clock,
+	)
+
+	reviewProductInteractor := review_product.NewInteractor(
+		productRepo,
+		spannerCommitter,
+		clock,
+	)
+
+	setChannelsInteractor := set_channels.NewInteractor(
+		productRepo,
+		spannerCommitter,
+		clock,
+	)
+
+	setMetadataInteractor := set_metadata.NewInteractor(
+		productRepo,
+		versionStore,
+		spannerCommitter,
+		clock,
+	)
+
+	linkExternalRefInteractor := link_external_ref.NewInteractor(
+		productRepo,
+		externalRefStore,
+		spannerCommitter,
+		clock,
+	)
+
+	unlinkExternalRefInteractor := unlink_external_ref.NewInteractor(
+		productRepo,
+		externalRefStore,
+		spannerCommitter,
+		clock,
+	)
+
+	changeBasePriceInteractor := change_base_price.NewInteractor(
+		productRepo,
+		pendingChangeStore,
+		domainServices.NewPriceApprovalPolicy(cfg.Approval.PriceChangeThresholdPercent),
+		spannerCommitter,
+		clock,
+		ids,
+	)
+
+	decideChangeInteractor := decide_change.NewInteractor(
+		productRepo,
+		pendingChangeStore,
+		clock,
+	)
+
+	setPriceFloorInteractor := set_price_floor.NewInteractor(
+		productRepo,
+		spannerCommitter,
+		clock,
+	)
+
+	batchTransitionInteractor := batch_transition.NewInteractor(
+		productRepo,
+		spannerCommitter,
+		clock,
+	)
+
+	mergeProductsInteractor := merge_products.NewInteractor(
+		productRepo,
+		aliasStore,
+		spannerCommitter,
+		clock,
+	)
+
+	createMerchRuleInteractor := create_merch_rule.NewInteractor(
+		productRepo,
+		merchRuleStore,
+		spannerCommitter,
+		clock,
+	)
+
+	deleteMerchRuleInteractor := delete_merch_rule.NewInteractor(
+		merchRuleStore,
+		spannerCommitter,
+	)
+
+	setAttributesInteractor := set_attributes.NewInteractor(
+		productRepo,
+		categoryTemplateStore,
+		spannerCommitter,
+		clock,
+	)
+
+	putCategoryTemplateInteractor := put_category_template.NewInteractor(
+		categoryTemplateStore,
+		spannerCommitter,
+		clock,
+	)
+
+	deleteCategoryTemplateInteractor := delete_category_template.NewInteractor(
+		categoryTemplateStore,
+		spannerCommitter,
+	)
+
+	putTenantSettingsInteractor := put_tenant_settings.NewInteractor(
+		tenantSettingsStore,
+		tenantConfig,
+		spannerCommitter,
+		clock,
+	)
+
+	deleteTenantSettingsInteractor := delete_tenant_settings.NewInteractor(
+		tenantSettingsStore,
+		tenantConfig,
+		spannerCommitter,
+	)
+
+	// Views are summed in memory and written in one transaction per flush
+	viewBuffer := coalesce.NewBuffer("product_views", cfg.Views.MaxPendingProducts,
+		func(ctx context.Context, counts map[contracts.ViewKey]int64) error {
+			return viewStore.AddViews(ctx, counts, clock.Now())
+		})
+	recordProductViewInteractor := record_product_view.NewInteractor(viewBuffer)
+
+	purgeArchivedProductsInteractor := purge_archived_products.NewInteractor(
+		retentionStore,
+		clock,
+	)
+
+	refreshProductCountsInteractor := refresh_product_counts.NewInteractor(
+		repo.NewSpannerProductCountStore(spannerClient),
+		clock,
+	)
+
+	refreshEffectivePricesInteractor := refresh_effective_prices.NewInteractor(
+		repo.NewSpannerEffectivePriceStore(spannerClient),
+		clock,
+	)
+
+	refreshProductSuggestionsInteractor := refresh_product_suggestions.NewInteractor(
+		suggestionStore,
+		clock,
+	)
+
+	refreshCuratedListsInteractor := refresh_curated_lists.NewInteractor(
+		curatedListStore,
+		clock,
+	)
+
+	rebuildProjectionInteractor := rebuild_projection.NewInteractor(
+		repo.NewSpannerProjectionStore(spannerClient),
+		productRepo,
+		spannerCommitter,
+		uniqueNamePolicy,
+	)
+
+	reassignCategoryInteractor := reassign_category.NewInteractor(
+		repo.NewSpannerCategoryScanner(spannerClient),
+		productRepo,
+		versionStore,
+		spannerCommitter,
+		clock,
+		uniqueNamePolicy,
+		nameLookup,
+		validationRules,
+	)
+
 	// 7. Create queries
 	// Note: Each query package has its own ReadModel interface to avoid import cycles
 	var readModelForGet get_product.ReadModel = spannerReadModel
 	var readModelForList list_products.ReadModel = spannerReadModel
-	
+
 	getProductQuery := get_product.NewQuery(
 		readModelForGet,
+		aliasStore,
+		draftStore,
 		pricingCalculator,
 		clock,
 	)
 
+	getProductByExternalRefQuery := get_product_by_external_ref.NewQuery(
+		externalRefStore,
+		getProductQuery,
+	)
+
+	getProductJsonLdQuery := get_product_json_ld.NewQuery(getProductQuery, clock, tenantConfig)
+
 	listProductsQuery := list_products.NewQuery(
 		readModelForList,
 		pricingCalculator,
 		clock,
+		list_products.PageLimits{
+			Default: cfg.Paging.DefaultPageSize,
+			Max:     cfg.Paging.MaxPageSize,
+		},
+		cfg.Pricing.Workers,
+	)
+
+	var readModelForCompare compare_products.ReadModel = spannerReadModel
+	compareProductsQuery := compare_products.NewQuery(
+		readModelForCompare,
+		pricingCalculator,
+		clock,
+	)
+
+	// Search candidates come from Spanner or from the OpenSearch index the sync job maintains
+	var readModelForSearch search_products.ReadModel = spannerReadModel
+	var searchIndex *repo.OpenSearchIndex
+	var syncSearchIndexInteractor *sync_search_index.Interactor
+	if cfg.Search.Backend == config.SearchBackendOpenSearch {
+		searchIndex = repo.NewOpenSearchIndex(
+			opensearch.NewClient(cfg.Search.OpenSearchURL, cfg.Search.OpenSearchIndex),
+			spannerReadModel,
+		)
+		readModelForSearch = searchIndex
+		syncSearchIndexInteractor = sync_search_index.NewInteractor(
+			repo.NewSpannerOutboxFeed(spannerClient),
+			spannerReadModel,
+			searchIndex,
+			spannerCommitter,
+			clock,
+		)
+	}
+	searchProductsQuery := search_products.NewQuery(
+		readModelForSearch,
+		pricingCalculator,
+		merchRuleStore,
+		synonyms,
+		flags,
+	)
+
+	listMerchRulesQuery := list_merch_rules.NewQuery(merchRuleStore)
+	getCategoryTemplateQuery := get_category_template.NewQuery(categoryTemplateStore)
+	getTenantSettingsQuery := get_tenant_settings.NewQuery(tenantSettingsStore, tenantDefaults)
+	listCategoriesQuery := list_categories.NewQuery(repo.NewSpannerCategoryCounter(spannerClient))
+
+	// Feeds are only uploaded when enabled, so credentials are only needed then
+	var generateProductFeedsInteractor *generate_product_feeds.Interactor
+	if cfg.Feeds.Enabled {
+		feedBucket, err := gcs.NewClient(ctx, cfg.Feeds.Endpoint, cfg.Feeds.Bucket)
+		if err != nil {
+			spannerClient.Close()
+			return nil, fmt.Errorf("failed to create feed bucket client: %w", err)
+		}
+		generateProductFeedsInteractor = generate_product_feeds.NewInteractor(
+			listProductsQuery,
+			feedBucket,
+			clock,
+		)
+	}
+
+	// CDN purges only run when enabled, so provider credentials are only needed then
+	var purgeCDNCacheInteractor *purge_cdn_cache.Interactor
+	if cfg.CDN.Enabled {
+		purger, err := newCachePurger(ctx, cfg.CDN)
+		if err != nil {
+			spannerClient.Close()
+			return nil, err
+		}
+		purgeCDNCacheInteractor = purge_cdn_cache.NewInteractor(
+			repo.NewSpannerOutboxFeed(spannerClient),
+			spannerReadModel,
+			purger,
+			spannerCommitter,
+			clock,
+		)
+	}
+
+	suggestProductsQuery := suggest_products.NewQuery(suggestionStore)
+
+	getProductStatsQuery := get_product_stats.NewQuery(viewStore)
+
+	var readModelForCurated list_curated_products.ReadModel = spannerReadModel
+	listCuratedProductsQuery := list_curated_products.NewQuery(
+		curatedListStore,
+		readModelForCurated,
+		pricingCalculator,
+		clock,
+	)
+
+	// Recommendations come from category peers; another Recommender can be plugged in here
+	var readModelForRecommendations get_recommendations.ReadModel = spannerReadModel
+	getRecommendationsQuery := get_recommendations.NewQuery(
+		repo.NewSpannerCoCategoryRecommender(spannerClient),
+		readModelForRecommendations,
+		pricingCalculator,
+		clock,
+	)
+
+	exportProductDataQuery := export_product_data.NewQuery(
+		repo.NewSpannerDataExporter(spannerClient),
+		clock,
+	)
+
+	validateProductQuery := validate_product.NewQuery(
+		productRepo,
+		nameLookup,
+		uniqueNamePolicy,
+		validationRules,
+		clock,
+	)
+
+	getProductHistoryQuery := get_product_history.NewQuery(
+		productRepo,
+		repo.NewSpannerHistoryReader(spannerClient),
+	)
+
+	listProductVersionsQuery := list_product_versions.NewQuery(productRepo, versionStore)
+	rollbackToVersionInteractor := rollback_to_version.NewInteractor(
+		productRepo,
+		versionStore,
+		spannerCommitter,
+		clock,
+		uniqueNamePolicy,
+		nameLookup,
+		validationRules,
+	)
+	saveDraftInteractor := save_draft.NewInteractor(productRepo, draftStore, spannerCommitter, clock)
+	publishDraftInteractor := publish_draft.NewInteractor(
+		productRepo,
+		draftStore,
+		versionStore,
+		spannerCommitter,
+		clock,
+		uniqueNamePolicy,
+		nameLookup,
+		validationRules,
+	)
+	discardDraftInteractor := discard_draft.NewInteractor(draftStore, spannerCommitter)
+
+	// Background work is queued in the jobs table and run by any server's job worker
+	jobQueue := jobs.NewQueue(spannerClient, clock, cfg.Jobs.MaxAttempts)
+	jobWorker := jobs.NewWorker(spannerClient, clock, jobs.WorkerConfig{
+		Concurrency:    cfg.Jobs.Concurrency,
+		PollInterval:   cfg.Jobs.PollInterval,
+		LeaseDuration:  cfg.Jobs.LeaseDuration,
+		InitialBackoff: cfg.Jobs.InitialBackoff,
+		MaxBackoff:     cfg.Jobs.MaxBackoff,
+	})
+
+	// Bulk RPCs run as long-running operations persisted in the operations table
+	operationRunner := lro.NewRunner(lro.NewSpannerStore(spannerClient), jobQueue, clock)
+	jobWorker.Register(lro.JobKind, operationRunner.HandleJob)
+	jobWorker.Register(purgeArchivedProductsJobKind, purgeArchivedProductsJob(purgeArchivedProductsInteractor, jobQueue, cfg.Retention))
+	jobWorker.Register(refreshProductCountsJobKind, refreshProductCountsJob(refreshProductCountsInteractor, jobQueue, cfg.Counts))
+	jobWorker.Register(refreshEffectivePricesJobKind, refreshEffectivePricesJob(refreshEffectivePricesInteractor, jobQueue, cfg.Pricing))
+	jobWorker.Register(refreshProductSuggestionsJobKind, refreshProductSuggestionsJob(refreshProductSuggestionsInteractor, jobQueue, cfg.Suggest))
+	jobWorker.Register(refreshCuratedListsJobKind, refreshCuratedListsJob(refreshCuratedListsInteractor, jobQueue, cfg.Curated))
+	jobWorker.Register(generateProductFeedsJobKind, generateProductFeedsJob(generateProductFeedsInteractor, jobQueue, cfg.Feeds, cfg.Paging.MaxPageSize))
+	jobWorker.Register(purgeCDNCacheJobKind, purgeCDNCacheJob(purgeCDNCacheInteractor, jobQueue, cfg.CDN))
+	// Registered on every backend so a sync job queued before switching back to Spanner ends its chain
+	jobWorker.Register(syncSearchIndexJobKind, syncSearchIndexJob(syncSearchIndexInteractor, jobQueue, cfg.Search))
+
+	// API keys authenticate machine clients; the interceptor and the admin RPCs share one manager
+	apiKeys := apikey.NewManager(apikey.NewSpannerStore(spannerClient), clock, cfg.Server.APIKeys.CacheTTL)
+	var previewTokens *preview.Signer
+	if cfg.Server.PreviewTokens.Secret != "" {
+		previewTokens = preview.NewSigner(cfg.Server.PreviewTokens.Secret, cfg.Server.PreviewTokens.DefaultTTL, cfg.Server.PreviewTokens.MaxTTL, clock)
+	}
+
+	// Tenant exports are only uploaded when a bucket is configured, so credentials are only needed then
+	var tenantExports contracts.FeedUploader
+	if cfg.Tenants.ExportBucket != "" {
+		exportBucket, err := gcs.NewClient(ctx, cfg.Tenants.ExportEndpoint, cfg.Tenants.ExportBucket)
+		if err != nil {
+			spannerClient.Close()
+			return nil, fmt.Errorf("failed to create tenant export bucket client: %w", err)
+		}
+		tenantExports = exportBucket
+	}
+	tenantRegistry := repo.NewSpannerTenantRegistry(spannerClient)
+	createTenantInteractor := create_tenant.NewInteractor(
+		tenantRegistry,
+		tenantSettingsStore,
+		categoryTemplateStore,
+		apiKeys,
+		tenantConfig,
+		spannerCommitter,
+		clock,
+		cfg.Tenants.OperatorTenant,
+	)
+	deleteTenantInteractor := delete_tenant.NewInteractor(
+		tenantRegistry,
+		repo.NewSpannerTenantPurger(spannerClient),
+		apiKeys,
+		exportProductDataQuery,
+		tenantExports,
+		tenantConfig,
+		spannerCommitter,
+		clock,
+		cfg.Tenants.OperatorTenant,
+	)
+	cloneProductInteractor := clone_product.NewInteractor(
+		productRepo,
+		versionStore,
+		categoryTemplateStore,
+		tenantRegistry,
+		spannerCommitter,
+		clock,
+		quotaCounter,
+		quotaPolicy,
+		uniqueNamePolicy,
+		nameLookup,
+		validationRules,
+		ids,
+		tenantConfig,
+		cfg.Tenants.OperatorTenant,
 	)
 
 	// 8. Create gRPC handler
+	text, err := textnorm.New(textnorm.Policy{
+		Unicode:         cfg.Catalog.NormalizeUnicode,
+		Whitespace:      cfg.Catalog.CollapseWhitespace,
+		DescriptionHTML: textnorm.HTMLPolicy(cfg.Catalog.DescriptionHTML),
+	})
+	if err != nil {
+		spannerClient.Close()
+		return nil, fmt.Errorf("failed to configure text normalization: %w", err)
+	}
 	productHandler := product.NewHandler(
 		createProductInteractor,
 		updateProductInteractor,
@@ -121,28 +759,317 @@ func NewOptions(ctx context.Context, spannerDatabase string) (*Options, error) {
 		activateProductInteractor,
 		deactivateProductInteractor,
 		archiveProductInteractor,
+		setLegalHoldInteractor,
+		purgeArchivedProductsInteractor,
 		getProductQuery,
 		listProductsQuery,
+		findSimilarProductsQuery,
+		compareProductsQuery,
+		exportProductDataQuery,
+		operationRunner,
+		validateProductQuery,
+		reviewProductInteractor,
+		getProductHistoryQuery,
+		rebuildProjectionInteractor,
+		setChannelsInteractor,
+		batchTransitionInteractor,
+		mergeProductsInteractor,
+		setMetadataInteractor,
+		linkExternalRefInteractor,
+		unlinkExternalRefInteractor,
+		getProductByExternalRefQuery,
+		changeBasePriceInteractor,
+		decideChangeInteractor,
+		setPriceFloorInteractor,
+		searchProductsQuery,
+		createMerchRuleInteractor,
+		deleteMerchRuleInteractor,
+		listMerchRulesQuery,
+		suggestProductsQuery,
+		recordProductViewInteractor,
+		getProductStatsQuery,
+		listCuratedProductsQuery,
+		getRecommendationsQuery,
+		getProductJsonLdQuery,
+		apiKeys,
+		usageRecorder,
+		faultInjector,
+		listProductVersionsQuery,
+		rollbackToVersionInteractor,
+		saveDraftInteractor,
+		publishDraftInteractor,
+		discardDraftInteractor,
+		previewTokens,
+		text,
+		setAttributesInteractor,
+		putCategoryTemplateInteractor,
+		deleteCategoryTemplateInteractor,
+		getCategoryTemplateQuery,
+		listCategoriesQuery,
+		reassignCategoryInteractor,
+		putTenantSettingsInteractor,
+		deleteTenantSettingsInteractor,
+		getTenantSettingsQuery,
+		createTenantInteractor,
+		deleteTenantInteractor,
+		cloneProductInteractor,
+	)
+	productV2Handler := productv2.NewHandler(
+		productHandler,
+		int32(cfg.Paging.DefaultPageSize),
+		int32(cfg.Paging.MaxPageSize),
 	)
+	operationsHandler := operations.NewHandler(operationRunner)
 
 	// 9. Create gRPC server
-	grpcServer := grpc.NewServer()
+	interceptors := []grpc.UnaryServerInterceptor{
+		spannertag.UnaryServerInterceptor(),
+		tenant.UnaryServerInterceptor(),
+		apikey.UnaryServerInterceptor(apiKeys, apiKeyMethodScopes, cfg.Server.APIKeys.Required),
+	}
+	if cfg.Usage.Enabled {
+		interceptors = append(interceptors, usageRecorder.UnaryServerInterceptor())
+	}
+	if cfg.Shadow.Percent > 0 {
+		shadower := shadow.New(shadow.Settings{
+			Percent:     cfg.Shadow.Percent,
+			MaxInFlight: cfg.Shadow.MaxInFlight,
+			Timeout:     cfg.Shadow.Timeout,
+		}, ShadowTargets(productV2Handler))
+		interceptors = append(interceptors, shadower.UnaryServerInterceptor())
+	}
+	serverOpts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(interceptors...),
+	}
+	var certs *tlsreload.Reloader
+	if cfg.Server.TLS.Enabled() {
+		certs, err = tlsreload.New(tlsreload.Settings{
+			CertFile:          cfg.Server.TLS.CertFile,
+			KeyFile:           cfg.Server.TLS.KeyFile,
+			ClientCAFile:      cfg.Server.TLS.ClientCAFile,
+			RequireClientCert: cfg.Server.TLS.RequireClientCert,
+			AllowedSPIFFEIDs:  cfg.Server.TLS.AllowedSPIFFEIDs,
+		})
+		if err != nil {
+			spannerClient.Close()
+			return nil, fmt.Errorf("failed to load TLS certificates: %w", err)
+		}
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(certs.ServerConfig())))
+	}
+	// Importing the gzip codec registers it: gzip-compressed requests are accepted and answered with
+	// gzip-compressed responses, which cuts transfer time of large pages over WAN links
+	// The level is process-wide, so it is only changed from the default when configured
+	if cfg.Server.GzipLevel != -1 {
+		if err := gzip.SetLevel(cfg.Server.GzipLevel); err != nil {
+			spannerClient.Close()
+			return nil, fmt.Errorf("failed to set gzip level: %w", err)
+		}
+	}
+	grpcServer := grpc.NewServer(serverOpts...)
 
 	return &Options{
 		SpannerClient:  spannerClient,
-		GRPCServer:      grpcServer,
+		GRPCServer:     grpcServer,
 		ProductHandler: productHandler,
+
+		ProductV2Handler:  productV2Handler,
+		OperationsHandler: operationsHandler,
+
+		PurgeArchivedProducts: purgeArchivedProductsInteractor,
+
+		JobQueue:  jobQueue,
+		JobWorker: jobWorker,
+
+		ViewBuffer: viewBuffer,
+
+		UsageRecorder: usageRecorder,
+
+		retention: cfg.Retention,
+		counts:    cfg.Counts,
+		pricing:   cfg.Pricing,
+		suggest:   cfg.Suggest,
+		views:     cfg.Views,
+		usage:     cfg.Usage,
+		curated:   cfg.Curated,
+		feeds:     cfg.Feeds,
+		cdn:       cfg.CDN,
+		tls:       cfg.Server.TLS,
+		grpcWeb:   cfg.Server.GRPCWeb,
+		http:      cfg.Server.HTTP,
+
+		certs: certs,
+
+		searchIndex: searchIndex,
 	}, nil
 }
 
-// createSpannerClient creates a Spanner client
-func createSpannerClient(ctx context.Context, database string) (*spanner.Client, error) {
+// RunViewFlusher writes buffered product views every flush interval until ctx is done, then once more
+func (o *Options) RunViewFlusher(ctx context.Context) {
+	o.ViewBuffer.Run(ctx, o.views.FlushInterval)
+
+	flushCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := o.ViewBuffer.Flush(flushCtx); err != nil {
+		slog.Error("Failed to flush product views on shutdown", "error", err)
+	}
+}
+
+// RunUsageFlusher writes buffered usage every flush interval until ctx is done, then once more
+// It returns at once when usage accounting is disabled
+func (o *Options) RunUsageFlusher(ctx context.Context) {
+	if !o.usage.Enabled {
+		return
+	}
+	o.UsageRecorder.Run(ctx, o.usage.FlushInterval)
+
+	flushCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := o.UsageRecorder.Flush(flushCtx); err != nil {
+		slog.Error("Failed to flush usage on shutdown", "error", err)
+	}
+}
+
+// RunCertReloader re-reads the TLS certificate files every reload interval until ctx is done
+// It returns at once when the server listens in plaintext or reloading is disabled
+func (o *Options) RunCertReloader(ctx context.Context) {
+	if o.certs == nil || o.tls.ReloadInterval <= 0 {
+		return
+	}
+	o.certs.Run(ctx, o.tls.ReloadInterval)
+}
+
+// ServeGRPCWeb serves GRPCServer to browsers over gRPC-Web until ctx is done, with the gRPC port's TLS settings
+// behind the gateway's CORS and security header middleware
+// It returns at once when gRPC-Web is disabled
+func (o *Options) ServeGRPCWeb(ctx context.Context) error {
+	if o.grpcWeb.Port == "" {
+		return nil
+	}
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%s", o.grpcWeb.Port),
+		Handler:           gateway.New(o.gatewaySettings(), grpcweb.New(o.GRPCServer)),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			slog.Error("Failed to shut down gRPC-Web server", "error", err)
+		}
+	}()
+
+	var err error
+	if o.certs != nil {
+		server.TLSConfig = o.certs.ServerConfig()
+		err = server.ListenAndServeTLS("", "")
+	} else {
+		err = server.ListenAndServe()
+	}
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+// gatewaySettings maps the HTTP configuration onto the gateway middleware; gRPC-Web's headers are always exposed
+func (o *Options) gatewaySettings() gateway.Settings {
+	return gateway.Settings{
+		CORS: gateway.CORSSettings{
+			AllowedOrigins: o.http.CORS.AllowedOrigins,
+			AllowedMethods: o.http.CORS.AllowedMethods,
+			AllowedHeaders: o.http.CORS.AllowedHeaders,
+			ExposedHeaders: grpcweb.ExposedHeaders,
+			MaxAge:         o.http.CORS.MaxAge,
+		},
+		Security: gateway.SecuritySettings{
+			HSTSMaxAge:            o.http.HSTSMaxAge,
+			HSTSIncludeSubdomains: o.http.HSTSIncludeSubdomains,
+		},
+	}
+}
+
+// provisionEmulator creates the instance and database on the emulator when they are missing
+// It is a no-op against real Spanner or when auto-provisioning is disabled
+func provisionEmulator(ctx context.Context, cfg config.SpannerConfig) error {
+	if !cfg.AutoProvision || os.Getenv("SPANNER_EMULATOR_HOST") == "" {
+		return nil
+	}
+	db, err := migrate.ParseDatabase(cfg.Database)
+	if err != nil {
+		return err
+	}
+	if err := migrate.EnsureInstance(ctx, db); err != nil {
+		return err
+	}
+	created, err := migrate.EnsureDatabase(ctx, db, cfg.MigrationsDir)
+	if err != nil {
+		return err
+	}
+	if created {
+		slog.Info("Provisioned emulator database", "database", cfg.Database, "migrations", cfg.MigrationsDir)
+	}
+	return nil
+}
+
+// newIDGenerator returns the generator for the configured ID strategy (validated by config)
+func newIDGenerator(strategy string) idgen.Generator {
+	switch strategy {
+	case config.IDStrategyUUIDv7:
+		return idgen.NewUUIDv7()
+	case config.IDStrategyBitReversed:
+		return idgen.NewBitReversed()
+	default:
+		return idgen.NewRandom()
+	}
+}
+
+// checkSchema fails fast (or warns) when the live schema lacks columns the models read and write,
+// instead of surfacing ToStruct errors at request time after a partial migration
+func checkSchema(ctx context.Context, client *spanner.Client, mode string) error {
+	if mode == config.SchemaCheckOff {
+		return nil
+	}
+	drift, err := migrate.CheckColumns(ctx, client, map[string][]string{
+		m_product.TableName: m_product.AllColumns(),
+		m_outbox.TableName:  m_outbox.AllColumns(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to check schema: %w", err)
+	}
+	if drift.Empty() {
+		return nil
+	}
+	if mode == config.SchemaCheckWarn {
+		slog.Warn("Live schema is missing columns the service uses; run pending migrations", "drift", drift.String())
+		return nil
+	}
+	return fmt.Errorf("live schema is missing columns the service uses (run pending migrations, or set CATALOG_SPANNER_SCHEMA_CHECK=warn): %s", drift)
+}
+
+// createSpannerClient creates a Spanner client tuned by the Spanner config
+func createSpannerClient(ctx context.Context, cfg config.SpannerConfig) (*spanner.Client, error) {
+	// Built-in OpenTelemetry metrics (such as GFE latency) are opt-in and process-wide
+	if cfg.EnableMetrics {
+		spanner.EnableOpenTelemetryMetrics()
+	}
+
+	// The client only uses multiplexed sessions, so there is no session pool to tune
+	clientConfig := spanner.ClientConfig{
+		NumChannels: cfg.NumChannels,
+	}
+	// Requests are tagged with the RPC or job that sent them, and slow queries are logged
+	var opts []option.ClientOption
+	for _, dialOption := range spannertag.New(spannertag.Settings{SlowQueryThreshold: cfg.SlowQueryThreshold}).DialOptions() {
+		opts = append(opts, option.WithGRPCDialOption(dialOption))
+	}
+
 	// Check if using emulator (for local development)
 	emulatorHost := os.Getenv("SPANNER_EMULATOR_HOST")
 	if emulatorHost != "" {
 		// For emulator, database string format: projects/{project}/instances/{instance}/databases/{database}
 		// Or we can use a simpler format if emulator is configured
-		client, err := spanner.NewClient(ctx, database)
+		client, err := spanner.NewClientWithConfig(ctx, cfg.Database, clientConfig, opts...)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create Spanner client (emulator): %w", err)
 		}
@@ -150,7 +1077,7 @@ func createSpannerClient(ctx context.Context, database string) (*spanner.Client,
 	}
 
 	// Production Spanner client
-	client, err := spanner.NewClient(ctx, database)
+	client, err := spanner.NewClientWithConfig(ctx, cfg.Database, clientConfig, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create Spanner client: %w", err)
 	}
@@ -158,6 +1085,24 @@ func createSpannerClient(ctx context.Context, database string) (*spanner.Client,
 	return client, nil
 }
 
+// newCachePurger creates the client of the configured CDN provider
+func newCachePurger(ctx context.Context, cfg config.CDNConfig) (contracts.CachePurger, error) {
+	switch cfg.Provider {
+	case config.CDNProviderCloudCDN:
+		purger, err := cdn.NewCloudCDN(ctx, cfg.Endpoint, cfg.CloudCDNProject, cfg.CloudCDNURLMap)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Cloud CDN client: %w", err)
+		}
+		return purger, nil
+	case config.CDNProviderFastly:
+		return cdn.NewFastly(cfg.Endpoint, cfg.FastlyToken), nil
+	case config.CDNProviderCloudflare:
+		return cdn.NewCloudflare(cfg.Endpoint, cfg.CloudflareZone, cfg.CloudflareToken), nil
+	default:
+		return nil, fmt.Errorf("unknown cdn provider %q", cfg.Provider)
+	}
+}
+
 // Close closes all resources
 func (o *Options) Close() error {
 	if o.SpannerClient != nil {
@@ -0,0 +1,54 @@
+package services
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"catalog-proj/internal/pkg/shadow"
+	"catalog-proj/internal/transport/grpc/productv2"
+	pb "catalog-proj/proto/product/v1"
+	pbv2 "catalog-proj/proto/product/v2"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// ShadowTargets are the alternate implementations read calls are shadowed to
+// Read paths being migrated to, such as a new read model, register here until they take over
+func ShadowTargets(v2 *productv2.Handler) map[string]shadow.Target {
+	return map[string]shadow.Target{
+		// The v2 resource API must present the same product as v1
+		pb.ProductService_GetProduct_FullMethodName: {
+			Call: func(ctx context.Context, req interface{}) (interface{}, error) {
+				return v2.GetProduct(ctx, &pbv2.GetProductRequest{Name: "products/" + req.(*pb.GetProductRequest).ProductId})
+			},
+			Equal: func(primary, shadowed interface{}) bool {
+				return sameProductV1V2(primary.(*pb.GetProductResponse).Product, shadowed.(*pbv2.Product))
+			},
+		},
+	}
+}
+
+// sameProductV1V2 reports whether a v1 and a v2 product describe the same product state
+func sameProductV1V2(v1 *pb.Product, v2 *pbv2.Product) bool {
+	if v1 == nil || v2 == nil {
+		return v1 == nil && v2 == nil
+	}
+	states := map[string]pbv2.Product_State{"active": pbv2.Product_ACTIVE, "inactive": pbv2.Product_INACTIVE}
+	return v2.Name == "products/"+v1.Id &&
+		v2.DisplayName == v1.Name &&
+		v2.Description == v1.Description &&
+		v2.Category == v1.Category &&
+		v2.Sku == v1.Sku &&
+		v2.Gtin == v1.Gtin &&
+		v2.GetBasePrice().GetAmount() == v1.GetBasePrice().GetAmount() &&
+		v2.GetEffectivePrice().GetAmount() == v1.GetEffectivePrice().GetAmount() &&
+		v2.MapApplied == v1.MapApplied &&
+		v2.State == states[v1.Status] &&
+		v2.LegalHold == v1.LegalHold &&
+		slices.Equal(v2.Channels, v1.Channels) &&
+		maps.Equal(v2.Metadata, v1.Metadata) &&
+		proto.Equal(v2.CreateTime, v1.CreatedAt) &&
+		proto.Equal(v2.UpdateTime, v1.UpdatedAt) &&
+		proto.Equal(v2.DeleteTime, v1.ArchivedAt)
+}
@@ -0,0 +1,54 @@
+package services
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+
+	domainServices "catalog-proj/internal/app/product/domain/services"
+	"catalog-proj/internal/pkg/config"
+)
+
+// newValidationRules compiles the configured category rules
+func newValidationRules(cfg map[string][]config.ValidationRule) (*domainServices.ValidationRules, error) {
+	byCategory := make(map[string][]domainServices.FieldRule, len(cfg))
+	for category, rules := range cfg {
+		for _, rule := range rules {
+			fieldRule := domainServices.FieldRule{
+				Field:     rule.Field,
+				Required:  rule.Required,
+				MinLength: rule.MinLength,
+				MaxLength: rule.MaxLength,
+				Message:   rule.Message,
+			}
+
+			var err error
+			if rule.Pattern != "" {
+				if fieldRule.Pattern, err = regexp.Compile(rule.Pattern); err != nil {
+					return nil, fmt.Errorf("category %q: invalid %s pattern: %w", category, rule.Field, err)
+				}
+			}
+			if fieldRule.MinPrice, err = parsePrice(rule.MinPrice); err != nil {
+				return nil, fmt.Errorf("category %q: invalid min_price: %w", category, err)
+			}
+			if fieldRule.MaxPrice, err = parsePrice(rule.MaxPrice); err != nil {
+				return nil, fmt.Errorf("category %q: invalid max_price: %w", category, err)
+			}
+
+			byCategory[category] = append(byCategory[category], fieldRule)
+		}
+	}
+	return domainServices.NewValidationRules(byCategory)
+}
+
+// parsePrice parses an optional decimal amount ("" means unset)
+func parsePrice(s string) (*big.Rat, error) {
+	if s == "" {
+		return nil, nil
+	}
+	price, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a decimal amount", s)
+	}
+	return price, nil
+}
@@ -0,0 +1,80 @@
+package gateway
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CORSSettings controls which browser origins may call the gateway cross-origin
+type CORSSettings struct {
+	// AllowedOrigins are origins such as https://admin.example.com; "*" allows any origin
+	AllowedOrigins []string
+	// AllowedMethods are the methods preflights allow
+	AllowedMethods []string
+	// AllowedHeaders are the request headers preflights allow; empty allows whatever is requested
+	AllowedHeaders []string
+	// ExposedHeaders are the response headers scripts may read
+	ExposedHeaders []string
+	// MaxAge is how long browsers may cache a preflight (0 leaves it to the browser)
+	MaxAge time.Duration
+}
+
+// CORS answers preflights and marks responses to allowed origins as readable cross-origin
+// Requests from other origins are refused with 403; requests without an Origin, such as from
+// curl or another server, are passed on untouched
+func CORS(settings CORSSettings, next http.Handler) http.Handler {
+	origins := make(map[string]bool, len(settings.AllowedOrigins))
+	anyOrigin := false
+	for _, origin := range settings.AllowedOrigins {
+		if origin == "*" {
+			anyOrigin = true
+		}
+		origins[strings.TrimSuffix(origin, "/")] = true
+	}
+	methods := strings.Join(settings.AllowedMethods, ", ")
+	headers := strings.Join(settings.AllowedHeaders, ", ")
+	exposed := strings.Join(settings.ExposedHeaders, ", ")
+	maxAge := strconv.Itoa(int(settings.MaxAge.Seconds()))
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		w.Header().Add("Vary", "Origin")
+		if !anyOrigin && !origins[origin] {
+			http.Error(w, "origin not allowed", http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+
+		requestMethod := r.Header.Get("Access-Control-Request-Method")
+		if r.Method != http.MethodOptions || requestMethod == "" {
+			if exposed != "" {
+				w.Header().Set("Access-Control-Expose-Headers", exposed)
+			}
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		// Preflight
+		if !slices.Contains(settings.AllowedMethods, requestMethod) {
+			http.Error(w, "method not allowed", http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Access-Control-Allow-Methods", methods)
+		if headers != "" {
+			w.Header().Set("Access-Control-Allow-Headers", headers)
+		} else if requested := r.Header.Get("Access-Control-Request-Headers"); requested != "" {
+			w.Header().Set("Access-Control-Allow-Headers", requested)
+		}
+		if settings.MaxAge > 0 {
+			w.Header().Set("Access-Control-Max-Age", maxAge)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
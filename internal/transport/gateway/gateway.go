@@ -0,0 +1,14 @@
+package gateway
+
+import "net/http"
+
+// Settings configures the middleware of the HTTP ports that browsers call, such as gRPC-Web
+type Settings struct {
+	CORS     CORSSettings
+	Security SecuritySettings
+}
+
+// New wraps next in the gateway middleware: security headers first, so refusals carry them too, then CORS
+func New(settings Settings, next http.Handler) http.Handler {
+	return SecurityHeaders(settings.Security, CORS(settings.CORS, next))
+}
@@ -0,0 +1,210 @@
+package operations
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"catalog-proj/internal/models/m_operation"
+	"catalog-proj/internal/pkg/lro"
+	pb "catalog-proj/proto/product/v1"
+
+	"cloud.google.com/go/longrunning/autogen/longrunningpb"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+	// pollInterval is how often WaitOperation re-reads a running operation
+	pollInterval = 250 * time.Millisecond
+)
+
+// Handler implements the google.longrunning.Operations mixin over operations started by bulk RPCs
+type Handler struct {
+	longrunningpb.UnimplementedOperationsServer
+
+	runner *lro.Runner
+}
+
+// NewHandler creates a new Operations handler
+func NewHandler(runner *lro.Runner) *Handler {
+	return &Handler{
+		runner: runner,
+	}
+}
+
+// GetOperation returns the latest state of an operation
+func (h *Handler) GetOperation(ctx context.Context, req *longrunningpb.GetOperationRequest) (*longrunningpb.Operation, error) {
+	op, err := h.runner.Get(ctx, req.Name)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return toProto(op)
+}
+
+// ListOperations lists the caller's operations, newest first
+// Filters are not supported; page tokens are opaque offsets
+func (h *Handler) ListOperations(ctx context.Context, req *longrunningpb.ListOperationsRequest) (*longrunningpb.ListOperationsResponse, error) {
+	if req.Filter != "" {
+		return nil, status.Error(codes.InvalidArgument, "filter is not supported")
+	}
+	if req.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must be non-negative")
+	}
+	pageSize := int(req.PageSize)
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	offset := 0
+	if req.PageToken != "" {
+		n, err := strconv.Atoi(req.PageToken)
+		if err != nil || n < 0 {
+			return nil, status.Error(codes.InvalidArgument, "page_token is invalid")
+		}
+		offset = n
+	}
+
+	// Fetch one extra row to know whether another page exists
+	ops, err := h.runner.List(ctx, pageSize+1, offset)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	resp := &longrunningpb.ListOperationsResponse{}
+	if len(ops) > pageSize {
+		ops = ops[:pageSize]
+		resp.NextPageToken = strconv.Itoa(offset + pageSize)
+	}
+	for _, op := range ops {
+		protoOp, err := toProto(op)
+		if err != nil {
+			return nil, err
+		}
+		resp.Operations = append(resp.Operations, protoOp)
+	}
+	return resp, nil
+}
+
+// DeleteOperation deletes an operation record; it does not stop a running operation
+func (h *Handler) DeleteOperation(ctx context.Context, req *longrunningpb.DeleteOperationRequest) (*emptypb.Empty, error) {
+	if err := h.runner.Delete(ctx, req.Name); err != nil {
+		return nil, mapError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// CancelOperation requests cancellation; the operation finishes with CANCELLED shortly after
+func (h *Handler) CancelOperation(ctx context.Context, req *longrunningpb.CancelOperationRequest) (*emptypb.Empty, error) {
+	if err := h.runner.Cancel(ctx, req.Name); err != nil {
+		return nil, mapError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// WaitOperation blocks until the operation is done, the timeout elapses, or the RPC deadline
+// is reached, and returns the latest state
+func (h *Handler) WaitOperation(ctx context.Context, req *longrunningpb.WaitOperationRequest) (*longrunningpb.Operation, error) {
+	if req.Timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, req.Timeout.AsDuration())
+		defer cancel()
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	var op *m_operation.Operation
+	for {
+		// Read with a fresh context so the final state is returned once the wait times out
+		latest, err := h.runner.Get(context.WithoutCancel(ctx), req.Name)
+		if err != nil {
+			return nil, mapError(err)
+		}
+		op = latest
+		if op.Done {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return toProto(op)
+		case <-ticker.C:
+		}
+	}
+	return toProto(op)
+}
+
+// toProto converts a stored operation to google.longrunning.Operation
+func toProto(op *m_operation.Operation) (*longrunningpb.Operation, error) {
+	metadata, err := anypb.New(&pb.OperationMetadata{
+		Kind:            op.Kind,
+		TotalItems:      op.TotalItems,
+		ProcessedItems:  op.ProcessedItems,
+		FailedItems:     op.FailedItems,
+		CancelRequested: op.CancelRequested,
+		CreateTime:      timestamppb.New(op.CreatedAt),
+		UpdateTime:      timestamppb.New(op.UpdatedAt),
+		Checkpoint:      stringValue(op.Checkpoint),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode operation metadata: %v", err)
+	}
+
+	protoOp := &longrunningpb.Operation{
+		Name:     lro.Name(op.OperationID),
+		Metadata: metadata,
+		Done:     op.Done,
+	}
+	if !op.Done {
+		return protoOp, nil
+	}
+
+	if op.ErrorCode != nil {
+		message := ""
+		if op.ErrorMessage != nil {
+			message = *op.ErrorMessage
+		}
+		protoOp.Result = &longrunningpb.Operation_Error{Error: &spb.Status{Code: int32(*op.ErrorCode), Message: message}}
+		return protoOp, nil
+	}
+
+	response := &anypb.Any{}
+	if len(op.Result) > 0 {
+		if err := proto.Unmarshal(op.Result, response); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to decode operation response: %v", err)
+		}
+	}
+	protoOp.Result = &longrunningpb.Operation_Response{Response: response}
+	return protoOp, nil
+}
+
+// mapError maps operation store errors to gRPC status codes
+func mapError(err error) error {
+	switch {
+	case errors.Is(err, lro.ErrNotFound):
+		return status.Error(codes.NotFound, "operation not found")
+	case errors.Is(err, lro.ErrInvalidName):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+// stringValue dereferences an optional string, "" when nil
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -20,6 +20,7 @@ func (h *Handler) ActivateProduct(ctx context.Context, req *pb.ActivateProductRe
 	}
 
 	// 3. Call use case
+	ctx = recordCommit(ctx, req.ReturnProduct)
 	resp, err := h.activateProductInteractor.Execute(ctx, useCaseReq)
 	if err != nil {
 		return nil, MapDomainError(err)
@@ -28,5 +29,6 @@ func (h *Handler) ActivateProduct(ctx context.Context, req *pb.ActivateProductRe
 	// 4. Map response to proto
 	return &pb.ActivateProductResponse{
 		ProductId: resp.ProductID,
+		Product:   h.committedProduct(ctx, req.ReturnProduct, resp.Product, false),
 	}, nil
 }
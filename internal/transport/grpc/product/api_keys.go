@@ -0,0 +1,133 @@
+package product
+
+import (
+	"context"
+	"errors"
+
+	"catalog-proj/internal/models/m_api_key"
+	"catalog-proj/internal/pkg/apikey"
+	pb "catalog-proj/proto/product/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxApiKeyNameLength matches the api_keys.name column
+const maxApiKeyNameLength = 256
+
+// apiKeyScopes maps proto scopes to API key scopes
+var apiKeyScopes = map[pb.ApiKeyScope]apikey.Scope{
+	pb.ApiKeyScope_API_KEY_SCOPE_READ:  apikey.ScopeRead,
+	pb.ApiKeyScope_API_KEY_SCOPE_WRITE: apikey.ScopeWrite,
+	pb.ApiKeyScope_API_KEY_SCOPE_ADMIN: apikey.ScopeAdmin,
+}
+
+// IssueApiKey handles the IssueApiKey gRPC request
+func (h *Handler) IssueApiKey(ctx context.Context, req *pb.IssueApiKeyRequest) (*pb.IssueApiKeyResponse, error) {
+	// 1. Validate
+	if req.Name == "" {
+		return nil, invalidArgumentError("name is required")
+	}
+	if len(req.Name) > maxApiKeyNameLength {
+		return nil, invalidArgumentError("name must be at most 256 bytes")
+	}
+	if len(req.Scopes) == 0 {
+		return nil, invalidArgumentError("at least one scope is required")
+	}
+	scopes, ok := protoApiKeyScopes(req.Scopes)
+	if !ok {
+		return nil, invalidArgumentError("scopes must be READ, WRITE or ADMIN")
+	}
+
+	// 2. Issue key
+	key, secret, err := h.apiKeys.Issue(ctx, req.Name, scopes)
+	if err != nil {
+		return nil, mapApiKeyError(err)
+	}
+
+	// 3. Map to proto
+	return &pb.IssueApiKeyResponse{
+		ApiKey: apiKeyToProto(key),
+		Secret: secret,
+	}, nil
+}
+
+// RevokeApiKey handles the RevokeApiKey gRPC request
+func (h *Handler) RevokeApiKey(ctx context.Context, req *pb.RevokeApiKeyRequest) (*pb.RevokeApiKeyResponse, error) {
+	// 1. Validate
+	if req.KeyId == "" {
+		return nil, invalidArgumentError("key_id is required")
+	}
+
+	// 2. Revoke key
+	key, err := h.apiKeys.Revoke(ctx, req.KeyId)
+	if err != nil {
+		return nil, mapApiKeyError(err)
+	}
+
+	// 3. Map to proto
+	return &pb.RevokeApiKeyResponse{ApiKey: apiKeyToProto(key)}, nil
+}
+
+// ListApiKeys handles the ListApiKeys gRPC request
+func (h *Handler) ListApiKeys(ctx context.Context, req *pb.ListApiKeysRequest) (*pb.ListApiKeysResponse, error) {
+	keys, err := h.apiKeys.List(ctx)
+	if err != nil {
+		return nil, mapApiKeyError(err)
+	}
+
+	resp := &pb.ListApiKeysResponse{
+		ApiKeys: make([]*pb.ApiKey, 0, len(keys)),
+	}
+	for _, key := range keys {
+		resp.ApiKeys = append(resp.ApiKeys, apiKeyToProto(key))
+	}
+	return resp, nil
+}
+
+// apiKeyToProto converts a stored key to proto; the hash is never exposed
+func apiKeyToProto(key *m_api_key.APIKey) *pb.ApiKey {
+	protoKey := &pb.ApiKey{
+		KeyId:     key.KeyID,
+		Name:      key.Name,
+		Scopes:    make([]pb.ApiKeyScope, 0, len(key.Scopes)),
+		CreatedAt: timestamppb.New(key.CreatedAt),
+	}
+	for _, s := range key.Scopes {
+		for protoScope, scope := range apiKeyScopes {
+			if string(scope) == s {
+				protoKey.Scopes = append(protoKey.Scopes, protoScope)
+			}
+		}
+	}
+	if key.RevokedAt != nil {
+		protoKey.RevokedAt = timestamppb.New(*key.RevokedAt)
+	}
+	return protoKey
+}
+
+// protoApiKeyScopes converts proto scopes to API key scopes without duplicates; ok is false for unknown scopes
+func protoApiKeyScopes(protoScopes []pb.ApiKeyScope) ([]apikey.Scope, bool) {
+	scopes := make([]apikey.Scope, 0, len(protoScopes))
+	seen := make(map[apikey.Scope]bool, len(protoScopes))
+	for _, s := range protoScopes {
+		scope, ok := apiKeyScopes[s]
+		if !ok {
+			return nil, false
+		}
+		if !seen[scope] {
+			seen[scope] = true
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes, true
+}
+
+// mapApiKeyError maps API key store errors to gRPC status codes
+func mapApiKeyError(err error) error {
+	if errors.Is(err, apikey.ErrNotFound) {
+		return status.Error(codes.NotFound, "api key not found")
+	}
+	return status.Error(codes.Internal, err.Error())
+}
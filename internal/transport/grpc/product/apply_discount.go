@@ -18,32 +18,38 @@ func (h *Handler) ApplyDiscount(ctx context.Context, req *pb.ApplyDiscountReques
 		return nil, invalidArgumentError("discount is required")
 	}
 
-	// Validate discount fields
+	// Validate every discount field, so all problems are reported at once
+	var violations fieldViolations
 	if strings.TrimSpace(req.Discount.Id) == "" {
-		return nil, invalidArgumentError("discount.id is required and cannot be empty")
+		violations.add("discount.id", "discount.id is required and cannot be empty")
 	}
 
-	if req.Discount.Amount == nil {
-		return nil, invalidArgumentError("discount.amount is required")
-	}
-
-	if req.Discount.Amount.Amount < 0 || req.Discount.Amount.Amount > 100 {
-		return nil, invalidArgumentError("discount.amount must be between 0 and 100 (0-100%)")
+	// percent_basis_points takes precedence over the deprecated whole-percent amount
+	switch {
+	case req.Discount.PercentBasisPoints != nil:
+		if bps := req.Discount.GetPercentBasisPoints(); bps < 0 || bps > basisPointsPerUnit {
+			violations.add("discount.percent_basis_points", "discount.percent_basis_points must be between 0 and 10000 (0-100%)")
+		}
+	case req.Discount.Amount != nil:
+		if req.Discount.Amount.Amount < 0 || req.Discount.Amount.Amount > 100 {
+			violations.add("discount.amount", "discount.amount must be between 0 and 100 (0-100%)")
+		}
+	default:
+		violations.add("discount.percent_basis_points", "discount.percent_basis_points is required")
 	}
 
 	if req.Discount.StartDate == nil {
-		return nil, invalidArgumentError("discount.start_date is required")
+		violations.add("discount.start_date", "discount.start_date is required")
 	}
-
 	if req.Discount.EndDate == nil {
-		return nil, invalidArgumentError("discount.end_date is required")
+		violations.add("discount.end_date", "discount.end_date is required")
 	}
-
-	startDate := req.Discount.StartDate.AsTime()
-	endDate := req.Discount.EndDate.AsTime()
-
-	if !startDate.Before(endDate) {
-		return nil, invalidArgumentError("discount.start_date must be before end_date")
+	if req.Discount.StartDate != nil && req.Discount.EndDate != nil &&
+		!req.Discount.StartDate.AsTime().Before(req.Discount.EndDate.AsTime()) {
+		violations.add("discount.end_date", "discount.start_date must be before end_date")
+	}
+	if err := violations.err(); err != nil {
+		return nil, err
 	}
 
 	// 2. Map proto to use case request
@@ -54,6 +60,7 @@ func (h *Handler) ApplyDiscount(ctx context.Context, req *pb.ApplyDiscountReques
 	}
 
 	// 3. Call use case
+	ctx = recordCommit(ctx, req.ReturnProduct)
 	resp, err := h.applyDiscountInteractor.Execute(ctx, useCaseReq)
 	if err != nil {
 		return nil, MapDomainError(err)
@@ -62,5 +69,6 @@ func (h *Handler) ApplyDiscount(ctx context.Context, req *pb.ApplyDiscountReques
 	// 4. Map response to proto
 	return &pb.ApplyDiscountResponse{
 		ProductId: resp.ProductID,
+		Product:   h.committedProduct(ctx, req.ReturnProduct, resp.Product, false),
 	}, nil
 }
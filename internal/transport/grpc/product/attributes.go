@@ -0,0 +1,100 @@
+package product
+
+import (
+	"context"
+
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/usecases/delete_category_template"
+	"catalog-proj/internal/app/product/usecases/put_category_template"
+	"catalog-proj/internal/app/product/usecases/set_attributes"
+	pb "catalog-proj/proto/product/v1"
+)
+
+// SetAttributes handles the SetAttributes gRPC request
+func (h *Handler) SetAttributes(ctx context.Context, req *pb.SetAttributesRequest) (*pb.SetAttributesResponse, error) {
+	// 1. Validate
+	if req.ProductId == "" {
+		return nil, invalidArgumentError("product_id is required")
+	}
+
+	// 2. Map proto to use case request (names, values and the category's template are checked by the domain)
+	useCaseReq := &set_attributes.Request{
+		ProductID:  req.ProductId,
+		Attributes: domain.Attributes(req.Attributes),
+	}
+
+	// 3. Call use case
+	ctx = recordCommit(ctx, req.ReturnProduct)
+	resp, err := h.setAttributesInteractor.Execute(ctx, useCaseReq)
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 4. Map response to proto
+	return &pb.SetAttributesResponse{
+		ProductId: resp.ProductID,
+		Product:   h.committedProduct(ctx, req.ReturnProduct, resp.Product, false),
+	}, nil
+}
+
+// PutCategoryTemplate handles the PutCategoryTemplate gRPC request
+func (h *Handler) PutCategoryTemplate(ctx context.Context, req *pb.PutCategoryTemplateRequest) (*pb.PutCategoryTemplateResponse, error) {
+	// 1. Validate
+	if req.Template == nil {
+		return nil, invalidArgumentError("template is required")
+	}
+
+	// 2. Map proto to use case request (the definitions are validated by the domain)
+	useCaseReq := &put_category_template.Request{
+		Template: ProtoCategoryTemplateToDomain(req.Template),
+	}
+
+	// 3. Call use case
+	resp, err := h.putCategoryTemplateInteractor.Execute(ctx, useCaseReq)
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 4. Map response to proto
+	return &pb.PutCategoryTemplateResponse{
+		Template: DomainCategoryTemplateToProto(resp.Template),
+	}, nil
+}
+
+// DeleteCategoryTemplate handles the DeleteCategoryTemplate gRPC request
+func (h *Handler) DeleteCategoryTemplate(ctx context.Context, req *pb.DeleteCategoryTemplateRequest) (*pb.DeleteCategoryTemplateResponse, error) {
+	// 1. Validate
+	if req.Category == "" {
+		return nil, invalidArgumentError("category is required")
+	}
+
+	// 2. Call use case
+	resp, err := h.deleteCategoryTemplateInteractor.Execute(ctx, &delete_category_template.Request{Category: req.Category})
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 3. Map response to proto
+	return &pb.DeleteCategoryTemplateResponse{
+		Category: resp.Category,
+	}, nil
+}
+
+// GetCategoryTemplate handles the GetCategoryTemplate gRPC request
+func (h *Handler) GetCategoryTemplate(ctx context.Context, req *pb.GetCategoryTemplateRequest) (*pb.GetCategoryTemplateResponse, error) {
+	// 1. Validate
+	if req.Category == "" {
+		return nil, invalidArgumentError("category is required")
+	}
+
+	// 2. Call query
+	dto, err := h.getCategoryTemplateQuery.Execute(ctx, req.Category)
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 3. Map DTO to proto
+	return &pb.GetCategoryTemplateResponse{
+		Template: DomainCategoryTemplateToProto(dto.Template),
+	}, nil
+}
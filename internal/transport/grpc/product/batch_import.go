@@ -0,0 +1,75 @@
+package product
+
+import (
+	"context"
+	"fmt"
+
+	"catalog-proj/internal/pkg/lro"
+	pb "catalog-proj/proto/product/v1"
+
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// maxBatchImportProducts bounds a single BatchImportProducts request
+	maxBatchImportProducts = 1000
+	// batchImportKind is the operation kind reported in OperationMetadata
+	batchImportKind = "batch_import_products"
+)
+
+// BatchImportProducts handles the BatchImportProducts gRPC request
+// Each product goes through CreateProduct, so validation, quotas and duplicate checks
+// apply per item; failures are collected in the result instead of aborting the batch
+func (h *Handler) BatchImportProducts(ctx context.Context, req *pb.BatchImportProductsRequest) (*pb.BatchImportProductsResponse, error) {
+	// 1. Validate
+	if len(req.Products) == 0 {
+		return nil, invalidArgumentError("products is required")
+	}
+	if len(req.Products) > maxBatchImportProducts {
+		return nil, invalidArgumentError(fmt.Sprintf("at most %d products can be imported per request", maxBatchImportProducts))
+	}
+
+	// 2. Queue the operation; the task runs on a job worker
+	name, err := h.operationRunner.Start(ctx, batchImportKind, len(req.Products), req)
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 3. Return response
+	return &pb.BatchImportProductsResponse{
+		OperationName: name,
+	}, nil
+}
+
+// runBatchImport is the operation task behind BatchImportProducts
+func (h *Handler) runBatchImport(ctx context.Context, request proto.Message, progress *lro.Progress) (proto.Message, error) {
+	req, ok := request.(*pb.BatchImportProductsRequest)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "unexpected %s request type %T", batchImportKind, request)
+	}
+
+	result := &pb.BatchImportProductsResult{ProductIds: make([]string, len(req.Products))}
+	for i, product := range req.Products {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		resp, err := h.CreateProduct(ctx, product)
+		if err != nil {
+			st := status.Convert(err)
+			result.Failures = append(result.Failures, &pb.BatchImportFailure{
+				Index:   int32(i),
+				Code:    code.Code(st.Code()).String(),
+				Message: st.Message(),
+			})
+			progress.Item(false)
+			continue
+		}
+		result.ProductIds[i] = resp.ProductId
+		progress.Item(true)
+	}
+	return result, nil
+}
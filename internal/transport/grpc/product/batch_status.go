@@ -0,0 +1,87 @@
+package product
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"catalog-proj/internal/app/product/usecases/batch_transition"
+	pb "catalog-proj/proto/product/v1"
+
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc/status"
+)
+
+// maxBatchTransitionProducts bounds a single batch status transition request
+const maxBatchTransitionProducts = 1000
+
+// BatchActivateProducts handles the BatchActivateProducts gRPC request
+func (h *Handler) BatchActivateProducts(ctx context.Context, req *pb.BatchActivateProductsRequest) (*pb.BatchActivateProductsResponse, error) {
+	outcomes, err := h.batchTransition(ctx, batch_transition.TransitionActivate, req.ProductIds)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.BatchActivateProductsResponse{Outcomes: outcomes}, nil
+}
+
+// BatchDeactivateProducts handles the BatchDeactivateProducts gRPC request
+func (h *Handler) BatchDeactivateProducts(ctx context.Context, req *pb.BatchDeactivateProductsRequest) (*pb.BatchDeactivateProductsResponse, error) {
+	outcomes, err := h.batchTransition(ctx, batch_transition.TransitionDeactivate, req.ProductIds)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.BatchDeactivateProductsResponse{Outcomes: outcomes}, nil
+}
+
+// BatchArchiveProducts handles the BatchArchiveProducts gRPC request
+func (h *Handler) BatchArchiveProducts(ctx context.Context, req *pb.BatchArchiveProductsRequest) (*pb.BatchArchiveProductsResponse, error) {
+	outcomes, err := h.batchTransition(ctx, batch_transition.TransitionArchive, req.ProductIds)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.BatchArchiveProductsResponse{Outcomes: outcomes}, nil
+}
+
+// batchTransition validates the product IDs, runs the transition and maps the per-product outcomes
+func (h *Handler) batchTransition(ctx context.Context, transition batch_transition.Transition, productIDs []string) ([]*pb.BatchOutcome, error) {
+	// 1. Validate
+	if len(productIDs) == 0 {
+		return nil, invalidArgumentError("product_ids is required")
+	}
+	if len(productIDs) > maxBatchTransitionProducts {
+		return nil, invalidArgumentError(fmt.Sprintf("at most %d products can be changed per request", maxBatchTransitionProducts))
+	}
+	// Duplicates would be loaded before the first copy commits and report a misleading outcome
+	seen := make(map[string]bool, len(productIDs))
+	for i, id := range productIDs {
+		if strings.TrimSpace(id) == "" {
+			return nil, invalidArgumentError(fmt.Sprintf("product_ids[%d] is empty", i))
+		}
+		if seen[id] {
+			return nil, invalidArgumentError(fmt.Sprintf("product_ids[%d] duplicates %q", i, id))
+		}
+		seen[id] = true
+	}
+
+	// 2. Call use case
+	resp, err := h.batchTransitionInteractor.Execute(ctx, &batch_transition.Request{
+		Transition: transition,
+		ProductIDs: productIDs,
+	})
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 3. Map outcomes to proto
+	outcomes := make([]*pb.BatchOutcome, 0, len(resp.Outcomes))
+	for _, outcome := range resp.Outcomes {
+		result := &pb.BatchOutcome{ProductId: outcome.ProductID, Code: code.Code_OK.String()}
+		if outcome.Err != nil {
+			st := status.Convert(MapDomainError(outcome.Err))
+			result.Code = code.Code(st.Code()).String()
+			result.Message = st.Message()
+		}
+		outcomes = append(outcomes, result)
+	}
+	return outcomes, nil
+}
@@ -0,0 +1,37 @@
+package product
+
+import (
+	"context"
+
+	"catalog-proj/internal/app/product/queries/list_categories"
+	pb "catalog-proj/proto/product/v1"
+)
+
+// ListCategories handles the ListCategories gRPC request
+func (h *Handler) ListCategories(ctx context.Context, req *pb.ListCategoriesRequest) (*pb.ListCategoriesResponse, error) {
+	// 1. Call query
+	dto, err := h.listCategoriesQuery.Execute(ctx, &list_categories.Request{IncludeEmpty: req.IncludeEmpty})
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 2. Map DTO to proto
+	return &pb.ListCategoriesResponse{
+		Categories: categoriesToProto(dto.Categories),
+	}, nil
+}
+
+// categoriesToProto converts a level of the category tree and its descendants
+func categoriesToProto(categories []list_categories.Category) []*pb.Category {
+	out := make([]*pb.Category, 0, len(categories))
+	for _, c := range categories {
+		out = append(out, &pb.Category{
+			Name:                    c.Name,
+			Path:                    c.Path,
+			ActiveProductCount:      c.ActiveProducts,
+			TotalActiveProductCount: c.TotalActiveProducts,
+			Children:                categoriesToProto(c.Children),
+		})
+	}
+	return out
+}
@@ -0,0 +1,40 @@
+package product
+
+import (
+	"context"
+
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/usecases/set_channels"
+	pb "catalog-proj/proto/product/v1"
+)
+
+// SetChannels handles the SetChannels gRPC request
+func (h *Handler) SetChannels(ctx context.Context, req *pb.SetChannelsRequest) (*pb.SetChannelsResponse, error) {
+	// 1. Validate
+	if req.ProductId == "" {
+		return nil, invalidArgumentError("product_id is required")
+	}
+
+	// 2. Map proto to use case request (channel names are validated by the domain)
+	channels := make([]domain.Channel, 0, len(req.Channels))
+	for _, c := range req.Channels {
+		channels = append(channels, domain.Channel(c))
+	}
+	useCaseReq := &set_channels.Request{
+		ProductID: req.ProductId,
+		Channels:  channels,
+	}
+
+	// 3. Call use case
+	ctx = recordCommit(ctx, req.ReturnProduct)
+	resp, err := h.setChannelsInteractor.Execute(ctx, useCaseReq)
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 4. Map response to proto
+	return &pb.SetChannelsResponse{
+		ProductId: resp.ProductID,
+		Product:   h.committedProduct(ctx, req.ReturnProduct, resp.Product, false),
+	}, nil
+}
@@ -0,0 +1,52 @@
+package product
+
+import (
+	"context"
+	"strings"
+
+	"catalog-proj/internal/app/product/usecases/clone_product"
+	pb "catalog-proj/proto/product/v1"
+)
+
+// CloneProduct handles the CloneProduct gRPC request
+func (h *Handler) CloneProduct(ctx context.Context, req *pb.CloneProductRequest) (*pb.CloneProductResponse, error) {
+	// 1. Validate every field, so all problems are reported at once
+	var violations fieldViolations
+	if req.ProductId == "" {
+		violations.add("product_id", "product_id is required")
+	}
+	name := h.text.Line(req.Name)
+	if len(name) > 255 {
+		violations.add("name", "name exceeds maximum length of 255 characters")
+	}
+	if req.CopyPrices && req.BasePrice != nil {
+		violations.add("base_price", "base_price cannot be set with copy_prices")
+	} else if !req.CopyPrices && req.BasePrice == nil {
+		violations.add("base_price", "base_price is required unless copy_prices is set")
+	} else if req.BasePrice != nil && req.BasePrice.Amount <= 0 {
+		violations.add("base_price", "base_price must be positive")
+	}
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+
+	// 2. Call use case
+	ctx = recordCommit(ctx, true)
+	resp, err := h.cloneProductInteractor.Execute(ctx, &clone_product.Request{
+		ProductID:      req.ProductId,
+		TargetTenantID: strings.TrimSpace(req.TargetTenantId),
+		Name:           name,
+		CopyPrices:     req.CopyPrices,
+		BasePrice:      ProtoMoneyToDomain(req.BasePrice),
+	})
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 3. Map response to proto
+	return &pb.CloneProductResponse{
+		ProductId: resp.ProductID,
+		TenantId:  resp.TenantID,
+		Product:   h.committedProduct(ctx, true, resp.Product, true),
+	}, nil
+}
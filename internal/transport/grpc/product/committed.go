@@ -0,0 +1,38 @@
+package product
+
+import (
+	"context"
+
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/pkg/committer"
+	pb "catalog-proj/proto/product/v1"
+)
+
+// recordCommit returns a context that records the commit timestamp when the request set
+// return_product
+func recordCommit(ctx context.Context, returnProduct bool) context.Context {
+	if !returnProduct {
+		return ctx
+	}
+	return committer.WithCommitTimestamp(ctx)
+}
+
+// committedProduct maps the product a mutation committed for requests that set return_product,
+// and is nil otherwise. The aggregate carries the server clock's timestamps while Spanner stored
+// the commit timestamp, so updated_at, and created_at for a new product, are taken from the commit
+func (h *Handler) committedProduct(ctx context.Context, returnProduct bool, product *domain.Product, created bool) *pb.Product {
+	if !returnProduct || product == nil {
+		return nil
+	}
+
+	dto := h.getProductQuery.FromProduct(product)
+	if commitTS, ok := committer.CommitTimestamp(ctx); ok {
+		if created || product.Changes().Dirty(domain.FieldUpdatedAt) {
+			dto.UpdatedAt = commitTS
+		}
+		if created {
+			dto.CreatedAt = commitTS
+		}
+	}
+	return DTOToProtoProduct(dto)
+}
@@ -0,0 +1,56 @@
+package product
+
+import (
+	"context"
+	"fmt"
+
+	"catalog-proj/internal/app/product/queries/compare_products"
+	pb "catalog-proj/proto/product/v1"
+)
+
+// CompareProducts handles the CompareProducts gRPC request
+func (h *Handler) CompareProducts(ctx context.Context, req *pb.CompareProductsRequest) (*pb.CompareProductsResponse, error) {
+	// 1. Validate
+	if len(req.ProductIds) < compare_products.MinProducts || len(req.ProductIds) > compare_products.MaxProducts {
+		return nil, invalidArgumentError(fmt.Sprintf("product_ids must contain between %d and %d ids", compare_products.MinProducts, compare_products.MaxProducts))
+	}
+	seen := make(map[string]bool, len(req.ProductIds))
+	for _, id := range req.ProductIds {
+		if id == "" {
+			return nil, invalidArgumentError("product_ids cannot contain empty ids")
+		}
+		if seen[id] {
+			return nil, invalidArgumentError("product_ids must be distinct")
+		}
+		seen[id] = true
+	}
+
+	// 2. Call query
+	dto, err := h.compareProductsQuery.Execute(ctx, req.ProductIds)
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 3. Map DTO to proto
+	resp := &pb.CompareProductsResponse{
+		Products:          make([]*pb.Product, 0, len(dto.Products)),
+		Rows:              make([]*pb.ComparisonRow, 0, len(dto.Rows)),
+		CheapestProductId: dto.CheapestProductID,
+		PriceDifferences:  make([]*pb.Money, 0, len(dto.PriceDifferences)),
+	}
+	for _, p := range dto.Products {
+		resp.Products = append(resp.Products, DTOToProtoProduct(p))
+	}
+	for _, row := range dto.Rows {
+		resp.Rows = append(resp.Rows, &pb.ComparisonRow{
+			Attribute: row.Attribute,
+			Values:    row.Values,
+			Differs:   row.Differs,
+		})
+	}
+	for _, diff := range dto.PriceDifferences {
+		resp.PriceDifferences = append(resp.PriceDifferences, BigRatToProtoMoney(diff))
+	}
+
+	return resp, nil
+}
@@ -4,54 +4,86 @@ import (
 	"context"
 	"strings"
 
+	"catalog-proj/internal/app/product/domain"
 	"catalog-proj/internal/app/product/usecases/create_product"
 	pb "catalog-proj/proto/product/v1"
 )
 
 // CreateProduct handles the CreateProduct gRPC request
 func (h *Handler) CreateProduct(ctx context.Context, req *pb.CreateProductRequest) (*pb.CreateProductResponse, error) {
-	// 1. Validate
-	name := strings.TrimSpace(req.Name)
+	// 1. Validate every field, so all problems are reported at once
+	var violations fieldViolations
+	name := h.text.Line(req.Name)
 	if name == "" {
-		return nil, invalidArgumentError("name is required and cannot be empty")
-	}
-	if len(name) > 255 {
-		return nil, invalidArgumentError("name exceeds maximum length of 255 characters")
+		violations.add("name", "name is required and cannot be empty")
+	} else if len(name) > 255 {
+		violations.add("name", "name exceeds maximum length of 255 characters")
 	}
 
-	description := strings.TrimSpace(req.Description)
+	description := h.text.Description(req.Description)
 	if description == "" {
-		return nil, invalidArgumentError("description is required and cannot be empty")
-	}
-	if len(description) > 1000 {
-		return nil, invalidArgumentError("description exceeds maximum length of 1000 characters")
+		violations.add("description", "description is required and cannot be empty")
+	} else if len(description) > 1000 {
+		violations.add("description", "description exceeds maximum length of 1000 characters")
 	}
 
-	category := strings.TrimSpace(req.Category)
+	category := h.text.Line(req.Category)
 	if category == "" {
-		return nil, invalidArgumentError("category is required and cannot be empty")
-	}
-	if len(category) > 100 {
-		return nil, invalidArgumentError("category exceeds maximum length of 100 characters")
+		violations.add("category", "category is required and cannot be empty")
+	} else if len(category) > 100 {
+		violations.add("category", "category exceeds maximum length of 100 characters")
 	}
 
 	if req.BasePrice == nil {
-		return nil, invalidArgumentError("base_price is required")
+		violations.add("base_price", "base_price is required")
+	} else if req.BasePrice.Amount <= 0 {
+		violations.add("base_price", "base_price must be positive")
+	}
+
+	duplicateCheck, err := protoDuplicateCheckToUseCase(req.DuplicateCheck)
+	if err != nil {
+		violations.add("duplicate_check", "unknown duplicate_check mode")
 	}
-	if req.BasePrice.Amount <= 0 {
-		return nil, invalidArgumentError("base_price must be positive")
+	productType, err := ProtoProductTypeToDomain(req.ProductType)
+	if err != nil {
+		violations.add("product_type", "unknown product_type")
+	}
+	descriptionFormat, err := ProtoDescriptionFormatToDomain(req.DescriptionFormat)
+	if err != nil {
+		violations.add("description_format", "unknown description_format")
+	}
+	if err := violations.err(); err != nil {
+		return nil, err
 	}
 
 	// 2. Map proto to use case request
 	basePrice := ProtoMoneyToDomain(req.BasePrice)
+	shipping := domain.ShippingDetails{
+		Weight:        ProtoWeightToDomain(req.Weight),
+		Dimensions:    ProtoDimensionsToDomain(req.Dimensions),
+		ShippingClass: strings.TrimSpace(req.ShippingClass),
+	}
+	digital := domain.DigitalDelivery{
+		DownloadURL:  strings.TrimSpace(req.DownloadUrl),
+		LicenseTerms: strings.TrimSpace(req.LicenseTerms),
+	}
 	useCaseReq := &create_product.Request{
-		Name:        name,
-		Description: description,
-		Category:    category,
-		BasePrice:   basePrice,
+		Name:              name,
+		Description:       description,
+		DescriptionFormat: descriptionFormat,
+		Category:          category,
+		SKU:               strings.TrimSpace(req.Sku),
+		GTIN:              strings.TrimSpace(req.Gtin),
+		BasePrice:         basePrice,
+		ProductType:       productType,
+		Shipping:          shipping,
+		Digital:           digital,
+		Compliance:        ProtoComplianceToDomain(req.Compliance),
+		DuplicateCheck:    duplicateCheck,
 	}
 
 	// 3. Call use case
+	ctx = recordCommit(ctx, req.ReturnProduct)
 	resp, err := h.createProductInteractor.Execute(ctx, useCaseReq)
 	if err != nil {
 		return nil, MapDomainError(err)
@@ -59,6 +91,22 @@ func (h *Handler) CreateProduct(ctx context.Context, req *pb.CreateProductReques
 
 	// 4. Map response to proto
 	return &pb.CreateProductResponse{
-		ProductId: resp.ProductID,
+		ProductId:            resp.ProductID,
+		PossibleDuplicateIds: resp.PossibleDuplicates,
+		Product:              h.committedProduct(ctx, req.ReturnProduct, resp.Product, true),
 	}, nil
 }
+
+// protoDuplicateCheckToUseCase converts the proto duplicate check mode
+func protoDuplicateCheckToUseCase(mode pb.DuplicateCheck) (create_product.DuplicateCheck, error) {
+	switch mode {
+	case pb.DuplicateCheck_DUPLICATE_CHECK_UNSPECIFIED, pb.DuplicateCheck_DUPLICATE_CHECK_ALLOW:
+		return create_product.DuplicateCheckAllow, nil
+	case pb.DuplicateCheck_DUPLICATE_CHECK_WARN:
+		return create_product.DuplicateCheckWarn, nil
+	case pb.DuplicateCheck_DUPLICATE_CHECK_REJECT:
+		return create_product.DuplicateCheckReject, nil
+	default:
+		return "", invalidArgumentError("unknown duplicate_check mode")
+	}
+}
@@ -0,0 +1,52 @@
+package product
+
+import (
+	"context"
+
+	"catalog-proj/internal/app/product/queries/list_curated_products"
+	pb "catalog-proj/proto/product/v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxCuratedLimit bounds the products per curated list request
+const maxCuratedLimit = 50
+
+// ListNewArrivals handles the ListNewArrivals gRPC request
+func (h *Handler) ListNewArrivals(ctx context.Context, req *pb.ListCuratedProductsRequest) (*pb.ListCuratedProductsResponse, error) {
+	return h.listCuratedProducts(ctx, list_curated_products.ListNewArrivals, req)
+}
+
+// ListTrendingProducts handles the ListTrendingProducts gRPC request
+func (h *Handler) ListTrendingProducts(ctx context.Context, req *pb.ListCuratedProductsRequest) (*pb.ListCuratedProductsResponse, error) {
+	return h.listCuratedProducts(ctx, list_curated_products.ListTrending, req)
+}
+
+// listCuratedProducts serves one of the curated lists
+func (h *Handler) listCuratedProducts(ctx context.Context, list string, req *pb.ListCuratedProductsRequest) (*pb.ListCuratedProductsResponse, error) {
+	// 1. Validate
+	if req.Limit < 0 || req.Limit > maxCuratedLimit {
+		return nil, invalidArgumentError("limit must be between 0 and 50")
+	}
+
+	// 2. Call query
+	dto, err := h.listCuratedProductsQuery.Execute(ctx, &list_curated_products.Request{
+		List:  list,
+		Limit: int(req.Limit),
+	})
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 3. Map DTO to proto
+	resp := &pb.ListCuratedProductsResponse{
+		Products: make([]*pb.Product, 0, len(dto.Products)),
+	}
+	for _, p := range dto.Products {
+		resp.Products = append(resp.Products, DTOToProtoProduct(p))
+	}
+	if dto.ComputedAt != nil {
+		resp.ComputedAt = timestamppb.New(*dto.ComputedAt)
+	}
+	return resp, nil
+}
@@ -20,6 +20,7 @@ func (h *Handler) DeactivateProduct(ctx context.Context, req *pb.DeactivateProdu
 	}
 
 	// 3. Call use case
+	ctx = recordCommit(ctx, req.ReturnProduct)
 	resp, err := h.deactivateProductInteractor.Execute(ctx, useCaseReq)
 	if err != nil {
 		return nil, MapDomainError(err)
@@ -28,5 +29,6 @@ func (h *Handler) DeactivateProduct(ctx context.Context, req *pb.DeactivateProdu
 	// 4. Map response to proto
 	return &pb.DeactivateProductResponse{
 		ProductId: resp.ProductID,
+		Product:   h.committedProduct(ctx, req.ReturnProduct, resp.Product, false),
 	}, nil
 }
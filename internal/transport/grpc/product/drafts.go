@@ -0,0 +1,85 @@
+package product
+
+import (
+	"context"
+
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/usecases/discard_draft"
+	"catalog-proj/internal/app/product/usecases/publish_draft"
+	"catalog-proj/internal/app/product/usecases/save_draft"
+	pb "catalog-proj/proto/product/v1"
+)
+
+// SaveDraft handles the SaveDraft gRPC request
+func (h *Handler) SaveDraft(ctx context.Context, req *pb.SaveDraftRequest) (*pb.SaveDraftResponse, error) {
+	// 1. Validate
+	if req.ProductId == "" {
+		return nil, invalidArgumentError("product_id is required")
+	}
+
+	// 2. Map proto to use case request (content is validated by the domain)
+	useCaseReq := &save_draft.Request{
+		ProductID:   req.ProductId,
+		Name:        h.normalizeLine(req.Name),
+		Description: h.normalizeDescription(req.Description),
+		Category:    h.normalizeLine(req.Category),
+	}
+	if req.Metadata != nil {
+		metadata := domain.Metadata(req.Metadata.Entries)
+		useCaseReq.Metadata = &metadata
+	}
+
+	// 3. Call use case
+	resp, err := h.saveDraftInteractor.Execute(ctx, useCaseReq)
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 4. Map response to proto
+	return &pb.SaveDraftResponse{
+		ProductId: resp.ProductID,
+	}, nil
+}
+
+// PublishDraft handles the PublishDraft gRPC request
+func (h *Handler) PublishDraft(ctx context.Context, req *pb.PublishDraftRequest) (*pb.PublishDraftResponse, error) {
+	// 1. Validate
+	if req.ProductId == "" {
+		return nil, invalidArgumentError("product_id is required")
+	}
+
+	// 2. Call use case
+	resp, err := h.publishDraftInteractor.Execute(ctx, &publish_draft.Request{
+		ProductID: req.ProductId,
+	})
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 3. Map response to proto
+	return &pb.PublishDraftResponse{
+		ProductId: resp.ProductID,
+		VersionId: resp.VersionID,
+	}, nil
+}
+
+// DiscardDraft handles the DiscardDraft gRPC request
+func (h *Handler) DiscardDraft(ctx context.Context, req *pb.DiscardDraftRequest) (*pb.DiscardDraftResponse, error) {
+	// 1. Validate
+	if req.ProductId == "" {
+		return nil, invalidArgumentError("product_id is required")
+	}
+
+	// 2. Call use case
+	resp, err := h.discardDraftInteractor.Execute(ctx, &discard_draft.Request{
+		ProductID: req.ProductId,
+	})
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 3. Map response to proto
+	return &pb.DiscardDraftResponse{
+		ProductId: resp.ProductID,
+	}, nil
+}
@@ -1,8 +1,14 @@
 package product
 
 import (
+	"errors"
+	"fmt"
+
 	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/queries/list_products"
+	"catalog-proj/internal/pkg/breaker"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -13,40 +19,230 @@ func MapDomainError(err error) error {
 		return nil
 	}
 
-	domainErr, ok := err.(*domain.DomainError)
-	if !ok {
+	// Read model circuit breaker is open: fail fast so clients back off
+	if errors.Is(err, breaker.ErrOpen) {
+		return status.Error(codes.Unavailable, "product catalog is temporarily unavailable, retry later")
+	}
+
+	// Quota violations carry QuotaFailure details so clients can see which limit was hit
+	var quotaErr *domain.QuotaExceededError
+	if errors.As(err, &quotaErr) {
+		return quotaExceededStatus(quotaErr)
+	}
+
+	// Duplicate detection in reject mode names the conflicting products
+	var duplicateErr *domain.DuplicateProductError
+	if errors.As(err, &duplicateErr) {
+		return status.Error(codes.AlreadyExists, duplicateErr.Error())
+	}
+
+	// Unique name violations point at the product holding the name
+	var nameTakenErr *domain.ProductNameTakenError
+	if errors.As(err, &nameTakenErr) {
+		return productNameTakenStatus(nameTakenErr)
+	}
+
+	// External references held by another product point at the holder
+	var refTakenErr *domain.ExternalRefTakenError
+	if errors.As(err, &refTakenErr) {
+		return externalRefTakenStatus(refTakenErr)
+	}
+
+	// Rule violations are all reported as BadRequest field violations
+	var validationErr *domain.ValidationFailedError
+	if errors.As(err, &validationErr) {
+		return validationFailedStatus(validationErr)
+	}
+
+	// Oversized list pages are a client error, not a reason to return the whole catalog
+	var pageSizeErr *list_products.PageSizeError
+	if errors.As(err, &pageSizeErr) {
+		return status.Error(codes.InvalidArgument, pageSizeErr.Error())
+	}
+
+	// Use cases wrap domain errors with context, so unwrap before matching
+	var domainErr *domain.DomainError
+	if !errors.As(err, &domainErr) {
 		// Unknown error, return as internal error
 		return status.Errorf(codes.Internal, "internal error: %v", err)
 	}
 
 	switch domainErr.Code {
 	case domain.ErrProductNotFound.Code:
-		return status.Errorf(codes.NotFound, domainErr.Message)
+		return status.Error(codes.NotFound, domainErr.Message)
 	case domain.ErrProductNotActive.Code:
-		return status.Errorf(codes.FailedPrecondition, domainErr.Message)
+		return status.Error(codes.FailedPrecondition, domainErr.Message)
 	case domain.ErrInvalidDiscountPeriod.Code:
-		return status.Errorf(codes.InvalidArgument, domainErr.Message)
+		return status.Error(codes.InvalidArgument, domainErr.Message)
 	case domain.ErrProductAlreadyArchived.Code:
-		return status.Errorf(codes.FailedPrecondition, domainErr.Message)
+		return status.Error(codes.FailedPrecondition, domainErr.Message)
+	case domain.ErrMergeIntoSelf.Code:
+		return status.Error(codes.InvalidArgument, domainErr.Message)
+	case domain.ErrMergeTargetArchived.Code:
+		return status.Error(codes.FailedPrecondition, domainErr.Message)
 	case domain.ErrDiscountAlreadyActive.Code:
-		return status.Errorf(codes.AlreadyExists, domainErr.Message)
+		return status.Error(codes.AlreadyExists, domainErr.Message)
 	case domain.ErrInvalidPrice.Code:
-		return status.Errorf(codes.InvalidArgument, domainErr.Message)
+		return status.Error(codes.InvalidArgument, domainErr.Message)
 	case domain.ErrProductHasActiveDiscount.Code:
-		return status.Errorf(codes.FailedPrecondition, domainErr.Message)
+		return status.Error(codes.FailedPrecondition, domainErr.Message)
 	case domain.ErrInvalidProductName.Code:
-		return status.Errorf(codes.InvalidArgument, domainErr.Message)
-	case domain.ErrInvalidProductDescription.Code:
-		return status.Errorf(codes.InvalidArgument, domainErr.Message)
+		return status.Error(codes.InvalidArgument, domainErr.Message)
+	case domain.ErrInvalidProductDescription.Code, domain.ErrInvalidDescriptionFormat.Code, domain.ErrInvalidMarkdown.Code:
+		return status.Error(codes.InvalidArgument, domainErr.Message)
 	case domain.ErrInvalidProductCategory.Code:
-		return status.Errorf(codes.InvalidArgument, domainErr.Message)
+		return status.Error(codes.InvalidArgument, domainErr.Message)
 	case domain.ErrInvalidDiscountID.Code:
-		return status.Errorf(codes.InvalidArgument, domainErr.Message)
+		return status.Error(codes.InvalidArgument, domainErr.Message)
 	case domain.ErrInvalidDiscountAmount.Code:
-		return status.Errorf(codes.InvalidArgument, domainErr.Message)
+		return status.Error(codes.InvalidArgument, domainErr.Message)
 	case domain.ErrInvalidDiscountDateRange.Code:
-		return status.Errorf(codes.InvalidArgument, domainErr.Message)
+		return status.Error(codes.InvalidArgument, domainErr.Message)
+	case domain.ErrInvalidSKU.Code:
+		return status.Error(codes.InvalidArgument, domainErr.Message)
+	case domain.ErrInvalidGTIN.Code:
+		return status.Error(codes.InvalidArgument, domainErr.Message)
+	case domain.ErrInvalidReviewer.Code, domain.ErrInvalidReviewDecision.Code, domain.ErrInvalidReviewComment.Code:
+		return status.Error(codes.InvalidArgument, domainErr.Message)
+	case domain.ErrInvalidChannel.Code:
+		return status.Error(codes.InvalidArgument, domainErr.Message)
+	case domain.ErrInvalidWeight.Code, domain.ErrInvalidDimensions.Code, domain.ErrInvalidShippingClass.Code:
+		return status.Error(codes.InvalidArgument, domainErr.Message)
+	case domain.ErrInvalidMetadata.Code:
+		return status.Error(codes.InvalidArgument, domainErr.Message)
+	case domain.ErrInvalidPriceFloor.Code:
+		return status.Error(codes.InvalidArgument, domainErr.Message)
+	case domain.ErrPriceBelowFloor.Code:
+		return status.Error(codes.FailedPrecondition, domainErr.Message)
+	case domain.ErrInvalidApprover.Code:
+		return status.Error(codes.InvalidArgument, domainErr.Message)
+	case domain.ErrSelfApproval.Code:
+		return status.Error(codes.PermissionDenied, domainErr.Message)
+	case domain.ErrChangeNotFound.Code:
+		return status.Error(codes.NotFound, domainErr.Message)
+	case domain.ErrChangeNotPending.Code, domain.ErrChangeStale.Code:
+		return status.Error(codes.FailedPrecondition, domainErr.Message)
+	case domain.ErrInvalidExternalRef.Code:
+		return status.Error(codes.InvalidArgument, domainErr.Message)
+	case domain.ErrExternalRefNotFound.Code:
+		return status.Error(codes.NotFound, domainErr.Message)
+	case domain.ErrInvalidMerchRule.Code:
+		return status.Error(codes.InvalidArgument, domainErr.Message)
+	case domain.ErrMerchRuleNotFound.Code:
+		return status.Error(codes.NotFound, domainErr.Message)
+	case domain.ErrSameCategory.Code:
+		return status.Error(codes.InvalidArgument, domainErr.Message)
+	case domain.ErrInvalidAttributes.Code, domain.ErrInvalidCategoryTemplate.Code:
+		return status.Error(codes.InvalidArgument, domainErr.Message)
+	case domain.ErrCategoryTemplateNotFound.Code:
+		return status.Error(codes.NotFound, domainErr.Message)
+	case domain.ErrInvalidTenantSettings.Code, domain.ErrDiscountOutOfRange.Code:
+		return status.Error(codes.InvalidArgument, domainErr.Message)
+	case domain.ErrInvalidTenant.Code:
+		return status.Error(codes.InvalidArgument, domainErr.Message)
+	case domain.ErrTenantNotFound.Code:
+		return status.Error(codes.NotFound, domainErr.Message)
+	case domain.ErrTenantExists.Code:
+		return status.Error(codes.AlreadyExists, domainErr.Message)
+	case domain.ErrTenantProvisioningDenied.Code, domain.ErrCrossTenantCloneDenied.Code:
+		return status.Error(codes.PermissionDenied, domainErr.Message)
+	case domain.ErrTenantBeingDeleted.Code, domain.ErrOperatorTenantDeletion.Code, domain.ErrTenantUnderLegalHold.Code, domain.ErrTenantExportUnavailable.Code:
+		return status.Error(codes.FailedPrecondition, domainErr.Message)
+	case domain.ErrUnboundedScan.Code:
+		return status.Error(codes.InvalidArgument, domainErr.Message)
+	case domain.ErrEffectivePriceSearchUnavailable.Code:
+		return status.Error(codes.FailedPrecondition, domainErr.Message)
+	case domain.ErrVersionNotFound.Code:
+		return status.Error(codes.NotFound, domainErr.Message)
+	case domain.ErrDraftNotFound.Code:
+		return status.Error(codes.NotFound, domainErr.Message)
+	case domain.ErrInvalidAgeRestriction.Code:
+		return status.Error(codes.InvalidArgument, domainErr.Message)
+	case domain.ErrInvalidProductType.Code, domain.ErrInvalidDownloadURL.Code, domain.ErrInvalidLicenseTerms.Code,
+		domain.ErrShippingNotApplicable.Code, domain.ErrDigitalDeliveryRequired.Code, domain.ErrDigitalDeliveryNotApplicable.Code:
+		return status.Error(codes.InvalidArgument, domainErr.Message)
 	default:
 		return status.Errorf(codes.Internal, "unexpected error: %s", domainErr.Message)
 	}
 }
+
+// quotaExceededStatus builds a ResourceExhausted status with QuotaFailure details
+func quotaExceededStatus(err *domain.QuotaExceededError) error {
+	st := status.New(codes.ResourceExhausted, err.Error())
+	detailed, detailErr := st.WithDetails(&errdetails.QuotaFailure{
+		Violations: []*errdetails.QuotaFailure_Violation{
+			{
+				Subject:     err.Subject,
+				Description: fmt.Sprintf("%s: limit %d, current %d", err.Quota, err.Limit, err.Current),
+			},
+		},
+	})
+	if detailErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// productNameTakenStatus builds an AlreadyExists status with a ResourceInfo naming the conflicting product
+func productNameTakenStatus(err *domain.ProductNameTakenError) error {
+	st := status.New(codes.AlreadyExists, err.Error())
+	detailed, detailErr := st.WithDetails(&errdetails.ResourceInfo{
+		ResourceType: "product.v1.Product",
+		ResourceName: err.ProductID,
+		Description:  fmt.Sprintf("category %q already has a product named %q", err.Category, err.Name),
+	})
+	if detailErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// externalRefTakenStatus builds an AlreadyExists status with a ResourceInfo naming the product holding the reference
+func externalRefTakenStatus(err *domain.ExternalRefTakenError) error {
+	st := status.New(codes.AlreadyExists, err.Error())
+	detailed, detailErr := st.WithDetails(&errdetails.ResourceInfo{
+		ResourceType: "product.v1.Product",
+		ResourceName: err.ProductID,
+		Description:  fmt.Sprintf("%s reference %q is already linked", err.Ref.System, err.Ref.ExternalID),
+	})
+	if detailErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// validationFailedStatus builds an InvalidArgument status with a BadRequest listing every violation
+func validationFailedStatus(err *domain.ValidationFailedError) error {
+	st := status.New(codes.InvalidArgument, err.Error())
+	detailed, detailErr := st.WithDetails(&errdetails.BadRequest{FieldViolations: violationsToProto(err.Violations)})
+	if detailErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// violationsToProto converts rule violations to BadRequest field violations
+func violationsToProto(violations []domain.RuleViolation) []*errdetails.BadRequest_FieldViolation {
+	out := make([]*errdetails.BadRequest_FieldViolation, len(violations))
+	for i, v := range violations {
+		out[i] = &errdetails.BadRequest_FieldViolation{Field: v.Field, Description: v.Description}
+	}
+	return out
+}
+
+// fieldViolations collects a request's invalid fields so handlers report them all in one error
+// rather than stopping at the first
+type fieldViolations []domain.RuleViolation
+
+// add records that field is invalid
+func (v *fieldViolations) add(field, description string) {
+	*v = append(*v, domain.RuleViolation{Field: field, Description: description})
+}
+
+// err returns an InvalidArgument status with a BadRequest listing every violation, or nil when there are none
+func (v fieldViolations) err() error {
+	if len(v) == 0 {
+		return nil
+	}
+	return validationFailedStatus(&domain.ValidationFailedError{Violations: v})
+}
@@ -0,0 +1,27 @@
+package product
+
+import (
+	"context"
+
+	pb "catalog-proj/proto/product/v1"
+)
+
+// ExportProductData handles the ExportProductData gRPC request
+func (h *Handler) ExportProductData(ctx context.Context, req *pb.ExportProductDataRequest) (*pb.ExportProductDataResponse, error) {
+	// 1. Validate
+	if req.ProductId == "" {
+		return nil, invalidArgumentError("product_id is required")
+	}
+
+	// 2. Call query
+	document, err := h.exportProductDataQuery.Execute(ctx, req.ProductId)
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 3. Return response
+	return &pb.ExportProductDataResponse{
+		ProductId: req.ProductId,
+		Document:  string(document),
+	}, nil
+}
@@ -0,0 +1,75 @@
+package product
+
+import (
+	"context"
+
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/usecases/link_external_ref"
+	"catalog-proj/internal/app/product/usecases/unlink_external_ref"
+	pb "catalog-proj/proto/product/v1"
+)
+
+// LinkExternalRef handles the LinkExternalRef gRPC request
+func (h *Handler) LinkExternalRef(ctx context.Context, req *pb.LinkExternalRefRequest) (*pb.LinkExternalRefResponse, error) {
+	// 1. Validate
+	if req.ProductId == "" {
+		return nil, invalidArgumentError("product_id is required")
+	}
+
+	// 2. Map proto to use case request (the reference format is validated by the domain)
+	useCaseReq := &link_external_ref.Request{
+		ProductID: req.ProductId,
+		Ref:       domain.ExternalRef{System: req.System, ExternalID: req.ExternalId},
+	}
+
+	// 3. Call use case
+	resp, err := h.linkExternalRefInteractor.Execute(ctx, useCaseReq)
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 4. Map response to proto
+	return &pb.LinkExternalRefResponse{
+		ProductId: resp.ProductID,
+	}, nil
+}
+
+// UnlinkExternalRef handles the UnlinkExternalRef gRPC request
+func (h *Handler) UnlinkExternalRef(ctx context.Context, req *pb.UnlinkExternalRefRequest) (*pb.UnlinkExternalRefResponse, error) {
+	// 1. Validate
+	if req.ProductId == "" {
+		return nil, invalidArgumentError("product_id is required")
+	}
+
+	// 2. Map proto to use case request
+	useCaseReq := &unlink_external_ref.Request{
+		ProductID: req.ProductId,
+		Ref:       domain.ExternalRef{System: req.System, ExternalID: req.ExternalId},
+	}
+
+	// 3. Call use case
+	resp, err := h.unlinkExternalRefInteractor.Execute(ctx, useCaseReq)
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 4. Map response to proto
+	return &pb.UnlinkExternalRefResponse{
+		ProductId: resp.ProductID,
+	}, nil
+}
+
+// GetProductByExternalRef handles the GetProductByExternalRef gRPC request
+func (h *Handler) GetProductByExternalRef(ctx context.Context, req *pb.GetProductByExternalRefRequest) (*pb.GetProductResponse, error) {
+	// 1. Call query (the reference format is validated by the query)
+	dto, err := h.getProductByExternalRefQuery.Execute(ctx, domain.ExternalRef{System: req.System, ExternalID: req.ExternalId})
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 2. Map DTO to proto
+	return &pb.GetProductResponse{
+		Product:     DTOToProtoProduct(dto),
+		AliasedFrom: dto.AliasedFrom,
+	}, nil
+}
@@ -0,0 +1,66 @@
+package product
+
+import (
+	"context"
+
+	"catalog-proj/internal/pkg/faults"
+	pb "catalog-proj/proto/product/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// GetFaultInjection handles the GetFaultInjection gRPC request
+func (h *Handler) GetFaultInjection(ctx context.Context, req *pb.GetFaultInjectionRequest) (*pb.GetFaultInjectionResponse, error) {
+	if h.faultInjector == nil {
+		return nil, status.Error(codes.FailedPrecondition, "fault injection is disabled")
+	}
+	return &pb.GetFaultInjectionResponse{
+		FaultInjection: faultInjectionToProto(h.faultInjector.Settings()),
+	}, nil
+}
+
+// SetFaultInjection handles the SetFaultInjection gRPC request
+// Only the server handling the request is retuned; other replicas keep their settings
+func (h *Handler) SetFaultInjection(ctx context.Context, req *pb.SetFaultInjectionRequest) (*pb.SetFaultInjectionResponse, error) {
+	if h.faultInjector == nil {
+		return nil, status.Error(codes.FailedPrecondition, "fault injection is disabled")
+	}
+
+	// 1. Validate
+	var settings faults.Settings
+	if fi := req.FaultInjection; fi != nil {
+		if fi.Latency != nil {
+			if err := fi.Latency.CheckValid(); err != nil {
+				return nil, invalidArgumentError("latency is invalid")
+			}
+			settings.Latency = fi.Latency.AsDuration()
+		}
+		settings.AbortPercent = fi.AbortPercent
+		settings.UnavailablePercent = fi.UnavailablePercent
+		settings.NotFoundPercent = fi.NotFoundPercent
+	}
+	if err := settings.Validate(); err != nil {
+		return nil, invalidArgumentError(err.Error())
+	}
+
+	// 2. Apply
+	h.faultInjector.Set(settings)
+
+	return &pb.SetFaultInjectionResponse{
+		FaultInjection: faultInjectionToProto(settings),
+	}, nil
+}
+
+func faultInjectionToProto(settings faults.Settings) *pb.FaultInjection {
+	fi := &pb.FaultInjection{
+		AbortPercent:       settings.AbortPercent,
+		UnavailablePercent: settings.UnavailablePercent,
+		NotFoundPercent:    settings.NotFoundPercent,
+	}
+	if settings.Latency > 0 {
+		fi.Latency = durationpb.New(settings.Latency)
+	}
+	return fi
+}
@@ -0,0 +1,59 @@
+package product
+
+import (
+	"context"
+	"strings"
+
+	"catalog-proj/internal/app/product/queries/find_similar_products"
+	"catalog-proj/internal/pkg/tenant"
+	pb "catalog-proj/proto/product/v1"
+)
+
+// maxSimilarProductsLimit bounds the number of matches returned by FindSimilarProducts
+const maxSimilarProductsLimit = 100
+
+// FindSimilarProducts handles the FindSimilarProducts gRPC request
+func (h *Handler) FindSimilarProducts(ctx context.Context, req *pb.FindSimilarProductsRequest) (*pb.FindSimilarProductsResponse, error) {
+	// 1. Validate
+	name := strings.TrimSpace(req.Name)
+	category := strings.TrimSpace(req.Category)
+	sku := strings.TrimSpace(req.Sku)
+	gtin := strings.TrimSpace(req.Gtin)
+	if (name == "" || category == "") && sku == "" && gtin == "" {
+		return nil, invalidArgumentError("name and category, sku, or gtin is required")
+	}
+	if req.Limit < 0 || req.Limit > maxSimilarProductsLimit {
+		return nil, invalidArgumentError("limit must be between 0 and 100")
+	}
+
+	// 2. Call query
+	dto, err := h.findSimilarProductsQuery.Execute(ctx, &find_similar_products.Request{
+		TenantID: tenant.FromContext(ctx),
+		Name:     name,
+		Category: category,
+		SKU:      sku,
+		GTIN:     gtin,
+		Limit:    int(req.Limit),
+	})
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 3. Map DTO to proto
+	products := make([]*pb.SimilarProduct, 0, len(dto.Matches))
+	for _, match := range dto.Matches {
+		products = append(products, &pb.SimilarProduct{
+			ProductId: match.ID,
+			Name:      match.Name,
+			Category:  match.Category,
+			Sku:       match.SKU,
+			Gtin:      match.GTIN,
+			Status:    match.Status,
+			MatchedOn: match.MatchedOn,
+		})
+	}
+
+	return &pb.FindSimilarProductsResponse{
+		Products: products,
+	}, nil
+}
@@ -3,7 +3,15 @@ package product
 import (
 	"context"
 
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/queries/get_product"
+	"catalog-proj/internal/pkg/apikey"
+	"catalog-proj/internal/pkg/markdown"
+	"catalog-proj/internal/pkg/tenant"
 	pb "catalog-proj/proto/product/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // GetProduct handles the GetProduct gRPC request
@@ -13,17 +21,50 @@ func (h *Handler) GetProduct(ctx context.Context, req *pb.GetProductRequest) (*p
 		return nil, invalidArgumentError("product_id is required")
 	}
 
+	// Drafts are unpublished, so read keys such as a storefront's may only preview them with a token
+	previewDraft := req.PreviewDraft || req.PreviewToken != ""
+	if req.PreviewToken != "" {
+		if h.previewTokens == nil {
+			return nil, status.Error(codes.FailedPrecondition, "preview tokens are disabled")
+		}
+		if err := h.previewTokens.Verify(req.PreviewToken, tenant.FromContext(ctx), req.ProductId); err != nil {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+	} else if previewDraft && !apikey.GrantedFromContext(ctx, apikey.ScopeWrite) {
+		return nil, status.Error(codes.PermissionDenied, "preview_draft requires the write scope or a preview_token")
+	}
+
 	// 2. Call query (no mapping needed, query handles it)
-	dto, err := h.getProductQuery.Execute(ctx, req.ProductId)
+	var dto *get_product.DTO
+	var err error
+	if previewDraft {
+		dto, err = h.getProductQuery.ExecutePreview(ctx, req.ProductId)
+	} else {
+		dto, err = h.getProductQuery.Execute(ctx, req.ProductId)
+	}
 	if err != nil {
 		return nil, MapDomainError(err)
 	}
 
 	// 3. Map DTO to proto
 	protoProduct := DTOToProtoProduct(dto)
+	if req.RenderDescriptionHtml {
+		protoProduct.DescriptionHtml = descriptionHTML(dto.DescriptionFormat, dto.Description)
+	}
 
 	// 4. Return response
 	return &pb.GetProductResponse{
-		Product: protoProduct,
+		Product:     protoProduct,
+		AliasedFrom: dto.AliasedFrom,
+		Draft:       dto.Draft,
 	}, nil
 }
+
+// descriptionHTML renders a description as sanitized HTML: markdown through the markdown
+// renderer, plain text as escaped paragraphs
+func descriptionHTML(format domain.DescriptionFormat, description string) string {
+	if format == domain.DescriptionFormatMarkdown {
+		return markdown.ToHTML(description)
+	}
+	return markdown.PlainToHTML(description)
+}
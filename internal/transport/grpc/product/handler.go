@@ -1,15 +1,67 @@
 package product
 
 import (
+	"catalog-proj/internal/app/product/queries/export_product_data"
+	"catalog-proj/internal/app/product/queries/compare_products"
+	"catalog-proj/internal/app/product/queries/find_similar_products"
+	"catalog-proj/internal/app/product/queries/get_category_template"
 	"catalog-proj/internal/app/product/queries/get_product"
+	"catalog-proj/internal/app/product/queries/get_product_by_external_ref"
+	"catalog-proj/internal/app/product/queries/get_product_history"
+	"catalog-proj/internal/app/product/queries/get_product_json_ld"
+	"catalog-proj/internal/app/product/queries/get_product_stats"
+	"catalog-proj/internal/app/product/queries/get_recommendations"
+	"catalog-proj/internal/app/product/queries/get_tenant_settings"
+	"catalog-proj/internal/app/product/queries/list_categories"
+	"catalog-proj/internal/app/product/queries/list_curated_products"
+	"catalog-proj/internal/app/product/queries/list_merch_rules"
+	"catalog-proj/internal/app/product/queries/list_product_versions"
 	"catalog-proj/internal/app/product/queries/list_products"
+	"catalog-proj/internal/app/product/queries/search_products"
+	"catalog-proj/internal/app/product/queries/suggest_products"
+	"catalog-proj/internal/app/product/queries/validate_product"
 	"catalog-proj/internal/app/product/usecases/activate_product"
 	"catalog-proj/internal/app/product/usecases/apply_discount"
 	"catalog-proj/internal/app/product/usecases/archive_product"
+	"catalog-proj/internal/app/product/usecases/batch_transition"
+	"catalog-proj/internal/app/product/usecases/change_base_price"
+	"catalog-proj/internal/app/product/usecases/clone_product"
+	"catalog-proj/internal/app/product/usecases/create_merch_rule"
 	"catalog-proj/internal/app/product/usecases/create_product"
+	"catalog-proj/internal/app/product/usecases/create_tenant"
 	"catalog-proj/internal/app/product/usecases/deactivate_product"
+	"catalog-proj/internal/app/product/usecases/delete_category_template"
+	"catalog-proj/internal/app/product/usecases/discard_draft"
+	"catalog-proj/internal/app/product/usecases/decide_change"
+	"catalog-proj/internal/app/product/usecases/delete_merch_rule"
+	"catalog-proj/internal/app/product/usecases/delete_tenant"
+	"catalog-proj/internal/app/product/usecases/delete_tenant_settings"
+	"catalog-proj/internal/app/product/usecases/link_external_ref"
+	"catalog-proj/internal/app/product/usecases/merge_products"
+	"catalog-proj/internal/app/product/usecases/publish_draft"
+	"catalog-proj/internal/app/product/usecases/purge_archived_products"
+	"catalog-proj/internal/app/product/usecases/put_category_template"
+	"catalog-proj/internal/app/product/usecases/put_tenant_settings"
+	"catalog-proj/internal/app/product/usecases/reassign_category"
+	"catalog-proj/internal/app/product/usecases/rebuild_projection"
+	"catalog-proj/internal/app/product/usecases/record_product_view"
 	"catalog-proj/internal/app/product/usecases/remove_discount"
+	"catalog-proj/internal/app/product/usecases/review_product"
+	"catalog-proj/internal/app/product/usecases/rollback_to_version"
+	"catalog-proj/internal/app/product/usecases/save_draft"
+	"catalog-proj/internal/app/product/usecases/set_attributes"
+	"catalog-proj/internal/app/product/usecases/set_channels"
+	"catalog-proj/internal/app/product/usecases/set_legal_hold"
+	"catalog-proj/internal/app/product/usecases/set_metadata"
+	"catalog-proj/internal/app/product/usecases/set_price_floor"
+	"catalog-proj/internal/app/product/usecases/unlink_external_ref"
 	"catalog-proj/internal/app/product/usecases/update_product"
+	"catalog-proj/internal/pkg/apikey"
+	"catalog-proj/internal/pkg/faults"
+	"catalog-proj/internal/pkg/lro"
+	"catalog-proj/internal/pkg/preview"
+	"catalog-proj/internal/pkg/textnorm"
+	"catalog-proj/internal/pkg/usage"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -28,10 +80,78 @@ type Handler struct {
 	activateProductInteractor   *activate_product.Interactor
 	deactivateProductInteractor *deactivate_product.Interactor
 	archiveProductInteractor    *archive_product.Interactor
+	setLegalHoldInteractor      *set_legal_hold.Interactor
+	reviewProductInteractor     *review_product.Interactor
+	setChannelsInteractor       *set_channels.Interactor
+	setMetadataInteractor       *set_metadata.Interactor
+	linkExternalRefInteractor   *link_external_ref.Interactor
+	unlinkExternalRefInteractor *unlink_external_ref.Interactor
+	changeBasePriceInteractor   *change_base_price.Interactor
+	decideChangeInteractor      *decide_change.Interactor
+	setPriceFloorInteractor     *set_price_floor.Interactor
+	batchTransitionInteractor   *batch_transition.Interactor
+	rollbackToVersionInteractor *rollback_to_version.Interactor
+	saveDraftInteractor         *save_draft.Interactor
+	publishDraftInteractor      *publish_draft.Interactor
+	discardDraftInteractor      *discard_draft.Interactor
+	setAttributesInteractor     *set_attributes.Interactor
+
+	// Admin use cases
+	purgeArchivedProductsInteractor *purge_archived_products.Interactor
+	exportProductDataQuery          *export_product_data.Query
+	rebuildProjectionInteractor     *rebuild_projection.Interactor
+	mergeProductsInteractor         *merge_products.Interactor
+	createMerchRuleInteractor       *create_merch_rule.Interactor
+	deleteMerchRuleInteractor       *delete_merch_rule.Interactor
+	listMerchRulesQuery             *list_merch_rules.Query
+	putCategoryTemplateInteractor    *put_category_template.Interactor
+	deleteCategoryTemplateInteractor *delete_category_template.Interactor
+	reassignCategoryInteractor       *reassign_category.Interactor
+	putTenantSettingsInteractor      *put_tenant_settings.Interactor
+	deleteTenantSettingsInteractor   *delete_tenant_settings.Interactor
+
+	// Runs bulk RPCs as long-running operations
+	operationRunner *lro.Runner
 
 	// Query handlers
 	getProductQuery  *get_product.Query
 	listProductsQuery *list_products.Query
+	findSimilarProductsQuery *find_similar_products.Query
+	compareProductsQuery     *compare_products.Query
+	validateProductQuery     *validate_product.Query
+	getProductHistoryQuery   *get_product_history.Query
+	getProductByExternalRefQuery *get_product_by_external_ref.Query
+	searchProductsQuery          *search_products.Query
+	suggestProductsQuery         *suggest_products.Query
+	getProductStatsQuery         *get_product_stats.Query
+	listCuratedProductsQuery     *list_curated_products.Query
+	getRecommendationsQuery      *get_recommendations.Query
+	getProductJsonLdQuery        *get_product_json_ld.Query
+	listProductVersionsQuery     *list_product_versions.Query
+	getCategoryTemplateQuery     *get_category_template.Query
+	listCategoriesQuery          *list_categories.Query
+	getTenantSettingsQuery       *get_tenant_settings.Query
+	createTenantInteractor       *create_tenant.Interactor
+	deleteTenantInteractor       *delete_tenant.Interactor
+	cloneProductInteractor       *clone_product.Interactor
+
+	// Ingestion
+	recordProductViewInteractor *record_product_view.Interactor
+
+	// Issues and revokes API keys for machine clients
+	apiKeys *apikey.Manager
+
+	// Reads per-caller usage
+	usageRecorder *usage.Recorder
+
+	// Retunes fault injection; nil unless enabled
+	faultInjector *faults.Injector
+
+	// Signs draft preview tokens; nil unless a secret is configured
+	previewTokens *preview.Signer
+
+	// Normalizes names, categories and descriptions before validation
+	text *textnorm.Normalizer
 }
 
 // NewHandler creates a new gRPC handler with all dependencies
@@ -43,10 +163,62 @@ func NewHandler(
 	activateProductInteractor *activate_product.Interactor,
 	deactivateProductInteractor *deactivate_product.Interactor,
 	archiveProductInteractor *archive_product.Interactor,
+	setLegalHoldInteractor *set_legal_hold.Interactor,
+	purgeArchivedProductsInteractor *purge_archived_products.Interactor,
 	getProductQuery *get_product.Query,
 	listProductsQuery *list_products.Query,
+	findSimilarProductsQuery *find_similar_products.Query,
+	compareProductsQuery *compare_products.Query,
+	exportProductDataQuery *export_product_data.Query,
+	operationRunner *lro.Runner,
+	validateProductQuery *validate_product.Query,
+	reviewProductInteractor *review_product.Interactor,
+	getProductHistoryQuery *get_product_history.Query,
+	rebuildProjectionInteractor *rebuild_projection.Interactor,
+	setChannelsInteractor *set_channels.Interactor,
+	batchTransitionInteractor *batch_transition.Interactor,
+	mergeProductsInteractor *merge_products.Interactor,
+	setMetadataInteractor *set_metadata.Interactor,
+	linkExternalRefInteractor *link_external_ref.Interactor,
+	unlinkExternalRefInteractor *unlink_external_ref.Interactor,
+	getProductByExternalRefQuery *get_product_by_external_ref.Query,
+	changeBasePriceInteractor *change_base_price.Interactor,
+	decideChangeInteractor *decide_change.Interactor,
+	setPriceFloorInteractor *set_price_floor.Interactor,
+	searchProductsQuery *search_products.Query,
+	createMerchRuleInteractor *create_merch_rule.Interactor,
+	deleteMerchRuleInteractor *delete_merch_rule.Interactor,
+	listMerchRulesQuery *list_merch_rules.Query,
+	suggestProductsQuery *suggest_products.Query,
+	recordProductViewInteractor *record_product_view.Interactor,
+	getProductStatsQuery *get_product_stats.Query,
+	listCuratedProductsQuery *list_curated_products.Query,
+	getRecommendationsQuery *get_recommendations.Query,
+	getProductJsonLdQuery *get_product_json_ld.Query,
+	apiKeys *apikey.Manager,
+	usageRecorder *usage.Recorder,
+	faultInjector *faults.Injector,
+	listProductVersionsQuery *list_product_versions.Query,
+	rollbackToVersionInteractor *rollback_to_version.Interactor,
+	saveDraftInteractor *save_draft.Interactor,
+	publishDraftInteractor *publish_draft.Interactor,
+	discardDraftInteractor *discard_draft.Interactor,
+	previewTokens *preview.Signer,
+	text *textnorm.Normalizer,
+	setAttributesInteractor *set_attributes.Interactor,
+	putCategoryTemplateInteractor *put_category_template.Interactor,
+	deleteCategoryTemplateInteractor *delete_category_template.Interactor,
+	getCategoryTemplateQuery *get_category_template.Query,
+	listCategoriesQuery *list_categories.Query,
+	reassignCategoryInteractor *reassign_category.Interactor,
+	putTenantSettingsInteractor *put_tenant_settings.Interactor,
+	deleteTenantSettingsInteractor *delete_tenant_settings.Interactor,
+	getTenantSettingsQuery *get_tenant_settings.Query,
+	createTenantInteractor *create_tenant.Interactor,
+	deleteTenantInteractor *delete_tenant.Interactor,
+	cloneProductInteractor *clone_product.Interactor,
 ) *Handler {
-	return &Handler{
+	h := &Handler{
 		createProductInteractor:     createProductInteractor,
 		updateProductInteractor:     updateProductInteractor,
 		applyDiscountInteractor:     applyDiscountInteractor,
@@ -54,12 +226,70 @@ func NewHandler(
 		activateProductInteractor:   activateProductInteractor,
 		deactivateProductInteractor: deactivateProductInteractor,
 		archiveProductInteractor:    archiveProductInteractor,
+		setLegalHoldInteractor:      setLegalHoldInteractor,
+		purgeArchivedProductsInteractor: purgeArchivedProductsInteractor,
 		getProductQuery:             getProductQuery,
 		listProductsQuery:           listProductsQuery,
+		findSimilarProductsQuery:    findSimilarProductsQuery,
+		compareProductsQuery:        compareProductsQuery,
+		exportProductDataQuery:      exportProductDataQuery,
+		operationRunner:             operationRunner,
+		validateProductQuery:        validateProductQuery,
+		reviewProductInteractor:     reviewProductInteractor,
+		getProductHistoryQuery:      getProductHistoryQuery,
+		rebuildProjectionInteractor: rebuildProjectionInteractor,
+		setChannelsInteractor:       setChannelsInteractor,
+		batchTransitionInteractor:   batchTransitionInteractor,
+		mergeProductsInteractor:     mergeProductsInteractor,
+		setMetadataInteractor:       setMetadataInteractor,
+		linkExternalRefInteractor:   linkExternalRefInteractor,
+		unlinkExternalRefInteractor: unlinkExternalRefInteractor,
+		getProductByExternalRefQuery: getProductByExternalRefQuery,
+		changeBasePriceInteractor:   changeBasePriceInteractor,
+		decideChangeInteractor:      decideChangeInteractor,
+		setPriceFloorInteractor:     setPriceFloorInteractor,
+		searchProductsQuery:         searchProductsQuery,
+		createMerchRuleInteractor:   createMerchRuleInteractor,
+		deleteMerchRuleInteractor:   deleteMerchRuleInteractor,
+		listMerchRulesQuery:         listMerchRulesQuery,
+		suggestProductsQuery:        suggestProductsQuery,
+		recordProductViewInteractor: recordProductViewInteractor,
+		getProductStatsQuery:        getProductStatsQuery,
+		listCuratedProductsQuery:    listCuratedProductsQuery,
+		getRecommendationsQuery:     getRecommendationsQuery,
+		getProductJsonLdQuery:       getProductJsonLdQuery,
+		apiKeys:                     apiKeys,
+		usageRecorder:               usageRecorder,
+		faultInjector:               faultInjector,
+		listProductVersionsQuery:    listProductVersionsQuery,
+		rollbackToVersionInteractor: rollbackToVersionInteractor,
+		saveDraftInteractor:         saveDraftInteractor,
+		publishDraftInteractor:      publishDraftInteractor,
+		discardDraftInteractor:      discardDraftInteractor,
+		previewTokens:               previewTokens,
+		text:                        text,
+		setAttributesInteractor:     setAttributesInteractor,
+		putCategoryTemplateInteractor:    putCategoryTemplateInteractor,
+		deleteCategoryTemplateInteractor: deleteCategoryTemplateInteractor,
+		getCategoryTemplateQuery:    getCategoryTemplateQuery,
+		listCategoriesQuery:         listCategoriesQuery,
+		reassignCategoryInteractor:  reassignCategoryInteractor,
+		putTenantSettingsInteractor: putTenantSettingsInteractor,
+		deleteTenantSettingsInteractor: deleteTenantSettingsInteractor,
+		getTenantSettingsQuery:      getTenantSettingsQuery,
+		createTenantInteractor:      createTenantInteractor,
+		deleteTenantInteractor:      deleteTenantInteractor,
+		cloneProductInteractor:      cloneProductInteractor,
 	}
+	operationRunner.Register(batchImportKind, h.runBatchImport)
+	operationRunner.Register(rebuildProjectionKind, h.runRebuildProjection)
+	operationRunner.Register(reassignCategoryKind, h.runReassignCategory)
+	operationRunner.Register(createTenantKind, h.runCreateTenant)
+	operationRunner.Register(deleteTenantKind, h.runDeleteTenant)
+	return h
 }
 
 // invalidArgumentError is a helper to create invalid argument errors
 func invalidArgumentError(msg string) error {
-	return status.Errorf(codes.InvalidArgument, msg)
+	return status.Error(codes.InvalidArgument, msg)
 }
@@ -0,0 +1,43 @@
+package product
+
+import (
+	"context"
+
+	pb "catalog-proj/proto/product/v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetProductHistory handles the GetProductHistory gRPC request
+func (h *Handler) GetProductHistory(ctx context.Context, req *pb.GetProductHistoryRequest) (*pb.GetProductHistoryResponse, error) {
+	// 1. Validate
+	if req.ProductId == "" {
+		return nil, invalidArgumentError("product_id is required")
+	}
+
+	// 2. Call query
+	dto, err := h.getProductHistoryQuery.Execute(ctx, req.ProductId)
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 3. Map DTO to proto
+	resp := &pb.GetProductHistoryResponse{ProductId: dto.ProductID}
+	for _, entry := range dto.Entries {
+		pbEntry := &pb.ProductHistoryEntry{
+			EventId:     entry.EventID,
+			EventType:   entry.EventType,
+			OccurredAt:  timestamppb.New(entry.OccurredAt),
+			PayloadJson: string(entry.Payload),
+		}
+		if entry.Review != nil {
+			pbEntry.Review = &pb.ProductReview{
+				Decision: domainReviewDecisionToProto(entry.Review.Decision),
+				Reviewer: entry.Review.Reviewer,
+				Comment:  entry.Review.Comment,
+			}
+		}
+		resp.Entries = append(resp.Entries, pbEntry)
+	}
+	return resp, nil
+}
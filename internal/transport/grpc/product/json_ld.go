@@ -0,0 +1,35 @@
+package product
+
+import (
+	"context"
+
+	"catalog-proj/internal/app/product/queries/get_product_json_ld"
+	pb "catalog-proj/proto/product/v1"
+)
+
+// GetProductJsonLd handles the GetProductJsonLd gRPC request
+func (h *Handler) GetProductJsonLd(ctx context.Context, req *pb.GetProductJsonLdRequest) (*pb.GetProductJsonLdResponse, error) {
+	// 1. Validate
+	if req.ProductId == "" {
+		return nil, invalidArgumentError("product_id is required")
+	}
+	if req.Currency != "" && !get_product_json_ld.ValidCurrency(req.Currency) {
+		return nil, invalidArgumentError("currency must be a three-letter ISO 4217 code")
+	}
+
+	// 2. Call query
+	dto, err := h.getProductJsonLdQuery.Execute(ctx, &get_product_json_ld.Request{
+		ProductID: req.ProductId,
+		Currency:  req.Currency,
+		URL:       req.Url,
+	})
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 3. Map DTO to proto
+	return &pb.GetProductJsonLdResponse{
+		JsonLd:      string(dto.JSONLD),
+		AliasedFrom: dto.AliasedFrom,
+	}, nil
+}
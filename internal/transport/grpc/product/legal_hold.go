@@ -0,0 +1,35 @@
+package product
+
+import (
+	"context"
+
+	"catalog-proj/internal/app/product/usecases/set_legal_hold"
+	pb "catalog-proj/proto/product/v1"
+)
+
+// SetLegalHold handles the SetLegalHold gRPC request
+func (h *Handler) SetLegalHold(ctx context.Context, req *pb.SetLegalHoldRequest) (*pb.SetLegalHoldResponse, error) {
+	// 1. Validate
+	if req.ProductId == "" {
+		return nil, invalidArgumentError("product_id is required")
+	}
+
+	// 2. Map proto to use case request
+	useCaseReq := &set_legal_hold.Request{
+		ProductID: req.ProductId,
+		LegalHold: req.LegalHold,
+	}
+
+	// 3. Call use case
+	ctx = recordCommit(ctx, req.ReturnProduct)
+	resp, err := h.setLegalHoldInteractor.Execute(ctx, useCaseReq)
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 4. Map response to proto
+	return &pb.SetLegalHoldResponse{
+		ProductId: resp.ProductID,
+		Product:   h.committedProduct(ctx, req.ReturnProduct, resp.Product, false),
+	}, nil
+}
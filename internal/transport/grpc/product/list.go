@@ -2,11 +2,37 @@ package product
 
 import (
 	"context"
+	"math/big"
 
+	"catalog-proj/internal/app/product/domain"
 	"catalog-proj/internal/app/product/queries/list_products"
+	"catalog-proj/internal/pkg/apikey"
+	"catalog-proj/internal/pkg/tenant"
 	pb "catalog-proj/proto/product/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// effectivePriceBounds converts the min and max effective price of a list or search request,
+// rejecting negative bounds and a min above the max
+func effectivePriceBounds(minPrice, maxPrice *pb.Money) (*big.Rat, *big.Rat, error) {
+	if minPrice.GetAmount() < 0 || maxPrice.GetAmount() < 0 {
+		return nil, nil, invalidArgumentError("effective price bounds must be non-negative")
+	}
+	if minPrice != nil && maxPrice != nil && minPrice.Amount > maxPrice.Amount {
+		return nil, nil, invalidArgumentError("min_effective_price must not exceed max_effective_price")
+	}
+	var lower, upper *big.Rat
+	if price := ProtoMoneyToDomain(minPrice); price != nil {
+		lower = (*big.Rat)(*price)
+	}
+	if price := ProtoMoneyToDomain(maxPrice); price != nil {
+		upper = (*big.Rat)(*price)
+	}
+	return lower, upper, nil
+}
+
 // ListProducts handles the ListProducts gRPC request
 func (h *Handler) ListProducts(ctx context.Context, req *pb.ListProductsRequest) (*pb.ListProductsResponse, error) {
 	// 1. Validate (optional fields, so just validate limit/offset if provided)
@@ -19,8 +45,9 @@ func (h *Handler) ListProducts(ctx context.Context, req *pb.ListProductsRequest)
 
 	// 2. Map proto to query request
 	queryReq := &list_products.Request{
-		Limit:  int(req.Limit),
-		Offset: int(req.Offset),
+		TenantID: tenant.FromContext(ctx),
+		Limit:    int(req.Limit),
+		Offset:   int(req.Offset),
 	}
 	if req.Category != nil {
 		queryReq.Category = *req.Category
@@ -28,6 +55,55 @@ func (h *Handler) ListProducts(ctx context.Context, req *pb.ListProductsRequest)
 	if req.Status != nil {
 		queryReq.Status = *req.Status
 	}
+	if req.Channel != nil {
+		if !domain.Channel(*req.Channel).Valid() {
+			return nil, MapDomainError(domain.ErrInvalidChannel)
+		}
+		queryReq.Channel = *req.Channel
+	}
+	if req.MetadataKey != nil {
+		if !domain.ValidMetadataKey(*req.MetadataKey) {
+			return nil, MapDomainError(domain.ErrInvalidMetadata)
+		}
+		queryReq.MetadataKey = *req.MetadataKey
+		queryReq.MetadataValue = req.MetadataValue
+	}
+	if req.MaxAgeRestriction != nil {
+		if *req.MaxAgeRestriction < 0 {
+			return nil, invalidArgumentError("max_age_restriction must be non-negative")
+		}
+		maxAge := int(*req.MaxAgeRestriction)
+		queryReq.MaxAgeRestriction = &maxAge
+	}
+	queryReq.ExcludeHazardous = req.ExcludeHazardous
+	queryReq.ExcludePrescription = req.ExcludePrescription
+	queryReq.SkipTotal = req.SkipTotal
+	queryReq.ApproximateTotal = req.ApproximateTotal
+	if req.AllowFullScan && !apikey.GrantedFromContext(ctx, apikey.ScopeAdmin) {
+		return nil, status.Error(codes.PermissionDenied, "allow_full_scan requires the admin scope")
+	}
+	queryReq.AllowFullScan = req.AllowFullScan
+	switch req.OrderBy {
+	case "", list_products.OrderByCreatedAt, list_products.OrderByPopularity,
+		list_products.OrderByEffectivePrice, list_products.OrderByEffectivePriceDesc:
+		queryReq.OrderBy = req.OrderBy
+	default:
+		return nil, invalidArgumentError("order_by must be created_at, popularity, effective_price or effective_price_desc")
+	}
+	var err error
+	if queryReq.MinEffectivePrice, queryReq.MaxEffectivePrice, err = effectivePriceBounds(req.MinEffectivePrice, req.MaxEffectivePrice); err != nil {
+		return nil, err
+	}
+	toProto := ListProductItemToProto
+	switch req.View {
+	case pb.ProductView_PRODUCT_VIEW_UNSPECIFIED, pb.ProductView_PRODUCT_VIEW_FULL:
+		queryReq.View = list_products.ViewFull
+	case pb.ProductView_PRODUCT_VIEW_BASIC:
+		queryReq.View = list_products.ViewBasic
+		toProto = ListProductItemToBasicProto
+	default:
+		return nil, invalidArgumentError("view must be BASIC or FULL")
+	}
 
 	// 3. Call query
 	dto, err := h.listProductsQuery.Execute(ctx, queryReq)
@@ -38,12 +114,15 @@ func (h *Handler) ListProducts(ctx context.Context, req *pb.ListProductsRequest)
 	// 4. Map DTO to proto
 	protoProducts := make([]*pb.Product, 0, len(dto.Products))
 	for _, item := range dto.Products {
-		protoProducts = append(protoProducts, ListProductItemToProto(item))
+		protoProducts = append(protoProducts, toProto(item))
 	}
 
 	// 5. Return response
 	return &pb.ListProductsResponse{
 		Products: protoProducts,
 		Total:    int32(dto.Total),
+		HasMore:  dto.HasMore,
+
+		TotalApproximate: dto.TotalApproximate,
 	}, nil
 }
@@ -93,16 +93,23 @@ func BigRatToInt64(rat *big.Rat) int64 {
 	return int64(amountFloat + 0.5) // Round to nearest
 }
 
+// basisPointsPerUnit is the number of basis points in a 100% discount
+const basisPointsPerUnit = 10000
+
 // ProtoDiscountToDomain converts proto Discount to domain Discount
+// percent_basis_points takes precedence; the deprecated amount is read as a whole percent
 func ProtoDiscountToDomain(pbDiscount *pb.Discount) *domain.Discount {
 	if pbDiscount == nil {
 		return nil
 	}
 
 	var amount *domain.Money
-	if pbDiscount.Amount != nil {
-		money := ProtoMoneyToDomain(pbDiscount.Amount)
-		amount = money
+	switch {
+	case pbDiscount.PercentBasisPoints != nil:
+		amount = BasisPointsToDiscountAmount(pbDiscount.GetPercentBasisPoints())
+	case pbDiscount.Amount != nil:
+		percent := domain.NewMoneyFromFraction(pbDiscount.Amount.Amount, 100)
+		amount = &percent
 	}
 
 	var startDate, endDate time.Time
@@ -127,16 +134,287 @@ func DomainDiscountToProto(domainDiscount *domain.Discount) *pb.Discount {
 		return nil
 	}
 
-	var pbAmount *pb.Money
+	var amount *big.Rat
 	if domainDiscount.Amount != nil {
-		pbAmount = DomainMoneyToProto(domainDiscount.Amount)
+		amount = *domainDiscount.Amount
+	}
+	return newProtoDiscount(domainDiscount.ID, amount, domainDiscount.StartDate, domainDiscount.EndDate)
+}
+
+// newProtoDiscount builds a proto Discount from a domain fraction (0.0-1.0), filling both
+// percent_basis_points and the deprecated whole-percent amount
+func newProtoDiscount(id string, amount *big.Rat, startDate, endDate time.Time) *pb.Discount {
+	discount := &pb.Discount{
+		Id:        id,
+		StartDate: timestamppb.New(startDate),
+		EndDate:   timestamppb.New(endDate),
+	}
+	if amount != nil {
+		bps := DiscountAmountToBasisPoints(amount)
+		discount.PercentBasisPoints = &bps
+		discount.Amount = &pb.Money{Amount: roundRat(new(big.Rat).Mul(amount, big.NewRat(100, 1)))}
+	}
+	return discount
+}
+
+// BasisPointsToDiscountAmount converts basis points to the domain fraction (1250 -> 0.125)
+func BasisPointsToDiscountAmount(bps int32) *domain.Money {
+	amount := domain.NewMoneyFromFraction(int64(bps), basisPointsPerUnit)
+	return &amount
+}
+
+// DiscountAmountToBasisPoints converts the domain fraction to basis points (0.125 -> 1250)
+// Fractions finer than a basis point are rounded half away from zero
+func DiscountAmountToBasisPoints(amount *big.Rat) int32 {
+	if amount == nil {
+		return 0
+	}
+	return int32(roundRat(new(big.Rat).Mul(amount, big.NewRat(basisPointsPerUnit, 1))))
+}
+
+// roundRat rounds r to the nearest integer, halves away from zero
+func roundRat(r *big.Rat) int64 {
+	num := new(big.Int).Abs(r.Num())
+	den := r.Denom()
+	// (2*|num| + den) / (2*den) == floor(|r| + 1/2)
+	q := new(big.Int).Mul(num, big.NewInt(2))
+	q.Add(q, den)
+	q.Quo(q, new(big.Int).Mul(den, big.NewInt(2)))
+	if r.Sign() < 0 {
+		q.Neg(q)
+	}
+	return q.Int64()
+}
+
+// ProtoWeightToDomain converts proto Weight to domain Weight (nil when unset)
+func ProtoWeightToDomain(w *pb.Weight) *domain.Weight {
+	if w == nil {
+		return nil
+	}
+	return &domain.Weight{Value: w.Value, Unit: domain.WeightUnit(w.Unit)}
+}
+
+// DomainWeightToProto converts domain Weight to proto Weight (nil when unset)
+func DomainWeightToProto(w *domain.Weight) *pb.Weight {
+	if w == nil {
+		return nil
+	}
+	return &pb.Weight{Value: w.Value, Unit: string(w.Unit)}
+}
+
+// ProtoDimensionsToDomain converts proto Dimensions to domain Dimensions (nil when unset)
+func ProtoDimensionsToDomain(d *pb.Dimensions) *domain.Dimensions {
+	if d == nil {
+		return nil
+	}
+	return &domain.Dimensions{Length: d.Length, Width: d.Width, Height: d.Height, Unit: domain.LengthUnit(d.Unit)}
+}
+
+// DomainDimensionsToProto converts domain Dimensions to proto Dimensions (nil when unset)
+func DomainDimensionsToProto(d *domain.Dimensions) *pb.Dimensions {
+	if d == nil {
+		return nil
+	}
+	return &pb.Dimensions{Length: d.Length, Width: d.Width, Height: d.Height, Unit: string(d.Unit)}
+}
+
+// ProtoProductTypeToDomain converts a proto product type; UNSPECIFIED maps to physical
+func ProtoProductTypeToDomain(t pb.ProductType) (domain.ProductType, error) {
+	switch t {
+	case pb.ProductType_PRODUCT_TYPE_UNSPECIFIED, pb.ProductType_PRODUCT_TYPE_PHYSICAL:
+		return domain.ProductTypePhysical, nil
+	case pb.ProductType_PRODUCT_TYPE_DIGITAL:
+		return domain.ProductTypeDigital, nil
+	case pb.ProductType_PRODUCT_TYPE_SERVICE:
+		return domain.ProductTypeService, nil
+	default:
+		return "", invalidArgumentError("unknown product_type")
+	}
+}
+
+// DomainProductTypeToProto converts a domain product type to its proto enum
+func DomainProductTypeToProto(t domain.ProductType) pb.ProductType {
+	switch t {
+	case domain.ProductTypePhysical:
+		return pb.ProductType_PRODUCT_TYPE_PHYSICAL
+	case domain.ProductTypeDigital:
+		return pb.ProductType_PRODUCT_TYPE_DIGITAL
+	case domain.ProductTypeService:
+		return pb.ProductType_PRODUCT_TYPE_SERVICE
+	default:
+		return pb.ProductType_PRODUCT_TYPE_UNSPECIFIED
 	}
+}
 
-	return &pb.Discount{
-		Id:        domainDiscount.ID,
-		Amount:    pbAmount,
-		StartDate: timestamppb.New(domainDiscount.StartDate),
-		EndDate:   timestamppb.New(domainDiscount.EndDate),
+// ProtoDescriptionFormatToDomain converts a proto description format; UNSPECIFIED maps to plain
+func ProtoDescriptionFormatToDomain(f pb.DescriptionFormat) (domain.DescriptionFormat, error) {
+	switch f {
+	case pb.DescriptionFormat_DESCRIPTION_FORMAT_UNSPECIFIED, pb.DescriptionFormat_DESCRIPTION_FORMAT_PLAIN:
+		return domain.DescriptionFormatPlain, nil
+	case pb.DescriptionFormat_DESCRIPTION_FORMAT_MARKDOWN:
+		return domain.DescriptionFormatMarkdown, nil
+	default:
+		return "", invalidArgumentError("unknown description_format")
+	}
+}
+
+// DomainDescriptionFormatToProto converts a domain description format to its proto enum
+func DomainDescriptionFormatToProto(f domain.DescriptionFormat) pb.DescriptionFormat {
+	switch f {
+	case domain.DescriptionFormatPlain:
+		return pb.DescriptionFormat_DESCRIPTION_FORMAT_PLAIN
+	case domain.DescriptionFormatMarkdown:
+		return pb.DescriptionFormat_DESCRIPTION_FORMAT_MARKDOWN
+	default:
+		return pb.DescriptionFormat_DESCRIPTION_FORMAT_UNSPECIFIED
+	}
+}
+
+// ProtoComplianceToDomain converts proto Compliance to domain Compliance (unrestricted when unset)
+func ProtoComplianceToDomain(c *pb.Compliance) domain.Compliance {
+	if c == nil {
+		return domain.Compliance{}
+	}
+	return domain.Compliance{
+		AgeRestriction:       int(c.AgeRestriction),
+		Hazardous:            c.Hazardous,
+		RequiresPrescription: c.RequiresPrescription,
+	}
+}
+
+// DomainComplianceToProto converts domain Compliance to proto Compliance (nil when unrestricted)
+func DomainComplianceToProto(c domain.Compliance) *pb.Compliance {
+	if !c.Restricted() {
+		return nil
+	}
+	return &pb.Compliance{
+		AgeRestriction:       int32(c.AgeRestriction),
+		Hazardous:            c.Hazardous,
+		RequiresPrescription: c.RequiresPrescription,
+	}
+}
+
+// ProtoPriceFloorToDomain converts proto PriceFloor to domain PriceFloor (zero when unset)
+func ProtoPriceFloorToDomain(f *pb.PriceFloor) domain.PriceFloor {
+	if f == nil {
+		return domain.PriceFloor{}
+	}
+	return domain.PriceFloor{
+		MinPrice:         ProtoMoneyToDomain(f.MinPrice),
+		Cost:             ProtoMoneyToDomain(f.Cost),
+		MinMarginPercent: f.MinMarginPercent,
+		MapPrice:         ProtoMoneyToDomain(f.MapPrice),
+	}
+}
+
+// DomainPriceFloorToProto converts domain PriceFloor to proto PriceFloor (nil when unconstrained)
+func DomainPriceFloorToProto(f domain.PriceFloor) *pb.PriceFloor {
+	if f.IsZero() {
+		return nil
+	}
+	floor := &pb.PriceFloor{MinMarginPercent: f.MinMarginPercent}
+	if f.MinPrice != nil {
+		floor.MinPrice = BigRatToProtoMoney(*f.MinPrice)
+	}
+	if f.Cost != nil {
+		floor.Cost = BigRatToProtoMoney(*f.Cost)
+	}
+	if f.MapPrice != nil {
+		floor.MapPrice = BigRatToProtoMoney(*f.MapPrice)
+	}
+	return floor
+}
+
+// ProtoMerchRuleToDomain converts proto MerchRule to domain MerchRule
+// An unspecified kind maps to an empty kind, which the domain rejects
+func ProtoMerchRuleToDomain(r *pb.MerchRule) domain.MerchRule {
+	rule := domain.MerchRule{
+		Query:     r.Query,
+		ProductID: r.ProductId,
+		Position:  r.Position,
+		Category:  r.Category,
+		Boost:     r.Boost,
+	}
+	switch r.Kind {
+	case pb.MerchRuleKind_MERCH_RULE_KIND_PIN:
+		rule.Kind = domain.MerchRuleKindPin
+	case pb.MerchRuleKind_MERCH_RULE_KIND_BOOST:
+		rule.Kind = domain.MerchRuleKindBoost
+	}
+	return rule
+}
+
+// DomainMerchRuleToProto converts domain MerchRule to proto MerchRule
+func DomainMerchRuleToProto(rule domain.MerchRule) *pb.MerchRule {
+	kind := pb.MerchRuleKind_MERCH_RULE_KIND_UNSPECIFIED
+	switch rule.Kind {
+	case domain.MerchRuleKindPin:
+		kind = pb.MerchRuleKind_MERCH_RULE_KIND_PIN
+	case domain.MerchRuleKindBoost:
+		kind = pb.MerchRuleKind_MERCH_RULE_KIND_BOOST
+	}
+	return &pb.MerchRule{
+		Id:        rule.ID,
+		Query:     rule.Query,
+		Kind:      kind,
+		ProductId: rule.ProductID,
+		Position:  rule.Position,
+		Category:  rule.Category,
+		Boost:     rule.Boost,
+		CreatedAt: timestamppb.New(rule.CreatedAt),
+	}
+}
+
+// ProtoCategoryTemplateToDomain converts proto CategoryTemplate to domain CategoryTemplate
+// An unspecified attribute type maps to an empty type, which the domain rejects
+func ProtoCategoryTemplateToDomain(t *pb.CategoryTemplate) domain.CategoryTemplate {
+	template := domain.CategoryTemplate{
+		Category:   t.Category,
+		Attributes: make([]domain.AttributeDefinition, 0, len(t.Attributes)),
+	}
+	for _, a := range t.Attributes {
+		definition := domain.AttributeDefinition{
+			Name:          a.Name,
+			Required:      a.Required,
+			AllowedValues: a.AllowedValues,
+		}
+		switch a.Type {
+		case pb.AttributeType_ATTRIBUTE_TYPE_TEXT:
+			definition.Type = domain.AttributeTypeText
+		case pb.AttributeType_ATTRIBUTE_TYPE_NUMBER:
+			definition.Type = domain.AttributeTypeNumber
+		case pb.AttributeType_ATTRIBUTE_TYPE_BOOLEAN:
+			definition.Type = domain.AttributeTypeBoolean
+		}
+		template.Attributes = append(template.Attributes, definition)
+	}
+	return template
+}
+
+// DomainCategoryTemplateToProto converts domain CategoryTemplate to proto CategoryTemplate
+func DomainCategoryTemplateToProto(template domain.CategoryTemplate) *pb.CategoryTemplate {
+	attributes := make([]*pb.AttributeDefinition, 0, len(template.Attributes))
+	for _, d := range template.Attributes {
+		attributeType := pb.AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED
+		switch d.Type {
+		case domain.AttributeTypeText:
+			attributeType = pb.AttributeType_ATTRIBUTE_TYPE_TEXT
+		case domain.AttributeTypeNumber:
+			attributeType = pb.AttributeType_ATTRIBUTE_TYPE_NUMBER
+		case domain.AttributeTypeBoolean:
+			attributeType = pb.AttributeType_ATTRIBUTE_TYPE_BOOLEAN
+		}
+		attributes = append(attributes, &pb.AttributeDefinition{
+			Name:          d.Name,
+			Type:          attributeType,
+			Required:      d.Required,
+			AllowedValues: d.AllowedValues,
+		})
+	}
+	return &pb.CategoryTemplate{
+		Category:   template.Category,
+		Attributes: attributes,
+		UpdatedAt:  timestamppb.New(template.UpdatedAt),
 	}
 }
 
@@ -147,24 +425,35 @@ func DTOToProtoProduct(dto *get_product.DTO) *pb.Product {
 	}
 
 	product := &pb.Product{
-		Id:             dto.ID,
-		Name:           dto.Name,
-		Description:    dto.Description,
-		Category:       dto.Category,
-		BasePrice:      BigRatToProtoMoney(dto.BasePrice),
-		EffectivePrice: BigRatToProtoMoney(dto.EffectivePrice),
-		Status:         dto.Status,
-		CreatedAt:      timestamppb.New(dto.CreatedAt),
-		UpdatedAt:      timestamppb.New(dto.UpdatedAt),
+		Id:                dto.ID,
+		Name:              dto.Name,
+		Description:       dto.Description,
+		DescriptionFormat: DomainDescriptionFormatToProto(dto.DescriptionFormat),
+		Category:          dto.Category,
+		Sku:               dto.SKU,
+		Gtin:              dto.GTIN,
+		BasePrice:         BigRatToProtoMoney(dto.BasePrice),
+		EffectivePrice:    BigRatToProtoMoney(dto.EffectivePrice),
+		MapApplied:        dto.MapApplied,
+		Status:            dto.Status,
+		LegalHold:         dto.LegalHold,
+		Channels:          dto.Channels,
+		Weight:            DomainWeightToProto(dto.Shipping.Weight),
+		Dimensions:        DomainDimensionsToProto(dto.Shipping.Dimensions),
+		ShippingClass:     dto.Shipping.ShippingClass,
+		ProductType:       DomainProductTypeToProto(dto.ProductType),
+		DownloadUrl:       dto.DigitalDelivery.DownloadURL,
+		LicenseTerms:      dto.DigitalDelivery.LicenseTerms,
+		Compliance:        DomainComplianceToProto(dto.Compliance),
+		Metadata:          dto.Metadata,
+		Attributes:        dto.Attributes,
+		PriceFloor:        DomainPriceFloorToProto(dto.PriceFloor),
+		CreatedAt:         timestamppb.New(dto.CreatedAt),
+		UpdatedAt:         timestamppb.New(dto.UpdatedAt),
 	}
 
 	if dto.DiscountID != nil {
-		product.Discount = &pb.Discount{
-			Id:        *dto.DiscountID,
-			Amount:    BigRatToProtoMoney(dto.DiscountAmount),
-			StartDate: timestamppb.New(*dto.DiscountStartDate),
-			EndDate:   timestamppb.New(*dto.DiscountEndDate),
-		}
+		product.Discount = newProtoDiscount(*dto.DiscountID, dto.DiscountAmount, *dto.DiscountStartDate, *dto.DiscountEndDate)
 	}
 
 	if dto.ArchivedAt != nil {
@@ -174,27 +463,63 @@ func DTOToProtoProduct(dto *get_product.DTO) *pb.Product {
 	return product
 }
 
-// ListProductItemToProto converts ListProducts ProductItem to proto Product
-func ListProductItemToProto(item list_products.ProductItem) *pb.Product {
+// ListProductItemToBasicProto converts a ListProducts ProductItem read with the basic view to a
+// trimmed proto Product holding only the fields that view reads
+func ListProductItemToBasicProto(item list_products.ProductItem) *pb.Product {
 	product := &pb.Product{
 		Id:             item.ID,
 		Name:           item.Name,
-		Description:    item.Description,
 		Category:       item.Category,
+		Sku:            item.SKU,
 		BasePrice:      BigRatToProtoMoney(item.BasePrice),
 		EffectivePrice: BigRatToProtoMoney(item.EffectivePrice),
+		MapApplied:     item.MapApplied,
 		Status:         item.Status,
+		Channels:       item.Channels,
+		ProductType:    DomainProductTypeToProto(item.ProductType),
 		CreatedAt:      timestamppb.New(item.CreatedAt),
 		UpdatedAt:      timestamppb.New(item.UpdatedAt),
 	}
 
 	if item.DiscountID != nil {
-		product.Discount = &pb.Discount{
-			Id:        *item.DiscountID,
-			Amount:    BigRatToProtoMoney(item.DiscountAmount),
-			StartDate: timestamppb.New(*item.DiscountStartDate),
-			EndDate:   timestamppb.New(*item.DiscountEndDate),
-		}
+		product.Discount = newProtoDiscount(*item.DiscountID, item.DiscountAmount, *item.DiscountStartDate, *item.DiscountEndDate)
+	}
+
+	return product
+}
+
+// ListProductItemToProto converts ListProducts ProductItem to proto Product
+func ListProductItemToProto(item list_products.ProductItem) *pb.Product {
+	product := &pb.Product{
+		Id:                item.ID,
+		Name:              item.Name,
+		Description:       item.Description,
+		DescriptionFormat: DomainDescriptionFormatToProto(item.DescriptionFormat),
+		Category:          item.Category,
+		Sku:               item.SKU,
+		Gtin:              item.GTIN,
+		BasePrice:         BigRatToProtoMoney(item.BasePrice),
+		EffectivePrice:    BigRatToProtoMoney(item.EffectivePrice),
+		MapApplied:        item.MapApplied,
+		Status:            item.Status,
+		LegalHold:         item.LegalHold,
+		Channels:          item.Channels,
+		Weight:            DomainWeightToProto(item.Shipping.Weight),
+		Dimensions:        DomainDimensionsToProto(item.Shipping.Dimensions),
+		ShippingClass:     item.Shipping.ShippingClass,
+		ProductType:       DomainProductTypeToProto(item.ProductType),
+		DownloadUrl:       item.DigitalDelivery.DownloadURL,
+		LicenseTerms:      item.DigitalDelivery.LicenseTerms,
+		Compliance:        DomainComplianceToProto(item.Compliance),
+		Metadata:          item.Metadata,
+		Attributes:        item.Attributes,
+		PriceFloor:        DomainPriceFloorToProto(item.PriceFloor),
+		CreatedAt:         timestamppb.New(item.CreatedAt),
+		UpdatedAt:         timestamppb.New(item.UpdatedAt),
+	}
+
+	if item.DiscountID != nil {
+		product.Discount = newProtoDiscount(*item.DiscountID, item.DiscountAmount, *item.DiscountStartDate, *item.DiscountEndDate)
 	}
 
 	if item.ArchivedAt != nil {
@@ -203,3 +528,52 @@ func ListProductItemToProto(item list_products.ProductItem) *pb.Product {
 
 	return product
 }
+
+// ProtoTenantSettingsToDomain converts proto TenantSettings to domain TenantSettings
+func ProtoTenantSettingsToDomain(s *pb.TenantSettings) domain.TenantSettings {
+	return domain.TenantSettings{
+		DefaultCurrency:        s.DefaultCurrency,
+		MaxNameLength:          int(s.MaxNameLength),
+		MinDiscountBasisPoints: s.MinDiscountBasisPoints,
+		MaxDiscountBasisPoints: s.MaxDiscountBasisPoints,
+	}
+}
+
+// DomainTenantSettingsToProto converts domain TenantSettings to proto TenantSettings
+// Settings that were never stored (the service defaults) have no updated_at
+func DomainTenantSettingsToProto(settings domain.TenantSettings) *pb.TenantSettings {
+	s := &pb.TenantSettings{
+		DefaultCurrency:        settings.DefaultCurrency,
+		MaxNameLength:          int32(settings.MaxNameLength),
+		MinDiscountBasisPoints: settings.MinDiscountBasisPoints,
+		MaxDiscountBasisPoints: settings.MaxDiscountBasisPoints,
+	}
+	if !settings.UpdatedAt.IsZero() {
+		s.UpdatedAt = timestamppb.New(settings.UpdatedAt)
+	}
+	return s
+}
+
+// tenantStates maps domain tenant states to proto
+var tenantStates = map[domain.TenantState]pb.TenantState{
+	domain.TenantProvisioning: pb.TenantState_TENANT_STATE_PROVISIONING,
+	domain.TenantActive:       pb.TenantState_TENANT_STATE_ACTIVE,
+	domain.TenantDeleting:     pb.TenantState_TENANT_STATE_DELETING,
+	domain.TenantDeleted:      pb.TenantState_TENANT_STATE_DELETED,
+}
+
+// DomainTenantToProto converts a domain Tenant to proto Tenant
+func DomainTenantToProto(t *domain.Tenant) *pb.Tenant {
+	tenant := &pb.Tenant{
+		TenantId:       t.ID,
+		RegistrationId: t.RegistrationID,
+		DisplayName:    t.DisplayName,
+		State:          tenantStates[t.State],
+		CreatedAt:      timestamppb.New(t.CreatedAt),
+		UpdatedAt:      timestamppb.New(t.UpdatedAt),
+	}
+	if t.DeletedAt != nil {
+		tenant.DeletedAt = timestamppb.New(*t.DeletedAt)
+	}
+	return tenant
+}
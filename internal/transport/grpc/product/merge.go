@@ -0,0 +1,34 @@
+package product
+
+import (
+	"context"
+
+	"catalog-proj/internal/app/product/usecases/merge_products"
+	pb "catalog-proj/proto/product/v1"
+)
+
+// MergeProducts handles the MergeProducts gRPC request
+func (h *Handler) MergeProducts(ctx context.Context, req *pb.MergeProductsRequest) (*pb.MergeProductsResponse, error) {
+	// 1. Validate
+	if req.DuplicateId == "" {
+		return nil, invalidArgumentError("duplicate_id is required")
+	}
+	if req.CanonicalId == "" {
+		return nil, invalidArgumentError("canonical_id is required")
+	}
+
+	// 2. Call use case
+	resp, err := h.mergeProductsInteractor.Execute(ctx, &merge_products.Request{
+		DuplicateID: req.DuplicateId,
+		CanonicalID: req.CanonicalId,
+	})
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 3. Map response to proto
+	return &pb.MergeProductsResponse{
+		DuplicateId: resp.DuplicateID,
+		CanonicalId: resp.CanonicalID,
+	}, nil
+}
@@ -0,0 +1,36 @@
+package product
+
+import (
+	"context"
+
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/usecases/set_metadata"
+	pb "catalog-proj/proto/product/v1"
+)
+
+// SetMetadata handles the SetMetadata gRPC request
+func (h *Handler) SetMetadata(ctx context.Context, req *pb.SetMetadataRequest) (*pb.SetMetadataResponse, error) {
+	// 1. Validate
+	if req.ProductId == "" {
+		return nil, invalidArgumentError("product_id is required")
+	}
+
+	// 2. Map proto to use case request (keys and values are validated by the domain)
+	useCaseReq := &set_metadata.Request{
+		ProductID: req.ProductId,
+		Metadata:  domain.Metadata(req.Metadata),
+	}
+
+	// 3. Call use case
+	ctx = recordCommit(ctx, req.ReturnProduct)
+	resp, err := h.setMetadataInteractor.Execute(ctx, useCaseReq)
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 4. Map response to proto
+	return &pb.SetMetadataResponse{
+		ProductId: resp.ProductID,
+		Product:   h.committedProduct(ctx, req.ReturnProduct, resp.Product, false),
+	}, nil
+}
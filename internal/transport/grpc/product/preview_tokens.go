@@ -0,0 +1,46 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"catalog-proj/internal/pkg/preview"
+	"catalog-proj/internal/pkg/tenant"
+	pb "catalog-proj/proto/product/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GeneratePreviewToken handles the GeneratePreviewToken gRPC request
+func (h *Handler) GeneratePreviewToken(ctx context.Context, req *pb.GeneratePreviewTokenRequest) (*pb.GeneratePreviewTokenResponse, error) {
+	if h.previewTokens == nil {
+		return nil, status.Error(codes.FailedPrecondition, "preview tokens are disabled")
+	}
+
+	// 1. Validate
+	var ttl time.Duration
+	if req.Ttl != nil {
+		if err := req.Ttl.CheckValid(); err != nil || req.Ttl.AsDuration() <= 0 {
+			return nil, invalidArgumentError("ttl must be positive")
+		}
+		ttl = req.Ttl.AsDuration()
+	}
+
+	// 2. Sign token
+	token, expiresAt, err := h.previewTokens.Issue(tenant.FromContext(ctx), req.ProductId, ttl)
+	if errors.Is(err, preview.ErrInvalidTTL) {
+		return nil, invalidArgumentError(err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to sign preview token")
+	}
+
+	// 3. Map to proto
+	return &pb.GeneratePreviewTokenResponse{
+		Token:      token,
+		ExpireTime: timestamppb.New(expiresAt),
+	}, nil
+}
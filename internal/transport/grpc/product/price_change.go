@@ -0,0 +1,123 @@
+package product
+
+import (
+	"context"
+
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/usecases/change_base_price"
+	"catalog-proj/internal/app/product/usecases/decide_change"
+	"catalog-proj/internal/app/product/usecases/set_price_floor"
+	"catalog-proj/internal/pkg/apikey"
+	pb "catalog-proj/proto/product/v1"
+)
+
+// ChangeBasePrice handles the ChangeBasePrice gRPC request
+func (h *Handler) ChangeBasePrice(ctx context.Context, req *pb.ChangeBasePriceRequest) (*pb.ChangeBasePriceResponse, error) {
+	// 1. Validate
+	if req.ProductId == "" {
+		return nil, invalidArgumentError("product_id is required")
+	}
+	if req.BasePrice == nil {
+		return nil, invalidArgumentError("base_price is required")
+	}
+	if req.BasePrice.Amount <= 0 {
+		return nil, invalidArgumentError("base_price must be positive")
+	}
+
+	// 2. Map proto to use case request; the requester is the API key, not the free-text requested_by
+	useCaseReq := &change_base_price.Request{
+		ProductID:   req.ProductId,
+		BasePrice:   ProtoMoneyToDomain(req.BasePrice),
+		RequestedBy: apikey.KeyIDFromContext(ctx),
+	}
+
+	// 3. Call use case
+	resp, err := h.changeBasePriceInteractor.Execute(ctx, useCaseReq)
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 4. Map response to proto
+	return &pb.ChangeBasePriceResponse{
+		ProductId:       resp.ProductID,
+		PendingChangeId: resp.PendingChangeID,
+	}, nil
+}
+
+// ApproveChange handles the ApproveChange gRPC request
+func (h *Handler) ApproveChange(ctx context.Context, req *pb.ApproveChangeRequest) (*pb.DecideChangeResponse, error) {
+	return h.decideChange(ctx, req.ChangeId, domain.ReviewApproved, "")
+}
+
+// RejectChange handles the RejectChange gRPC request
+func (h *Handler) RejectChange(ctx context.Context, req *pb.RejectChangeRequest) (*pb.DecideChangeResponse, error) {
+	return h.decideChange(ctx, req.ChangeId, domain.ReviewRejected, req.Reason)
+}
+
+// decideChange records an approval or rejection of a pending change
+// The approver is the API key that authenticated the call, so it is compared with the requesting key
+func (h *Handler) decideChange(ctx context.Context, changeID string, decision domain.ReviewDecision, note string) (*pb.DecideChangeResponse, error) {
+	// 1. Validate
+	if changeID == "" {
+		return nil, invalidArgumentError("change_id is required")
+	}
+
+	// 2. Call use case (approver and reason are validated by the domain)
+	resp, err := h.decideChangeInteractor.Execute(ctx, &decide_change.Request{
+		ChangeID: changeID,
+		Decision: decision,
+		Approver: apikey.KeyIDFromContext(ctx),
+		Note:     note,
+	})
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 3. Map response to proto
+	return &pb.DecideChangeResponse{
+		ChangeId:  resp.ChangeID,
+		ProductId: resp.ProductID,
+		Status:    domainPendingChangeStatusToProto(resp.Status),
+	}, nil
+}
+
+// domainPendingChangeStatusToProto converts a domain pending change status to proto
+func domainPendingChangeStatusToProto(status domain.PendingChangeStatus) pb.PendingChangeStatus {
+	switch status {
+	case domain.PendingChangeStatusPending:
+		return pb.PendingChangeStatus_PENDING_CHANGE_STATUS_PENDING
+	case domain.PendingChangeStatusApproved:
+		return pb.PendingChangeStatus_PENDING_CHANGE_STATUS_APPROVED
+	case domain.PendingChangeStatusRejected:
+		return pb.PendingChangeStatus_PENDING_CHANGE_STATUS_REJECTED
+	default:
+		return pb.PendingChangeStatus_PENDING_CHANGE_STATUS_UNSPECIFIED
+	}
+}
+
+// SetPriceFloor handles the SetPriceFloor gRPC request
+func (h *Handler) SetPriceFloor(ctx context.Context, req *pb.SetPriceFloorRequest) (*pb.SetPriceFloorResponse, error) {
+	// 1. Validate
+	if req.ProductId == "" {
+		return nil, invalidArgumentError("product_id is required")
+	}
+
+	// 2. Map proto to use case request (the floor is validated by the domain)
+	useCaseReq := &set_price_floor.Request{
+		ProductID:  req.ProductId,
+		PriceFloor: ProtoPriceFloorToDomain(req.PriceFloor),
+	}
+
+	// 3. Call use case
+	ctx = recordCommit(ctx, req.ReturnProduct)
+	resp, err := h.setPriceFloorInteractor.Execute(ctx, useCaseReq)
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 4. Map response to proto
+	return &pb.SetPriceFloorResponse{
+		ProductId: resp.ProductID,
+		Product:   h.committedProduct(ctx, req.ReturnProduct, resp.Product, false),
+	}, nil
+}
@@ -0,0 +1,51 @@
+package product
+
+import (
+	"context"
+
+	"catalog-proj/internal/app/product/usecases/purge_archived_products"
+	pb "catalog-proj/proto/product/v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// PurgeArchivedProducts handles the PurgeArchivedProducts gRPC request
+func (h *Handler) PurgeArchivedProducts(ctx context.Context, req *pb.PurgeArchivedProductsRequest) (*pb.PurgeArchivedProductsResponse, error) {
+	// 1. Validate
+	if req.RetentionDays < 1 {
+		return nil, invalidArgumentError("retention_days must be at least 1")
+	}
+	if req.Limit < 0 {
+		return nil, invalidArgumentError("limit must be non-negative")
+	}
+
+	// 2. Call use case
+	resp, err := h.purgeArchivedProductsInteractor.Execute(ctx, &purge_archived_products.Request{
+		RetentionDays: int(req.RetentionDays),
+		DryRun:        req.DryRun,
+		Limit:         int(req.Limit),
+	})
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 3. Map response to proto
+	purged := make([]*pb.PurgedProduct, 0, len(resp.Purged))
+	for _, p := range resp.Purged {
+		purged = append(purged, &pb.PurgedProduct{
+			ProductId:  p.ProductID,
+			TenantId:   p.TenantID,
+			ArchivedAt: timestamppb.New(p.ArchivedAt),
+			EventCount: p.EventCount,
+		})
+	}
+
+	return &pb.PurgeArchivedProductsResponse{
+		DryRun:            resp.DryRun,
+		ArchivedBefore:    timestamppb.New(resp.ArchivedBefore),
+		Purged:            purged,
+		HeldProductIds:    resp.Held,
+		SkippedProductIds: resp.Skipped,
+		EventsDeleted:     resp.EventsDeleted,
+	}, nil
+}
@@ -0,0 +1,92 @@
+package product
+
+import (
+	"context"
+
+	"catalog-proj/internal/app/product/usecases/reassign_category"
+	"catalog-proj/internal/pkg/lro"
+	pb "catalog-proj/proto/product/v1"
+
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// reassignCategoryKind is the operation kind reported in OperationMetadata
+const reassignCategoryKind = "reassign_category"
+
+// ReassignCategory handles the ReassignCategory gRPC request
+// The moves run as a long-running operation; its checkpoint can be passed back as
+// start_after_product_id to resume a failed or cancelled run
+func (h *Handler) ReassignCategory(ctx context.Context, req *pb.ReassignCategoryRequest) (*pb.ReassignCategoryResponse, error) {
+	// 1. Validate; categories are normalized like product categories so they match stored ones
+	req = &pb.ReassignCategoryRequest{
+		FromCategory:        h.text.Line(req.FromCategory),
+		ToCategory:          h.text.Line(req.ToCategory),
+		StartAfterProductId: req.StartAfterProductId,
+	}
+	var violations fieldViolations
+	if req.FromCategory == "" {
+		violations.add("from_category", "is required")
+	}
+	switch {
+	case req.ToCategory == "":
+		violations.add("to_category", "is required")
+	case len(req.ToCategory) > 100:
+		violations.add("to_category", "must be at most 100 characters")
+	case req.ToCategory == req.FromCategory:
+		violations.add("to_category", "must differ from from_category")
+	}
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+
+	// 2. Size the operation so clients can follow its progress
+	total, err := h.reassignCategoryInteractor.Count(ctx, req.FromCategory, req.StartAfterProductId)
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 3. Queue the operation; the task runs on a job worker
+	name, err := h.operationRunner.Start(ctx, reassignCategoryKind, int(total), req)
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 4. Return response
+	return &pb.ReassignCategoryResponse{
+		OperationName: name,
+	}, nil
+}
+
+// runReassignCategory is the operation task behind ReassignCategory
+func (h *Handler) runReassignCategory(ctx context.Context, request proto.Message, progress *lro.Progress) (proto.Message, error) {
+	req, ok := request.(*pb.ReassignCategoryRequest)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "unexpected %s request type %T", reassignCategoryKind, request)
+	}
+
+	resp, err := h.reassignCategoryInteractor.Execute(ctx, &reassign_category.Request{
+		From:       req.FromCategory,
+		To:         req.ToCategory,
+		StartAfter: req.StartAfterProductId,
+	}, progress)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &pb.ReassignCategoryResult{
+		Moved:         resp.Moved,
+		LastProductId: resp.LastProductID,
+	}
+	for _, failure := range resp.Failures {
+		st := status.Convert(MapDomainError(failure.Err))
+		result.Failures = append(result.Failures, &pb.ReassignCategoryFailure{
+			ProductId: failure.ProductID,
+			Code:      code.Code(st.Code()).String(),
+			Message:   st.Message(),
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,72 @@
+package product
+
+import (
+	"context"
+	"fmt"
+
+	"catalog-proj/internal/app/product/usecases/rebuild_projection"
+	"catalog-proj/internal/pkg/lro"
+	pb "catalog-proj/proto/product/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// maxRebuildBatchSize bounds the products read per batch by a rebuild
+	maxRebuildBatchSize = 5000
+	// rebuildProjectionKind is the operation kind reported in OperationMetadata
+	rebuildProjectionKind = "rebuild_projection"
+)
+
+// RebuildProjection handles the RebuildProjection gRPC request
+// The rebuild runs as a long-running operation; its checkpoint can be passed back as
+// start_after_product_id to resume a failed or cancelled run
+func (h *Handler) RebuildProjection(ctx context.Context, req *pb.RebuildProjectionRequest) (*pb.RebuildProjectionResponse, error) {
+	// 1. Validate
+	if req.BatchSize < 0 || req.BatchSize > maxRebuildBatchSize {
+		return nil, invalidArgumentError(fmt.Sprintf("batch_size must be between 0 and %d", maxRebuildBatchSize))
+	}
+
+	// 2. Size the operation so clients can follow its progress
+	total, err := h.rebuildProjectionInteractor.Count(ctx, req.StartAfterProductId)
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 3. Queue the operation; the task runs on a job worker
+	name, err := h.operationRunner.Start(ctx, rebuildProjectionKind, int(total), req)
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 4. Return response
+	return &pb.RebuildProjectionResponse{
+		OperationName: name,
+	}, nil
+}
+
+// runRebuildProjection is the operation task behind RebuildProjection
+func (h *Handler) runRebuildProjection(ctx context.Context, request proto.Message, progress *lro.Progress) (proto.Message, error) {
+	req, ok := request.(*pb.RebuildProjectionRequest)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "unexpected %s request type %T", rebuildProjectionKind, request)
+	}
+
+	resp, err := h.rebuildProjectionInteractor.Execute(ctx, &rebuild_projection.Request{
+		StartAfter: req.StartAfterProductId,
+		DryRun:     req.DryRun,
+		BatchSize:  int(req.BatchSize),
+	}, progress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RebuildProjectionResult{
+		Scanned:               resp.Scanned,
+		Updated:               resp.Updated,
+		ConflictingProductIds: resp.Conflicts,
+		LastProductId:         resp.LastProductID,
+	}, nil
+}
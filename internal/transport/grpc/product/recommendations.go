@@ -0,0 +1,40 @@
+package product
+
+import (
+	"context"
+
+	"catalog-proj/internal/app/product/queries/get_recommendations"
+	pb "catalog-proj/proto/product/v1"
+)
+
+// maxRecommendations bounds the products per GetRecommendations request
+const maxRecommendations = 50
+
+// GetRecommendations handles the GetRecommendations gRPC request
+func (h *Handler) GetRecommendations(ctx context.Context, req *pb.GetRecommendationsRequest) (*pb.GetRecommendationsResponse, error) {
+	// 1. Validate
+	if req.ProductId == "" {
+		return nil, invalidArgumentError("product_id is required")
+	}
+	if req.Limit < 0 || req.Limit > maxRecommendations {
+		return nil, invalidArgumentError("limit must be between 0 and 50")
+	}
+
+	// 2. Call query
+	dto, err := h.getRecommendationsQuery.Execute(ctx, &get_recommendations.Request{
+		ProductID: req.ProductId,
+		Limit:     int(req.Limit),
+	})
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 3. Map DTO to proto
+	resp := &pb.GetRecommendationsResponse{
+		Products: make([]*pb.Product, 0, len(dto.Products)),
+	}
+	for _, p := range dto.Products {
+		resp.Products = append(resp.Products, DTOToProtoProduct(p))
+	}
+	return resp, nil
+}
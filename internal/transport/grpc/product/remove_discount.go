@@ -20,6 +20,7 @@ func (h *Handler) RemoveDiscount(ctx context.Context, req *pb.RemoveDiscountRequ
 	}
 
 	// 3. Call use case
+	ctx = recordCommit(ctx, req.ReturnProduct)
 	resp, err := h.removeDiscountInteractor.Execute(ctx, useCaseReq)
 	if err != nil {
 		return nil, MapDomainError(err)
@@ -28,5 +29,6 @@ func (h *Handler) RemoveDiscount(ctx context.Context, req *pb.RemoveDiscountRequ
 	// 4. Map response to proto
 	return &pb.RemoveDiscountResponse{
 		ProductId: resp.ProductID,
+		Product:   h.committedProduct(ctx, req.ReturnProduct, resp.Product, false),
 	}, nil
 }
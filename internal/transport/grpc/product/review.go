@@ -0,0 +1,70 @@
+package product
+
+import (
+	"context"
+
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/app/product/usecases/review_product"
+	"catalog-proj/internal/pkg/apikey"
+	pb "catalog-proj/proto/product/v1"
+)
+
+// ReviewProduct handles the ReviewProduct gRPC request
+func (h *Handler) ReviewProduct(ctx context.Context, req *pb.ReviewProductRequest) (*pb.ReviewProductResponse, error) {
+	// 1. Validate
+	if req.ProductId == "" {
+		return nil, invalidArgumentError("product_id is required")
+	}
+	decision, ok := protoReviewDecisionToDomain(req.Decision)
+	if !ok {
+		return nil, invalidArgumentError("decision must be REVIEW_DECISION_APPROVED or REVIEW_DECISION_REJECTED")
+	}
+
+	// 2. Map proto to use case request; when an API key authenticated the call it is the reviewer,
+	// not the free-text reviewer, so the audit trail cannot name someone else
+	reviewer := req.Reviewer
+	if keyID := apikey.KeyIDFromContext(ctx); keyID != "" {
+		reviewer = keyID
+	}
+	useCaseReq := &review_product.Request{
+		ProductID: req.ProductId,
+		Decision:  decision,
+		Reviewer:  reviewer,
+		Comment:   req.Comment,
+	}
+
+	// 3. Call use case
+	resp, err := h.reviewProductInteractor.Execute(ctx, useCaseReq)
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 4. Return response
+	return &pb.ReviewProductResponse{
+		ProductId: resp.ProductID,
+	}, nil
+}
+
+// protoReviewDecisionToDomain converts a proto review decision, reporting false when unspecified
+func protoReviewDecisionToDomain(decision pb.ReviewDecision) (domain.ReviewDecision, bool) {
+	switch decision {
+	case pb.ReviewDecision_REVIEW_DECISION_APPROVED:
+		return domain.ReviewApproved, true
+	case pb.ReviewDecision_REVIEW_DECISION_REJECTED:
+		return domain.ReviewRejected, true
+	default:
+		return "", false
+	}
+}
+
+// domainReviewDecisionToProto converts a domain review decision to proto
+func domainReviewDecisionToProto(decision domain.ReviewDecision) pb.ReviewDecision {
+	switch decision {
+	case domain.ReviewApproved:
+		return pb.ReviewDecision_REVIEW_DECISION_APPROVED
+	case domain.ReviewRejected:
+		return pb.ReviewDecision_REVIEW_DECISION_REJECTED
+	default:
+		return pb.ReviewDecision_REVIEW_DECISION_UNSPECIFIED
+	}
+}
@@ -0,0 +1,170 @@
+package product
+
+import (
+	"context"
+	"strings"
+
+	"catalog-proj/internal/app/product/queries/search_products"
+	"catalog-proj/internal/app/product/queries/suggest_products"
+	"catalog-proj/internal/app/product/usecases/create_merch_rule"
+	"catalog-proj/internal/app/product/usecases/delete_merch_rule"
+	"catalog-proj/internal/pkg/tenant"
+	pb "catalog-proj/proto/product/v1"
+)
+
+const (
+	// maxSearchLimit bounds the number of hits returned by SearchProducts
+	maxSearchLimit = 100
+	// maxSuggestLimit bounds the number of suggestions returned by SuggestProducts
+	maxSuggestLimit = 50
+)
+
+// SearchProducts handles the SearchProducts gRPC request
+func (h *Handler) SearchProducts(ctx context.Context, req *pb.SearchProductsRequest) (*pb.SearchProductsResponse, error) {
+	// 1. Validate
+	if strings.TrimSpace(req.Query) == "" {
+		return nil, invalidArgumentError("query is required")
+	}
+	if req.Limit < 0 || req.Limit > maxSearchLimit {
+		return nil, invalidArgumentError("limit must be between 0 and 100")
+	}
+	switch req.OrderBy {
+	case "", search_products.OrderByRelevance, search_products.OrderByEffectivePrice, search_products.OrderByEffectivePriceDesc:
+	default:
+		return nil, invalidArgumentError("order_by must be relevance, effective_price or effective_price_desc")
+	}
+	minPrice, maxPrice, err := effectivePriceBounds(req.MinEffectivePrice, req.MaxEffectivePrice)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Call query
+	dto, err := h.searchProductsQuery.Execute(ctx, &search_products.Request{
+		TenantID:          tenant.FromContext(ctx),
+		Query:             req.Query,
+		Limit:             int(req.Limit),
+		OrderBy:           req.OrderBy,
+		MinEffectivePrice: minPrice,
+		MaxEffectivePrice: maxPrice,
+	})
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 3. Map DTO to proto
+	hits := make([]*pb.SearchHit, 0, len(dto.Hits))
+	for _, hit := range dto.Hits {
+		hits = append(hits, &pb.SearchHit{
+			ProductId: hit.ID,
+			Name:      hit.Name,
+			Category:  hit.Category,
+			Score:     hit.Score,
+			Pinned:    hit.Pinned,
+		})
+	}
+
+	return &pb.SearchProductsResponse{
+		Hits: hits,
+	}, nil
+}
+
+// CreateMerchRule handles the CreateMerchRule gRPC request
+func (h *Handler) CreateMerchRule(ctx context.Context, req *pb.CreateMerchRuleRequest) (*pb.CreateMerchRuleResponse, error) {
+	// 1. Validate
+	if req.Rule == nil {
+		return nil, invalidArgumentError("rule is required")
+	}
+
+	// 2. Map proto to use case request (the rule's fields are validated by the domain)
+	useCaseReq := &create_merch_rule.Request{
+		Rule: ProtoMerchRuleToDomain(req.Rule),
+	}
+
+	// 3. Call use case
+	resp, err := h.createMerchRuleInteractor.Execute(ctx, useCaseReq)
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 4. Map response to proto
+	return &pb.CreateMerchRuleResponse{
+		RuleId: resp.RuleID,
+	}, nil
+}
+
+// DeleteMerchRule handles the DeleteMerchRule gRPC request
+func (h *Handler) DeleteMerchRule(ctx context.Context, req *pb.DeleteMerchRuleRequest) (*pb.DeleteMerchRuleResponse, error) {
+	// 1. Validate
+	if req.RuleId == "" {
+		return nil, invalidArgumentError("rule_id is required")
+	}
+
+	// 2. Call use case
+	resp, err := h.deleteMerchRuleInteractor.Execute(ctx, &delete_merch_rule.Request{RuleID: req.RuleId})
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 3. Map response to proto
+	return &pb.DeleteMerchRuleResponse{
+		RuleId: resp.RuleID,
+	}, nil
+}
+
+// ListMerchRules handles the ListMerchRules gRPC request
+func (h *Handler) ListMerchRules(ctx context.Context, req *pb.ListMerchRulesRequest) (*pb.ListMerchRulesResponse, error) {
+	// 1. Call query
+	dto, err := h.listMerchRulesQuery.Execute(ctx)
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 2. Map DTO to proto
+	rules := make([]*pb.MerchRule, 0, len(dto.Rules))
+	for _, rule := range dto.Rules {
+		rules = append(rules, DomainMerchRuleToProto(rule))
+	}
+
+	return &pb.ListMerchRulesResponse{
+		Rules: rules,
+	}, nil
+}
+
+// SuggestProducts handles the SuggestProducts gRPC request
+func (h *Handler) SuggestProducts(ctx context.Context, req *pb.SuggestProductsRequest) (*pb.SuggestProductsResponse, error) {
+	// 1. Validate
+	if strings.TrimSpace(req.Prefix) == "" {
+		return nil, invalidArgumentError("prefix is required")
+	}
+	if req.Limit < 0 || req.Limit > maxSuggestLimit {
+		return nil, invalidArgumentError("limit must be between 0 and 50")
+	}
+
+	// 2. Call query
+	dto, err := h.suggestProductsQuery.Execute(ctx, &suggest_products.Request{
+		TenantID: tenant.FromContext(ctx),
+		Prefix:   req.Prefix,
+		Limit:    int(req.Limit),
+	})
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 3. Map DTO to proto
+	suggestions := make([]*pb.Suggestion, 0, len(dto.Suggestions))
+	for _, suggestion := range dto.Suggestions {
+		kind := pb.SuggestionKind_SUGGESTION_KIND_NAME
+		if suggestion.Kind == suggest_products.KindCategory {
+			kind = pb.SuggestionKind_SUGGESTION_KIND_CATEGORY
+		}
+		suggestions = append(suggestions, &pb.Suggestion{
+			Text:       suggestion.Text,
+			Kind:       kind,
+			Popularity: suggestion.Popularity,
+		})
+	}
+
+	return &pb.SuggestProductsResponse{
+		Suggestions: suggestions,
+	}, nil
+}
@@ -0,0 +1,66 @@
+package product
+
+import (
+	"context"
+
+	"catalog-proj/internal/app/product/usecases/delete_tenant_settings"
+	"catalog-proj/internal/app/product/usecases/put_tenant_settings"
+	pb "catalog-proj/proto/product/v1"
+)
+
+// PutTenantSettings handles the PutTenantSettings gRPC request
+func (h *Handler) PutTenantSettings(ctx context.Context, req *pb.PutTenantSettingsRequest) (*pb.PutTenantSettingsResponse, error) {
+	// 1. Validate
+	if req.Settings == nil {
+		return nil, invalidArgumentError("settings is required")
+	}
+
+	// 2. Map proto to use case request (the limits are validated by the domain)
+	useCaseReq := &put_tenant_settings.Request{
+		Settings: ProtoTenantSettingsToDomain(req.Settings),
+	}
+
+	// 3. Call use case
+	resp, err := h.putTenantSettingsInteractor.Execute(ctx, useCaseReq)
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 4. Map response to proto
+	return &pb.PutTenantSettingsResponse{
+		Settings:  DomainTenantSettingsToProto(resp.Settings),
+		Effective: DomainTenantSettingsToProto(resp.Effective),
+	}, nil
+}
+
+// DeleteTenantSettings handles the DeleteTenantSettings gRPC request
+func (h *Handler) DeleteTenantSettings(ctx context.Context, req *pb.DeleteTenantSettingsRequest) (*pb.DeleteTenantSettingsResponse, error) {
+	// 1. Call use case
+	resp, err := h.deleteTenantSettingsInteractor.Execute(ctx, &delete_tenant_settings.Request{})
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 2. Map response to proto
+	return &pb.DeleteTenantSettingsResponse{
+		Effective: DomainTenantSettingsToProto(resp.Effective),
+	}, nil
+}
+
+// GetTenantSettings handles the GetTenantSettings gRPC request
+func (h *Handler) GetTenantSettings(ctx context.Context, req *pb.GetTenantSettingsRequest) (*pb.GetTenantSettingsResponse, error) {
+	// 1. Call query
+	dto, err := h.getTenantSettingsQuery.Execute(ctx)
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 2. Map DTO to proto
+	resp := &pb.GetTenantSettingsResponse{
+		Effective: DomainTenantSettingsToProto(dto.Effective),
+	}
+	if dto.Overrides != nil {
+		resp.Settings = DomainTenantSettingsToProto(*dto.Overrides)
+	}
+	return resp, nil
+}
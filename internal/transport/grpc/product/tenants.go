@@ -0,0 +1,191 @@
+package product
+
+import (
+	"context"
+	"fmt"
+
+	"catalog-proj/internal/app/product/usecases/create_tenant"
+	"catalog-proj/internal/app/product/usecases/delete_tenant"
+	"catalog-proj/internal/pkg/lro"
+	pb "catalog-proj/proto/product/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// createTenantKind and deleteTenantKind are the operation kinds reported in OperationMetadata
+	createTenantKind = "create_tenant"
+	deleteTenantKind = "delete_tenant"
+
+	// maxTenantTemplates and maxTenantApiKeys bound what CreateTenant seeds, keeping its commit small
+	maxTenantTemplates = 100
+	maxTenantApiKeys   = 10
+)
+
+// CreateTenant handles the CreateTenant gRPC request
+// The tenant is registered and its keys issued before the response; its settings and templates
+// are written by a long-running operation that activates the tenant
+func (h *Handler) CreateTenant(ctx context.Context, req *pb.CreateTenantRequest) (*pb.CreateTenantResponse, error) {
+	// 1. Validate the request shape; the tenant, settings and templates are validated by the domain
+	var violations fieldViolations
+	if req.TenantId == "" {
+		violations.add("tenant_id", "is required")
+	}
+	if req.DisplayName == "" {
+		violations.add("display_name", "is required")
+	}
+	if len(req.CategoryTemplates) > maxTenantTemplates {
+		violations.add("category_templates", fmt.Sprintf("must have at most %d entries", maxTenantTemplates))
+	}
+	for i, t := range req.CategoryTemplates {
+		if t == nil {
+			violations.add(fmt.Sprintf("category_templates[%d]", i), "is required")
+		}
+	}
+	if len(req.ApiKeys) > maxTenantApiKeys {
+		violations.add("api_keys", fmt.Sprintf("must have at most %d entries", maxTenantApiKeys))
+	}
+	for i, k := range req.ApiKeys {
+		field := fmt.Sprintf("api_keys[%d]", i)
+		switch {
+		case k.GetName() == "":
+			violations.add(field+".name", "is required")
+		case len(k.Name) > maxApiKeyNameLength:
+			violations.add(field+".name", "must be at most 256 bytes")
+		}
+		if len(k.GetScopes()) == 0 {
+			violations.add(field+".scopes", "at least one scope is required")
+		} else if _, ok := protoApiKeyScopes(k.Scopes); !ok {
+			violations.add(field+".scopes", "must be READ, WRITE or ADMIN")
+		}
+	}
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+
+	// 2. Register the tenant and issue its keys
+	reg, err := h.createTenantInteractor.Begin(ctx, protoCreateTenantToRequest(req))
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 3. Queue the seeding; the task runs on a job worker
+	name, err := h.operationRunner.Start(ctx, createTenantKind, len(req.CategoryTemplates), req)
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 4. Return response; the secrets are not stored and cannot be shown again
+	resp := &pb.CreateTenantResponse{
+		Tenant:        DomainTenantToProto(reg.Tenant),
+		OperationName: name,
+	}
+	for _, key := range reg.Keys {
+		resp.ApiKeys = append(resp.ApiKeys, &pb.TenantApiKey{
+			ApiKey: apiKeyToProto(key.Key),
+			Secret: key.Secret,
+		})
+	}
+	return resp, nil
+}
+
+// runCreateTenant is the operation task behind CreateTenant
+func (h *Handler) runCreateTenant(ctx context.Context, request proto.Message, progress *lro.Progress) (proto.Message, error) {
+	req, ok := request.(*pb.CreateTenantRequest)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "unexpected %s request type %T", createTenantKind, request)
+	}
+
+	resp, err := h.createTenantInteractor.Execute(ctx, protoCreateTenantToRequest(req), progress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateTenantResult{
+		Tenant:            DomainTenantToProto(resp.Tenant),
+		CategoryTemplates: int32(resp.CategoryTemplates),
+	}, nil
+}
+
+// DeleteTenant handles the DeleteTenant gRPC request
+// The tenant is marked deleting before the response; a long-running operation revokes its keys
+// and purges its data. Its checkpoint can be passed back as start_after_product_id to resume a
+// failed or cancelled run
+func (h *Handler) DeleteTenant(ctx context.Context, req *pb.DeleteTenantRequest) (*pb.DeleteTenantResponse, error) {
+	// 1. Validate
+	if req.TenantId == "" {
+		return nil, invalidArgumentError("tenant_id is required")
+	}
+
+	// 2. Mark the tenant deleting and size the operation so clients can follow its progress
+	t, total, err := h.deleteTenantInteractor.Begin(ctx, protoDeleteTenantToRequest(req))
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 3. Queue the operation; the task runs on a job worker
+	name, err := h.operationRunner.Start(ctx, deleteTenantKind, int(total), req)
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 4. Return response
+	return &pb.DeleteTenantResponse{
+		Tenant:        DomainTenantToProto(t),
+		OperationName: name,
+	}, nil
+}
+
+// runDeleteTenant is the operation task behind DeleteTenant
+func (h *Handler) runDeleteTenant(ctx context.Context, request proto.Message, progress *lro.Progress) (proto.Message, error) {
+	req, ok := request.(*pb.DeleteTenantRequest)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "unexpected %s request type %T", deleteTenantKind, request)
+	}
+
+	resp, err := h.deleteTenantInteractor.Execute(ctx, protoDeleteTenantToRequest(req), progress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteTenantResult{
+		Tenant:           DomainTenantToProto(resp.Tenant),
+		ApiKeysRevoked:   int32(resp.KeysRevoked),
+		ProductsPurged:   resp.ProductsPurged,
+		ProductsExported: resp.ProductsExported,
+		RowsDeleted:      resp.RowsDeleted,
+		ExportPrefix:     resp.ExportPrefix,
+		LastProductId:    resp.LastProductID,
+	}, nil
+}
+
+// protoCreateTenantToRequest maps a validated CreateTenant request to the use case request
+func protoCreateTenantToRequest(req *pb.CreateTenantRequest) *create_tenant.Request {
+	useCaseReq := &create_tenant.Request{
+		TenantID:    req.TenantId,
+		DisplayName: req.DisplayName,
+	}
+	if req.Settings != nil {
+		settings := ProtoTenantSettingsToDomain(req.Settings)
+		useCaseReq.Settings = &settings
+	}
+	for _, t := range req.CategoryTemplates {
+		useCaseReq.Templates = append(useCaseReq.Templates, ProtoCategoryTemplateToDomain(t))
+	}
+	for _, k := range req.ApiKeys {
+		scopes, _ := protoApiKeyScopes(k.Scopes)
+		useCaseReq.APIKeys = append(useCaseReq.APIKeys, create_tenant.KeySpec{Name: k.Name, Scopes: scopes})
+	}
+	return useCaseReq
+}
+
+// protoDeleteTenantToRequest maps a DeleteTenant request to the use case request
+func protoDeleteTenantToRequest(req *pb.DeleteTenantRequest) *delete_tenant.Request {
+	return &delete_tenant.Request{
+		TenantID:   req.TenantId,
+		Export:     req.Export,
+		StartAfter: req.StartAfterProductId,
+	}
+}
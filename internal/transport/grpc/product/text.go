@@ -0,0 +1,19 @@
+package product
+
+// normalizeLine normalizes an optional name or category, keeping nil as unset
+func (h *Handler) normalizeLine(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	normalized := h.text.Line(*s)
+	return &normalized
+}
+
+// normalizeDescription normalizes an optional description, keeping nil as unset
+func (h *Handler) normalizeDescription(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	normalized := h.text.Description(*s)
+	return &normalized
+}
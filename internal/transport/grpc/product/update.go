@@ -16,46 +16,89 @@ func (h *Handler) UpdateProduct(ctx context.Context, req *pb.UpdateProductReques
 	}
 
 	// Validate that at least one field is being updated
-	if req.Name == nil && req.Description == nil && req.Category == nil {
-		return nil, invalidArgumentError("at least one field (name, description, or category) must be provided")
+	if req.Name == nil && req.Description == nil && req.Category == nil &&
+		req.Weight == nil && req.Dimensions == nil && req.ShippingClass == nil &&
+		req.ProductType == nil && req.DownloadUrl == nil && req.LicenseTerms == nil && req.Compliance == nil &&
+		req.DescriptionFormat == nil {
+		return nil, invalidArgumentError("at least one field (name, description, description_format, category, weight, dimensions, shipping_class, product_type, download_url, license_terms, or compliance) must be provided")
 	}
 
-	// 2. Map proto to use case request
+	// 2. Map proto to use case request, collecting every invalid field
 	useCaseReq := &update_product.Request{
 		ProductID: req.ProductId,
 	}
+	var violations fieldViolations
 	if req.Name != nil {
-		name := strings.TrimSpace(*req.Name)
+		name := h.text.Line(*req.Name)
 		if name == "" {
-			return nil, invalidArgumentError("name cannot be empty")
-		}
-		if len(name) > 255 {
-			return nil, invalidArgumentError("name exceeds maximum length of 255 characters")
+			violations.add("name", "name cannot be empty")
+		} else if len(name) > 255 {
+			violations.add("name", "name exceeds maximum length of 255 characters")
 		}
 		useCaseReq.Name = &name
 	}
 	if req.Description != nil {
-		description := strings.TrimSpace(*req.Description)
+		description := h.text.Description(*req.Description)
 		if description == "" {
-			return nil, invalidArgumentError("description cannot be empty")
-		}
-		if len(description) > 1000 {
-			return nil, invalidArgumentError("description exceeds maximum length of 1000 characters")
+			violations.add("description", "description cannot be empty")
+		} else if len(description) > 1000 {
+			violations.add("description", "description exceeds maximum length of 1000 characters")
 		}
 		useCaseReq.Description = &description
 	}
 	if req.Category != nil {
-		category := strings.TrimSpace(*req.Category)
+		category := h.text.Line(*req.Category)
 		if category == "" {
-			return nil, invalidArgumentError("category cannot be empty")
-		}
-		if len(category) > 100 {
-			return nil, invalidArgumentError("category exceeds maximum length of 100 characters")
+			violations.add("category", "category cannot be empty")
+		} else if len(category) > 100 {
+			violations.add("category", "category exceeds maximum length of 100 characters")
 		}
 		useCaseReq.Category = &category
 	}
 
+	useCaseReq.Weight = ProtoWeightToDomain(req.Weight)
+	useCaseReq.Dimensions = ProtoDimensionsToDomain(req.Dimensions)
+	if req.ShippingClass != nil {
+		shippingClass := strings.TrimSpace(*req.ShippingClass)
+		useCaseReq.ShippingClass = &shippingClass
+	}
+	if req.ProductType != nil {
+		if *req.ProductType == pb.ProductType_PRODUCT_TYPE_UNSPECIFIED {
+			violations.add("product_type", "product_type cannot be unspecified")
+		} else if productType, err := ProtoProductTypeToDomain(*req.ProductType); err != nil {
+			violations.add("product_type", "unknown product_type")
+		} else {
+			useCaseReq.ProductType = &productType
+		}
+	}
+	if req.DescriptionFormat != nil {
+		if *req.DescriptionFormat == pb.DescriptionFormat_DESCRIPTION_FORMAT_UNSPECIFIED {
+			violations.add("description_format", "description_format cannot be unspecified")
+		} else if descriptionFormat, err := ProtoDescriptionFormatToDomain(*req.DescriptionFormat); err != nil {
+			violations.add("description_format", "unknown description_format")
+		} else {
+			useCaseReq.DescriptionFormat = &descriptionFormat
+		}
+	}
+	if req.DownloadUrl != nil {
+		downloadURL := strings.TrimSpace(*req.DownloadUrl)
+		useCaseReq.DownloadURL = &downloadURL
+	}
+	if req.LicenseTerms != nil {
+		licenseTerms := strings.TrimSpace(*req.LicenseTerms)
+		useCaseReq.LicenseTerms = &licenseTerms
+	}
+	if req.Compliance != nil {
+		compliance := ProtoComplianceToDomain(req.Compliance)
+		useCaseReq.Compliance = &compliance
+	}
+
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+
 	// 3. Call use case
+	ctx = recordCommit(ctx, req.ReturnProduct)
 	resp, err := h.updateProductInteractor.Execute(ctx, useCaseReq)
 	if err != nil {
 		return nil, MapDomainError(err)
@@ -64,5 +107,6 @@ func (h *Handler) UpdateProduct(ctx context.Context, req *pb.UpdateProductReques
 	// 4. Map response to proto
 	return &pb.UpdateProductResponse{
 		ProductId: resp.ProductID,
+		Product:   h.committedProduct(ctx, req.ReturnProduct, resp.Product, false),
 	}, nil
 }
@@ -0,0 +1,56 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"catalog-proj/internal/pkg/usage"
+	pb "catalog-proj/proto/product/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetUsage handles the GetUsage gRPC request
+func (h *Handler) GetUsage(ctx context.Context, req *pb.GetUsageRequest) (*pb.GetUsageResponse, error) {
+	// 1. Validate
+	var start, end time.Time
+	if req.StartTime != nil {
+		if err := req.StartTime.CheckValid(); err != nil {
+			return nil, invalidArgumentError("start_time is invalid")
+		}
+		start = req.StartTime.AsTime()
+	}
+	if req.EndTime != nil {
+		if err := req.EndTime.CheckValid(); err != nil {
+			return nil, invalidArgumentError("end_time is invalid")
+		}
+		end = req.EndTime.AsTime()
+	}
+
+	// 2. Read usage
+	rows, err := h.usageRecorder.List(ctx, start, end, req.KeyId)
+	if errors.Is(err, usage.ErrInvalidRange) {
+		return nil, invalidArgumentError(err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// 3. Map to proto
+	resp := &pb.GetUsageResponse{
+		Records: make([]*pb.UsageRecord, 0, len(rows)),
+	}
+	for _, row := range rows {
+		resp.Records = append(resp.Records, &pb.UsageRecord{
+			KeyId:         row.KeyID,
+			Day:           timestamppb.New(row.Day),
+			RequestCount:  row.RequestCount,
+			RowsReturned:  row.RowsReturned,
+			MutationCount: row.MutationCount,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,48 @@
+package product
+
+import (
+	"context"
+
+	"catalog-proj/internal/app/product/queries/validate_product"
+	pb "catalog-proj/proto/product/v1"
+)
+
+// ValidateProduct handles the ValidateProduct gRPC request
+// Violations are returned in the response; only lookup failures are reported as errors
+func (h *Handler) ValidateProduct(ctx context.Context, req *pb.ValidateProductRequest) (*pb.ValidateProductResponse, error) {
+	// 1. Map proto to query request
+	queryReq := &validate_product.Request{
+		ProductID:   req.ProductId,
+		Name:        h.normalizeLine(req.Name),
+		Description: h.normalizeDescription(req.Description),
+		Category:    h.normalizeLine(req.Category),
+		SKU:         req.Sku,
+		GTIN:        req.Gtin,
+	}
+	if req.BasePrice != nil {
+		queryReq.BasePrice = ProtoMoneyToDomain(req.BasePrice)
+	}
+	if req.DescriptionFormat != nil {
+		descriptionFormat, err := ProtoDescriptionFormatToDomain(*req.DescriptionFormat)
+		if err != nil {
+			return nil, err
+		}
+		queryReq.DescriptionFormat = &descriptionFormat
+	}
+
+	// 2. Call query
+	dto, err := h.validateProductQuery.Execute(ctx, queryReq)
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 3. Map DTO to proto
+	resp := &pb.ValidateProductResponse{Valid: len(dto.Violations) == 0}
+	for _, v := range dto.Violations {
+		resp.Violations = append(resp.Violations, &pb.ValidationViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		})
+	}
+	return resp, nil
+}
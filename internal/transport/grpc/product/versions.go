@@ -0,0 +1,67 @@
+package product
+
+import (
+	"context"
+
+	"catalog-proj/internal/app/product/usecases/rollback_to_version"
+	pb "catalog-proj/proto/product/v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListProductVersions handles the ListProductVersions gRPC request
+func (h *Handler) ListProductVersions(ctx context.Context, req *pb.ListProductVersionsRequest) (*pb.ListProductVersionsResponse, error) {
+	// 1. Validate
+	if req.ProductId == "" {
+		return nil, invalidArgumentError("product_id is required")
+	}
+
+	// 2. Call query
+	dto, err := h.listProductVersionsQuery.Execute(ctx, req.ProductId)
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 3. Map DTO to proto
+	resp := &pb.ListProductVersionsResponse{
+		ProductId: dto.ProductID,
+		Versions:  make([]*pb.ProductVersion, 0, len(dto.Versions)),
+	}
+	for _, version := range dto.Versions {
+		resp.Versions = append(resp.Versions, &pb.ProductVersion{
+			VersionId:   version.ID,
+			Name:        version.Name,
+			Description: version.Description,
+			Category:    version.Category,
+			Metadata:    version.Metadata,
+			CreatedAt:   timestamppb.New(version.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+// RollbackToVersion handles the RollbackToVersion gRPC request
+func (h *Handler) RollbackToVersion(ctx context.Context, req *pb.RollbackToVersionRequest) (*pb.RollbackToVersionResponse, error) {
+	// 1. Validate
+	if req.ProductId == "" {
+		return nil, invalidArgumentError("product_id is required")
+	}
+	if req.VersionId == "" {
+		return nil, invalidArgumentError("version_id is required")
+	}
+
+	// 2. Call use case
+	resp, err := h.rollbackToVersionInteractor.Execute(ctx, &rollback_to_version.Request{
+		ProductID: req.ProductId,
+		VersionID: req.VersionId,
+	})
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 3. Map response to proto
+	return &pb.RollbackToVersionResponse{
+		ProductId: resp.ProductID,
+		VersionId: resp.VersionID,
+	}, nil
+}
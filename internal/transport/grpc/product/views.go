@@ -0,0 +1,64 @@
+package product
+
+import (
+	"context"
+
+	"catalog-proj/internal/app/product/usecases/record_product_view"
+	pb "catalog-proj/proto/product/v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxStatsProducts bounds the products per GetProductStats request
+const maxStatsProducts = 100
+
+// RecordProductView handles the RecordProductView gRPC request
+func (h *Handler) RecordProductView(ctx context.Context, req *pb.RecordProductViewRequest) (*pb.RecordProductViewResponse, error) {
+	// 1. Validate
+	if req.ProductId == "" {
+		return nil, invalidArgumentError("product_id is required")
+	}
+
+	// 2. Call use case
+	h.recordProductViewInteractor.Execute(ctx, &record_product_view.Request{
+		ProductID: req.ProductId,
+	})
+
+	return &pb.RecordProductViewResponse{}, nil
+}
+
+// GetProductStats handles the GetProductStats gRPC request
+func (h *Handler) GetProductStats(ctx context.Context, req *pb.GetProductStatsRequest) (*pb.GetProductStatsResponse, error) {
+	// 1. Validate
+	if len(req.ProductIds) == 0 || len(req.ProductIds) > maxStatsProducts {
+		return nil, invalidArgumentError("product_ids must hold between 1 and 100 IDs")
+	}
+	for _, id := range req.ProductIds {
+		if id == "" {
+			return nil, invalidArgumentError("product_ids must not contain empty IDs")
+		}
+	}
+
+	// 2. Call query
+	dto, err := h.getProductStatsQuery.Execute(ctx, req.ProductIds)
+	if err != nil {
+		return nil, MapDomainError(err)
+	}
+
+	// 3. Map DTO to proto
+	stats := make([]*pb.ProductStats, 0, len(dto.Stats))
+	for _, entry := range dto.Stats {
+		out := &pb.ProductStats{
+			ProductId: entry.ProductID,
+			ViewCount: entry.ViewCount,
+		}
+		if entry.LastViewedAt != nil {
+			out.LastViewedAt = timestamppb.New(*entry.LastViewedAt)
+		}
+		stats = append(stats, out)
+	}
+
+	return &pb.GetProductStatsResponse{
+		Stats: stats,
+	}, nil
+}
@@ -0,0 +1,37 @@
+package productv2
+
+import (
+	"context"
+
+	v1 "catalog-proj/proto/product/v1"
+	pb "catalog-proj/proto/product/v2"
+)
+
+// CreateProduct handles the CreateProduct gRPC request
+func (h *Handler) CreateProduct(ctx context.Context, req *pb.CreateProductRequest) (*pb.Product, error) {
+	if req.Product == nil {
+		return nil, invalidArgumentError("product is required")
+	}
+
+	resp, err := h.v1.CreateProduct(ctx, &v1.CreateProductRequest{
+		Name:          req.Product.DisplayName,
+		Description:   req.Product.Description,
+		Category:      req.Product.Category,
+		BasePrice:     moneyToV1(req.Product.BasePrice),
+		Sku:           req.Product.Sku,
+		Gtin:          req.Product.Gtin,
+		Weight:        weightToV1(req.Product.Weight),
+		Dimensions:    dimensionsToV1(req.Product.Dimensions),
+		ShippingClass: req.Product.ShippingClass,
+		ProductType:   typeToV1(req.Product.Type),
+		DownloadUrl:   req.Product.DownloadUri,
+		LicenseTerms:  req.Product.LicenseTerms,
+		Compliance:    complianceToV1(req.Product.Compliance),
+		ReturnProduct: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return productToV2(resp.Product), nil
+}
@@ -0,0 +1,24 @@
+package productv2
+
+import (
+	"context"
+
+	v1 "catalog-proj/proto/product/v1"
+	pb "catalog-proj/proto/product/v2"
+)
+
+// DeleteProduct handles the DeleteProduct gRPC request
+// Deletion is soft (AIP-164): the product is archived and returned with delete_time set
+func (h *Handler) DeleteProduct(ctx context.Context, req *pb.DeleteProductRequest) (*pb.Product, error) {
+	id, err := parseProductName("name", req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.v1.ArchiveProduct(ctx, &v1.ArchiveProductRequest{ProductId: id, ReturnProduct: true})
+	if err != nil {
+		return nil, err
+	}
+
+	return productToV2(resp.Product), nil
+}
@@ -0,0 +1,42 @@
+package productv2
+
+import (
+	"context"
+
+	v1 "catalog-proj/proto/product/v1"
+	pb "catalog-proj/proto/product/v2"
+)
+
+// ApplyDiscount handles the ApplyDiscount gRPC request
+func (h *Handler) ApplyDiscount(ctx context.Context, req *pb.ApplyDiscountRequest) (*pb.Product, error) {
+	id, err := parseProductName("name", req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.v1.ApplyDiscount(ctx, &v1.ApplyDiscountRequest{
+		ProductId:     id,
+		Discount:      discountToV1(req.Discount),
+		ReturnProduct: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return productToV2(resp.Product), nil
+}
+
+// RemoveDiscount handles the RemoveDiscount gRPC request
+func (h *Handler) RemoveDiscount(ctx context.Context, req *pb.RemoveDiscountRequest) (*pb.Product, error) {
+	id, err := parseProductName("name", req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.v1.RemoveDiscount(ctx, &v1.RemoveDiscountRequest{ProductId: id, ReturnProduct: true})
+	if err != nil {
+		return nil, err
+	}
+
+	return productToV2(resp.Product), nil
+}
@@ -0,0 +1,16 @@
+package productv2
+
+import (
+	"context"
+
+	pb "catalog-proj/proto/product/v2"
+)
+
+// GetProduct handles the GetProduct gRPC request
+func (h *Handler) GetProduct(ctx context.Context, req *pb.GetProductRequest) (*pb.Product, error) {
+	id, err := parseProductName("name", req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return h.fetch(ctx, id)
+}
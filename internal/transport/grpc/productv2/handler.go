@@ -0,0 +1,40 @@
+package productv2
+
+import (
+	"context"
+
+	v1 "catalog-proj/proto/product/v1"
+	pb "catalog-proj/proto/product/v2"
+)
+
+// Handler implements the product.v2 ProductService as an adapter over the v1 service:
+// requests are translated to v1 calls, so validation, tenancy and error mapping
+// stay in one place and both versions are served from the same use cases
+// Mutations set return_product, so they return the committed product without reading it back
+type Handler struct {
+	pb.UnimplementedProductServiceServer
+
+	v1 v1.ProductServiceServer
+
+	// defaultPageSize and maxPageSize match the v1 list limits; v2 clamps instead of rejecting
+	defaultPageSize int32
+	maxPageSize     int32
+}
+
+// NewHandler creates a v2 handler backed by the v1 service implementation
+func NewHandler(v1Server v1.ProductServiceServer, defaultPageSize, maxPageSize int32) *Handler {
+	return &Handler{
+		v1:              v1Server,
+		defaultPageSize: defaultPageSize,
+		maxPageSize:     maxPageSize,
+	}
+}
+
+// fetch returns the current state of a product as a v2 resource
+func (h *Handler) fetch(ctx context.Context, productID string) (*pb.Product, error) {
+	resp, err := h.v1.GetProduct(ctx, &v1.GetProductRequest{ProductId: productID})
+	if err != nil {
+		return nil, err
+	}
+	return productToV2(resp.Product), nil
+}
@@ -0,0 +1,172 @@
+package productv2
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	v1 "catalog-proj/proto/product/v1"
+	pb "catalog-proj/proto/product/v2"
+)
+
+// pageToken is the opaque cursor behind next_page_token
+// The filter is pinned so a token cannot be replayed against a different query
+type pageToken struct {
+	Offset int32  `json:"o"`
+	Filter string `json:"f"`
+}
+
+// ListProducts handles the ListProducts gRPC request
+func (h *Handler) ListProducts(ctx context.Context, req *pb.ListProductsRequest) (*pb.ListProductsResponse, error) {
+	if req.PageSize < 0 {
+		return nil, invalidArgumentError("page_size must be non-negative")
+	}
+	pageSize := req.PageSize
+	if pageSize == 0 {
+		pageSize = h.defaultPageSize
+	}
+	if pageSize > h.maxPageSize {
+		pageSize = h.maxPageSize
+	}
+
+	offset, err := decodePageToken(req.PageToken, req.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	v1Req := &v1.ListProductsRequest{
+		Limit:            pageSize,
+		Offset:           offset,
+		SkipTotal:        !req.ReturnTotalSize,
+		ApproximateTotal: req.ApproximateTotalSize,
+	}
+	if err := applyFilter(req.Filter, v1Req); err != nil {
+		return nil, err
+	}
+
+	resp, err := h.v1.ListProducts(ctx, v1Req)
+	if err != nil {
+		return nil, err
+	}
+
+	products := make([]*pb.Product, 0, len(resp.Products))
+	for _, p := range resp.Products {
+		products = append(products, productToV2(p))
+	}
+
+	var next string
+	if resp.HasMore {
+		next = encodePageToken(pageToken{Offset: offset + int32(len(resp.Products)), Filter: req.Filter})
+	}
+
+	return &pb.ListProductsResponse{
+		Products:      products,
+		NextPageToken: next,
+		TotalSize:     resp.Total,
+
+		TotalSizeApproximate: resp.TotalApproximate,
+	}, nil
+}
+
+// applyFilter parses an AIP-160 style conjunction of terms into v1 filters
+// Supported: category = "value", state = ACTIVE|INACTIVE, channels:"value", age_restriction <= N,
+// hazardous = false, requires_prescription = false and metadata.<key> = "value", joined with AND
+func applyFilter(filter string, req *v1.ListProductsRequest) error {
+	if strings.TrimSpace(filter) == "" {
+		return nil
+	}
+
+	for _, term := range strings.Split(filter, " AND ") {
+		// channels is repeated, so it takes the has operator
+		if field, value, ok := strings.Cut(term, ":"); ok && strings.TrimSpace(field) == "channels" {
+			value = strings.Trim(strings.TrimSpace(value), `"`)
+			if value == "" {
+				return invalidArgumentError("filter channels must not be empty")
+			}
+			req.Channel = &value
+			continue
+		}
+		if field, value, ok := strings.Cut(term, "<="); ok && strings.TrimSpace(field) == "age_restriction" {
+			maxAge, err := strconv.ParseInt(strings.TrimSpace(value), 10, 32)
+			if err != nil || maxAge < 0 {
+				return invalidArgumentError(fmt.Sprintf("filter age_restriction must be compared to a non-negative integer, got %q", strings.TrimSpace(value)))
+			}
+			limit := int32(maxAge)
+			req.MaxAgeRestriction = &limit
+			continue
+		}
+
+		field, value, ok := strings.Cut(term, "=")
+		if !ok {
+			return invalidArgumentError(fmt.Sprintf("filter term %q must have the form field = value", strings.TrimSpace(term)))
+		}
+		field = strings.TrimSpace(field)
+		value = strings.Trim(strings.TrimSpace(value), `"`)
+
+		if key, ok := strings.CutPrefix(field, "metadata."); ok {
+			if req.MetadataKey != nil {
+				return invalidArgumentError("filter supports a single metadata term")
+			}
+			req.MetadataKey = &key
+			req.MetadataValue = value
+			continue
+		}
+
+		switch field {
+		case "category":
+			if value == "" {
+				return invalidArgumentError("filter category must not be empty")
+			}
+			req.Category = &value
+		case "state":
+			status := stateToV1(pb.Product_State(pb.Product_State_value[value]))
+			if status == "" {
+				return invalidArgumentError(fmt.Sprintf("filter state must be ACTIVE or INACTIVE, got %q", value))
+			}
+			req.Status = &status
+		// Compliance flags only filter restricted products out
+		case "hazardous":
+			if value != "false" {
+				return invalidArgumentError("filter hazardous only supports = false")
+			}
+			req.ExcludeHazardous = true
+		case "requires_prescription":
+			if value != "false" {
+				return invalidArgumentError("filter requires_prescription only supports = false")
+			}
+			req.ExcludePrescription = true
+		default:
+			return invalidArgumentError(fmt.Sprintf("filter field %q is not supported; allowed: category, state, channels, age_restriction, hazardous, requires_prescription, metadata.<key>", field))
+		}
+	}
+	return nil
+}
+
+// encodePageToken serializes a page token
+func encodePageToken(token pageToken) string {
+	data, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodePageToken returns the offset encoded in token, checking it was issued for filter
+func decodePageToken(token, filter string) (int32, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, invalidArgumentError("page_token is invalid")
+	}
+	var decoded pageToken
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded.Offset < 0 {
+		return 0, invalidArgumentError("page_token is invalid")
+	}
+	if decoded.Filter != filter {
+		return 0, invalidArgumentError("page_token was issued for a different filter")
+	}
+	return decoded.Offset, nil
+}
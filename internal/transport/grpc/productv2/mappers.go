@@ -0,0 +1,184 @@
+package productv2
+
+import (
+	v1 "catalog-proj/proto/product/v1"
+	pb "catalog-proj/proto/product/v2"
+)
+
+// productToV2 converts a v1 Product to the v2 resource
+func productToV2(p *v1.Product) *pb.Product {
+	if p == nil {
+		return nil
+	}
+
+	return &pb.Product{
+		Name:           productName(p.Id),
+		DisplayName:    p.Name,
+		Description:    p.Description,
+		Category:       p.Category,
+		Sku:            p.Sku,
+		Gtin:           p.Gtin,
+		BasePrice:      moneyToV2(p.BasePrice),
+		EffectivePrice: moneyToV2(p.EffectivePrice),
+		MapApplied:     p.MapApplied,
+		Discount:       discountToV2(p.Discount),
+		State:          stateToV2(p.Status),
+		LegalHold:      p.LegalHold,
+		Channels:       p.Channels,
+		Weight:         weightToV2(p.Weight),
+		Dimensions:     dimensionsToV2(p.Dimensions),
+		ShippingClass:  p.ShippingClass,
+		Type:           typeToV2(p.ProductType),
+		DownloadUri:    p.DownloadUrl,
+		LicenseTerms:   p.LicenseTerms,
+		Compliance:     complianceToV2(p.Compliance),
+		Metadata:       p.Metadata,
+		CreateTime:     p.CreatedAt,
+		UpdateTime:     p.UpdatedAt,
+		DeleteTime:     p.ArchivedAt,
+	}
+}
+
+// moneyToV2 converts v1 Money to v2 Money
+func moneyToV2(m *v1.Money) *pb.Money {
+	if m == nil {
+		return nil
+	}
+	return &pb.Money{Amount: m.Amount}
+}
+
+// moneyToV1 converts v2 Money to v1 Money
+func moneyToV1(m *pb.Money) *v1.Money {
+	if m == nil {
+		return nil
+	}
+	return &v1.Money{Amount: m.Amount}
+}
+
+// weightToV2 converts v1 Weight to v2 Weight
+func weightToV2(w *v1.Weight) *pb.Weight {
+	if w == nil {
+		return nil
+	}
+	return &pb.Weight{Value: w.Value, Unit: w.Unit}
+}
+
+// weightToV1 converts v2 Weight to v1 Weight
+func weightToV1(w *pb.Weight) *v1.Weight {
+	if w == nil {
+		return nil
+	}
+	return &v1.Weight{Value: w.Value, Unit: w.Unit}
+}
+
+// dimensionsToV2 converts v1 Dimensions to v2 Dimensions
+func dimensionsToV2(d *v1.Dimensions) *pb.Dimensions {
+	if d == nil {
+		return nil
+	}
+	return &pb.Dimensions{Length: d.Length, Width: d.Width, Height: d.Height, Unit: d.Unit}
+}
+
+// dimensionsToV1 converts v2 Dimensions to v1 Dimensions
+func dimensionsToV1(d *pb.Dimensions) *v1.Dimensions {
+	if d == nil {
+		return nil
+	}
+	return &v1.Dimensions{Length: d.Length, Width: d.Width, Height: d.Height, Unit: d.Unit}
+}
+
+// complianceToV2 converts v1 Compliance to v2 Compliance
+func complianceToV2(c *v1.Compliance) *pb.Compliance {
+	if c == nil {
+		return nil
+	}
+	return &pb.Compliance{AgeRestriction: c.AgeRestriction, Hazardous: c.Hazardous, RequiresPrescription: c.RequiresPrescription}
+}
+
+// complianceToV1 converts v2 Compliance to v1 Compliance
+func complianceToV1(c *pb.Compliance) *v1.Compliance {
+	if c == nil {
+		return nil
+	}
+	return &v1.Compliance{AgeRestriction: c.AgeRestriction, Hazardous: c.Hazardous, RequiresPrescription: c.RequiresPrescription}
+}
+
+// discountToV2 converts a v1 Discount to v2
+func discountToV2(d *v1.Discount) *pb.Discount {
+	if d == nil {
+		return nil
+	}
+	return &pb.Discount{
+		Id:                 d.Id,
+		Amount:             moneyToV2(d.Amount),
+		StartTime:          d.StartDate,
+		EndTime:            d.EndDate,
+		PercentBasisPoints: d.PercentBasisPoints,
+	}
+}
+
+// discountToV1 converts a v2 Discount to v1
+func discountToV1(d *pb.Discount) *v1.Discount {
+	if d == nil {
+		return nil
+	}
+	return &v1.Discount{
+		Id:                 d.Id,
+		Amount:             moneyToV1(d.Amount),
+		StartDate:          d.StartTime,
+		EndDate:            d.EndTime,
+		PercentBasisPoints: d.PercentBasisPoints,
+	}
+}
+
+// typeToV2 converts a v1 ProductType to the v2 Type enum
+func typeToV2(t v1.ProductType) pb.Product_Type {
+	switch t {
+	case v1.ProductType_PRODUCT_TYPE_PHYSICAL:
+		return pb.Product_PHYSICAL
+	case v1.ProductType_PRODUCT_TYPE_DIGITAL:
+		return pb.Product_DIGITAL
+	case v1.ProductType_PRODUCT_TYPE_SERVICE:
+		return pb.Product_SERVICE
+	default:
+		return pb.Product_TYPE_UNSPECIFIED
+	}
+}
+
+// typeToV1 converts a v2 Type enum to the v1 ProductType
+func typeToV1(t pb.Product_Type) v1.ProductType {
+	switch t {
+	case pb.Product_PHYSICAL:
+		return v1.ProductType_PRODUCT_TYPE_PHYSICAL
+	case pb.Product_DIGITAL:
+		return v1.ProductType_PRODUCT_TYPE_DIGITAL
+	case pb.Product_SERVICE:
+		return v1.ProductType_PRODUCT_TYPE_SERVICE
+	default:
+		return v1.ProductType_PRODUCT_TYPE_UNSPECIFIED
+	}
+}
+
+// stateToV2 converts a v1 status string to the v2 State enum
+func stateToV2(status string) pb.Product_State {
+	switch status {
+	case "active":
+		return pb.Product_ACTIVE
+	case "inactive":
+		return pb.Product_INACTIVE
+	default:
+		return pb.Product_STATE_UNSPECIFIED
+	}
+}
+
+// stateToV1 converts a v2 State enum to the v1 status string
+func stateToV1(state pb.Product_State) string {
+	switch state {
+	case pb.Product_ACTIVE:
+		return "active"
+	case pb.Product_INACTIVE:
+		return "inactive"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,31 @@
+package productv2
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// collection is the resource collection segment of product names
+const collection = "products"
+
+// productName formats the resource name of a product: products/{product}
+func productName(productID string) string {
+	return collection + "/" + productID
+}
+
+// parseProductName extracts the product ID from a resource name
+func parseProductName(field, name string) (string, error) {
+	id, ok := strings.CutPrefix(name, collection+"/")
+	if !ok || id == "" || strings.Contains(id, "/") {
+		return "", invalidArgumentError(fmt.Sprintf("%s must have the form products/{product}, got %q", field, name))
+	}
+	return id, nil
+}
+
+// invalidArgumentError creates an InvalidArgument gRPC error
+func invalidArgumentError(message string) error {
+	return status.Error(codes.InvalidArgument, message)
+}
@@ -0,0 +1,38 @@
+package productv2
+
+import (
+	"context"
+
+	v1 "catalog-proj/proto/product/v1"
+	pb "catalog-proj/proto/product/v2"
+)
+
+// ActivateProduct handles the ActivateProduct gRPC request
+func (h *Handler) ActivateProduct(ctx context.Context, req *pb.ActivateProductRequest) (*pb.Product, error) {
+	id, err := parseProductName("name", req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.v1.ActivateProduct(ctx, &v1.ActivateProductRequest{ProductId: id, ReturnProduct: true})
+	if err != nil {
+		return nil, err
+	}
+
+	return productToV2(resp.Product), nil
+}
+
+// DeactivateProduct handles the DeactivateProduct gRPC request
+func (h *Handler) DeactivateProduct(ctx context.Context, req *pb.DeactivateProductRequest) (*pb.Product, error) {
+	id, err := parseProductName("name", req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.v1.DeactivateProduct(ctx, &v1.DeactivateProductRequest{ProductId: id, ReturnProduct: true})
+	if err != nil {
+		return nil, err
+	}
+
+	return productToV2(resp.Product), nil
+}
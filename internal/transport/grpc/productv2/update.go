@@ -0,0 +1,126 @@
+package productv2
+
+import (
+	"context"
+	"fmt"
+
+	v1 "catalog-proj/proto/product/v1"
+	pb "catalog-proj/proto/product/v2"
+)
+
+// updatablePaths are the update_mask paths UpdateProduct accepts
+var updatablePaths = []string{"display_name", "description", "category", "weight", "dimensions", "shipping_class", "type", "download_uri", "license_terms", "compliance"}
+
+// UpdateProduct handles the UpdateProduct gRPC request
+// An empty update_mask updates every updatable field that is set (AIP-134)
+func (h *Handler) UpdateProduct(ctx context.Context, req *pb.UpdateProductRequest) (*pb.Product, error) {
+	if req.Product == nil {
+		return nil, invalidArgumentError("product is required")
+	}
+	id, err := parseProductName("product.name", req.Product.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		paths = populatedPaths(req.Product)
+	}
+
+	v1Req := &v1.UpdateProductRequest{ProductId: id, ReturnProduct: true}
+	for _, path := range paths {
+		switch path {
+		case "*":
+			v1Req.Name = &req.Product.DisplayName
+			v1Req.Description = &req.Product.Description
+			v1Req.Category = &req.Product.Category
+			v1Req.Weight = weightToV1(req.Product.Weight)
+			v1Req.Dimensions = dimensionsToV1(req.Product.Dimensions)
+			v1Req.ShippingClass = &req.Product.ShippingClass
+			// An unspecified type leaves the type unchanged rather than failing the replace
+			if req.Product.Type != pb.Product_TYPE_UNSPECIFIED {
+				productType := typeToV1(req.Product.Type)
+				v1Req.ProductType = &productType
+			}
+			v1Req.DownloadUrl = &req.Product.DownloadUri
+			v1Req.LicenseTerms = &req.Product.LicenseTerms
+			// An unset compliance replaces the metadata with an unrestricted one
+			v1Req.Compliance = complianceOrUnrestricted(req.Product.Compliance)
+		case "display_name":
+			v1Req.Name = &req.Product.DisplayName
+		case "description":
+			v1Req.Description = &req.Product.Description
+		case "category":
+			v1Req.Category = &req.Product.Category
+		case "weight":
+			v1Req.Weight = weightToV1(req.Product.Weight)
+		case "dimensions":
+			v1Req.Dimensions = dimensionsToV1(req.Product.Dimensions)
+		case "shipping_class":
+			v1Req.ShippingClass = &req.Product.ShippingClass
+		case "type":
+			productType := typeToV1(req.Product.Type)
+			v1Req.ProductType = &productType
+		case "download_uri":
+			v1Req.DownloadUrl = &req.Product.DownloadUri
+		case "license_terms":
+			v1Req.LicenseTerms = &req.Product.LicenseTerms
+		case "compliance":
+			v1Req.Compliance = complianceOrUnrestricted(req.Product.Compliance)
+		default:
+			return nil, invalidArgumentError(fmt.Sprintf("update_mask path %q is not updatable; allowed: %v", path, updatablePaths))
+		}
+	}
+
+	resp, err := h.v1.UpdateProduct(ctx, v1Req)
+	if err != nil {
+		return nil, err
+	}
+
+	return productToV2(resp.Product), nil
+}
+
+// populatedPaths returns the updatable fields that are set on product
+func populatedPaths(product *pb.Product) []string {
+	var paths []string
+	if product.DisplayName != "" {
+		paths = append(paths, "display_name")
+	}
+	if product.Description != "" {
+		paths = append(paths, "description")
+	}
+	if product.Category != "" {
+		paths = append(paths, "category")
+	}
+	if product.Weight != nil {
+		paths = append(paths, "weight")
+	}
+	if product.Dimensions != nil {
+		paths = append(paths, "dimensions")
+	}
+	if product.ShippingClass != "" {
+		paths = append(paths, "shipping_class")
+	}
+	if product.Type != pb.Product_TYPE_UNSPECIFIED {
+		paths = append(paths, "type")
+	}
+	if product.DownloadUri != "" {
+		paths = append(paths, "download_uri")
+	}
+	if product.LicenseTerms != "" {
+		paths = append(paths, "license_terms")
+	}
+	if product.Compliance != nil {
+		paths = append(paths, "compliance")
+	}
+	return paths
+}
+
+// complianceOrUnrestricted converts compliance to v1, mapping unset to an explicit unrestricted value
+// v1 treats an unset compliance as "leave unchanged", which a masked v2 update must not do
+func complianceOrUnrestricted(c *pb.Compliance) *v1.Compliance {
+	if c == nil {
+		return &v1.Compliance{}
+	}
+	return complianceToV1(c)
+}
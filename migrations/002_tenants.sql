@@ -0,0 +1,9 @@
+-- Multi-tenancy: every product belongs to a tenant
+-- Existing rows are assigned to the "default" tenant
+ALTER TABLE products ADD COLUMN tenant_id STRING(64) NOT NULL DEFAULT ("default");
+
+-- Index for tenant-scoped listing and quota counting
+CREATE INDEX idx_products_tenant_category ON products(tenant_id, category, status) STORING (archived_at);
+
+-- Index for counting active discounts per tenant
+CREATE INDEX idx_products_tenant_discount ON products(tenant_id, discount_end_date) STORING (discount_start_date, archived_at);
@@ -0,0 +1,7 @@
+-- Optional merchant identifiers used for duplicate detection
+ALTER TABLE products ADD COLUMN sku STRING(64);
+ALTER TABLE products ADD COLUMN gtin STRING(14);
+
+-- Indexes for tenant-scoped identifier lookups
+CREATE INDEX idx_products_tenant_sku ON products(tenant_id, sku) STORING (archived_at);
+CREATE INDEX idx_products_tenant_gtin ON products(tenant_id, gtin) STORING (archived_at);
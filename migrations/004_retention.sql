@@ -0,0 +1,8 @@
+-- Data retention: products under legal hold are never purged
+ALTER TABLE products ADD COLUMN legal_hold BOOL NOT NULL DEFAULT (false);
+
+-- Index for finding archived products past the retention period
+CREATE INDEX idx_products_archived_at ON products(archived_at) STORING (tenant_id, legal_hold);
+
+-- Index for deleting a product's outbox events on purge
+CREATE INDEX idx_outbox_aggregate ON outbox_events(aggregate_id);
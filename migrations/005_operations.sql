@@ -0,0 +1,20 @@
+-- Long-running operations (google.longrunning) started by bulk RPCs
+-- updated_at doubles as a heartbeat: running operations refresh it while they make progress
+CREATE TABLE operations (
+    operation_id STRING(36) NOT NULL,
+    tenant_id STRING(64) NOT NULL,
+    kind STRING(64) NOT NULL,
+    done BOOL NOT NULL,
+    total_items INT64 NOT NULL,
+    processed_items INT64 NOT NULL,
+    failed_items INT64 NOT NULL,
+    cancel_requested BOOL NOT NULL,
+    error_code INT64,
+    error_message STRING(MAX),
+    result BYTES(MAX),
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (operation_id);
+
+-- Index for listing a tenant's operations, newest first
+CREATE INDEX idx_operations_tenant ON operations(tenant_id, created_at DESC);
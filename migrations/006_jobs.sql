@@ -0,0 +1,23 @@
+-- Async job queue: workers lease due jobs, heartbeat while running, and retry with backoff
+CREATE TABLE jobs (
+    job_id STRING(36) NOT NULL,
+    kind STRING(64) NOT NULL,
+    tenant_id STRING(64) NOT NULL,
+    payload BYTES(MAX),
+    status STRING(20) NOT NULL,
+    attempts INT64 NOT NULL,
+    max_attempts INT64 NOT NULL,
+    run_at TIMESTAMP NOT NULL,
+    unique_key STRING(128),
+    lease_owner STRING(64),
+    lease_expires_at TIMESTAMP,
+    last_error STRING(MAX),
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (job_id);
+
+-- Index for claiming due jobs
+CREATE INDEX idx_jobs_status_run_at ON jobs(status, run_at);
+
+-- At most one pending job per unique key (the key is cleared once the job starts)
+CREATE UNIQUE NULL_FILTERED INDEX idx_jobs_unique_key ON jobs(unique_key);
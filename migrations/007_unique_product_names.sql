@@ -0,0 +1,5 @@
+-- Normalized product name, set only for tenants that enforce unique names per category
+-- NULL for other tenants and for archived products, so the unique index ignores them
+ALTER TABLE products ADD COLUMN name_key STRING(255);
+
+CREATE UNIQUE NULL_FILTERED INDEX idx_products_unique_name ON products(tenant_id, category, name_key);
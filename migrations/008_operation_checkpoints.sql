@@ -0,0 +1,3 @@
+-- Where a long-running operation got to, so a failed or cancelled run can be resumed
+-- The format is specific to the operation kind (e.g. the last product ID processed)
+ALTER TABLE operations ADD COLUMN checkpoint STRING(MAX);
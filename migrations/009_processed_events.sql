@@ -0,0 +1,10 @@
+-- Inbox of events already handled by each consumer, for effectively-once processing
+-- A consumer's side effects and its processed_events row are committed in one transaction
+CREATE TABLE processed_events (
+    consumer STRING(100) NOT NULL,
+    event_id STRING(36) NOT NULL,
+    processed_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (consumer, event_id);
+
+-- Index for purging old inbox rows
+CREATE INDEX idx_processed_events_processed_at ON processed_events(processed_at);
@@ -0,0 +1,3 @@
+-- Sales channels a product is visible on ("web", "mobile_app", "marketplace")
+-- NULL or empty means the product is not published to any channel
+ALTER TABLE products ADD COLUMN channels ARRAY<STRING(32)>;
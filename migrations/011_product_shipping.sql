@@ -0,0 +1,9 @@
+-- Physical attributes for fulfilment, all optional
+-- Weight and dimensions keep the unit they were given in; a unit is set whenever its values are
+ALTER TABLE products ADD COLUMN weight_value FLOAT64;
+ALTER TABLE products ADD COLUMN weight_unit STRING(8);
+ALTER TABLE products ADD COLUMN length FLOAT64;
+ALTER TABLE products ADD COLUMN width FLOAT64;
+ALTER TABLE products ADD COLUMN height FLOAT64;
+ALTER TABLE products ADD COLUMN dimension_unit STRING(8);
+ALTER TABLE products ADD COLUMN shipping_class STRING(64);
@@ -0,0 +1,5 @@
+-- Product type and the delivery details of digital products
+-- Rows written before product types existed have a NULL type and read as physical
+ALTER TABLE products ADD COLUMN product_type STRING(16);
+ALTER TABLE products ADD COLUMN download_url STRING(2048);
+ALTER TABLE products ADD COLUMN license_terms STRING(MAX);
@@ -0,0 +1,4 @@
+-- Compliance metadata; existing products are unrestricted
+ALTER TABLE products ADD COLUMN age_restriction INT64 NOT NULL DEFAULT (0);
+ALTER TABLE products ADD COLUMN hazardous BOOL NOT NULL DEFAULT (false);
+ALTER TABLE products ADD COLUMN requires_prescription BOOL NOT NULL DEFAULT (false);
@@ -0,0 +1,8 @@
+-- Approximate list counts: product counts per tenant, category and status, refreshed periodically by a job
+CREATE TABLE product_counts (
+    tenant_id STRING(64) NOT NULL,
+    category STRING(100) NOT NULL,
+    status STRING(20) NOT NULL,
+    product_count INT64 NOT NULL,
+    computed_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (tenant_id, category, status);
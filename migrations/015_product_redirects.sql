@@ -0,0 +1,10 @@
+-- Merged products redirect to their canonical product; redirects outlive the purged duplicate
+CREATE TABLE product_redirects (
+    product_id STRING(36) NOT NULL,
+    tenant_id STRING(64) NOT NULL,
+    canonical_id STRING(36) NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (product_id);
+
+-- Index for repointing redirects when their target is merged in turn
+CREATE INDEX idx_product_redirects_canonical ON product_redirects(canonical_id);
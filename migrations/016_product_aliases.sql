@@ -0,0 +1,18 @@
+-- Aliases resolve old product references (merged product IDs, and later old slugs) to the
+-- canonical product within a tenant; they replace the merge-only product_redirects table
+CREATE TABLE product_aliases (
+    tenant_id STRING(64) NOT NULL,
+    alias STRING(255) NOT NULL,
+    canonical_id STRING(36) NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (tenant_id, alias);
+
+-- Index for repointing aliases when their target is merged in turn
+CREATE INDEX idx_product_aliases_canonical ON product_aliases(canonical_id);
+
+-- Carry the merge redirects over as aliases before their table goes
+INSERT OR IGNORE INTO product_aliases (tenant_id, alias, canonical_id, created_at)
+SELECT tenant_id, product_id, canonical_id, created_at FROM product_redirects;
+
+DROP INDEX idx_product_redirects_canonical;
+DROP TABLE product_redirects;
@@ -0,0 +1,3 @@
+-- Integrator metadata as sorted "key=value" entries; NULL or empty when the product has none
+-- Stored as an array (like channels) so ListProducts can filter on an entry with IN UNNEST
+ALTER TABLE products ADD COLUMN metadata ARRAY<STRING(MAX)>;
@@ -0,0 +1,12 @@
+-- External references map an identifier in an integrator's system (ERP code, marketplace listing ID)
+-- to a product; the key makes each reference unique per tenant and resolvable with a single read
+CREATE TABLE external_refs (
+    tenant_id STRING(64) NOT NULL,
+    system STRING(32) NOT NULL,
+    external_id STRING(255) NOT NULL,
+    product_id STRING(36) NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (tenant_id, system, external_id);
+
+-- Index for removing a product's references when it is purged
+CREATE INDEX idx_external_refs_product ON external_refs(product_id);
@@ -0,0 +1,21 @@
+-- Pending changes hold product changes that need a second approver (four-eyes); rows are kept
+-- after the decision as the audit trail of who requested and who approved or rejected each change
+CREATE TABLE pending_changes (
+    change_id STRING(36) NOT NULL,
+    tenant_id STRING(64) NOT NULL,
+    product_id STRING(36) NOT NULL,
+    kind STRING(32) NOT NULL,
+    old_price_numerator INT64 NOT NULL,
+    old_price_denominator INT64 NOT NULL,
+    new_price_numerator INT64 NOT NULL,
+    new_price_denominator INT64 NOT NULL,
+    requested_by STRING(255) NOT NULL,
+    requested_at TIMESTAMP NOT NULL,
+    status STRING(16) NOT NULL,
+    decided_by STRING(255),
+    decision_note STRING(MAX),
+    decided_at TIMESTAMP,
+) PRIMARY KEY (change_id);
+
+-- Index for finding the open changes of a product
+CREATE INDEX idx_pending_changes_product ON pending_changes(product_id, status);
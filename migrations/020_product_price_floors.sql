@@ -0,0 +1,5 @@
+-- Price floors protect the effective price: floor_price is a minimum price and cost_price with
+-- min_margin_percent a minimum margin; NULL prices and a zero margin leave the price unconstrained
+ALTER TABLE products ADD COLUMN floor_price NUMERIC;
+ALTER TABLE products ADD COLUMN cost_price NUMERIC;
+ALTER TABLE products ADD COLUMN min_margin_percent INT64 NOT NULL DEFAULT (0);
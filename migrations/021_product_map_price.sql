@@ -0,0 +1,3 @@
+-- map_price is the minimum advertised price agreed with the vendor; it limits the price shown,
+-- not the price charged, and NULL means the product has no MAP agreement
+ALTER TABLE products ADD COLUMN map_price NUMERIC;
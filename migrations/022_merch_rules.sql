@@ -0,0 +1,13 @@
+-- Merchandising rules curate search results: a pin places product_id at position for query,
+-- a boost multiplies the relevance of category's products for query (or every query when it is empty)
+CREATE TABLE merch_rules (
+    tenant_id STRING(64) NOT NULL,
+    rule_id STRING(36) NOT NULL,
+    query STRING(256) NOT NULL,
+    kind STRING(16) NOT NULL,
+    product_id STRING(36),
+    position INT64 NOT NULL,
+    category STRING(255),
+    boost FLOAT64 NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (tenant_id, rule_id);
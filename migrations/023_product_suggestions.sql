@@ -0,0 +1,10 @@
+-- Search-as-you-type suggestions: the distinct names and categories of each tenant's active products,
+-- keyed by their lowercased text for prefix scans and refreshed periodically by a job
+CREATE TABLE product_suggestions (
+    tenant_id STRING(64) NOT NULL,
+    suggest_key STRING(255) NOT NULL,
+    kind STRING(16) NOT NULL,
+    text STRING(255) NOT NULL,
+    popularity INT64 NOT NULL,
+    computed_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (tenant_id, suggest_key, kind);
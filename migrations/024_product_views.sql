@@ -0,0 +1,7 @@
+-- View counters behind popularity sorting: one row per viewed product, incremented in coalesced batches
+CREATE TABLE product_views (
+    product_id STRING(36) NOT NULL,
+    tenant_id STRING(64) NOT NULL,
+    view_count INT64 NOT NULL,
+    last_viewed_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (product_id);
@@ -0,0 +1,18 @@
+-- Trending scores: each product's views with a half-life decay, as of scored_at; maintained by the curated lists job
+CREATE TABLE product_trends (
+    product_id STRING(36) NOT NULL,
+    tenant_id STRING(64) NOT NULL,
+    score FLOAT64 NOT NULL,
+    scored_views INT64 NOT NULL,
+    scored_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (product_id);
+
+-- Curated storefront lists (new arrivals, trending) per tenant, replaced on every curated lists job run
+CREATE TABLE curated_lists (
+    tenant_id STRING(64) NOT NULL,
+    list STRING(32) NOT NULL,
+    position INT64 NOT NULL,
+    product_id STRING(36) NOT NULL,
+    score FLOAT64 NOT NULL,
+    computed_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (tenant_id, list, position);
@@ -0,0 +1,17 @@
+-- API keys authenticating machine clients; only the SHA-256 of the secret is stored
+-- revoked_at is NULL while the key is valid
+CREATE TABLE api_keys (
+    key_id STRING(36) NOT NULL,
+    tenant_id STRING(64) NOT NULL,
+    name STRING(256) NOT NULL,
+    key_hash STRING(64) NOT NULL,
+    scopes ARRAY<STRING(16)> NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP,
+) PRIMARY KEY (key_id);
+
+-- Index for authenticating a presented key with a single lookup
+CREATE UNIQUE INDEX idx_api_keys_hash ON api_keys(key_hash);
+
+-- Index for listing a tenant's keys, newest first
+CREATE INDEX idx_api_keys_tenant ON api_keys(tenant_id, created_at DESC);
@@ -0,0 +1,11 @@
+-- Per-caller usage for billing and spotting heavy integrators: one row per tenant, API key and UTC day
+-- key_id is empty for requests made without an API key
+CREATE TABLE api_usage (
+    tenant_id STRING(64) NOT NULL,
+    day TIMESTAMP NOT NULL,
+    key_id STRING(36) NOT NULL,
+    request_count INT64 NOT NULL,
+    rows_returned INT64 NOT NULL,
+    mutation_count INT64 NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (tenant_id, day, key_id);
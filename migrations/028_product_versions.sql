@@ -0,0 +1,14 @@
+-- Content versions are immutable snapshots of a product's name, description, category and metadata,
+-- written in the same commit as each change to them; rolling back writes the restored content as a new version
+-- Interleaved so a product's versions are stored with it and removed when it is purged
+CREATE TABLE product_versions (
+    product_id STRING(36) NOT NULL,
+    version_id STRING(36) NOT NULL,
+    tenant_id STRING(64) NOT NULL,
+    name STRING(255) NOT NULL,
+    description STRING(MAX) NOT NULL,
+    category STRING(100) NOT NULL,
+    metadata ARRAY<STRING(MAX)>,
+    created_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (product_id, version_id),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
@@ -0,0 +1,13 @@
+-- A draft holds a product's unpublished name, description, category and metadata
+-- Reads serve the product's published content until PublishDraft applies the draft and deletes it
+-- Interleaved so a product's draft is stored with it and removed when it is purged
+CREATE TABLE product_drafts (
+    product_id STRING(36) NOT NULL,
+    tenant_id STRING(64) NOT NULL,
+    name STRING(255) NOT NULL,
+    description STRING(MAX) NOT NULL,
+    category STRING(100) NOT NULL,
+    metadata ARRAY<STRING(MAX)>,
+    updated_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (product_id),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
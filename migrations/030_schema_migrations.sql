@@ -0,0 +1,6 @@
+-- Records which migration files have been applied, by file name without .sql
+-- Written by `go run ./cmd/migrate apply`; databases created before it have their versions inferred from the schema
+CREATE TABLE schema_migrations (
+    version STRING(255) NOT NULL,
+    applied_at TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+) PRIMARY KEY (version);
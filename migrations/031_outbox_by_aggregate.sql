@@ -0,0 +1,35 @@
+-- Outbox events move to outbox_events_by_aggregate, keyed by aggregate: a product's events are
+-- stored together and read back with a key range scan, and inserts spread across the key space
+-- instead of appending at the end of a time-ordered event_id range. created_at is the commit
+-- timestamp, so an aggregate's events sort in commit order (events of one commit by event_id).
+-- The table is keyed by, not interleaved in, products: some events have no product aggregate and
+-- a purge records its product_purged event after the product row is deleted.
+-- Spanner cannot change a primary key, so the events are copied into a new table; outbox_events
+-- is dropped by 038 once every server writes here.
+CREATE TABLE outbox_events_by_aggregate (
+    aggregate_id STRING(36) NOT NULL,
+    created_at TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+    event_id STRING(36) NOT NULL,
+    event_type STRING(100) NOT NULL,
+    payload JSON NOT NULL,
+    status STRING(20) NOT NULL,
+    processed_at TIMESTAMP,
+    shard INT64 NOT NULL AS (ABS(MOD(FARM_FINGERPRINT(event_id), 16))) STORED,
+) PRIMARY KEY (aggregate_id, created_at, event_id);
+
+-- Relays and replay read across aggregates in commit order. Leading with shard splits the index
+-- into 16 ranges, so inserts do not all land at the newest created_at
+CREATE INDEX idx_outbox_by_aggregate_shard_created ON outbox_events_by_aggregate(shard, created_at, event_id) STORING (event_type);
+
+-- Each consumer's position in commit order: feeds resume after it instead of scanning from the
+-- oldest event. processed_events still records every event, so events at the position are not repeated
+CREATE TABLE outbox_cursors (
+    consumer STRING(100) NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    event_id STRING(36) NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (consumer);
+
+-- Copy the existing events, keeping their original creation times
+INSERT OR IGNORE INTO outbox_events_by_aggregate (aggregate_id, created_at, event_id, event_type, payload, status, processed_at)
+SELECT aggregate_id, created_at, event_id, event_type, payload, status, processed_at FROM outbox_events;
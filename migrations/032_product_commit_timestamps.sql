@@ -0,0 +1,5 @@
+-- Product created_at/updated_at are written as the commit timestamp rather than the app server's
+-- clock, so they order with the outbox and are not skewed between servers.
+-- Existing values are kept; Spanner only rejects values later than the current time.
+ALTER TABLE products ALTER COLUMN created_at SET OPTIONS (allow_commit_timestamp=true);
+ALTER TABLE products ALTER COLUMN updated_at SET OPTIONS (allow_commit_timestamp=true);
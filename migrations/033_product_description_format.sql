@@ -0,0 +1,3 @@
+-- Whether a product's description is plain text or markdown
+-- Rows written before description formats existed have a NULL format and read as plain
+ALTER TABLE products ADD COLUMN description_format STRING(16);
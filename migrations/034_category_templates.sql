@@ -0,0 +1,11 @@
+-- Category templates define the attributes a category's products take; attributes holds the
+-- definitions (name, type, required, allowed values) as a JSON array in display order
+CREATE TABLE category_templates (
+    tenant_id STRING(64) NOT NULL,
+    category STRING(255) NOT NULL,
+    attributes STRING(MAX) NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (tenant_id, category);
+
+-- Product attributes as sorted "name=value" entries, like metadata
+ALTER TABLE products ADD COLUMN attributes ARRAY<STRING(MAX)>;
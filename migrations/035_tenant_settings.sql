@@ -0,0 +1,9 @@
+-- Per-tenant overrides of catalog behaviour; a zero value keeps the service default
+CREATE TABLE tenant_settings (
+    tenant_id STRING(64) NOT NULL,
+    default_currency STRING(3) NOT NULL,
+    max_name_length INT64 NOT NULL,
+    min_discount_basis_points INT64 NOT NULL,
+    max_discount_basis_points INT64 NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (tenant_id);
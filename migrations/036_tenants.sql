@@ -0,0 +1,12 @@
+-- Tenants provisioned with CreateTenant; tenants that predate provisioning have no row until deleted
+-- registration_id is the aggregate of the tenant's audit events (outbox aggregate IDs are UUIDs)
+-- and is replaced when a deleted tenant is created again
+CREATE TABLE tenants (
+    tenant_id STRING(64) NOT NULL,
+    registration_id STRING(36) NOT NULL,
+    display_name STRING(256) NOT NULL,
+    state STRING(20) NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    deleted_at TIMESTAMP,
+) PRIMARY KEY (tenant_id);
@@ -0,0 +1,6 @@
+-- The effective price (base price less any discount live at the time of the write), persisted so lists
+-- can sort and filter on it, and when it next changes as a discount starts or ends
+-- Existing rows default to the epoch, so the first effective price refresh prices them
+ALTER TABLE products ADD COLUMN effective_price NUMERIC;
+ALTER TABLE products ADD COLUMN effective_price_valid_until TIMESTAMP DEFAULT (TIMESTAMP "1970-01-01T00:00:00Z");
+CREATE NULL_FILTERED INDEX idx_products_effective_price_valid_until ON products(effective_price_valid_until);
@@ -0,0 +1,9 @@
+-- Retire the outbox_events table replaced by outbox_events_by_aggregate in 031
+-- Apply this once every server writes the new table (migrate apply -to 031_outbox_by_aggregate first,
+-- then roll out, then apply the rest): events older servers wrote after the 031 copy are copied again
+INSERT OR IGNORE INTO outbox_events_by_aggregate (aggregate_id, created_at, event_id, event_type, payload, status, processed_at)
+SELECT aggregate_id, created_at, event_id, event_type, payload, status, processed_at FROM outbox_events;
+
+DROP INDEX idx_outbox_status;
+DROP INDEX idx_outbox_aggregate;
+DROP TABLE outbox_events;
@@ -0,0 +1,3 @@
+-- Lists sorted or bounded by effective price read a tenant's products in price order
+-- With MAP enforcement the compared price is raised to map_price row by row, so only the plain order uses it
+CREATE INDEX idx_products_tenant_effective_price ON products(tenant_id, effective_price) STORING (status, archived_at, map_price);
@@ -9,6 +9,7 @@ package productv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -22,6 +23,577 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ProductType decides which fulfilment fields a product takes
+type ProductType int32
+
+const (
+	ProductType_PRODUCT_TYPE_UNSPECIFIED ProductType = 0 // Same as PHYSICAL on create
+	ProductType_PRODUCT_TYPE_PHYSICAL    ProductType = 1 // May have weight, dimensions and a shipping class
+	ProductType_PRODUCT_TYPE_DIGITAL     ProductType = 2 // Requires a download URL or license terms; no shipping details
+	ProductType_PRODUCT_TYPE_SERVICE     ProductType = 3 // Neither shipping nor delivery details
+)
+
+// Enum value maps for ProductType.
+var (
+	ProductType_name = map[int32]string{
+		0: "PRODUCT_TYPE_UNSPECIFIED",
+		1: "PRODUCT_TYPE_PHYSICAL",
+		2: "PRODUCT_TYPE_DIGITAL",
+		3: "PRODUCT_TYPE_SERVICE",
+	}
+	ProductType_value = map[string]int32{
+		"PRODUCT_TYPE_UNSPECIFIED": 0,
+		"PRODUCT_TYPE_PHYSICAL":    1,
+		"PRODUCT_TYPE_DIGITAL":     2,
+		"PRODUCT_TYPE_SERVICE":     3,
+	}
+)
+
+func (x ProductType) Enum() *ProductType {
+	p := new(ProductType)
+	*p = x
+	return p
+}
+
+func (x ProductType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProductType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_product_v1_product_service_proto_enumTypes[0].Descriptor()
+}
+
+func (ProductType) Type() protoreflect.EnumType {
+	return &file_proto_product_v1_product_service_proto_enumTypes[0]
+}
+
+func (x ProductType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProductType.Descriptor instead.
+func (ProductType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{0}
+}
+
+// DescriptionFormat is how a product's description is written
+type DescriptionFormat int32
+
+const (
+	DescriptionFormat_DESCRIPTION_FORMAT_UNSPECIFIED DescriptionFormat = 0 // Same as PLAIN on create
+	DescriptionFormat_DESCRIPTION_FORMAT_PLAIN       DescriptionFormat = 1
+	DescriptionFormat_DESCRIPTION_FORMAT_MARKDOWN    DescriptionFormat = 2 // Code blocks closed, lists nested at most 3 deep, links to http(s) or mailto only
+)
+
+// Enum value maps for DescriptionFormat.
+var (
+	DescriptionFormat_name = map[int32]string{
+		0: "DESCRIPTION_FORMAT_UNSPECIFIED",
+		1: "DESCRIPTION_FORMAT_PLAIN",
+		2: "DESCRIPTION_FORMAT_MARKDOWN",
+	}
+	DescriptionFormat_value = map[string]int32{
+		"DESCRIPTION_FORMAT_UNSPECIFIED": 0,
+		"DESCRIPTION_FORMAT_PLAIN":       1,
+		"DESCRIPTION_FORMAT_MARKDOWN":    2,
+	}
+)
+
+func (x DescriptionFormat) Enum() *DescriptionFormat {
+	p := new(DescriptionFormat)
+	*p = x
+	return p
+}
+
+func (x DescriptionFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DescriptionFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_product_v1_product_service_proto_enumTypes[1].Descriptor()
+}
+
+func (DescriptionFormat) Type() protoreflect.EnumType {
+	return &file_proto_product_v1_product_service_proto_enumTypes[1]
+}
+
+func (x DescriptionFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DescriptionFormat.Descriptor instead.
+func (DescriptionFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{1}
+}
+
+// DuplicateCheck controls how CreateProduct handles products similar to existing ones
+type DuplicateCheck int32
+
+const (
+	DuplicateCheck_DUPLICATE_CHECK_UNSPECIFIED DuplicateCheck = 0 // Same as ALLOW
+	DuplicateCheck_DUPLICATE_CHECK_ALLOW       DuplicateCheck = 1 // Skip duplicate detection
+	DuplicateCheck_DUPLICATE_CHECK_WARN        DuplicateCheck = 2 // Create and report possible duplicates
+	DuplicateCheck_DUPLICATE_CHECK_REJECT      DuplicateCheck = 3 // Fail with ALREADY_EXISTS when duplicates exist
+)
+
+// Enum value maps for DuplicateCheck.
+var (
+	DuplicateCheck_name = map[int32]string{
+		0: "DUPLICATE_CHECK_UNSPECIFIED",
+		1: "DUPLICATE_CHECK_ALLOW",
+		2: "DUPLICATE_CHECK_WARN",
+		3: "DUPLICATE_CHECK_REJECT",
+	}
+	DuplicateCheck_value = map[string]int32{
+		"DUPLICATE_CHECK_UNSPECIFIED": 0,
+		"DUPLICATE_CHECK_ALLOW":       1,
+		"DUPLICATE_CHECK_WARN":        2,
+		"DUPLICATE_CHECK_REJECT":      3,
+	}
+)
+
+func (x DuplicateCheck) Enum() *DuplicateCheck {
+	p := new(DuplicateCheck)
+	*p = x
+	return p
+}
+
+func (x DuplicateCheck) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DuplicateCheck) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_product_v1_product_service_proto_enumTypes[2].Descriptor()
+}
+
+func (DuplicateCheck) Type() protoreflect.EnumType {
+	return &file_proto_product_v1_product_service_proto_enumTypes[2]
+}
+
+func (x DuplicateCheck) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DuplicateCheck.Descriptor instead.
+func (DuplicateCheck) EnumDescriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{2}
+}
+
+// ProductView selects how much of each listed product is read and returned
+type ProductView int32
+
+const (
+	ProductView_PRODUCT_VIEW_UNSPECIFIED ProductView = 0 // Same as FULL
+	ProductView_PRODUCT_VIEW_BASIC       ProductView = 1 // id, name, category, sku, prices, discount, status, channels, product_type and timestamps only
+	ProductView_PRODUCT_VIEW_FULL        ProductView = 2 // Every field
+)
+
+// Enum value maps for ProductView.
+var (
+	ProductView_name = map[int32]string{
+		0: "PRODUCT_VIEW_UNSPECIFIED",
+		1: "PRODUCT_VIEW_BASIC",
+		2: "PRODUCT_VIEW_FULL",
+	}
+	ProductView_value = map[string]int32{
+		"PRODUCT_VIEW_UNSPECIFIED": 0,
+		"PRODUCT_VIEW_BASIC":       1,
+		"PRODUCT_VIEW_FULL":        2,
+	}
+)
+
+func (x ProductView) Enum() *ProductView {
+	p := new(ProductView)
+	*p = x
+	return p
+}
+
+func (x ProductView) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProductView) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_product_v1_product_service_proto_enumTypes[3].Descriptor()
+}
+
+func (ProductView) Type() protoreflect.EnumType {
+	return &file_proto_product_v1_product_service_proto_enumTypes[3]
+}
+
+func (x ProductView) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProductView.Descriptor instead.
+func (ProductView) EnumDescriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{3}
+}
+
+// ReviewDecision is a reviewer's verdict on a product
+type ReviewDecision int32
+
+const (
+	ReviewDecision_REVIEW_DECISION_UNSPECIFIED ReviewDecision = 0
+	ReviewDecision_REVIEW_DECISION_APPROVED    ReviewDecision = 1
+	ReviewDecision_REVIEW_DECISION_REJECTED    ReviewDecision = 2 // Requires a comment
+)
+
+// Enum value maps for ReviewDecision.
+var (
+	ReviewDecision_name = map[int32]string{
+		0: "REVIEW_DECISION_UNSPECIFIED",
+		1: "REVIEW_DECISION_APPROVED",
+		2: "REVIEW_DECISION_REJECTED",
+	}
+	ReviewDecision_value = map[string]int32{
+		"REVIEW_DECISION_UNSPECIFIED": 0,
+		"REVIEW_DECISION_APPROVED":    1,
+		"REVIEW_DECISION_REJECTED":    2,
+	}
+)
+
+func (x ReviewDecision) Enum() *ReviewDecision {
+	p := new(ReviewDecision)
+	*p = x
+	return p
+}
+
+func (x ReviewDecision) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReviewDecision) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_product_v1_product_service_proto_enumTypes[4].Descriptor()
+}
+
+func (ReviewDecision) Type() protoreflect.EnumType {
+	return &file_proto_product_v1_product_service_proto_enumTypes[4]
+}
+
+func (x ReviewDecision) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReviewDecision.Descriptor instead.
+func (ReviewDecision) EnumDescriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{4}
+}
+
+// PendingChangeStatus is the state of a change awaiting a second approver
+type PendingChangeStatus int32
+
+const (
+	PendingChangeStatus_PENDING_CHANGE_STATUS_UNSPECIFIED PendingChangeStatus = 0
+	PendingChangeStatus_PENDING_CHANGE_STATUS_PENDING     PendingChangeStatus = 1
+	PendingChangeStatus_PENDING_CHANGE_STATUS_APPROVED    PendingChangeStatus = 2
+	PendingChangeStatus_PENDING_CHANGE_STATUS_REJECTED    PendingChangeStatus = 3
+)
+
+// Enum value maps for PendingChangeStatus.
+var (
+	PendingChangeStatus_name = map[int32]string{
+		0: "PENDING_CHANGE_STATUS_UNSPECIFIED",
+		1: "PENDING_CHANGE_STATUS_PENDING",
+		2: "PENDING_CHANGE_STATUS_APPROVED",
+		3: "PENDING_CHANGE_STATUS_REJECTED",
+	}
+	PendingChangeStatus_value = map[string]int32{
+		"PENDING_CHANGE_STATUS_UNSPECIFIED": 0,
+		"PENDING_CHANGE_STATUS_PENDING":     1,
+		"PENDING_CHANGE_STATUS_APPROVED":    2,
+		"PENDING_CHANGE_STATUS_REJECTED":    3,
+	}
+)
+
+func (x PendingChangeStatus) Enum() *PendingChangeStatus {
+	p := new(PendingChangeStatus)
+	*p = x
+	return p
+}
+
+func (x PendingChangeStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PendingChangeStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_product_v1_product_service_proto_enumTypes[5].Descriptor()
+}
+
+func (PendingChangeStatus) Type() protoreflect.EnumType {
+	return &file_proto_product_v1_product_service_proto_enumTypes[5]
+}
+
+func (x PendingChangeStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PendingChangeStatus.Descriptor instead.
+func (PendingChangeStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{5}
+}
+
+// MerchRuleKind is what a merchandising rule does to search results
+type MerchRuleKind int32
+
+const (
+	MerchRuleKind_MERCH_RULE_KIND_UNSPECIFIED MerchRuleKind = 0
+	MerchRuleKind_MERCH_RULE_KIND_PIN         MerchRuleKind = 1 // Places product_id at position for query
+	MerchRuleKind_MERCH_RULE_KIND_BOOST       MerchRuleKind = 2 // Multiplies the relevance of category's products by boost
+)
+
+// Enum value maps for MerchRuleKind.
+var (
+	MerchRuleKind_name = map[int32]string{
+		0: "MERCH_RULE_KIND_UNSPECIFIED",
+		1: "MERCH_RULE_KIND_PIN",
+		2: "MERCH_RULE_KIND_BOOST",
+	}
+	MerchRuleKind_value = map[string]int32{
+		"MERCH_RULE_KIND_UNSPECIFIED": 0,
+		"MERCH_RULE_KIND_PIN":         1,
+		"MERCH_RULE_KIND_BOOST":       2,
+	}
+)
+
+func (x MerchRuleKind) Enum() *MerchRuleKind {
+	p := new(MerchRuleKind)
+	*p = x
+	return p
+}
+
+func (x MerchRuleKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MerchRuleKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_product_v1_product_service_proto_enumTypes[6].Descriptor()
+}
+
+func (MerchRuleKind) Type() protoreflect.EnumType {
+	return &file_proto_product_v1_product_service_proto_enumTypes[6]
+}
+
+func (x MerchRuleKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MerchRuleKind.Descriptor instead.
+func (MerchRuleKind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{6}
+}
+
+// AttributeType is the kind of value a template attribute takes
+type AttributeType int32
+
+const (
+	AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED AttributeType = 0
+	AttributeType_ATTRIBUTE_TYPE_TEXT        AttributeType = 1 // Any value
+	AttributeType_ATTRIBUTE_TYPE_NUMBER      AttributeType = 2 // A decimal number such as "15.6"
+	AttributeType_ATTRIBUTE_TYPE_BOOLEAN     AttributeType = 3 // "true" or "false"
+)
+
+// Enum value maps for AttributeType.
+var (
+	AttributeType_name = map[int32]string{
+		0: "ATTRIBUTE_TYPE_UNSPECIFIED",
+		1: "ATTRIBUTE_TYPE_TEXT",
+		2: "ATTRIBUTE_TYPE_NUMBER",
+		3: "ATTRIBUTE_TYPE_BOOLEAN",
+	}
+	AttributeType_value = map[string]int32{
+		"ATTRIBUTE_TYPE_UNSPECIFIED": 0,
+		"ATTRIBUTE_TYPE_TEXT":        1,
+		"ATTRIBUTE_TYPE_NUMBER":      2,
+		"ATTRIBUTE_TYPE_BOOLEAN":     3,
+	}
+)
+
+func (x AttributeType) Enum() *AttributeType {
+	p := new(AttributeType)
+	*p = x
+	return p
+}
+
+func (x AttributeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AttributeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_product_v1_product_service_proto_enumTypes[7].Descriptor()
+}
+
+func (AttributeType) Type() protoreflect.EnumType {
+	return &file_proto_product_v1_product_service_proto_enumTypes[7]
+}
+
+func (x AttributeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AttributeType.Descriptor instead.
+func (AttributeType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{7}
+}
+
+// TenantState is the provisioning state of a tenant
+type TenantState int32
+
+const (
+	TenantState_TENANT_STATE_UNSPECIFIED  TenantState = 0
+	TenantState_TENANT_STATE_PROVISIONING TenantState = 1 // Keys issued; settings and templates not yet written
+	TenantState_TENANT_STATE_ACTIVE       TenantState = 2
+	TenantState_TENANT_STATE_DELETING     TenantState = 3
+	TenantState_TENANT_STATE_DELETED      TenantState = 4 // No data left; the ID may be created again
+)
+
+// Enum value maps for TenantState.
+var (
+	TenantState_name = map[int32]string{
+		0: "TENANT_STATE_UNSPECIFIED",
+		1: "TENANT_STATE_PROVISIONING",
+		2: "TENANT_STATE_ACTIVE",
+		3: "TENANT_STATE_DELETING",
+		4: "TENANT_STATE_DELETED",
+	}
+	TenantState_value = map[string]int32{
+		"TENANT_STATE_UNSPECIFIED":  0,
+		"TENANT_STATE_PROVISIONING": 1,
+		"TENANT_STATE_ACTIVE":       2,
+		"TENANT_STATE_DELETING":     3,
+		"TENANT_STATE_DELETED":      4,
+	}
+)
+
+func (x TenantState) Enum() *TenantState {
+	p := new(TenantState)
+	*p = x
+	return p
+}
+
+func (x TenantState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TenantState) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_product_v1_product_service_proto_enumTypes[8].Descriptor()
+}
+
+func (TenantState) Type() protoreflect.EnumType {
+	return &file_proto_product_v1_product_service_proto_enumTypes[8]
+}
+
+func (x TenantState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TenantState.Descriptor instead.
+func (TenantState) EnumDescriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{8}
+}
+
+// SuggestionKind is what a suggestion completes to
+type SuggestionKind int32
+
+const (
+	SuggestionKind_SUGGESTION_KIND_UNSPECIFIED SuggestionKind = 0
+	SuggestionKind_SUGGESTION_KIND_NAME        SuggestionKind = 1
+	SuggestionKind_SUGGESTION_KIND_CATEGORY    SuggestionKind = 2
+)
+
+// Enum value maps for SuggestionKind.
+var (
+	SuggestionKind_name = map[int32]string{
+		0: "SUGGESTION_KIND_UNSPECIFIED",
+		1: "SUGGESTION_KIND_NAME",
+		2: "SUGGESTION_KIND_CATEGORY",
+	}
+	SuggestionKind_value = map[string]int32{
+		"SUGGESTION_KIND_UNSPECIFIED": 0,
+		"SUGGESTION_KIND_NAME":        1,
+		"SUGGESTION_KIND_CATEGORY":    2,
+	}
+)
+
+func (x SuggestionKind) Enum() *SuggestionKind {
+	p := new(SuggestionKind)
+	*p = x
+	return p
+}
+
+func (x SuggestionKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SuggestionKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_product_v1_product_service_proto_enumTypes[9].Descriptor()
+}
+
+func (SuggestionKind) Type() protoreflect.EnumType {
+	return &file_proto_product_v1_product_service_proto_enumTypes[9]
+}
+
+func (x SuggestionKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SuggestionKind.Descriptor instead.
+func (SuggestionKind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{9}
+}
+
+// ApiKeyScope is a permission granted to an API key; admin implies write, and write implies read
+type ApiKeyScope int32
+
+const (
+	ApiKeyScope_API_KEY_SCOPE_UNSPECIFIED ApiKeyScope = 0
+	ApiKeyScope_API_KEY_SCOPE_READ        ApiKeyScope = 1
+	ApiKeyScope_API_KEY_SCOPE_WRITE       ApiKeyScope = 2
+	ApiKeyScope_API_KEY_SCOPE_ADMIN       ApiKeyScope = 3
+)
+
+// Enum value maps for ApiKeyScope.
+var (
+	ApiKeyScope_name = map[int32]string{
+		0: "API_KEY_SCOPE_UNSPECIFIED",
+		1: "API_KEY_SCOPE_READ",
+		2: "API_KEY_SCOPE_WRITE",
+		3: "API_KEY_SCOPE_ADMIN",
+	}
+	ApiKeyScope_value = map[string]int32{
+		"API_KEY_SCOPE_UNSPECIFIED": 0,
+		"API_KEY_SCOPE_READ":        1,
+		"API_KEY_SCOPE_WRITE":       2,
+		"API_KEY_SCOPE_ADMIN":       3,
+	}
+)
+
+func (x ApiKeyScope) Enum() *ApiKeyScope {
+	p := new(ApiKeyScope)
+	*p = x
+	return p
+}
+
+func (x ApiKeyScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApiKeyScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_product_v1_product_service_proto_enumTypes[10].Descriptor()
+}
+
+func (ApiKeyScope) Type() protoreflect.EnumType {
+	return &file_proto_product_v1_product_service_proto_enumTypes[10]
+}
+
+func (x ApiKeyScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApiKeyScope.Descriptor instead.
+func (ApiKeyScope) EnumDescriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{10}
+}
+
 // Money represents a monetary value
 type Money struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -70,13 +642,18 @@ func (x *Money) GetAmount() int64 {
 
 // Discount represents a discount value object
 type Discount struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Amount        *Money                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"` // Percentage as decimal (e.g., 0.10 = 10%)
-	StartDate     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Deprecated: whole percent (e.g., 10 = 10%); use percent_basis_points
+	//
+	// Deprecated: Marked as deprecated in proto/product/v1/product_service.proto.
+	Amount    *Money                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	StartDate *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Discount in basis points, 0-10000 (e.g., 1000 = 10%, 1250 = 12.5%); takes precedence over amount
+	PercentBasisPoints *int32 `protobuf:"varint,5,opt,name=percent_basis_points,json=percentBasisPoints,proto3,oneof" json:"percent_basis_points,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *Discount) Reset() {
@@ -116,6 +693,7 @@ func (x *Discount) GetId() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in proto/product/v1/product_service.proto.
 func (x *Discount) GetAmount() *Money {
 	if x != nil {
 		return x.Amount
@@ -137,22 +715,46 @@ func (x *Discount) GetEndDate() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Discount) GetPercentBasisPoints() int32 {
+	if x != nil && x.PercentBasisPoints != nil {
+		return *x.PercentBasisPoints
+	}
+	return 0
+}
+
 // Product represents a product entity
 type Product struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Category       string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
-	BasePrice      *Money                 `protobuf:"bytes,5,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
-	EffectivePrice *Money                 `protobuf:"bytes,6,opt,name=effective_price,json=effectivePrice,proto3" json:"effective_price,omitempty"` // Calculated price after discount
-	Discount       *Discount              `protobuf:"bytes,7,opt,name=discount,proto3" json:"discount,omitempty"`
-	Status         string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"` // "active" or "inactive"
-	ArchivedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description       string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Category          string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	BasePrice         *Money                 `protobuf:"bytes,5,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	EffectivePrice    *Money                 `protobuf:"bytes,6,opt,name=effective_price,json=effectivePrice,proto3" json:"effective_price,omitempty"` // Calculated price after discount, raised to the MAP when map_applied
+	Discount          *Discount              `protobuf:"bytes,7,opt,name=discount,proto3" json:"discount,omitempty"`
+	Status            string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"` // "active" or "inactive"
+	ArchivedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Sku               string                 `protobuf:"bytes,12,opt,name=sku,proto3" json:"sku,omitempty"`                                          // Merchant stock keeping unit (optional)
+	Gtin              string                 `protobuf:"bytes,13,opt,name=gtin,proto3" json:"gtin,omitempty"`                                        // GTIN-8/12/13/14 (optional)
+	LegalHold         bool                   `protobuf:"varint,14,opt,name=legal_hold,json=legalHold,proto3" json:"legal_hold,omitempty"`            // Exempt from retention purges
+	Channels          []string               `protobuf:"bytes,15,rep,name=channels,proto3" json:"channels,omitempty"`                                // Sales channels the product is visible on ("web", "mobile_app", "marketplace")
+	Weight            *Weight                `protobuf:"bytes,16,opt,name=weight,proto3" json:"weight,omitempty"`                                    // Unset when unknown
+	Dimensions        *Dimensions            `protobuf:"bytes,17,opt,name=dimensions,proto3" json:"dimensions,omitempty"`                            // Unset when unknown
+	ShippingClass     string                 `protobuf:"bytes,18,opt,name=shipping_class,json=shippingClass,proto3" json:"shipping_class,omitempty"` // e.g. "standard", "oversized" (optional)
+	ProductType       ProductType            `protobuf:"varint,19,opt,name=product_type,json=productType,proto3,enum=product.v1.ProductType" json:"product_type,omitempty"`
+	DownloadUrl       string                 `protobuf:"bytes,20,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`                                                  // Digital products only
+	LicenseTerms      string                 `protobuf:"bytes,21,opt,name=license_terms,json=licenseTerms,proto3" json:"license_terms,omitempty"`                                               // Digital products only
+	Compliance        *Compliance            `protobuf:"bytes,22,opt,name=compliance,proto3" json:"compliance,omitempty"`                                                                       // Unset when unrestricted
+	Metadata          map[string]string      `protobuf:"bytes,23,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Integrator key/value data such as external IDs
+	PriceFloor        *PriceFloor            `protobuf:"bytes,24,opt,name=price_floor,json=priceFloor,proto3" json:"price_floor,omitempty"`                                                     // Unset when the price is unconstrained
+	MapApplied        bool                   `protobuf:"varint,25,opt,name=map_applied,json=mapApplied,proto3" json:"map_applied,omitempty"`                                                    // effective_price shows the minimum advertised price instead of the lower price charged
+	DescriptionFormat DescriptionFormat      `protobuf:"varint,26,opt,name=description_format,json=descriptionFormat,proto3,enum=product.v1.DescriptionFormat" json:"description_format,omitempty"`
+	DescriptionHtml   string                 `protobuf:"bytes,27,opt,name=description_html,json=descriptionHtml,proto3" json:"description_html,omitempty"`                                          // The description rendered as sanitized HTML; set when render_description_html was
+	Attributes        map[string]string      `protobuf:"bytes,28,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Descriptive properties such as color, checked against the category's template
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Product) Reset() {
@@ -262,20 +864,403 @@ func (x *Product) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-// CreateProductRequest represents the request to create a product
-type CreateProductRequest struct {
+func (x *Product) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *Product) GetGtin() string {
+	if x != nil {
+		return x.Gtin
+	}
+	return ""
+}
+
+func (x *Product) GetLegalHold() bool {
+	if x != nil {
+		return x.LegalHold
+	}
+	return false
+}
+
+func (x *Product) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *Product) GetWeight() *Weight {
+	if x != nil {
+		return x.Weight
+	}
+	return nil
+}
+
+func (x *Product) GetDimensions() *Dimensions {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+func (x *Product) GetShippingClass() string {
+	if x != nil {
+		return x.ShippingClass
+	}
+	return ""
+}
+
+func (x *Product) GetProductType() ProductType {
+	if x != nil {
+		return x.ProductType
+	}
+	return ProductType_PRODUCT_TYPE_UNSPECIFIED
+}
+
+func (x *Product) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *Product) GetLicenseTerms() string {
+	if x != nil {
+		return x.LicenseTerms
+	}
+	return ""
+}
+
+func (x *Product) GetCompliance() *Compliance {
+	if x != nil {
+		return x.Compliance
+	}
+	return nil
+}
+
+func (x *Product) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Product) GetPriceFloor() *PriceFloor {
+	if x != nil {
+		return x.PriceFloor
+	}
+	return nil
+}
+
+func (x *Product) GetMapApplied() bool {
+	if x != nil {
+		return x.MapApplied
+	}
+	return false
+}
+
+func (x *Product) GetDescriptionFormat() DescriptionFormat {
+	if x != nil {
+		return x.DescriptionFormat
+	}
+	return DescriptionFormat_DESCRIPTION_FORMAT_UNSPECIFIED
+}
+
+func (x *Product) GetDescriptionHtml() string {
+	if x != nil {
+		return x.DescriptionHtml
+	}
+	return ""
+}
+
+func (x *Product) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// PriceFloor protects the effective price, after any discount, from dropping too low
+type PriceFloor struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	MinPrice         *Money                 `protobuf:"bytes,1,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`                            // Lowest allowed effective price
+	Cost             *Money                 `protobuf:"bytes,2,opt,name=cost,proto3" json:"cost,omitempty"`                                                    // Effective prices below cost are refused
+	MinMarginPercent int64                  `protobuf:"varint,3,opt,name=min_margin_percent,json=minMarginPercent,proto3" json:"min_margin_percent,omitempty"` // 0-99; requires cost, refuses prices below cost / (1 - margin)
+	MapPrice         *Money                 `protobuf:"bytes,4,opt,name=map_price,json=mapPrice,proto3" json:"map_price,omitempty"`                            // Minimum advertised price; limits the price shown, not the price charged
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PriceFloor) Reset() {
+	*x = PriceFloor{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceFloor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceFloor) ProtoMessage() {}
+
+func (x *PriceFloor) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceFloor.ProtoReflect.Descriptor instead.
+func (*PriceFloor) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PriceFloor) GetMinPrice() *Money {
+	if x != nil {
+		return x.MinPrice
+	}
+	return nil
+}
+
+func (x *PriceFloor) GetCost() *Money {
+	if x != nil {
+		return x.Cost
+	}
+	return nil
+}
+
+func (x *PriceFloor) GetMinMarginPercent() int64 {
+	if x != nil {
+		return x.MinMarginPercent
+	}
+	return 0
+}
+
+func (x *PriceFloor) GetMapPrice() *Money {
+	if x != nil {
+		return x.MapPrice
+	}
+	return nil
+}
+
+// Compliance is the regulatory metadata that decides where a product may be offered
+type Compliance struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	AgeRestriction       int32                  `protobuf:"varint,1,opt,name=age_restriction,json=ageRestriction,proto3" json:"age_restriction,omitempty"` // Minimum buyer age in years (0-21), 0 for none
+	Hazardous            bool                   `protobuf:"varint,2,opt,name=hazardous,proto3" json:"hazardous,omitempty"`
+	RequiresPrescription bool                   `protobuf:"varint,3,opt,name=requires_prescription,json=requiresPrescription,proto3" json:"requires_prescription,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Compliance) Reset() {
+	*x = Compliance{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Compliance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Compliance) ProtoMessage() {}
+
+func (x *Compliance) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Compliance.ProtoReflect.Descriptor instead.
+func (*Compliance) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Compliance) GetAgeRestriction() int32 {
+	if x != nil {
+		return x.AgeRestriction
+	}
+	return 0
+}
+
+func (x *Compliance) GetHazardous() bool {
+	if x != nil {
+		return x.Hazardous
+	}
+	return false
+}
+
+func (x *Compliance) GetRequiresPrescription() bool {
+	if x != nil {
+		return x.RequiresPrescription
+	}
+	return false
+}
+
+// Weight is a product's shipping weight
+type Weight struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
-	BasePrice     *Money                 `protobuf:"bytes,4,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	Value         float64                `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"` // Non-negative
+	Unit          string                 `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"`     // "g", "kg", "oz" or "lb"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Weight) Reset() {
+	*x = Weight{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Weight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Weight) ProtoMessage() {}
+
+func (x *Weight) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Weight.ProtoReflect.Descriptor instead.
+func (*Weight) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Weight) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Weight) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+// Dimensions are a product's package dimensions
+type Dimensions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Length        float64                `protobuf:"fixed64,1,opt,name=length,proto3" json:"length,omitempty"` // Non-negative, like width and height
+	Width         float64                `protobuf:"fixed64,2,opt,name=width,proto3" json:"width,omitempty"`
+	Height        float64                `protobuf:"fixed64,3,opt,name=height,proto3" json:"height,omitempty"`
+	Unit          string                 `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"` // "mm", "cm", "m" or "in"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *Dimensions) Reset() {
+	*x = Dimensions{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Dimensions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Dimensions) ProtoMessage() {}
+
+func (x *Dimensions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Dimensions.ProtoReflect.Descriptor instead.
+func (*Dimensions) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Dimensions) GetLength() float64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *Dimensions) GetWidth() float64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Dimensions) GetHeight() float64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Dimensions) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+// CreateProductRequest represents the request to create a product
+type CreateProductRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description       string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Category          string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	BasePrice         *Money                 `protobuf:"bytes,4,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	Sku               string                 `protobuf:"bytes,5,opt,name=sku,proto3" json:"sku,omitempty"`
+	Gtin              string                 `protobuf:"bytes,6,opt,name=gtin,proto3" json:"gtin,omitempty"`
+	DuplicateCheck    DuplicateCheck         `protobuf:"varint,7,opt,name=duplicate_check,json=duplicateCheck,proto3,enum=product.v1.DuplicateCheck" json:"duplicate_check,omitempty"`
+	Weight            *Weight                `protobuf:"bytes,8,opt,name=weight,proto3" json:"weight,omitempty"`
+	Dimensions        *Dimensions            `protobuf:"bytes,9,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
+	ShippingClass     string                 `protobuf:"bytes,10,opt,name=shipping_class,json=shippingClass,proto3" json:"shipping_class,omitempty"`
+	ProductType       ProductType            `protobuf:"varint,11,opt,name=product_type,json=productType,proto3,enum=product.v1.ProductType" json:"product_type,omitempty"`
+	DownloadUrl       string                 `protobuf:"bytes,12,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
+	LicenseTerms      string                 `protobuf:"bytes,13,opt,name=license_terms,json=licenseTerms,proto3" json:"license_terms,omitempty"`
+	Compliance        *Compliance            `protobuf:"bytes,14,opt,name=compliance,proto3" json:"compliance,omitempty"`                             // Unset for an unrestricted product
+	ReturnProduct     bool                   `protobuf:"varint,15,opt,name=return_product,json=returnProduct,proto3" json:"return_product,omitempty"` // Return the product as committed in the response
+	DescriptionFormat DescriptionFormat      `protobuf:"varint,16,opt,name=description_format,json=descriptionFormat,proto3,enum=product.v1.DescriptionFormat" json:"description_format,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
 func (x *CreateProductRequest) Reset() {
 	*x = CreateProductRequest{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[3]
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -287,7 +1272,7 @@ func (x *CreateProductRequest) String() string {
 func (*CreateProductRequest) ProtoMessage() {}
 
 func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[3]
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -300,60 +1285,8497 @@ func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
 func (*CreateProductRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{3}
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateProductRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetBasePrice() *Money {
+	if x != nil {
+		return x.BasePrice
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetGtin() string {
+	if x != nil {
+		return x.Gtin
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetDuplicateCheck() DuplicateCheck {
+	if x != nil {
+		return x.DuplicateCheck
+	}
+	return DuplicateCheck_DUPLICATE_CHECK_UNSPECIFIED
+}
+
+func (x *CreateProductRequest) GetWeight() *Weight {
+	if x != nil {
+		return x.Weight
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetDimensions() *Dimensions {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetShippingClass() string {
+	if x != nil {
+		return x.ShippingClass
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetProductType() ProductType {
+	if x != nil {
+		return x.ProductType
+	}
+	return ProductType_PRODUCT_TYPE_UNSPECIFIED
+}
+
+func (x *CreateProductRequest) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetLicenseTerms() string {
+	if x != nil {
+		return x.LicenseTerms
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetCompliance() *Compliance {
+	if x != nil {
+		return x.Compliance
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetReturnProduct() bool {
+	if x != nil {
+		return x.ReturnProduct
+	}
+	return false
+}
+
+func (x *CreateProductRequest) GetDescriptionFormat() DescriptionFormat {
+	if x != nil {
+		return x.DescriptionFormat
+	}
+	return DescriptionFormat_DESCRIPTION_FORMAT_UNSPECIFIED
+}
+
+// CreateProductResponse represents the response from creating a product
+type CreateProductResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ProductId            string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	PossibleDuplicateIds []string               `protobuf:"bytes,2,rep,name=possible_duplicate_ids,json=possibleDuplicateIds,proto3" json:"possible_duplicate_ids,omitempty"` // Set when duplicate_check is WARN
+	Product              *Product               `protobuf:"bytes,3,opt,name=product,proto3" json:"product,omitempty"`                                                         // Set when return_product was
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CreateProductResponse) Reset() {
+	*x = CreateProductResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductResponse) ProtoMessage() {}
+
+func (x *CreateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductResponse.ProtoReflect.Descriptor instead.
+func (*CreateProductResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateProductResponse) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CreateProductResponse) GetPossibleDuplicateIds() []string {
+	if x != nil {
+		return x.PossibleDuplicateIds
+	}
+	return nil
+}
+
+func (x *CreateProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// UpdateProductRequest represents the request to update a product
+type UpdateProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Category      *string                `protobuf:"bytes,4,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	Weight        *Weight                `protobuf:"bytes,5,opt,name=weight,proto3" json:"weight,omitempty"`
+	Dimensions    *Dimensions            `protobuf:"bytes,6,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
+	ShippingClass *string                `protobuf:"bytes,7,opt,name=shipping_class,json=shippingClass,proto3,oneof" json:"shipping_class,omitempty"` // "" clears the shipping class
+	// Changing the type drops the shipping or delivery fields the new type does not take
+	ProductType       *ProductType       `protobuf:"varint,8,opt,name=product_type,json=productType,proto3,enum=product.v1.ProductType,oneof" json:"product_type,omitempty"`
+	DownloadUrl       *string            `protobuf:"bytes,9,opt,name=download_url,json=downloadUrl,proto3,oneof" json:"download_url,omitempty"`                                                       // "" clears the download URL
+	LicenseTerms      *string            `protobuf:"bytes,10,opt,name=license_terms,json=licenseTerms,proto3,oneof" json:"license_terms,omitempty"`                                                   // "" clears the license terms
+	Compliance        *Compliance        `protobuf:"bytes,11,opt,name=compliance,proto3" json:"compliance,omitempty"`                                                                                 // Replaces all compliance metadata when set
+	ReturnProduct     bool               `protobuf:"varint,12,opt,name=return_product,json=returnProduct,proto3" json:"return_product,omitempty"`                                                     // Return the product as committed in the response
+	DescriptionFormat *DescriptionFormat `protobuf:"varint,13,opt,name=description_format,json=descriptionFormat,proto3,enum=product.v1.DescriptionFormat,oneof" json:"description_format,omitempty"` // Checked against the new description when both are set
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateProductRequest) Reset() {
+	*x = UpdateProductRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductRequest) ProtoMessage() {}
+
+func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetWeight() *Weight {
+	if x != nil {
+		return x.Weight
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest) GetDimensions() *Dimensions {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest) GetShippingClass() string {
+	if x != nil && x.ShippingClass != nil {
+		return *x.ShippingClass
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetProductType() ProductType {
+	if x != nil && x.ProductType != nil {
+		return *x.ProductType
+	}
+	return ProductType_PRODUCT_TYPE_UNSPECIFIED
+}
+
+func (x *UpdateProductRequest) GetDownloadUrl() string {
+	if x != nil && x.DownloadUrl != nil {
+		return *x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetLicenseTerms() string {
+	if x != nil && x.LicenseTerms != nil {
+		return *x.LicenseTerms
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetCompliance() *Compliance {
+	if x != nil {
+		return x.Compliance
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest) GetReturnProduct() bool {
+	if x != nil {
+		return x.ReturnProduct
+	}
+	return false
+}
+
+func (x *UpdateProductRequest) GetDescriptionFormat() DescriptionFormat {
+	if x != nil && x.DescriptionFormat != nil {
+		return *x.DescriptionFormat
+	}
+	return DescriptionFormat_DESCRIPTION_FORMAT_UNSPECIFIED
+}
+
+// UpdateProductResponse represents the response from updating a product
+type UpdateProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Product       *Product               `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"` // Set when return_product was
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductResponse) Reset() {
+	*x = UpdateProductResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductResponse) ProtoMessage() {}
+
+func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProductResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateProductResponse) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *UpdateProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// GetProductRequest represents the request to get a product
+type GetProductRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	ProductId             string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	PreviewDraft          bool                   `protobuf:"varint,2,opt,name=preview_draft,json=previewDraft,proto3" json:"preview_draft,omitempty"`                              // Show the product's unpublished draft content, if any; needs the write scope or preview_token
+	PreviewToken          string                 `protobuf:"bytes,3,opt,name=preview_token,json=previewToken,proto3" json:"preview_token,omitempty"`                               // From GeneratePreviewToken; implies preview_draft
+	RenderDescriptionHtml bool                   `protobuf:"varint,4,opt,name=render_description_html,json=renderDescriptionHtml,proto3" json:"render_description_html,omitempty"` // Set product.description_html
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetProductRequest) Reset() {
+	*x = GetProductRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductRequest) ProtoMessage() {}
+
+func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
+func (*GetProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetProductRequest) GetPreviewDraft() bool {
+	if x != nil {
+		return x.PreviewDraft
+	}
+	return false
+}
+
+func (x *GetProductRequest) GetPreviewToken() string {
+	if x != nil {
+		return x.PreviewToken
+	}
+	return ""
+}
+
+func (x *GetProductRequest) GetRenderDescriptionHtml() bool {
+	if x != nil {
+		return x.RenderDescriptionHtml
+	}
+	return false
+}
+
+// GetProductResponse represents the response from getting a product
+type GetProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	AliasedFrom   string                 `protobuf:"bytes,2,opt,name=aliased_from,json=aliasedFrom,proto3" json:"aliased_from,omitempty"` // The requested ID when it is an alias of product, e.g. a merged duplicate
+	Draft         bool                   `protobuf:"varint,3,opt,name=draft,proto3" json:"draft,omitempty"`                               // The name, description, category and metadata are the unpublished draft
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductResponse) Reset() {
+	*x = GetProductResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductResponse) ProtoMessage() {}
+
+func (x *GetProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductResponse.ProtoReflect.Descriptor instead.
+func (*GetProductResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *GetProductResponse) GetAliasedFrom() string {
+	if x != nil {
+		return x.AliasedFrom
+	}
+	return ""
+}
+
+func (x *GetProductResponse) GetDraft() bool {
+	if x != nil {
+		return x.Draft
+	}
+	return false
+}
+
+// ListProductsRequest represents the request to list products
+type ListProductsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Category *string                `protobuf:"bytes,1,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	Status   *string                `protobuf:"bytes,2,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Limit    int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset   int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	Channel  *string                `protobuf:"bytes,5,opt,name=channel,proto3,oneof" json:"channel,omitempty"` // Only products visible on this channel
+	// Compliance filters exclude products a market or channel cannot sell
+	MaxAgeRestriction   *int32 `protobuf:"varint,6,opt,name=max_age_restriction,json=maxAgeRestriction,proto3,oneof" json:"max_age_restriction,omitempty"` // Only products whose age restriction is at most this
+	ExcludeHazardous    bool   `protobuf:"varint,7,opt,name=exclude_hazardous,json=excludeHazardous,proto3" json:"exclude_hazardous,omitempty"`
+	ExcludePrescription bool   `protobuf:"varint,8,opt,name=exclude_prescription,json=excludePrescription,proto3" json:"exclude_prescription,omitempty"`
+	SkipTotal           bool   `protobuf:"varint,9,opt,name=skip_total,json=skipTotal,proto3" json:"skip_total,omitempty"` // Skip counting matches; total is then 0, use has_more to page
+	// Read total from periodically refreshed per-category counts; ignores channel, compliance and effective price filters
+	ApproximateTotal bool `protobuf:"varint,10,opt,name=approximate_total,json=approximateTotal,proto3" json:"approximate_total,omitempty"`
+	// Only products whose metadata maps metadata_key to metadata_value
+	MetadataKey   *string `protobuf:"bytes,11,opt,name=metadata_key,json=metadataKey,proto3,oneof" json:"metadata_key,omitempty"`
+	MetadataValue string  `protobuf:"bytes,12,opt,name=metadata_value,json=metadataValue,proto3" json:"metadata_value,omitempty"`
+	// "created_at" (newest first, the default), "popularity" (most viewed first),
+	// "effective_price" (cheapest first) or "effective_price_desc" (dearest first)
+	OrderBy string      `protobuf:"bytes,13,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	View    ProductView `protobuf:"varint,14,opt,name=view,proto3,enum=product.v1.ProductView" json:"view,omitempty"` // How much of each product is returned; FULL when unspecified
+	// Lift the guard on deep pages without a category filter; admin keys only, for exports
+	AllowFullScan bool `protobuf:"varint,15,opt,name=allow_full_scan,json=allowFullScan,proto3" json:"allow_full_scan,omitempty"`
+	// Only products whose effective price is at least min_effective_price and at most max_effective_price
+	// Prices are compared as stored, so a discount starting or ending counts once the effective price refresh has run
+	MinEffectivePrice *Money `protobuf:"bytes,16,opt,name=min_effective_price,json=minEffectivePrice,proto3" json:"min_effective_price,omitempty"`
+	MaxEffectivePrice *Money `protobuf:"bytes,17,opt,name=max_effective_price,json=maxEffectivePrice,proto3" json:"max_effective_price,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListProductsRequest) Reset() {
+	*x = ListProductsRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsRequest) ProtoMessage() {}
+
+func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListProductsRequest) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetChannel() string {
+	if x != nil && x.Channel != nil {
+		return *x.Channel
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetMaxAgeRestriction() int32 {
+	if x != nil && x.MaxAgeRestriction != nil {
+		return *x.MaxAgeRestriction
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetExcludeHazardous() bool {
+	if x != nil {
+		return x.ExcludeHazardous
+	}
+	return false
+}
+
+func (x *ListProductsRequest) GetExcludePrescription() bool {
+	if x != nil {
+		return x.ExcludePrescription
+	}
+	return false
+}
+
+func (x *ListProductsRequest) GetSkipTotal() bool {
+	if x != nil {
+		return x.SkipTotal
+	}
+	return false
+}
+
+func (x *ListProductsRequest) GetApproximateTotal() bool {
+	if x != nil {
+		return x.ApproximateTotal
+	}
+	return false
+}
+
+func (x *ListProductsRequest) GetMetadataKey() string {
+	if x != nil && x.MetadataKey != nil {
+		return *x.MetadataKey
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetMetadataValue() string {
+	if x != nil {
+		return x.MetadataValue
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetView() ProductView {
+	if x != nil {
+		return x.View
+	}
+	return ProductView_PRODUCT_VIEW_UNSPECIFIED
+}
+
+func (x *ListProductsRequest) GetAllowFullScan() bool {
+	if x != nil {
+		return x.AllowFullScan
+	}
+	return false
+}
+
+func (x *ListProductsRequest) GetMinEffectivePrice() *Money {
+	if x != nil {
+		return x.MinEffectivePrice
+	}
+	return nil
+}
+
+func (x *ListProductsRequest) GetMaxEffectivePrice() *Money {
+	if x != nil {
+		return x.MaxEffectivePrice
+	}
+	return nil
+}
+
+// ListProductsResponse represents the response from listing products
+type ListProductsResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Products         []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	Total            int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`                                               // 0 when skip_total was set
+	HasMore          bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`                            // More products follow this page
+	TotalApproximate bool                   `protobuf:"varint,4,opt,name=total_approximate,json=totalApproximate,proto3" json:"total_approximate,omitempty"` // total came from the cached counts and may be stale
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListProductsResponse) Reset() {
+	*x = ListProductsResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsResponse) ProtoMessage() {}
+
+func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ListProductsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListProductsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *ListProductsResponse) GetTotalApproximate() bool {
+	if x != nil {
+		return x.TotalApproximate
+	}
+	return false
+}
+
+// ApplyDiscountRequest represents the request to apply a discount
+type ApplyDiscountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Discount      *Discount              `protobuf:"bytes,2,opt,name=discount,proto3" json:"discount,omitempty"`
+	ReturnProduct bool                   `protobuf:"varint,3,opt,name=return_product,json=returnProduct,proto3" json:"return_product,omitempty"` // Return the product as committed in the response
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyDiscountRequest) Reset() {
+	*x = ApplyDiscountRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyDiscountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyDiscountRequest) ProtoMessage() {}
+
+func (x *ApplyDiscountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyDiscountRequest.ProtoReflect.Descriptor instead.
+func (*ApplyDiscountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ApplyDiscountRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ApplyDiscountRequest) GetDiscount() *Discount {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *ApplyDiscountRequest) GetReturnProduct() bool {
+	if x != nil {
+		return x.ReturnProduct
+	}
+	return false
+}
+
+// ApplyDiscountResponse represents the response from applying a discount
+type ApplyDiscountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Product       *Product               `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"` // Set when return_product was
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyDiscountResponse) Reset() {
+	*x = ApplyDiscountResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyDiscountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyDiscountResponse) ProtoMessage() {}
+
+func (x *ApplyDiscountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyDiscountResponse.ProtoReflect.Descriptor instead.
+func (*ApplyDiscountResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ApplyDiscountResponse) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ApplyDiscountResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// RemoveDiscountRequest represents the request to remove a discount
+type RemoveDiscountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ReturnProduct bool                   `protobuf:"varint,2,opt,name=return_product,json=returnProduct,proto3" json:"return_product,omitempty"` // Return the product as committed in the response
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDiscountRequest) Reset() {
+	*x = RemoveDiscountRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDiscountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDiscountRequest) ProtoMessage() {}
+
+func (x *RemoveDiscountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDiscountRequest.ProtoReflect.Descriptor instead.
+func (*RemoveDiscountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RemoveDiscountRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *RemoveDiscountRequest) GetReturnProduct() bool {
+	if x != nil {
+		return x.ReturnProduct
+	}
+	return false
+}
+
+// RemoveDiscountResponse represents the response from removing a discount
+type RemoveDiscountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Product       *Product               `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"` // Set when return_product was
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDiscountResponse) Reset() {
+	*x = RemoveDiscountResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDiscountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDiscountResponse) ProtoMessage() {}
+
+func (x *RemoveDiscountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDiscountResponse.ProtoReflect.Descriptor instead.
+func (*RemoveDiscountResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RemoveDiscountResponse) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *RemoveDiscountResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// ActivateProductRequest represents the request to activate a product
+type ActivateProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ReturnProduct bool                   `protobuf:"varint,2,opt,name=return_product,json=returnProduct,proto3" json:"return_product,omitempty"` // Return the product as committed in the response
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateProductRequest) Reset() {
+	*x = ActivateProductRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateProductRequest) ProtoMessage() {}
+
+func (x *ActivateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateProductRequest.ProtoReflect.Descriptor instead.
+func (*ActivateProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ActivateProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ActivateProductRequest) GetReturnProduct() bool {
+	if x != nil {
+		return x.ReturnProduct
+	}
+	return false
+}
+
+// ActivateProductResponse represents the response from activating a product
+type ActivateProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Product       *Product               `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"` // Set when return_product was
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateProductResponse) Reset() {
+	*x = ActivateProductResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateProductResponse) ProtoMessage() {}
+
+func (x *ActivateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateProductResponse.ProtoReflect.Descriptor instead.
+func (*ActivateProductResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ActivateProductResponse) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ActivateProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// DeactivateProductRequest represents the request to deactivate a product
+type DeactivateProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ReturnProduct bool                   `protobuf:"varint,2,opt,name=return_product,json=returnProduct,proto3" json:"return_product,omitempty"` // Return the product as committed in the response
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateProductRequest) Reset() {
+	*x = DeactivateProductRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateProductRequest) ProtoMessage() {}
+
+func (x *DeactivateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateProductRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeactivateProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *DeactivateProductRequest) GetReturnProduct() bool {
+	if x != nil {
+		return x.ReturnProduct
+	}
+	return false
+}
+
+// DeactivateProductResponse represents the response from deactivating a product
+type DeactivateProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Product       *Product               `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"` // Set when return_product was
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateProductResponse) Reset() {
+	*x = DeactivateProductResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateProductResponse) ProtoMessage() {}
+
+func (x *DeactivateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateProductResponse.ProtoReflect.Descriptor instead.
+func (*DeactivateProductResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeactivateProductResponse) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *DeactivateProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// ArchiveProductRequest represents the request to archive a product
+type ArchiveProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ReturnProduct bool                   `protobuf:"varint,2,opt,name=return_product,json=returnProduct,proto3" json:"return_product,omitempty"` // Return the product as committed in the response
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveProductRequest) Reset() {
+	*x = ArchiveProductRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveProductRequest) ProtoMessage() {}
+
+func (x *ArchiveProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveProductRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ArchiveProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ArchiveProductRequest) GetReturnProduct() bool {
+	if x != nil {
+		return x.ReturnProduct
+	}
+	return false
+}
+
+// ArchiveProductResponse represents the response from archiving a product
+type ArchiveProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Product       *Product               `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"` // Set when return_product was
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveProductResponse) Reset() {
+	*x = ArchiveProductResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveProductResponse) ProtoMessage() {}
+
+func (x *ArchiveProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveProductResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveProductResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ArchiveProductResponse) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ArchiveProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// FindSimilarProductsRequest represents the request to find similar products
+type FindSimilarProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Sku           string                 `protobuf:"bytes,3,opt,name=sku,proto3" json:"sku,omitempty"`
+	Gtin          string                 `protobuf:"bytes,4,opt,name=gtin,proto3" json:"gtin,omitempty"`
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindSimilarProductsRequest) Reset() {
+	*x = FindSimilarProductsRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindSimilarProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindSimilarProductsRequest) ProtoMessage() {}
+
+func (x *FindSimilarProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindSimilarProductsRequest.ProtoReflect.Descriptor instead.
+func (*FindSimilarProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *FindSimilarProductsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FindSimilarProductsRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *FindSimilarProductsRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *FindSimilarProductsRequest) GetGtin() string {
+	if x != nil {
+		return x.Gtin
+	}
+	return ""
+}
+
+func (x *FindSimilarProductsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// SimilarProduct represents an existing product matching the request
+type SimilarProduct struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Sku           string                 `protobuf:"bytes,4,opt,name=sku,proto3" json:"sku,omitempty"`
+	Gtin          string                 `protobuf:"bytes,5,opt,name=gtin,proto3" json:"gtin,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	MatchedOn     []string               `protobuf:"bytes,7,rep,name=matched_on,json=matchedOn,proto3" json:"matched_on,omitempty"` // "name_category", "sku", "gtin"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimilarProduct) Reset() {
+	*x = SimilarProduct{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimilarProduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimilarProduct) ProtoMessage() {}
+
+func (x *SimilarProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimilarProduct.ProtoReflect.Descriptor instead.
+func (*SimilarProduct) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SimilarProduct) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SimilarProduct) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SimilarProduct) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SimilarProduct) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *SimilarProduct) GetGtin() string {
+	if x != nil {
+		return x.Gtin
+	}
+	return ""
+}
+
+func (x *SimilarProduct) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SimilarProduct) GetMatchedOn() []string {
+	if x != nil {
+		return x.MatchedOn
+	}
+	return nil
+}
+
+// FindSimilarProductsResponse represents the response from finding similar products
+type FindSimilarProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*SimilarProduct      `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindSimilarProductsResponse) Reset() {
+	*x = FindSimilarProductsResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindSimilarProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindSimilarProductsResponse) ProtoMessage() {}
+
+func (x *FindSimilarProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindSimilarProductsResponse.ProtoReflect.Descriptor instead.
+func (*FindSimilarProductsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *FindSimilarProductsResponse) GetProducts() []*SimilarProduct {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+// CompareProductsRequest represents the request to compare products
+type CompareProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductIds    []string               `protobuf:"bytes,1,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"` // 2-5 distinct product IDs
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompareProductsRequest) Reset() {
+	*x = CompareProductsRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareProductsRequest) ProtoMessage() {}
+
+func (x *CompareProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareProductsRequest.ProtoReflect.Descriptor instead.
+func (*CompareProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CompareProductsRequest) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+// ComparisonRow is one attribute across all compared products
+type ComparisonRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attribute     string                 `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	Values        []string               `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"` // Aligned with CompareProductsResponse.products
+	Differs       bool                   `protobuf:"varint,3,opt,name=differs,proto3" json:"differs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComparisonRow) Reset() {
+	*x = ComparisonRow{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComparisonRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComparisonRow) ProtoMessage() {}
+
+func (x *ComparisonRow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComparisonRow.ProtoReflect.Descriptor instead.
+func (*ComparisonRow) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ComparisonRow) GetAttribute() string {
+	if x != nil {
+		return x.Attribute
+	}
+	return ""
+}
+
+func (x *ComparisonRow) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *ComparisonRow) GetDiffers() bool {
+	if x != nil {
+		return x.Differs
+	}
+	return false
+}
+
+// CompareProductsResponse represents the response from comparing products
+type CompareProductsResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Products          []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"` // In request order
+	Rows              []*ComparisonRow       `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+	CheapestProductId string                 `protobuf:"bytes,3,opt,name=cheapest_product_id,json=cheapestProductId,proto3" json:"cheapest_product_id,omitempty"`
+	PriceDifferences  []*Money               `protobuf:"bytes,4,rep,name=price_differences,json=priceDifferences,proto3" json:"price_differences,omitempty"` // Effective price minus the lowest, aligned with products
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CompareProductsResponse) Reset() {
+	*x = CompareProductsResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareProductsResponse) ProtoMessage() {}
+
+func (x *CompareProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareProductsResponse.ProtoReflect.Descriptor instead.
+func (*CompareProductsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CompareProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *CompareProductsResponse) GetRows() []*ComparisonRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *CompareProductsResponse) GetCheapestProductId() string {
+	if x != nil {
+		return x.CheapestProductId
+	}
+	return ""
+}
+
+func (x *CompareProductsResponse) GetPriceDifferences() []*Money {
+	if x != nil {
+		return x.PriceDifferences
+	}
+	return nil
+}
+
+// SetLegalHoldRequest represents the request to place or release a legal hold
+type SetLegalHoldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	LegalHold     bool                   `protobuf:"varint,2,opt,name=legal_hold,json=legalHold,proto3" json:"legal_hold,omitempty"`
+	ReturnProduct bool                   `protobuf:"varint,3,opt,name=return_product,json=returnProduct,proto3" json:"return_product,omitempty"` // Return the product as committed in the response
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLegalHoldRequest) Reset() {
+	*x = SetLegalHoldRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLegalHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLegalHoldRequest) ProtoMessage() {}
+
+func (x *SetLegalHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLegalHoldRequest.ProtoReflect.Descriptor instead.
+func (*SetLegalHoldRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SetLegalHoldRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetLegalHoldRequest) GetLegalHold() bool {
+	if x != nil {
+		return x.LegalHold
+	}
+	return false
+}
+
+func (x *SetLegalHoldRequest) GetReturnProduct() bool {
+	if x != nil {
+		return x.ReturnProduct
+	}
+	return false
+}
+
+// SetLegalHoldResponse represents the response from changing a legal hold
+type SetLegalHoldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Product       *Product               `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"` // Set when return_product was
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLegalHoldResponse) Reset() {
+	*x = SetLegalHoldResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLegalHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLegalHoldResponse) ProtoMessage() {}
+
+func (x *SetLegalHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLegalHoldResponse.ProtoReflect.Descriptor instead.
+func (*SetLegalHoldResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SetLegalHoldResponse) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetLegalHoldResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// PurgeArchivedProductsRequest represents the request to purge archived products
+type PurgeArchivedProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RetentionDays int32                  `protobuf:"varint,1,opt,name=retention_days,json=retentionDays,proto3" json:"retention_days,omitempty"` // Products archived longer than this are purged
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                      // Report only, delete nothing
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                                      // Maximum products per run (default 500)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeArchivedProductsRequest) Reset() {
+	*x = PurgeArchivedProductsRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeArchivedProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeArchivedProductsRequest) ProtoMessage() {}
+
+func (x *PurgeArchivedProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeArchivedProductsRequest.ProtoReflect.Descriptor instead.
+func (*PurgeArchivedProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PurgeArchivedProductsRequest) GetRetentionDays() int32 {
+	if x != nil {
+		return x.RetentionDays
+	}
+	return 0
+}
+
+func (x *PurgeArchivedProductsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *PurgeArchivedProductsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// PurgedProduct describes a product that was (or would be) purged
+type PurgedProduct struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	ArchivedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
+	EventCount    int64                  `protobuf:"varint,4,opt,name=event_count,json=eventCount,proto3" json:"event_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgedProduct) Reset() {
+	*x = PurgedProduct{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgedProduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgedProduct) ProtoMessage() {}
+
+func (x *PurgedProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgedProduct.ProtoReflect.Descriptor instead.
+func (*PurgedProduct) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PurgedProduct) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *PurgedProduct) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *PurgedProduct) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+func (x *PurgedProduct) GetEventCount() int64 {
+	if x != nil {
+		return x.EventCount
+	}
+	return 0
+}
+
+// PurgeArchivedProductsResponse represents the purge report
+type PurgeArchivedProductsResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	DryRun            bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	ArchivedBefore    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=archived_before,json=archivedBefore,proto3" json:"archived_before,omitempty"`
+	Purged            []*PurgedProduct       `protobuf:"bytes,3,rep,name=purged,proto3" json:"purged,omitempty"`
+	HeldProductIds    []string               `protobuf:"bytes,4,rep,name=held_product_ids,json=heldProductIds,proto3" json:"held_product_ids,omitempty"`          // Kept because of a legal hold
+	SkippedProductIds []string               `protobuf:"bytes,5,rep,name=skipped_product_ids,json=skippedProductIds,proto3" json:"skipped_product_ids,omitempty"` // Changed before they could be purged
+	EventsDeleted     int64                  `protobuf:"varint,6,opt,name=events_deleted,json=eventsDeleted,proto3" json:"events_deleted,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PurgeArchivedProductsResponse) Reset() {
+	*x = PurgeArchivedProductsResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeArchivedProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeArchivedProductsResponse) ProtoMessage() {}
+
+func (x *PurgeArchivedProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeArchivedProductsResponse.ProtoReflect.Descriptor instead.
+func (*PurgeArchivedProductsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PurgeArchivedProductsResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *PurgeArchivedProductsResponse) GetArchivedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedBefore
+	}
+	return nil
+}
+
+func (x *PurgeArchivedProductsResponse) GetPurged() []*PurgedProduct {
+	if x != nil {
+		return x.Purged
+	}
+	return nil
+}
+
+func (x *PurgeArchivedProductsResponse) GetHeldProductIds() []string {
+	if x != nil {
+		return x.HeldProductIds
+	}
+	return nil
+}
+
+func (x *PurgeArchivedProductsResponse) GetSkippedProductIds() []string {
+	if x != nil {
+		return x.SkippedProductIds
+	}
+	return nil
+}
+
+func (x *PurgeArchivedProductsResponse) GetEventsDeleted() int64 {
+	if x != nil {
+		return x.EventsDeleted
+	}
+	return 0
+}
+
+// ExportProductDataRequest represents the request to export a product's data
+type ExportProductDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportProductDataRequest) Reset() {
+	*x = ExportProductDataRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportProductDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportProductDataRequest) ProtoMessage() {}
+
+func (x *ExportProductDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportProductDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportProductDataRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ExportProductDataRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+// ExportProductDataResponse represents the exported data
+type ExportProductDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Document      string                 `protobuf:"bytes,2,opt,name=document,proto3" json:"document,omitempty"` // JSON document with the product row and its events
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportProductDataResponse) Reset() {
+	*x = ExportProductDataResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportProductDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportProductDataResponse) ProtoMessage() {}
+
+func (x *ExportProductDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportProductDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportProductDataResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ExportProductDataResponse) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ExportProductDataResponse) GetDocument() string {
+	if x != nil {
+		return x.Document
+	}
+	return ""
+}
+
+// BatchImportProductsRequest represents the request to import products in bulk
+type BatchImportProductsRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Products      []*CreateProductRequest `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"` // 1-1000 products, validated like CreateProduct
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchImportProductsRequest) Reset() {
+	*x = BatchImportProductsRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchImportProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchImportProductsRequest) ProtoMessage() {}
+
+func (x *BatchImportProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchImportProductsRequest.ProtoReflect.Descriptor instead.
+func (*BatchImportProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *BatchImportProductsRequest) GetProducts() []*CreateProductRequest {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+// BatchImportProductsResponse identifies the operation running the import
+type BatchImportProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperationName string                 `protobuf:"bytes,1,opt,name=operation_name,json=operationName,proto3" json:"operation_name,omitempty"` // operations/{operation}
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchImportProductsResponse) Reset() {
+	*x = BatchImportProductsResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchImportProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchImportProductsResponse) ProtoMessage() {}
+
+func (x *BatchImportProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchImportProductsResponse.ProtoReflect.Descriptor instead.
+func (*BatchImportProductsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *BatchImportProductsResponse) GetOperationName() string {
+	if x != nil {
+		return x.OperationName
+	}
+	return ""
+}
+
+// BatchImportFailure describes a product that could not be imported
+type BatchImportFailure struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"` // Position in BatchImportProductsRequest.products
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`    // gRPC status code name, e.g. "INVALID_ARGUMENT"
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchImportFailure) Reset() {
+	*x = BatchImportFailure{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchImportFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchImportFailure) ProtoMessage() {}
+
+func (x *BatchImportFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchImportFailure.ProtoReflect.Descriptor instead.
+func (*BatchImportFailure) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *BatchImportFailure) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BatchImportFailure) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *BatchImportFailure) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// BatchImportProductsResult is the response of a finished BatchImportProducts operation
+type BatchImportProductsResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductIds    []string               `protobuf:"bytes,1,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"` // Aligned with the request; empty for failed items
+	Failures      []*BatchImportFailure  `protobuf:"bytes,2,rep,name=failures,proto3" json:"failures,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchImportProductsResult) Reset() {
+	*x = BatchImportProductsResult{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchImportProductsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchImportProductsResult) ProtoMessage() {}
+
+func (x *BatchImportProductsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchImportProductsResult.ProtoReflect.Descriptor instead.
+func (*BatchImportProductsResult) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *BatchImportProductsResult) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *BatchImportProductsResult) GetFailures() []*BatchImportFailure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+// OperationMetadata reports the progress of a long-running operation
+type OperationMetadata struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Kind            string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // e.g. "batch_import_products"
+	TotalItems      int64                  `protobuf:"varint,2,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
+	ProcessedItems  int64                  `protobuf:"varint,3,opt,name=processed_items,json=processedItems,proto3" json:"processed_items,omitempty"`
+	FailedItems     int64                  `protobuf:"varint,4,opt,name=failed_items,json=failedItems,proto3" json:"failed_items,omitempty"`
+	CancelRequested bool                   `protobuf:"varint,5,opt,name=cancel_requested,json=cancelRequested,proto3" json:"cancel_requested,omitempty"`
+	CreateTime      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// Kind-specific point a failed or cancelled operation can be resumed from
+	// (for rebuild_projection: the last product ID processed)
+	Checkpoint    string `protobuf:"bytes,8,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OperationMetadata) Reset() {
+	*x = OperationMetadata{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OperationMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationMetadata) ProtoMessage() {}
+
+func (x *OperationMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationMetadata.ProtoReflect.Descriptor instead.
+func (*OperationMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *OperationMetadata) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *OperationMetadata) GetTotalItems() int64 {
+	if x != nil {
+		return x.TotalItems
+	}
+	return 0
+}
+
+func (x *OperationMetadata) GetProcessedItems() int64 {
+	if x != nil {
+		return x.ProcessedItems
+	}
+	return 0
+}
+
+func (x *OperationMetadata) GetFailedItems() int64 {
+	if x != nil {
+		return x.FailedItems
+	}
+	return 0
+}
+
+func (x *OperationMetadata) GetCancelRequested() bool {
+	if x != nil {
+		return x.CancelRequested
+	}
+	return false
+}
+
+func (x *OperationMetadata) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *OperationMetadata) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *OperationMetadata) GetCheckpoint() string {
+	if x != nil {
+		return x.Checkpoint
+	}
+	return ""
+}
+
+type ValidateProductRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// product_id validates changes to an existing product; unset fields keep their stored values
+	ProductId         string             `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Name              *string            `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description       *string            `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Category          *string            `protobuf:"bytes,4,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	BasePrice         *Money             `protobuf:"bytes,5,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	Sku               *string            `protobuf:"bytes,6,opt,name=sku,proto3,oneof" json:"sku,omitempty"`
+	Gtin              *string            `protobuf:"bytes,7,opt,name=gtin,proto3,oneof" json:"gtin,omitempty"`
+	DescriptionFormat *DescriptionFormat `protobuf:"varint,8,opt,name=description_format,json=descriptionFormat,proto3,enum=product.v1.DescriptionFormat,oneof" json:"description_format,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ValidateProductRequest) Reset() {
+	*x = ValidateProductRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateProductRequest) ProtoMessage() {}
+
+func (x *ValidateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateProductRequest.ProtoReflect.Descriptor instead.
+func (*ValidateProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ValidateProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ValidateProductRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *ValidateProductRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *ValidateProductRequest) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+func (x *ValidateProductRequest) GetBasePrice() *Money {
+	if x != nil {
+		return x.BasePrice
+	}
+	return nil
+}
+
+func (x *ValidateProductRequest) GetSku() string {
+	if x != nil && x.Sku != nil {
+		return *x.Sku
+	}
+	return ""
+}
+
+func (x *ValidateProductRequest) GetGtin() string {
+	if x != nil && x.Gtin != nil {
+		return *x.Gtin
+	}
+	return ""
+}
+
+func (x *ValidateProductRequest) GetDescriptionFormat() DescriptionFormat {
+	if x != nil && x.DescriptionFormat != nil {
+		return *x.DescriptionFormat
+	}
+	return DescriptionFormat_DESCRIPTION_FORMAT_UNSPECIFIED
+}
+
+type ValidationViolation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// field is the request field name, e.g. "gtin"
+	Field         string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Description   string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidationViolation) Reset() {
+	*x = ValidationViolation{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidationViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidationViolation) ProtoMessage() {}
+
+func (x *ValidationViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidationViolation.ProtoReflect.Descriptor instead.
+func (*ValidationViolation) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ValidationViolation) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ValidationViolation) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type ValidateProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Violations    []*ValidationViolation `protobuf:"bytes,2,rep,name=violations,proto3" json:"violations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateProductResponse) Reset() {
+	*x = ValidateProductResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateProductResponse) ProtoMessage() {}
+
+func (x *ValidateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateProductResponse.ProtoReflect.Descriptor instead.
+func (*ValidateProductResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ValidateProductResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateProductResponse) GetViolations() []*ValidationViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+type ReviewProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Decision      ReviewDecision         `protobuf:"varint,2,opt,name=decision,proto3,enum=product.v1.ReviewDecision" json:"decision,omitempty"`
+	Reviewer      string                 `protobuf:"bytes,3,opt,name=reviewer,proto3" json:"reviewer,omitempty"` // Reviewer identity, e.g. an email address; ignored when an API key authenticates the call
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`   // Up to 2000 characters
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewProductRequest) Reset() {
+	*x = ReviewProductRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewProductRequest) ProtoMessage() {}
+
+func (x *ReviewProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewProductRequest.ProtoReflect.Descriptor instead.
+func (*ReviewProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ReviewProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ReviewProductRequest) GetDecision() ReviewDecision {
+	if x != nil {
+		return x.Decision
+	}
+	return ReviewDecision_REVIEW_DECISION_UNSPECIFIED
+}
+
+func (x *ReviewProductRequest) GetReviewer() string {
+	if x != nil {
+		return x.Reviewer
+	}
+	return ""
+}
+
+func (x *ReviewProductRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type ReviewProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewProductResponse) Reset() {
+	*x = ReviewProductResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewProductResponse) ProtoMessage() {}
+
+func (x *ReviewProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewProductResponse.ProtoReflect.Descriptor instead.
+func (*ReviewProductResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ReviewProductResponse) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type GetProductHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductHistoryRequest) Reset() {
+	*x = GetProductHistoryRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductHistoryRequest) ProtoMessage() {}
+
+func (x *GetProductHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetProductHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetProductHistoryRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+// ProductReview is the reviewer decision carried by a history entry
+type ProductReview struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Decision      ReviewDecision         `protobuf:"varint,1,opt,name=decision,proto3,enum=product.v1.ReviewDecision" json:"decision,omitempty"`
+	Reviewer      string                 `protobuf:"bytes,2,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductReview) Reset() {
+	*x = ProductReview{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductReview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductReview) ProtoMessage() {}
+
+func (x *ProductReview) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductReview.ProtoReflect.Descriptor instead.
+func (*ProductReview) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ProductReview) GetDecision() ReviewDecision {
+	if x != nil {
+		return x.Decision
+	}
+	return ReviewDecision_REVIEW_DECISION_UNSPECIFIED
+}
+
+func (x *ProductReview) GetReviewer() string {
+	if x != nil {
+		return x.Reviewer
+	}
+	return ""
+}
+
+func (x *ProductReview) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+// ProductHistoryEntry is one recorded event for a product
+type ProductHistoryEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType     string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"` // e.g. "product_created", "product_approved"
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	PayloadJson   string                 `protobuf:"bytes,4,opt,name=payload_json,json=payloadJson,proto3" json:"payload_json,omitempty"` // Event payload as JSON
+	Review        *ProductReview         `protobuf:"bytes,5,opt,name=review,proto3" json:"review,omitempty"`                              // Set for product_approved and product_rejected
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductHistoryEntry) Reset() {
+	*x = ProductHistoryEntry{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductHistoryEntry) ProtoMessage() {}
+
+func (x *ProductHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductHistoryEntry.ProtoReflect.Descriptor instead.
+func (*ProductHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ProductHistoryEntry) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ProductHistoryEntry) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *ProductHistoryEntry) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *ProductHistoryEntry) GetPayloadJson() string {
+	if x != nil {
+		return x.PayloadJson
+	}
+	return ""
+}
+
+func (x *ProductHistoryEntry) GetReview() *ProductReview {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+type GetProductHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Entries       []*ProductHistoryEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductHistoryResponse) Reset() {
+	*x = GetProductHistoryResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductHistoryResponse) ProtoMessage() {}
+
+func (x *GetProductHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetProductHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetProductHistoryResponse) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetProductHistoryResponse) GetEntries() []*ProductHistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// RebuildProjectionRequest selects the products to rebuild, in product ID order
+// Only the unique name key (name_key) is derived from product rows today
+type RebuildProjectionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Resume after this product ID, e.g. the checkpoint of a failed or cancelled run
+	StartAfterProductId string `protobuf:"bytes,1,opt,name=start_after_product_id,json=startAfterProductId,proto3" json:"start_after_product_id,omitempty"`
+	DryRun              bool   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`          // Report rows that would change without writing them
+	BatchSize           int32  `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"` // Products read per batch (default 500, max 5000)
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *RebuildProjectionRequest) Reset() {
+	*x = RebuildProjectionRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebuildProjectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebuildProjectionRequest) ProtoMessage() {}
+
+func (x *RebuildProjectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebuildProjectionRequest.ProtoReflect.Descriptor instead.
+func (*RebuildProjectionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RebuildProjectionRequest) GetStartAfterProductId() string {
+	if x != nil {
+		return x.StartAfterProductId
+	}
+	return ""
+}
+
+func (x *RebuildProjectionRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *RebuildProjectionRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+// RebuildProjectionResponse identifies the operation running the rebuild
+type RebuildProjectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperationName string                 `protobuf:"bytes,1,opt,name=operation_name,json=operationName,proto3" json:"operation_name,omitempty"` // operations/{operation}
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RebuildProjectionResponse) Reset() {
+	*x = RebuildProjectionResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebuildProjectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebuildProjectionResponse) ProtoMessage() {}
+
+func (x *RebuildProjectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebuildProjectionResponse.ProtoReflect.Descriptor instead.
+func (*RebuildProjectionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RebuildProjectionResponse) GetOperationName() string {
+	if x != nil {
+		return x.OperationName
+	}
+	return ""
+}
+
+// RebuildProjectionResult is the response of a finished RebuildProjection operation
+type RebuildProjectionResult struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Scanned int64                  `protobuf:"varint,1,opt,name=scanned,proto3" json:"scanned,omitempty"`
+	Updated int64                  `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"` // Rows rewritten (or that would be, in dry-run mode)
+	// Products whose name key could not be set because another product holds the name
+	ConflictingProductIds []string `protobuf:"bytes,3,rep,name=conflicting_product_ids,json=conflictingProductIds,proto3" json:"conflicting_product_ids,omitempty"`
+	LastProductId         string   `protobuf:"bytes,4,opt,name=last_product_id,json=lastProductId,proto3" json:"last_product_id,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *RebuildProjectionResult) Reset() {
+	*x = RebuildProjectionResult{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebuildProjectionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebuildProjectionResult) ProtoMessage() {}
+
+func (x *RebuildProjectionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebuildProjectionResult.ProtoReflect.Descriptor instead.
+func (*RebuildProjectionResult) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RebuildProjectionResult) GetScanned() int64 {
+	if x != nil {
+		return x.Scanned
+	}
+	return 0
+}
+
+func (x *RebuildProjectionResult) GetUpdated() int64 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *RebuildProjectionResult) GetConflictingProductIds() []string {
+	if x != nil {
+		return x.ConflictingProductIds
+	}
+	return nil
+}
+
+func (x *RebuildProjectionResult) GetLastProductId() string {
+	if x != nil {
+		return x.LastProductId
+	}
+	return ""
+}
+
+// ReassignCategoryRequest represents the request to move a category's products to another category
+type ReassignCategoryRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	FromCategory string                 `protobuf:"bytes,1,opt,name=from_category,json=fromCategory,proto3" json:"from_category,omitempty"`
+	ToCategory   string                 `protobuf:"bytes,2,opt,name=to_category,json=toCategory,proto3" json:"to_category,omitempty"` // Must differ from from_category
+	// Resume after this product ID, e.g. the checkpoint of a failed or cancelled run
+	StartAfterProductId string `protobuf:"bytes,3,opt,name=start_after_product_id,json=startAfterProductId,proto3" json:"start_after_product_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ReassignCategoryRequest) Reset() {
+	*x = ReassignCategoryRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignCategoryRequest) ProtoMessage() {}
+
+func (x *ReassignCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignCategoryRequest.ProtoReflect.Descriptor instead.
+func (*ReassignCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ReassignCategoryRequest) GetFromCategory() string {
+	if x != nil {
+		return x.FromCategory
+	}
+	return ""
+}
+
+func (x *ReassignCategoryRequest) GetToCategory() string {
+	if x != nil {
+		return x.ToCategory
+	}
+	return ""
+}
+
+func (x *ReassignCategoryRequest) GetStartAfterProductId() string {
+	if x != nil {
+		return x.StartAfterProductId
+	}
+	return ""
+}
+
+// ReassignCategoryResponse identifies the operation running the reassignment
+type ReassignCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperationName string                 `protobuf:"bytes,1,opt,name=operation_name,json=operationName,proto3" json:"operation_name,omitempty"` // operations/{operation}
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignCategoryResponse) Reset() {
+	*x = ReassignCategoryResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignCategoryResponse) ProtoMessage() {}
+
+func (x *ReassignCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignCategoryResponse.ProtoReflect.Descriptor instead.
+func (*ReassignCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ReassignCategoryResponse) GetOperationName() string {
+	if x != nil {
+		return x.OperationName
+	}
+	return ""
+}
+
+// ReassignCategoryFailure describes a product that stayed in its category
+type ReassignCategoryFailure struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"` // gRPC status code name, e.g. "ALREADY_EXISTS" when the name is taken in to_category
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignCategoryFailure) Reset() {
+	*x = ReassignCategoryFailure{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignCategoryFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignCategoryFailure) ProtoMessage() {}
+
+func (x *ReassignCategoryFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignCategoryFailure.ProtoReflect.Descriptor instead.
+func (*ReassignCategoryFailure) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ReassignCategoryFailure) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ReassignCategoryFailure) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ReassignCategoryFailure) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ReassignCategoryResult is the response of a finished ReassignCategory operation
+type ReassignCategoryResult struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Moved         int64                      `protobuf:"varint,1,opt,name=moved,proto3" json:"moved,omitempty"`
+	Failures      []*ReassignCategoryFailure `protobuf:"bytes,2,rep,name=failures,proto3" json:"failures,omitempty"`
+	LastProductId string                     `protobuf:"bytes,3,opt,name=last_product_id,json=lastProductId,proto3" json:"last_product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignCategoryResult) Reset() {
+	*x = ReassignCategoryResult{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignCategoryResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignCategoryResult) ProtoMessage() {}
+
+func (x *ReassignCategoryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignCategoryResult.ProtoReflect.Descriptor instead.
+func (*ReassignCategoryResult) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ReassignCategoryResult) GetMoved() int64 {
+	if x != nil {
+		return x.Moved
+	}
+	return 0
+}
+
+func (x *ReassignCategoryResult) GetFailures() []*ReassignCategoryFailure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+func (x *ReassignCategoryResult) GetLastProductId() string {
+	if x != nil {
+		return x.LastProductId
+	}
+	return ""
+}
+
+// SetChannelsRequest represents the request to set a product's sales channels
+type SetChannelsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Channels      []string               `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`                                 // "web", "mobile_app", "marketplace"; empty hides the product everywhere
+	ReturnProduct bool                   `protobuf:"varint,3,opt,name=return_product,json=returnProduct,proto3" json:"return_product,omitempty"` // Return the product as committed in the response
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetChannelsRequest) Reset() {
+	*x = SetChannelsRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChannelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChannelsRequest) ProtoMessage() {}
+
+func (x *SetChannelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChannelsRequest.ProtoReflect.Descriptor instead.
+func (*SetChannelsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SetChannelsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetChannelsRequest) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *SetChannelsRequest) GetReturnProduct() bool {
+	if x != nil {
+		return x.ReturnProduct
+	}
+	return false
+}
+
+// SetChannelsResponse represents the response from setting a product's sales channels
+type SetChannelsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Product       *Product               `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"` // Set when return_product was
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetChannelsResponse) Reset() {
+	*x = SetChannelsResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChannelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChannelsResponse) ProtoMessage() {}
+
+func (x *SetChannelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChannelsResponse.ProtoReflect.Descriptor instead.
+func (*SetChannelsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SetChannelsResponse) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetChannelsResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// SetMetadataRequest represents the request to replace a product's integrator metadata
+type SetMetadataRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// At most 32 keys of lowercase letters, digits, '_', '.' or '-' with values of at most 256 characters;
+	// empty clears the metadata
+	Metadata      map[string]string `protobuf:"bytes,2,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ReturnProduct bool              `protobuf:"varint,3,opt,name=return_product,json=returnProduct,proto3" json:"return_product,omitempty"` // Return the product as committed in the response
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMetadataRequest) Reset() {
+	*x = SetMetadataRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMetadataRequest) ProtoMessage() {}
+
+func (x *SetMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMetadataRequest.ProtoReflect.Descriptor instead.
+func (*SetMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SetMetadataRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetMetadataRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *SetMetadataRequest) GetReturnProduct() bool {
+	if x != nil {
+		return x.ReturnProduct
+	}
+	return false
+}
+
+// SetMetadataResponse represents the response from setting a product's metadata
+type SetMetadataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Product       *Product               `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"` // Set when return_product was
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMetadataResponse) Reset() {
+	*x = SetMetadataResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMetadataResponse) ProtoMessage() {}
+
+func (x *SetMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMetadataResponse.ProtoReflect.Descriptor instead.
+func (*SetMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SetMetadataResponse) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetMetadataResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// SetAttributesRequest represents the request to replace a product's attributes
+type SetAttributesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// At most 64 names of lowercase letters, digits, '_', '.' or '-' with non-empty values of at most 256
+	// characters; when the category has a template every violation of it is reported; empty clears the attributes
+	Attributes    map[string]string `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ReturnProduct bool              `protobuf:"varint,3,opt,name=return_product,json=returnProduct,proto3" json:"return_product,omitempty"` // Return the product as committed in the response
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAttributesRequest) Reset() {
+	*x = SetAttributesRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAttributesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAttributesRequest) ProtoMessage() {}
+
+func (x *SetAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAttributesRequest.ProtoReflect.Descriptor instead.
+func (*SetAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SetAttributesRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetAttributesRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *SetAttributesRequest) GetReturnProduct() bool {
+	if x != nil {
+		return x.ReturnProduct
+	}
+	return false
+}
+
+// SetAttributesResponse represents the response from setting a product's attributes
+type SetAttributesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Product       *Product               `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"` // Set when return_product was
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAttributesResponse) Reset() {
+	*x = SetAttributesResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAttributesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAttributesResponse) ProtoMessage() {}
+
+func (x *SetAttributesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAttributesResponse.ProtoReflect.Descriptor instead.
+func (*SetAttributesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SetAttributesResponse) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetAttributesResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// LinkExternalRefRequest represents the request to link an external system's identifier to a product
+type LinkExternalRefRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	System        string                 `protobuf:"bytes,2,opt,name=system,proto3" json:"system,omitempty"`                           // 1-32 lowercase letters, digits, '_' or '-', e.g. "erp" or "amazon-de"
+	ExternalId    string                 `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"` // Unique per system within the tenant
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkExternalRefRequest) Reset() {
+	*x = LinkExternalRefRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkExternalRefRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkExternalRefRequest) ProtoMessage() {}
+
+func (x *LinkExternalRefRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkExternalRefRequest.ProtoReflect.Descriptor instead.
+func (*LinkExternalRefRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *LinkExternalRefRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *LinkExternalRefRequest) GetSystem() string {
+	if x != nil {
+		return x.System
+	}
+	return ""
+}
+
+func (x *LinkExternalRefRequest) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+// LinkExternalRefResponse represents the response from linking an external reference
+type LinkExternalRefResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkExternalRefResponse) Reset() {
+	*x = LinkExternalRefResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkExternalRefResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkExternalRefResponse) ProtoMessage() {}
+
+func (x *LinkExternalRefResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkExternalRefResponse.ProtoReflect.Descriptor instead.
+func (*LinkExternalRefResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *LinkExternalRefResponse) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+// UnlinkExternalRefRequest represents the request to remove an external reference from a product
+type UnlinkExternalRefRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	System        string                 `protobuf:"bytes,2,opt,name=system,proto3" json:"system,omitempty"`
+	ExternalId    string                 `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkExternalRefRequest) Reset() {
+	*x = UnlinkExternalRefRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkExternalRefRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkExternalRefRequest) ProtoMessage() {}
+
+func (x *UnlinkExternalRefRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkExternalRefRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkExternalRefRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *UnlinkExternalRefRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *UnlinkExternalRefRequest) GetSystem() string {
+	if x != nil {
+		return x.System
+	}
+	return ""
+}
+
+func (x *UnlinkExternalRefRequest) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+// UnlinkExternalRefResponse represents the response from unlinking an external reference
+type UnlinkExternalRefResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkExternalRefResponse) Reset() {
+	*x = UnlinkExternalRefResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkExternalRefResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkExternalRefResponse) ProtoMessage() {}
+
+func (x *UnlinkExternalRefResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkExternalRefResponse.ProtoReflect.Descriptor instead.
+func (*UnlinkExternalRefResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *UnlinkExternalRefResponse) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+// GetProductByExternalRefRequest represents the request to find a product by an external identifier
+type GetProductByExternalRefRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	System        string                 `protobuf:"bytes,1,opt,name=system,proto3" json:"system,omitempty"`
+	ExternalId    string                 `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductByExternalRefRequest) Reset() {
+	*x = GetProductByExternalRefRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductByExternalRefRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductByExternalRefRequest) ProtoMessage() {}
+
+func (x *GetProductByExternalRefRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductByExternalRefRequest.ProtoReflect.Descriptor instead.
+func (*GetProductByExternalRefRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetProductByExternalRefRequest) GetSystem() string {
+	if x != nil {
+		return x.System
+	}
+	return ""
+}
+
+func (x *GetProductByExternalRefRequest) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+// ChangeBasePriceRequest represents the request to change a product's base price
+type ChangeBasePriceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	BasePrice     *Money                 `protobuf:"bytes,2,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,3,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"` // Ignored: the requester is the caller's API key, which the approver's must differ from
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeBasePriceRequest) Reset() {
+	*x = ChangeBasePriceRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeBasePriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeBasePriceRequest) ProtoMessage() {}
+
+func (x *ChangeBasePriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeBasePriceRequest.ProtoReflect.Descriptor instead.
+func (*ChangeBasePriceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ChangeBasePriceRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ChangeBasePriceRequest) GetBasePrice() *Money {
+	if x != nil {
+		return x.BasePrice
+	}
+	return nil
+}
+
+func (x *ChangeBasePriceRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+// ChangeBasePriceResponse represents the response from changing a base price
+type ChangeBasePriceResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProductId       string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	PendingChangeId string                 `protobuf:"bytes,2,opt,name=pending_change_id,json=pendingChangeId,proto3" json:"pending_change_id,omitempty"` // Set when the change awaits approval and was not applied
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ChangeBasePriceResponse) Reset() {
+	*x = ChangeBasePriceResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeBasePriceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeBasePriceResponse) ProtoMessage() {}
+
+func (x *ChangeBasePriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeBasePriceResponse.ProtoReflect.Descriptor instead.
+func (*ChangeBasePriceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ChangeBasePriceResponse) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ChangeBasePriceResponse) GetPendingChangeId() string {
+	if x != nil {
+		return x.PendingChangeId
+	}
+	return ""
+}
+
+// ApproveChangeRequest represents the request to approve and apply a pending change
+type ApproveChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChangeId      string                 `protobuf:"bytes,1,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	Approver      string                 `protobuf:"bytes,2,opt,name=approver,proto3" json:"approver,omitempty"` // Ignored: the approver is the caller's API key, which must differ from the requester's
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveChangeRequest) Reset() {
+	*x = ApproveChangeRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveChangeRequest) ProtoMessage() {}
+
+func (x *ApproveChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveChangeRequest.ProtoReflect.Descriptor instead.
+func (*ApproveChangeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ApproveChangeRequest) GetChangeId() string {
+	if x != nil {
+		return x.ChangeId
+	}
+	return ""
+}
+
+func (x *ApproveChangeRequest) GetApprover() string {
+	if x != nil {
+		return x.Approver
+	}
+	return ""
+}
+
+// RejectChangeRequest represents the request to reject a pending change
+type RejectChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChangeId      string                 `protobuf:"bytes,1,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	Approver      string                 `protobuf:"bytes,2,opt,name=approver,proto3" json:"approver,omitempty"` // Ignored: the approver is the caller's API key, which must differ from the requester's
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`     // Required
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectChangeRequest) Reset() {
+	*x = RejectChangeRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectChangeRequest) ProtoMessage() {}
+
+func (x *RejectChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectChangeRequest.ProtoReflect.Descriptor instead.
+func (*RejectChangeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *RejectChangeRequest) GetChangeId() string {
+	if x != nil {
+		return x.ChangeId
+	}
+	return ""
+}
+
+func (x *RejectChangeRequest) GetApprover() string {
+	if x != nil {
+		return x.Approver
+	}
+	return ""
+}
+
+func (x *RejectChangeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// DecideChangeResponse represents the response from approving or rejecting a pending change
+type DecideChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChangeId      string                 `protobuf:"bytes,1,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Status        PendingChangeStatus    `protobuf:"varint,3,opt,name=status,proto3,enum=product.v1.PendingChangeStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecideChangeResponse) Reset() {
+	*x = DecideChangeResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecideChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecideChangeResponse) ProtoMessage() {}
+
+func (x *DecideChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecideChangeResponse.ProtoReflect.Descriptor instead.
+func (*DecideChangeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *DecideChangeResponse) GetChangeId() string {
+	if x != nil {
+		return x.ChangeId
+	}
+	return ""
+}
+
+func (x *DecideChangeResponse) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *DecideChangeResponse) GetStatus() PendingChangeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PendingChangeStatus_PENDING_CHANGE_STATUS_UNSPECIFIED
+}
+
+// SetPriceFloorRequest represents the request to replace a product's price floor
+type SetPriceFloorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	PriceFloor    *PriceFloor            `protobuf:"bytes,2,opt,name=price_floor,json=priceFloor,proto3" json:"price_floor,omitempty"`           // Unset removes the floor
+	ReturnProduct bool                   `protobuf:"varint,3,opt,name=return_product,json=returnProduct,proto3" json:"return_product,omitempty"` // Return the product as committed in the response
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPriceFloorRequest) Reset() {
+	*x = SetPriceFloorRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPriceFloorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPriceFloorRequest) ProtoMessage() {}
+
+func (x *SetPriceFloorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPriceFloorRequest.ProtoReflect.Descriptor instead.
+func (*SetPriceFloorRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SetPriceFloorRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetPriceFloorRequest) GetPriceFloor() *PriceFloor {
+	if x != nil {
+		return x.PriceFloor
+	}
+	return nil
+}
+
+func (x *SetPriceFloorRequest) GetReturnProduct() bool {
+	if x != nil {
+		return x.ReturnProduct
+	}
+	return false
+}
+
+// SetPriceFloorResponse represents the response from setting a price floor
+type SetPriceFloorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Product       *Product               `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"` // Set when return_product was
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPriceFloorResponse) Reset() {
+	*x = SetPriceFloorResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPriceFloorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPriceFloorResponse) ProtoMessage() {}
+
+func (x *SetPriceFloorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPriceFloorResponse.ProtoReflect.Descriptor instead.
+func (*SetPriceFloorResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *SetPriceFloorResponse) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetPriceFloorResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// BatchOutcome is the result of a batch status transition for one product
+type BatchOutcome struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`       // gRPC status code name; "OK" when applied or already in the target state
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"` // Set when code is not "OK"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchOutcome) Reset() {
+	*x = BatchOutcome{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchOutcome) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchOutcome) ProtoMessage() {}
+
+func (x *BatchOutcome) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchOutcome.ProtoReflect.Descriptor instead.
+func (*BatchOutcome) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *BatchOutcome) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *BatchOutcome) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *BatchOutcome) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type BatchActivateProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductIds    []string               `protobuf:"bytes,1,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"` // 1-1000 distinct product IDs
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchActivateProductsRequest) Reset() {
+	*x = BatchActivateProductsRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchActivateProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchActivateProductsRequest) ProtoMessage() {}
+
+func (x *BatchActivateProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchActivateProductsRequest.ProtoReflect.Descriptor instead.
+func (*BatchActivateProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *BatchActivateProductsRequest) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+type BatchActivateProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Outcomes      []*BatchOutcome        `protobuf:"bytes,1,rep,name=outcomes,proto3" json:"outcomes,omitempty"` // Aligned with product_ids
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchActivateProductsResponse) Reset() {
+	*x = BatchActivateProductsResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchActivateProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchActivateProductsResponse) ProtoMessage() {}
+
+func (x *BatchActivateProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchActivateProductsResponse.ProtoReflect.Descriptor instead.
+func (*BatchActivateProductsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *BatchActivateProductsResponse) GetOutcomes() []*BatchOutcome {
+	if x != nil {
+		return x.Outcomes
+	}
+	return nil
+}
+
+type BatchDeactivateProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductIds    []string               `protobuf:"bytes,1,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"` // 1-1000 distinct product IDs
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeactivateProductsRequest) Reset() {
+	*x = BatchDeactivateProductsRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeactivateProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeactivateProductsRequest) ProtoMessage() {}
+
+func (x *BatchDeactivateProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeactivateProductsRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeactivateProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *BatchDeactivateProductsRequest) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+type BatchDeactivateProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Outcomes      []*BatchOutcome        `protobuf:"bytes,1,rep,name=outcomes,proto3" json:"outcomes,omitempty"` // Aligned with product_ids
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeactivateProductsResponse) Reset() {
+	*x = BatchDeactivateProductsResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeactivateProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeactivateProductsResponse) ProtoMessage() {}
+
+func (x *BatchDeactivateProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeactivateProductsResponse.ProtoReflect.Descriptor instead.
+func (*BatchDeactivateProductsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *BatchDeactivateProductsResponse) GetOutcomes() []*BatchOutcome {
+	if x != nil {
+		return x.Outcomes
+	}
+	return nil
+}
+
+type BatchArchiveProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductIds    []string               `protobuf:"bytes,1,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"` // 1-1000 distinct product IDs
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchArchiveProductsRequest) Reset() {
+	*x = BatchArchiveProductsRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchArchiveProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchArchiveProductsRequest) ProtoMessage() {}
+
+func (x *BatchArchiveProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchArchiveProductsRequest.ProtoReflect.Descriptor instead.
+func (*BatchArchiveProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *BatchArchiveProductsRequest) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+type BatchArchiveProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Outcomes      []*BatchOutcome        `protobuf:"bytes,1,rep,name=outcomes,proto3" json:"outcomes,omitempty"` // Aligned with product_ids
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchArchiveProductsResponse) Reset() {
+	*x = BatchArchiveProductsResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchArchiveProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchArchiveProductsResponse) ProtoMessage() {}
+
+func (x *BatchArchiveProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchArchiveProductsResponse.ProtoReflect.Descriptor instead.
+func (*BatchArchiveProductsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *BatchArchiveProductsResponse) GetOutcomes() []*BatchOutcome {
+	if x != nil {
+		return x.Outcomes
+	}
+	return nil
+}
+
+// MergeProductsRequest names the duplicate to merge and the product it merges into
+type MergeProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DuplicateId   string                 `protobuf:"bytes,1,opt,name=duplicate_id,json=duplicateId,proto3" json:"duplicate_id,omitempty"`
+	CanonicalId   string                 `protobuf:"bytes,2,opt,name=canonical_id,json=canonicalId,proto3" json:"canonical_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeProductsRequest) Reset() {
+	*x = MergeProductsRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeProductsRequest) ProtoMessage() {}
+
+func (x *MergeProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeProductsRequest.ProtoReflect.Descriptor instead.
+func (*MergeProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *MergeProductsRequest) GetDuplicateId() string {
+	if x != nil {
+		return x.DuplicateId
+	}
+	return ""
+}
+
+func (x *MergeProductsRequest) GetCanonicalId() string {
+	if x != nil {
+		return x.CanonicalId
+	}
+	return ""
+}
+
+// MergeProductsResponse represents the response from merging products
+type MergeProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DuplicateId   string                 `protobuf:"bytes,1,opt,name=duplicate_id,json=duplicateId,proto3" json:"duplicate_id,omitempty"`
+	CanonicalId   string                 `protobuf:"bytes,2,opt,name=canonical_id,json=canonicalId,proto3" json:"canonical_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeProductsResponse) Reset() {
+	*x = MergeProductsResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeProductsResponse) ProtoMessage() {}
+
+func (x *MergeProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeProductsResponse.ProtoReflect.Descriptor instead.
+func (*MergeProductsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *MergeProductsResponse) GetDuplicateId() string {
+	if x != nil {
+		return x.DuplicateId
+	}
+	return ""
+}
+
+func (x *MergeProductsResponse) GetCanonicalId() string {
+	if x != nil {
+		return x.CanonicalId
+	}
+	return ""
+}
+
+// CloneProductRequest represents the request to clone a product of the caller's tenant
+type CloneProductRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProductId      string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	TargetTenantId string                 `protobuf:"bytes,2,opt,name=target_tenant_id,json=targetTenantId,proto3" json:"target_tenant_id,omitempty"` // Empty clones within the caller's tenant
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                             // Empty keeps the source's name
+	CopyPrices     bool                   `protobuf:"varint,4,opt,name=copy_prices,json=copyPrices,proto3" json:"copy_prices,omitempty"`              // Copy the base price and price floor; otherwise base_price is required
+	BasePrice      *Money                 `protobuf:"bytes,5,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CloneProductRequest) Reset() {
+	*x = CloneProductRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneProductRequest) ProtoMessage() {}
+
+func (x *CloneProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneProductRequest.ProtoReflect.Descriptor instead.
+func (*CloneProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CloneProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CloneProductRequest) GetTargetTenantId() string {
+	if x != nil {
+		return x.TargetTenantId
+	}
+	return ""
+}
+
+func (x *CloneProductRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CloneProductRequest) GetCopyPrices() bool {
+	if x != nil {
+		return x.CopyPrices
+	}
+	return false
+}
+
+func (x *CloneProductRequest) GetBasePrice() *Money {
+	if x != nil {
+		return x.BasePrice
+	}
+	return nil
+}
+
+// CloneProductResponse represents the response from cloning a product
+type CloneProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Product       *Product               `protobuf:"bytes,3,opt,name=product,proto3" json:"product,omitempty"` // The clone as committed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloneProductResponse) Reset() {
+	*x = CloneProductResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneProductResponse) ProtoMessage() {}
+
+func (x *CloneProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneProductResponse.ProtoReflect.Descriptor instead.
+func (*CloneProductResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *CloneProductResponse) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CloneProductResponse) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *CloneProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// SearchProductsRequest represents a free-text product search
+type SearchProductsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Query string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`  // Required; matched case-insensitively against names and descriptions
+	Limit int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // 0-100, defaults to 20
+	// "relevance" (best first, the default), "effective_price" (cheapest first) or "effective_price_desc"
+	// (dearest first); pinned products keep their positions either way
+	OrderBy string `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// Only products whose effective price is within these bounds, compared as for ListProducts
+	// Ordering and filtering by effective price need the Spanner search backend
+	MinEffectivePrice *Money `protobuf:"bytes,4,opt,name=min_effective_price,json=minEffectivePrice,proto3" json:"min_effective_price,omitempty"`
+	MaxEffectivePrice *Money `protobuf:"bytes,5,opt,name=max_effective_price,json=maxEffectivePrice,proto3" json:"max_effective_price,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SearchProductsRequest) Reset() {
+	*x = SearchProductsRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProductsRequest) ProtoMessage() {}
+
+func (x *SearchProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchProductsRequest.ProtoReflect.Descriptor instead.
+func (*SearchProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *SearchProductsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchProductsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchProductsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *SearchProductsRequest) GetMinEffectivePrice() *Money {
+	if x != nil {
+		return x.MinEffectivePrice
+	}
+	return nil
+}
+
+func (x *SearchProductsRequest) GetMaxEffectivePrice() *Money {
+	if x != nil {
+		return x.MaxEffectivePrice
+	}
+	return nil
+}
+
+// SearchHit is one product in the search results
+type SearchHit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Score         float64                `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`  // Relevance after boosts
+	Pinned        bool                   `protobuf:"varint,5,opt,name=pinned,proto3" json:"pinned,omitempty"` // Placed by a pin rule
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchHit) Reset() {
+	*x = SearchHit{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHit) ProtoMessage() {}
+
+func (x *SearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHit.ProtoReflect.Descriptor instead.
+func (*SearchHit) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *SearchHit) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SearchHit) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SearchHit) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SearchHit) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SearchHit) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+// SearchProductsResponse represents the response from searching products
+type SearchProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hits          []*SearchHit           `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"` // Best first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchProductsResponse) Reset() {
+	*x = SearchProductsResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProductsResponse) ProtoMessage() {}
+
+func (x *SearchProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchProductsResponse.ProtoReflect.Descriptor instead.
+func (*SearchProductsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *SearchProductsResponse) GetHits() []*SearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+// MerchRule curates search results without code changes
+type MerchRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`       // Output only
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"` // Pins: required; boosts: empty applies to every search
+	Kind          MerchRuleKind          `protobuf:"varint,3,opt,name=kind,proto3,enum=product.v1.MerchRuleKind" json:"kind,omitempty"`
+	ProductId     string                 `protobuf:"bytes,4,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"` // Pins only
+	Position      int64                  `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`                   // Pins only: 1-100
+	Category      string                 `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`                    // Boosts only
+	Boost         float64                `protobuf:"fixed64,7,opt,name=boost,proto3" json:"boost,omitempty"`                        // Boosts only: above 0 and up to 100, below 1 buries the category
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Output only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MerchRule) Reset() {
+	*x = MerchRule{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MerchRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MerchRule) ProtoMessage() {}
+
+func (x *MerchRule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MerchRule.ProtoReflect.Descriptor instead.
+func (*MerchRule) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *MerchRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MerchRule) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *MerchRule) GetKind() MerchRuleKind {
+	if x != nil {
+		return x.Kind
+	}
+	return MerchRuleKind_MERCH_RULE_KIND_UNSPECIFIED
+}
+
+func (x *MerchRule) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *MerchRule) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *MerchRule) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *MerchRule) GetBoost() float64 {
+	if x != nil {
+		return x.Boost
+	}
+	return 0
+}
+
+func (x *MerchRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// CreateMerchRuleRequest represents the request to create a merchandising rule
+type CreateMerchRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *MerchRule             `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMerchRuleRequest) Reset() {
+	*x = CreateMerchRuleRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMerchRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMerchRuleRequest) ProtoMessage() {}
+
+func (x *CreateMerchRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMerchRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateMerchRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *CreateMerchRuleRequest) GetRule() *MerchRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// CreateMerchRuleResponse represents the response from creating a merchandising rule
+type CreateMerchRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMerchRuleResponse) Reset() {
+	*x = CreateMerchRuleResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMerchRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMerchRuleResponse) ProtoMessage() {}
+
+func (x *CreateMerchRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMerchRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateMerchRuleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *CreateMerchRuleResponse) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+// DeleteMerchRuleRequest represents the request to delete a merchandising rule
+type DeleteMerchRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMerchRuleRequest) Reset() {
+	*x = DeleteMerchRuleRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMerchRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMerchRuleRequest) ProtoMessage() {}
+
+func (x *DeleteMerchRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMerchRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMerchRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *DeleteMerchRuleRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+// DeleteMerchRuleResponse represents the response from deleting a merchandising rule
+type DeleteMerchRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMerchRuleResponse) Reset() {
+	*x = DeleteMerchRuleResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMerchRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMerchRuleResponse) ProtoMessage() {}
+
+func (x *DeleteMerchRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMerchRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMerchRuleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *DeleteMerchRuleResponse) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+// ListMerchRulesRequest represents the request to list the tenant's merchandising rules
+type ListMerchRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMerchRulesRequest) Reset() {
+	*x = ListMerchRulesRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMerchRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMerchRulesRequest) ProtoMessage() {}
+
+func (x *ListMerchRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMerchRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListMerchRulesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{96}
+}
+
+// ListMerchRulesResponse represents the response from listing merchandising rules
+type ListMerchRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*MerchRule           `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"` // Oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMerchRulesResponse) Reset() {
+	*x = ListMerchRulesResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMerchRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMerchRulesResponse) ProtoMessage() {}
+
+func (x *ListMerchRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMerchRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListMerchRulesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ListMerchRulesResponse) GetRules() []*MerchRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// AttributeDefinition describes one attribute of a category template
+type AttributeDefinition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // Lowercase letters, digits, '_', '.' or '-'
+	Type          AttributeType          `protobuf:"varint,2,opt,name=type,proto3,enum=product.v1.AttributeType" json:"type,omitempty"`
+	Required      bool                   `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	AllowedValues []string               `protobuf:"bytes,4,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"` // Empty allows any value of the type
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttributeDefinition) Reset() {
+	*x = AttributeDefinition{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeDefinition) ProtoMessage() {}
+
+func (x *AttributeDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeDefinition.ProtoReflect.Descriptor instead.
+func (*AttributeDefinition) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *AttributeDefinition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AttributeDefinition) GetType() AttributeType {
+	if x != nil {
+		return x.Type
+	}
+	return AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED
+}
+
+func (x *AttributeDefinition) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *AttributeDefinition) GetAllowedValues() []string {
+	if x != nil {
+		return x.AllowedValues
+	}
+	return nil
+}
+
+// CategoryTemplate lists the attributes products of a category take
+type CategoryTemplate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Attributes    []*AttributeDefinition `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty"`                // 1-64, in display order
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Output only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryTemplate) Reset() {
+	*x = CategoryTemplate{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryTemplate) ProtoMessage() {}
+
+func (x *CategoryTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryTemplate.ProtoReflect.Descriptor instead.
+func (*CategoryTemplate) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *CategoryTemplate) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CategoryTemplate) GetAttributes() []*AttributeDefinition {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *CategoryTemplate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// PutCategoryTemplateRequest represents the request to create or replace a category's template
+type PutCategoryTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *CategoryTemplate      `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutCategoryTemplateRequest) Reset() {
+	*x = PutCategoryTemplateRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutCategoryTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutCategoryTemplateRequest) ProtoMessage() {}
+
+func (x *PutCategoryTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutCategoryTemplateRequest.ProtoReflect.Descriptor instead.
+func (*PutCategoryTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *PutCategoryTemplateRequest) GetTemplate() *CategoryTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// PutCategoryTemplateResponse represents the response from putting a category template
+type PutCategoryTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *CategoryTemplate      `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutCategoryTemplateResponse) Reset() {
+	*x = PutCategoryTemplateResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutCategoryTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutCategoryTemplateResponse) ProtoMessage() {}
+
+func (x *PutCategoryTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutCategoryTemplateResponse.ProtoReflect.Descriptor instead.
+func (*PutCategoryTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *PutCategoryTemplateResponse) GetTemplate() *CategoryTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// DeleteCategoryTemplateRequest represents the request to delete a category's template
+type DeleteCategoryTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryTemplateRequest) Reset() {
+	*x = DeleteCategoryTemplateRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *DeleteCategoryTemplateRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+// DeleteCategoryTemplateResponse represents the response from deleting a category template
+type DeleteCategoryTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryTemplateResponse) Reset() {
+	*x = DeleteCategoryTemplateResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteCategoryTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *DeleteCategoryTemplateResponse) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+// GetCategoryTemplateRequest represents the request to get a category's template
+type GetCategoryTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryTemplateRequest) Reset() {
+	*x = GetCategoryTemplateRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryTemplateRequest) ProtoMessage() {}
+
+func (x *GetCategoryTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *GetCategoryTemplateRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+// GetCategoryTemplateResponse represents the response from getting a category template
+type GetCategoryTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *CategoryTemplate      `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryTemplateResponse) Reset() {
+	*x = GetCategoryTemplateResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryTemplateResponse) ProtoMessage() {}
+
+func (x *GetCategoryTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryTemplateResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoryTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *GetCategoryTemplateResponse) GetTemplate() *CategoryTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// TenantSettings overrides catalog behaviour for one tenant; a zero field keeps the service default
+type TenantSettings struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	DefaultCurrency        string                 `protobuf:"bytes,1,opt,name=default_currency,json=defaultCurrency,proto3" json:"default_currency,omitempty"`                           // ISO 4217 code used when GetProductJsonLd names no currency
+	MaxNameLength          int32                  `protobuf:"varint,2,opt,name=max_name_length,json=maxNameLength,proto3" json:"max_name_length,omitempty"`                              // 1-255; longer product names are refused
+	MinDiscountBasisPoints int64                  `protobuf:"varint,3,opt,name=min_discount_basis_points,json=minDiscountBasisPoints,proto3" json:"min_discount_basis_points,omitempty"` // 0-10000; smaller discounts are refused
+	MaxDiscountBasisPoints int64                  `protobuf:"varint,4,opt,name=max_discount_basis_points,json=maxDiscountBasisPoints,proto3" json:"max_discount_basis_points,omitempty"` // 1-10000; larger discounts are refused
+	UpdatedAt              *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                                             // Output only
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *TenantSettings) Reset() {
+	*x = TenantSettings{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantSettings) ProtoMessage() {}
+
+func (x *TenantSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantSettings.ProtoReflect.Descriptor instead.
+func (*TenantSettings) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *TenantSettings) GetDefaultCurrency() string {
+	if x != nil {
+		return x.DefaultCurrency
+	}
+	return ""
+}
+
+func (x *TenantSettings) GetMaxNameLength() int32 {
+	if x != nil {
+		return x.MaxNameLength
+	}
+	return 0
+}
+
+func (x *TenantSettings) GetMinDiscountBasisPoints() int64 {
+	if x != nil {
+		return x.MinDiscountBasisPoints
+	}
+	return 0
+}
+
+func (x *TenantSettings) GetMaxDiscountBasisPoints() int64 {
+	if x != nil {
+		return x.MaxDiscountBasisPoints
+	}
+	return 0
+}
+
+func (x *TenantSettings) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// PutTenantSettingsRequest represents the request to replace the caller's tenant settings
+type PutTenantSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *TenantSettings        `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutTenantSettingsRequest) Reset() {
+	*x = PutTenantSettingsRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutTenantSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutTenantSettingsRequest) ProtoMessage() {}
+
+func (x *PutTenantSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutTenantSettingsRequest.ProtoReflect.Descriptor instead.
+func (*PutTenantSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *PutTenantSettingsRequest) GetSettings() *TenantSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// PutTenantSettingsResponse represents the response from putting tenant settings
+type PutTenantSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *TenantSettings        `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`   // As stored
+	Effective     *TenantSettings        `protobuf:"bytes,2,opt,name=effective,proto3" json:"effective,omitempty"` // With the service defaults filled in
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutTenantSettingsResponse) Reset() {
+	*x = PutTenantSettingsResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutTenantSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutTenantSettingsResponse) ProtoMessage() {}
+
+func (x *PutTenantSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutTenantSettingsResponse.ProtoReflect.Descriptor instead.
+func (*PutTenantSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *PutTenantSettingsResponse) GetSettings() *TenantSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *PutTenantSettingsResponse) GetEffective() *TenantSettings {
+	if x != nil {
+		return x.Effective
+	}
+	return nil
+}
+
+// DeleteTenantSettingsRequest represents the request to return the caller's tenant to the service defaults
+type DeleteTenantSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTenantSettingsRequest) Reset() {
+	*x = DeleteTenantSettingsRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTenantSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTenantSettingsRequest) ProtoMessage() {}
+
+func (x *DeleteTenantSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTenantSettingsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTenantSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{109}
+}
+
+// DeleteTenantSettingsResponse represents the response from deleting tenant settings
+type DeleteTenantSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Effective     *TenantSettings        `protobuf:"bytes,1,opt,name=effective,proto3" json:"effective,omitempty"` // The service defaults
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTenantSettingsResponse) Reset() {
+	*x = DeleteTenantSettingsResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTenantSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTenantSettingsResponse) ProtoMessage() {}
+
+func (x *DeleteTenantSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTenantSettingsResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTenantSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *DeleteTenantSettingsResponse) GetEffective() *TenantSettings {
+	if x != nil {
+		return x.Effective
+	}
+	return nil
+}
+
+// GetTenantSettingsRequest represents the request to get the caller's tenant settings
+type GetTenantSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantSettingsRequest) Reset() {
+	*x = GetTenantSettingsRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantSettingsRequest) ProtoMessage() {}
+
+func (x *GetTenantSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetTenantSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{111}
+}
+
+// GetTenantSettingsResponse represents the response from getting tenant settings
+type GetTenantSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *TenantSettings        `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`   // As stored; unset when the tenant uses the service defaults
+	Effective     *TenantSettings        `protobuf:"bytes,2,opt,name=effective,proto3" json:"effective,omitempty"` // With the service defaults filled in
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantSettingsResponse) Reset() {
+	*x = GetTenantSettingsResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantSettingsResponse) ProtoMessage() {}
+
+func (x *GetTenantSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetTenantSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *GetTenantSettingsResponse) GetSettings() *TenantSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *GetTenantSettingsResponse) GetEffective() *TenantSettings {
+	if x != nil {
+		return x.Effective
+	}
+	return nil
+}
+
+// Tenant is a tenant registered by CreateTenant, or by DeleteTenant for a tenant that predates provisioning
+type Tenant struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TenantId       string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	RegistrationId string                 `protobuf:"bytes,2,opt,name=registration_id,json=registrationId,proto3" json:"registration_id,omitempty"` // Identifies this incarnation of the tenant; aggregate of its audit events
+	DisplayName    string                 `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	State          TenantState            `protobuf:"varint,4,opt,name=state,proto3,enum=product.v1.TenantState" json:"state,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DeletedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Tenant) Reset() {
+	*x = Tenant{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tenant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tenant) ProtoMessage() {}
+
+func (x *Tenant) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tenant.ProtoReflect.Descriptor instead.
+func (*Tenant) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *Tenant) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *Tenant) GetRegistrationId() string {
+	if x != nil {
+		return x.RegistrationId
+	}
+	return ""
+}
+
+func (x *Tenant) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Tenant) GetState() TenantState {
+	if x != nil {
+		return x.State
+	}
+	return TenantState_TENANT_STATE_UNSPECIFIED
+}
+
+func (x *Tenant) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Tenant) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Tenant) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+// TenantApiKeySpec is an API key to issue for a new tenant
+type TenantApiKeySpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []ApiKeyScope          `protobuf:"varint,2,rep,packed,name=scopes,proto3,enum=product.v1.ApiKeyScope" json:"scopes,omitempty"` // At least one
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantApiKeySpec) Reset() {
+	*x = TenantApiKeySpec{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantApiKeySpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantApiKeySpec) ProtoMessage() {}
+
+func (x *TenantApiKeySpec) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantApiKeySpec.ProtoReflect.Descriptor instead.
+func (*TenantApiKeySpec) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *TenantApiKeySpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TenantApiKeySpec) GetScopes() []ApiKeyScope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+// TenantApiKey is an API key issued for a new tenant
+type TenantApiKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        *ApiKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"` // Shown only once
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantApiKey) Reset() {
+	*x = TenantApiKey{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantApiKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantApiKey) ProtoMessage() {}
+
+func (x *TenantApiKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantApiKey.ProtoReflect.Descriptor instead.
+func (*TenantApiKey) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *TenantApiKey) GetApiKey() *ApiKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *TenantApiKey) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+// CreateTenantRequest represents the request to provision a tenant
+type CreateTenantRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TenantId          string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"` // Becomes the tenant's x-tenant-id
+	DisplayName       string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Settings          *TenantSettings        `protobuf:"bytes,3,opt,name=settings,proto3" json:"settings,omitempty"`                                            // Unset keeps the service defaults
+	CategoryTemplates []*CategoryTemplate    `protobuf:"bytes,4,rep,name=category_templates,json=categoryTemplates,proto3" json:"category_templates,omitempty"` // At most 100, one per category
+	ApiKeys           []*TenantApiKeySpec    `protobuf:"bytes,5,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`                               // At most 10
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateTenantRequest) Reset() {
+	*x = CreateTenantRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTenantRequest) ProtoMessage() {}
+
+func (x *CreateTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTenantRequest.ProtoReflect.Descriptor instead.
+func (*CreateTenantRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *CreateTenantRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *CreateTenantRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *CreateTenantRequest) GetSettings() *TenantSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *CreateTenantRequest) GetCategoryTemplates() []*CategoryTemplate {
+	if x != nil {
+		return x.CategoryTemplates
+	}
+	return nil
+}
+
+func (x *CreateTenantRequest) GetApiKeys() []*TenantApiKeySpec {
+	if x != nil {
+		return x.ApiKeys
+	}
+	return nil
+}
+
+// CreateTenantResponse identifies the operation seeding the tenant and returns the issued keys
+type CreateTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenant        *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	ApiKeys       []*TenantApiKey        `protobuf:"bytes,2,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
+	OperationName string                 `protobuf:"bytes,3,opt,name=operation_name,json=operationName,proto3" json:"operation_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTenantResponse) Reset() {
+	*x = CreateTenantResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTenantResponse) ProtoMessage() {}
+
+func (x *CreateTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTenantResponse.ProtoReflect.Descriptor instead.
+func (*CreateTenantResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *CreateTenantResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+func (x *CreateTenantResponse) GetApiKeys() []*TenantApiKey {
+	if x != nil {
+		return x.ApiKeys
+	}
+	return nil
+}
+
+func (x *CreateTenantResponse) GetOperationName() string {
+	if x != nil {
+		return x.OperationName
+	}
+	return ""
+}
+
+// CreateTenantResult is the response of a finished CreateTenant operation
+type CreateTenantResult struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Tenant            *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	CategoryTemplates int32                  `protobuf:"varint,2,opt,name=category_templates,json=categoryTemplates,proto3" json:"category_templates,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateTenantResult) Reset() {
+	*x = CreateTenantResult{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTenantResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTenantResult) ProtoMessage() {}
+
+func (x *CreateTenantResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTenantResult.ProtoReflect.Descriptor instead.
+func (*CreateTenantResult) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *CreateTenantResult) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+func (x *CreateTenantResult) GetCategoryTemplates() int32 {
+	if x != nil {
+		return x.CategoryTemplates
+	}
+	return 0
+}
+
+// DeleteTenantRequest represents the request to delete a tenant and all of its data
+type DeleteTenantRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	TenantId            string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Export              bool                   `protobuf:"varint,2,opt,name=export,proto3" json:"export,omitempty"`                                                         // Upload each product's export document before purging it
+	StartAfterProductId string                 `protobuf:"bytes,3,opt,name=start_after_product_id,json=startAfterProductId,proto3" json:"start_after_product_id,omitempty"` // Resume a previous run from its checkpoint
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *DeleteTenantRequest) Reset() {
+	*x = DeleteTenantRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTenantRequest) ProtoMessage() {}
+
+func (x *DeleteTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTenantRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTenantRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *DeleteTenantRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *DeleteTenantRequest) GetExport() bool {
+	if x != nil {
+		return x.Export
+	}
+	return false
+}
+
+func (x *DeleteTenantRequest) GetStartAfterProductId() string {
+	if x != nil {
+		return x.StartAfterProductId
+	}
+	return ""
+}
+
+// DeleteTenantResponse identifies the operation purging the tenant
+type DeleteTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenant        *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	OperationName string                 `protobuf:"bytes,2,opt,name=operation_name,json=operationName,proto3" json:"operation_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTenantResponse) Reset() {
+	*x = DeleteTenantResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTenantResponse) ProtoMessage() {}
+
+func (x *DeleteTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTenantResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTenantResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *DeleteTenantResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+func (x *DeleteTenantResponse) GetOperationName() string {
+	if x != nil {
+		return x.OperationName
+	}
+	return ""
+}
+
+// DeleteTenantResult is the response of a finished DeleteTenant operation
+type DeleteTenantResult struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Tenant           *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	ApiKeysRevoked   int32                  `protobuf:"varint,2,opt,name=api_keys_revoked,json=apiKeysRevoked,proto3" json:"api_keys_revoked,omitempty"`
+	ProductsPurged   int64                  `protobuf:"varint,3,opt,name=products_purged,json=productsPurged,proto3" json:"products_purged,omitempty"`
+	ProductsExported int64                  `protobuf:"varint,4,opt,name=products_exported,json=productsExported,proto3" json:"products_exported,omitempty"`
+	RowsDeleted      int64                  `protobuf:"varint,5,opt,name=rows_deleted,json=rowsDeleted,proto3" json:"rows_deleted,omitempty"`   // Rows removed from the tenant's other tables
+	ExportPrefix     string                 `protobuf:"bytes,6,opt,name=export_prefix,json=exportPrefix,proto3" json:"export_prefix,omitempty"` // Object prefix in the tenant export bucket; empty without export
+	LastProductId    string                 `protobuf:"bytes,7,opt,name=last_product_id,json=lastProductId,proto3" json:"last_product_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DeleteTenantResult) Reset() {
+	*x = DeleteTenantResult{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTenantResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTenantResult) ProtoMessage() {}
+
+func (x *DeleteTenantResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTenantResult.ProtoReflect.Descriptor instead.
+func (*DeleteTenantResult) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *DeleteTenantResult) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+func (x *DeleteTenantResult) GetApiKeysRevoked() int32 {
+	if x != nil {
+		return x.ApiKeysRevoked
+	}
+	return 0
+}
+
+func (x *DeleteTenantResult) GetProductsPurged() int64 {
+	if x != nil {
+		return x.ProductsPurged
+	}
+	return 0
+}
+
+func (x *DeleteTenantResult) GetProductsExported() int64 {
+	if x != nil {
+		return x.ProductsExported
+	}
+	return 0
+}
+
+func (x *DeleteTenantResult) GetRowsDeleted() int64 {
+	if x != nil {
+		return x.RowsDeleted
+	}
+	return 0
+}
+
+func (x *DeleteTenantResult) GetExportPrefix() string {
+	if x != nil {
+		return x.ExportPrefix
+	}
+	return ""
+}
+
+func (x *DeleteTenantResult) GetLastProductId() string {
+	if x != nil {
+		return x.LastProductId
+	}
+	return ""
+}
+
+// SuggestProductsRequest represents a search-as-you-type request
+type SuggestProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prefix        string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"` // Required; matched case-insensitively against the start of names and categories
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`  // 0-50, defaults to 10
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestProductsRequest) Reset() {
+	*x = SuggestProductsRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestProductsRequest) ProtoMessage() {}
+
+func (x *SuggestProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestProductsRequest.ProtoReflect.Descriptor instead.
+func (*SuggestProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *SuggestProductsRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *SuggestProductsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Suggestion is one completion of a search prefix
+type Suggestion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Kind          SuggestionKind         `protobuf:"varint,2,opt,name=kind,proto3,enum=product.v1.SuggestionKind" json:"kind,omitempty"`
+	Popularity    int64                  `protobuf:"varint,3,opt,name=popularity,proto3" json:"popularity,omitempty"` // Active products carrying the text
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Suggestion) Reset() {
+	*x = Suggestion{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Suggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Suggestion) ProtoMessage() {}
+
+func (x *Suggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Suggestion.ProtoReflect.Descriptor instead.
+func (*Suggestion) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *Suggestion) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Suggestion) GetKind() SuggestionKind {
+	if x != nil {
+		return x.Kind
+	}
+	return SuggestionKind_SUGGESTION_KIND_UNSPECIFIED
+}
+
+func (x *Suggestion) GetPopularity() int64 {
+	if x != nil {
+		return x.Popularity
+	}
+	return 0
+}
+
+// SuggestProductsResponse represents the response from suggesting completions
+type SuggestProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suggestions   []*Suggestion          `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"` // Most popular first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestProductsResponse) Reset() {
+	*x = SuggestProductsResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestProductsResponse) ProtoMessage() {}
+
+func (x *SuggestProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestProductsResponse.ProtoReflect.Descriptor instead.
+func (*SuggestProductsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *SuggestProductsResponse) GetSuggestions() []*Suggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+// RecordProductViewRequest represents a storefront view of a product
+type RecordProductViewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordProductViewRequest) Reset() {
+	*x = RecordProductViewRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordProductViewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordProductViewRequest) ProtoMessage() {}
+
+func (x *RecordProductViewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordProductViewRequest.ProtoReflect.Descriptor instead.
+func (*RecordProductViewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *RecordProductViewRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+// RecordProductViewResponse represents the response from recording a view
+type RecordProductViewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordProductViewResponse) Reset() {
+	*x = RecordProductViewResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordProductViewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordProductViewResponse) ProtoMessage() {}
+
+func (x *RecordProductViewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordProductViewResponse.ProtoReflect.Descriptor instead.
+func (*RecordProductViewResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{126}
+}
+
+// ListCategoriesRequest represents the request to list the tenant's category tree
+type ListCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IncludeEmpty  bool                   `protobuf:"varint,1,opt,name=include_empty,json=includeEmpty,proto3" json:"include_empty,omitempty"` // Also return categories whose products are all inactive
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesRequest) Reset() {
+	*x = ListCategoriesRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesRequest) ProtoMessage() {}
+
+func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *ListCategoriesRequest) GetIncludeEmpty() bool {
+	if x != nil {
+		return x.IncludeEmpty
+	}
+	return false
+}
+
+// Category is a node of the category tree; a category named "electronics/laptops" is a child of "electronics"
+type Category struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Name                    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                                           // Last path segment, e.g. "laptops"
+	Path                    string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`                                                                           // The category products are filed under, e.g. "electronics/laptops"
+	ActiveProductCount      int64                  `protobuf:"varint,3,opt,name=active_product_count,json=activeProductCount,proto3" json:"active_product_count,omitempty"`                  // Active products filed under path itself
+	TotalActiveProductCount int64                  `protobuf:"varint,4,opt,name=total_active_product_count,json=totalActiveProductCount,proto3" json:"total_active_product_count,omitempty"` // Including every descendant
+	Children                []*Category            `protobuf:"bytes,5,rep,name=children,proto3" json:"children,omitempty"`                                                                   // By name
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Category) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Category) GetActiveProductCount() int64 {
+	if x != nil {
+		return x.ActiveProductCount
+	}
+	return 0
+}
+
+func (x *Category) GetTotalActiveProductCount() int64 {
+	if x != nil {
+		return x.TotalActiveProductCount
+	}
+	return 0
+}
+
+func (x *Category) GetChildren() []*Category {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+// ListCategoriesResponse represents the response from listing categories
+type ListCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"` // Top-level categories by name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResponse) ProtoMessage() {}
+
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ListCategoriesResponse) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+// GetProductStatsRequest represents the request for products' view counters
+type GetProductStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductIds    []string               `protobuf:"bytes,1,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"` // 1-100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductStatsRequest) Reset() {
+	*x = GetProductStatsRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductStatsRequest) ProtoMessage() {}
+
+func (x *GetProductStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetProductStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *GetProductStatsRequest) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+// ProductStats holds a product's view counter
+type ProductStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ViewCount     int64                  `protobuf:"varint,2,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`           // 0 for products without recorded views
+	LastViewedAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_viewed_at,json=lastViewedAt,proto3" json:"last_viewed_at,omitempty"` // Unset until a view is recorded
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductStats) Reset() {
+	*x = ProductStats{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductStats) ProtoMessage() {}
+
+func (x *ProductStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductStats.ProtoReflect.Descriptor instead.
+func (*ProductStats) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *ProductStats) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ProductStats) GetViewCount() int64 {
+	if x != nil {
+		return x.ViewCount
+	}
+	return 0
+}
+
+func (x *ProductStats) GetLastViewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastViewedAt
+	}
+	return nil
+}
+
+// GetProductStatsResponse represents the response from getting product stats
+type GetProductStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stats         []*ProductStats        `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"` // In request order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductStatsResponse) Reset() {
+	*x = GetProductStatsResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductStatsResponse) ProtoMessage() {}
+
+func (x *GetProductStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetProductStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *GetProductStatsResponse) GetStats() []*ProductStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// ListCuratedProductsRequest represents the request for a curated storefront list
+type ListCuratedProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // 0-50, defaults to 20
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCuratedProductsRequest) Reset() {
+	*x = ListCuratedProductsRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCuratedProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCuratedProductsRequest) ProtoMessage() {}
+
+func (x *ListCuratedProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCuratedProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListCuratedProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *ListCuratedProductsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ListCuratedProductsResponse represents the response from listing a curated storefront list
+type ListCuratedProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`                       // In list order; products deactivated or archived since the last refresh are skipped
+	ComputedAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"` // Unset until the list has been computed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCuratedProductsResponse) Reset() {
+	*x = ListCuratedProductsResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCuratedProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCuratedProductsResponse) ProtoMessage() {}
+
+func (x *ListCuratedProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCuratedProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListCuratedProductsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *ListCuratedProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ListCuratedProductsResponse) GetComputedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return nil
+}
+
+// GetRecommendationsRequest represents the request for recommendations alongside a seed product
+type GetRecommendationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"` // Seed product
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                         // 0-50, defaults to 10
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecommendationsRequest) Reset() {
+	*x = GetRecommendationsRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecommendationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecommendationsRequest) ProtoMessage() {}
+
+func (x *GetRecommendationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecommendationsRequest.ProtoReflect.Descriptor instead.
+func (*GetRecommendationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *GetRecommendationsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetRecommendationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// GetRecommendationsResponse represents the response from getting recommendations
+type GetRecommendationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"` // Best first; only active products, never the seed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecommendationsResponse) Reset() {
+	*x = GetRecommendationsResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecommendationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecommendationsResponse) ProtoMessage() {}
+
+func (x *GetRecommendationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecommendationsResponse.ProtoReflect.Descriptor instead.
+func (*GetRecommendationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *GetRecommendationsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+// GetProductJsonLdRequest represents the request for a product's schema.org snippet
+type GetProductJsonLdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"` // ISO 4217 code, e.g. "USD"; defaults to the tenant's default currency
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`           // Product page URL to include; optional
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductJsonLdRequest) Reset() {
+	*x = GetProductJsonLdRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductJsonLdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductJsonLdRequest) ProtoMessage() {}
+
+func (x *GetProductJsonLdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductJsonLdRequest.ProtoReflect.Descriptor instead.
+func (*GetProductJsonLdRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *GetProductJsonLdRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetProductJsonLdRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetProductJsonLdRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// GetProductJsonLdResponse represents the response from getting a product's schema.org snippet
+type GetProductJsonLdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JsonLd        string                 `protobuf:"bytes,1,opt,name=json_ld,json=jsonLd,proto3" json:"json_ld,omitempty"`                // schema.org Product as JSON, for a <script type="application/ld+json"> element
+	AliasedFrom   string                 `protobuf:"bytes,2,opt,name=aliased_from,json=aliasedFrom,proto3" json:"aliased_from,omitempty"` // The requested ID when it is an alias of the product, e.g. a merged duplicate
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductJsonLdResponse) Reset() {
+	*x = GetProductJsonLdResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductJsonLdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductJsonLdResponse) ProtoMessage() {}
+
+func (x *GetProductJsonLdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductJsonLdResponse.ProtoReflect.Descriptor instead.
+func (*GetProductJsonLdResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *GetProductJsonLdResponse) GetJsonLd() string {
+	if x != nil {
+		return x.JsonLd
+	}
+	return ""
+}
+
+func (x *GetProductJsonLdResponse) GetAliasedFrom() string {
+	if x != nil {
+		return x.AliasedFrom
+	}
+	return ""
+}
+
+// ApiKey describes an issued API key; the secret is never returned after issuing
+type ApiKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []ApiKeyScope          `protobuf:"varint,3,rep,packed,name=scopes,proto3,enum=product.v1.ApiKeyScope" json:"scopes,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"` // Unset while the key is valid
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiKey) Reset() {
+	*x = ApiKey{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKey) ProtoMessage() {}
+
+func (x *ApiKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKey.ProtoReflect.Descriptor instead.
+func (*ApiKey) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *ApiKey) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *ApiKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApiKey) GetScopes() []ApiKeyScope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *ApiKey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ApiKey) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+// IssueApiKeyRequest represents the request to issue an API key for the caller's tenant
+type IssueApiKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                         // Required; identifies the client, e.g. "erp-sync"
+	Scopes        []ApiKeyScope          `protobuf:"varint,2,rep,packed,name=scopes,proto3,enum=product.v1.ApiKeyScope" json:"scopes,omitempty"` // At least one
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueApiKeyRequest) Reset() {
+	*x = IssueApiKeyRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueApiKeyRequest) ProtoMessage() {}
+
+func (x *IssueApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*IssueApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *IssueApiKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IssueApiKeyRequest) GetScopes() []ApiKeyScope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+// IssueApiKeyResponse represents the response from issuing an API key
+type IssueApiKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        *ApiKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"` // Send as x-api-key metadata; shown only once
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueApiKeyResponse) Reset() {
+	*x = IssueApiKeyResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueApiKeyResponse) ProtoMessage() {}
+
+func (x *IssueApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*IssueApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *IssueApiKeyResponse) GetApiKey() *ApiKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *IssueApiKeyResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+// RevokeApiKeyRequest represents the request to revoke an API key
+type RevokeApiKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiKeyRequest) Reset() {
+	*x = RevokeApiKeyRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiKeyRequest) ProtoMessage() {}
+
+func (x *RevokeApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *RevokeApiKeyRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+// RevokeApiKeyResponse represents the response from revoking an API key
+type RevokeApiKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        *ApiKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiKeyResponse) Reset() {
+	*x = RevokeApiKeyResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiKeyResponse) ProtoMessage() {}
+
+func (x *RevokeApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *RevokeApiKeyResponse) GetApiKey() *ApiKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+// ListApiKeysRequest represents the request to list the caller's API keys
+type ListApiKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiKeysRequest) Reset() {
+	*x = ListApiKeysRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeysRequest) ProtoMessage() {}
+
+func (x *ListApiKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListApiKeysRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{144}
+}
+
+// ListApiKeysResponse represents the response from listing API keys
+type ListApiKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKeys       []*ApiKey              `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"` // Newest first, including revoked keys
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiKeysResponse) Reset() {
+	*x = ListApiKeysResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeysResponse) ProtoMessage() {}
+
+func (x *ListApiKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListApiKeysResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *ListApiKeysResponse) GetApiKeys() []*ApiKey {
+	if x != nil {
+		return x.ApiKeys
+	}
+	return nil
+}
+
+// GetUsageRequest represents the request for the caller's tenant's usage
+type GetUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // Defaults to 30 days before end_time; truncated to the UTC day
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`       // Exclusive; defaults to the end of the current UTC day, at most 366 days after start_time
+	KeyId         string                 `protobuf:"bytes,3,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`             // Limits the usage to one API key; optional
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageRequest) Reset() {
+	*x = GetUsageRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageRequest) ProtoMessage() {}
+
+func (x *GetUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *GetUsageRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetUsageRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *GetUsageRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+// UsageRecord is one caller's usage on one UTC day
+type UsageRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"` // Empty for requests made without an API key
+	Day           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=day,proto3" json:"day,omitempty"`
+	RequestCount  int64                  `protobuf:"varint,3,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`
+	RowsReturned  int64                  `protobuf:"varint,4,opt,name=rows_returned,json=rowsReturned,proto3" json:"rows_returned,omitempty"`    // List entries, or the single product, returned by successful requests
+	MutationCount int64                  `protobuf:"varint,5,opt,name=mutation_count,json=mutationCount,proto3" json:"mutation_count,omitempty"` // Spanner mutations committed by write requests
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UsageRecord) Reset() {
+	*x = UsageRecord{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageRecord) ProtoMessage() {}
+
+func (x *UsageRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageRecord.ProtoReflect.Descriptor instead.
+func (*UsageRecord) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *UsageRecord) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *UsageRecord) GetDay() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Day
+	}
+	return nil
+}
+
+func (x *UsageRecord) GetRequestCount() int64 {
+	if x != nil {
+		return x.RequestCount
+	}
+	return 0
+}
+
+func (x *UsageRecord) GetRowsReturned() int64 {
+	if x != nil {
+		return x.RowsReturned
+	}
+	return 0
+}
+
+func (x *UsageRecord) GetMutationCount() int64 {
+	if x != nil {
+		return x.MutationCount
+	}
+	return 0
+}
+
+// GetUsageResponse represents the response from getting usage
+type GetUsageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Records       []*UsageRecord         `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"` // Oldest day first; usage still buffered on servers is not included
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageResponse) Reset() {
+	*x = GetUsageResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageResponse) ProtoMessage() {}
+
+func (x *GetUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetUsageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *GetUsageResponse) GetRecords() []*UsageRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+// FaultInjection describes the faults injected into Spanner commits and read model queries
+type FaultInjection struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Latency            *durationpb.Duration   `protobuf:"bytes,1,opt,name=latency,proto3" json:"latency,omitempty"`                                                   // Added to every call
+	AbortPercent       float64                `protobuf:"fixed64,2,opt,name=abort_percent,json=abortPercent,proto3" json:"abort_percent,omitempty"`                   // Calls failing with ABORTED, retried like real Spanner aborts
+	UnavailablePercent float64                `protobuf:"fixed64,3,opt,name=unavailable_percent,json=unavailablePercent,proto3" json:"unavailable_percent,omitempty"` // Calls failing with UNAVAILABLE
+	NotFoundPercent    float64                `protobuf:"fixed64,4,opt,name=not_found_percent,json=notFoundPercent,proto3" json:"not_found_percent,omitempty"`        // Commits and product lookups failing with NOT_FOUND; the percentages add up to at most 100
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *FaultInjection) Reset() {
+	*x = FaultInjection{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FaultInjection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FaultInjection) ProtoMessage() {}
+
+func (x *FaultInjection) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FaultInjection.ProtoReflect.Descriptor instead.
+func (*FaultInjection) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *FaultInjection) GetLatency() *durationpb.Duration {
+	if x != nil {
+		return x.Latency
+	}
+	return nil
+}
+
+func (x *FaultInjection) GetAbortPercent() float64 {
+	if x != nil {
+		return x.AbortPercent
+	}
+	return 0
+}
+
+func (x *FaultInjection) GetUnavailablePercent() float64 {
+	if x != nil {
+		return x.UnavailablePercent
+	}
+	return 0
+}
+
+func (x *FaultInjection) GetNotFoundPercent() float64 {
+	if x != nil {
+		return x.NotFoundPercent
+	}
+	return 0
+}
+
+// GetFaultInjectionRequest represents the request for the current fault injection
+type GetFaultInjectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFaultInjectionRequest) Reset() {
+	*x = GetFaultInjectionRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFaultInjectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFaultInjectionRequest) ProtoMessage() {}
+
+func (x *GetFaultInjectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFaultInjectionRequest.ProtoReflect.Descriptor instead.
+func (*GetFaultInjectionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{150}
+}
+
+// GetFaultInjectionResponse represents the response from getting the fault injection
+type GetFaultInjectionResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	FaultInjection *FaultInjection        `protobuf:"bytes,1,opt,name=fault_injection,json=faultInjection,proto3" json:"fault_injection,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetFaultInjectionResponse) Reset() {
+	*x = GetFaultInjectionResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFaultInjectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFaultInjectionResponse) ProtoMessage() {}
+
+func (x *GetFaultInjectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFaultInjectionResponse.ProtoReflect.Descriptor instead.
+func (*GetFaultInjectionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *GetFaultInjectionResponse) GetFaultInjection() *FaultInjection {
+	if x != nil {
+		return x.FaultInjection
+	}
+	return nil
+}
+
+// SetFaultInjectionRequest represents the request to replace the fault injection on the server handling it
+type SetFaultInjectionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	FaultInjection *FaultInjection        `protobuf:"bytes,1,opt,name=fault_injection,json=faultInjection,proto3" json:"fault_injection,omitempty"` // Unset clears all faults
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetFaultInjectionRequest) Reset() {
+	*x = SetFaultInjectionRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProductRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+func (x *SetFaultInjectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CreateProductRequest) GetDescription() string {
+func (*SetFaultInjectionRequest) ProtoMessage() {}
+
+func (x *SetFaultInjectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[152]
 	if x != nil {
-		return x.Description
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateProductRequest) GetCategory() string {
-	if x != nil {
-		return x.Category
-	}
-	return ""
+// Deprecated: Use SetFaultInjectionRequest.ProtoReflect.Descriptor instead.
+func (*SetFaultInjectionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{152}
 }
 
-func (x *CreateProductRequest) GetBasePrice() *Money {
+func (x *SetFaultInjectionRequest) GetFaultInjection() *FaultInjection {
 	if x != nil {
-		return x.BasePrice
+		return x.FaultInjection
 	}
 	return nil
 }
 
-// CreateProductResponse represents the response from creating a product
-type CreateProductResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// SetFaultInjectionResponse represents the response from setting the fault injection
+type SetFaultInjectionResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	FaultInjection *FaultInjection        `protobuf:"bytes,1,opt,name=fault_injection,json=faultInjection,proto3" json:"fault_injection,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CreateProductResponse) Reset() {
-	*x = CreateProductResponse{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[4]
+func (x *SetFaultInjectionResponse) Reset() {
+	*x = SetFaultInjectionResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProductResponse) String() string {
+func (x *SetFaultInjectionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProductResponse) ProtoMessage() {}
+func (*SetFaultInjectionResponse) ProtoMessage() {}
 
-func (x *CreateProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[4]
+func (x *SetFaultInjectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -364,44 +9786,46 @@ func (x *CreateProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProductResponse.ProtoReflect.Descriptor instead.
-func (*CreateProductResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use SetFaultInjectionResponse.ProtoReflect.Descriptor instead.
+func (*SetFaultInjectionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{153}
 }
 
-func (x *CreateProductResponse) GetProductId() string {
+func (x *SetFaultInjectionResponse) GetFaultInjection() *FaultInjection {
 	if x != nil {
-		return x.ProductId
+		return x.FaultInjection
 	}
-	return ""
+	return nil
 }
 
-// UpdateProductRequest represents the request to update a product
-type UpdateProductRequest struct {
+// ProductVersion is an immutable snapshot of a product's content, written with each change to it
+type ProductVersion struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Category      *string                `protobuf:"bytes,4,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	VersionId     string                 `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Category      string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProductRequest) Reset() {
-	*x = UpdateProductRequest{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[5]
+func (x *ProductVersion) Reset() {
+	*x = ProductVersion{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[154]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProductRequest) String() string {
+func (x *ProductVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProductRequest) ProtoMessage() {}
+func (*ProductVersion) ProtoMessage() {}
 
-func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[5]
+func (x *ProductVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[154]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -412,107 +9836,76 @@ func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
-func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ProductVersion.ProtoReflect.Descriptor instead.
+func (*ProductVersion) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{154}
 }
 
-func (x *UpdateProductRequest) GetProductId() string {
+func (x *ProductVersion) GetVersionId() string {
 	if x != nil {
-		return x.ProductId
+		return x.VersionId
 	}
 	return ""
 }
 
-func (x *UpdateProductRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *ProductVersion) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *UpdateProductRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *ProductVersion) GetDescription() string {
+	if x != nil {
+		return x.Description
 	}
 	return ""
 }
 
-func (x *UpdateProductRequest) GetCategory() string {
-	if x != nil && x.Category != nil {
-		return *x.Category
+func (x *ProductVersion) GetCategory() string {
+	if x != nil {
+		return x.Category
 	}
 	return ""
 }
 
-// UpdateProductResponse represents the response from updating a product
-type UpdateProductResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UpdateProductResponse) Reset() {
-	*x = UpdateProductResponse{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[6]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *UpdateProductResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*UpdateProductResponse) ProtoMessage() {}
-
-func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[6]
+func (x *ProductVersion) GetMetadata() map[string]string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Metadata
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UpdateProductResponse.ProtoReflect.Descriptor instead.
-func (*UpdateProductResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{6}
+	return nil
 }
 
-func (x *UpdateProductResponse) GetProductId() string {
+func (x *ProductVersion) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ProductId
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-// GetProductRequest represents the request to get a product
-type GetProductRequest struct {
+// ListProductVersionsRequest represents the request to list a product's content versions
+type ListProductVersionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProductRequest) Reset() {
-	*x = GetProductRequest{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[7]
+func (x *ListProductVersionsRequest) Reset() {
+	*x = ListProductVersionsRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductRequest) String() string {
+func (x *ListProductVersionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductRequest) ProtoMessage() {}
+func (*ListProductVersionsRequest) ProtoMessage() {}
 
-func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[7]
+func (x *ListProductVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -523,41 +9916,42 @@ func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
-func (*GetProductRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ListProductVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{155}
 }
 
-func (x *GetProductRequest) GetProductId() string {
+func (x *ListProductVersionsRequest) GetProductId() string {
 	if x != nil {
 		return x.ProductId
 	}
 	return ""
 }
 
-// GetProductResponse represents the response from getting a product
-type GetProductResponse struct {
+// ListProductVersionsResponse represents the response from listing content versions
+type ListProductVersionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Versions      []*ProductVersion      `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions,omitempty"` // Newest first; the first one is the current content
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProductResponse) Reset() {
-	*x = GetProductResponse{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[8]
+func (x *ListProductVersionsResponse) Reset() {
+	*x = ListProductVersionsResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductResponse) String() string {
+func (x *ListProductVersionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductResponse) ProtoMessage() {}
+func (*ListProductVersionsResponse) ProtoMessage() {}
 
-func (x *GetProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[8]
+func (x *ListProductVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -568,44 +9962,49 @@ func (x *GetProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductResponse.ProtoReflect.Descriptor instead.
-func (*GetProductResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ListProductVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{156}
 }
 
-func (x *GetProductResponse) GetProduct() *Product {
+func (x *ListProductVersionsResponse) GetProductId() string {
 	if x != nil {
-		return x.Product
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ListProductVersionsResponse) GetVersions() []*ProductVersion {
+	if x != nil {
+		return x.Versions
 	}
 	return nil
 }
 
-// ListProductsRequest represents the request to list products
-type ListProductsRequest struct {
+// RollbackToVersionRequest represents the request to restore a product's content from a version
+type RollbackToVersionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Category      *string                `protobuf:"bytes,1,opt,name=category,proto3,oneof" json:"category,omitempty"`
-	Status        *string                `protobuf:"bytes,2,opt,name=status,proto3,oneof" json:"status,omitempty"`
-	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VersionId     string                 `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProductsRequest) Reset() {
-	*x = ListProductsRequest{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[9]
+func (x *RollbackToVersionRequest) Reset() {
+	*x = RollbackToVersionRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductsRequest) String() string {
+func (x *RollbackToVersionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductsRequest) ProtoMessage() {}
+func (*RollbackToVersionRequest) ProtoMessage() {}
 
-func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[9]
+func (x *RollbackToVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -616,63 +10015,49 @@ func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
-func (*ListProductsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *ListProductsRequest) GetCategory() string {
-	if x != nil && x.Category != nil {
-		return *x.Category
-	}
-	return ""
-}
-
-func (x *ListProductsRequest) GetStatus() string {
-	if x != nil && x.Status != nil {
-		return *x.Status
-	}
-	return ""
+// Deprecated: Use RollbackToVersionRequest.ProtoReflect.Descriptor instead.
+func (*RollbackToVersionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{157}
 }
 
-func (x *ListProductsRequest) GetLimit() int32 {
+func (x *RollbackToVersionRequest) GetProductId() string {
 	if x != nil {
-		return x.Limit
+		return x.ProductId
 	}
-	return 0
+	return ""
 }
 
-func (x *ListProductsRequest) GetOffset() int32 {
+func (x *RollbackToVersionRequest) GetVersionId() string {
 	if x != nil {
-		return x.Offset
+		return x.VersionId
 	}
-	return 0
+	return ""
 }
 
-// ListProductsResponse represents the response from listing products
-type ListProductsResponse struct {
+// RollbackToVersionResponse represents the response from a rollback
+type RollbackToVersionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VersionId     string                 `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"` // The new version holding the restored content; empty when nothing changed
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProductsResponse) Reset() {
-	*x = ListProductsResponse{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[10]
+func (x *RollbackToVersionResponse) Reset() {
+	*x = RollbackToVersionResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductsResponse) String() string {
+func (x *RollbackToVersionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductsResponse) ProtoMessage() {}
+func (*RollbackToVersionResponse) ProtoMessage() {}
 
-func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[10]
+func (x *RollbackToVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -683,49 +10068,53 @@ func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
-func (*ListProductsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use RollbackToVersionResponse.ProtoReflect.Descriptor instead.
+func (*RollbackToVersionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{158}
 }
 
-func (x *ListProductsResponse) GetProducts() []*Product {
+func (x *RollbackToVersionResponse) GetProductId() string {
 	if x != nil {
-		return x.Products
+		return x.ProductId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListProductsResponse) GetTotal() int32 {
+func (x *RollbackToVersionResponse) GetVersionId() string {
 	if x != nil {
-		return x.Total
+		return x.VersionId
 	}
-	return 0
+	return ""
 }
 
-// ApplyDiscountRequest represents the request to apply a discount
-type ApplyDiscountRequest struct {
+// SaveDraftRequest stages content in a product's draft
+// Unset fields keep the draft's value, or the published value when the product has no draft yet
+type SaveDraftRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Discount      *Discount              `protobuf:"bytes,2,opt,name=discount,proto3" json:"discount,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Category      *string                `protobuf:"bytes,4,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	Metadata      *DraftMetadata         `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"` // Replaces the draft's metadata when set
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApplyDiscountRequest) Reset() {
-	*x = ApplyDiscountRequest{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[11]
+func (x *SaveDraftRequest) Reset() {
+	*x = SaveDraftRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[159]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplyDiscountRequest) String() string {
+func (x *SaveDraftRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplyDiscountRequest) ProtoMessage() {}
+func (*SaveDraftRequest) ProtoMessage() {}
 
-func (x *ApplyDiscountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[11]
+func (x *SaveDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[159]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -736,93 +10125,69 @@ func (x *ApplyDiscountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplyDiscountRequest.ProtoReflect.Descriptor instead.
-func (*ApplyDiscountRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use SaveDraftRequest.ProtoReflect.Descriptor instead.
+func (*SaveDraftRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{159}
 }
 
-func (x *ApplyDiscountRequest) GetProductId() string {
+func (x *SaveDraftRequest) GetProductId() string {
 	if x != nil {
 		return x.ProductId
 	}
 	return ""
 }
 
-func (x *ApplyDiscountRequest) GetDiscount() *Discount {
-	if x != nil {
-		return x.Discount
+func (x *SaveDraftRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
-	return nil
-}
-
-// ApplyDiscountResponse represents the response from applying a discount
-type ApplyDiscountResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ApplyDiscountResponse) Reset() {
-	*x = ApplyDiscountResponse{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[12]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ApplyDiscountResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*ApplyDiscountResponse) ProtoMessage() {}
-
-func (x *ApplyDiscountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[12]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *SaveDraftRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ApplyDiscountResponse.ProtoReflect.Descriptor instead.
-func (*ApplyDiscountResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{12}
+func (x *SaveDraftRequest) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
 }
 
-func (x *ApplyDiscountResponse) GetProductId() string {
+func (x *SaveDraftRequest) GetMetadata() *DraftMetadata {
 	if x != nil {
-		return x.ProductId
+		return x.Metadata
 	}
-	return ""
+	return nil
 }
 
-// RemoveDiscountRequest represents the request to remove a discount
-type RemoveDiscountRequest struct {
+// DraftMetadata wraps a metadata map so an empty map can be told apart from an unset one
+type DraftMetadata struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Entries       map[string]string      `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveDiscountRequest) Reset() {
-	*x = RemoveDiscountRequest{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[13]
+func (x *DraftMetadata) Reset() {
+	*x = DraftMetadata{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveDiscountRequest) String() string {
+func (x *DraftMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveDiscountRequest) ProtoMessage() {}
+func (*DraftMetadata) ProtoMessage() {}
 
-func (x *RemoveDiscountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[13]
+func (x *DraftMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -833,41 +10198,41 @@ func (x *RemoveDiscountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveDiscountRequest.ProtoReflect.Descriptor instead.
-func (*RemoveDiscountRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use DraftMetadata.ProtoReflect.Descriptor instead.
+func (*DraftMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{160}
 }
 
-func (x *RemoveDiscountRequest) GetProductId() string {
+func (x *DraftMetadata) GetEntries() map[string]string {
 	if x != nil {
-		return x.ProductId
+		return x.Entries
 	}
-	return ""
+	return nil
 }
 
-// RemoveDiscountResponse represents the response from removing a discount
-type RemoveDiscountResponse struct {
+// SaveDraftResponse represents the response from saving a draft
+type SaveDraftResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveDiscountResponse) Reset() {
-	*x = RemoveDiscountResponse{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[14]
+func (x *SaveDraftResponse) Reset() {
+	*x = SaveDraftResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveDiscountResponse) String() string {
+func (x *SaveDraftResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveDiscountResponse) ProtoMessage() {}
+func (*SaveDraftResponse) ProtoMessage() {}
 
-func (x *RemoveDiscountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[14]
+func (x *SaveDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -878,41 +10243,41 @@ func (x *RemoveDiscountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveDiscountResponse.ProtoReflect.Descriptor instead.
-func (*RemoveDiscountResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use SaveDraftResponse.ProtoReflect.Descriptor instead.
+func (*SaveDraftResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{161}
 }
 
-func (x *RemoveDiscountResponse) GetProductId() string {
+func (x *SaveDraftResponse) GetProductId() string {
 	if x != nil {
 		return x.ProductId
 	}
 	return ""
 }
 
-// ActivateProductRequest represents the request to activate a product
-type ActivateProductRequest struct {
+// PublishDraftRequest applies a product's draft to its published content
+type PublishDraftRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ActivateProductRequest) Reset() {
-	*x = ActivateProductRequest{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[15]
+func (x *PublishDraftRequest) Reset() {
+	*x = PublishDraftRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActivateProductRequest) String() string {
+func (x *PublishDraftRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivateProductRequest) ProtoMessage() {}
+func (*PublishDraftRequest) ProtoMessage() {}
 
-func (x *ActivateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[15]
+func (x *PublishDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -923,41 +10288,42 @@ func (x *ActivateProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivateProductRequest.ProtoReflect.Descriptor instead.
-func (*ActivateProductRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use PublishDraftRequest.ProtoReflect.Descriptor instead.
+func (*PublishDraftRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{162}
 }
 
-func (x *ActivateProductRequest) GetProductId() string {
+func (x *PublishDraftRequest) GetProductId() string {
 	if x != nil {
 		return x.ProductId
 	}
 	return ""
 }
 
-// ActivateProductResponse represents the response from activating a product
-type ActivateProductResponse struct {
+// PublishDraftResponse represents the response from publishing a draft
+type PublishDraftResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VersionId     string                 `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"` // The new version holding the published content; empty when nothing changed
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ActivateProductResponse) Reset() {
-	*x = ActivateProductResponse{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[16]
+func (x *PublishDraftResponse) Reset() {
+	*x = PublishDraftResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActivateProductResponse) String() string {
+func (x *PublishDraftResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivateProductResponse) ProtoMessage() {}
+func (*PublishDraftResponse) ProtoMessage() {}
 
-func (x *ActivateProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[16]
+func (x *PublishDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -968,41 +10334,48 @@ func (x *ActivateProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivateProductResponse.ProtoReflect.Descriptor instead.
-func (*ActivateProductResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use PublishDraftResponse.ProtoReflect.Descriptor instead.
+func (*PublishDraftResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{163}
 }
 
-func (x *ActivateProductResponse) GetProductId() string {
+func (x *PublishDraftResponse) GetProductId() string {
 	if x != nil {
 		return x.ProductId
 	}
 	return ""
 }
 
-// DeactivateProductRequest represents the request to deactivate a product
-type DeactivateProductRequest struct {
+func (x *PublishDraftResponse) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+// DiscardDraftRequest deletes a product's draft
+type DiscardDraftRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeactivateProductRequest) Reset() {
-	*x = DeactivateProductRequest{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[17]
+func (x *DiscardDraftRequest) Reset() {
+	*x = DiscardDraftRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeactivateProductRequest) String() string {
+func (x *DiscardDraftRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeactivateProductRequest) ProtoMessage() {}
+func (*DiscardDraftRequest) ProtoMessage() {}
 
-func (x *DeactivateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[17]
+func (x *DiscardDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1013,41 +10386,41 @@ func (x *DeactivateProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeactivateProductRequest.ProtoReflect.Descriptor instead.
-func (*DeactivateProductRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use DiscardDraftRequest.ProtoReflect.Descriptor instead.
+func (*DiscardDraftRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{164}
 }
 
-func (x *DeactivateProductRequest) GetProductId() string {
+func (x *DiscardDraftRequest) GetProductId() string {
 	if x != nil {
 		return x.ProductId
 	}
 	return ""
 }
 
-// DeactivateProductResponse represents the response from deactivating a product
-type DeactivateProductResponse struct {
+// DiscardDraftResponse represents the response from discarding a draft
+type DiscardDraftResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeactivateProductResponse) Reset() {
-	*x = DeactivateProductResponse{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[18]
+func (x *DiscardDraftResponse) Reset() {
+	*x = DiscardDraftResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeactivateProductResponse) String() string {
+func (x *DiscardDraftResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeactivateProductResponse) ProtoMessage() {}
+func (*DiscardDraftResponse) ProtoMessage() {}
 
-func (x *DeactivateProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[18]
+func (x *DiscardDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1058,41 +10431,42 @@ func (x *DeactivateProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeactivateProductResponse.ProtoReflect.Descriptor instead.
-func (*DeactivateProductResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use DiscardDraftResponse.ProtoReflect.Descriptor instead.
+func (*DiscardDraftResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{165}
 }
 
-func (x *DeactivateProductResponse) GetProductId() string {
+func (x *DiscardDraftResponse) GetProductId() string {
 	if x != nil {
 		return x.ProductId
 	}
 	return ""
 }
 
-// ArchiveProductRequest represents the request to archive a product
-type ArchiveProductRequest struct {
+// GeneratePreviewTokenRequest represents the request to sign a preview token for the caller's tenant
+type GeneratePreviewTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"` // Limits the token to one product; empty covers every product of the tenant
+	Ttl           *durationpb.Duration   `protobuf:"bytes,2,opt,name=ttl,proto3" json:"ttl,omitempty"`                              // Unset for the server's default lifetime
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ArchiveProductRequest) Reset() {
-	*x = ArchiveProductRequest{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[19]
+func (x *GeneratePreviewTokenRequest) Reset() {
+	*x = GeneratePreviewTokenRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ArchiveProductRequest) String() string {
+func (x *GeneratePreviewTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArchiveProductRequest) ProtoMessage() {}
+func (*GeneratePreviewTokenRequest) ProtoMessage() {}
 
-func (x *ArchiveProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[19]
+func (x *GeneratePreviewTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1103,41 +10477,49 @@ func (x *ArchiveProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArchiveProductRequest.ProtoReflect.Descriptor instead.
-func (*ArchiveProductRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GeneratePreviewTokenRequest.ProtoReflect.Descriptor instead.
+func (*GeneratePreviewTokenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{166}
 }
 
-func (x *ArchiveProductRequest) GetProductId() string {
+func (x *GeneratePreviewTokenRequest) GetProductId() string {
 	if x != nil {
 		return x.ProductId
 	}
 	return ""
 }
 
-// ArchiveProductResponse represents the response from archiving a product
-type ArchiveProductResponse struct {
+func (x *GeneratePreviewTokenRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+// GeneratePreviewTokenResponse represents the response from generating a preview token
+type GeneratePreviewTokenResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // Send as GetProductRequest.preview_token
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ArchiveProductResponse) Reset() {
-	*x = ArchiveProductResponse{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[20]
+func (x *GeneratePreviewTokenResponse) Reset() {
+	*x = GeneratePreviewTokenResponse{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ArchiveProductResponse) String() string {
+func (x *GeneratePreviewTokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArchiveProductResponse) ProtoMessage() {}
+func (*GeneratePreviewTokenResponse) ProtoMessage() {}
 
-func (x *ArchiveProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[20]
+func (x *GeneratePreviewTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1148,32 +10530,42 @@ func (x *ArchiveProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArchiveProductResponse.ProtoReflect.Descriptor instead.
-func (*ArchiveProductResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use GeneratePreviewTokenResponse.ProtoReflect.Descriptor instead.
+func (*GeneratePreviewTokenResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{167}
 }
 
-func (x *ArchiveProductResponse) GetProductId() string {
+func (x *GeneratePreviewTokenResponse) GetToken() string {
 	if x != nil {
-		return x.ProductId
+		return x.Token
 	}
 	return ""
 }
 
+func (x *GeneratePreviewTokenResponse) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
 var File_proto_product_v1_product_service_proto protoreflect.FileDescriptor
 
 const file_proto_product_v1_product_service_proto_rawDesc = "" +
 	"\n" +
 	"&proto/product/v1/product_service.proto\x12\n" +
-	"product.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x1f\n" +
+	"product.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x1f\n" +
 	"\x05Money\x12\x16\n" +
-	"\x06amount\x18\x01 \x01(\x03R\x06amount\"\xb7\x01\n" +
+	"\x06amount\x18\x01 \x01(\x03R\x06amount\"\x8b\x02\n" +
 	"\bDiscount\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12)\n" +
-	"\x06amount\x18\x02 \x01(\v2\x11.product.v1.MoneyR\x06amount\x129\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12-\n" +
+	"\x06amount\x18\x02 \x01(\v2\x11.product.v1.MoneyB\x02\x18\x01R\x06amount\x129\n" +
 	"\n" +
 	"start_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
-	"\bend_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\"\xd6\x03\n" +
+	"\bend_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x125\n" +
+	"\x14percent_basis_points\x18\x05 \x01(\x05H\x00R\x12percentBasisPoints\x88\x01\x01B\x17\n" +
+	"\x15_percent_basis_points\"\xd1\n" +
+	"\n" +
 	"\aProduct\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -1190,74 +10582,853 @@ const file_proto_product_v1_product_service_proto_rawDesc = "" +
 	"created_at\x18\n" +
 	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x9a\x01\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x10\n" +
+	"\x03sku\x18\f \x01(\tR\x03sku\x12\x12\n" +
+	"\x04gtin\x18\r \x01(\tR\x04gtin\x12\x1d\n" +
+	"\n" +
+	"legal_hold\x18\x0e \x01(\bR\tlegalHold\x12\x1a\n" +
+	"\bchannels\x18\x0f \x03(\tR\bchannels\x12*\n" +
+	"\x06weight\x18\x10 \x01(\v2\x12.product.v1.WeightR\x06weight\x126\n" +
+	"\n" +
+	"dimensions\x18\x11 \x01(\v2\x16.product.v1.DimensionsR\n" +
+	"dimensions\x12%\n" +
+	"\x0eshipping_class\x18\x12 \x01(\tR\rshippingClass\x12:\n" +
+	"\fproduct_type\x18\x13 \x01(\x0e2\x17.product.v1.ProductTypeR\vproductType\x12!\n" +
+	"\fdownload_url\x18\x14 \x01(\tR\vdownloadUrl\x12#\n" +
+	"\rlicense_terms\x18\x15 \x01(\tR\flicenseTerms\x126\n" +
+	"\n" +
+	"compliance\x18\x16 \x01(\v2\x16.product.v1.ComplianceR\n" +
+	"compliance\x12=\n" +
+	"\bmetadata\x18\x17 \x03(\v2!.product.v1.Product.MetadataEntryR\bmetadata\x127\n" +
+	"\vprice_floor\x18\x18 \x01(\v2\x16.product.v1.PriceFloorR\n" +
+	"priceFloor\x12\x1f\n" +
+	"\vmap_applied\x18\x19 \x01(\bR\n" +
+	"mapApplied\x12L\n" +
+	"\x12description_format\x18\x1a \x01(\x0e2\x1d.product.v1.DescriptionFormatR\x11descriptionFormat\x12)\n" +
+	"\x10description_html\x18\x1b \x01(\tR\x0fdescriptionHtml\x12C\n" +
+	"\n" +
+	"attributes\x18\x1c \x03(\v2#.product.v1.Product.AttributesEntryR\n" +
+	"attributes\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc1\x01\n" +
+	"\n" +
+	"PriceFloor\x12.\n" +
+	"\tmin_price\x18\x01 \x01(\v2\x11.product.v1.MoneyR\bminPrice\x12%\n" +
+	"\x04cost\x18\x02 \x01(\v2\x11.product.v1.MoneyR\x04cost\x12,\n" +
+	"\x12min_margin_percent\x18\x03 \x01(\x03R\x10minMarginPercent\x12.\n" +
+	"\tmap_price\x18\x04 \x01(\v2\x11.product.v1.MoneyR\bmapPrice\"\x88\x01\n" +
+	"\n" +
+	"Compliance\x12'\n" +
+	"\x0fage_restriction\x18\x01 \x01(\x05R\x0eageRestriction\x12\x1c\n" +
+	"\thazardous\x18\x02 \x01(\bR\thazardous\x123\n" +
+	"\x15requires_prescription\x18\x03 \x01(\bR\x14requiresPrescription\"2\n" +
+	"\x06Weight\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x01R\x05value\x12\x12\n" +
+	"\x04unit\x18\x02 \x01(\tR\x04unit\"f\n" +
+	"\n" +
+	"Dimensions\x12\x16\n" +
+	"\x06length\x18\x01 \x01(\x01R\x06length\x12\x14\n" +
+	"\x05width\x18\x02 \x01(\x01R\x05width\x12\x16\n" +
+	"\x06height\x18\x03 \x01(\x01R\x06height\x12\x12\n" +
+	"\x04unit\x18\x04 \x01(\tR\x04unit\"\xc1\x05\n" +
 	"\x14CreateProductRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1a\n" +
 	"\bcategory\x18\x03 \x01(\tR\bcategory\x120\n" +
 	"\n" +
-	"base_price\x18\x04 \x01(\v2\x11.product.v1.MoneyR\tbasePrice\"6\n" +
+	"base_price\x18\x04 \x01(\v2\x11.product.v1.MoneyR\tbasePrice\x12\x10\n" +
+	"\x03sku\x18\x05 \x01(\tR\x03sku\x12\x12\n" +
+	"\x04gtin\x18\x06 \x01(\tR\x04gtin\x12C\n" +
+	"\x0fduplicate_check\x18\a \x01(\x0e2\x1a.product.v1.DuplicateCheckR\x0eduplicateCheck\x12*\n" +
+	"\x06weight\x18\b \x01(\v2\x12.product.v1.WeightR\x06weight\x126\n" +
+	"\n" +
+	"dimensions\x18\t \x01(\v2\x16.product.v1.DimensionsR\n" +
+	"dimensions\x12%\n" +
+	"\x0eshipping_class\x18\n" +
+	" \x01(\tR\rshippingClass\x12:\n" +
+	"\fproduct_type\x18\v \x01(\x0e2\x17.product.v1.ProductTypeR\vproductType\x12!\n" +
+	"\fdownload_url\x18\f \x01(\tR\vdownloadUrl\x12#\n" +
+	"\rlicense_terms\x18\r \x01(\tR\flicenseTerms\x126\n" +
+	"\n" +
+	"compliance\x18\x0e \x01(\v2\x16.product.v1.ComplianceR\n" +
+	"compliance\x12%\n" +
+	"\x0ereturn_product\x18\x0f \x01(\bR\rreturnProduct\x12L\n" +
+	"\x12description_format\x18\x10 \x01(\x0e2\x1d.product.v1.DescriptionFormatR\x11descriptionFormat\"\x9b\x01\n" +
 	"\x15CreateProductResponse\x12\x1d\n" +
 	"\n" +
-	"product_id\x18\x01 \x01(\tR\tproductId\"\xbc\x01\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x124\n" +
+	"\x16possible_duplicate_ids\x18\x02 \x03(\tR\x14possibleDuplicateIds\x12-\n" +
+	"\aproduct\x18\x03 \x01(\v2\x13.product.v1.ProductR\aproduct\"\xef\x05\n" +
 	"\x14UpdateProductRequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12\x17\n" +
 	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
 	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x1f\n" +
-	"\bcategory\x18\x04 \x01(\tH\x02R\bcategory\x88\x01\x01B\a\n" +
+	"\bcategory\x18\x04 \x01(\tH\x02R\bcategory\x88\x01\x01\x12*\n" +
+	"\x06weight\x18\x05 \x01(\v2\x12.product.v1.WeightR\x06weight\x126\n" +
+	"\n" +
+	"dimensions\x18\x06 \x01(\v2\x16.product.v1.DimensionsR\n" +
+	"dimensions\x12*\n" +
+	"\x0eshipping_class\x18\a \x01(\tH\x03R\rshippingClass\x88\x01\x01\x12?\n" +
+	"\fproduct_type\x18\b \x01(\x0e2\x17.product.v1.ProductTypeH\x04R\vproductType\x88\x01\x01\x12&\n" +
+	"\fdownload_url\x18\t \x01(\tH\x05R\vdownloadUrl\x88\x01\x01\x12(\n" +
+	"\rlicense_terms\x18\n" +
+	" \x01(\tH\x06R\flicenseTerms\x88\x01\x01\x126\n" +
+	"\n" +
+	"compliance\x18\v \x01(\v2\x16.product.v1.ComplianceR\n" +
+	"compliance\x12%\n" +
+	"\x0ereturn_product\x18\f \x01(\bR\rreturnProduct\x12Q\n" +
+	"\x12description_format\x18\r \x01(\x0e2\x1d.product.v1.DescriptionFormatH\aR\x11descriptionFormat\x88\x01\x01B\a\n" +
 	"\x05_nameB\x0e\n" +
 	"\f_descriptionB\v\n" +
-	"\t_category\"6\n" +
+	"\t_categoryB\x11\n" +
+	"\x0f_shipping_classB\x0f\n" +
+	"\r_product_typeB\x0f\n" +
+	"\r_download_urlB\x10\n" +
+	"\x0e_license_termsB\x15\n" +
+	"\x13_description_format\"e\n" +
 	"\x15UpdateProductResponse\x12\x1d\n" +
 	"\n" +
-	"product_id\x18\x01 \x01(\tR\tproductId\"2\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12-\n" +
+	"\aproduct\x18\x02 \x01(\v2\x13.product.v1.ProductR\aproduct\"\xb4\x01\n" +
 	"\x11GetProductRequest\x12\x1d\n" +
 	"\n" +
-	"product_id\x18\x01 \x01(\tR\tproductId\"C\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12#\n" +
+	"\rpreview_draft\x18\x02 \x01(\bR\fpreviewDraft\x12#\n" +
+	"\rpreview_token\x18\x03 \x01(\tR\fpreviewToken\x126\n" +
+	"\x17render_description_html\x18\x04 \x01(\bR\x15renderDescriptionHtml\"|\n" +
 	"\x12GetProductResponse\x12-\n" +
-	"\aproduct\x18\x01 \x01(\v2\x13.product.v1.ProductR\aproduct\"\x99\x01\n" +
+	"\aproduct\x18\x01 \x01(\v2\x13.product.v1.ProductR\aproduct\x12!\n" +
+	"\faliased_from\x18\x02 \x01(\tR\valiasedFrom\x12\x14\n" +
+	"\x05draft\x18\x03 \x01(\bR\x05draft\"\x93\x06\n" +
 	"\x13ListProductsRequest\x12\x1f\n" +
 	"\bcategory\x18\x01 \x01(\tH\x00R\bcategory\x88\x01\x01\x12\x1b\n" +
 	"\x06status\x18\x02 \x01(\tH\x01R\x06status\x88\x01\x01\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x04 \x01(\x05R\x06offsetB\v\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\x12\x1d\n" +
+	"\achannel\x18\x05 \x01(\tH\x02R\achannel\x88\x01\x01\x123\n" +
+	"\x13max_age_restriction\x18\x06 \x01(\x05H\x03R\x11maxAgeRestriction\x88\x01\x01\x12+\n" +
+	"\x11exclude_hazardous\x18\a \x01(\bR\x10excludeHazardous\x121\n" +
+	"\x14exclude_prescription\x18\b \x01(\bR\x13excludePrescription\x12\x1d\n" +
+	"\n" +
+	"skip_total\x18\t \x01(\bR\tskipTotal\x12+\n" +
+	"\x11approximate_total\x18\n" +
+	" \x01(\bR\x10approximateTotal\x12&\n" +
+	"\fmetadata_key\x18\v \x01(\tH\x04R\vmetadataKey\x88\x01\x01\x12%\n" +
+	"\x0emetadata_value\x18\f \x01(\tR\rmetadataValue\x12\x19\n" +
+	"\border_by\x18\r \x01(\tR\aorderBy\x12+\n" +
+	"\x04view\x18\x0e \x01(\x0e2\x17.product.v1.ProductViewR\x04view\x12&\n" +
+	"\x0fallow_full_scan\x18\x0f \x01(\bR\rallowFullScan\x12A\n" +
+	"\x13min_effective_price\x18\x10 \x01(\v2\x11.product.v1.MoneyR\x11minEffectivePrice\x12A\n" +
+	"\x13max_effective_price\x18\x11 \x01(\v2\x11.product.v1.MoneyR\x11maxEffectivePriceB\v\n" +
 	"\t_categoryB\t\n" +
-	"\a_status\"]\n" +
+	"\a_statusB\n" +
+	"\n" +
+	"\b_channelB\x16\n" +
+	"\x14_max_age_restrictionB\x0f\n" +
+	"\r_metadata_key\"\xa5\x01\n" +
 	"\x14ListProductsResponse\x12/\n" +
 	"\bproducts\x18\x01 \x03(\v2\x13.product.v1.ProductR\bproducts\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"g\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\x12+\n" +
+	"\x11total_approximate\x18\x04 \x01(\bR\x10totalApproximate\"\x8e\x01\n" +
 	"\x14ApplyDiscountRequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x120\n" +
-	"\bdiscount\x18\x02 \x01(\v2\x14.product.v1.DiscountR\bdiscount\"6\n" +
+	"\bdiscount\x18\x02 \x01(\v2\x14.product.v1.DiscountR\bdiscount\x12%\n" +
+	"\x0ereturn_product\x18\x03 \x01(\bR\rreturnProduct\"e\n" +
 	"\x15ApplyDiscountResponse\x12\x1d\n" +
 	"\n" +
-	"product_id\x18\x01 \x01(\tR\tproductId\"6\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12-\n" +
+	"\aproduct\x18\x02 \x01(\v2\x13.product.v1.ProductR\aproduct\"]\n" +
 	"\x15RemoveDiscountRequest\x12\x1d\n" +
 	"\n" +
-	"product_id\x18\x01 \x01(\tR\tproductId\"7\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12%\n" +
+	"\x0ereturn_product\x18\x02 \x01(\bR\rreturnProduct\"f\n" +
 	"\x16RemoveDiscountResponse\x12\x1d\n" +
 	"\n" +
-	"product_id\x18\x01 \x01(\tR\tproductId\"7\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12-\n" +
+	"\aproduct\x18\x02 \x01(\v2\x13.product.v1.ProductR\aproduct\"^\n" +
 	"\x16ActivateProductRequest\x12\x1d\n" +
 	"\n" +
-	"product_id\x18\x01 \x01(\tR\tproductId\"8\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12%\n" +
+	"\x0ereturn_product\x18\x02 \x01(\bR\rreturnProduct\"g\n" +
 	"\x17ActivateProductResponse\x12\x1d\n" +
 	"\n" +
-	"product_id\x18\x01 \x01(\tR\tproductId\"9\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12-\n" +
+	"\aproduct\x18\x02 \x01(\v2\x13.product.v1.ProductR\aproduct\"`\n" +
 	"\x18DeactivateProductRequest\x12\x1d\n" +
 	"\n" +
-	"product_id\x18\x01 \x01(\tR\tproductId\":\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12%\n" +
+	"\x0ereturn_product\x18\x02 \x01(\bR\rreturnProduct\"i\n" +
 	"\x19DeactivateProductResponse\x12\x1d\n" +
 	"\n" +
-	"product_id\x18\x01 \x01(\tR\tproductId\"6\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12-\n" +
+	"\aproduct\x18\x02 \x01(\v2\x13.product.v1.ProductR\aproduct\"]\n" +
 	"\x15ArchiveProductRequest\x12\x1d\n" +
 	"\n" +
-	"product_id\x18\x01 \x01(\tR\tproductId\"7\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12%\n" +
+	"\x0ereturn_product\x18\x02 \x01(\bR\rreturnProduct\"f\n" +
 	"\x16ArchiveProductResponse\x12\x1d\n" +
 	"\n" +
-	"product_id\x18\x01 \x01(\tR\tproductId2\xa2\x06\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12-\n" +
+	"\aproduct\x18\x02 \x01(\v2\x13.product.v1.ProductR\aproduct\"\x88\x01\n" +
+	"\x1aFindSimilarProductsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12\x10\n" +
+	"\x03sku\x18\x03 \x01(\tR\x03sku\x12\x12\n" +
+	"\x04gtin\x18\x04 \x01(\tR\x04gtin\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"\xbc\x01\n" +
+	"\x0eSimilarProduct\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12\x10\n" +
+	"\x03sku\x18\x04 \x01(\tR\x03sku\x12\x12\n" +
+	"\x04gtin\x18\x05 \x01(\tR\x04gtin\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"matched_on\x18\a \x03(\tR\tmatchedOn\"U\n" +
+	"\x1bFindSimilarProductsResponse\x126\n" +
+	"\bproducts\x18\x01 \x03(\v2\x1a.product.v1.SimilarProductR\bproducts\"9\n" +
+	"\x16CompareProductsRequest\x12\x1f\n" +
+	"\vproduct_ids\x18\x01 \x03(\tR\n" +
+	"productIds\"_\n" +
+	"\rComparisonRow\x12\x1c\n" +
+	"\tattribute\x18\x01 \x01(\tR\tattribute\x12\x16\n" +
+	"\x06values\x18\x02 \x03(\tR\x06values\x12\x18\n" +
+	"\adiffers\x18\x03 \x01(\bR\adiffers\"\xe9\x01\n" +
+	"\x17CompareProductsResponse\x12/\n" +
+	"\bproducts\x18\x01 \x03(\v2\x13.product.v1.ProductR\bproducts\x12-\n" +
+	"\x04rows\x18\x02 \x03(\v2\x19.product.v1.ComparisonRowR\x04rows\x12.\n" +
+	"\x13cheapest_product_id\x18\x03 \x01(\tR\x11cheapestProductId\x12>\n" +
+	"\x11price_differences\x18\x04 \x03(\v2\x11.product.v1.MoneyR\x10priceDifferences\"z\n" +
+	"\x13SetLegalHoldRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"legal_hold\x18\x02 \x01(\bR\tlegalHold\x12%\n" +
+	"\x0ereturn_product\x18\x03 \x01(\bR\rreturnProduct\"d\n" +
+	"\x14SetLegalHoldResponse\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12-\n" +
+	"\aproduct\x18\x02 \x01(\v2\x13.product.v1.ProductR\aproduct\"t\n" +
+	"\x1cPurgeArchivedProductsRequest\x12%\n" +
+	"\x0eretention_days\x18\x01 \x01(\x05R\rretentionDays\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\xa9\x01\n" +
+	"\rPurgedProduct\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12;\n" +
+	"\varchived_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"archivedAt\x12\x1f\n" +
+	"\vevent_count\x18\x04 \x01(\x03R\n" +
+	"eventCount\"\xb1\x02\n" +
+	"\x1dPurgeArchivedProductsResponse\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x12C\n" +
+	"\x0farchived_before\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x0earchivedBefore\x121\n" +
+	"\x06purged\x18\x03 \x03(\v2\x19.product.v1.PurgedProductR\x06purged\x12(\n" +
+	"\x10held_product_ids\x18\x04 \x03(\tR\x0eheldProductIds\x12.\n" +
+	"\x13skipped_product_ids\x18\x05 \x03(\tR\x11skippedProductIds\x12%\n" +
+	"\x0eevents_deleted\x18\x06 \x01(\x03R\reventsDeleted\"9\n" +
+	"\x18ExportProductDataRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"V\n" +
+	"\x19ExportProductDataResponse\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bdocument\x18\x02 \x01(\tR\bdocument\"Z\n" +
+	"\x1aBatchImportProductsRequest\x12<\n" +
+	"\bproducts\x18\x01 \x03(\v2 .product.v1.CreateProductRequestR\bproducts\"D\n" +
+	"\x1bBatchImportProductsResponse\x12%\n" +
+	"\x0eoperation_name\x18\x01 \x01(\tR\roperationName\"X\n" +
+	"\x12BatchImportFailure\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"x\n" +
+	"\x19BatchImportProductsResult\x12\x1f\n" +
+	"\vproduct_ids\x18\x01 \x03(\tR\n" +
+	"productIds\x12:\n" +
+	"\bfailures\x18\x02 \x03(\v2\x1e.product.v1.BatchImportFailureR\bfailures\"\xd9\x02\n" +
+	"\x11OperationMetadata\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x1f\n" +
+	"\vtotal_items\x18\x02 \x01(\x03R\n" +
+	"totalItems\x12'\n" +
+	"\x0fprocessed_items\x18\x03 \x01(\x03R\x0eprocessedItems\x12!\n" +
+	"\ffailed_items\x18\x04 \x01(\x03R\vfailedItems\x12)\n" +
+	"\x10cancel_requested\x18\x05 \x01(\bR\x0fcancelRequested\x12;\n" +
+	"\vcreate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\x12\x1e\n" +
+	"\n" +
+	"checkpoint\x18\b \x01(\tR\n" +
+	"checkpoint\"\x9b\x03\n" +
+	"\x16ValidateProductRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x1f\n" +
+	"\bcategory\x18\x04 \x01(\tH\x02R\bcategory\x88\x01\x01\x120\n" +
+	"\n" +
+	"base_price\x18\x05 \x01(\v2\x11.product.v1.MoneyR\tbasePrice\x12\x15\n" +
+	"\x03sku\x18\x06 \x01(\tH\x03R\x03sku\x88\x01\x01\x12\x17\n" +
+	"\x04gtin\x18\a \x01(\tH\x04R\x04gtin\x88\x01\x01\x12Q\n" +
+	"\x12description_format\x18\b \x01(\x0e2\x1d.product.v1.DescriptionFormatH\x05R\x11descriptionFormat\x88\x01\x01B\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_descriptionB\v\n" +
+	"\t_categoryB\x06\n" +
+	"\x04_skuB\a\n" +
+	"\x05_gtinB\x15\n" +
+	"\x13_description_format\"M\n" +
+	"\x13ValidationViolation\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\"p\n" +
+	"\x17ValidateProductResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12?\n" +
+	"\n" +
+	"violations\x18\x02 \x03(\v2\x1f.product.v1.ValidationViolationR\n" +
+	"violations\"\xa3\x01\n" +
+	"\x14ReviewProductRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x126\n" +
+	"\bdecision\x18\x02 \x01(\x0e2\x1a.product.v1.ReviewDecisionR\bdecision\x12\x1a\n" +
+	"\breviewer\x18\x03 \x01(\tR\breviewer\x12\x18\n" +
+	"\acomment\x18\x04 \x01(\tR\acomment\"6\n" +
+	"\x15ReviewProductResponse\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"9\n" +
+	"\x18GetProductHistoryRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"}\n" +
+	"\rProductReview\x126\n" +
+	"\bdecision\x18\x01 \x01(\x0e2\x1a.product.v1.ReviewDecisionR\bdecision\x12\x1a\n" +
+	"\breviewer\x18\x02 \x01(\tR\breviewer\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\"\xe2\x01\n" +
+	"\x13ProductHistoryEntry\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tR\teventType\x12;\n" +
+	"\voccurred_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12!\n" +
+	"\fpayload_json\x18\x04 \x01(\tR\vpayloadJson\x121\n" +
+	"\x06review\x18\x05 \x01(\v2\x19.product.v1.ProductReviewR\x06review\"u\n" +
+	"\x19GetProductHistoryResponse\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x129\n" +
+	"\aentries\x18\x02 \x03(\v2\x1f.product.v1.ProductHistoryEntryR\aentries\"\x87\x01\n" +
+	"\x18RebuildProjectionRequest\x123\n" +
+	"\x16start_after_product_id\x18\x01 \x01(\tR\x13startAfterProductId\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x03 \x01(\x05R\tbatchSize\"B\n" +
+	"\x19RebuildProjectionResponse\x12%\n" +
+	"\x0eoperation_name\x18\x01 \x01(\tR\roperationName\"\xad\x01\n" +
+	"\x17RebuildProjectionResult\x12\x18\n" +
+	"\ascanned\x18\x01 \x01(\x03R\ascanned\x12\x18\n" +
+	"\aupdated\x18\x02 \x01(\x03R\aupdated\x126\n" +
+	"\x17conflicting_product_ids\x18\x03 \x03(\tR\x15conflictingProductIds\x12&\n" +
+	"\x0flast_product_id\x18\x04 \x01(\tR\rlastProductId\"\x94\x01\n" +
+	"\x17ReassignCategoryRequest\x12#\n" +
+	"\rfrom_category\x18\x01 \x01(\tR\ffromCategory\x12\x1f\n" +
+	"\vto_category\x18\x02 \x01(\tR\n" +
+	"toCategory\x123\n" +
+	"\x16start_after_product_id\x18\x03 \x01(\tR\x13startAfterProductId\"A\n" +
+	"\x18ReassignCategoryResponse\x12%\n" +
+	"\x0eoperation_name\x18\x01 \x01(\tR\roperationName\"f\n" +
+	"\x17ReassignCategoryFailure\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\x97\x01\n" +
+	"\x16ReassignCategoryResult\x12\x14\n" +
+	"\x05moved\x18\x01 \x01(\x03R\x05moved\x12?\n" +
+	"\bfailures\x18\x02 \x03(\v2#.product.v1.ReassignCategoryFailureR\bfailures\x12&\n" +
+	"\x0flast_product_id\x18\x03 \x01(\tR\rlastProductId\"v\n" +
+	"\x12SetChannelsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bchannels\x18\x02 \x03(\tR\bchannels\x12%\n" +
+	"\x0ereturn_product\x18\x03 \x01(\bR\rreturnProduct\"c\n" +
+	"\x13SetChannelsResponse\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12-\n" +
+	"\aproduct\x18\x02 \x01(\v2\x13.product.v1.ProductR\aproduct\"\xe1\x01\n" +
+	"\x12SetMetadataRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12H\n" +
+	"\bmetadata\x18\x02 \x03(\v2,.product.v1.SetMetadataRequest.MetadataEntryR\bmetadata\x12%\n" +
+	"\x0ereturn_product\x18\x03 \x01(\bR\rreturnProduct\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"c\n" +
+	"\x13SetMetadataResponse\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12-\n" +
+	"\aproduct\x18\x02 \x01(\v2\x13.product.v1.ProductR\aproduct\"\xed\x01\n" +
+	"\x14SetAttributesRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12P\n" +
+	"\n" +
+	"attributes\x18\x02 \x03(\v20.product.v1.SetAttributesRequest.AttributesEntryR\n" +
+	"attributes\x12%\n" +
+	"\x0ereturn_product\x18\x03 \x01(\bR\rreturnProduct\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"e\n" +
+	"\x15SetAttributesResponse\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12-\n" +
+	"\aproduct\x18\x02 \x01(\v2\x13.product.v1.ProductR\aproduct\"p\n" +
+	"\x16LinkExternalRefRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x16\n" +
+	"\x06system\x18\x02 \x01(\tR\x06system\x12\x1f\n" +
+	"\vexternal_id\x18\x03 \x01(\tR\n" +
+	"externalId\"8\n" +
+	"\x17LinkExternalRefResponse\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"r\n" +
+	"\x18UnlinkExternalRefRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x16\n" +
+	"\x06system\x18\x02 \x01(\tR\x06system\x12\x1f\n" +
+	"\vexternal_id\x18\x03 \x01(\tR\n" +
+	"externalId\":\n" +
+	"\x19UnlinkExternalRefResponse\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"Y\n" +
+	"\x1eGetProductByExternalRefRequest\x12\x16\n" +
+	"\x06system\x18\x01 \x01(\tR\x06system\x12\x1f\n" +
+	"\vexternal_id\x18\x02 \x01(\tR\n" +
+	"externalId\"\x8c\x01\n" +
+	"\x16ChangeBasePriceRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x120\n" +
+	"\n" +
+	"base_price\x18\x02 \x01(\v2\x11.product.v1.MoneyR\tbasePrice\x12!\n" +
+	"\frequested_by\x18\x03 \x01(\tR\vrequestedBy\"d\n" +
+	"\x17ChangeBasePriceResponse\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12*\n" +
+	"\x11pending_change_id\x18\x02 \x01(\tR\x0fpendingChangeId\"O\n" +
+	"\x14ApproveChangeRequest\x12\x1b\n" +
+	"\tchange_id\x18\x01 \x01(\tR\bchangeId\x12\x1a\n" +
+	"\bapprover\x18\x02 \x01(\tR\bapprover\"f\n" +
+	"\x13RejectChangeRequest\x12\x1b\n" +
+	"\tchange_id\x18\x01 \x01(\tR\bchangeId\x12\x1a\n" +
+	"\bapprover\x18\x02 \x01(\tR\bapprover\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x8b\x01\n" +
+	"\x14DecideChangeResponse\x12\x1b\n" +
+	"\tchange_id\x18\x01 \x01(\tR\bchangeId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x127\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1f.product.v1.PendingChangeStatusR\x06status\"\x95\x01\n" +
+	"\x14SetPriceFloorRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x127\n" +
+	"\vprice_floor\x18\x02 \x01(\v2\x16.product.v1.PriceFloorR\n" +
+	"priceFloor\x12%\n" +
+	"\x0ereturn_product\x18\x03 \x01(\bR\rreturnProduct\"e\n" +
+	"\x15SetPriceFloorResponse\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12-\n" +
+	"\aproduct\x18\x02 \x01(\v2\x13.product.v1.ProductR\aproduct\"[\n" +
+	"\fBatchOutcome\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"?\n" +
+	"\x1cBatchActivateProductsRequest\x12\x1f\n" +
+	"\vproduct_ids\x18\x01 \x03(\tR\n" +
+	"productIds\"U\n" +
+	"\x1dBatchActivateProductsResponse\x124\n" +
+	"\boutcomes\x18\x01 \x03(\v2\x18.product.v1.BatchOutcomeR\boutcomes\"A\n" +
+	"\x1eBatchDeactivateProductsRequest\x12\x1f\n" +
+	"\vproduct_ids\x18\x01 \x03(\tR\n" +
+	"productIds\"W\n" +
+	"\x1fBatchDeactivateProductsResponse\x124\n" +
+	"\boutcomes\x18\x01 \x03(\v2\x18.product.v1.BatchOutcomeR\boutcomes\">\n" +
+	"\x1bBatchArchiveProductsRequest\x12\x1f\n" +
+	"\vproduct_ids\x18\x01 \x03(\tR\n" +
+	"productIds\"T\n" +
+	"\x1cBatchArchiveProductsResponse\x124\n" +
+	"\boutcomes\x18\x01 \x03(\v2\x18.product.v1.BatchOutcomeR\boutcomes\"\\\n" +
+	"\x14MergeProductsRequest\x12!\n" +
+	"\fduplicate_id\x18\x01 \x01(\tR\vduplicateId\x12!\n" +
+	"\fcanonical_id\x18\x02 \x01(\tR\vcanonicalId\"]\n" +
+	"\x15MergeProductsResponse\x12!\n" +
+	"\fduplicate_id\x18\x01 \x01(\tR\vduplicateId\x12!\n" +
+	"\fcanonical_id\x18\x02 \x01(\tR\vcanonicalId\"\xc5\x01\n" +
+	"\x13CloneProductRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12(\n" +
+	"\x10target_tenant_id\x18\x02 \x01(\tR\x0etargetTenantId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1f\n" +
+	"\vcopy_prices\x18\x04 \x01(\bR\n" +
+	"copyPrices\x120\n" +
+	"\n" +
+	"base_price\x18\x05 \x01(\v2\x11.product.v1.MoneyR\tbasePrice\"\x81\x01\n" +
+	"\x14CloneProductResponse\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12-\n" +
+	"\aproduct\x18\x03 \x01(\v2\x13.product.v1.ProductR\aproduct\"\xe4\x01\n" +
+	"\x15SearchProductsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x19\n" +
+	"\border_by\x18\x03 \x01(\tR\aorderBy\x12A\n" +
+	"\x13min_effective_price\x18\x04 \x01(\v2\x11.product.v1.MoneyR\x11minEffectivePrice\x12A\n" +
+	"\x13max_effective_price\x18\x05 \x01(\v2\x11.product.v1.MoneyR\x11maxEffectivePrice\"\x88\x01\n" +
+	"\tSearchHit\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x01R\x05score\x12\x16\n" +
+	"\x06pinned\x18\x05 \x01(\bR\x06pinned\"C\n" +
+	"\x16SearchProductsResponse\x12)\n" +
+	"\x04hits\x18\x01 \x03(\v2\x15.product.v1.SearchHitR\x04hits\"\x88\x02\n" +
+	"\tMerchRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12-\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x19.product.v1.MerchRuleKindR\x04kind\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x04 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bposition\x18\x05 \x01(\x03R\bposition\x12\x1a\n" +
+	"\bcategory\x18\x06 \x01(\tR\bcategory\x12\x14\n" +
+	"\x05boost\x18\a \x01(\x01R\x05boost\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"C\n" +
+	"\x16CreateMerchRuleRequest\x12)\n" +
+	"\x04rule\x18\x01 \x01(\v2\x15.product.v1.MerchRuleR\x04rule\"2\n" +
+	"\x17CreateMerchRuleResponse\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\tR\x06ruleId\"1\n" +
+	"\x16DeleteMerchRuleRequest\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\tR\x06ruleId\"2\n" +
+	"\x17DeleteMerchRuleResponse\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\tR\x06ruleId\"\x17\n" +
+	"\x15ListMerchRulesRequest\"E\n" +
+	"\x16ListMerchRulesResponse\x12+\n" +
+	"\x05rules\x18\x01 \x03(\v2\x15.product.v1.MerchRuleR\x05rules\"\x9b\x01\n" +
+	"\x13AttributeDefinition\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12-\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x19.product.v1.AttributeTypeR\x04type\x12\x1a\n" +
+	"\brequired\x18\x03 \x01(\bR\brequired\x12%\n" +
+	"\x0eallowed_values\x18\x04 \x03(\tR\rallowedValues\"\xaa\x01\n" +
+	"\x10CategoryTemplate\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12?\n" +
+	"\n" +
+	"attributes\x18\x02 \x03(\v2\x1f.product.v1.AttributeDefinitionR\n" +
+	"attributes\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"V\n" +
+	"\x1aPutCategoryTemplateRequest\x128\n" +
+	"\btemplate\x18\x01 \x01(\v2\x1c.product.v1.CategoryTemplateR\btemplate\"W\n" +
+	"\x1bPutCategoryTemplateResponse\x128\n" +
+	"\btemplate\x18\x01 \x01(\v2\x1c.product.v1.CategoryTemplateR\btemplate\";\n" +
+	"\x1dDeleteCategoryTemplateRequest\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\"<\n" +
+	"\x1eDeleteCategoryTemplateResponse\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\"8\n" +
+	"\x1aGetCategoryTemplateRequest\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\"W\n" +
+	"\x1bGetCategoryTemplateResponse\x128\n" +
+	"\btemplate\x18\x01 \x01(\v2\x1c.product.v1.CategoryTemplateR\btemplate\"\x94\x02\n" +
+	"\x0eTenantSettings\x12)\n" +
+	"\x10default_currency\x18\x01 \x01(\tR\x0fdefaultCurrency\x12&\n" +
+	"\x0fmax_name_length\x18\x02 \x01(\x05R\rmaxNameLength\x129\n" +
+	"\x19min_discount_basis_points\x18\x03 \x01(\x03R\x16minDiscountBasisPoints\x129\n" +
+	"\x19max_discount_basis_points\x18\x04 \x01(\x03R\x16maxDiscountBasisPoints\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"R\n" +
+	"\x18PutTenantSettingsRequest\x126\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1a.product.v1.TenantSettingsR\bsettings\"\x8d\x01\n" +
+	"\x19PutTenantSettingsResponse\x126\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1a.product.v1.TenantSettingsR\bsettings\x128\n" +
+	"\teffective\x18\x02 \x01(\v2\x1a.product.v1.TenantSettingsR\teffective\"\x1d\n" +
+	"\x1bDeleteTenantSettingsRequest\"X\n" +
+	"\x1cDeleteTenantSettingsResponse\x128\n" +
+	"\teffective\x18\x01 \x01(\v2\x1a.product.v1.TenantSettingsR\teffective\"\x1a\n" +
+	"\x18GetTenantSettingsRequest\"\x8d\x01\n" +
+	"\x19GetTenantSettingsResponse\x126\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1a.product.v1.TenantSettingsR\bsettings\x128\n" +
+	"\teffective\x18\x02 \x01(\v2\x1a.product.v1.TenantSettingsR\teffective\"\xd1\x02\n" +
+	"\x06Tenant\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12'\n" +
+	"\x0fregistration_id\x18\x02 \x01(\tR\x0eregistrationId\x12!\n" +
+	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12-\n" +
+	"\x05state\x18\x04 \x01(\x0e2\x17.product.v1.TenantStateR\x05state\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x129\n" +
+	"\n" +
+	"deleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"W\n" +
+	"\x10TenantApiKeySpec\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12/\n" +
+	"\x06scopes\x18\x02 \x03(\x0e2\x17.product.v1.ApiKeyScopeR\x06scopes\"S\n" +
+	"\fTenantApiKey\x12+\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x12.product.v1.ApiKeyR\x06apiKey\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"\x93\x02\n" +
+	"\x13CreateTenantRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x126\n" +
+	"\bsettings\x18\x03 \x01(\v2\x1a.product.v1.TenantSettingsR\bsettings\x12K\n" +
+	"\x12category_templates\x18\x04 \x03(\v2\x1c.product.v1.CategoryTemplateR\x11categoryTemplates\x127\n" +
+	"\bapi_keys\x18\x05 \x03(\v2\x1c.product.v1.TenantApiKeySpecR\aapiKeys\"\x9e\x01\n" +
+	"\x14CreateTenantResponse\x12*\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x12.product.v1.TenantR\x06tenant\x123\n" +
+	"\bapi_keys\x18\x02 \x03(\v2\x18.product.v1.TenantApiKeyR\aapiKeys\x12%\n" +
+	"\x0eoperation_name\x18\x03 \x01(\tR\roperationName\"o\n" +
+	"\x12CreateTenantResult\x12*\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x12.product.v1.TenantR\x06tenant\x12-\n" +
+	"\x12category_templates\x18\x02 \x01(\x05R\x11categoryTemplates\"\x7f\n" +
+	"\x13DeleteTenantRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x16\n" +
+	"\x06export\x18\x02 \x01(\bR\x06export\x123\n" +
+	"\x16start_after_product_id\x18\x03 \x01(\tR\x13startAfterProductId\"i\n" +
+	"\x14DeleteTenantResponse\x12*\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x12.product.v1.TenantR\x06tenant\x12%\n" +
+	"\x0eoperation_name\x18\x02 \x01(\tR\roperationName\"\xb0\x02\n" +
+	"\x12DeleteTenantResult\x12*\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x12.product.v1.TenantR\x06tenant\x12(\n" +
+	"\x10api_keys_revoked\x18\x02 \x01(\x05R\x0eapiKeysRevoked\x12'\n" +
+	"\x0fproducts_purged\x18\x03 \x01(\x03R\x0eproductsPurged\x12+\n" +
+	"\x11products_exported\x18\x04 \x01(\x03R\x10productsExported\x12!\n" +
+	"\frows_deleted\x18\x05 \x01(\x03R\vrowsDeleted\x12#\n" +
+	"\rexport_prefix\x18\x06 \x01(\tR\fexportPrefix\x12&\n" +
+	"\x0flast_product_id\x18\a \x01(\tR\rlastProductId\"F\n" +
+	"\x16SuggestProductsRequest\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"p\n" +
+	"\n" +
+	"Suggestion\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12.\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x1a.product.v1.SuggestionKindR\x04kind\x12\x1e\n" +
+	"\n" +
+	"popularity\x18\x03 \x01(\x03R\n" +
+	"popularity\"S\n" +
+	"\x17SuggestProductsResponse\x128\n" +
+	"\vsuggestions\x18\x01 \x03(\v2\x16.product.v1.SuggestionR\vsuggestions\"9\n" +
+	"\x18RecordProductViewRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"\x1b\n" +
+	"\x19RecordProductViewResponse\"<\n" +
+	"\x15ListCategoriesRequest\x12#\n" +
+	"\rinclude_empty\x18\x01 \x01(\bR\fincludeEmpty\"\xd3\x01\n" +
+	"\bCategory\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x120\n" +
+	"\x14active_product_count\x18\x03 \x01(\x03R\x12activeProductCount\x12;\n" +
+	"\x1atotal_active_product_count\x18\x04 \x01(\x03R\x17totalActiveProductCount\x120\n" +
+	"\bchildren\x18\x05 \x03(\v2\x14.product.v1.CategoryR\bchildren\"N\n" +
+	"\x16ListCategoriesResponse\x124\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x14.product.v1.CategoryR\n" +
+	"categories\"9\n" +
+	"\x16GetProductStatsRequest\x12\x1f\n" +
+	"\vproduct_ids\x18\x01 \x03(\tR\n" +
+	"productIds\"\x8e\x01\n" +
+	"\fProductStats\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"view_count\x18\x02 \x01(\x03R\tviewCount\x12@\n" +
+	"\x0elast_viewed_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\flastViewedAt\"I\n" +
+	"\x17GetProductStatsResponse\x12.\n" +
+	"\x05stats\x18\x01 \x03(\v2\x18.product.v1.ProductStatsR\x05stats\"2\n" +
+	"\x1aListCuratedProductsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"\x8b\x01\n" +
+	"\x1bListCuratedProductsResponse\x12/\n" +
+	"\bproducts\x18\x01 \x03(\v2\x13.product.v1.ProductR\bproducts\x12;\n" +
+	"\vcomputed_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"computedAt\"P\n" +
+	"\x19GetRecommendationsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"M\n" +
+	"\x1aGetRecommendationsResponse\x12/\n" +
+	"\bproducts\x18\x01 \x03(\v2\x13.product.v1.ProductR\bproducts\"f\n" +
+	"\x17GetProductJsonLdRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\"V\n" +
+	"\x18GetProductJsonLdResponse\x12\x17\n" +
+	"\ajson_ld\x18\x01 \x01(\tR\x06jsonLd\x12!\n" +
+	"\faliased_from\x18\x02 \x01(\tR\valiasedFrom\"\xda\x01\n" +
+	"\x06ApiKey\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12/\n" +
+	"\x06scopes\x18\x03 \x03(\x0e2\x17.product.v1.ApiKeyScopeR\x06scopes\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"revoked_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\"Y\n" +
+	"\x12IssueApiKeyRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12/\n" +
+	"\x06scopes\x18\x02 \x03(\x0e2\x17.product.v1.ApiKeyScopeR\x06scopes\"Z\n" +
+	"\x13IssueApiKeyResponse\x12+\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x12.product.v1.ApiKeyR\x06apiKey\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\",\n" +
+	"\x13RevokeApiKeyRequest\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\"C\n" +
+	"\x14RevokeApiKeyResponse\x12+\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x12.product.v1.ApiKeyR\x06apiKey\"\x14\n" +
+	"\x12ListApiKeysRequest\"D\n" +
+	"\x13ListApiKeysResponse\x12-\n" +
+	"\bapi_keys\x18\x01 \x03(\v2\x12.product.v1.ApiKeyR\aapiKeys\"\x9a\x01\n" +
+	"\x0fGetUsageRequest\x129\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x15\n" +
+	"\x06key_id\x18\x03 \x01(\tR\x05keyId\"\xc3\x01\n" +
+	"\vUsageRecord\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x12,\n" +
+	"\x03day\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x03day\x12#\n" +
+	"\rrequest_count\x18\x03 \x01(\x03R\frequestCount\x12#\n" +
+	"\rrows_returned\x18\x04 \x01(\x03R\frowsReturned\x12%\n" +
+	"\x0emutation_count\x18\x05 \x01(\x03R\rmutationCount\"E\n" +
+	"\x10GetUsageResponse\x121\n" +
+	"\arecords\x18\x01 \x03(\v2\x17.product.v1.UsageRecordR\arecords\"\xc7\x01\n" +
+	"\x0eFaultInjection\x123\n" +
+	"\alatency\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\alatency\x12#\n" +
+	"\rabort_percent\x18\x02 \x01(\x01R\fabortPercent\x12/\n" +
+	"\x13unavailable_percent\x18\x03 \x01(\x01R\x12unavailablePercent\x12*\n" +
+	"\x11not_found_percent\x18\x04 \x01(\x01R\x0fnotFoundPercent\"\x1a\n" +
+	"\x18GetFaultInjectionRequest\"`\n" +
+	"\x19GetFaultInjectionResponse\x12C\n" +
+	"\x0ffault_injection\x18\x01 \x01(\v2\x1a.product.v1.FaultInjectionR\x0efaultInjection\"_\n" +
+	"\x18SetFaultInjectionRequest\x12C\n" +
+	"\x0ffault_injection\x18\x01 \x01(\v2\x1a.product.v1.FaultInjectionR\x0efaultInjection\"`\n" +
+	"\x19SetFaultInjectionResponse\x12C\n" +
+	"\x0ffault_injection\x18\x01 \x01(\v2\x1a.product.v1.FaultInjectionR\x0efaultInjection\"\xbf\x02\n" +
+	"\x0eProductVersion\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x01 \x01(\tR\tversionId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\x12D\n" +
+	"\bmetadata\x18\x05 \x03(\v2(.product.v1.ProductVersion.MetadataEntryR\bmetadata\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\";\n" +
+	"\x1aListProductVersionsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"t\n" +
+	"\x1bListProductVersionsResponse\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x126\n" +
+	"\bversions\x18\x02 \x03(\v2\x1a.product.v1.ProductVersionR\bversions\"X\n" +
+	"\x18RollbackToVersionRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x02 \x01(\tR\tversionId\"Y\n" +
+	"\x19RollbackToVersionResponse\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x02 \x01(\tR\tversionId\"\xef\x01\n" +
+	"\x10SaveDraftRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x1f\n" +
+	"\bcategory\x18\x04 \x01(\tH\x02R\bcategory\x88\x01\x01\x125\n" +
+	"\bmetadata\x18\x05 \x01(\v2\x19.product.v1.DraftMetadataR\bmetadataB\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_descriptionB\v\n" +
+	"\t_category\"\x8d\x01\n" +
+	"\rDraftMetadata\x12@\n" +
+	"\aentries\x18\x01 \x03(\v2&.product.v1.DraftMetadata.EntriesEntryR\aentries\x1a:\n" +
+	"\fEntriesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"2\n" +
+	"\x11SaveDraftResponse\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"4\n" +
+	"\x13PublishDraftRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"T\n" +
+	"\x14PublishDraftResponse\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x02 \x01(\tR\tversionId\"4\n" +
+	"\x13DiscardDraftRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"5\n" +
+	"\x14DiscardDraftResponse\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"i\n" +
+	"\x1bGeneratePreviewTokenRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12+\n" +
+	"\x03ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x03ttl\"q\n" +
+	"\x1cGeneratePreviewTokenResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12;\n" +
+	"\vexpire_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime*z\n" +
+	"\vProductType\x12\x1c\n" +
+	"\x18PRODUCT_TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15PRODUCT_TYPE_PHYSICAL\x10\x01\x12\x18\n" +
+	"\x14PRODUCT_TYPE_DIGITAL\x10\x02\x12\x18\n" +
+	"\x14PRODUCT_TYPE_SERVICE\x10\x03*v\n" +
+	"\x11DescriptionFormat\x12\"\n" +
+	"\x1eDESCRIPTION_FORMAT_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18DESCRIPTION_FORMAT_PLAIN\x10\x01\x12\x1f\n" +
+	"\x1bDESCRIPTION_FORMAT_MARKDOWN\x10\x02*\x82\x01\n" +
+	"\x0eDuplicateCheck\x12\x1f\n" +
+	"\x1bDUPLICATE_CHECK_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15DUPLICATE_CHECK_ALLOW\x10\x01\x12\x18\n" +
+	"\x14DUPLICATE_CHECK_WARN\x10\x02\x12\x1a\n" +
+	"\x16DUPLICATE_CHECK_REJECT\x10\x03*Z\n" +
+	"\vProductView\x12\x1c\n" +
+	"\x18PRODUCT_VIEW_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12PRODUCT_VIEW_BASIC\x10\x01\x12\x15\n" +
+	"\x11PRODUCT_VIEW_FULL\x10\x02*m\n" +
+	"\x0eReviewDecision\x12\x1f\n" +
+	"\x1bREVIEW_DECISION_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18REVIEW_DECISION_APPROVED\x10\x01\x12\x1c\n" +
+	"\x18REVIEW_DECISION_REJECTED\x10\x02*\xa7\x01\n" +
+	"\x13PendingChangeStatus\x12%\n" +
+	"!PENDING_CHANGE_STATUS_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dPENDING_CHANGE_STATUS_PENDING\x10\x01\x12\"\n" +
+	"\x1ePENDING_CHANGE_STATUS_APPROVED\x10\x02\x12\"\n" +
+	"\x1ePENDING_CHANGE_STATUS_REJECTED\x10\x03*d\n" +
+	"\rMerchRuleKind\x12\x1f\n" +
+	"\x1bMERCH_RULE_KIND_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13MERCH_RULE_KIND_PIN\x10\x01\x12\x19\n" +
+	"\x15MERCH_RULE_KIND_BOOST\x10\x02*\x7f\n" +
+	"\rAttributeType\x12\x1e\n" +
+	"\x1aATTRIBUTE_TYPE_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13ATTRIBUTE_TYPE_TEXT\x10\x01\x12\x19\n" +
+	"\x15ATTRIBUTE_TYPE_NUMBER\x10\x02\x12\x1a\n" +
+	"\x16ATTRIBUTE_TYPE_BOOLEAN\x10\x03*\x98\x01\n" +
+	"\vTenantState\x12\x1c\n" +
+	"\x18TENANT_STATE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19TENANT_STATE_PROVISIONING\x10\x01\x12\x17\n" +
+	"\x13TENANT_STATE_ACTIVE\x10\x02\x12\x19\n" +
+	"\x15TENANT_STATE_DELETING\x10\x03\x12\x18\n" +
+	"\x14TENANT_STATE_DELETED\x10\x04*i\n" +
+	"\x0eSuggestionKind\x12\x1f\n" +
+	"\x1bSUGGESTION_KIND_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14SUGGESTION_KIND_NAME\x10\x01\x12\x1c\n" +
+	"\x18SUGGESTION_KIND_CATEGORY\x10\x02*v\n" +
+	"\vApiKeyScope\x12\x1d\n" +
+	"\x19API_KEY_SCOPE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12API_KEY_SCOPE_READ\x10\x01\x12\x17\n" +
+	"\x13API_KEY_SCOPE_WRITE\x10\x02\x12\x17\n" +
+	"\x13API_KEY_SCOPE_ADMIN\x10\x032\xd80\n" +
 	"\x0eProductService\x12T\n" +
 	"\rCreateProduct\x12 .product.v1.CreateProductRequest\x1a!.product.v1.CreateProductResponse\x12T\n" +
 	"\rUpdateProduct\x12 .product.v1.UpdateProductRequest\x1a!.product.v1.UpdateProductResponse\x12K\n" +
@@ -1268,7 +11439,65 @@ const file_proto_product_v1_product_service_proto_rawDesc = "" +
 	"\x0eRemoveDiscount\x12!.product.v1.RemoveDiscountRequest\x1a\".product.v1.RemoveDiscountResponse\x12Z\n" +
 	"\x0fActivateProduct\x12\".product.v1.ActivateProductRequest\x1a#.product.v1.ActivateProductResponse\x12`\n" +
 	"\x11DeactivateProduct\x12$.product.v1.DeactivateProductRequest\x1a%.product.v1.DeactivateProductResponse\x12W\n" +
-	"\x0eArchiveProduct\x12!.product.v1.ArchiveProductRequest\x1a\".product.v1.ArchiveProductResponseB)Z'catalog-proj/proto/product/v1;productv1b\x06proto3"
+	"\x0eArchiveProduct\x12!.product.v1.ArchiveProductRequest\x1a\".product.v1.ArchiveProductResponse\x12f\n" +
+	"\x13FindSimilarProducts\x12&.product.v1.FindSimilarProductsRequest\x1a'.product.v1.FindSimilarProductsResponse\x12Z\n" +
+	"\x0fCompareProducts\x12\".product.v1.CompareProductsRequest\x1a#.product.v1.CompareProductsResponse\x12Q\n" +
+	"\fSetLegalHold\x12\x1f.product.v1.SetLegalHoldRequest\x1a .product.v1.SetLegalHoldResponse\x12l\n" +
+	"\x15PurgeArchivedProducts\x12(.product.v1.PurgeArchivedProductsRequest\x1a).product.v1.PurgeArchivedProductsResponse\x12`\n" +
+	"\x11ExportProductData\x12$.product.v1.ExportProductDataRequest\x1a%.product.v1.ExportProductDataResponse\x12f\n" +
+	"\x13BatchImportProducts\x12&.product.v1.BatchImportProductsRequest\x1a'.product.v1.BatchImportProductsResponse\x12Z\n" +
+	"\x0fValidateProduct\x12\".product.v1.ValidateProductRequest\x1a#.product.v1.ValidateProductResponse\x12T\n" +
+	"\rReviewProduct\x12 .product.v1.ReviewProductRequest\x1a!.product.v1.ReviewProductResponse\x12`\n" +
+	"\x11GetProductHistory\x12$.product.v1.GetProductHistoryRequest\x1a%.product.v1.GetProductHistoryResponse\x12`\n" +
+	"\x11RebuildProjection\x12$.product.v1.RebuildProjectionRequest\x1a%.product.v1.RebuildProjectionResponse\x12]\n" +
+	"\x10ReassignCategory\x12#.product.v1.ReassignCategoryRequest\x1a$.product.v1.ReassignCategoryResponse\x12N\n" +
+	"\vSetChannels\x12\x1e.product.v1.SetChannelsRequest\x1a\x1f.product.v1.SetChannelsResponse\x12N\n" +
+	"\vSetMetadata\x12\x1e.product.v1.SetMetadataRequest\x1a\x1f.product.v1.SetMetadataResponse\x12T\n" +
+	"\rSetAttributes\x12 .product.v1.SetAttributesRequest\x1a!.product.v1.SetAttributesResponse\x12Z\n" +
+	"\x0fLinkExternalRef\x12\".product.v1.LinkExternalRefRequest\x1a#.product.v1.LinkExternalRefResponse\x12`\n" +
+	"\x11UnlinkExternalRef\x12$.product.v1.UnlinkExternalRefRequest\x1a%.product.v1.UnlinkExternalRefResponse\x12e\n" +
+	"\x17GetProductByExternalRef\x12*.product.v1.GetProductByExternalRefRequest\x1a\x1e.product.v1.GetProductResponse\x12l\n" +
+	"\x15BatchActivateProducts\x12(.product.v1.BatchActivateProductsRequest\x1a).product.v1.BatchActivateProductsResponse\x12r\n" +
+	"\x17BatchDeactivateProducts\x12*.product.v1.BatchDeactivateProductsRequest\x1a+.product.v1.BatchDeactivateProductsResponse\x12i\n" +
+	"\x14BatchArchiveProducts\x12'.product.v1.BatchArchiveProductsRequest\x1a(.product.v1.BatchArchiveProductsResponse\x12T\n" +
+	"\rMergeProducts\x12 .product.v1.MergeProductsRequest\x1a!.product.v1.MergeProductsResponse\x12Q\n" +
+	"\fCloneProduct\x12\x1f.product.v1.CloneProductRequest\x1a .product.v1.CloneProductResponse\x12Z\n" +
+	"\x0fChangeBasePrice\x12\".product.v1.ChangeBasePriceRequest\x1a#.product.v1.ChangeBasePriceResponse\x12S\n" +
+	"\rApproveChange\x12 .product.v1.ApproveChangeRequest\x1a .product.v1.DecideChangeResponse\x12Q\n" +
+	"\fRejectChange\x12\x1f.product.v1.RejectChangeRequest\x1a .product.v1.DecideChangeResponse\x12T\n" +
+	"\rSetPriceFloor\x12 .product.v1.SetPriceFloorRequest\x1a!.product.v1.SetPriceFloorResponse\x12W\n" +
+	"\x0eSearchProducts\x12!.product.v1.SearchProductsRequest\x1a\".product.v1.SearchProductsResponse\x12Z\n" +
+	"\x0fCreateMerchRule\x12\".product.v1.CreateMerchRuleRequest\x1a#.product.v1.CreateMerchRuleResponse\x12Z\n" +
+	"\x0fDeleteMerchRule\x12\".product.v1.DeleteMerchRuleRequest\x1a#.product.v1.DeleteMerchRuleResponse\x12W\n" +
+	"\x0eListMerchRules\x12!.product.v1.ListMerchRulesRequest\x1a\".product.v1.ListMerchRulesResponse\x12f\n" +
+	"\x13PutCategoryTemplate\x12&.product.v1.PutCategoryTemplateRequest\x1a'.product.v1.PutCategoryTemplateResponse\x12o\n" +
+	"\x16DeleteCategoryTemplate\x12).product.v1.DeleteCategoryTemplateRequest\x1a*.product.v1.DeleteCategoryTemplateResponse\x12f\n" +
+	"\x13GetCategoryTemplate\x12&.product.v1.GetCategoryTemplateRequest\x1a'.product.v1.GetCategoryTemplateResponse\x12`\n" +
+	"\x11PutTenantSettings\x12$.product.v1.PutTenantSettingsRequest\x1a%.product.v1.PutTenantSettingsResponse\x12i\n" +
+	"\x14DeleteTenantSettings\x12'.product.v1.DeleteTenantSettingsRequest\x1a(.product.v1.DeleteTenantSettingsResponse\x12`\n" +
+	"\x11GetTenantSettings\x12$.product.v1.GetTenantSettingsRequest\x1a%.product.v1.GetTenantSettingsResponse\x12Q\n" +
+	"\fCreateTenant\x12\x1f.product.v1.CreateTenantRequest\x1a .product.v1.CreateTenantResponse\x12Q\n" +
+	"\fDeleteTenant\x12\x1f.product.v1.DeleteTenantRequest\x1a .product.v1.DeleteTenantResponse\x12Z\n" +
+	"\x0fSuggestProducts\x12\".product.v1.SuggestProductsRequest\x1a#.product.v1.SuggestProductsResponse\x12`\n" +
+	"\x11RecordProductView\x12$.product.v1.RecordProductViewRequest\x1a%.product.v1.RecordProductViewResponse\x12W\n" +
+	"\x0eListCategories\x12!.product.v1.ListCategoriesRequest\x1a\".product.v1.ListCategoriesResponse\x12Z\n" +
+	"\x0fGetProductStats\x12\".product.v1.GetProductStatsRequest\x1a#.product.v1.GetProductStatsResponse\x12b\n" +
+	"\x0fListNewArrivals\x12&.product.v1.ListCuratedProductsRequest\x1a'.product.v1.ListCuratedProductsResponse\x12g\n" +
+	"\x14ListTrendingProducts\x12&.product.v1.ListCuratedProductsRequest\x1a'.product.v1.ListCuratedProductsResponse\x12c\n" +
+	"\x12GetRecommendations\x12%.product.v1.GetRecommendationsRequest\x1a&.product.v1.GetRecommendationsResponse\x12]\n" +
+	"\x10GetProductJsonLd\x12#.product.v1.GetProductJsonLdRequest\x1a$.product.v1.GetProductJsonLdResponse\x12N\n" +
+	"\vIssueApiKey\x12\x1e.product.v1.IssueApiKeyRequest\x1a\x1f.product.v1.IssueApiKeyResponse\x12Q\n" +
+	"\fRevokeApiKey\x12\x1f.product.v1.RevokeApiKeyRequest\x1a .product.v1.RevokeApiKeyResponse\x12N\n" +
+	"\vListApiKeys\x12\x1e.product.v1.ListApiKeysRequest\x1a\x1f.product.v1.ListApiKeysResponse\x12E\n" +
+	"\bGetUsage\x12\x1b.product.v1.GetUsageRequest\x1a\x1c.product.v1.GetUsageResponse\x12`\n" +
+	"\x11GetFaultInjection\x12$.product.v1.GetFaultInjectionRequest\x1a%.product.v1.GetFaultInjectionResponse\x12`\n" +
+	"\x11SetFaultInjection\x12$.product.v1.SetFaultInjectionRequest\x1a%.product.v1.SetFaultInjectionResponse\x12f\n" +
+	"\x13ListProductVersions\x12&.product.v1.ListProductVersionsRequest\x1a'.product.v1.ListProductVersionsResponse\x12`\n" +
+	"\x11RollbackToVersion\x12$.product.v1.RollbackToVersionRequest\x1a%.product.v1.RollbackToVersionResponse\x12H\n" +
+	"\tSaveDraft\x12\x1c.product.v1.SaveDraftRequest\x1a\x1d.product.v1.SaveDraftResponse\x12Q\n" +
+	"\fPublishDraft\x12\x1f.product.v1.PublishDraftRequest\x1a .product.v1.PublishDraftResponse\x12Q\n" +
+	"\fDiscardDraft\x12\x1f.product.v1.DiscardDraftRequest\x1a .product.v1.DiscardDraftResponse\x12i\n" +
+	"\x14GeneratePreviewToken\x12'.product.v1.GeneratePreviewTokenRequest\x1a(.product.v1.GeneratePreviewTokenResponseB)Z'catalog-proj/proto/product/v1;productv1b\x06proto3"
 
 var (
 	file_proto_product_v1_product_service_proto_rawDescOnce sync.Once
@@ -1282,68 +11511,482 @@ func file_proto_product_v1_product_service_proto_rawDescGZIP() []byte {
 	return file_proto_product_v1_product_service_proto_rawDescData
 }
 
-var file_proto_product_v1_product_service_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_proto_product_v1_product_service_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
+var file_proto_product_v1_product_service_proto_msgTypes = make([]protoimpl.MessageInfo, 174)
 var file_proto_product_v1_product_service_proto_goTypes = []any{
-	(*Money)(nil),                     // 0: product.v1.Money
-	(*Discount)(nil),                  // 1: product.v1.Discount
-	(*Product)(nil),                   // 2: product.v1.Product
-	(*CreateProductRequest)(nil),      // 3: product.v1.CreateProductRequest
-	(*CreateProductResponse)(nil),     // 4: product.v1.CreateProductResponse
-	(*UpdateProductRequest)(nil),      // 5: product.v1.UpdateProductRequest
-	(*UpdateProductResponse)(nil),     // 6: product.v1.UpdateProductResponse
-	(*GetProductRequest)(nil),         // 7: product.v1.GetProductRequest
-	(*GetProductResponse)(nil),        // 8: product.v1.GetProductResponse
-	(*ListProductsRequest)(nil),       // 9: product.v1.ListProductsRequest
-	(*ListProductsResponse)(nil),      // 10: product.v1.ListProductsResponse
-	(*ApplyDiscountRequest)(nil),      // 11: product.v1.ApplyDiscountRequest
-	(*ApplyDiscountResponse)(nil),     // 12: product.v1.ApplyDiscountResponse
-	(*RemoveDiscountRequest)(nil),     // 13: product.v1.RemoveDiscountRequest
-	(*RemoveDiscountResponse)(nil),    // 14: product.v1.RemoveDiscountResponse
-	(*ActivateProductRequest)(nil),    // 15: product.v1.ActivateProductRequest
-	(*ActivateProductResponse)(nil),   // 16: product.v1.ActivateProductResponse
-	(*DeactivateProductRequest)(nil),  // 17: product.v1.DeactivateProductRequest
-	(*DeactivateProductResponse)(nil), // 18: product.v1.DeactivateProductResponse
-	(*ArchiveProductRequest)(nil),     // 19: product.v1.ArchiveProductRequest
-	(*ArchiveProductResponse)(nil),    // 20: product.v1.ArchiveProductResponse
-	(*timestamppb.Timestamp)(nil),     // 21: google.protobuf.Timestamp
+	(ProductType)(0),                        // 0: product.v1.ProductType
+	(DescriptionFormat)(0),                  // 1: product.v1.DescriptionFormat
+	(DuplicateCheck)(0),                     // 2: product.v1.DuplicateCheck
+	(ProductView)(0),                        // 3: product.v1.ProductView
+	(ReviewDecision)(0),                     // 4: product.v1.ReviewDecision
+	(PendingChangeStatus)(0),                // 5: product.v1.PendingChangeStatus
+	(MerchRuleKind)(0),                      // 6: product.v1.MerchRuleKind
+	(AttributeType)(0),                      // 7: product.v1.AttributeType
+	(TenantState)(0),                        // 8: product.v1.TenantState
+	(SuggestionKind)(0),                     // 9: product.v1.SuggestionKind
+	(ApiKeyScope)(0),                        // 10: product.v1.ApiKeyScope
+	(*Money)(nil),                           // 11: product.v1.Money
+	(*Discount)(nil),                        // 12: product.v1.Discount
+	(*Product)(nil),                         // 13: product.v1.Product
+	(*PriceFloor)(nil),                      // 14: product.v1.PriceFloor
+	(*Compliance)(nil),                      // 15: product.v1.Compliance
+	(*Weight)(nil),                          // 16: product.v1.Weight
+	(*Dimensions)(nil),                      // 17: product.v1.Dimensions
+	(*CreateProductRequest)(nil),            // 18: product.v1.CreateProductRequest
+	(*CreateProductResponse)(nil),           // 19: product.v1.CreateProductResponse
+	(*UpdateProductRequest)(nil),            // 20: product.v1.UpdateProductRequest
+	(*UpdateProductResponse)(nil),           // 21: product.v1.UpdateProductResponse
+	(*GetProductRequest)(nil),               // 22: product.v1.GetProductRequest
+	(*GetProductResponse)(nil),              // 23: product.v1.GetProductResponse
+	(*ListProductsRequest)(nil),             // 24: product.v1.ListProductsRequest
+	(*ListProductsResponse)(nil),            // 25: product.v1.ListProductsResponse
+	(*ApplyDiscountRequest)(nil),            // 26: product.v1.ApplyDiscountRequest
+	(*ApplyDiscountResponse)(nil),           // 27: product.v1.ApplyDiscountResponse
+	(*RemoveDiscountRequest)(nil),           // 28: product.v1.RemoveDiscountRequest
+	(*RemoveDiscountResponse)(nil),          // 29: product.v1.RemoveDiscountResponse
+	(*ActivateProductRequest)(nil),          // 30: product.v1.ActivateProductRequest
+	(*ActivateProductResponse)(nil),         // 31: product.v1.ActivateProductResponse
+	(*DeactivateProductRequest)(nil),        // 32: product.v1.DeactivateProductRequest
+	(*DeactivateProductResponse)(nil),       // 33: product.v1.DeactivateProductResponse
+	(*ArchiveProductRequest)(nil),           // 34: product.v1.ArchiveProductRequest
+	(*ArchiveProductResponse)(nil),          // 35: product.v1.ArchiveProductResponse
+	(*FindSimilarProductsRequest)(nil),      // 36: product.v1.FindSimilarProductsRequest
+	(*SimilarProduct)(nil),                  // 37: product.v1.SimilarProduct
+	(*FindSimilarProductsResponse)(nil),     // 38: product.v1.FindSimilarProductsResponse
+	(*CompareProductsRequest)(nil),          // 39: product.v1.CompareProductsRequest
+	(*ComparisonRow)(nil),                   // 40: product.v1.ComparisonRow
+	(*CompareProductsResponse)(nil),         // 41: product.v1.CompareProductsResponse
+	(*SetLegalHoldRequest)(nil),             // 42: product.v1.SetLegalHoldRequest
+	(*SetLegalHoldResponse)(nil),            // 43: product.v1.SetLegalHoldResponse
+	(*PurgeArchivedProductsRequest)(nil),    // 44: product.v1.PurgeArchivedProductsRequest
+	(*PurgedProduct)(nil),                   // 45: product.v1.PurgedProduct
+	(*PurgeArchivedProductsResponse)(nil),   // 46: product.v1.PurgeArchivedProductsResponse
+	(*ExportProductDataRequest)(nil),        // 47: product.v1.ExportProductDataRequest
+	(*ExportProductDataResponse)(nil),       // 48: product.v1.ExportProductDataResponse
+	(*BatchImportProductsRequest)(nil),      // 49: product.v1.BatchImportProductsRequest
+	(*BatchImportProductsResponse)(nil),     // 50: product.v1.BatchImportProductsResponse
+	(*BatchImportFailure)(nil),              // 51: product.v1.BatchImportFailure
+	(*BatchImportProductsResult)(nil),       // 52: product.v1.BatchImportProductsResult
+	(*OperationMetadata)(nil),               // 53: product.v1.OperationMetadata
+	(*ValidateProductRequest)(nil),          // 54: product.v1.ValidateProductRequest
+	(*ValidationViolation)(nil),             // 55: product.v1.ValidationViolation
+	(*ValidateProductResponse)(nil),         // 56: product.v1.ValidateProductResponse
+	(*ReviewProductRequest)(nil),            // 57: product.v1.ReviewProductRequest
+	(*ReviewProductResponse)(nil),           // 58: product.v1.ReviewProductResponse
+	(*GetProductHistoryRequest)(nil),        // 59: product.v1.GetProductHistoryRequest
+	(*ProductReview)(nil),                   // 60: product.v1.ProductReview
+	(*ProductHistoryEntry)(nil),             // 61: product.v1.ProductHistoryEntry
+	(*GetProductHistoryResponse)(nil),       // 62: product.v1.GetProductHistoryResponse
+	(*RebuildProjectionRequest)(nil),        // 63: product.v1.RebuildProjectionRequest
+	(*RebuildProjectionResponse)(nil),       // 64: product.v1.RebuildProjectionResponse
+	(*RebuildProjectionResult)(nil),         // 65: product.v1.RebuildProjectionResult
+	(*ReassignCategoryRequest)(nil),         // 66: product.v1.ReassignCategoryRequest
+	(*ReassignCategoryResponse)(nil),        // 67: product.v1.ReassignCategoryResponse
+	(*ReassignCategoryFailure)(nil),         // 68: product.v1.ReassignCategoryFailure
+	(*ReassignCategoryResult)(nil),          // 69: product.v1.ReassignCategoryResult
+	(*SetChannelsRequest)(nil),              // 70: product.v1.SetChannelsRequest
+	(*SetChannelsResponse)(nil),             // 71: product.v1.SetChannelsResponse
+	(*SetMetadataRequest)(nil),              // 72: product.v1.SetMetadataRequest
+	(*SetMetadataResponse)(nil),             // 73: product.v1.SetMetadataResponse
+	(*SetAttributesRequest)(nil),            // 74: product.v1.SetAttributesRequest
+	(*SetAttributesResponse)(nil),           // 75: product.v1.SetAttributesResponse
+	(*LinkExternalRefRequest)(nil),          // 76: product.v1.LinkExternalRefRequest
+	(*LinkExternalRefResponse)(nil),         // 77: product.v1.LinkExternalRefResponse
+	(*UnlinkExternalRefRequest)(nil),        // 78: product.v1.UnlinkExternalRefRequest
+	(*UnlinkExternalRefResponse)(nil),       // 79: product.v1.UnlinkExternalRefResponse
+	(*GetProductByExternalRefRequest)(nil),  // 80: product.v1.GetProductByExternalRefRequest
+	(*ChangeBasePriceRequest)(nil),          // 81: product.v1.ChangeBasePriceRequest
+	(*ChangeBasePriceResponse)(nil),         // 82: product.v1.ChangeBasePriceResponse
+	(*ApproveChangeRequest)(nil),            // 83: product.v1.ApproveChangeRequest
+	(*RejectChangeRequest)(nil),             // 84: product.v1.RejectChangeRequest
+	(*DecideChangeResponse)(nil),            // 85: product.v1.DecideChangeResponse
+	(*SetPriceFloorRequest)(nil),            // 86: product.v1.SetPriceFloorRequest
+	(*SetPriceFloorResponse)(nil),           // 87: product.v1.SetPriceFloorResponse
+	(*BatchOutcome)(nil),                    // 88: product.v1.BatchOutcome
+	(*BatchActivateProductsRequest)(nil),    // 89: product.v1.BatchActivateProductsRequest
+	(*BatchActivateProductsResponse)(nil),   // 90: product.v1.BatchActivateProductsResponse
+	(*BatchDeactivateProductsRequest)(nil),  // 91: product.v1.BatchDeactivateProductsRequest
+	(*BatchDeactivateProductsResponse)(nil), // 92: product.v1.BatchDeactivateProductsResponse
+	(*BatchArchiveProductsRequest)(nil),     // 93: product.v1.BatchArchiveProductsRequest
+	(*BatchArchiveProductsResponse)(nil),    // 94: product.v1.BatchArchiveProductsResponse
+	(*MergeProductsRequest)(nil),            // 95: product.v1.MergeProductsRequest
+	(*MergeProductsResponse)(nil),           // 96: product.v1.MergeProductsResponse
+	(*CloneProductRequest)(nil),             // 97: product.v1.CloneProductRequest
+	(*CloneProductResponse)(nil),            // 98: product.v1.CloneProductResponse
+	(*SearchProductsRequest)(nil),           // 99: product.v1.SearchProductsRequest
+	(*SearchHit)(nil),                       // 100: product.v1.SearchHit
+	(*SearchProductsResponse)(nil),          // 101: product.v1.SearchProductsResponse
+	(*MerchRule)(nil),                       // 102: product.v1.MerchRule
+	(*CreateMerchRuleRequest)(nil),          // 103: product.v1.CreateMerchRuleRequest
+	(*CreateMerchRuleResponse)(nil),         // 104: product.v1.CreateMerchRuleResponse
+	(*DeleteMerchRuleRequest)(nil),          // 105: product.v1.DeleteMerchRuleRequest
+	(*DeleteMerchRuleResponse)(nil),         // 106: product.v1.DeleteMerchRuleResponse
+	(*ListMerchRulesRequest)(nil),           // 107: product.v1.ListMerchRulesRequest
+	(*ListMerchRulesResponse)(nil),          // 108: product.v1.ListMerchRulesResponse
+	(*AttributeDefinition)(nil),             // 109: product.v1.AttributeDefinition
+	(*CategoryTemplate)(nil),                // 110: product.v1.CategoryTemplate
+	(*PutCategoryTemplateRequest)(nil),      // 111: product.v1.PutCategoryTemplateRequest
+	(*PutCategoryTemplateResponse)(nil),     // 112: product.v1.PutCategoryTemplateResponse
+	(*DeleteCategoryTemplateRequest)(nil),   // 113: product.v1.DeleteCategoryTemplateRequest
+	(*DeleteCategoryTemplateResponse)(nil),  // 114: product.v1.DeleteCategoryTemplateResponse
+	(*GetCategoryTemplateRequest)(nil),      // 115: product.v1.GetCategoryTemplateRequest
+	(*GetCategoryTemplateResponse)(nil),     // 116: product.v1.GetCategoryTemplateResponse
+	(*TenantSettings)(nil),                  // 117: product.v1.TenantSettings
+	(*PutTenantSettingsRequest)(nil),        // 118: product.v1.PutTenantSettingsRequest
+	(*PutTenantSettingsResponse)(nil),       // 119: product.v1.PutTenantSettingsResponse
+	(*DeleteTenantSettingsRequest)(nil),     // 120: product.v1.DeleteTenantSettingsRequest
+	(*DeleteTenantSettingsResponse)(nil),    // 121: product.v1.DeleteTenantSettingsResponse
+	(*GetTenantSettingsRequest)(nil),        // 122: product.v1.GetTenantSettingsRequest
+	(*GetTenantSettingsResponse)(nil),       // 123: product.v1.GetTenantSettingsResponse
+	(*Tenant)(nil),                          // 124: product.v1.Tenant
+	(*TenantApiKeySpec)(nil),                // 125: product.v1.TenantApiKeySpec
+	(*TenantApiKey)(nil),                    // 126: product.v1.TenantApiKey
+	(*CreateTenantRequest)(nil),             // 127: product.v1.CreateTenantRequest
+	(*CreateTenantResponse)(nil),            // 128: product.v1.CreateTenantResponse
+	(*CreateTenantResult)(nil),              // 129: product.v1.CreateTenantResult
+	(*DeleteTenantRequest)(nil),             // 130: product.v1.DeleteTenantRequest
+	(*DeleteTenantResponse)(nil),            // 131: product.v1.DeleteTenantResponse
+	(*DeleteTenantResult)(nil),              // 132: product.v1.DeleteTenantResult
+	(*SuggestProductsRequest)(nil),          // 133: product.v1.SuggestProductsRequest
+	(*Suggestion)(nil),                      // 134: product.v1.Suggestion
+	(*SuggestProductsResponse)(nil),         // 135: product.v1.SuggestProductsResponse
+	(*RecordProductViewRequest)(nil),        // 136: product.v1.RecordProductViewRequest
+	(*RecordProductViewResponse)(nil),       // 137: product.v1.RecordProductViewResponse
+	(*ListCategoriesRequest)(nil),           // 138: product.v1.ListCategoriesRequest
+	(*Category)(nil),                        // 139: product.v1.Category
+	(*ListCategoriesResponse)(nil),          // 140: product.v1.ListCategoriesResponse
+	(*GetProductStatsRequest)(nil),          // 141: product.v1.GetProductStatsRequest
+	(*ProductStats)(nil),                    // 142: product.v1.ProductStats
+	(*GetProductStatsResponse)(nil),         // 143: product.v1.GetProductStatsResponse
+	(*ListCuratedProductsRequest)(nil),      // 144: product.v1.ListCuratedProductsRequest
+	(*ListCuratedProductsResponse)(nil),     // 145: product.v1.ListCuratedProductsResponse
+	(*GetRecommendationsRequest)(nil),       // 146: product.v1.GetRecommendationsRequest
+	(*GetRecommendationsResponse)(nil),      // 147: product.v1.GetRecommendationsResponse
+	(*GetProductJsonLdRequest)(nil),         // 148: product.v1.GetProductJsonLdRequest
+	(*GetProductJsonLdResponse)(nil),        // 149: product.v1.GetProductJsonLdResponse
+	(*ApiKey)(nil),                          // 150: product.v1.ApiKey
+	(*IssueApiKeyRequest)(nil),              // 151: product.v1.IssueApiKeyRequest
+	(*IssueApiKeyResponse)(nil),             // 152: product.v1.IssueApiKeyResponse
+	(*RevokeApiKeyRequest)(nil),             // 153: product.v1.RevokeApiKeyRequest
+	(*RevokeApiKeyResponse)(nil),            // 154: product.v1.RevokeApiKeyResponse
+	(*ListApiKeysRequest)(nil),              // 155: product.v1.ListApiKeysRequest
+	(*ListApiKeysResponse)(nil),             // 156: product.v1.ListApiKeysResponse
+	(*GetUsageRequest)(nil),                 // 157: product.v1.GetUsageRequest
+	(*UsageRecord)(nil),                     // 158: product.v1.UsageRecord
+	(*GetUsageResponse)(nil),                // 159: product.v1.GetUsageResponse
+	(*FaultInjection)(nil),                  // 160: product.v1.FaultInjection
+	(*GetFaultInjectionRequest)(nil),        // 161: product.v1.GetFaultInjectionRequest
+	(*GetFaultInjectionResponse)(nil),       // 162: product.v1.GetFaultInjectionResponse
+	(*SetFaultInjectionRequest)(nil),        // 163: product.v1.SetFaultInjectionRequest
+	(*SetFaultInjectionResponse)(nil),       // 164: product.v1.SetFaultInjectionResponse
+	(*ProductVersion)(nil),                  // 165: product.v1.ProductVersion
+	(*ListProductVersionsRequest)(nil),      // 166: product.v1.ListProductVersionsRequest
+	(*ListProductVersionsResponse)(nil),     // 167: product.v1.ListProductVersionsResponse
+	(*RollbackToVersionRequest)(nil),        // 168: product.v1.RollbackToVersionRequest
+	(*RollbackToVersionResponse)(nil),       // 169: product.v1.RollbackToVersionResponse
+	(*SaveDraftRequest)(nil),                // 170: product.v1.SaveDraftRequest
+	(*DraftMetadata)(nil),                   // 171: product.v1.DraftMetadata
+	(*SaveDraftResponse)(nil),               // 172: product.v1.SaveDraftResponse
+	(*PublishDraftRequest)(nil),             // 173: product.v1.PublishDraftRequest
+	(*PublishDraftResponse)(nil),            // 174: product.v1.PublishDraftResponse
+	(*DiscardDraftRequest)(nil),             // 175: product.v1.DiscardDraftRequest
+	(*DiscardDraftResponse)(nil),            // 176: product.v1.DiscardDraftResponse
+	(*GeneratePreviewTokenRequest)(nil),     // 177: product.v1.GeneratePreviewTokenRequest
+	(*GeneratePreviewTokenResponse)(nil),    // 178: product.v1.GeneratePreviewTokenResponse
+	nil,                                     // 179: product.v1.Product.MetadataEntry
+	nil,                                     // 180: product.v1.Product.AttributesEntry
+	nil,                                     // 181: product.v1.SetMetadataRequest.MetadataEntry
+	nil,                                     // 182: product.v1.SetAttributesRequest.AttributesEntry
+	nil,                                     // 183: product.v1.ProductVersion.MetadataEntry
+	nil,                                     // 184: product.v1.DraftMetadata.EntriesEntry
+	(*timestamppb.Timestamp)(nil),           // 185: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),             // 186: google.protobuf.Duration
 }
 var file_proto_product_v1_product_service_proto_depIdxs = []int32{
-	0,  // 0: product.v1.Discount.amount:type_name -> product.v1.Money
-	21, // 1: product.v1.Discount.start_date:type_name -> google.protobuf.Timestamp
-	21, // 2: product.v1.Discount.end_date:type_name -> google.protobuf.Timestamp
-	0,  // 3: product.v1.Product.base_price:type_name -> product.v1.Money
-	0,  // 4: product.v1.Product.effective_price:type_name -> product.v1.Money
-	1,  // 5: product.v1.Product.discount:type_name -> product.v1.Discount
-	21, // 6: product.v1.Product.archived_at:type_name -> google.protobuf.Timestamp
-	21, // 7: product.v1.Product.created_at:type_name -> google.protobuf.Timestamp
-	21, // 8: product.v1.Product.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 9: product.v1.CreateProductRequest.base_price:type_name -> product.v1.Money
-	2,  // 10: product.v1.GetProductResponse.product:type_name -> product.v1.Product
-	2,  // 11: product.v1.ListProductsResponse.products:type_name -> product.v1.Product
-	1,  // 12: product.v1.ApplyDiscountRequest.discount:type_name -> product.v1.Discount
-	3,  // 13: product.v1.ProductService.CreateProduct:input_type -> product.v1.CreateProductRequest
-	5,  // 14: product.v1.ProductService.UpdateProduct:input_type -> product.v1.UpdateProductRequest
-	7,  // 15: product.v1.ProductService.GetProduct:input_type -> product.v1.GetProductRequest
-	9,  // 16: product.v1.ProductService.ListProducts:input_type -> product.v1.ListProductsRequest
-	11, // 17: product.v1.ProductService.ApplyDiscount:input_type -> product.v1.ApplyDiscountRequest
-	13, // 18: product.v1.ProductService.RemoveDiscount:input_type -> product.v1.RemoveDiscountRequest
-	15, // 19: product.v1.ProductService.ActivateProduct:input_type -> product.v1.ActivateProductRequest
-	17, // 20: product.v1.ProductService.DeactivateProduct:input_type -> product.v1.DeactivateProductRequest
-	19, // 21: product.v1.ProductService.ArchiveProduct:input_type -> product.v1.ArchiveProductRequest
-	4,  // 22: product.v1.ProductService.CreateProduct:output_type -> product.v1.CreateProductResponse
-	6,  // 23: product.v1.ProductService.UpdateProduct:output_type -> product.v1.UpdateProductResponse
-	8,  // 24: product.v1.ProductService.GetProduct:output_type -> product.v1.GetProductResponse
-	10, // 25: product.v1.ProductService.ListProducts:output_type -> product.v1.ListProductsResponse
-	12, // 26: product.v1.ProductService.ApplyDiscount:output_type -> product.v1.ApplyDiscountResponse
-	14, // 27: product.v1.ProductService.RemoveDiscount:output_type -> product.v1.RemoveDiscountResponse
-	16, // 28: product.v1.ProductService.ActivateProduct:output_type -> product.v1.ActivateProductResponse
-	18, // 29: product.v1.ProductService.DeactivateProduct:output_type -> product.v1.DeactivateProductResponse
-	20, // 30: product.v1.ProductService.ArchiveProduct:output_type -> product.v1.ArchiveProductResponse
-	22, // [22:31] is the sub-list for method output_type
-	13, // [13:22] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	11,  // 0: product.v1.Discount.amount:type_name -> product.v1.Money
+	185, // 1: product.v1.Discount.start_date:type_name -> google.protobuf.Timestamp
+	185, // 2: product.v1.Discount.end_date:type_name -> google.protobuf.Timestamp
+	11,  // 3: product.v1.Product.base_price:type_name -> product.v1.Money
+	11,  // 4: product.v1.Product.effective_price:type_name -> product.v1.Money
+	12,  // 5: product.v1.Product.discount:type_name -> product.v1.Discount
+	185, // 6: product.v1.Product.archived_at:type_name -> google.protobuf.Timestamp
+	185, // 7: product.v1.Product.created_at:type_name -> google.protobuf.Timestamp
+	185, // 8: product.v1.Product.updated_at:type_name -> google.protobuf.Timestamp
+	16,  // 9: product.v1.Product.weight:type_name -> product.v1.Weight
+	17,  // 10: product.v1.Product.dimensions:type_name -> product.v1.Dimensions
+	0,   // 11: product.v1.Product.product_type:type_name -> product.v1.ProductType
+	15,  // 12: product.v1.Product.compliance:type_name -> product.v1.Compliance
+	179, // 13: product.v1.Product.metadata:type_name -> product.v1.Product.MetadataEntry
+	14,  // 14: product.v1.Product.price_floor:type_name -> product.v1.PriceFloor
+	1,   // 15: product.v1.Product.description_format:type_name -> product.v1.DescriptionFormat
+	180, // 16: product.v1.Product.attributes:type_name -> product.v1.Product.AttributesEntry
+	11,  // 17: product.v1.PriceFloor.min_price:type_name -> product.v1.Money
+	11,  // 18: product.v1.PriceFloor.cost:type_name -> product.v1.Money
+	11,  // 19: product.v1.PriceFloor.map_price:type_name -> product.v1.Money
+	11,  // 20: product.v1.CreateProductRequest.base_price:type_name -> product.v1.Money
+	2,   // 21: product.v1.CreateProductRequest.duplicate_check:type_name -> product.v1.DuplicateCheck
+	16,  // 22: product.v1.CreateProductRequest.weight:type_name -> product.v1.Weight
+	17,  // 23: product.v1.CreateProductRequest.dimensions:type_name -> product.v1.Dimensions
+	0,   // 24: product.v1.CreateProductRequest.product_type:type_name -> product.v1.ProductType
+	15,  // 25: product.v1.CreateProductRequest.compliance:type_name -> product.v1.Compliance
+	1,   // 26: product.v1.CreateProductRequest.description_format:type_name -> product.v1.DescriptionFormat
+	13,  // 27: product.v1.CreateProductResponse.product:type_name -> product.v1.Product
+	16,  // 28: product.v1.UpdateProductRequest.weight:type_name -> product.v1.Weight
+	17,  // 29: product.v1.UpdateProductRequest.dimensions:type_name -> product.v1.Dimensions
+	0,   // 30: product.v1.UpdateProductRequest.product_type:type_name -> product.v1.ProductType
+	15,  // 31: product.v1.UpdateProductRequest.compliance:type_name -> product.v1.Compliance
+	1,   // 32: product.v1.UpdateProductRequest.description_format:type_name -> product.v1.DescriptionFormat
+	13,  // 33: product.v1.UpdateProductResponse.product:type_name -> product.v1.Product
+	13,  // 34: product.v1.GetProductResponse.product:type_name -> product.v1.Product
+	3,   // 35: product.v1.ListProductsRequest.view:type_name -> product.v1.ProductView
+	11,  // 36: product.v1.ListProductsRequest.min_effective_price:type_name -> product.v1.Money
+	11,  // 37: product.v1.ListProductsRequest.max_effective_price:type_name -> product.v1.Money
+	13,  // 38: product.v1.ListProductsResponse.products:type_name -> product.v1.Product
+	12,  // 39: product.v1.ApplyDiscountRequest.discount:type_name -> product.v1.Discount
+	13,  // 40: product.v1.ApplyDiscountResponse.product:type_name -> product.v1.Product
+	13,  // 41: product.v1.RemoveDiscountResponse.product:type_name -> product.v1.Product
+	13,  // 42: product.v1.ActivateProductResponse.product:type_name -> product.v1.Product
+	13,  // 43: product.v1.DeactivateProductResponse.product:type_name -> product.v1.Product
+	13,  // 44: product.v1.ArchiveProductResponse.product:type_name -> product.v1.Product
+	37,  // 45: product.v1.FindSimilarProductsResponse.products:type_name -> product.v1.SimilarProduct
+	13,  // 46: product.v1.CompareProductsResponse.products:type_name -> product.v1.Product
+	40,  // 47: product.v1.CompareProductsResponse.rows:type_name -> product.v1.ComparisonRow
+	11,  // 48: product.v1.CompareProductsResponse.price_differences:type_name -> product.v1.Money
+	13,  // 49: product.v1.SetLegalHoldResponse.product:type_name -> product.v1.Product
+	185, // 50: product.v1.PurgedProduct.archived_at:type_name -> google.protobuf.Timestamp
+	185, // 51: product.v1.PurgeArchivedProductsResponse.archived_before:type_name -> google.protobuf.Timestamp
+	45,  // 52: product.v1.PurgeArchivedProductsResponse.purged:type_name -> product.v1.PurgedProduct
+	18,  // 53: product.v1.BatchImportProductsRequest.products:type_name -> product.v1.CreateProductRequest
+	51,  // 54: product.v1.BatchImportProductsResult.failures:type_name -> product.v1.BatchImportFailure
+	185, // 55: product.v1.OperationMetadata.create_time:type_name -> google.protobuf.Timestamp
+	185, // 56: product.v1.OperationMetadata.update_time:type_name -> google.protobuf.Timestamp
+	11,  // 57: product.v1.ValidateProductRequest.base_price:type_name -> product.v1.Money
+	1,   // 58: product.v1.ValidateProductRequest.description_format:type_name -> product.v1.DescriptionFormat
+	55,  // 59: product.v1.ValidateProductResponse.violations:type_name -> product.v1.ValidationViolation
+	4,   // 60: product.v1.ReviewProductRequest.decision:type_name -> product.v1.ReviewDecision
+	4,   // 61: product.v1.ProductReview.decision:type_name -> product.v1.ReviewDecision
+	185, // 62: product.v1.ProductHistoryEntry.occurred_at:type_name -> google.protobuf.Timestamp
+	60,  // 63: product.v1.ProductHistoryEntry.review:type_name -> product.v1.ProductReview
+	61,  // 64: product.v1.GetProductHistoryResponse.entries:type_name -> product.v1.ProductHistoryEntry
+	68,  // 65: product.v1.ReassignCategoryResult.failures:type_name -> product.v1.ReassignCategoryFailure
+	13,  // 66: product.v1.SetChannelsResponse.product:type_name -> product.v1.Product
+	181, // 67: product.v1.SetMetadataRequest.metadata:type_name -> product.v1.SetMetadataRequest.MetadataEntry
+	13,  // 68: product.v1.SetMetadataResponse.product:type_name -> product.v1.Product
+	182, // 69: product.v1.SetAttributesRequest.attributes:type_name -> product.v1.SetAttributesRequest.AttributesEntry
+	13,  // 70: product.v1.SetAttributesResponse.product:type_name -> product.v1.Product
+	11,  // 71: product.v1.ChangeBasePriceRequest.base_price:type_name -> product.v1.Money
+	5,   // 72: product.v1.DecideChangeResponse.status:type_name -> product.v1.PendingChangeStatus
+	14,  // 73: product.v1.SetPriceFloorRequest.price_floor:type_name -> product.v1.PriceFloor
+	13,  // 74: product.v1.SetPriceFloorResponse.product:type_name -> product.v1.Product
+	88,  // 75: product.v1.BatchActivateProductsResponse.outcomes:type_name -> product.v1.BatchOutcome
+	88,  // 76: product.v1.BatchDeactivateProductsResponse.outcomes:type_name -> product.v1.BatchOutcome
+	88,  // 77: product.v1.BatchArchiveProductsResponse.outcomes:type_name -> product.v1.BatchOutcome
+	11,  // 78: product.v1.CloneProductRequest.base_price:type_name -> product.v1.Money
+	13,  // 79: product.v1.CloneProductResponse.product:type_name -> product.v1.Product
+	11,  // 80: product.v1.SearchProductsRequest.min_effective_price:type_name -> product.v1.Money
+	11,  // 81: product.v1.SearchProductsRequest.max_effective_price:type_name -> product.v1.Money
+	100, // 82: product.v1.SearchProductsResponse.hits:type_name -> product.v1.SearchHit
+	6,   // 83: product.v1.MerchRule.kind:type_name -> product.v1.MerchRuleKind
+	185, // 84: product.v1.MerchRule.created_at:type_name -> google.protobuf.Timestamp
+	102, // 85: product.v1.CreateMerchRuleRequest.rule:type_name -> product.v1.MerchRule
+	102, // 86: product.v1.ListMerchRulesResponse.rules:type_name -> product.v1.MerchRule
+	7,   // 87: product.v1.AttributeDefinition.type:type_name -> product.v1.AttributeType
+	109, // 88: product.v1.CategoryTemplate.attributes:type_name -> product.v1.AttributeDefinition
+	185, // 89: product.v1.CategoryTemplate.updated_at:type_name -> google.protobuf.Timestamp
+	110, // 90: product.v1.PutCategoryTemplateRequest.template:type_name -> product.v1.CategoryTemplate
+	110, // 91: product.v1.PutCategoryTemplateResponse.template:type_name -> product.v1.CategoryTemplate
+	110, // 92: product.v1.GetCategoryTemplateResponse.template:type_name -> product.v1.CategoryTemplate
+	185, // 93: product.v1.TenantSettings.updated_at:type_name -> google.protobuf.Timestamp
+	117, // 94: product.v1.PutTenantSettingsRequest.settings:type_name -> product.v1.TenantSettings
+	117, // 95: product.v1.PutTenantSettingsResponse.settings:type_name -> product.v1.TenantSettings
+	117, // 96: product.v1.PutTenantSettingsResponse.effective:type_name -> product.v1.TenantSettings
+	117, // 97: product.v1.DeleteTenantSettingsResponse.effective:type_name -> product.v1.TenantSettings
+	117, // 98: product.v1.GetTenantSettingsResponse.settings:type_name -> product.v1.TenantSettings
+	117, // 99: product.v1.GetTenantSettingsResponse.effective:type_name -> product.v1.TenantSettings
+	8,   // 100: product.v1.Tenant.state:type_name -> product.v1.TenantState
+	185, // 101: product.v1.Tenant.created_at:type_name -> google.protobuf.Timestamp
+	185, // 102: product.v1.Tenant.updated_at:type_name -> google.protobuf.Timestamp
+	185, // 103: product.v1.Tenant.deleted_at:type_name -> google.protobuf.Timestamp
+	10,  // 104: product.v1.TenantApiKeySpec.scopes:type_name -> product.v1.ApiKeyScope
+	150, // 105: product.v1.TenantApiKey.api_key:type_name -> product.v1.ApiKey
+	117, // 106: product.v1.CreateTenantRequest.settings:type_name -> product.v1.TenantSettings
+	110, // 107: product.v1.CreateTenantRequest.category_templates:type_name -> product.v1.CategoryTemplate
+	125, // 108: product.v1.CreateTenantRequest.api_keys:type_name -> product.v1.TenantApiKeySpec
+	124, // 109: product.v1.CreateTenantResponse.tenant:type_name -> product.v1.Tenant
+	126, // 110: product.v1.CreateTenantResponse.api_keys:type_name -> product.v1.TenantApiKey
+	124, // 111: product.v1.CreateTenantResult.tenant:type_name -> product.v1.Tenant
+	124, // 112: product.v1.DeleteTenantResponse.tenant:type_name -> product.v1.Tenant
+	124, // 113: product.v1.DeleteTenantResult.tenant:type_name -> product.v1.Tenant
+	9,   // 114: product.v1.Suggestion.kind:type_name -> product.v1.SuggestionKind
+	134, // 115: product.v1.SuggestProductsResponse.suggestions:type_name -> product.v1.Suggestion
+	139, // 116: product.v1.Category.children:type_name -> product.v1.Category
+	139, // 117: product.v1.ListCategoriesResponse.categories:type_name -> product.v1.Category
+	185, // 118: product.v1.ProductStats.last_viewed_at:type_name -> google.protobuf.Timestamp
+	142, // 119: product.v1.GetProductStatsResponse.stats:type_name -> product.v1.ProductStats
+	13,  // 120: product.v1.ListCuratedProductsResponse.products:type_name -> product.v1.Product
+	185, // 121: product.v1.ListCuratedProductsResponse.computed_at:type_name -> google.protobuf.Timestamp
+	13,  // 122: product.v1.GetRecommendationsResponse.products:type_name -> product.v1.Product
+	10,  // 123: product.v1.ApiKey.scopes:type_name -> product.v1.ApiKeyScope
+	185, // 124: product.v1.ApiKey.created_at:type_name -> google.protobuf.Timestamp
+	185, // 125: product.v1.ApiKey.revoked_at:type_name -> google.protobuf.Timestamp
+	10,  // 126: product.v1.IssueApiKeyRequest.scopes:type_name -> product.v1.ApiKeyScope
+	150, // 127: product.v1.IssueApiKeyResponse.api_key:type_name -> product.v1.ApiKey
+	150, // 128: product.v1.RevokeApiKeyResponse.api_key:type_name -> product.v1.ApiKey
+	150, // 129: product.v1.ListApiKeysResponse.api_keys:type_name -> product.v1.ApiKey
+	185, // 130: product.v1.GetUsageRequest.start_time:type_name -> google.protobuf.Timestamp
+	185, // 131: product.v1.GetUsageRequest.end_time:type_name -> google.protobuf.Timestamp
+	185, // 132: product.v1.UsageRecord.day:type_name -> google.protobuf.Timestamp
+	158, // 133: product.v1.GetUsageResponse.records:type_name -> product.v1.UsageRecord
+	186, // 134: product.v1.FaultInjection.latency:type_name -> google.protobuf.Duration
+	160, // 135: product.v1.GetFaultInjectionResponse.fault_injection:type_name -> product.v1.FaultInjection
+	160, // 136: product.v1.SetFaultInjectionRequest.fault_injection:type_name -> product.v1.FaultInjection
+	160, // 137: product.v1.SetFaultInjectionResponse.fault_injection:type_name -> product.v1.FaultInjection
+	183, // 138: product.v1.ProductVersion.metadata:type_name -> product.v1.ProductVersion.MetadataEntry
+	185, // 139: product.v1.ProductVersion.created_at:type_name -> google.protobuf.Timestamp
+	165, // 140: product.v1.ListProductVersionsResponse.versions:type_name -> product.v1.ProductVersion
+	171, // 141: product.v1.SaveDraftRequest.metadata:type_name -> product.v1.DraftMetadata
+	184, // 142: product.v1.DraftMetadata.entries:type_name -> product.v1.DraftMetadata.EntriesEntry
+	186, // 143: product.v1.GeneratePreviewTokenRequest.ttl:type_name -> google.protobuf.Duration
+	185, // 144: product.v1.GeneratePreviewTokenResponse.expire_time:type_name -> google.protobuf.Timestamp
+	18,  // 145: product.v1.ProductService.CreateProduct:input_type -> product.v1.CreateProductRequest
+	20,  // 146: product.v1.ProductService.UpdateProduct:input_type -> product.v1.UpdateProductRequest
+	22,  // 147: product.v1.ProductService.GetProduct:input_type -> product.v1.GetProductRequest
+	24,  // 148: product.v1.ProductService.ListProducts:input_type -> product.v1.ListProductsRequest
+	26,  // 149: product.v1.ProductService.ApplyDiscount:input_type -> product.v1.ApplyDiscountRequest
+	28,  // 150: product.v1.ProductService.RemoveDiscount:input_type -> product.v1.RemoveDiscountRequest
+	30,  // 151: product.v1.ProductService.ActivateProduct:input_type -> product.v1.ActivateProductRequest
+	32,  // 152: product.v1.ProductService.DeactivateProduct:input_type -> product.v1.DeactivateProductRequest
+	34,  // 153: product.v1.ProductService.ArchiveProduct:input_type -> product.v1.ArchiveProductRequest
+	36,  // 154: product.v1.ProductService.FindSimilarProducts:input_type -> product.v1.FindSimilarProductsRequest
+	39,  // 155: product.v1.ProductService.CompareProducts:input_type -> product.v1.CompareProductsRequest
+	42,  // 156: product.v1.ProductService.SetLegalHold:input_type -> product.v1.SetLegalHoldRequest
+	44,  // 157: product.v1.ProductService.PurgeArchivedProducts:input_type -> product.v1.PurgeArchivedProductsRequest
+	47,  // 158: product.v1.ProductService.ExportProductData:input_type -> product.v1.ExportProductDataRequest
+	49,  // 159: product.v1.ProductService.BatchImportProducts:input_type -> product.v1.BatchImportProductsRequest
+	54,  // 160: product.v1.ProductService.ValidateProduct:input_type -> product.v1.ValidateProductRequest
+	57,  // 161: product.v1.ProductService.ReviewProduct:input_type -> product.v1.ReviewProductRequest
+	59,  // 162: product.v1.ProductService.GetProductHistory:input_type -> product.v1.GetProductHistoryRequest
+	63,  // 163: product.v1.ProductService.RebuildProjection:input_type -> product.v1.RebuildProjectionRequest
+	66,  // 164: product.v1.ProductService.ReassignCategory:input_type -> product.v1.ReassignCategoryRequest
+	70,  // 165: product.v1.ProductService.SetChannels:input_type -> product.v1.SetChannelsRequest
+	72,  // 166: product.v1.ProductService.SetMetadata:input_type -> product.v1.SetMetadataRequest
+	74,  // 167: product.v1.ProductService.SetAttributes:input_type -> product.v1.SetAttributesRequest
+	76,  // 168: product.v1.ProductService.LinkExternalRef:input_type -> product.v1.LinkExternalRefRequest
+	78,  // 169: product.v1.ProductService.UnlinkExternalRef:input_type -> product.v1.UnlinkExternalRefRequest
+	80,  // 170: product.v1.ProductService.GetProductByExternalRef:input_type -> product.v1.GetProductByExternalRefRequest
+	89,  // 171: product.v1.ProductService.BatchActivateProducts:input_type -> product.v1.BatchActivateProductsRequest
+	91,  // 172: product.v1.ProductService.BatchDeactivateProducts:input_type -> product.v1.BatchDeactivateProductsRequest
+	93,  // 173: product.v1.ProductService.BatchArchiveProducts:input_type -> product.v1.BatchArchiveProductsRequest
+	95,  // 174: product.v1.ProductService.MergeProducts:input_type -> product.v1.MergeProductsRequest
+	97,  // 175: product.v1.ProductService.CloneProduct:input_type -> product.v1.CloneProductRequest
+	81,  // 176: product.v1.ProductService.ChangeBasePrice:input_type -> product.v1.ChangeBasePriceRequest
+	83,  // 177: product.v1.ProductService.ApproveChange:input_type -> product.v1.ApproveChangeRequest
+	84,  // 178: product.v1.ProductService.RejectChange:input_type -> product.v1.RejectChangeRequest
+	86,  // 179: product.v1.ProductService.SetPriceFloor:input_type -> product.v1.SetPriceFloorRequest
+	99,  // 180: product.v1.ProductService.SearchProducts:input_type -> product.v1.SearchProductsRequest
+	103, // 181: product.v1.ProductService.CreateMerchRule:input_type -> product.v1.CreateMerchRuleRequest
+	105, // 182: product.v1.ProductService.DeleteMerchRule:input_type -> product.v1.DeleteMerchRuleRequest
+	107, // 183: product.v1.ProductService.ListMerchRules:input_type -> product.v1.ListMerchRulesRequest
+	111, // 184: product.v1.ProductService.PutCategoryTemplate:input_type -> product.v1.PutCategoryTemplateRequest
+	113, // 185: product.v1.ProductService.DeleteCategoryTemplate:input_type -> product.v1.DeleteCategoryTemplateRequest
+	115, // 186: product.v1.ProductService.GetCategoryTemplate:input_type -> product.v1.GetCategoryTemplateRequest
+	118, // 187: product.v1.ProductService.PutTenantSettings:input_type -> product.v1.PutTenantSettingsRequest
+	120, // 188: product.v1.ProductService.DeleteTenantSettings:input_type -> product.v1.DeleteTenantSettingsRequest
+	122, // 189: product.v1.ProductService.GetTenantSettings:input_type -> product.v1.GetTenantSettingsRequest
+	127, // 190: product.v1.ProductService.CreateTenant:input_type -> product.v1.CreateTenantRequest
+	130, // 191: product.v1.ProductService.DeleteTenant:input_type -> product.v1.DeleteTenantRequest
+	133, // 192: product.v1.ProductService.SuggestProducts:input_type -> product.v1.SuggestProductsRequest
+	136, // 193: product.v1.ProductService.RecordProductView:input_type -> product.v1.RecordProductViewRequest
+	138, // 194: product.v1.ProductService.ListCategories:input_type -> product.v1.ListCategoriesRequest
+	141, // 195: product.v1.ProductService.GetProductStats:input_type -> product.v1.GetProductStatsRequest
+	144, // 196: product.v1.ProductService.ListNewArrivals:input_type -> product.v1.ListCuratedProductsRequest
+	144, // 197: product.v1.ProductService.ListTrendingProducts:input_type -> product.v1.ListCuratedProductsRequest
+	146, // 198: product.v1.ProductService.GetRecommendations:input_type -> product.v1.GetRecommendationsRequest
+	148, // 199: product.v1.ProductService.GetProductJsonLd:input_type -> product.v1.GetProductJsonLdRequest
+	151, // 200: product.v1.ProductService.IssueApiKey:input_type -> product.v1.IssueApiKeyRequest
+	153, // 201: product.v1.ProductService.RevokeApiKey:input_type -> product.v1.RevokeApiKeyRequest
+	155, // 202: product.v1.ProductService.ListApiKeys:input_type -> product.v1.ListApiKeysRequest
+	157, // 203: product.v1.ProductService.GetUsage:input_type -> product.v1.GetUsageRequest
+	161, // 204: product.v1.ProductService.GetFaultInjection:input_type -> product.v1.GetFaultInjectionRequest
+	163, // 205: product.v1.ProductService.SetFaultInjection:input_type -> product.v1.SetFaultInjectionRequest
+	166, // 206: product.v1.ProductService.ListProductVersions:input_type -> product.v1.ListProductVersionsRequest
+	168, // 207: product.v1.ProductService.RollbackToVersion:input_type -> product.v1.RollbackToVersionRequest
+	170, // 208: product.v1.ProductService.SaveDraft:input_type -> product.v1.SaveDraftRequest
+	173, // 209: product.v1.ProductService.PublishDraft:input_type -> product.v1.PublishDraftRequest
+	175, // 210: product.v1.ProductService.DiscardDraft:input_type -> product.v1.DiscardDraftRequest
+	177, // 211: product.v1.ProductService.GeneratePreviewToken:input_type -> product.v1.GeneratePreviewTokenRequest
+	19,  // 212: product.v1.ProductService.CreateProduct:output_type -> product.v1.CreateProductResponse
+	21,  // 213: product.v1.ProductService.UpdateProduct:output_type -> product.v1.UpdateProductResponse
+	23,  // 214: product.v1.ProductService.GetProduct:output_type -> product.v1.GetProductResponse
+	25,  // 215: product.v1.ProductService.ListProducts:output_type -> product.v1.ListProductsResponse
+	27,  // 216: product.v1.ProductService.ApplyDiscount:output_type -> product.v1.ApplyDiscountResponse
+	29,  // 217: product.v1.ProductService.RemoveDiscount:output_type -> product.v1.RemoveDiscountResponse
+	31,  // 218: product.v1.ProductService.ActivateProduct:output_type -> product.v1.ActivateProductResponse
+	33,  // 219: product.v1.ProductService.DeactivateProduct:output_type -> product.v1.DeactivateProductResponse
+	35,  // 220: product.v1.ProductService.ArchiveProduct:output_type -> product.v1.ArchiveProductResponse
+	38,  // 221: product.v1.ProductService.FindSimilarProducts:output_type -> product.v1.FindSimilarProductsResponse
+	41,  // 222: product.v1.ProductService.CompareProducts:output_type -> product.v1.CompareProductsResponse
+	43,  // 223: product.v1.ProductService.SetLegalHold:output_type -> product.v1.SetLegalHoldResponse
+	46,  // 224: product.v1.ProductService.PurgeArchivedProducts:output_type -> product.v1.PurgeArchivedProductsResponse
+	48,  // 225: product.v1.ProductService.ExportProductData:output_type -> product.v1.ExportProductDataResponse
+	50,  // 226: product.v1.ProductService.BatchImportProducts:output_type -> product.v1.BatchImportProductsResponse
+	56,  // 227: product.v1.ProductService.ValidateProduct:output_type -> product.v1.ValidateProductResponse
+	58,  // 228: product.v1.ProductService.ReviewProduct:output_type -> product.v1.ReviewProductResponse
+	62,  // 229: product.v1.ProductService.GetProductHistory:output_type -> product.v1.GetProductHistoryResponse
+	64,  // 230: product.v1.ProductService.RebuildProjection:output_type -> product.v1.RebuildProjectionResponse
+	67,  // 231: product.v1.ProductService.ReassignCategory:output_type -> product.v1.ReassignCategoryResponse
+	71,  // 232: product.v1.ProductService.SetChannels:output_type -> product.v1.SetChannelsResponse
+	73,  // 233: product.v1.ProductService.SetMetadata:output_type -> product.v1.SetMetadataResponse
+	75,  // 234: product.v1.ProductService.SetAttributes:output_type -> product.v1.SetAttributesResponse
+	77,  // 235: product.v1.ProductService.LinkExternalRef:output_type -> product.v1.LinkExternalRefResponse
+	79,  // 236: product.v1.ProductService.UnlinkExternalRef:output_type -> product.v1.UnlinkExternalRefResponse
+	23,  // 237: product.v1.ProductService.GetProductByExternalRef:output_type -> product.v1.GetProductResponse
+	90,  // 238: product.v1.ProductService.BatchActivateProducts:output_type -> product.v1.BatchActivateProductsResponse
+	92,  // 239: product.v1.ProductService.BatchDeactivateProducts:output_type -> product.v1.BatchDeactivateProductsResponse
+	94,  // 240: product.v1.ProductService.BatchArchiveProducts:output_type -> product.v1.BatchArchiveProductsResponse
+	96,  // 241: product.v1.ProductService.MergeProducts:output_type -> product.v1.MergeProductsResponse
+	98,  // 242: product.v1.ProductService.CloneProduct:output_type -> product.v1.CloneProductResponse
+	82,  // 243: product.v1.ProductService.ChangeBasePrice:output_type -> product.v1.ChangeBasePriceResponse
+	85,  // 244: product.v1.ProductService.ApproveChange:output_type -> product.v1.DecideChangeResponse
+	85,  // 245: product.v1.ProductService.RejectChange:output_type -> product.v1.DecideChangeResponse
+	87,  // 246: product.v1.ProductService.SetPriceFloor:output_type -> product.v1.SetPriceFloorResponse
+	101, // 247: product.v1.ProductService.SearchProducts:output_type -> product.v1.SearchProductsResponse
+	104, // 248: product.v1.ProductService.CreateMerchRule:output_type -> product.v1.CreateMerchRuleResponse
+	106, // 249: product.v1.ProductService.DeleteMerchRule:output_type -> product.v1.DeleteMerchRuleResponse
+	108, // 250: product.v1.ProductService.ListMerchRules:output_type -> product.v1.ListMerchRulesResponse
+	112, // 251: product.v1.ProductService.PutCategoryTemplate:output_type -> product.v1.PutCategoryTemplateResponse
+	114, // 252: product.v1.ProductService.DeleteCategoryTemplate:output_type -> product.v1.DeleteCategoryTemplateResponse
+	116, // 253: product.v1.ProductService.GetCategoryTemplate:output_type -> product.v1.GetCategoryTemplateResponse
+	119, // 254: product.v1.ProductService.PutTenantSettings:output_type -> product.v1.PutTenantSettingsResponse
+	121, // 255: product.v1.ProductService.DeleteTenantSettings:output_type -> product.v1.DeleteTenantSettingsResponse
+	123, // 256: product.v1.ProductService.GetTenantSettings:output_type -> product.v1.GetTenantSettingsResponse
+	128, // 257: product.v1.ProductService.CreateTenant:output_type -> product.v1.CreateTenantResponse
+	131, // 258: product.v1.ProductService.DeleteTenant:output_type -> product.v1.DeleteTenantResponse
+	135, // 259: product.v1.ProductService.SuggestProducts:output_type -> product.v1.SuggestProductsResponse
+	137, // 260: product.v1.ProductService.RecordProductView:output_type -> product.v1.RecordProductViewResponse
+	140, // 261: product.v1.ProductService.ListCategories:output_type -> product.v1.ListCategoriesResponse
+	143, // 262: product.v1.ProductService.GetProductStats:output_type -> product.v1.GetProductStatsResponse
+	145, // 263: product.v1.ProductService.ListNewArrivals:output_type -> product.v1.ListCuratedProductsResponse
+	145, // 264: product.v1.ProductService.ListTrendingProducts:output_type -> product.v1.ListCuratedProductsResponse
+	147, // 265: product.v1.ProductService.GetRecommendations:output_type -> product.v1.GetRecommendationsResponse
+	149, // 266: product.v1.ProductService.GetProductJsonLd:output_type -> product.v1.GetProductJsonLdResponse
+	152, // 267: product.v1.ProductService.IssueApiKey:output_type -> product.v1.IssueApiKeyResponse
+	154, // 268: product.v1.ProductService.RevokeApiKey:output_type -> product.v1.RevokeApiKeyResponse
+	156, // 269: product.v1.ProductService.ListApiKeys:output_type -> product.v1.ListApiKeysResponse
+	159, // 270: product.v1.ProductService.GetUsage:output_type -> product.v1.GetUsageResponse
+	162, // 271: product.v1.ProductService.GetFaultInjection:output_type -> product.v1.GetFaultInjectionResponse
+	164, // 272: product.v1.ProductService.SetFaultInjection:output_type -> product.v1.SetFaultInjectionResponse
+	167, // 273: product.v1.ProductService.ListProductVersions:output_type -> product.v1.ListProductVersionsResponse
+	169, // 274: product.v1.ProductService.RollbackToVersion:output_type -> product.v1.RollbackToVersionResponse
+	172, // 275: product.v1.ProductService.SaveDraft:output_type -> product.v1.SaveDraftResponse
+	174, // 276: product.v1.ProductService.PublishDraft:output_type -> product.v1.PublishDraftResponse
+	176, // 277: product.v1.ProductService.DiscardDraft:output_type -> product.v1.DiscardDraftResponse
+	178, // 278: product.v1.ProductService.GeneratePreviewToken:output_type -> product.v1.GeneratePreviewTokenResponse
+	212, // [212:279] is the sub-list for method output_type
+	145, // [145:212] is the sub-list for method input_type
+	145, // [145:145] is the sub-list for extension type_name
+	145, // [145:145] is the sub-list for extension extendee
+	0,   // [0:145] is the sub-list for field type_name
 }
 
 func init() { file_proto_product_v1_product_service_proto_init() }
@@ -1351,20 +11994,24 @@ func file_proto_product_v1_product_service_proto_init() {
 	if File_proto_product_v1_product_service_proto != nil {
 		return
 	}
-	file_proto_product_v1_product_service_proto_msgTypes[5].OneofWrappers = []any{}
+	file_proto_product_v1_product_service_proto_msgTypes[1].OneofWrappers = []any{}
 	file_proto_product_v1_product_service_proto_msgTypes[9].OneofWrappers = []any{}
+	file_proto_product_v1_product_service_proto_msgTypes[13].OneofWrappers = []any{}
+	file_proto_product_v1_product_service_proto_msgTypes[43].OneofWrappers = []any{}
+	file_proto_product_v1_product_service_proto_msgTypes[159].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_product_v1_product_service_proto_rawDesc), len(file_proto_product_v1_product_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   21,
+			NumEnums:      11,
+			NumMessages:   174,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_product_v1_product_service_proto_goTypes,
 		DependencyIndexes: file_proto_product_v1_product_service_proto_depIdxs,
+		EnumInfos:         file_proto_product_v1_product_service_proto_enumTypes,
 		MessageInfos:      file_proto_product_v1_product_service_proto_msgTypes,
 	}.Build()
 	File_proto_product_v1_product_service_proto = out.File
@@ -19,15 +19,73 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ProductService_CreateProduct_FullMethodName     = "/product.v1.ProductService/CreateProduct"
-	ProductService_UpdateProduct_FullMethodName     = "/product.v1.ProductService/UpdateProduct"
-	ProductService_GetProduct_FullMethodName        = "/product.v1.ProductService/GetProduct"
-	ProductService_ListProducts_FullMethodName      = "/product.v1.ProductService/ListProducts"
-	ProductService_ApplyDiscount_FullMethodName     = "/product.v1.ProductService/ApplyDiscount"
-	ProductService_RemoveDiscount_FullMethodName    = "/product.v1.ProductService/RemoveDiscount"
-	ProductService_ActivateProduct_FullMethodName   = "/product.v1.ProductService/ActivateProduct"
-	ProductService_DeactivateProduct_FullMethodName = "/product.v1.ProductService/DeactivateProduct"
-	ProductService_ArchiveProduct_FullMethodName    = "/product.v1.ProductService/ArchiveProduct"
+	ProductService_CreateProduct_FullMethodName           = "/product.v1.ProductService/CreateProduct"
+	ProductService_UpdateProduct_FullMethodName           = "/product.v1.ProductService/UpdateProduct"
+	ProductService_GetProduct_FullMethodName              = "/product.v1.ProductService/GetProduct"
+	ProductService_ListProducts_FullMethodName            = "/product.v1.ProductService/ListProducts"
+	ProductService_ApplyDiscount_FullMethodName           = "/product.v1.ProductService/ApplyDiscount"
+	ProductService_RemoveDiscount_FullMethodName          = "/product.v1.ProductService/RemoveDiscount"
+	ProductService_ActivateProduct_FullMethodName         = "/product.v1.ProductService/ActivateProduct"
+	ProductService_DeactivateProduct_FullMethodName       = "/product.v1.ProductService/DeactivateProduct"
+	ProductService_ArchiveProduct_FullMethodName          = "/product.v1.ProductService/ArchiveProduct"
+	ProductService_FindSimilarProducts_FullMethodName     = "/product.v1.ProductService/FindSimilarProducts"
+	ProductService_CompareProducts_FullMethodName         = "/product.v1.ProductService/CompareProducts"
+	ProductService_SetLegalHold_FullMethodName            = "/product.v1.ProductService/SetLegalHold"
+	ProductService_PurgeArchivedProducts_FullMethodName   = "/product.v1.ProductService/PurgeArchivedProducts"
+	ProductService_ExportProductData_FullMethodName       = "/product.v1.ProductService/ExportProductData"
+	ProductService_BatchImportProducts_FullMethodName     = "/product.v1.ProductService/BatchImportProducts"
+	ProductService_ValidateProduct_FullMethodName         = "/product.v1.ProductService/ValidateProduct"
+	ProductService_ReviewProduct_FullMethodName           = "/product.v1.ProductService/ReviewProduct"
+	ProductService_GetProductHistory_FullMethodName       = "/product.v1.ProductService/GetProductHistory"
+	ProductService_RebuildProjection_FullMethodName       = "/product.v1.ProductService/RebuildProjection"
+	ProductService_ReassignCategory_FullMethodName        = "/product.v1.ProductService/ReassignCategory"
+	ProductService_SetChannels_FullMethodName             = "/product.v1.ProductService/SetChannels"
+	ProductService_SetMetadata_FullMethodName             = "/product.v1.ProductService/SetMetadata"
+	ProductService_SetAttributes_FullMethodName           = "/product.v1.ProductService/SetAttributes"
+	ProductService_LinkExternalRef_FullMethodName         = "/product.v1.ProductService/LinkExternalRef"
+	ProductService_UnlinkExternalRef_FullMethodName       = "/product.v1.ProductService/UnlinkExternalRef"
+	ProductService_GetProductByExternalRef_FullMethodName = "/product.v1.ProductService/GetProductByExternalRef"
+	ProductService_BatchActivateProducts_FullMethodName   = "/product.v1.ProductService/BatchActivateProducts"
+	ProductService_BatchDeactivateProducts_FullMethodName = "/product.v1.ProductService/BatchDeactivateProducts"
+	ProductService_BatchArchiveProducts_FullMethodName    = "/product.v1.ProductService/BatchArchiveProducts"
+	ProductService_MergeProducts_FullMethodName           = "/product.v1.ProductService/MergeProducts"
+	ProductService_CloneProduct_FullMethodName            = "/product.v1.ProductService/CloneProduct"
+	ProductService_ChangeBasePrice_FullMethodName         = "/product.v1.ProductService/ChangeBasePrice"
+	ProductService_ApproveChange_FullMethodName           = "/product.v1.ProductService/ApproveChange"
+	ProductService_RejectChange_FullMethodName            = "/product.v1.ProductService/RejectChange"
+	ProductService_SetPriceFloor_FullMethodName           = "/product.v1.ProductService/SetPriceFloor"
+	ProductService_SearchProducts_FullMethodName          = "/product.v1.ProductService/SearchProducts"
+	ProductService_CreateMerchRule_FullMethodName         = "/product.v1.ProductService/CreateMerchRule"
+	ProductService_DeleteMerchRule_FullMethodName         = "/product.v1.ProductService/DeleteMerchRule"
+	ProductService_ListMerchRules_FullMethodName          = "/product.v1.ProductService/ListMerchRules"
+	ProductService_PutCategoryTemplate_FullMethodName     = "/product.v1.ProductService/PutCategoryTemplate"
+	ProductService_DeleteCategoryTemplate_FullMethodName  = "/product.v1.ProductService/DeleteCategoryTemplate"
+	ProductService_GetCategoryTemplate_FullMethodName     = "/product.v1.ProductService/GetCategoryTemplate"
+	ProductService_PutTenantSettings_FullMethodName       = "/product.v1.ProductService/PutTenantSettings"
+	ProductService_DeleteTenantSettings_FullMethodName    = "/product.v1.ProductService/DeleteTenantSettings"
+	ProductService_GetTenantSettings_FullMethodName       = "/product.v1.ProductService/GetTenantSettings"
+	ProductService_CreateTenant_FullMethodName            = "/product.v1.ProductService/CreateTenant"
+	ProductService_DeleteTenant_FullMethodName            = "/product.v1.ProductService/DeleteTenant"
+	ProductService_SuggestProducts_FullMethodName         = "/product.v1.ProductService/SuggestProducts"
+	ProductService_RecordProductView_FullMethodName       = "/product.v1.ProductService/RecordProductView"
+	ProductService_ListCategories_FullMethodName          = "/product.v1.ProductService/ListCategories"
+	ProductService_GetProductStats_FullMethodName         = "/product.v1.ProductService/GetProductStats"
+	ProductService_ListNewArrivals_FullMethodName         = "/product.v1.ProductService/ListNewArrivals"
+	ProductService_ListTrendingProducts_FullMethodName    = "/product.v1.ProductService/ListTrendingProducts"
+	ProductService_GetRecommendations_FullMethodName      = "/product.v1.ProductService/GetRecommendations"
+	ProductService_GetProductJsonLd_FullMethodName        = "/product.v1.ProductService/GetProductJsonLd"
+	ProductService_IssueApiKey_FullMethodName             = "/product.v1.ProductService/IssueApiKey"
+	ProductService_RevokeApiKey_FullMethodName            = "/product.v1.ProductService/RevokeApiKey"
+	ProductService_ListApiKeys_FullMethodName             = "/product.v1.ProductService/ListApiKeys"
+	ProductService_GetUsage_FullMethodName                = "/product.v1.ProductService/GetUsage"
+	ProductService_GetFaultInjection_FullMethodName       = "/product.v1.ProductService/GetFaultInjection"
+	ProductService_SetFaultInjection_FullMethodName       = "/product.v1.ProductService/SetFaultInjection"
+	ProductService_ListProductVersions_FullMethodName     = "/product.v1.ProductService/ListProductVersions"
+	ProductService_RollbackToVersion_FullMethodName       = "/product.v1.ProductService/RollbackToVersion"
+	ProductService_SaveDraft_FullMethodName               = "/product.v1.ProductService/SaveDraft"
+	ProductService_PublishDraft_FullMethodName            = "/product.v1.ProductService/PublishDraft"
+	ProductService_DiscardDraft_FullMethodName            = "/product.v1.ProductService/DiscardDraft"
+	ProductService_GeneratePreviewToken_FullMethodName    = "/product.v1.ProductService/GeneratePreviewToken"
 )
 
 // ProductServiceClient is the client API for ProductService service.
@@ -54,6 +112,128 @@ type ProductServiceClient interface {
 	DeactivateProduct(ctx context.Context, in *DeactivateProductRequest, opts ...grpc.CallOption) (*DeactivateProductResponse, error)
 	// ArchiveProduct archives a product
 	ArchiveProduct(ctx context.Context, in *ArchiveProductRequest, opts ...grpc.CallOption) (*ArchiveProductResponse, error)
+	// FindSimilarProducts finds existing products with the same name+category, SKU, or GTIN
+	FindSimilarProducts(ctx context.Context, in *FindSimilarProductsRequest, opts ...grpc.CallOption) (*FindSimilarProductsResponse, error)
+	// CompareProducts returns an aligned attribute/price matrix for 2-5 products
+	CompareProducts(ctx context.Context, in *CompareProductsRequest, opts ...grpc.CallOption) (*CompareProductsResponse, error)
+	// SetLegalHold places or releases a legal hold that exempts a product from retention purges
+	SetLegalHold(ctx context.Context, in *SetLegalHoldRequest, opts ...grpc.CallOption) (*SetLegalHoldResponse, error)
+	// PurgeArchivedProducts hard-deletes products archived longer than the retention period (admin)
+	PurgeArchivedProducts(ctx context.Context, in *PurgeArchivedProductsRequest, opts ...grpc.CallOption) (*PurgeArchivedProductsResponse, error)
+	// ExportProductData returns everything stored about a product as one JSON document (admin)
+	ExportProductData(ctx context.Context, in *ExportProductDataRequest, opts ...grpc.CallOption) (*ExportProductDataResponse, error)
+	// BatchImportProducts creates up to 1000 products in the background and returns a
+	// google.longrunning operation name; poll it with google.longrunning.Operations
+	// (metadata: OperationMetadata, response: BatchImportProductsResult)
+	BatchImportProducts(ctx context.Context, in *BatchImportProductsRequest, opts ...grpc.CallOption) (*BatchImportProductsResponse, error)
+	// ValidateProduct checks a new product, or changes to an existing one, against the built-in
+	// and category validation rules and returns every violation without saving anything
+	ValidateProduct(ctx context.Context, in *ValidateProductRequest, opts ...grpc.CallOption) (*ValidateProductResponse, error)
+	// ReviewProduct records a reviewer's approval or rejection of a product
+	ReviewProduct(ctx context.Context, in *ReviewProductRequest, opts ...grpc.CallOption) (*ReviewProductResponse, error)
+	// GetProductHistory returns the events recorded for a product, oldest first,
+	// including reviewer decisions and comments
+	GetProductHistory(ctx context.Context, in *GetProductHistoryRequest, opts ...grpc.CallOption) (*GetProductHistoryResponse, error)
+	// RebuildProjection recomputes derived product columns across all tenants in the background
+	// and returns a google.longrunning operation name (admin)
+	// (metadata: OperationMetadata, response: RebuildProjectionResult)
+	RebuildProjection(ctx context.Context, in *RebuildProjectionRequest, opts ...grpc.CallOption) (*RebuildProjectionResponse, error)
+	// ReassignCategory moves every unarchived product of a category to another in the background,
+	// for taxonomy restructures, and returns a google.longrunning operation name (admin)
+	// (metadata: OperationMetadata, response: ReassignCategoryResult)
+	ReassignCategory(ctx context.Context, in *ReassignCategoryRequest, opts ...grpc.CallOption) (*ReassignCategoryResponse, error)
+	// SetChannels replaces the sales channels a product is visible on
+	SetChannels(ctx context.Context, in *SetChannelsRequest, opts ...grpc.CallOption) (*SetChannelsResponse, error)
+	// SetMetadata replaces a product's integrator metadata
+	SetMetadata(ctx context.Context, in *SetMetadataRequest, opts ...grpc.CallOption) (*SetMetadataResponse, error)
+	// SetAttributes replaces a product's attributes, checked against its category's template when it has one
+	SetAttributes(ctx context.Context, in *SetAttributesRequest, opts ...grpc.CallOption) (*SetAttributesResponse, error)
+	// LinkExternalRef and UnlinkExternalRef maintain the identifiers external systems know a product by;
+	// GetProductByExternalRef resolves one with a single keyed read
+	LinkExternalRef(ctx context.Context, in *LinkExternalRefRequest, opts ...grpc.CallOption) (*LinkExternalRefResponse, error)
+	UnlinkExternalRef(ctx context.Context, in *UnlinkExternalRefRequest, opts ...grpc.CallOption) (*UnlinkExternalRefResponse, error)
+	GetProductByExternalRef(ctx context.Context, in *GetProductByExternalRefRequest, opts ...grpc.CallOption) (*GetProductResponse, error)
+	// BatchActivateProducts, BatchDeactivateProducts and BatchArchiveProducts apply a status
+	// transition to up to 1000 products, committed in chunks of 100, and report an outcome
+	// per product instead of failing the whole batch
+	BatchActivateProducts(ctx context.Context, in *BatchActivateProductsRequest, opts ...grpc.CallOption) (*BatchActivateProductsResponse, error)
+	BatchDeactivateProducts(ctx context.Context, in *BatchDeactivateProductsRequest, opts ...grpc.CallOption) (*BatchDeactivateProductsResponse, error)
+	BatchArchiveProducts(ctx context.Context, in *BatchArchiveProductsRequest, opts ...grpc.CallOption) (*BatchArchiveProductsResponse, error)
+	// MergeProducts merges a duplicate into a canonical product: the duplicate is archived
+	// and GetProduct on its ID returns the canonical product from then on (admin)
+	MergeProducts(ctx context.Context, in *MergeProductsRequest, opts ...grpc.CallOption) (*MergeProductsResponse, error)
+	// CloneProduct copies a product's content, media references and attributes, and optionally its prices,
+	// into a new inactive product; only the operator tenant may clone into another tenant (admin)
+	CloneProduct(ctx context.Context, in *CloneProductRequest, opts ...grpc.CallOption) (*CloneProductResponse, error)
+	// ChangeBasePrice sets a product's base price; changes above the configured threshold are held
+	// as a pending change until a second person approves it with ApproveChange
+	ChangeBasePrice(ctx context.Context, in *ChangeBasePriceRequest, opts ...grpc.CallOption) (*ChangeBasePriceResponse, error)
+	ApproveChange(ctx context.Context, in *ApproveChangeRequest, opts ...grpc.CallOption) (*DecideChangeResponse, error)
+	RejectChange(ctx context.Context, in *RejectChangeRequest, opts ...grpc.CallOption) (*DecideChangeResponse, error)
+	// SetPriceFloor replaces a product's price floor; ChangeBasePrice and ApplyDiscount refuse
+	// operations that would take the effective price below it
+	SetPriceFloor(ctx context.Context, in *SetPriceFloorRequest, opts ...grpc.CallOption) (*SetPriceFloorResponse, error)
+	// SearchProducts finds active products by name and description, ranked by relevance
+	// and curated by the tenant's merchandising rules
+	SearchProducts(ctx context.Context, in *SearchProductsRequest, opts ...grpc.CallOption) (*SearchProductsResponse, error)
+	// CreateMerchRule pins a product for a query or boosts a category in search results (admin)
+	CreateMerchRule(ctx context.Context, in *CreateMerchRuleRequest, opts ...grpc.CallOption) (*CreateMerchRuleResponse, error)
+	DeleteMerchRule(ctx context.Context, in *DeleteMerchRuleRequest, opts ...grpc.CallOption) (*DeleteMerchRuleResponse, error)
+	ListMerchRules(ctx context.Context, in *ListMerchRulesRequest, opts ...grpc.CallOption) (*ListMerchRulesResponse, error)
+	// PutCategoryTemplate and DeleteCategoryTemplate manage the attributes a category's products take (admin);
+	// GetCategoryTemplate returns them, e.g. to build a product form
+	PutCategoryTemplate(ctx context.Context, in *PutCategoryTemplateRequest, opts ...grpc.CallOption) (*PutCategoryTemplateResponse, error)
+	DeleteCategoryTemplate(ctx context.Context, in *DeleteCategoryTemplateRequest, opts ...grpc.CallOption) (*DeleteCategoryTemplateResponse, error)
+	GetCategoryTemplate(ctx context.Context, in *GetCategoryTemplateRequest, opts ...grpc.CallOption) (*GetCategoryTemplateResponse, error)
+	// PutTenantSettings, DeleteTenantSettings and GetTenantSettings manage the caller's tenant overrides
+	// of the default currency, maximum name length and allowed discount range (admin)
+	PutTenantSettings(ctx context.Context, in *PutTenantSettingsRequest, opts ...grpc.CallOption) (*PutTenantSettingsResponse, error)
+	DeleteTenantSettings(ctx context.Context, in *DeleteTenantSettingsRequest, opts ...grpc.CallOption) (*DeleteTenantSettingsResponse, error)
+	GetTenantSettings(ctx context.Context, in *GetTenantSettingsRequest, opts ...grpc.CallOption) (*GetTenantSettingsResponse, error)
+	// CreateTenant registers a tenant and issues its API keys, then seeds its settings and category templates
+	// in the background; DeleteTenant revokes a tenant's keys and purges its data in the background, optionally
+	// exporting its products first. Only the configured operator tenant may call them
+	// (metadata: OperationMetadata, response: CreateTenantResult or DeleteTenantResult)
+	CreateTenant(ctx context.Context, in *CreateTenantRequest, opts ...grpc.CallOption) (*CreateTenantResponse, error)
+	DeleteTenant(ctx context.Context, in *DeleteTenantRequest, opts ...grpc.CallOption) (*DeleteTenantResponse, error)
+	// SuggestProducts completes a search prefix with the most popular product names and categories
+	SuggestProducts(ctx context.Context, in *SuggestProductsRequest, opts ...grpc.CallOption) (*SuggestProductsResponse, error)
+	// RecordProductView counts a storefront view of a product; views are written in batches
+	RecordProductView(ctx context.Context, in *RecordProductViewRequest, opts ...grpc.CallOption) (*RecordProductViewResponse, error)
+	// ListCategories returns the tenant's categories as a tree with active product counts, for navigation menus
+	ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
+	// GetProductStats returns the view counters behind popularity sorting
+	GetProductStats(ctx context.Context, in *GetProductStatsRequest, opts ...grpc.CallOption) (*GetProductStatsResponse, error)
+	// ListNewArrivals and ListTrendingProducts serve the curated storefront lists computed by a background job
+	ListNewArrivals(ctx context.Context, in *ListCuratedProductsRequest, opts ...grpc.CallOption) (*ListCuratedProductsResponse, error)
+	ListTrendingProducts(ctx context.Context, in *ListCuratedProductsRequest, opts ...grpc.CallOption) (*ListCuratedProductsResponse, error)
+	// GetRecommendations returns products to show alongside a seed product
+	GetRecommendations(ctx context.Context, in *GetRecommendationsRequest, opts ...grpc.CallOption) (*GetRecommendationsResponse, error)
+	// GetProductJsonLd returns a schema.org Product snippet with the effective price, for SEO markup
+	GetProductJsonLd(ctx context.Context, in *GetProductJsonLdRequest, opts ...grpc.CallOption) (*GetProductJsonLdResponse, error)
+	// IssueApiKey, RevokeApiKey and ListApiKeys manage the tenant's API keys for machine clients (admin);
+	// clients send the key as x-api-key metadata
+	IssueApiKey(ctx context.Context, in *IssueApiKeyRequest, opts ...grpc.CallOption) (*IssueApiKeyResponse, error)
+	RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*RevokeApiKeyResponse, error)
+	ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error)
+	// GetUsage returns the tenant's daily usage per API key, for billing and spotting heavy integrators (admin)
+	GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error)
+	// GetFaultInjection and SetFaultInjection read and retune the server's fault injection for resilience
+	// testing (admin); FAILED_PRECONDITION unless the server was started with fault injection enabled
+	GetFaultInjection(ctx context.Context, in *GetFaultInjectionRequest, opts ...grpc.CallOption) (*GetFaultInjectionResponse, error)
+	SetFaultInjection(ctx context.Context, in *SetFaultInjectionRequest, opts ...grpc.CallOption) (*SetFaultInjectionResponse, error)
+	// ListProductVersions returns the immutable versions of a product's name, description, category and
+	// metadata, newest first; RollbackToVersion restores one of them, recorded as a new version
+	ListProductVersions(ctx context.Context, in *ListProductVersionsRequest, opts ...grpc.CallOption) (*ListProductVersionsResponse, error)
+	RollbackToVersion(ctx context.Context, in *RollbackToVersionRequest, opts ...grpc.CallOption) (*RollbackToVersionResponse, error)
+	// SaveDraft stages content edits in a product's draft, which reads ignore until PublishDraft applies it;
+	// GetProduct shows it with preview_draft, and DiscardDraft throws it away
+	SaveDraft(ctx context.Context, in *SaveDraftRequest, opts ...grpc.CallOption) (*SaveDraftResponse, error)
+	PublishDraft(ctx context.Context, in *PublishDraftRequest, opts ...grpc.CallOption) (*PublishDraftResponse, error)
+	DiscardDraft(ctx context.Context, in *DiscardDraftRequest, opts ...grpc.CallOption) (*DiscardDraftResponse, error)
+	// GeneratePreviewToken signs an expiring token that lets read keys, such as a storefront preview
+	// environment's, see drafts through GetProduct
+	GeneratePreviewToken(ctx context.Context, in *GeneratePreviewTokenRequest, opts ...grpc.CallOption) (*GeneratePreviewTokenResponse, error)
 }
 
 type productServiceClient struct {
@@ -154,6 +334,586 @@ func (c *productServiceClient) ArchiveProduct(ctx context.Context, in *ArchivePr
 	return out, nil
 }
 
+func (c *productServiceClient) FindSimilarProducts(ctx context.Context, in *FindSimilarProductsRequest, opts ...grpc.CallOption) (*FindSimilarProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindSimilarProductsResponse)
+	err := c.cc.Invoke(ctx, ProductService_FindSimilarProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CompareProducts(ctx context.Context, in *CompareProductsRequest, opts ...grpc.CallOption) (*CompareProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompareProductsResponse)
+	err := c.cc.Invoke(ctx, ProductService_CompareProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetLegalHold(ctx context.Context, in *SetLegalHoldRequest, opts ...grpc.CallOption) (*SetLegalHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetLegalHoldResponse)
+	err := c.cc.Invoke(ctx, ProductService_SetLegalHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) PurgeArchivedProducts(ctx context.Context, in *PurgeArchivedProductsRequest, opts ...grpc.CallOption) (*PurgeArchivedProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeArchivedProductsResponse)
+	err := c.cc.Invoke(ctx, ProductService_PurgeArchivedProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ExportProductData(ctx context.Context, in *ExportProductDataRequest, opts ...grpc.CallOption) (*ExportProductDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportProductDataResponse)
+	err := c.cc.Invoke(ctx, ProductService_ExportProductData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) BatchImportProducts(ctx context.Context, in *BatchImportProductsRequest, opts ...grpc.CallOption) (*BatchImportProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchImportProductsResponse)
+	err := c.cc.Invoke(ctx, ProductService_BatchImportProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ValidateProduct(ctx context.Context, in *ValidateProductRequest, opts ...grpc.CallOption) (*ValidateProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateProductResponse)
+	err := c.cc.Invoke(ctx, ProductService_ValidateProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ReviewProduct(ctx context.Context, in *ReviewProductRequest, opts ...grpc.CallOption) (*ReviewProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewProductResponse)
+	err := c.cc.Invoke(ctx, ProductService_ReviewProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetProductHistory(ctx context.Context, in *GetProductHistoryRequest, opts ...grpc.CallOption) (*GetProductHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProductHistoryResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetProductHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RebuildProjection(ctx context.Context, in *RebuildProjectionRequest, opts ...grpc.CallOption) (*RebuildProjectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RebuildProjectionResponse)
+	err := c.cc.Invoke(ctx, ProductService_RebuildProjection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ReassignCategory(ctx context.Context, in *ReassignCategoryRequest, opts ...grpc.CallOption) (*ReassignCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReassignCategoryResponse)
+	err := c.cc.Invoke(ctx, ProductService_ReassignCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetChannels(ctx context.Context, in *SetChannelsRequest, opts ...grpc.CallOption) (*SetChannelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetChannelsResponse)
+	err := c.cc.Invoke(ctx, ProductService_SetChannels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetMetadata(ctx context.Context, in *SetMetadataRequest, opts ...grpc.CallOption) (*SetMetadataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMetadataResponse)
+	err := c.cc.Invoke(ctx, ProductService_SetMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetAttributes(ctx context.Context, in *SetAttributesRequest, opts ...grpc.CallOption) (*SetAttributesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAttributesResponse)
+	err := c.cc.Invoke(ctx, ProductService_SetAttributes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) LinkExternalRef(ctx context.Context, in *LinkExternalRefRequest, opts ...grpc.CallOption) (*LinkExternalRefResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinkExternalRefResponse)
+	err := c.cc.Invoke(ctx, ProductService_LinkExternalRef_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UnlinkExternalRef(ctx context.Context, in *UnlinkExternalRefRequest, opts ...grpc.CallOption) (*UnlinkExternalRefResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlinkExternalRefResponse)
+	err := c.cc.Invoke(ctx, ProductService_UnlinkExternalRef_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetProductByExternalRef(ctx context.Context, in *GetProductByExternalRefRequest, opts ...grpc.CallOption) (*GetProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProductResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetProductByExternalRef_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) BatchActivateProducts(ctx context.Context, in *BatchActivateProductsRequest, opts ...grpc.CallOption) (*BatchActivateProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchActivateProductsResponse)
+	err := c.cc.Invoke(ctx, ProductService_BatchActivateProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) BatchDeactivateProducts(ctx context.Context, in *BatchDeactivateProductsRequest, opts ...grpc.CallOption) (*BatchDeactivateProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchDeactivateProductsResponse)
+	err := c.cc.Invoke(ctx, ProductService_BatchDeactivateProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) BatchArchiveProducts(ctx context.Context, in *BatchArchiveProductsRequest, opts ...grpc.CallOption) (*BatchArchiveProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchArchiveProductsResponse)
+	err := c.cc.Invoke(ctx, ProductService_BatchArchiveProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) MergeProducts(ctx context.Context, in *MergeProductsRequest, opts ...grpc.CallOption) (*MergeProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeProductsResponse)
+	err := c.cc.Invoke(ctx, ProductService_MergeProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CloneProduct(ctx context.Context, in *CloneProductRequest, opts ...grpc.CallOption) (*CloneProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloneProductResponse)
+	err := c.cc.Invoke(ctx, ProductService_CloneProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ChangeBasePrice(ctx context.Context, in *ChangeBasePriceRequest, opts ...grpc.CallOption) (*ChangeBasePriceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeBasePriceResponse)
+	err := c.cc.Invoke(ctx, ProductService_ChangeBasePrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ApproveChange(ctx context.Context, in *ApproveChangeRequest, opts ...grpc.CallOption) (*DecideChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DecideChangeResponse)
+	err := c.cc.Invoke(ctx, ProductService_ApproveChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RejectChange(ctx context.Context, in *RejectChangeRequest, opts ...grpc.CallOption) (*DecideChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DecideChangeResponse)
+	err := c.cc.Invoke(ctx, ProductService_RejectChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetPriceFloor(ctx context.Context, in *SetPriceFloorRequest, opts ...grpc.CallOption) (*SetPriceFloorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPriceFloorResponse)
+	err := c.cc.Invoke(ctx, ProductService_SetPriceFloor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SearchProducts(ctx context.Context, in *SearchProductsRequest, opts ...grpc.CallOption) (*SearchProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchProductsResponse)
+	err := c.cc.Invoke(ctx, ProductService_SearchProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreateMerchRule(ctx context.Context, in *CreateMerchRuleRequest, opts ...grpc.CallOption) (*CreateMerchRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateMerchRuleResponse)
+	err := c.cc.Invoke(ctx, ProductService_CreateMerchRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteMerchRule(ctx context.Context, in *DeleteMerchRuleRequest, opts ...grpc.CallOption) (*DeleteMerchRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMerchRuleResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteMerchRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListMerchRules(ctx context.Context, in *ListMerchRulesRequest, opts ...grpc.CallOption) (*ListMerchRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMerchRulesResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListMerchRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) PutCategoryTemplate(ctx context.Context, in *PutCategoryTemplateRequest, opts ...grpc.CallOption) (*PutCategoryTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PutCategoryTemplateResponse)
+	err := c.cc.Invoke(ctx, ProductService_PutCategoryTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteCategoryTemplate(ctx context.Context, in *DeleteCategoryTemplateRequest, opts ...grpc.CallOption) (*DeleteCategoryTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCategoryTemplateResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteCategoryTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetCategoryTemplate(ctx context.Context, in *GetCategoryTemplateRequest, opts ...grpc.CallOption) (*GetCategoryTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCategoryTemplateResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetCategoryTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) PutTenantSettings(ctx context.Context, in *PutTenantSettingsRequest, opts ...grpc.CallOption) (*PutTenantSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PutTenantSettingsResponse)
+	err := c.cc.Invoke(ctx, ProductService_PutTenantSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteTenantSettings(ctx context.Context, in *DeleteTenantSettingsRequest, opts ...grpc.CallOption) (*DeleteTenantSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTenantSettingsResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteTenantSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetTenantSettings(ctx context.Context, in *GetTenantSettingsRequest, opts ...grpc.CallOption) (*GetTenantSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTenantSettingsResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetTenantSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreateTenant(ctx context.Context, in *CreateTenantRequest, opts ...grpc.CallOption) (*CreateTenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTenantResponse)
+	err := c.cc.Invoke(ctx, ProductService_CreateTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteTenant(ctx context.Context, in *DeleteTenantRequest, opts ...grpc.CallOption) (*DeleteTenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTenantResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SuggestProducts(ctx context.Context, in *SuggestProductsRequest, opts ...grpc.CallOption) (*SuggestProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestProductsResponse)
+	err := c.cc.Invoke(ctx, ProductService_SuggestProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RecordProductView(ctx context.Context, in *RecordProductViewRequest, opts ...grpc.CallOption) (*RecordProductViewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordProductViewResponse)
+	err := c.cc.Invoke(ctx, ProductService_RecordProductView_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCategoriesResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetProductStats(ctx context.Context, in *GetProductStatsRequest, opts ...grpc.CallOption) (*GetProductStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProductStatsResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetProductStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListNewArrivals(ctx context.Context, in *ListCuratedProductsRequest, opts ...grpc.CallOption) (*ListCuratedProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCuratedProductsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListNewArrivals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListTrendingProducts(ctx context.Context, in *ListCuratedProductsRequest, opts ...grpc.CallOption) (*ListCuratedProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCuratedProductsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListTrendingProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetRecommendations(ctx context.Context, in *GetRecommendationsRequest, opts ...grpc.CallOption) (*GetRecommendationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRecommendationsResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetRecommendations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetProductJsonLd(ctx context.Context, in *GetProductJsonLdRequest, opts ...grpc.CallOption) (*GetProductJsonLdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProductJsonLdResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetProductJsonLd_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) IssueApiKey(ctx context.Context, in *IssueApiKeyRequest, opts ...grpc.CallOption) (*IssueApiKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssueApiKeyResponse)
+	err := c.cc.Invoke(ctx, ProductService_IssueApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*RevokeApiKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeApiKeyResponse)
+	err := c.cc.Invoke(ctx, ProductService_RevokeApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListApiKeysResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListApiKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsageResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetFaultInjection(ctx context.Context, in *GetFaultInjectionRequest, opts ...grpc.CallOption) (*GetFaultInjectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFaultInjectionResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetFaultInjection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetFaultInjection(ctx context.Context, in *SetFaultInjectionRequest, opts ...grpc.CallOption) (*SetFaultInjectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetFaultInjectionResponse)
+	err := c.cc.Invoke(ctx, ProductService_SetFaultInjection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListProductVersions(ctx context.Context, in *ListProductVersionsRequest, opts ...grpc.CallOption) (*ListProductVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProductVersionsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListProductVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RollbackToVersion(ctx context.Context, in *RollbackToVersionRequest, opts ...grpc.CallOption) (*RollbackToVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RollbackToVersionResponse)
+	err := c.cc.Invoke(ctx, ProductService_RollbackToVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SaveDraft(ctx context.Context, in *SaveDraftRequest, opts ...grpc.CallOption) (*SaveDraftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveDraftResponse)
+	err := c.cc.Invoke(ctx, ProductService_SaveDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) PublishDraft(ctx context.Context, in *PublishDraftRequest, opts ...grpc.CallOption) (*PublishDraftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishDraftResponse)
+	err := c.cc.Invoke(ctx, ProductService_PublishDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DiscardDraft(ctx context.Context, in *DiscardDraftRequest, opts ...grpc.CallOption) (*DiscardDraftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscardDraftResponse)
+	err := c.cc.Invoke(ctx, ProductService_DiscardDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GeneratePreviewToken(ctx context.Context, in *GeneratePreviewTokenRequest, opts ...grpc.CallOption) (*GeneratePreviewTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeneratePreviewTokenResponse)
+	err := c.cc.Invoke(ctx, ProductService_GeneratePreviewToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProductServiceServer is the server API for ProductService service.
 // All implementations must embed UnimplementedProductServiceServer
 // for forward compatibility.
@@ -178,222 +938,1562 @@ type ProductServiceServer interface {
 	DeactivateProduct(context.Context, *DeactivateProductRequest) (*DeactivateProductResponse, error)
 	// ArchiveProduct archives a product
 	ArchiveProduct(context.Context, *ArchiveProductRequest) (*ArchiveProductResponse, error)
+	// FindSimilarProducts finds existing products with the same name+category, SKU, or GTIN
+	FindSimilarProducts(context.Context, *FindSimilarProductsRequest) (*FindSimilarProductsResponse, error)
+	// CompareProducts returns an aligned attribute/price matrix for 2-5 products
+	CompareProducts(context.Context, *CompareProductsRequest) (*CompareProductsResponse, error)
+	// SetLegalHold places or releases a legal hold that exempts a product from retention purges
+	SetLegalHold(context.Context, *SetLegalHoldRequest) (*SetLegalHoldResponse, error)
+	// PurgeArchivedProducts hard-deletes products archived longer than the retention period (admin)
+	PurgeArchivedProducts(context.Context, *PurgeArchivedProductsRequest) (*PurgeArchivedProductsResponse, error)
+	// ExportProductData returns everything stored about a product as one JSON document (admin)
+	ExportProductData(context.Context, *ExportProductDataRequest) (*ExportProductDataResponse, error)
+	// BatchImportProducts creates up to 1000 products in the background and returns a
+	// google.longrunning operation name; poll it with google.longrunning.Operations
+	// (metadata: OperationMetadata, response: BatchImportProductsResult)
+	BatchImportProducts(context.Context, *BatchImportProductsRequest) (*BatchImportProductsResponse, error)
+	// ValidateProduct checks a new product, or changes to an existing one, against the built-in
+	// and category validation rules and returns every violation without saving anything
+	ValidateProduct(context.Context, *ValidateProductRequest) (*ValidateProductResponse, error)
+	// ReviewProduct records a reviewer's approval or rejection of a product
+	ReviewProduct(context.Context, *ReviewProductRequest) (*ReviewProductResponse, error)
+	// GetProductHistory returns the events recorded for a product, oldest first,
+	// including reviewer decisions and comments
+	GetProductHistory(context.Context, *GetProductHistoryRequest) (*GetProductHistoryResponse, error)
+	// RebuildProjection recomputes derived product columns across all tenants in the background
+	// and returns a google.longrunning operation name (admin)
+	// (metadata: OperationMetadata, response: RebuildProjectionResult)
+	RebuildProjection(context.Context, *RebuildProjectionRequest) (*RebuildProjectionResponse, error)
+	// ReassignCategory moves every unarchived product of a category to another in the background,
+	// for taxonomy restructures, and returns a google.longrunning operation name (admin)
+	// (metadata: OperationMetadata, response: ReassignCategoryResult)
+	ReassignCategory(context.Context, *ReassignCategoryRequest) (*ReassignCategoryResponse, error)
+	// SetChannels replaces the sales channels a product is visible on
+	SetChannels(context.Context, *SetChannelsRequest) (*SetChannelsResponse, error)
+	// SetMetadata replaces a product's integrator metadata
+	SetMetadata(context.Context, *SetMetadataRequest) (*SetMetadataResponse, error)
+	// SetAttributes replaces a product's attributes, checked against its category's template when it has one
+	SetAttributes(context.Context, *SetAttributesRequest) (*SetAttributesResponse, error)
+	// LinkExternalRef and UnlinkExternalRef maintain the identifiers external systems know a product by;
+	// GetProductByExternalRef resolves one with a single keyed read
+	LinkExternalRef(context.Context, *LinkExternalRefRequest) (*LinkExternalRefResponse, error)
+	UnlinkExternalRef(context.Context, *UnlinkExternalRefRequest) (*UnlinkExternalRefResponse, error)
+	GetProductByExternalRef(context.Context, *GetProductByExternalRefRequest) (*GetProductResponse, error)
+	// BatchActivateProducts, BatchDeactivateProducts and BatchArchiveProducts apply a status
+	// transition to up to 1000 products, committed in chunks of 100, and report an outcome
+	// per product instead of failing the whole batch
+	BatchActivateProducts(context.Context, *BatchActivateProductsRequest) (*BatchActivateProductsResponse, error)
+	BatchDeactivateProducts(context.Context, *BatchDeactivateProductsRequest) (*BatchDeactivateProductsResponse, error)
+	BatchArchiveProducts(context.Context, *BatchArchiveProductsRequest) (*BatchArchiveProductsResponse, error)
+	// MergeProducts merges a duplicate into a canonical product: the duplicate is archived
+	// and GetProduct on its ID returns the canonical product from then on (admin)
+	MergeProducts(context.Context, *MergeProductsRequest) (*MergeProductsResponse, error)
+	// CloneProduct copies a product's content, media references and attributes, and optionally its prices,
+	// into a new inactive product; only the operator tenant may clone into another tenant (admin)
+	CloneProduct(context.Context, *CloneProductRequest) (*CloneProductResponse, error)
+	// ChangeBasePrice sets a product's base price; changes above the configured threshold are held
+	// as a pending change until a second person approves it with ApproveChange
+	ChangeBasePrice(context.Context, *ChangeBasePriceRequest) (*ChangeBasePriceResponse, error)
+	ApproveChange(context.Context, *ApproveChangeRequest) (*DecideChangeResponse, error)
+	RejectChange(context.Context, *RejectChangeRequest) (*DecideChangeResponse, error)
+	// SetPriceFloor replaces a product's price floor; ChangeBasePrice and ApplyDiscount refuse
+	// operations that would take the effective price below it
+	SetPriceFloor(context.Context, *SetPriceFloorRequest) (*SetPriceFloorResponse, error)
+	// SearchProducts finds active products by name and description, ranked by relevance
+	// and curated by the tenant's merchandising rules
+	SearchProducts(context.Context, *SearchProductsRequest) (*SearchProductsResponse, error)
+	// CreateMerchRule pins a product for a query or boosts a category in search results (admin)
+	CreateMerchRule(context.Context, *CreateMerchRuleRequest) (*CreateMerchRuleResponse, error)
+	DeleteMerchRule(context.Context, *DeleteMerchRuleRequest) (*DeleteMerchRuleResponse, error)
+	ListMerchRules(context.Context, *ListMerchRulesRequest) (*ListMerchRulesResponse, error)
+	// PutCategoryTemplate and DeleteCategoryTemplate manage the attributes a category's products take (admin);
+	// GetCategoryTemplate returns them, e.g. to build a product form
+	PutCategoryTemplate(context.Context, *PutCategoryTemplateRequest) (*PutCategoryTemplateResponse, error)
+	DeleteCategoryTemplate(context.Context, *DeleteCategoryTemplateRequest) (*DeleteCategoryTemplateResponse, error)
+	GetCategoryTemplate(context.Context, *GetCategoryTemplateRequest) (*GetCategoryTemplateResponse, error)
+	// PutTenantSettings, DeleteTenantSettings and GetTenantSettings manage the caller's tenant overrides
+	// of the default currency, maximum name length and allowed discount range (admin)
+	PutTenantSettings(context.Context, *PutTenantSettingsRequest) (*PutTenantSettingsResponse, error)
+	DeleteTenantSettings(context.Context, *DeleteTenantSettingsRequest) (*DeleteTenantSettingsResponse, error)
+	GetTenantSettings(context.Context, *GetTenantSettingsRequest) (*GetTenantSettingsResponse, error)
+	// CreateTenant registers a tenant and issues its API keys, then seeds its settings and category templates
+	// in the background; DeleteTenant revokes a tenant's keys and purges its data in the background, optionally
+	// exporting its products first. Only the configured operator tenant may call them
+	// (metadata: OperationMetadata, response: CreateTenantResult or DeleteTenantResult)
+	CreateTenant(context.Context, *CreateTenantRequest) (*CreateTenantResponse, error)
+	DeleteTenant(context.Context, *DeleteTenantRequest) (*DeleteTenantResponse, error)
+	// SuggestProducts completes a search prefix with the most popular product names and categories
+	SuggestProducts(context.Context, *SuggestProductsRequest) (*SuggestProductsResponse, error)
+	// RecordProductView counts a storefront view of a product; views are written in batches
+	RecordProductView(context.Context, *RecordProductViewRequest) (*RecordProductViewResponse, error)
+	// ListCategories returns the tenant's categories as a tree with active product counts, for navigation menus
+	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
+	// GetProductStats returns the view counters behind popularity sorting
+	GetProductStats(context.Context, *GetProductStatsRequest) (*GetProductStatsResponse, error)
+	// ListNewArrivals and ListTrendingProducts serve the curated storefront lists computed by a background job
+	ListNewArrivals(context.Context, *ListCuratedProductsRequest) (*ListCuratedProductsResponse, error)
+	ListTrendingProducts(context.Context, *ListCuratedProductsRequest) (*ListCuratedProductsResponse, error)
+	// GetRecommendations returns products to show alongside a seed product
+	GetRecommendations(context.Context, *GetRecommendationsRequest) (*GetRecommendationsResponse, error)
+	// GetProductJsonLd returns a schema.org Product snippet with the effective price, for SEO markup
+	GetProductJsonLd(context.Context, *GetProductJsonLdRequest) (*GetProductJsonLdResponse, error)
+	// IssueApiKey, RevokeApiKey and ListApiKeys manage the tenant's API keys for machine clients (admin);
+	// clients send the key as x-api-key metadata
+	IssueApiKey(context.Context, *IssueApiKeyRequest) (*IssueApiKeyResponse, error)
+	RevokeApiKey(context.Context, *RevokeApiKeyRequest) (*RevokeApiKeyResponse, error)
+	ListApiKeys(context.Context, *ListApiKeysRequest) (*ListApiKeysResponse, error)
+	// GetUsage returns the tenant's daily usage per API key, for billing and spotting heavy integrators (admin)
+	GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error)
+	// GetFaultInjection and SetFaultInjection read and retune the server's fault injection for resilience
+	// testing (admin); FAILED_PRECONDITION unless the server was started with fault injection enabled
+	GetFaultInjection(context.Context, *GetFaultInjectionRequest) (*GetFaultInjectionResponse, error)
+	SetFaultInjection(context.Context, *SetFaultInjectionRequest) (*SetFaultInjectionResponse, error)
+	// ListProductVersions returns the immutable versions of a product's name, description, category and
+	// metadata, newest first; RollbackToVersion restores one of them, recorded as a new version
+	ListProductVersions(context.Context, *ListProductVersionsRequest) (*ListProductVersionsResponse, error)
+	RollbackToVersion(context.Context, *RollbackToVersionRequest) (*RollbackToVersionResponse, error)
+	// SaveDraft stages content edits in a product's draft, which reads ignore until PublishDraft applies it;
+	// GetProduct shows it with preview_draft, and DiscardDraft throws it away
+	SaveDraft(context.Context, *SaveDraftRequest) (*SaveDraftResponse, error)
+	PublishDraft(context.Context, *PublishDraftRequest) (*PublishDraftResponse, error)
+	DiscardDraft(context.Context, *DiscardDraftRequest) (*DiscardDraftResponse, error)
+	// GeneratePreviewToken signs an expiring token that lets read keys, such as a storefront preview
+	// environment's, see drafts through GetProduct
+	GeneratePreviewToken(context.Context, *GeneratePreviewTokenRequest) (*GeneratePreviewTokenResponse, error)
+	mustEmbedUnimplementedProductServiceServer()
+}
+
+// UnimplementedProductServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedProductServiceServer struct{}
+
+func (UnimplementedProductServiceServer) CreateProduct(context.Context, *CreateProductRequest) (*CreateProductResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateProduct not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateProduct not implemented")
+}
+func (UnimplementedProductServiceServer) GetProduct(context.Context, *GetProductRequest) (*GetProductResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProduct not implemented")
+}
+func (UnimplementedProductServiceServer) ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListProducts not implemented")
+}
+func (UnimplementedProductServiceServer) ApplyDiscount(context.Context, *ApplyDiscountRequest) (*ApplyDiscountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApplyDiscount not implemented")
+}
+func (UnimplementedProductServiceServer) RemoveDiscount(context.Context, *RemoveDiscountRequest) (*RemoveDiscountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveDiscount not implemented")
+}
+func (UnimplementedProductServiceServer) ActivateProduct(context.Context, *ActivateProductRequest) (*ActivateProductResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ActivateProduct not implemented")
+}
+func (UnimplementedProductServiceServer) DeactivateProduct(context.Context, *DeactivateProductRequest) (*DeactivateProductResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeactivateProduct not implemented")
+}
+func (UnimplementedProductServiceServer) ArchiveProduct(context.Context, *ArchiveProductRequest) (*ArchiveProductResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ArchiveProduct not implemented")
+}
+func (UnimplementedProductServiceServer) FindSimilarProducts(context.Context, *FindSimilarProductsRequest) (*FindSimilarProductsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FindSimilarProducts not implemented")
+}
+func (UnimplementedProductServiceServer) CompareProducts(context.Context, *CompareProductsRequest) (*CompareProductsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompareProducts not implemented")
+}
+func (UnimplementedProductServiceServer) SetLegalHold(context.Context, *SetLegalHoldRequest) (*SetLegalHoldResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetLegalHold not implemented")
+}
+func (UnimplementedProductServiceServer) PurgeArchivedProducts(context.Context, *PurgeArchivedProductsRequest) (*PurgeArchivedProductsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PurgeArchivedProducts not implemented")
+}
+func (UnimplementedProductServiceServer) ExportProductData(context.Context, *ExportProductDataRequest) (*ExportProductDataResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportProductData not implemented")
+}
+func (UnimplementedProductServiceServer) BatchImportProducts(context.Context, *BatchImportProductsRequest) (*BatchImportProductsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchImportProducts not implemented")
+}
+func (UnimplementedProductServiceServer) ValidateProduct(context.Context, *ValidateProductRequest) (*ValidateProductResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ValidateProduct not implemented")
+}
+func (UnimplementedProductServiceServer) ReviewProduct(context.Context, *ReviewProductRequest) (*ReviewProductResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReviewProduct not implemented")
+}
+func (UnimplementedProductServiceServer) GetProductHistory(context.Context, *GetProductHistoryRequest) (*GetProductHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProductHistory not implemented")
+}
+func (UnimplementedProductServiceServer) RebuildProjection(context.Context, *RebuildProjectionRequest) (*RebuildProjectionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RebuildProjection not implemented")
+}
+func (UnimplementedProductServiceServer) ReassignCategory(context.Context, *ReassignCategoryRequest) (*ReassignCategoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReassignCategory not implemented")
+}
+func (UnimplementedProductServiceServer) SetChannels(context.Context, *SetChannelsRequest) (*SetChannelsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetChannels not implemented")
+}
+func (UnimplementedProductServiceServer) SetMetadata(context.Context, *SetMetadataRequest) (*SetMetadataResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetMetadata not implemented")
+}
+func (UnimplementedProductServiceServer) SetAttributes(context.Context, *SetAttributesRequest) (*SetAttributesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetAttributes not implemented")
+}
+func (UnimplementedProductServiceServer) LinkExternalRef(context.Context, *LinkExternalRefRequest) (*LinkExternalRefResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method LinkExternalRef not implemented")
+}
+func (UnimplementedProductServiceServer) UnlinkExternalRef(context.Context, *UnlinkExternalRefRequest) (*UnlinkExternalRefResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnlinkExternalRef not implemented")
+}
+func (UnimplementedProductServiceServer) GetProductByExternalRef(context.Context, *GetProductByExternalRefRequest) (*GetProductResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProductByExternalRef not implemented")
+}
+func (UnimplementedProductServiceServer) BatchActivateProducts(context.Context, *BatchActivateProductsRequest) (*BatchActivateProductsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchActivateProducts not implemented")
+}
+func (UnimplementedProductServiceServer) BatchDeactivateProducts(context.Context, *BatchDeactivateProductsRequest) (*BatchDeactivateProductsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchDeactivateProducts not implemented")
+}
+func (UnimplementedProductServiceServer) BatchArchiveProducts(context.Context, *BatchArchiveProductsRequest) (*BatchArchiveProductsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchArchiveProducts not implemented")
+}
+func (UnimplementedProductServiceServer) MergeProducts(context.Context, *MergeProductsRequest) (*MergeProductsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MergeProducts not implemented")
+}
+func (UnimplementedProductServiceServer) CloneProduct(context.Context, *CloneProductRequest) (*CloneProductResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CloneProduct not implemented")
+}
+func (UnimplementedProductServiceServer) ChangeBasePrice(context.Context, *ChangeBasePriceRequest) (*ChangeBasePriceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ChangeBasePrice not implemented")
+}
+func (UnimplementedProductServiceServer) ApproveChange(context.Context, *ApproveChangeRequest) (*DecideChangeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveChange not implemented")
+}
+func (UnimplementedProductServiceServer) RejectChange(context.Context, *RejectChangeRequest) (*DecideChangeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectChange not implemented")
+}
+func (UnimplementedProductServiceServer) SetPriceFloor(context.Context, *SetPriceFloorRequest) (*SetPriceFloorResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetPriceFloor not implemented")
+}
+func (UnimplementedProductServiceServer) SearchProducts(context.Context, *SearchProductsRequest) (*SearchProductsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchProducts not implemented")
+}
+func (UnimplementedProductServiceServer) CreateMerchRule(context.Context, *CreateMerchRuleRequest) (*CreateMerchRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateMerchRule not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteMerchRule(context.Context, *DeleteMerchRuleRequest) (*DeleteMerchRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteMerchRule not implemented")
+}
+func (UnimplementedProductServiceServer) ListMerchRules(context.Context, *ListMerchRulesRequest) (*ListMerchRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMerchRules not implemented")
+}
+func (UnimplementedProductServiceServer) PutCategoryTemplate(context.Context, *PutCategoryTemplateRequest) (*PutCategoryTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PutCategoryTemplate not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteCategoryTemplate(context.Context, *DeleteCategoryTemplateRequest) (*DeleteCategoryTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCategoryTemplate not implemented")
+}
+func (UnimplementedProductServiceServer) GetCategoryTemplate(context.Context, *GetCategoryTemplateRequest) (*GetCategoryTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCategoryTemplate not implemented")
+}
+func (UnimplementedProductServiceServer) PutTenantSettings(context.Context, *PutTenantSettingsRequest) (*PutTenantSettingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PutTenantSettings not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteTenantSettings(context.Context, *DeleteTenantSettingsRequest) (*DeleteTenantSettingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteTenantSettings not implemented")
+}
+func (UnimplementedProductServiceServer) GetTenantSettings(context.Context, *GetTenantSettingsRequest) (*GetTenantSettingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTenantSettings not implemented")
+}
+func (UnimplementedProductServiceServer) CreateTenant(context.Context, *CreateTenantRequest) (*CreateTenantResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTenant not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteTenant(context.Context, *DeleteTenantRequest) (*DeleteTenantResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteTenant not implemented")
+}
+func (UnimplementedProductServiceServer) SuggestProducts(context.Context, *SuggestProductsRequest) (*SuggestProductsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SuggestProducts not implemented")
+}
+func (UnimplementedProductServiceServer) RecordProductView(context.Context, *RecordProductViewRequest) (*RecordProductViewResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecordProductView not implemented")
+}
+func (UnimplementedProductServiceServer) ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCategories not implemented")
+}
+func (UnimplementedProductServiceServer) GetProductStats(context.Context, *GetProductStatsRequest) (*GetProductStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProductStats not implemented")
+}
+func (UnimplementedProductServiceServer) ListNewArrivals(context.Context, *ListCuratedProductsRequest) (*ListCuratedProductsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListNewArrivals not implemented")
+}
+func (UnimplementedProductServiceServer) ListTrendingProducts(context.Context, *ListCuratedProductsRequest) (*ListCuratedProductsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTrendingProducts not implemented")
+}
+func (UnimplementedProductServiceServer) GetRecommendations(context.Context, *GetRecommendationsRequest) (*GetRecommendationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRecommendations not implemented")
+}
+func (UnimplementedProductServiceServer) GetProductJsonLd(context.Context, *GetProductJsonLdRequest) (*GetProductJsonLdResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProductJsonLd not implemented")
+}
+func (UnimplementedProductServiceServer) IssueApiKey(context.Context, *IssueApiKeyRequest) (*IssueApiKeyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method IssueApiKey not implemented")
+}
+func (UnimplementedProductServiceServer) RevokeApiKey(context.Context, *RevokeApiKeyRequest) (*RevokeApiKeyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeApiKey not implemented")
+}
+func (UnimplementedProductServiceServer) ListApiKeys(context.Context, *ListApiKeysRequest) (*ListApiKeysResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListApiKeys not implemented")
+}
+func (UnimplementedProductServiceServer) GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUsage not implemented")
+}
+func (UnimplementedProductServiceServer) GetFaultInjection(context.Context, *GetFaultInjectionRequest) (*GetFaultInjectionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFaultInjection not implemented")
+}
+func (UnimplementedProductServiceServer) SetFaultInjection(context.Context, *SetFaultInjectionRequest) (*SetFaultInjectionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetFaultInjection not implemented")
+}
+func (UnimplementedProductServiceServer) ListProductVersions(context.Context, *ListProductVersionsRequest) (*ListProductVersionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListProductVersions not implemented")
+}
+func (UnimplementedProductServiceServer) RollbackToVersion(context.Context, *RollbackToVersionRequest) (*RollbackToVersionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RollbackToVersion not implemented")
+}
+func (UnimplementedProductServiceServer) SaveDraft(context.Context, *SaveDraftRequest) (*SaveDraftResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveDraft not implemented")
+}
+func (UnimplementedProductServiceServer) PublishDraft(context.Context, *PublishDraftRequest) (*PublishDraftResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PublishDraft not implemented")
+}
+func (UnimplementedProductServiceServer) DiscardDraft(context.Context, *DiscardDraftRequest) (*DiscardDraftResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiscardDraft not implemented")
+}
+func (UnimplementedProductServiceServer) GeneratePreviewToken(context.Context, *GeneratePreviewTokenRequest) (*GeneratePreviewTokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GeneratePreviewToken not implemented")
+}
+func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
+func (UnimplementedProductServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeProductServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProductServiceServer will
+// result in compilation errors.
+type UnsafeProductServiceServer interface {
 	mustEmbedUnimplementedProductServiceServer()
 }
 
-// UnimplementedProductServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedProductServiceServer struct{}
+func RegisterProductServiceServer(s grpc.ServiceRegistrar, srv ProductServiceServer) {
+	// If the following call panics, it indicates UnimplementedProductServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ProductService_ServiceDesc, srv)
+}
+
+func _ProductService_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateProduct(ctx, req.(*CreateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateProduct(ctx, req.(*UpdateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProduct(ctx, req.(*GetProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListProducts(ctx, req.(*ListProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ApplyDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyDiscountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ApplyDiscount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ApplyDiscount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ApplyDiscount(ctx, req.(*ApplyDiscountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_RemoveDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDiscountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RemoveDiscount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_RemoveDiscount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RemoveDiscount(ctx, req.(*RemoveDiscountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ActivateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ActivateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ActivateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ActivateProduct(ctx, req.(*ActivateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeactivateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeactivateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeactivateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeactivateProduct(ctx, req.(*DeactivateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ArchiveProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ArchiveProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ArchiveProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ArchiveProduct(ctx, req.(*ArchiveProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_FindSimilarProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindSimilarProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).FindSimilarProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_FindSimilarProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).FindSimilarProducts(ctx, req.(*FindSimilarProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CompareProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CompareProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CompareProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CompareProducts(ctx, req.(*CompareProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLegalHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetLegalHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetLegalHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetLegalHold(ctx, req.(*SetLegalHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_PurgeArchivedProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeArchivedProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).PurgeArchivedProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_PurgeArchivedProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).PurgeArchivedProducts(ctx, req.(*PurgeArchivedProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ExportProductData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportProductDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ExportProductData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ExportProductData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ExportProductData(ctx, req.(*ExportProductDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_BatchImportProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchImportProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).BatchImportProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_BatchImportProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).BatchImportProducts(ctx, req.(*BatchImportProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ValidateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ValidateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ValidateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ValidateProduct(ctx, req.(*ValidateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ReviewProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ReviewProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ReviewProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ReviewProduct(ctx, req.(*ReviewProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetProductHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProductHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetProductHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProductHistory(ctx, req.(*GetProductHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_RebuildProjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebuildProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RebuildProjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_RebuildProjection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RebuildProjection(ctx, req.(*RebuildProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ReassignCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReassignCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ReassignCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ReassignCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ReassignCategory(ctx, req.(*ReassignCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetChannels(ctx, req.(*SetChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetMetadata(ctx, req.(*SetMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetAttributes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetAttributes(ctx, req.(*SetAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_LinkExternalRef_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkExternalRefRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).LinkExternalRef(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_LinkExternalRef_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).LinkExternalRef(ctx, req.(*LinkExternalRefRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UnlinkExternalRef_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkExternalRefRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UnlinkExternalRef(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UnlinkExternalRef_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UnlinkExternalRef(ctx, req.(*UnlinkExternalRefRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetProductByExternalRef_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductByExternalRefRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProductByExternalRef(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetProductByExternalRef_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProductByExternalRef(ctx, req.(*GetProductByExternalRefRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_BatchActivateProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchActivateProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).BatchActivateProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_BatchActivateProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).BatchActivateProducts(ctx, req.(*BatchActivateProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_BatchDeactivateProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeactivateProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).BatchDeactivateProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_BatchDeactivateProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).BatchDeactivateProducts(ctx, req.(*BatchDeactivateProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_BatchArchiveProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchArchiveProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).BatchArchiveProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_BatchArchiveProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).BatchArchiveProducts(ctx, req.(*BatchArchiveProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_MergeProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).MergeProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_MergeProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).MergeProducts(ctx, req.(*MergeProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CloneProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CloneProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CloneProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CloneProduct(ctx, req.(*CloneProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ChangeBasePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeBasePriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ChangeBasePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ChangeBasePrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ChangeBasePrice(ctx, req.(*ChangeBasePriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ApproveChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ApproveChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ApproveChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ApproveChange(ctx, req.(*ApproveChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_RejectChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RejectChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_RejectChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RejectChange(ctx, req.(*RejectChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (UnimplementedProductServiceServer) CreateProduct(context.Context, *CreateProductRequest) (*CreateProductResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateProduct not implemented")
+func _ProductService_SetPriceFloor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPriceFloorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetPriceFloor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetPriceFloor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetPriceFloor(ctx, req.(*SetPriceFloorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductServiceServer) UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateProduct not implemented")
+
+func _ProductService_SearchProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SearchProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SearchProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SearchProducts(ctx, req.(*SearchProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductServiceServer) GetProduct(context.Context, *GetProductRequest) (*GetProductResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetProduct not implemented")
+
+func _ProductService_CreateMerchRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMerchRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateMerchRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateMerchRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateMerchRule(ctx, req.(*CreateMerchRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductServiceServer) ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListProducts not implemented")
+
+func _ProductService_DeleteMerchRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMerchRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteMerchRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteMerchRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteMerchRule(ctx, req.(*DeleteMerchRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductServiceServer) ApplyDiscount(context.Context, *ApplyDiscountRequest) (*ApplyDiscountResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ApplyDiscount not implemented")
+
+func _ProductService_ListMerchRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMerchRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListMerchRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListMerchRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListMerchRules(ctx, req.(*ListMerchRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_PutCategoryTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutCategoryTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).PutCategoryTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_PutCategoryTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).PutCategoryTemplate(ctx, req.(*PutCategoryTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteCategoryTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteCategoryTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteCategoryTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteCategoryTemplate(ctx, req.(*DeleteCategoryTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetCategoryTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetCategoryTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetCategoryTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetCategoryTemplate(ctx, req.(*GetCategoryTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_PutTenantSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutTenantSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).PutTenantSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_PutTenantSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).PutTenantSettings(ctx, req.(*PutTenantSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteTenantSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTenantSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteTenantSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteTenantSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteTenantSettings(ctx, req.(*DeleteTenantSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetTenantSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenantSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetTenantSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetTenantSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetTenantSettings(ctx, req.(*GetTenantSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateTenant(ctx, req.(*CreateTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteTenant(ctx, req.(*DeleteTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SuggestProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SuggestProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SuggestProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SuggestProducts(ctx, req.(*SuggestProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_RecordProductView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordProductViewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RecordProductView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_RecordProductView_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RecordProductView(ctx, req.(*RecordProductViewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListCategories(ctx, req.(*ListCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetProductStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProductStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetProductStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProductStats(ctx, req.(*GetProductStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListNewArrivals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCuratedProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListNewArrivals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListNewArrivals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListNewArrivals(ctx, req.(*ListCuratedProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductServiceServer) RemoveDiscount(context.Context, *RemoveDiscountRequest) (*RemoveDiscountResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method RemoveDiscount not implemented")
+
+func _ProductService_ListTrendingProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCuratedProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListTrendingProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListTrendingProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListTrendingProducts(ctx, req.(*ListCuratedProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductServiceServer) ActivateProduct(context.Context, *ActivateProductRequest) (*ActivateProductResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ActivateProduct not implemented")
+
+func _ProductService_GetRecommendations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecommendationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetRecommendations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetRecommendations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetRecommendations(ctx, req.(*GetRecommendationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductServiceServer) DeactivateProduct(context.Context, *DeactivateProductRequest) (*DeactivateProductResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeactivateProduct not implemented")
+
+func _ProductService_GetProductJsonLd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductJsonLdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProductJsonLd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetProductJsonLd_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProductJsonLd(ctx, req.(*GetProductJsonLdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductServiceServer) ArchiveProduct(context.Context, *ArchiveProductRequest) (*ArchiveProductResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ArchiveProduct not implemented")
+
+func _ProductService_IssueApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).IssueApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_IssueApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).IssueApiKey(ctx, req.(*IssueApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
-func (UnimplementedProductServiceServer) testEmbeddedByValue()                        {}
 
-// UnsafeProductServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ProductServiceServer will
-// result in compilation errors.
-type UnsafeProductServiceServer interface {
-	mustEmbedUnimplementedProductServiceServer()
+func _ProductService_RevokeApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RevokeApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_RevokeApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RevokeApiKey(ctx, req.(*RevokeApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterProductServiceServer(s grpc.ServiceRegistrar, srv ProductServiceServer) {
-	// If the following call panics, it indicates UnimplementedProductServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _ProductService_ListApiKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApiKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&ProductService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListApiKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListApiKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListApiKeys(ctx, req.(*ListApiKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateProductRequest)
+func _ProductService_GetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).CreateProduct(ctx, in)
+		return srv.(ProductServiceServer).GetUsage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_CreateProduct_FullMethodName,
+		FullMethod: ProductService_GetUsage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).CreateProduct(ctx, req.(*CreateProductRequest))
+		return srv.(ProductServiceServer).GetUsage(ctx, req.(*GetUsageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateProductRequest)
+func _ProductService_GetFaultInjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFaultInjectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).UpdateProduct(ctx, in)
+		return srv.(ProductServiceServer).GetFaultInjection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_UpdateProduct_FullMethodName,
+		FullMethod: ProductService_GetFaultInjection_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).UpdateProduct(ctx, req.(*UpdateProductRequest))
+		return srv.(ProductServiceServer).GetFaultInjection(ctx, req.(*GetFaultInjectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_GetProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProductRequest)
+func _ProductService_SetFaultInjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFaultInjectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).GetProduct(ctx, in)
+		return srv.(ProductServiceServer).SetFaultInjection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_GetProduct_FullMethodName,
+		FullMethod: ProductService_SetFaultInjection_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GetProduct(ctx, req.(*GetProductRequest))
+		return srv.(ProductServiceServer).SetFaultInjection(ctx, req.(*SetFaultInjectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_ListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListProductsRequest)
+func _ProductService_ListProductVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductVersionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).ListProducts(ctx, in)
+		return srv.(ProductServiceServer).ListProductVersions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_ListProducts_FullMethodName,
+		FullMethod: ProductService_ListProductVersions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).ListProducts(ctx, req.(*ListProductsRequest))
+		return srv.(ProductServiceServer).ListProductVersions(ctx, req.(*ListProductVersionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_ApplyDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplyDiscountRequest)
+func _ProductService_RollbackToVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackToVersionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).ApplyDiscount(ctx, in)
+		return srv.(ProductServiceServer).RollbackToVersion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_ApplyDiscount_FullMethodName,
+		FullMethod: ProductService_RollbackToVersion_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).ApplyDiscount(ctx, req.(*ApplyDiscountRequest))
+		return srv.(ProductServiceServer).RollbackToVersion(ctx, req.(*RollbackToVersionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_RemoveDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveDiscountRequest)
+func _ProductService_SaveDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveDraftRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).RemoveDiscount(ctx, in)
+		return srv.(ProductServiceServer).SaveDraft(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_RemoveDiscount_FullMethodName,
+		FullMethod: ProductService_SaveDraft_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).RemoveDiscount(ctx, req.(*RemoveDiscountRequest))
+		return srv.(ProductServiceServer).SaveDraft(ctx, req.(*SaveDraftRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_ActivateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ActivateProductRequest)
+func _ProductService_PublishDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishDraftRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).ActivateProduct(ctx, in)
+		return srv.(ProductServiceServer).PublishDraft(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_ActivateProduct_FullMethodName,
+		FullMethod: ProductService_PublishDraft_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).ActivateProduct(ctx, req.(*ActivateProductRequest))
+		return srv.(ProductServiceServer).PublishDraft(ctx, req.(*PublishDraftRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_DeactivateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeactivateProductRequest)
+func _ProductService_DiscardDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscardDraftRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).DeactivateProduct(ctx, in)
+		return srv.(ProductServiceServer).DiscardDraft(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_DeactivateProduct_FullMethodName,
+		FullMethod: ProductService_DiscardDraft_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).DeactivateProduct(ctx, req.(*DeactivateProductRequest))
+		return srv.(ProductServiceServer).DiscardDraft(ctx, req.(*DiscardDraftRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_ArchiveProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ArchiveProductRequest)
+func _ProductService_GeneratePreviewToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeneratePreviewTokenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).ArchiveProduct(ctx, in)
+		return srv.(ProductServiceServer).GeneratePreviewToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_ArchiveProduct_FullMethodName,
+		FullMethod: ProductService_GeneratePreviewToken_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).ArchiveProduct(ctx, req.(*ArchiveProductRequest))
+		return srv.(ProductServiceServer).GeneratePreviewToken(ctx, req.(*GeneratePreviewTokenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -441,6 +2541,238 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ArchiveProduct",
 			Handler:    _ProductService_ArchiveProduct_Handler,
 		},
+		{
+			MethodName: "FindSimilarProducts",
+			Handler:    _ProductService_FindSimilarProducts_Handler,
+		},
+		{
+			MethodName: "CompareProducts",
+			Handler:    _ProductService_CompareProducts_Handler,
+		},
+		{
+			MethodName: "SetLegalHold",
+			Handler:    _ProductService_SetLegalHold_Handler,
+		},
+		{
+			MethodName: "PurgeArchivedProducts",
+			Handler:    _ProductService_PurgeArchivedProducts_Handler,
+		},
+		{
+			MethodName: "ExportProductData",
+			Handler:    _ProductService_ExportProductData_Handler,
+		},
+		{
+			MethodName: "BatchImportProducts",
+			Handler:    _ProductService_BatchImportProducts_Handler,
+		},
+		{
+			MethodName: "ValidateProduct",
+			Handler:    _ProductService_ValidateProduct_Handler,
+		},
+		{
+			MethodName: "ReviewProduct",
+			Handler:    _ProductService_ReviewProduct_Handler,
+		},
+		{
+			MethodName: "GetProductHistory",
+			Handler:    _ProductService_GetProductHistory_Handler,
+		},
+		{
+			MethodName: "RebuildProjection",
+			Handler:    _ProductService_RebuildProjection_Handler,
+		},
+		{
+			MethodName: "ReassignCategory",
+			Handler:    _ProductService_ReassignCategory_Handler,
+		},
+		{
+			MethodName: "SetChannels",
+			Handler:    _ProductService_SetChannels_Handler,
+		},
+		{
+			MethodName: "SetMetadata",
+			Handler:    _ProductService_SetMetadata_Handler,
+		},
+		{
+			MethodName: "SetAttributes",
+			Handler:    _ProductService_SetAttributes_Handler,
+		},
+		{
+			MethodName: "LinkExternalRef",
+			Handler:    _ProductService_LinkExternalRef_Handler,
+		},
+		{
+			MethodName: "UnlinkExternalRef",
+			Handler:    _ProductService_UnlinkExternalRef_Handler,
+		},
+		{
+			MethodName: "GetProductByExternalRef",
+			Handler:    _ProductService_GetProductByExternalRef_Handler,
+		},
+		{
+			MethodName: "BatchActivateProducts",
+			Handler:    _ProductService_BatchActivateProducts_Handler,
+		},
+		{
+			MethodName: "BatchDeactivateProducts",
+			Handler:    _ProductService_BatchDeactivateProducts_Handler,
+		},
+		{
+			MethodName: "BatchArchiveProducts",
+			Handler:    _ProductService_BatchArchiveProducts_Handler,
+		},
+		{
+			MethodName: "MergeProducts",
+			Handler:    _ProductService_MergeProducts_Handler,
+		},
+		{
+			MethodName: "CloneProduct",
+			Handler:    _ProductService_CloneProduct_Handler,
+		},
+		{
+			MethodName: "ChangeBasePrice",
+			Handler:    _ProductService_ChangeBasePrice_Handler,
+		},
+		{
+			MethodName: "ApproveChange",
+			Handler:    _ProductService_ApproveChange_Handler,
+		},
+		{
+			MethodName: "RejectChange",
+			Handler:    _ProductService_RejectChange_Handler,
+		},
+		{
+			MethodName: "SetPriceFloor",
+			Handler:    _ProductService_SetPriceFloor_Handler,
+		},
+		{
+			MethodName: "SearchProducts",
+			Handler:    _ProductService_SearchProducts_Handler,
+		},
+		{
+			MethodName: "CreateMerchRule",
+			Handler:    _ProductService_CreateMerchRule_Handler,
+		},
+		{
+			MethodName: "DeleteMerchRule",
+			Handler:    _ProductService_DeleteMerchRule_Handler,
+		},
+		{
+			MethodName: "ListMerchRules",
+			Handler:    _ProductService_ListMerchRules_Handler,
+		},
+		{
+			MethodName: "PutCategoryTemplate",
+			Handler:    _ProductService_PutCategoryTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteCategoryTemplate",
+			Handler:    _ProductService_DeleteCategoryTemplate_Handler,
+		},
+		{
+			MethodName: "GetCategoryTemplate",
+			Handler:    _ProductService_GetCategoryTemplate_Handler,
+		},
+		{
+			MethodName: "PutTenantSettings",
+			Handler:    _ProductService_PutTenantSettings_Handler,
+		},
+		{
+			MethodName: "DeleteTenantSettings",
+			Handler:    _ProductService_DeleteTenantSettings_Handler,
+		},
+		{
+			MethodName: "GetTenantSettings",
+			Handler:    _ProductService_GetTenantSettings_Handler,
+		},
+		{
+			MethodName: "CreateTenant",
+			Handler:    _ProductService_CreateTenant_Handler,
+		},
+		{
+			MethodName: "DeleteTenant",
+			Handler:    _ProductService_DeleteTenant_Handler,
+		},
+		{
+			MethodName: "SuggestProducts",
+			Handler:    _ProductService_SuggestProducts_Handler,
+		},
+		{
+			MethodName: "RecordProductView",
+			Handler:    _ProductService_RecordProductView_Handler,
+		},
+		{
+			MethodName: "ListCategories",
+			Handler:    _ProductService_ListCategories_Handler,
+		},
+		{
+			MethodName: "GetProductStats",
+			Handler:    _ProductService_GetProductStats_Handler,
+		},
+		{
+			MethodName: "ListNewArrivals",
+			Handler:    _ProductService_ListNewArrivals_Handler,
+		},
+		{
+			MethodName: "ListTrendingProducts",
+			Handler:    _ProductService_ListTrendingProducts_Handler,
+		},
+		{
+			MethodName: "GetRecommendations",
+			Handler:    _ProductService_GetRecommendations_Handler,
+		},
+		{
+			MethodName: "GetProductJsonLd",
+			Handler:    _ProductService_GetProductJsonLd_Handler,
+		},
+		{
+			MethodName: "IssueApiKey",
+			Handler:    _ProductService_IssueApiKey_Handler,
+		},
+		{
+			MethodName: "RevokeApiKey",
+			Handler:    _ProductService_RevokeApiKey_Handler,
+		},
+		{
+			MethodName: "ListApiKeys",
+			Handler:    _ProductService_ListApiKeys_Handler,
+		},
+		{
+			MethodName: "GetUsage",
+			Handler:    _ProductService_GetUsage_Handler,
+		},
+		{
+			MethodName: "GetFaultInjection",
+			Handler:    _ProductService_GetFaultInjection_Handler,
+		},
+		{
+			MethodName: "SetFaultInjection",
+			Handler:    _ProductService_SetFaultInjection_Handler,
+		},
+		{
+			MethodName: "ListProductVersions",
+			Handler:    _ProductService_ListProductVersions_Handler,
+		},
+		{
+			MethodName: "RollbackToVersion",
+			Handler:    _ProductService_RollbackToVersion_Handler,
+		},
+		{
+			MethodName: "SaveDraft",
+			Handler:    _ProductService_SaveDraft_Handler,
+		},
+		{
+			MethodName: "PublishDraft",
+			Handler:    _ProductService_PublishDraft_Handler,
+		},
+		{
+			MethodName: "DiscardDraft",
+			Handler:    _ProductService_DiscardDraft_Handler,
+		},
+		{
+			MethodName: "GeneratePreviewToken",
+			Handler:    _ProductService_GeneratePreviewToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/product/v1/product_service.proto",
@@ -0,0 +1,1425 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: proto/product/v2/product_service.proto
+
+package productv2
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// State is the lifecycle state of a product
+type Product_State int32
+
+const (
+	Product_STATE_UNSPECIFIED Product_State = 0
+	Product_ACTIVE            Product_State = 1
+	Product_INACTIVE          Product_State = 2
+)
+
+// Enum value maps for Product_State.
+var (
+	Product_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "ACTIVE",
+		2: "INACTIVE",
+	}
+	Product_State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"ACTIVE":            1,
+		"INACTIVE":          2,
+	}
+)
+
+func (x Product_State) Enum() *Product_State {
+	p := new(Product_State)
+	*p = x
+	return p
+}
+
+func (x Product_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Product_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_product_v2_product_service_proto_enumTypes[0].Descriptor()
+}
+
+func (Product_State) Type() protoreflect.EnumType {
+	return &file_proto_product_v2_product_service_proto_enumTypes[0]
+}
+
+func (x Product_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Product_State.Descriptor instead.
+func (Product_State) EnumDescriptor() ([]byte, []int) {
+	return file_proto_product_v2_product_service_proto_rawDescGZIP(), []int{2, 0}
+}
+
+// Type decides which fulfilment fields a product takes
+type Product_Type int32
+
+const (
+	Product_TYPE_UNSPECIFIED Product_Type = 0 // Same as PHYSICAL on create
+	Product_PHYSICAL         Product_Type = 1 // May have weight, dimensions and a shipping class
+	Product_DIGITAL          Product_Type = 2 // Requires download_uri or license_terms; no shipping details
+	Product_SERVICE          Product_Type = 3 // Neither shipping nor delivery details
+)
+
+// Enum value maps for Product_Type.
+var (
+	Product_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "PHYSICAL",
+		2: "DIGITAL",
+		3: "SERVICE",
+	}
+	Product_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"PHYSICAL":         1,
+		"DIGITAL":          2,
+		"SERVICE":          3,
+	}
+)
+
+func (x Product_Type) Enum() *Product_Type {
+	p := new(Product_Type)
+	*p = x
+	return p
+}
+
+func (x Product_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Product_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_product_v2_product_service_proto_enumTypes[1].Descriptor()
+}
+
+func (Product_Type) Type() protoreflect.EnumType {
+	return &file_proto_product_v2_product_service_proto_enumTypes[1]
+}
+
+func (x Product_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Product_Type.Descriptor instead.
+func (Product_Type) EnumDescriptor() ([]byte, []int) {
+	return file_proto_product_v2_product_service_proto_rawDescGZIP(), []int{2, 1}
+}
+
+// Money represents a monetary value
+type Money struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Amount in smallest currency unit (e.g., cents for USD)
+	Amount        int64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_proto_product_v2_product_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v2_product_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_proto_product_v2_product_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Money) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// Discount represents a discount value object
+type Discount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Deprecated: whole percent (e.g., 10 = 10%); use percent_basis_points
+	//
+	// Deprecated: Marked as deprecated in proto/product/v2/product_service.proto.
+	Amount    *Money                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Discount in basis points, 0-10000 (e.g., 1000 = 10%, 1250 = 12.5%); takes precedence over amount
+	PercentBasisPoints *int32 `protobuf:"varint,5,opt,name=percent_basis_points,json=percentBasisPoints,proto3,oneof" json:"percent_basis_points,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Discount) Reset() {
+	*x = Discount{}
+	mi := &file_proto_product_v2_product_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Discount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Discount) ProtoMessage() {}
+
+func (x *Discount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v2_product_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Discount.ProtoReflect.Descriptor instead.
+func (*Discount) Descriptor() ([]byte, []int) {
+	return file_proto_product_v2_product_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Discount) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in proto/product/v2/product_service.proto.
+func (x *Discount) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Discount) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *Discount) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *Discount) GetPercentBasisPoints() int32 {
+	if x != nil && x.PercentBasisPoints != nil {
+		return *x.PercentBasisPoints
+	}
+	return 0
+}
+
+// Product is the product resource
+type Product struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // Resource name: products/{product}
+	DisplayName    string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Category       string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Sku            string                 `protobuf:"bytes,5,opt,name=sku,proto3" json:"sku,omitempty"`   // Merchant stock keeping unit (optional)
+	Gtin           string                 `protobuf:"bytes,6,opt,name=gtin,proto3" json:"gtin,omitempty"` // GTIN-8/12/13/14 (optional)
+	BasePrice      *Money                 `protobuf:"bytes,7,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	EffectivePrice *Money                 `protobuf:"bytes,8,opt,name=effective_price,json=effectivePrice,proto3" json:"effective_price,omitempty"` // Output only: price after discount, raised to the MAP when map_applied
+	Discount       *Discount              `protobuf:"bytes,9,opt,name=discount,proto3" json:"discount,omitempty"`                                   // Output only: use ApplyDiscount/RemoveDiscount
+	State          Product_State          `protobuf:"varint,10,opt,name=state,proto3,enum=product.v2.Product_State" json:"state,omitempty"`         // Output only: use ActivateProduct/DeactivateProduct
+	LegalHold      bool                   `protobuf:"varint,11,opt,name=legal_hold,json=legalHold,proto3" json:"legal_hold,omitempty"`              // Output only: exempt from retention purges
+	CreateTime     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`            // Output only
+	UpdateTime     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`            // Output only
+	DeleteTime     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=delete_time,json=deleteTime,proto3" json:"delete_time,omitempty"`            // Output only: set once archived
+	Channels       []string               `protobuf:"bytes,15,rep,name=channels,proto3" json:"channels,omitempty"`                                  // Output only: use the v1 SetChannels RPC
+	Weight         *Weight                `protobuf:"bytes,16,opt,name=weight,proto3" json:"weight,omitempty"`
+	Dimensions     *Dimensions            `protobuf:"bytes,17,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
+	ShippingClass  string                 `protobuf:"bytes,18,opt,name=shipping_class,json=shippingClass,proto3" json:"shipping_class,omitempty"` // e.g. "standard", "oversized" (optional)
+	Type           Product_Type           `protobuf:"varint,19,opt,name=type,proto3,enum=product.v2.Product_Type" json:"type,omitempty"`
+	DownloadUri    string                 `protobuf:"bytes,20,opt,name=download_uri,json=downloadUri,proto3" json:"download_uri,omitempty"`                                                  // Digital products only
+	LicenseTerms   string                 `protobuf:"bytes,21,opt,name=license_terms,json=licenseTerms,proto3" json:"license_terms,omitempty"`                                               // Digital products only
+	Compliance     *Compliance            `protobuf:"bytes,22,opt,name=compliance,proto3" json:"compliance,omitempty"`                                                                       // Unset when unrestricted
+	Metadata       map[string]string      `protobuf:"bytes,23,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Output only: use the v1 SetMetadata RPC
+	MapApplied     bool                   `protobuf:"varint,24,opt,name=map_applied,json=mapApplied,proto3" json:"map_applied,omitempty"`                                                    // Output only: effective_price shows the minimum advertised price
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Product) Reset() {
+	*x = Product{}
+	mi := &file_proto_product_v2_product_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Product) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Product) ProtoMessage() {}
+
+func (x *Product) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v2_product_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Product.ProtoReflect.Descriptor instead.
+func (*Product) Descriptor() ([]byte, []int) {
+	return file_proto_product_v2_product_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Product) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Product) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Product) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Product) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Product) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *Product) GetGtin() string {
+	if x != nil {
+		return x.Gtin
+	}
+	return ""
+}
+
+func (x *Product) GetBasePrice() *Money {
+	if x != nil {
+		return x.BasePrice
+	}
+	return nil
+}
+
+func (x *Product) GetEffectivePrice() *Money {
+	if x != nil {
+		return x.EffectivePrice
+	}
+	return nil
+}
+
+func (x *Product) GetDiscount() *Discount {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *Product) GetState() Product_State {
+	if x != nil {
+		return x.State
+	}
+	return Product_STATE_UNSPECIFIED
+}
+
+func (x *Product) GetLegalHold() bool {
+	if x != nil {
+		return x.LegalHold
+	}
+	return false
+}
+
+func (x *Product) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Product) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *Product) GetDeleteTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeleteTime
+	}
+	return nil
+}
+
+func (x *Product) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *Product) GetWeight() *Weight {
+	if x != nil {
+		return x.Weight
+	}
+	return nil
+}
+
+func (x *Product) GetDimensions() *Dimensions {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+func (x *Product) GetShippingClass() string {
+	if x != nil {
+		return x.ShippingClass
+	}
+	return ""
+}
+
+func (x *Product) GetType() Product_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Product_TYPE_UNSPECIFIED
+}
+
+func (x *Product) GetDownloadUri() string {
+	if x != nil {
+		return x.DownloadUri
+	}
+	return ""
+}
+
+func (x *Product) GetLicenseTerms() string {
+	if x != nil {
+		return x.LicenseTerms
+	}
+	return ""
+}
+
+func (x *Product) GetCompliance() *Compliance {
+	if x != nil {
+		return x.Compliance
+	}
+	return nil
+}
+
+func (x *Product) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Product) GetMapApplied() bool {
+	if x != nil {
+		return x.MapApplied
+	}
+	return false
+}
+
+// Compliance is the regulatory metadata that decides where a product may be offered
+type Compliance struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	AgeRestriction       int32                  `protobuf:"varint,1,opt,name=age_restriction,json=ageRestriction,proto3" json:"age_restriction,omitempty"` // Minimum buyer age in years (0-21), 0 for none
+	Hazardous            bool                   `protobuf:"varint,2,opt,name=hazardous,proto3" json:"hazardous,omitempty"`
+	RequiresPrescription bool                   `protobuf:"varint,3,opt,name=requires_prescription,json=requiresPrescription,proto3" json:"requires_prescription,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Compliance) Reset() {
+	*x = Compliance{}
+	mi := &file_proto_product_v2_product_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Compliance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Compliance) ProtoMessage() {}
+
+func (x *Compliance) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v2_product_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Compliance.ProtoReflect.Descriptor instead.
+func (*Compliance) Descriptor() ([]byte, []int) {
+	return file_proto_product_v2_product_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Compliance) GetAgeRestriction() int32 {
+	if x != nil {
+		return x.AgeRestriction
+	}
+	return 0
+}
+
+func (x *Compliance) GetHazardous() bool {
+	if x != nil {
+		return x.Hazardous
+	}
+	return false
+}
+
+func (x *Compliance) GetRequiresPrescription() bool {
+	if x != nil {
+		return x.RequiresPrescription
+	}
+	return false
+}
+
+// Weight is a product's shipping weight
+type Weight struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         float64                `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"` // Non-negative
+	Unit          string                 `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"`     // "g", "kg", "oz" or "lb"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Weight) Reset() {
+	*x = Weight{}
+	mi := &file_proto_product_v2_product_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Weight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Weight) ProtoMessage() {}
+
+func (x *Weight) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v2_product_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Weight.ProtoReflect.Descriptor instead.
+func (*Weight) Descriptor() ([]byte, []int) {
+	return file_proto_product_v2_product_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Weight) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Weight) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+// Dimensions are a product's package dimensions
+type Dimensions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Length        float64                `protobuf:"fixed64,1,opt,name=length,proto3" json:"length,omitempty"` // Non-negative, like width and height
+	Width         float64                `protobuf:"fixed64,2,opt,name=width,proto3" json:"width,omitempty"`
+	Height        float64                `protobuf:"fixed64,3,opt,name=height,proto3" json:"height,omitempty"`
+	Unit          string                 `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"` // "mm", "cm", "m" or "in"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Dimensions) Reset() {
+	*x = Dimensions{}
+	mi := &file_proto_product_v2_product_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Dimensions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Dimensions) ProtoMessage() {}
+
+func (x *Dimensions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v2_product_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Dimensions.ProtoReflect.Descriptor instead.
+func (*Dimensions) Descriptor() ([]byte, []int) {
+	return file_proto_product_v2_product_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Dimensions) GetLength() float64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *Dimensions) GetWidth() float64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Dimensions) GetHeight() float64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Dimensions) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+// GetProductRequest is the request for GetProduct
+type GetProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // products/{product}
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductRequest) Reset() {
+	*x = GetProductRequest{}
+	mi := &file_proto_product_v2_product_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductRequest) ProtoMessage() {}
+
+func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v2_product_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
+func (*GetProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v2_product_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetProductRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// ListProductsRequest is the request for ListProducts
+type ListProductsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Default 50, maximum 1000
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // From a previous ListProductsResponse
+	// Conjunction of equality terms, e.g. category = "books" AND state = ACTIVE
+	// Supported fields: category, state, channels, age_restriction, hazardous, requires_prescription, metadata.<key>
+	Filter          string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	ReturnTotalSize bool   `protobuf:"varint,4,opt,name=return_total_size,json=returnTotalSize,proto3" json:"return_total_size,omitempty"` // Count the matches into total_size; skipped by default as it costs a second query
+	// With return_total_size, take total_size from periodically refreshed counts instead of counting
+	ApproximateTotalSize bool `protobuf:"varint,5,opt,name=approximate_total_size,json=approximateTotalSize,proto3" json:"approximate_total_size,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ListProductsRequest) Reset() {
+	*x = ListProductsRequest{}
+	mi := &file_proto_product_v2_product_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsRequest) ProtoMessage() {}
+
+func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v2_product_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v2_product_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListProductsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetReturnTotalSize() bool {
+	if x != nil {
+		return x.ReturnTotalSize
+	}
+	return false
+}
+
+func (x *ListProductsRequest) GetApproximateTotalSize() bool {
+	if x != nil {
+		return x.ApproximateTotalSize
+	}
+	return false
+}
+
+// ListProductsResponse is the response for ListProducts
+type ListProductsResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Products             []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	NextPageToken        string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`                       // Empty on the last page
+	TotalSize            int32                  `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`                                    // Total matches for the filter; only set when return_total_size was requested
+	TotalSizeApproximate bool                   `protobuf:"varint,4,opt,name=total_size_approximate,json=totalSizeApproximate,proto3" json:"total_size_approximate,omitempty"` // total_size came from the cached counts and may be stale
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ListProductsResponse) Reset() {
+	*x = ListProductsResponse{}
+	mi := &file_proto_product_v2_product_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsResponse) ProtoMessage() {}
+
+func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v2_product_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_v2_product_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ListProductsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListProductsResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+func (x *ListProductsResponse) GetTotalSizeApproximate() bool {
+	if x != nil {
+		return x.TotalSizeApproximate
+	}
+	return false
+}
+
+// CreateProductRequest is the request for CreateProduct
+type CreateProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"` // name and output-only fields are ignored
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductRequest) Reset() {
+	*x = CreateProductRequest{}
+	mi := &file_proto_product_v2_product_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductRequest) ProtoMessage() {}
+
+func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v2_product_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v2_product_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateProductRequest) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// UpdateProductRequest is the request for UpdateProduct
+type UpdateProductRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Product *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"` // product.name identifies the product to update
+	// Fields to update: display_name, description, category; "*" updates all three
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductRequest) Reset() {
+	*x = UpdateProductRequest{}
+	mi := &file_proto_product_v2_product_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductRequest) ProtoMessage() {}
+
+func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v2_product_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v2_product_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateProductRequest) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// DeleteProductRequest is the request for DeleteProduct
+type DeleteProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // products/{product}
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductRequest) Reset() {
+	*x = DeleteProductRequest{}
+	mi := &file_proto_product_v2_product_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductRequest) ProtoMessage() {}
+
+func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v2_product_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v2_product_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteProductRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// ActivateProductRequest is the request for ActivateProduct
+type ActivateProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // products/{product}
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateProductRequest) Reset() {
+	*x = ActivateProductRequest{}
+	mi := &file_proto_product_v2_product_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateProductRequest) ProtoMessage() {}
+
+func (x *ActivateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v2_product_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateProductRequest.ProtoReflect.Descriptor instead.
+func (*ActivateProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v2_product_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ActivateProductRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// DeactivateProductRequest is the request for DeactivateProduct
+type DeactivateProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // products/{product}
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateProductRequest) Reset() {
+	*x = DeactivateProductRequest{}
+	mi := &file_proto_product_v2_product_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateProductRequest) ProtoMessage() {}
+
+func (x *DeactivateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v2_product_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateProductRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v2_product_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeactivateProductRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// ApplyDiscountRequest is the request for ApplyDiscount
+type ApplyDiscountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // products/{product}
+	Discount      *Discount              `protobuf:"bytes,2,opt,name=discount,proto3" json:"discount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyDiscountRequest) Reset() {
+	*x = ApplyDiscountRequest{}
+	mi := &file_proto_product_v2_product_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyDiscountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyDiscountRequest) ProtoMessage() {}
+
+func (x *ApplyDiscountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v2_product_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyDiscountRequest.ProtoReflect.Descriptor instead.
+func (*ApplyDiscountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v2_product_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ApplyDiscountRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApplyDiscountRequest) GetDiscount() *Discount {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+// RemoveDiscountRequest is the request for RemoveDiscount
+type RemoveDiscountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // products/{product}
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDiscountRequest) Reset() {
+	*x = RemoveDiscountRequest{}
+	mi := &file_proto_product_v2_product_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDiscountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDiscountRequest) ProtoMessage() {}
+
+func (x *RemoveDiscountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v2_product_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDiscountRequest.ProtoReflect.Descriptor instead.
+func (*RemoveDiscountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v2_product_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RemoveDiscountRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_proto_product_v2_product_service_proto protoreflect.FileDescriptor
+
+const file_proto_product_v2_product_service_proto_rawDesc = "" +
+	"\n" +
+	"&proto/product/v2/product_service.proto\x12\n" +
+	"product.v2\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x1f\n" +
+	"\x05Money\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x03R\x06amount\"\x8b\x02\n" +
+	"\bDiscount\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12-\n" +
+	"\x06amount\x18\x02 \x01(\v2\x11.product.v2.MoneyB\x02\x18\x01R\x06amount\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x125\n" +
+	"\x14percent_basis_points\x18\x05 \x01(\x05H\x00R\x12percentBasisPoints\x88\x01\x01B\x17\n" +
+	"\x15_percent_basis_points\"\xbd\t\n" +
+	"\aProduct\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\x12\x10\n" +
+	"\x03sku\x18\x05 \x01(\tR\x03sku\x12\x12\n" +
+	"\x04gtin\x18\x06 \x01(\tR\x04gtin\x120\n" +
+	"\n" +
+	"base_price\x18\a \x01(\v2\x11.product.v2.MoneyR\tbasePrice\x12:\n" +
+	"\x0feffective_price\x18\b \x01(\v2\x11.product.v2.MoneyR\x0eeffectivePrice\x120\n" +
+	"\bdiscount\x18\t \x01(\v2\x14.product.v2.DiscountR\bdiscount\x12/\n" +
+	"\x05state\x18\n" +
+	" \x01(\x0e2\x19.product.v2.Product.StateR\x05state\x12\x1d\n" +
+	"\n" +
+	"legal_hold\x18\v \x01(\bR\tlegalHold\x12;\n" +
+	"\vcreate_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\x12;\n" +
+	"\vdelete_time\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"deleteTime\x12\x1a\n" +
+	"\bchannels\x18\x0f \x03(\tR\bchannels\x12*\n" +
+	"\x06weight\x18\x10 \x01(\v2\x12.product.v2.WeightR\x06weight\x126\n" +
+	"\n" +
+	"dimensions\x18\x11 \x01(\v2\x16.product.v2.DimensionsR\n" +
+	"dimensions\x12%\n" +
+	"\x0eshipping_class\x18\x12 \x01(\tR\rshippingClass\x12,\n" +
+	"\x04type\x18\x13 \x01(\x0e2\x18.product.v2.Product.TypeR\x04type\x12!\n" +
+	"\fdownload_uri\x18\x14 \x01(\tR\vdownloadUri\x12#\n" +
+	"\rlicense_terms\x18\x15 \x01(\tR\flicenseTerms\x126\n" +
+	"\n" +
+	"compliance\x18\x16 \x01(\v2\x16.product.v2.ComplianceR\n" +
+	"compliance\x12=\n" +
+	"\bmetadata\x18\x17 \x03(\v2!.product.v2.Product.MetadataEntryR\bmetadata\x12\x1f\n" +
+	"\vmap_applied\x18\x18 \x01(\bR\n" +
+	"mapApplied\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"8\n" +
+	"\x05State\x12\x15\n" +
+	"\x11STATE_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06ACTIVE\x10\x01\x12\f\n" +
+	"\bINACTIVE\x10\x02\"D\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\f\n" +
+	"\bPHYSICAL\x10\x01\x12\v\n" +
+	"\aDIGITAL\x10\x02\x12\v\n" +
+	"\aSERVICE\x10\x03\"\x88\x01\n" +
+	"\n" +
+	"Compliance\x12'\n" +
+	"\x0fage_restriction\x18\x01 \x01(\x05R\x0eageRestriction\x12\x1c\n" +
+	"\thazardous\x18\x02 \x01(\bR\thazardous\x123\n" +
+	"\x15requires_prescription\x18\x03 \x01(\bR\x14requiresPrescription\"2\n" +
+	"\x06Weight\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x01R\x05value\x12\x12\n" +
+	"\x04unit\x18\x02 \x01(\tR\x04unit\"f\n" +
+	"\n" +
+	"Dimensions\x12\x16\n" +
+	"\x06length\x18\x01 \x01(\x01R\x06length\x12\x14\n" +
+	"\x05width\x18\x02 \x01(\x01R\x05width\x12\x16\n" +
+	"\x06height\x18\x03 \x01(\x01R\x06height\x12\x12\n" +
+	"\x04unit\x18\x04 \x01(\tR\x04unit\"'\n" +
+	"\x11GetProductRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\xcb\x01\n" +
+	"\x13ListProductsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\x12*\n" +
+	"\x11return_total_size\x18\x04 \x01(\bR\x0freturnTotalSize\x124\n" +
+	"\x16approximate_total_size\x18\x05 \x01(\bR\x14approximateTotalSize\"\xc4\x01\n" +
+	"\x14ListProductsResponse\x12/\n" +
+	"\bproducts\x18\x01 \x03(\v2\x13.product.v2.ProductR\bproducts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\x124\n" +
+	"\x16total_size_approximate\x18\x04 \x01(\bR\x14totalSizeApproximate\"E\n" +
+	"\x14CreateProductRequest\x12-\n" +
+	"\aproduct\x18\x01 \x01(\v2\x13.product.v2.ProductR\aproduct\"\x82\x01\n" +
+	"\x14UpdateProductRequest\x12-\n" +
+	"\aproduct\x18\x01 \x01(\v2\x13.product.v2.ProductR\aproduct\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"*\n" +
+	"\x14DeleteProductRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\",\n" +
+	"\x16ActivateProductRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\".\n" +
+	"\x18DeactivateProductRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\\\n" +
+	"\x14ApplyDiscountRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x120\n" +
+	"\bdiscount\x18\x02 \x01(\v2\x14.product.v2.DiscountR\bdiscount\"+\n" +
+	"\x15RemoveDiscountRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name2\xab\x05\n" +
+	"\x0eProductService\x12@\n" +
+	"\n" +
+	"GetProduct\x12\x1d.product.v2.GetProductRequest\x1a\x13.product.v2.Product\x12Q\n" +
+	"\fListProducts\x12\x1f.product.v2.ListProductsRequest\x1a .product.v2.ListProductsResponse\x12F\n" +
+	"\rCreateProduct\x12 .product.v2.CreateProductRequest\x1a\x13.product.v2.Product\x12F\n" +
+	"\rUpdateProduct\x12 .product.v2.UpdateProductRequest\x1a\x13.product.v2.Product\x12F\n" +
+	"\rDeleteProduct\x12 .product.v2.DeleteProductRequest\x1a\x13.product.v2.Product\x12J\n" +
+	"\x0fActivateProduct\x12\".product.v2.ActivateProductRequest\x1a\x13.product.v2.Product\x12N\n" +
+	"\x11DeactivateProduct\x12$.product.v2.DeactivateProductRequest\x1a\x13.product.v2.Product\x12F\n" +
+	"\rApplyDiscount\x12 .product.v2.ApplyDiscountRequest\x1a\x13.product.v2.Product\x12H\n" +
+	"\x0eRemoveDiscount\x12!.product.v2.RemoveDiscountRequest\x1a\x13.product.v2.ProductB)Z'catalog-proj/proto/product/v2;productv2b\x06proto3"
+
+var (
+	file_proto_product_v2_product_service_proto_rawDescOnce sync.Once
+	file_proto_product_v2_product_service_proto_rawDescData []byte
+)
+
+func file_proto_product_v2_product_service_proto_rawDescGZIP() []byte {
+	file_proto_product_v2_product_service_proto_rawDescOnce.Do(func() {
+		file_proto_product_v2_product_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_product_v2_product_service_proto_rawDesc), len(file_proto_product_v2_product_service_proto_rawDesc)))
+	})
+	return file_proto_product_v2_product_service_proto_rawDescData
+}
+
+var file_proto_product_v2_product_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_product_v2_product_service_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_proto_product_v2_product_service_proto_goTypes = []any{
+	(Product_State)(0),               // 0: product.v2.Product.State
+	(Product_Type)(0),                // 1: product.v2.Product.Type
+	(*Money)(nil),                    // 2: product.v2.Money
+	(*Discount)(nil),                 // 3: product.v2.Discount
+	(*Product)(nil),                  // 4: product.v2.Product
+	(*Compliance)(nil),               // 5: product.v2.Compliance
+	(*Weight)(nil),                   // 6: product.v2.Weight
+	(*Dimensions)(nil),               // 7: product.v2.Dimensions
+	(*GetProductRequest)(nil),        // 8: product.v2.GetProductRequest
+	(*ListProductsRequest)(nil),      // 9: product.v2.ListProductsRequest
+	(*ListProductsResponse)(nil),     // 10: product.v2.ListProductsResponse
+	(*CreateProductRequest)(nil),     // 11: product.v2.CreateProductRequest
+	(*UpdateProductRequest)(nil),     // 12: product.v2.UpdateProductRequest
+	(*DeleteProductRequest)(nil),     // 13: product.v2.DeleteProductRequest
+	(*ActivateProductRequest)(nil),   // 14: product.v2.ActivateProductRequest
+	(*DeactivateProductRequest)(nil), // 15: product.v2.DeactivateProductRequest
+	(*ApplyDiscountRequest)(nil),     // 16: product.v2.ApplyDiscountRequest
+	(*RemoveDiscountRequest)(nil),    // 17: product.v2.RemoveDiscountRequest
+	nil,                              // 18: product.v2.Product.MetadataEntry
+	(*timestamppb.Timestamp)(nil),    // 19: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),    // 20: google.protobuf.FieldMask
+}
+var file_proto_product_v2_product_service_proto_depIdxs = []int32{
+	2,  // 0: product.v2.Discount.amount:type_name -> product.v2.Money
+	19, // 1: product.v2.Discount.start_time:type_name -> google.protobuf.Timestamp
+	19, // 2: product.v2.Discount.end_time:type_name -> google.protobuf.Timestamp
+	2,  // 3: product.v2.Product.base_price:type_name -> product.v2.Money
+	2,  // 4: product.v2.Product.effective_price:type_name -> product.v2.Money
+	3,  // 5: product.v2.Product.discount:type_name -> product.v2.Discount
+	0,  // 6: product.v2.Product.state:type_name -> product.v2.Product.State
+	19, // 7: product.v2.Product.create_time:type_name -> google.protobuf.Timestamp
+	19, // 8: product.v2.Product.update_time:type_name -> google.protobuf.Timestamp
+	19, // 9: product.v2.Product.delete_time:type_name -> google.protobuf.Timestamp
+	6,  // 10: product.v2.Product.weight:type_name -> product.v2.Weight
+	7,  // 11: product.v2.Product.dimensions:type_name -> product.v2.Dimensions
+	1,  // 12: product.v2.Product.type:type_name -> product.v2.Product.Type
+	5,  // 13: product.v2.Product.compliance:type_name -> product.v2.Compliance
+	18, // 14: product.v2.Product.metadata:type_name -> product.v2.Product.MetadataEntry
+	4,  // 15: product.v2.ListProductsResponse.products:type_name -> product.v2.Product
+	4,  // 16: product.v2.CreateProductRequest.product:type_name -> product.v2.Product
+	4,  // 17: product.v2.UpdateProductRequest.product:type_name -> product.v2.Product
+	20, // 18: product.v2.UpdateProductRequest.update_mask:type_name -> google.protobuf.FieldMask
+	3,  // 19: product.v2.ApplyDiscountRequest.discount:type_name -> product.v2.Discount
+	8,  // 20: product.v2.ProductService.GetProduct:input_type -> product.v2.GetProductRequest
+	9,  // 21: product.v2.ProductService.ListProducts:input_type -> product.v2.ListProductsRequest
+	11, // 22: product.v2.ProductService.CreateProduct:input_type -> product.v2.CreateProductRequest
+	12, // 23: product.v2.ProductService.UpdateProduct:input_type -> product.v2.UpdateProductRequest
+	13, // 24: product.v2.ProductService.DeleteProduct:input_type -> product.v2.DeleteProductRequest
+	14, // 25: product.v2.ProductService.ActivateProduct:input_type -> product.v2.ActivateProductRequest
+	15, // 26: product.v2.ProductService.DeactivateProduct:input_type -> product.v2.DeactivateProductRequest
+	16, // 27: product.v2.ProductService.ApplyDiscount:input_type -> product.v2.ApplyDiscountRequest
+	17, // 28: product.v2.ProductService.RemoveDiscount:input_type -> product.v2.RemoveDiscountRequest
+	4,  // 29: product.v2.ProductService.GetProduct:output_type -> product.v2.Product
+	10, // 30: product.v2.ProductService.ListProducts:output_type -> product.v2.ListProductsResponse
+	4,  // 31: product.v2.ProductService.CreateProduct:output_type -> product.v2.Product
+	4,  // 32: product.v2.ProductService.UpdateProduct:output_type -> product.v2.Product
+	4,  // 33: product.v2.ProductService.DeleteProduct:output_type -> product.v2.Product
+	4,  // 34: product.v2.ProductService.ActivateProduct:output_type -> product.v2.Product
+	4,  // 35: product.v2.ProductService.DeactivateProduct:output_type -> product.v2.Product
+	4,  // 36: product.v2.ProductService.ApplyDiscount:output_type -> product.v2.Product
+	4,  // 37: product.v2.ProductService.RemoveDiscount:output_type -> product.v2.Product
+	29, // [29:38] is the sub-list for method output_type
+	20, // [20:29] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
+}
+
+func init() { file_proto_product_v2_product_service_proto_init() }
+func file_proto_product_v2_product_service_proto_init() {
+	if File_proto_product_v2_product_service_proto != nil {
+		return
+	}
+	file_proto_product_v2_product_service_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_product_v2_product_service_proto_rawDesc), len(file_proto_product_v2_product_service_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_product_v2_product_service_proto_goTypes,
+		DependencyIndexes: file_proto_product_v2_product_service_proto_depIdxs,
+		EnumInfos:         file_proto_product_v2_product_service_proto_enumTypes,
+		MessageInfos:      file_proto_product_v2_product_service_proto_msgTypes,
+	}.Build()
+	File_proto_product_v2_product_service_proto = out.File
+	file_proto_product_v2_product_service_proto_goTypes = nil
+	file_proto_product_v2_product_service_proto_depIdxs = nil
+}
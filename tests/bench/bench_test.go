@@ -0,0 +1,240 @@
+package bench
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"catalog-proj/internal/app/product/domain/services"
+	"catalog-proj/internal/app/product/queries/list_products"
+	"catalog-proj/internal/app/product/repo"
+	"catalog-proj/internal/models/m_product"
+	"catalog-proj/internal/pkg/clock"
+	"catalog-proj/internal/transport/grpc/product"
+
+	"cloud.google.com/go/spanner"
+)
+
+// pageSize matches the default ListProducts page
+const pageSize = 50
+
+// now is inside the discount window of the discounted test products
+var now = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+// allocBudgets caps the allocations per listed product of each hot path
+// Lower a budget when an optimisation lands, so the gain cannot silently regress
+var allocBudgets = map[string]float64{
+	"row_mapping":   30,
+	"pricing":       12,
+	"proto_mapping": 50,
+}
+
+// testModels returns a page of products as stored, every other one discounted and with metadata
+func testModels(n int) []*m_product.Product {
+	models := make([]*m_product.Product, n)
+	for i := range models {
+		sku := fmt.Sprintf("SKU-%04d", i)
+		weight, weightUnit := 1.25, "kg"
+		model := &m_product.Product{
+			ProductID:            fmt.Sprintf("00000000-0000-0000-0000-%012d", i),
+			TenantID:             "bench",
+			Name:                 fmt.Sprintf("Product %d", i),
+			Description:          "A product used to measure the list hot path",
+			Category:             "Electronics",
+			SKU:                  &sku,
+			BasePriceNumerator:   int64(1999 + i),
+			BasePriceDenominator: 100,
+			Status:               "active",
+			Channels:             []string{"web", "mobile"},
+			WeightValue:          &weight,
+			WeightUnit:           &weightUnit,
+			CreatedAt:            now.Add(-time.Duration(i) * time.Hour),
+			UpdatedAt:            now,
+		}
+		if i%2 == 0 {
+			id := fmt.Sprintf("discount-%d", i)
+			start, end := now.Add(-24*time.Hour), now.Add(24*time.Hour)
+			model.DiscountID = &id
+			model.DiscountAmount = big.NewRat(15, 100)
+			model.DiscountStartDate = &start
+			model.DiscountEndDate = &end
+			model.Metadata = []string{"color=black", "warranty=2y"}
+			model.MapPrice = big.NewRat(1800, 100)
+		}
+		models[i] = model
+	}
+	return models
+}
+
+// testRows encodes the models as Spanner rows of the given columns, as ListProducts reads them
+func testRows(tb testing.TB, models []*m_product.Product, columns []string) []*spanner.Row {
+	tb.Helper()
+
+	rows := make([]*spanner.Row, len(models))
+	for i, model := range models {
+		v := reflect.ValueOf(model).Elem()
+		fields := make(map[string]interface{}, v.NumField())
+		for f := range v.NumField() {
+			fields[v.Type().Field(f).Tag.Get("spanner")] = v.Field(f).Interface()
+		}
+		values := make([]interface{}, len(columns))
+		for c, column := range columns {
+			values[c] = fields[column]
+		}
+		row, err := spanner.NewRow(columns, values)
+		if err != nil {
+			tb.Fatalf("Failed to build row: %v", err)
+		}
+		rows[i] = row
+	}
+	return rows
+}
+
+// testItems maps the models to list items the way the read model does
+func testItems(tb testing.TB, models []*m_product.Product) []list_products.ProductItem {
+	tb.Helper()
+
+	items := make([]list_products.ProductItem, len(models))
+	scanner := repo.NewProductItemScanner(m_product.FullColumns())
+	for i, row := range testRows(tb, models, m_product.FullColumns()) {
+		item, err := scanner.Scan(row)
+		if err != nil {
+			tb.Fatalf("Failed to map row: %v", err)
+		}
+		items[i] = item
+	}
+	return items
+}
+
+// pageReadModel serves the same page to every ListProducts call
+type pageReadModel struct {
+	dto *list_products.DTO
+}
+
+func (r *pageReadModel) ListProducts(ctx context.Context, req *list_products.Request) (*list_products.DTO, error) {
+	return r.dto, nil
+}
+
+// mapRows is the row mapping of one ListProducts page, into a page slice as the read model does
+func mapRows(rows []*spanner.Row, columns []string) {
+	items := make([]list_products.ProductItem, 0, len(rows))
+	scanner := repo.NewProductItemScanner(columns)
+	for _, row := range rows {
+		item, err := scanner.Scan(row)
+		if err != nil {
+			panic(err)
+		}
+		items = append(items, item)
+	}
+}
+
+// pricingQuery returns a ListProducts query whose read model serves items, so Execute only does
+// the per-product reconstruction and effective price math; workers is passed on as configured
+func pricingQuery(items []list_products.ProductItem, workers int) (*list_products.Query, *list_products.Request) {
+	readModel := &pageReadModel{dto: &list_products.DTO{Products: items}}
+	query := list_products.NewQuery(readModel, services.NewPricingCalculator(true), clock.NewFixedClock(now), list_products.PageLimits{Default: pageSize, Max: 1000}, workers)
+	return query, &list_products.Request{TenantID: "bench", Limit: len(items)}
+}
+
+// pricedItems returns list items with their effective prices set, as the handler converts them
+func pricedItems(tb testing.TB, models []*m_product.Product) []list_products.ProductItem {
+	tb.Helper()
+
+	query, req := pricingQuery(testItems(tb, models), 0)
+	dto, err := query.Execute(context.Background(), req)
+	if err != nil {
+		tb.Fatalf("Failed to price items: %v", err)
+	}
+	return dto.Products
+}
+
+// mapProtos is the proto conversion of one ListProducts page
+func mapProtos(items []list_products.ProductItem) {
+	for _, item := range items {
+		product.ListProductItemToProto(item)
+	}
+}
+
+func BenchmarkListProductsRowMapping(b *testing.B) {
+	views := []struct {
+		name    string
+		columns []string
+	}{
+		{"full", m_product.FullColumns()},
+		{"basic", m_product.BasicColumns()},
+	}
+	for _, view := range views {
+		for _, size := range []int{pageSize, 100} {
+			b.Run(fmt.Sprintf("view=%s/rows=%d", view.name, size), func(b *testing.B) {
+				rows := testRows(b, testModels(size), view.columns)
+				b.ReportAllocs()
+				for b.Loop() {
+					mapRows(rows, view.columns)
+				}
+			})
+		}
+	}
+}
+
+// BenchmarkListProductsPricing compares serial pricing (workers=1) with the default worker pool;
+// default pages stay serial, while 1000-product pages, as exports read them, are split across workers
+func BenchmarkListProductsPricing(b *testing.B) {
+	for _, size := range []int{pageSize, 1000} {
+		for _, workers := range []int{1, 0} {
+			b.Run(fmt.Sprintf("products=%d/workers=%d", size, workers), func(b *testing.B) {
+				query, req := pricingQuery(testItems(b, testModels(size)), workers)
+				ctx := context.Background()
+				b.ReportAllocs()
+				for b.Loop() {
+					if _, err := query.Execute(ctx, req); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkListProductItemToProto(b *testing.B) {
+	items := pricedItems(b, testModels(pageSize))
+	b.ReportAllocs()
+	for b.Loop() {
+		mapProtos(items)
+	}
+}
+
+func TestAllocationBudgets(t *testing.T) {
+	if raceEnabled {
+		t.Skip("allocation counts are not representative under the race detector")
+	}
+
+	models := testModels(pageSize)
+	rows := testRows(t, models, m_product.FullColumns())
+	query, req := pricingQuery(testItems(t, models), 0)
+	items := pricedItems(t, models)
+	ctx := context.Background()
+
+	paths := []struct {
+		name string
+		run  func()
+	}{
+		{"row_mapping", func() { mapRows(rows, m_product.FullColumns()) }},
+		{"pricing", func() {
+			if _, err := query.Execute(ctx, req); err != nil {
+				t.Fatal(err)
+			}
+		}},
+		{"proto_mapping", func() { mapProtos(items) }},
+	}
+	for _, path := range paths {
+		perProduct := testing.AllocsPerRun(20, path.run) / pageSize
+		if budget := allocBudgets[path.name]; perProduct > budget {
+			t.Errorf("%s: %.1f allocations per product, budget is %.0f", path.name, perProduct, budget)
+		} else {
+			t.Logf("%s: %.1f allocations per product (budget %.0f)", path.name, perProduct, budget)
+		}
+	}
+}
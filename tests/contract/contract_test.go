@@ -0,0 +1,284 @@
+// Package contract pins the wire and JSON encoding of every product API RPC to
+// golden files, so incompatible proto edits fail before they reach consumers
+package contract
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	productv1 "catalog-proj/proto/product/v1"
+	productv2 "catalog-proj/proto/product/v2"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// update regenerates the golden files: go test ./tests/contract -update
+var update = flag.Bool("update", false, "rewrite golden files from the current proto definitions")
+
+// maxDepth stops canonical population of recursive messages
+const maxDepth = 4
+
+// golden is the on-disk contract of one RPC
+type golden struct {
+	Method   string       `json:"method"`
+	Request  goldenSample `json:"request"`
+	Response goldenSample `json:"response"`
+}
+
+// goldenSample is a canonical message in both encodings consumers depend on
+type goldenSample struct {
+	Type string          `json:"type"`
+	JSON json.RawMessage `json:"json"`
+	Wire string          `json:"wire"`
+}
+
+// apiVersions maps each published API version to its proto file; goldens live in testdata/<version>
+var apiVersions = map[string]protoreflect.FileDescriptor{
+	"v1": productv1.File_proto_product_v1_product_service_proto,
+	"v2": productv2.File_proto_product_v2_product_service_proto,
+}
+
+func TestProductServiceContract(t *testing.T) {
+	for version, file := range apiVersions {
+		services := file.Services()
+		for i := 0; i < services.Len(); i++ {
+			methods := services.Get(i).Methods()
+			for j := 0; j < methods.Len(); j++ {
+				method := methods.Get(j)
+				t.Run(version+"/"+string(method.Name()), func(t *testing.T) {
+					checkMethod(t, filepath.Join("testdata", version), method)
+				})
+			}
+		}
+	}
+}
+
+func checkMethod(t *testing.T, dir string, method protoreflect.MethodDescriptor) {
+	path := filepath.Join(dir, string(method.Name())+".json")
+
+	current := golden{
+		Method:   string(method.FullName()),
+		Request:  canonicalSample(t, method.Input()),
+		Response: canonicalSample(t, method.Output()),
+	}
+
+	if *update {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatalf("Failed to create %s: %v", dir, err)
+		}
+		data, err := json.MarshalIndent(current, "", "  ")
+		if err != nil {
+			t.Fatalf("Failed to marshal golden: %v", err)
+		}
+		if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+			t.Fatalf("Failed to write %s: %v", path, err)
+		}
+		return
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Missing golden file %s for new RPC; run go test ./tests/contract -update: %v", path, err)
+	}
+	var want golden
+	if err := json.Unmarshal(data, &want); err != nil {
+		t.Fatalf("Failed to parse %s: %v", path, err)
+	}
+
+	checkSample(t, "request", method.Input(), want.Request, current.Request)
+	checkSample(t, "response", method.Output(), want.Response, current.Response)
+}
+
+// checkSample decodes the golden encodings with the current types; anything that
+// no longer round-trips (renumbered, retyped, renamed or removed fields) is a break
+func checkSample(t *testing.T, kind string, desc protoreflect.MessageDescriptor, want, current goldenSample) {
+	t.Helper()
+
+	if want.Type != string(desc.FullName()) {
+		t.Errorf("%s type changed from %s to %s", kind, want.Type, desc.FullName())
+		return
+	}
+
+	wire, err := base64.StdEncoding.DecodeString(want.Wire)
+	if err != nil {
+		t.Fatalf("Invalid golden %s wire encoding: %v", kind, err)
+	}
+	fromWire := newMessage(t, desc)
+	if err := proto.Unmarshal(wire, fromWire.Interface()); err != nil {
+		t.Errorf("Incompatible %s: golden wire bytes no longer decode: %v", kind, err)
+		return
+	}
+	if unknown := fromWire.GetUnknown(); len(unknown) > 0 {
+		t.Errorf("Incompatible %s: golden wire bytes contain fields the current proto does not know (renumbered or removed field)", kind)
+	}
+	if got := mustJSON(t, fromWire.Interface()); !jsonEqual(t, got, want.JSON) {
+		t.Errorf("Incompatible %s: golden wire bytes decode differently (field renumbered or type changed)\nwant: %s\ngot:  %s", kind, want.JSON, got)
+	}
+
+	fromJSON := newMessage(t, desc)
+	if err := protojson.Unmarshal(want.JSON, fromJSON.Interface()); err != nil {
+		t.Errorf("Incompatible %s: golden JSON no longer parses (field renamed, removed or retyped): %v", kind, err)
+	}
+
+	if !jsonEqual(t, current.JSON, want.JSON) {
+		t.Errorf("Compatible change to %s detected; review it and run go test ./tests/contract -update\nwant: %s\ngot:  %s", kind, want.JSON, current.JSON)
+	}
+}
+
+// canonicalSample builds a deterministic, fully populated message and encodes it
+func canonicalSample(t *testing.T, desc protoreflect.MessageDescriptor) goldenSample {
+	t.Helper()
+
+	msg := newMessage(t, desc)
+	populate(msg, 0)
+
+	wire, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg.Interface())
+	if err != nil {
+		t.Fatalf("Failed to marshal %s: %v", desc.FullName(), err)
+	}
+
+	return goldenSample{
+		Type: string(desc.FullName()),
+		JSON: mustJSON(t, msg.Interface()),
+		Wire: base64.StdEncoding.EncodeToString(wire),
+	}
+}
+
+// populate sets every field (the first member of each oneof) to a value derived
+// from its field number, so a renumbered or retyped field changes the encoding
+func populate(msg protoreflect.Message, depth int) {
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if oneof := fd.ContainingOneof(); oneof != nil && !oneof.IsSynthetic() && oneof.Fields().Get(0) != fd {
+			continue
+		}
+		if fd.Message() != nil && depth >= maxDepth {
+			continue
+		}
+
+		switch {
+		case fd.IsList():
+			list := msg.Mutable(fd).List()
+			if fd.Message() != nil {
+				elem := list.NewElement()
+				populate(elem.Message(), depth+1)
+				list.Append(elem)
+			} else {
+				list.Append(scalarValue(fd))
+			}
+		case fd.IsMap():
+			m := msg.Mutable(fd).Map()
+			key := scalarValue(fd.MapKey()).MapKey()
+			if fd.MapValue().Message() != nil {
+				val := m.NewValue()
+				populate(val.Message(), depth+1)
+				m.Set(key, val)
+			} else {
+				m.Set(key, scalarValue(fd.MapValue()))
+			}
+		case fd.Message() != nil:
+			populateWellKnown(msg.Mutable(fd).Message(), fd, depth)
+		default:
+			msg.Set(fd, scalarValue(fd))
+		}
+	}
+}
+
+// populateWellKnown keeps well-known types within the ranges their JSON mapping accepts
+func populateWellKnown(msg protoreflect.Message, fd protoreflect.FieldDescriptor, depth int) {
+	switch msg.Descriptor().FullName() {
+	case "google.protobuf.Timestamp", "google.protobuf.Duration":
+		fields := msg.Descriptor().Fields()
+		msg.Set(fields.ByName("seconds"), protoreflect.ValueOfInt64(1700000000+int64(fd.Number())))
+		msg.Set(fields.ByName("nanos"), protoreflect.ValueOfInt32(int32(fd.Number())*1000))
+	case "google.protobuf.FieldMask":
+		// Paths must survive the lowerCamelCase JSON mapping
+		paths := msg.Mutable(msg.Descriptor().Fields().ByName("paths")).List()
+		paths.Append(protoreflect.ValueOfString(fmt.Sprintf("field%d.path", fd.Number())))
+	default:
+		populate(msg, depth+1)
+	}
+}
+
+// scalarValue derives a deterministic value of the field's kind from its number
+func scalarValue(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	n := int64(fd.Number())
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return protoreflect.ValueOfBool(true)
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		return protoreflect.ValueOfEnum(values.Get(values.Len() - 1).Number())
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return protoreflect.ValueOfInt32(int32(n))
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return protoreflect.ValueOfInt64(n)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return protoreflect.ValueOfUint32(uint32(n))
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return protoreflect.ValueOfUint64(uint64(n))
+	case protoreflect.FloatKind:
+		return protoreflect.ValueOfFloat32(float32(n) + 0.5)
+	case protoreflect.DoubleKind:
+		return protoreflect.ValueOfFloat64(float64(n) + 0.5)
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes([]byte(fmt.Sprintf("%s-%d", fd.Name(), n)))
+	default:
+		return protoreflect.ValueOfString(fmt.Sprintf("%s-%d", fd.Name(), n))
+	}
+}
+
+// newMessage instantiates the generated Go type for desc
+func newMessage(t *testing.T, desc protoreflect.MessageDescriptor) protoreflect.Message {
+	t.Helper()
+
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(desc.FullName())
+	if err != nil {
+		t.Fatalf("Message type %s is not registered: %v", desc.FullName(), err)
+	}
+	return mt.New()
+}
+
+// mustJSON renders msg with the proto field names the golden files are keyed by
+func mustJSON(t *testing.T, msg proto.Message) json.RawMessage {
+	t.Helper()
+
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+	if err != nil {
+		t.Fatalf("Failed to marshal JSON: %v", err)
+	}
+	// protojson output is intentionally unstable; normalize it for the golden files
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		t.Fatalf("Failed to normalize JSON: %v", err)
+	}
+	normalized, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Failed to normalize JSON: %v", err)
+	}
+	return normalized
+}
+
+// jsonEqual compares two JSON documents structurally
+func jsonEqual(t *testing.T, a, b json.RawMessage) bool {
+	t.Helper()
+
+	var va, vb interface{}
+	if err := json.Unmarshal(a, &va); err != nil {
+		t.Fatalf("Failed to parse JSON: %v", err)
+	}
+	if err := json.Unmarshal(b, &vb); err != nil {
+		t.Fatalf("Failed to parse JSON: %v", err)
+	}
+	return reflect.DeepEqual(va, vb)
+}
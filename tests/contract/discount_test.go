@@ -0,0 +1,80 @@
+package contract
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"catalog-proj/internal/app/product/domain"
+	"catalog-proj/internal/transport/grpc/product"
+	productv1 "catalog-proj/proto/product/v1"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TestDiscountPercentRoundTrip pins how discount percentages map between the API and the domain fraction
+func TestDiscountPercentRoundTrip(t *testing.T) {
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(30 * 24 * time.Hour)
+
+	cases := []struct {
+		name        string
+		discount    *productv1.Discount
+		wantAmount  *big.Rat
+		wantBPS     int32
+		wantPercent int64
+	}{
+		{"basis points", &productv1.Discount{PercentBasisPoints: proto.Int32(2000)}, big.NewRat(1, 5), 2000, 20},
+		{"fractional percent", &productv1.Discount{PercentBasisPoints: proto.Int32(1250)}, big.NewRat(1, 8), 1250, 13},
+		{"zero", &productv1.Discount{PercentBasisPoints: proto.Int32(0)}, big.NewRat(0, 1), 0, 0},
+		{"full", &productv1.Discount{PercentBasisPoints: proto.Int32(10000)}, big.NewRat(1, 1), 10000, 100},
+		{"legacy whole percent", &productv1.Discount{Amount: &productv1.Money{Amount: 20}}, big.NewRat(1, 5), 2000, 20},
+		{"basis points win over amount", &productv1.Discount{PercentBasisPoints: proto.Int32(500), Amount: &productv1.Money{Amount: 20}}, big.NewRat(1, 20), 500, 5},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.discount.Id = "d-1"
+			tc.discount.StartDate = timestamppb.New(start)
+			tc.discount.EndDate = timestamppb.New(end)
+
+			d := product.ProtoDiscountToDomain(tc.discount)
+			if err := d.Validate(); err != nil {
+				t.Fatalf("Expected a valid domain discount, got %v", err)
+			}
+			if got := (*big.Rat)(*d.Amount); got.Cmp(tc.wantAmount) != 0 {
+				t.Errorf("Expected domain amount %s, got %s", tc.wantAmount.RatString(), got.RatString())
+			}
+
+			out := product.DomainDiscountToProto(d)
+			if out.GetPercentBasisPoints() != tc.wantBPS {
+				t.Errorf("Expected %d basis points, got %d", tc.wantBPS, out.GetPercentBasisPoints())
+			}
+			if out.GetAmount().GetAmount() != tc.wantPercent {
+				t.Errorf("Expected legacy amount %d, got %d", tc.wantPercent, out.GetAmount().GetAmount())
+			}
+			if !out.StartDate.AsTime().Equal(start) || !out.EndDate.AsTime().Equal(end) {
+				t.Errorf("Expected dates to round-trip, got %v - %v", out.StartDate.AsTime(), out.EndDate.AsTime())
+			}
+
+			// Feeding the response back in is stable
+			again := product.ProtoDiscountToDomain(out)
+			if (*big.Rat)(*again.Amount).Cmp(tc.wantAmount) != 0 {
+				t.Errorf("Expected a stable round trip, got %s", (*big.Rat)(*again.Amount).RatString())
+			}
+		})
+	}
+}
+
+// TestDiscountBasisPointsRounding pins rounding of stored fractions finer than a basis point
+func TestDiscountBasisPointsRounding(t *testing.T) {
+	amount := domain.NewMoneyFromFraction(1, 3)
+	if got := product.DiscountAmountToBasisPoints(amount); got != 3333 {
+		t.Errorf("Expected 1/3 to round to 3333 basis points, got %d", got)
+	}
+	amount = domain.NewMoneyFromFraction(1, 20000)
+	if got := product.DiscountAmountToBasisPoints(amount); got != 1 {
+		t.Errorf("Expected half a basis point to round up, got %d", got)
+	}
+}
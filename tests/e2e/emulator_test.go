@@ -0,0 +1,156 @@
+package e2e
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	// defaultEmulatorHost is where `docker compose up -d` exposes the emulator
+	defaultEmulatorHost = "localhost:9010"
+	// emulatorBinEnv overrides the emulator binary lookup
+	emulatorBinEnv = "SPANNER_EMULATOR_BIN"
+	// emulatorStartTimeout bounds how long we wait for a spawned emulator to accept connections
+	emulatorStartTimeout = 15 * time.Second
+)
+
+// emulatorUnavailable is set by TestMain when no emulator could be found or started;
+// setupTest skips with this message instead of timing out against a dead endpoint
+var emulatorUnavailable error
+
+// TestMain points the suite at a Spanner emulator, starting one if needed:
+//  1. SPANNER_EMULATOR_HOST, when set, is used as-is
+//  2. an emulator already listening on localhost:9010 (docker compose) is reused
+//  3. otherwise the emulator binary is started on a free port and stopped afterwards
+func TestMain(m *testing.M) {
+	stop, err := ensureEmulator()
+	if err != nil {
+		emulatorUnavailable = err
+		fmt.Fprintf(os.Stderr, "e2e: skipping tests, no Spanner emulator available: %v\n", err)
+	}
+
+	code := m.Run()
+	pool.close()
+	stop()
+	os.Exit(code)
+}
+
+// requireEmulator skips the test when TestMain could not provide an emulator
+func requireEmulator(t *testing.T) {
+	t.Helper()
+	if emulatorUnavailable != nil {
+		t.Skipf("no Spanner emulator available: %v", emulatorUnavailable)
+	}
+}
+
+// ensureEmulator makes SPANNER_EMULATOR_HOST point at a reachable emulator and
+// returns a function that stops anything it started
+func ensureEmulator() (func(), error) {
+	noop := func() {}
+
+	if host := os.Getenv("SPANNER_EMULATOR_HOST"); host != "" {
+		return noop, nil
+	}
+
+	if canDial(defaultEmulatorHost) {
+		os.Setenv("SPANNER_EMULATOR_HOST", defaultEmulatorHost)
+		return noop, nil
+	}
+
+	bin, err := findEmulatorBinary()
+	if err != nil {
+		return noop, err
+	}
+
+	host, err := freeLocalAddr()
+	if err != nil {
+		return noop, fmt.Errorf("failed to pick a port for the emulator: %w", err)
+	}
+
+	var output bytes.Buffer
+	cmd := exec.Command(bin, "--host_port", host)
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+	if err := cmd.Start(); err != nil {
+		return noop, fmt.Errorf("failed to start %s: %w", bin, err)
+	}
+
+	exited := make(chan error, 1)
+	go func() { exited <- cmd.Wait() }()
+
+	stop := func() {
+		_ = cmd.Process.Kill()
+		<-exited
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), emulatorStartTimeout)
+	defer cancel()
+	for !canDial(host) {
+		select {
+		case err := <-exited:
+			return noop, fmt.Errorf("emulator %s exited during startup: %v\n%s", bin, err, output.String())
+		case <-ctx.Done():
+			stop()
+			return noop, fmt.Errorf("emulator %s did not accept connections on %s within %s", bin, host, emulatorStartTimeout)
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+
+	os.Setenv("SPANNER_EMULATOR_HOST", host)
+	return stop, nil
+}
+
+// findEmulatorBinary locates emulator_main via SPANNER_EMULATOR_BIN, PATH, or the gcloud SDK
+func findEmulatorBinary() (string, error) {
+	if bin := os.Getenv(emulatorBinEnv); bin != "" {
+		if _, err := os.Stat(bin); err != nil {
+			return "", fmt.Errorf("%s=%s: %w", emulatorBinEnv, bin, err)
+		}
+		return bin, nil
+	}
+
+	if bin, err := exec.LookPath("emulator_main"); err == nil {
+		return bin, nil
+	}
+
+	if gcloud, err := exec.LookPath("gcloud"); err == nil {
+		out, err := exec.Command(gcloud, "info", "--format=value(installation.sdk_root)").Output()
+		if err == nil {
+			bin := filepath.Join(strings.TrimSpace(string(out)), "bin", "cloud_spanner_emulator", "emulator_main")
+			if _, err := os.Stat(bin); err == nil {
+				return bin, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("set SPANNER_EMULATOR_HOST, run `docker compose up -d`, " +
+		"install it with `gcloud components install cloud-spanner-emulator`, or set " + emulatorBinEnv)
+}
+
+// freeLocalAddr reserves and releases a loopback port for the emulator to bind
+func freeLocalAddr() (string, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", err
+	}
+	defer l.Close()
+	return l.Addr().String(), nil
+}
+
+// canDial reports whether something accepts TCP connections on addr
+func canDial(addr string) bool {
+	conn, err := net.DialTimeout("tcp", addr, 200*time.Millisecond)
+	if err != nil {
+		return false
+	}
+	conn.Close()
+	return true
+}
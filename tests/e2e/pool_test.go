@@ -0,0 +1,256 @@
+package e2e
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"runtime"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"catalog-proj/internal/models/m_api_key"
+	"catalog-proj/internal/models/m_api_usage"
+	"catalog-proj/internal/models/m_category_template"
+	"catalog-proj/internal/models/m_curated_list"
+	"catalog-proj/internal/models/m_external_ref"
+	"catalog-proj/internal/models/m_job"
+	"catalog-proj/internal/models/m_merch_rule"
+	"catalog-proj/internal/models/m_operation"
+	"catalog-proj/internal/models/m_outbox"
+	"catalog-proj/internal/models/m_outbox_cursor"
+	"catalog-proj/internal/models/m_pending_change"
+	"catalog-proj/internal/models/m_processed_event"
+	"catalog-proj/internal/models/m_product"
+	"catalog-proj/internal/models/m_product_alias"
+	"catalog-proj/internal/models/m_product_count"
+	"catalog-proj/internal/models/m_product_draft"
+	"catalog-proj/internal/models/m_product_suggestion"
+	"catalog-proj/internal/models/m_product_trend"
+	"catalog-proj/internal/models/m_product_version"
+	"catalog-proj/internal/models/m_product_view"
+	"catalog-proj/internal/models/m_tenant"
+	"catalog-proj/internal/models/m_tenant_setting"
+	"catalog-proj/internal/pkg/config"
+	"catalog-proj/internal/services"
+
+	"cloud.google.com/go/spanner"
+	admin "cloud.google.com/go/spanner/admin/database/apiv1"
+	"cloud.google.com/go/spanner/admin/database/apiv1/databasepb"
+	instanceadmin "cloud.google.com/go/spanner/admin/instance/apiv1"
+	"cloud.google.com/go/spanner/admin/instance/apiv1/instancepb"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// poolSizeEnv overrides the number of pre-created databases (defaults to -test.parallel)
+	poolSizeEnv = "E2E_DB_POOL_SIZE"
+	// poolInitTimeout bounds instance + database creation and migrations for the whole pool
+	poolInitTimeout = 2 * time.Minute
+)
+
+// pooledTables are emptied when a database is returned to the pool
+var pooledTables = []string{m_product.TableName, m_outbox.TableName, m_operation.TableName, m_job.TableName, m_processed_event.TableName, m_outbox_cursor.TableName, m_product_count.TableName, m_product_alias.TableName, m_external_ref.TableName, m_pending_change.TableName, m_merch_rule.TableName, m_product_suggestion.TableName, m_product_view.TableName, m_product_trend.TableName, m_curated_list.TableName, m_api_key.TableName, m_api_usage.TableName, m_product_version.TableName, m_product_draft.TableName, m_category_template.TableName, m_tenant_setting.TableName, m_tenant.TableName}
+
+// pool is shared by all tests; databases are created on the first lease and dropped by TestMain
+var pool = &dbPool{}
+
+// pooledDatabase is a migrated database with long-lived clients, reused across tests
+type pooledDatabase struct {
+	name   string
+	client *spanner.Client
+	opts   *services.Options
+}
+
+// dbPool hands out one migrated database per test so tests can run with t.Parallel()
+type dbPool struct {
+	once    sync.Once
+	initErr error
+	admin   *admin.DatabaseAdminClient
+	all     []*pooledDatabase
+	free    chan *pooledDatabase
+}
+
+// lease takes a database from the pool for the duration of the test; it is
+// truncated and handed back to the pool when the test finishes
+func (p *dbPool) lease(t *testing.T) *pooledDatabase {
+	t.Helper()
+
+	p.once.Do(func() { p.initErr = p.init(poolSize()) })
+	if p.initErr != nil {
+		t.Fatalf("Failed to initialize database pool: %v", p.initErr)
+	}
+
+	db := <-p.free
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := db.truncate(ctx); err != nil {
+			t.Errorf("Failed to truncate pooled database %s: %v", db.name, err)
+		}
+		p.free <- db
+	})
+	return db
+}
+
+// init ensures the test instance exists and creates size migrated databases concurrently
+func (p *dbPool) init(size int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), poolInitTimeout)
+	defer cancel()
+
+	adminClient, err := admin.NewDatabaseAdminClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create admin client: %w", err)
+	}
+	p.admin = adminClient
+
+	if err := ensureInstance(ctx); err != nil {
+		return err
+	}
+
+	statements, err := migrationStatements()
+	if err != nil {
+		return err
+	}
+
+	dbs := make([]*pooledDatabase, size)
+	errs := make([]error, size)
+	var wg sync.WaitGroup
+	for i := range dbs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			dbs[i], errs[i] = p.createDatabase(ctx, statements)
+		}(i)
+	}
+	wg.Wait()
+
+	p.free = make(chan *pooledDatabase, size)
+	for i, db := range dbs {
+		if db != nil {
+			p.all = append(p.all, db)
+			p.free <- db
+		}
+		if errs[i] != nil {
+			err = errs[i]
+		}
+	}
+	if ctx.Err() == context.DeadlineExceeded {
+		return fmt.Errorf("timeout creating %d databases. Is the emulator at %s healthy?", size, os.Getenv("SPANNER_EMULATOR_HOST"))
+	}
+	return err
+}
+
+// createDatabase creates one database with the schema applied and opens its clients
+func (p *dbPool) createDatabase(ctx context.Context, statements []string) (*pooledDatabase, error) {
+	dbName := fmt.Sprintf("test-db-%s", uuid.New().String()[:8])
+
+	op, err := p.admin.CreateDatabase(ctx, &databasepb.CreateDatabaseRequest{
+		Parent:          fmt.Sprintf("projects/%s/instances/%s", testProject, testInstance),
+		CreateStatement: fmt.Sprintf("CREATE DATABASE `%s`", dbName),
+		ExtraStatements: statements,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create database: %w", err)
+	}
+	db, err := op.Wait(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for database creation: %w", err)
+	}
+
+	// Clients outlive the init context, so they get a background one
+	client, err := spanner.NewClient(context.Background(), db.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Spanner client: %w", err)
+	}
+
+	cfg := config.Default()
+	cfg.Spanner.Database = db.Name
+	opts, err := services.NewOptions(context.Background(), cfg)
+	if err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to create service options: %w", err)
+	}
+
+	return &pooledDatabase{name: db.Name, client: client, opts: opts}, nil
+}
+
+// close releases all clients and drops every database the pool created
+func (p *dbPool) close() {
+	if p.admin == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	for _, db := range p.all {
+		db.opts.Close()
+		db.client.Close()
+		if err := p.admin.DropDatabase(ctx, &databasepb.DropDatabaseRequest{Database: db.name}); err != nil {
+			fmt.Fprintf(os.Stderr, "e2e: failed to drop database %s: %v\n", db.name, err)
+		}
+	}
+	p.admin.Close()
+}
+
+// truncate deletes all rows so the next lease starts from an empty schema
+func (db *pooledDatabase) truncate(ctx context.Context) error {
+	mutations := make([]*spanner.Mutation, 0, len(pooledTables))
+	for _, table := range pooledTables {
+		mutations = append(mutations, spanner.Delete(table, spanner.AllKeys()))
+	}
+	_, err := db.client.Apply(ctx, mutations)
+	return err
+}
+
+// ensureInstance creates the emulator test instance if it does not exist yet
+func ensureInstance(ctx context.Context) error {
+	instanceAdminClient, err := instanceadmin.NewInstanceAdminClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create instance admin client: %w", err)
+	}
+	defer instanceAdminClient.Close()
+
+	instanceName := fmt.Sprintf("projects/%s/instances/%s", testProject, testInstance)
+	_, err = instanceAdminClient.GetInstance(ctx, &instancepb.GetInstanceRequest{Name: instanceName})
+	if err == nil {
+		return nil
+	}
+	if st, ok := status.FromError(err); !ok || st.Code() != codes.NotFound {
+		return fmt.Errorf("failed to check instance existence: %w", err)
+	}
+
+	op, err := instanceAdminClient.CreateInstance(ctx, &instancepb.CreateInstanceRequest{
+		Parent:     fmt.Sprintf("projects/%s", testProject),
+		InstanceId: testInstance,
+		Instance: &instancepb.Instance{
+			DisplayName: testInstance,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create instance: %w", err)
+	}
+	if _, err := op.Wait(ctx); err != nil {
+		return fmt.Errorf("failed to wait for instance creation: %w", err)
+	}
+	return nil
+}
+
+// poolSize returns E2E_DB_POOL_SIZE if set, otherwise the -test.parallel value
+func poolSize() int {
+	if v := os.Getenv(poolSizeEnv); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			return n
+		}
+	}
+	if f := flag.Lookup("test.parallel"); f != nil {
+		if n, err := strconv.Atoi(f.Value.String()); err == nil && n > 0 {
+			return n
+		}
+	}
+	return runtime.GOMAXPROCS(0)
+}
@@ -1,50 +1,133 @@
 package e2e
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"expvar"
 	"fmt"
+	"io"
+	"maps"
 	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
 	"strings"
 	"testing"
 	"time"
 
+	"catalog-proj/internal/app/product/contracts"
 	"catalog-proj/internal/app/product/domain"
 	domainServices "catalog-proj/internal/app/product/domain/services"
+	"catalog-proj/internal/app/product/queries/find_similar_products"
 	"catalog-proj/internal/app/product/queries/get_product"
+	"catalog-proj/internal/app/product/queries/get_product_by_external_ref"
+	"catalog-proj/internal/app/product/queries/get_product_history"
+	"catalog-proj/internal/app/product/queries/get_product_json_ld"
+	"catalog-proj/internal/app/product/queries/get_product_stats"
+	"catalog-proj/internal/app/product/queries/get_recommendations"
+	"catalog-proj/internal/app/product/queries/list_curated_products"
+	"catalog-proj/internal/app/product/queries/list_product_versions"
 	"catalog-proj/internal/app/product/queries/list_products"
+	"catalog-proj/internal/app/product/queries/search_products"
+	"catalog-proj/internal/app/product/queries/suggest_products"
+	"catalog-proj/internal/app/product/queries/validate_product"
 	"catalog-proj/internal/app/product/repo"
+	"catalog-proj/internal/app/product/tenant_config"
+	"catalog-proj/internal/app/product/unit_of_work"
 	"catalog-proj/internal/app/product/usecases/activate_product"
 	"catalog-proj/internal/app/product/usecases/apply_discount"
 	"catalog-proj/internal/app/product/usecases/archive_product"
+	"catalog-proj/internal/app/product/usecases/batch_transition"
+	"catalog-proj/internal/app/product/usecases/change_base_price"
+	"catalog-proj/internal/app/product/usecases/clone_product"
+	"catalog-proj/internal/app/product/usecases/create_merch_rule"
 	"catalog-proj/internal/app/product/usecases/create_product"
+	"catalog-proj/internal/app/product/usecases/create_tenant"
 	"catalog-proj/internal/app/product/usecases/deactivate_product"
+	"catalog-proj/internal/app/product/usecases/decide_change"
+	"catalog-proj/internal/app/product/usecases/delete_merch_rule"
+	"catalog-proj/internal/app/product/usecases/delete_tenant"
+	"catalog-proj/internal/app/product/usecases/discard_draft"
+	"catalog-proj/internal/app/product/usecases/generate_product_feeds"
+	"catalog-proj/internal/app/product/usecases/link_external_ref"
+	"catalog-proj/internal/app/product/usecases/merge_products"
+	"catalog-proj/internal/app/product/usecases/publish_draft"
+	"catalog-proj/internal/app/product/usecases/purge_cdn_cache"
+	"catalog-proj/internal/app/product/usecases/reassign_category"
+	"catalog-proj/internal/app/product/usecases/rebuild_projection"
+	"catalog-proj/internal/app/product/usecases/record_product_view"
+	"catalog-proj/internal/app/product/usecases/refresh_curated_lists"
+	"catalog-proj/internal/app/product/usecases/refresh_product_counts"
+	"catalog-proj/internal/app/product/usecases/refresh_product_suggestions"
 	"catalog-proj/internal/app/product/usecases/remove_discount"
+	"catalog-proj/internal/app/product/usecases/review_product"
+	"catalog-proj/internal/app/product/usecases/rollback_to_version"
+	"catalog-proj/internal/app/product/usecases/save_draft"
+	"catalog-proj/internal/app/product/usecases/set_channels"
+	"catalog-proj/internal/app/product/usecases/set_metadata"
+	"catalog-proj/internal/app/product/usecases/set_price_floor"
+	"catalog-proj/internal/app/product/usecases/sync_search_index"
+	"catalog-proj/internal/app/product/usecases/unlink_external_ref"
 	"catalog-proj/internal/app/product/usecases/update_product"
 	"catalog-proj/internal/models/m_outbox"
+	"catalog-proj/internal/models/m_processed_event"
 	"catalog-proj/internal/models/m_product"
+	"catalog-proj/internal/models/m_product_view"
+	"catalog-proj/internal/pkg/apikey"
+	"catalog-proj/internal/pkg/breaker"
 	"catalog-proj/internal/pkg/clock"
+	"catalog-proj/internal/pkg/coalesce"
+	"catalog-proj/internal/pkg/committer"
+	"catalog-proj/internal/pkg/faults"
+	"catalog-proj/internal/pkg/featureflags"
+	"catalog-proj/internal/pkg/grpcweb"
+	"catalog-proj/internal/pkg/idgen"
+	"catalog-proj/internal/pkg/inbox"
+	"catalog-proj/internal/pkg/metrics"
+	"catalog-proj/internal/pkg/preview"
+	"catalog-proj/internal/pkg/retry"
+	"catalog-proj/internal/pkg/shadow"
+	"catalog-proj/internal/pkg/tenant"
+	"catalog-proj/internal/pkg/usage"
 	"catalog-proj/internal/services"
+	"catalog-proj/internal/transport/gateway"
+	pb "catalog-proj/proto/product/v1"
 
+	"github.com/wuyiadepoju/commitplan"
 	spannerdriver "github.com/wuyiadepoju/commitplan/drivers/spanner"
 
+	"cloud.google.com/go/longrunning/autogen/longrunningpb"
 	"cloud.google.com/go/spanner"
-	admin "cloud.google.com/go/spanner/admin/database/apiv1"
-	"cloud.google.com/go/spanner/admin/database/apiv1/databasepb"
-	instanceadmin "cloud.google.com/go/spanner/admin/instance/apiv1"
-	"cloud.google.com/go/spanner/admin/instance/apiv1/instancepb"
-	"github.com/google/uuid"
 	"google.golang.org/api/iterator"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 const (
 	testProject  = "test-project"
 	testInstance = "test-instance"
-	emulatorHost = "localhost:9010"
 	// baseDiscountDate is the minimum date for discounts (2026-02-25T00:00:00Z)
 	baseDiscountDateStr = "2026-02-25T00:00:00Z"
+	// uniqueNameTenant enforces unique product names per category in the test setup
+	uniqueNameTenant = "unique-names"
+	// priceApprovalThresholdPercent holds back base price changes of more than 10% in the test setup
+	priceApprovalThresholdPercent = 10
+	// exactSearchTenant is excluded from the search_fuzzy flag in the test setup
+	exactSearchTenant = "exact-search"
 )
 
 // getDiscountTime returns a time that is at least baseDiscountDate
@@ -63,7 +146,6 @@ type testSetup struct {
 	cancel            context.CancelFunc
 	database          string
 	spannerClient     *spanner.Client
-	adminClient       *admin.DatabaseAdminClient
 	opts              *services.Options
 	createProduct     *create_product.Interactor
 	updateProduct     *update_product.Interactor
@@ -74,135 +156,117 @@ type testSetup struct {
 	archiveProduct    *archive_product.Interactor
 	getProductQuery   *get_product.Query
 	listProductsQuery *list_products.Query
+	validateProduct   *validate_product.Query
+	reviewProduct     *review_product.Interactor
+	productHistory    *get_product_history.Query
+	setChannels       *set_channels.Interactor
+	batchTransition   *batch_transition.Interactor
+	mergeProducts     *merge_products.Interactor
+	setMetadata       *set_metadata.Interactor
+	linkExternalRef   *link_external_ref.Interactor
+	unlinkExternalRef *unlink_external_ref.Interactor
+	productByRef      *get_product_by_external_ref.Query
+	changeBasePrice   *change_base_price.Interactor
+	decideChange      *decide_change.Interactor
+	setPriceFloor     *set_price_floor.Interactor
+	searchProducts    *search_products.Query
+	createMerchRule   *create_merch_rule.Interactor
+	deleteMerchRule   *delete_merch_rule.Interactor
+	productVersions   *list_product_versions.Query
+	rollbackToVersion *rollback_to_version.Interactor
+	saveDraft         *save_draft.Interactor
+	publishDraft      *publish_draft.Interactor
+	discardDraft      *discard_draft.Interactor
 }
 
-// setupTest creates a test database and initializes all dependencies
+// setupTest leases a database from the pool and initializes all dependencies
 func setupTest(t *testing.T) *testSetup {
-	// Create context with timeout for setup operations to prevent hanging
-	setupCtx, setupCancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer setupCancel()
+	requireEmulator(t)
 
-	// Set emulator host
-	os.Setenv("SPANNER_EMULATOR_HOST", emulatorHost)
-	defer os.Unsetenv("SPANNER_EMULATOR_HOST")
+	db := pool.lease(t)
 
-	// Create unique database name for this test
-	dbName := fmt.Sprintf("test-db-%s", uuid.New().String()[:8])
-	database := fmt.Sprintf("projects/%s/instances/%s/databases/%s", testProject, testInstance, dbName)
-
-	// Create admin client with timeout context
-	adminClient, err := admin.NewDatabaseAdminClient(setupCtx)
-	if err != nil {
-		t.Fatalf("Failed to create admin client: %v. Make sure Spanner emulator is running (docker compose up -d)", err)
-	}
-
-	// Create instance if it doesn't exist (for emulator)
-	instanceName := fmt.Sprintf("projects/%s/instances/%s", testProject, testInstance)
-	projectName := fmt.Sprintf("projects/%s", testProject)
-
-	instanceAdminClient, err := instanceadmin.NewInstanceAdminClient(setupCtx)
-	if err != nil {
-		t.Fatalf("Failed to create instance admin client: %v", err)
-	}
-	defer instanceAdminClient.Close()
-
-	_, err = instanceAdminClient.GetInstance(setupCtx, &instancepb.GetInstanceRequest{
-		Name: instanceName,
-	})
-	if err != nil {
-		if st, ok := status.FromError(err); ok && st.Code() == codes.NotFound {
-			// Instance doesn't exist, create it
-			op, err := instanceAdminClient.CreateInstance(setupCtx, &instancepb.CreateInstanceRequest{
-				Parent:     projectName,
-				InstanceId: testInstance,
-				Instance: &instancepb.Instance{
-					DisplayName: testInstance,
-				},
-			})
-			if err != nil {
-				t.Fatalf("Failed to create instance: %v", err)
-			}
-			_, err = op.Wait(setupCtx)
-			if err != nil {
-				if setupCtx.Err() == context.DeadlineExceeded {
-					t.Fatalf("Timeout waiting for instance creation. Is Spanner emulator running? (docker compose up -d)")
-				}
-				t.Fatalf("Failed to wait for instance creation: %v", err)
-			}
-		} else {
-			t.Fatalf("Failed to check instance existence: %v", err)
-		}
-	}
-
-	// Create database
-	op, err := adminClient.CreateDatabase(setupCtx, &databasepb.CreateDatabaseRequest{
-		Parent:          instanceName,
-		CreateStatement: fmt.Sprintf("CREATE DATABASE `%s`", dbName),
-	})
-	if err != nil {
-		t.Fatalf("Failed to create database: %v", err)
-	}
-
-	// Wait for database creation with timeout
-	db, err := op.Wait(setupCtx)
-	if err != nil {
-		if setupCtx.Err() == context.DeadlineExceeded {
-			t.Fatalf("Timeout waiting for database creation. Is Spanner emulator running? (docker compose up -d)")
-		}
-		t.Fatalf("Failed to wait for database creation: %v", err)
-	}
-	database = db.Name
-
-	// Run migrations
-	if err := runMigrations(setupCtx, adminClient, database); err != nil {
-		t.Fatalf("Failed to run migrations: %v", err)
-	}
-
-	// Create a background context for test execution (not canceled when setup returns)
+	// Create a background context for test execution
 	ctx, cancel := context.WithCancel(context.Background())
 
-	// Create Spanner client
-	spannerClient, err := spanner.NewClient(ctx, database)
-	if err != nil {
-		cancel()
-		t.Fatalf("Failed to create Spanner client: %v", err)
-	}
-
-	// Create service options to get all dependencies
-	opts, err := services.NewOptions(ctx, database)
-	if err != nil {
-		cancel()
-		t.Fatalf("Failed to create service options: %v", err)
-	}
-
 	// Create use cases and queries directly (same as in services.NewOptions)
 	// We'll recreate them here for direct access in tests
+	spannerClient := db.client
 	clock := clock.NewRealClock()
 	spannerCommitter := spannerdriver.NewCommitter(spannerClient)
 	productRepo := repo.NewSpannerProductRepository(spannerClient)
-	spannerReadModel := repo.NewSpannerReadModel(spannerClient)
-	pricingCalculator := domainServices.NewPricingCalculator()
-
-	createProductUC := create_product.NewInteractor(productRepo, spannerCommitter, clock)
-	updateProductUC := update_product.NewInteractor(productRepo, spannerCommitter, clock)
-	applyDiscountUC := apply_discount.NewInteractor(productRepo, spannerCommitter, clock)
+	spannerReadModel := repo.NewSpannerReadModel(spannerClient, 0)
+	pricingCalculator := domainServices.NewPricingCalculator(true)
+
+	quotaCounter := repo.NewSpannerQuotaCounter(spannerClient)
+	quotaPolicy := domainServices.NewQuotaPolicy(domainServices.QuotaLimits{})
+
+	var readModelForSimilar find_similar_products.ReadModel = spannerReadModel
+	findSimilarProductsQ := find_similar_products.NewQuery(readModelForSimilar)
+
+	namePolicy := domainServices.NewUniqueNamePolicy([]string{uniqueNameTenant})
+	nameLookup := repo.NewSpannerNameLookup(spannerClient)
+	validationRules := testValidationRules(t)
+
+	versionStore := repo.NewSpannerVersionStore(spannerClient)
+	tenantConfig := tenant_config.NewTenantConfig(repo.NewSpannerTenantSettingsStore(spannerClient), domain.TenantSettings{
+		DefaultCurrency:        "USD",
+		MaxNameLength:          domain.MaxProductNameLength,
+		MaxDiscountBasisPoints: domain.MaxDiscountBasisPoints,
+	}, clock, 0)
+	draftStore := repo.NewSpannerDraftStore(spannerClient)
+
+	createProductUC := create_product.NewInteractor(productRepo, versionStore, spannerCommitter, clock, quotaCounter, quotaPolicy, findSimilarProductsQ, namePolicy, nameLookup, validationRules, idgen.NewRandom(), tenantConfig)
+	updateProductUC := update_product.NewInteractor(productRepo, versionStore, spannerCommitter, clock, namePolicy, nameLookup, validationRules, tenantConfig)
+	applyDiscountUC := apply_discount.NewInteractor(productRepo, spannerCommitter, clock, quotaCounter, quotaPolicy, tenantConfig)
 	removeDiscountUC := remove_discount.NewInteractor(productRepo, spannerCommitter, clock)
 	activateProductUC := activate_product.NewInteractor(productRepo, spannerCommitter, clock)
 	deactivateProductUC := deactivate_product.NewInteractor(productRepo, spannerCommitter, clock)
 	archiveProductUC := archive_product.NewInteractor(productRepo, spannerCommitter, clock)
+	reviewProductUC := review_product.NewInteractor(productRepo, spannerCommitter, clock)
+	setChannelsUC := set_channels.NewInteractor(productRepo, spannerCommitter, clock)
+	batchTransitionUC := batch_transition.NewInteractor(productRepo, spannerCommitter, clock)
+	aliasStore := repo.NewSpannerAliasStore(spannerClient)
+	mergeProductsUC := merge_products.NewInteractor(productRepo, aliasStore, spannerCommitter, clock)
+	setMetadataUC := set_metadata.NewInteractor(productRepo, versionStore, spannerCommitter, clock)
+	externalRefStore := repo.NewSpannerExternalRefStore(spannerClient)
+	linkExternalRefUC := link_external_ref.NewInteractor(productRepo, externalRefStore, spannerCommitter, clock)
+	unlinkExternalRefUC := unlink_external_ref.NewInteractor(productRepo, externalRefStore, spannerCommitter, clock)
+	pendingChangeStore := repo.NewSpannerPendingChangeStore(spannerClient)
+	changeBasePriceUC := change_base_price.NewInteractor(productRepo, pendingChangeStore, domainServices.NewPriceApprovalPolicy(priceApprovalThresholdPercent), spannerCommitter, clock, idgen.NewRandom())
+	decideChangeUC := decide_change.NewInteractor(productRepo, pendingChangeStore, clock)
+	setPriceFloorUC := set_price_floor.NewInteractor(productRepo, spannerCommitter, clock)
+	merchRuleStore := repo.NewSpannerMerchRuleStore(spannerClient)
+	createMerchRuleUC := create_merch_rule.NewInteractor(productRepo, merchRuleStore, spannerCommitter, clock)
+	deleteMerchRuleUC := delete_merch_rule.NewInteractor(merchRuleStore, spannerCommitter)
 
 	var readModelForGet get_product.ReadModel = spannerReadModel
 	var readModelForList list_products.ReadModel = spannerReadModel
-	getProductQ := get_product.NewQuery(readModelForGet, pricingCalculator, clock)
-	listProductsQ := list_products.NewQuery(readModelForList, pricingCalculator, clock)
+	getProductQ := get_product.NewQuery(readModelForGet, aliasStore, draftStore, pricingCalculator, clock)
+	listProductsQ := list_products.NewQuery(readModelForList, pricingCalculator, clock, list_products.PageLimits{Default: 50, Max: 1000}, 0)
+	productByRefQ := get_product_by_external_ref.NewQuery(externalRefStore, getProductQ)
+	validateProductQ := validate_product.NewQuery(productRepo, nameLookup, namePolicy, validationRules, clock)
+	productHistoryQ := get_product_history.NewQuery(productRepo, repo.NewSpannerHistoryReader(spannerClient))
+	productVersionsQ := list_product_versions.NewQuery(productRepo, versionStore)
+	rollbackToVersionUC := rollback_to_version.NewInteractor(productRepo, versionStore, spannerCommitter, clock, namePolicy, nameLookup, validationRules)
+	saveDraftUC := save_draft.NewInteractor(productRepo, draftStore, spannerCommitter, clock)
+	publishDraftUC := publish_draft.NewInteractor(productRepo, draftStore, versionStore, spannerCommitter, clock, namePolicy, nameLookup, validationRules)
+	discardDraftUC := discard_draft.NewInteractor(draftStore, spannerCommitter)
+	var readModelForSearch search_products.ReadModel = spannerReadModel
+	synonyms, err := domainServices.NewSynonymTable([][]string{{"t-shirt", "tshirt", "tee"}})
+	if err != nil {
+		t.Fatalf("Failed to create synonym table: %v", err)
+	}
+	searchFlags := featureflags.NewStatic(map[string]featureflags.Rule{
+		featureflags.SearchFuzzy: {Enabled: true, ExcludeTenants: []string{exactSearchTenant}},
+	})
+	searchProductsQ := search_products.NewQuery(readModelForSearch, pricingCalculator, merchRuleStore, synonyms, searchFlags)
 
 	return &testSetup{
 		ctx:               ctx,
 		cancel:            cancel,
-		database:          database,
+		database:          db.name,
 		spannerClient:     spannerClient,
-		adminClient:       adminClient,
-		opts:              opts,
+		opts:              db.opts,
 		createProduct:     createProductUC,
 		updateProduct:     updateProductUC,
 		applyDiscount:     applyDiscountUC,
@@ -212,64 +276,70 @@ func setupTest(t *testing.T) *testSetup {
 		archiveProduct:    archiveProductUC,
 		getProductQuery:   getProductQ,
 		listProductsQuery: listProductsQ,
+		validateProduct:   validateProductQ,
+		reviewProduct:     reviewProductUC,
+		productHistory:    productHistoryQ,
+		setChannels:       setChannelsUC,
+		batchTransition:   batchTransitionUC,
+		mergeProducts:     mergeProductsUC,
+		setMetadata:       setMetadataUC,
+		linkExternalRef:   linkExternalRefUC,
+		unlinkExternalRef: unlinkExternalRefUC,
+		productByRef:      productByRefQ,
+		changeBasePrice:   changeBasePriceUC,
+		decideChange:      decideChangeUC,
+		setPriceFloor:     setPriceFloorUC,
+		searchProducts:    searchProductsQ,
+		createMerchRule:   createMerchRuleUC,
+		deleteMerchRule:   deleteMerchRuleUC,
+		productVersions:   productVersionsQ,
+		rollbackToVersion: rollbackToVersionUC,
+		saveDraft:         saveDraftUC,
+		publishDraft:      publishDraftUC,
+		discardDraft:      discardDraftUC,
 	}
 }
 
-// teardownTest cleans up test resources
-func (ts *testSetup) teardownTest(t *testing.T) {
-	// Cancel context first to stop any ongoing operations
-	if ts.cancel != nil {
-		ts.cancel()
-	}
-
-	if ts.spannerClient != nil {
-		ts.spannerClient.Close()
-	}
-
-	if ts.adminClient != nil {
-		// Use a fresh context for cleanup operations
-		cleanupCtx, cleanupCancel := context.WithTimeout(context.Background(), 10*time.Second)
-		defer cleanupCancel()
-
-		// Drop database
-		if err := ts.adminClient.DropDatabase(cleanupCtx, &databasepb.DropDatabaseRequest{
-			Database: ts.database,
-		}); err != nil {
-			t.Logf("Failed to drop database: %v", err)
-		}
-		ts.adminClient.Close()
-	}
+// testValidationRules requires an ISBN-13 (as GTIN) for products in the Textbooks category
+func testValidationRules(t *testing.T) *domainServices.ValidationRules {
+	t.Helper()
 
-	if ts.opts != nil {
-		ts.opts.Close()
+	rules, err := domainServices.NewValidationRules(map[string][]domainServices.FieldRule{
+		"Textbooks": {
+			{Field: domainServices.RuleFieldGTIN, Required: true, Pattern: regexp.MustCompile(`^97[89]\d{10}$`), Message: "textbooks need an ISBN-13"},
+			{Field: domainServices.RuleFieldDescription, MinLength: 20},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to build validation rules: %v", err)
 	}
+	return rules
 }
 
-// runMigrations runs database migrations
-func runMigrations(ctx context.Context, adminClient *admin.DatabaseAdminClient, database string) error {
-	migrationSQL, err := os.ReadFile("../../migrations/001_initial_schema.sql")
-	if err != nil {
-		return fmt.Errorf("failed to read migration file: %w", err)
+// teardownTest cancels the test context; the pool truncates and reclaims the database
+func (ts *testSetup) teardownTest(t *testing.T) {
+	if ts.cancel != nil {
+		ts.cancel()
 	}
+}
 
-	statements := parseDDLStatements(string(migrationSQL))
-
-	op, err := adminClient.UpdateDatabaseDdl(ctx, &databasepb.UpdateDatabaseDdlRequest{
-		Database:   database,
-		Statements: statements,
-	})
+// migrationStatements returns the DDL of all migrations in order
+func migrationStatements() ([]string, error) {
+	files, err := filepath.Glob("../../migrations/*.sql")
 	if err != nil {
-		return fmt.Errorf("failed to start DDL operation: %w", err)
+		return nil, fmt.Errorf("failed to list migration files: %w", err)
 	}
+	sort.Strings(files)
 
-	err = op.Wait(ctx)
-	if err != nil {
-		if ctx.Err() == context.DeadlineExceeded {
-			return fmt.Errorf("timeout waiting for migrations. Is Spanner emulator running? (docker compose up -d)")
+	var statements []string
+	for _, file := range files {
+		migrationSQL, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration file %s: %w", file, err)
 		}
-		return fmt.Errorf("failed to wait for migrations: %w", err)
+		statements = append(statements, parseDDLStatements(string(migrationSQL))...)
 	}
-	return nil
+	return statements, nil
 }
 
 // stringPtr returns a pointer to the given string
@@ -336,9 +406,29 @@ func (ts *testSetup) assertProductState(t *testing.T, productID string, expected
 }
 
 // assertOutboxEvents verifies outbox events were created
+// assertDiscountCleared checks that every discount column of the product row is NULL
+func (ts *testSetup) assertDiscountCleared(t *testing.T, productID string) {
+	t.Helper()
+	row, err := ts.spannerClient.Single().ReadRow(ts.ctx, m_product.TableName, spanner.Key{productID}, m_product.DiscountColumns())
+	if err != nil {
+		t.Fatalf("Failed to read discount columns: %v", err)
+	}
+	var (
+		id         spanner.NullString
+		amount     spanner.NullNumeric
+		start, end spanner.NullTime
+	)
+	if err := row.Columns(&id, &amount, &start, &end); err != nil {
+		t.Fatalf("Failed to parse discount columns: %v", err)
+	}
+	if id.Valid || amount.Valid || start.Valid || end.Valid {
+		t.Errorf("Expected NULL discount columns after removal, got id=%v amount=%v start=%v end=%v", id, amount, start, end)
+	}
+}
+
 func (ts *testSetup) assertOutboxEvents(t *testing.T, expectedEventNames []string) {
 	stmt := spanner.Statement{
-		SQL: `SELECT event_type FROM outbox_events ORDER BY created_at`,
+		SQL: `SELECT event_type FROM outbox_events_by_aggregate ORDER BY created_at`,
 	}
 	iter := ts.spannerClient.Single().Query(ts.ctx, stmt)
 	defer iter.Stop()
@@ -393,6 +483,8 @@ func (ts *testSetup) cleanupDatabase(t *testing.T) {
 // Test scenarios
 
 func TestProductCreationFlow(t *testing.T) {
+	t.Parallel()
+
 	ts := setupTest(t)
 	defer ts.teardownTest(t)
 	defer ts.cleanupDatabase(t)
@@ -424,6 +516,8 @@ func TestProductCreationFlow(t *testing.T) {
 }
 
 func TestProductUpdateFlow(t *testing.T) {
+	t.Parallel()
+
 	ts := setupTest(t)
 	defer ts.teardownTest(t)
 	defer ts.cleanupDatabase(t)
@@ -459,7 +553,7 @@ func TestProductUpdateFlow(t *testing.T) {
 
 	// Verify product was updated
 	row, err := ts.spannerClient.Single().ReadRow(ts.ctx, m_product.TableName, spanner.Key{productID}, []string{
-		m_product.Name, m_product.Description, m_product.Category,
+		m_product.Name, m_product.Description, m_product.Category, m_product.CreatedAt, m_product.UpdatedAt,
 	})
 	if err != nil {
 		t.Fatalf("Failed to read product: %v", err)
@@ -479,12 +573,75 @@ func TestProductUpdateFlow(t *testing.T) {
 	if model.Category != "Books" {
 		t.Errorf("Expected category 'Books', got '%s'", model.Category)
 	}
+	if !model.UpdatedAt.After(model.CreatedAt) {
+		t.Errorf("Expected updated_at %v to move past created_at %v", model.UpdatedAt, model.CreatedAt)
+	}
+
+	// An update that changes nothing leaves updated_at alone
+	if _, err := ts.updateProduct.Execute(ts.ctx, updateReq); err != nil {
+		t.Fatalf("Failed to repeat update: %v", err)
+	}
+	got, err := ts.getProductQuery.Execute(ts.ctx, productID)
+	if err != nil {
+		t.Fatalf("Failed to get product: %v", err)
+	}
+	if !got.UpdatedAt.Equal(model.UpdatedAt) {
+		t.Errorf("Expected unchanged updated_at %v, got %v", model.UpdatedAt, got.UpdatedAt)
+	}
+
+	// Other state changes move it forward and reads surface the new value
+	if _, err := ts.activateProduct.Execute(ts.ctx, &activate_product.Request{ProductID: productID}); err != nil {
+		t.Fatalf("Failed to activate product: %v", err)
+	}
+	got, err = ts.getProductQuery.Execute(ts.ctx, productID)
+	if err != nil {
+		t.Fatalf("Failed to get product: %v", err)
+	}
+	if !got.UpdatedAt.After(model.UpdatedAt) {
+		t.Errorf("Expected activation to move updated_at past %v, got %v", model.UpdatedAt, got.UpdatedAt)
+	}
+
+	// The update event carries the previous content, which history exposes as an audit trail
+	history, err := ts.productHistory.Execute(ts.ctx, productID)
+	if err != nil {
+		t.Fatalf("Failed to get product history: %v", err)
+	}
+	var payload struct {
+		Previous map[string]string `json:"previous"`
+	}
+	if err := json.Unmarshal(history.Entries[1].Payload, &payload); err != nil {
+		t.Fatalf("Failed to decode product_updated payload: %v", err)
+	}
+	if payload.Previous[domain.FieldName] != "Original Name" || payload.Previous[domain.FieldCategory] != "Electronics" {
+		t.Errorf("Expected the previous name and category in the event, got %v", payload.Previous)
+	}
+
+	// The change tracker keeps the value the product was loaded with across repeated changes
+	product, err := repo.NewSpannerProductRepository(ts.spannerClient).Load(ts.ctx, productID)
+	if err != nil {
+		t.Fatalf("Failed to load product: %v", err)
+	}
+	now := time.Now()
+	for _, name := range []string{"Draft Name", "Final Name"} {
+		if err := product.UpdateDetails(name, "Updated Description", "Books", now); err != nil {
+			t.Fatalf("Failed to update details: %v", err)
+		}
+	}
+	diff := product.Changes().Diff()
+	if len(diff) != 2 || diff[0].Field != domain.FieldName || diff[1].Field != domain.FieldUpdatedAt {
+		t.Fatalf("Expected name and updated_at changes, got %+v", diff)
+	}
+	if diff[0].Old != "Updated Name" || diff[0].New != "Final Name" {
+		t.Errorf("Expected name to change from the loaded value to the last one, got %+v", diff[0])
+	}
 
 	// Verify outbox events
-	ts.assertOutboxEvents(t, []string{"product_created", "product_updated"})
+	ts.assertOutboxEvents(t, []string{"product_created", "product_updated", "product_activated"})
 }
 
 func TestDiscountApplicationFlow(t *testing.T) {
+	t.Parallel()
+
 	ts := setupTest(t)
 	defer ts.teardownTest(t)
 	defer ts.cleanupDatabase(t)
@@ -550,6 +707,8 @@ func TestDiscountApplicationFlow(t *testing.T) {
 }
 
 func TestProductActivationFlow(t *testing.T) {
+	t.Parallel()
+
 	ts := setupTest(t)
 	defer ts.teardownTest(t)
 	defer ts.cleanupDatabase(t)
@@ -600,10 +759,32 @@ func TestProductActivationFlow(t *testing.T) {
 }
 
 func TestBusinessRuleValidation(t *testing.T) {
+	t.Parallel()
+
 	ts := setupTest(t)
 	defer ts.teardownTest(t)
 	defer ts.cleanupDatabase(t)
 
+	// Products must be created with a name and a positive price
+	_, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{
+		Name:        "   ",
+		Description: "A product",
+		Category:    "Electronics",
+		BasePrice:   moneyFromRat(big.NewRat(5000, 100)),
+	})
+	if !errors.Is(err, domain.ErrInvalidProductName) {
+		t.Errorf("Expected ErrInvalidProductName for a blank name, got %v", err)
+	}
+	_, err = ts.createProduct.Execute(ts.ctx, &create_product.Request{
+		Name:        "Free Product",
+		Description: "A product",
+		Category:    "Electronics",
+		BasePrice:   moneyFromRat(big.NewRat(0, 1)),
+	})
+	if !errors.Is(err, domain.ErrInvalidPrice) {
+		t.Errorf("Expected ErrInvalidPrice for a zero price, got %v", err)
+	}
+
 	// Create product
 	createReq := &create_product.Request{
 		Name:        "Product for Validation",
@@ -660,6 +841,7 @@ func TestBusinessRuleValidation(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Failed to remove discount: %v", err)
 	}
+	ts.assertDiscountCleared(t, productID)
 
 	// Now deactivate should work
 	_, err = ts.deactivateProduct.Execute(ts.ctx, &deactivate_product.Request{ProductID: productID})
@@ -669,6 +851,8 @@ func TestBusinessRuleValidation(t *testing.T) {
 }
 
 func TestOutboxEventCreation(t *testing.T) {
+	t.Parallel()
+
 	ts := setupTest(t)
 	defer ts.teardownTest(t)
 	defer ts.cleanupDatabase(t)
@@ -691,7 +875,7 @@ func TestOutboxEventCreation(t *testing.T) {
 	// Verify outbox event was created
 	// Use TO_JSON_STRING to convert JSON column to string for reading
 	stmt := spanner.Statement{
-		SQL: `SELECT event_type, TO_JSON_STRING(payload) as payload_str FROM outbox_events WHERE event_type = @eventType`,
+		SQL: `SELECT event_type, TO_JSON_STRING(payload) as payload_str FROM outbox_events_by_aggregate WHERE event_type = @eventType`,
 		Params: map[string]interface{}{
 			"eventType": "product_created",
 		},
@@ -730,6 +914,8 @@ func TestOutboxEventCreation(t *testing.T) {
 }
 
 func TestListProductsWithFilters(t *testing.T) {
+	t.Parallel()
+
 	ts := setupTest(t)
 	defer ts.teardownTest(t)
 	defer ts.cleanupDatabase(t)
@@ -824,9 +1010,45 @@ func TestListProductsWithFilters(t *testing.T) {
 	if result.Total != 4 {
 		t.Errorf("Expected total 4 products, got %d", result.Total)
 	}
+	if !result.HasMore {
+		t.Error("Expected more products after the first page")
+	}
+
+	// Test pagination without a count: the last page reports no more products
+	listReq = &list_products.Request{
+		Limit:     2,
+		Offset:    2,
+		SkipTotal: true,
+	}
+
+	result, err = ts.listProductsQuery.Execute(ts.ctx, listReq)
+	if err != nil {
+		t.Fatalf("Failed to list products: %v", err)
+	}
+
+	if len(result.Products) != 2 || result.Total != 0 || result.HasMore {
+		t.Errorf("Expected 2 products, no total and no more pages, got %d (total %d, has more %t)", len(result.Products), result.Total, result.HasMore)
+	}
+
+	// Test page size limits: no limit gets the default page, an oversized limit is rejected
+	result, err = ts.listProductsQuery.Execute(ts.ctx, &list_products.Request{})
+	if err != nil {
+		t.Fatalf("Failed to list products: %v", err)
+	}
+	if len(result.Products) != 4 {
+		t.Errorf("Expected 4 products with the default limit, got %d", len(result.Products))
+	}
+
+	var pageSizeErr *list_products.PageSizeError
+	_, err = ts.listProductsQuery.Execute(ts.ctx, &list_products.Request{Limit: 1001})
+	if !errors.As(err, &pageSizeErr) {
+		t.Errorf("Expected PageSizeError, got %v", err)
+	}
 }
 
 func TestGetProductWithEffectivePrice(t *testing.T) {
+	t.Parallel()
+
 	ts := setupTest(t)
 	defer ts.teardownTest(t)
 	defer ts.cleanupDatabase(t)
@@ -897,3 +1119,4210 @@ func TestGetProductWithEffectivePrice(t *testing.T) {
 		t.Errorf("Expected effective price %s, got %s", expectedPrice.String(), result.EffectivePrice.String())
 	}
 }
+
+func TestDuplicateProductDetection(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	basePrice := domain.NewMoney(2500)
+	original, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{
+		Name:        "Wireless Mouse",
+		Description: "Original listing",
+		Category:    "Electronics",
+		SKU:         "MOUSE-001",
+		GTIN:        "4006381333931",
+		BasePrice:   &basePrice,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+
+	// Warn: created, with the original reported as a possible duplicate
+	warnResp, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{
+		Name:           "wireless mouse",
+		Description:    "Same name, different case",
+		Category:       "Electronics",
+		BasePrice:      &basePrice,
+		DuplicateCheck: create_product.DuplicateCheckWarn,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create product in warn mode: %v", err)
+	}
+	if len(warnResp.PossibleDuplicates) != 1 || warnResp.PossibleDuplicates[0] != original.ProductID {
+		t.Errorf("Expected possible duplicate %s, got %v", original.ProductID, warnResp.PossibleDuplicates)
+	}
+
+	// Reject: matching GTIN under a different name is refused
+	_, err = ts.createProduct.Execute(ts.ctx, &create_product.Request{
+		Name:           "Ergonomic Mouse",
+		Description:    "Same GTIN",
+		Category:       "Accessories",
+		GTIN:           "4006381333931",
+		BasePrice:      &basePrice,
+		DuplicateCheck: create_product.DuplicateCheckReject,
+	})
+	var duplicateErr *domain.DuplicateProductError
+	if !errors.As(err, &duplicateErr) {
+		t.Fatalf("Expected DuplicateProductError, got %v", err)
+	}
+
+	// Allow (default): no check
+	if _, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{
+		Name:        "Wireless Mouse",
+		Description: "Allowed duplicate",
+		Category:    "Electronics",
+		SKU:         "MOUSE-001",
+		BasePrice:   &basePrice,
+	}); err != nil {
+		t.Fatalf("Failed to create product in allow mode: %v", err)
+	}
+}
+
+func TestUniqueProductNames(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	ctx := tenant.WithID(ts.ctx, uniqueNameTenant)
+	basePrice := domain.NewMoney(1500)
+	create := func(ctx context.Context, name, category string) (*create_product.Response, error) {
+		return ts.createProduct.Execute(ctx, &create_product.Request{
+			Name:        name,
+			Description: "Protective case",
+			Category:    category,
+			BasePrice:   &basePrice,
+		})
+	}
+
+	original, err := create(ctx, "iPhone Case", "Accessories")
+	if err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+
+	// Case and whitespace variants collide and name the existing product
+	_, err = create(ctx, "  iphone   CASE ", "Accessories")
+	var nameErr *domain.ProductNameTakenError
+	if !errors.As(err, &nameErr) {
+		t.Fatalf("Expected ProductNameTakenError, got %v", err)
+	}
+	if nameErr.ProductID != original.ProductID {
+		t.Errorf("Expected conflict with %s, got %s", original.ProductID, nameErr.ProductID)
+	}
+
+	// The same name is fine in another category, and for tenants that do not opt in
+	other, err := create(ctx, "iPhone Case", "Clearance")
+	if err != nil {
+		t.Fatalf("Expected same name in another category to succeed: %v", err)
+	}
+	if _, err := create(ts.ctx, "iPhone Case", "Accessories"); err != nil {
+		t.Fatalf("Expected default tenant to allow duplicate names: %v", err)
+	}
+
+	// Moving a product into the category under a taken name is refused
+	category := "Accessories"
+	_, err = ts.updateProduct.Execute(ctx, &update_product.Request{ProductID: other.ProductID, Category: &category})
+	if !errors.As(err, &nameErr) {
+		t.Fatalf("Expected ProductNameTakenError on update, got %v", err)
+	}
+
+	// Archiving releases the name
+	if _, err := ts.archiveProduct.Execute(ctx, &archive_product.Request{ProductID: original.ProductID}); err != nil {
+		t.Fatalf("Failed to archive product: %v", err)
+	}
+	if _, err := create(ctx, "iPhone Case", "Accessories"); err != nil {
+		t.Fatalf("Expected name to be free after archiving: %v", err)
+	}
+}
+
+func TestCategoryValidationRules(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	basePrice := domain.NewMoney(4999)
+
+	// Every broken rule is reported together
+	_, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{
+		Name:        "Linear Algebra",
+		Description: "Used copy",
+		Category:    "Textbooks",
+		BasePrice:   &basePrice,
+	})
+	var validationErr *domain.ValidationFailedError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("Expected ValidationFailedError, got %v", err)
+	}
+	if len(validationErr.Violations) != 2 {
+		t.Fatalf("Expected 2 violations, got %v", validationErr.Violations)
+	}
+
+	created, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{
+		Name:        "Linear Algebra",
+		Description: "Hardcover, third edition",
+		Category:    "Textbooks",
+		GTIN:        "9780306406157",
+		BasePrice:   &basePrice,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create valid textbook: %v", err)
+	}
+
+	// Validation reports built-in and category violations of proposed changes without saving them
+	empty := ""
+	dto, err := ts.validateProduct.Execute(ts.ctx, &validate_product.Request{
+		ProductID:   created.ProductID,
+		Name:        &empty,
+		Description: stringPtr("Short"),
+	})
+	if err != nil {
+		t.Fatalf("Failed to validate product: %v", err)
+	}
+	fields := map[string]bool{}
+	for _, v := range dto.Violations {
+		fields[v.Field] = true
+	}
+	if len(dto.Violations) != 2 || !fields["name"] || !fields["description"] {
+		t.Errorf("Expected name and description violations, got %v", dto.Violations)
+	}
+
+	got, err := ts.getProductQuery.Execute(ts.ctx, created.ProductID)
+	if err != nil {
+		t.Fatalf("Failed to get product: %v", err)
+	}
+	if got.Name != "Linear Algebra" {
+		t.Errorf("Expected validation not to change the product, got name %q", got.Name)
+	}
+}
+
+func TestBatchImportOperation(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	// Operations run on the job worker; stop it before the database goes back to the pool
+	workerDone := make(chan struct{})
+	go func() {
+		defer close(workerDone)
+		ts.opts.JobWorker.Run(ts.ctx)
+	}()
+	t.Cleanup(func() { <-workerDone })
+
+	resp, err := ts.opts.ProductHandler.BatchImportProducts(ts.ctx, &pb.BatchImportProductsRequest{
+		Products: []*pb.CreateProductRequest{
+			{Name: "Desk Lamp", Description: "LED lamp", Category: "Home", BasePrice: &pb.Money{Amount: 3999}},
+			{Name: "", Description: "Missing name", Category: "Home", BasePrice: &pb.Money{Amount: 100}},
+			{Name: "Floor Lamp", Description: "Tall lamp", Category: "Home", BasePrice: &pb.Money{Amount: 8999}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to start batch import: %v", err)
+	}
+
+	op, err := ts.opts.OperationsHandler.WaitOperation(ts.ctx, &longrunningpb.WaitOperationRequest{
+		Name:    resp.OperationName,
+		Timeout: durationpb.New(20 * time.Second),
+	})
+	if err != nil {
+		t.Fatalf("Failed to wait for operation: %v", err)
+	}
+	if !op.Done {
+		t.Fatalf("Expected operation %s to be done", resp.OperationName)
+	}
+
+	var metadata pb.OperationMetadata
+	if err := op.Metadata.UnmarshalTo(&metadata); err != nil {
+		t.Fatalf("Failed to decode metadata: %v", err)
+	}
+	if metadata.ProcessedItems != 3 || metadata.FailedItems != 1 {
+		t.Errorf("Expected 3 processed / 1 failed, got %d / %d", metadata.ProcessedItems, metadata.FailedItems)
+	}
+
+	var result pb.BatchImportProductsResult
+	if err := op.GetResponse().UnmarshalTo(&result); err != nil {
+		t.Fatalf("Failed to decode result: %v", err)
+	}
+	if len(result.Failures) != 1 || result.Failures[0].Index != 1 || result.Failures[0].Code != "INVALID_ARGUMENT" {
+		t.Errorf("Expected item 1 to fail with INVALID_ARGUMENT, got %v", result.Failures)
+	}
+	for _, i := range []int{0, 2} {
+		if result.ProductIds[i] == "" {
+			t.Errorf("Expected item %d to be imported", i)
+			continue
+		}
+		if _, err := ts.getProductQuery.Execute(ts.ctx, result.ProductIds[i]); err != nil {
+			t.Errorf("Imported product %s not readable: %v", result.ProductIds[i], err)
+		}
+	}
+}
+
+func TestProductReviewHistory(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	basePrice := domain.NewMoney(1999)
+	created, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{
+		Name:        "Desk Lamp",
+		Description: "LED desk lamp",
+		Category:    "Lighting",
+		BasePrice:   &basePrice,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+
+	// Rejections must explain why
+	_, err = ts.reviewProduct.Execute(ts.ctx, &review_product.Request{
+		ProductID: created.ProductID,
+		Decision:  domain.ReviewRejected,
+		Reviewer:  "reviewer@example.com",
+	})
+	if !errors.Is(err, domain.ErrInvalidReviewComment) {
+		t.Fatalf("Expected ErrInvalidReviewComment, got %v", err)
+	}
+
+	reviews := []review_product.Request{
+		{ProductID: created.ProductID, Decision: domain.ReviewRejected, Reviewer: "reviewer@example.com", Comment: "Missing wattage"},
+		{ProductID: created.ProductID, Decision: domain.ReviewApproved, Reviewer: "lead@example.com", Comment: "Looks good"},
+	}
+	for i := range reviews {
+		if _, err := ts.reviewProduct.Execute(ts.ctx, &reviews[i]); err != nil {
+			t.Fatalf("Failed to review product: %v", err)
+		}
+	}
+
+	history, err := ts.productHistory.Execute(ts.ctx, created.ProductID)
+	if err != nil {
+		t.Fatalf("Failed to get product history: %v", err)
+	}
+	var got []get_product_history.Review
+	for _, entry := range history.Entries {
+		if entry.Review != nil {
+			got = append(got, *entry.Review)
+		}
+	}
+	if len(history.Entries) != 3 || len(got) != 2 {
+		t.Fatalf("Expected created event and 2 reviews, got %+v", history.Entries)
+	}
+	for i, want := range reviews {
+		if got[i].Decision != want.Decision || got[i].Reviewer != want.Reviewer || got[i].Comment != want.Comment {
+			t.Errorf("Review %d: expected %+v, got %+v", i, want, got[i])
+		}
+	}
+
+	// A review authenticated by an API key records the key, not the free-text reviewer
+	manager := apikey.NewManager(apikey.NewSpannerStore(ts.spannerClient), clock.NewRealClock(), 0)
+	key, secret, err := manager.Issue(ts.ctx, "reviewer-bot", []apikey.Scope{apikey.ScopeWrite})
+	if err != nil {
+		t.Fatalf("Failed to issue key: %v", err)
+	}
+	reviewMethod := "/product.v1.ProductService/ReviewProduct"
+	interceptor := apikey.UnaryServerInterceptor(manager, map[string]apikey.Scope{reviewMethod: apikey.ScopeWrite}, false)
+	keyed := metadata.NewIncomingContext(ts.ctx, metadata.Pairs(apikey.MetadataKey, secret))
+	_, err = interceptor(keyed, &pb.ReviewProductRequest{
+		ProductId: created.ProductID,
+		Decision:  pb.ReviewDecision_REVIEW_DECISION_APPROVED,
+		Reviewer:  "ceo@example.com",
+	}, &grpc.UnaryServerInfo{FullMethod: reviewMethod}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return ts.opts.ProductHandler.ReviewProduct(ctx, req.(*pb.ReviewProductRequest))
+	})
+	if err != nil {
+		t.Fatalf("Failed to review product with a key: %v", err)
+	}
+	history, err = ts.productHistory.Execute(ts.ctx, created.ProductID)
+	if err != nil {
+		t.Fatalf("Failed to get product history: %v", err)
+	}
+	if last := history.Entries[len(history.Entries)-1]; last.Review == nil || last.Review.Reviewer != key.KeyID {
+		t.Errorf("Expected the review to record key %s, got %+v", key.KeyID, last.Review)
+	}
+
+	// History is scoped to the product's tenant
+	_, err = ts.productHistory.Execute(tenant.WithID(ts.ctx, "other-tenant"), created.ProductID)
+	if !errors.Is(err, domain.ErrProductNotFound) {
+		t.Errorf("Expected ErrProductNotFound for another tenant, got %v", err)
+	}
+}
+
+func TestProductHistoryKeepsEmissionOrder(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	basePrice := domain.NewMoney(1999)
+	created, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{
+		Name:        "Desk Lamp",
+		Description: "LED desk lamp",
+		Category:    "Lighting",
+		BasePrice:   &basePrice,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+
+	// One update emits four product_updated events that share a commit timestamp
+	name, markdown, shippingClass := "Desk Lamp Pro", domain.DescriptionFormatMarkdown, "fragile"
+	if _, err := ts.updateProduct.Execute(ts.ctx, &update_product.Request{
+		ProductID:         created.ProductID,
+		Name:              &name,
+		DescriptionFormat: &markdown,
+		ShippingClass:     &shippingClass,
+		Compliance:        &domain.Compliance{Hazardous: true},
+	}); err != nil {
+		t.Fatalf("Failed to update product: %v", err)
+	}
+
+	history, err := ts.productHistory.Execute(ts.ctx, created.ProductID)
+	if err != nil {
+		t.Fatalf("Failed to get product history: %v", err)
+	}
+	var got []string
+	for _, entry := range history.Entries {
+		if entry.EventType != "product_updated" {
+			continue
+		}
+		var payload struct {
+			ChangedFields []string `json:"changed_fields"`
+		}
+		if err := json.Unmarshal(entry.Payload, &payload); err != nil {
+			t.Fatalf("Failed to decode event payload: %v", err)
+		}
+		got = append(got, strings.Join(payload.ChangedFields, ","))
+	}
+	want := []string{domain.FieldName, domain.FieldDescriptionFormat, domain.FieldShippingClass, domain.FieldHazardous}
+	if !slices.Equal(got, want) {
+		t.Errorf("Expected updates in emission order %v, got %v", want, got)
+	}
+}
+
+// rebuildProgress records what a rebuild reports
+type rebuildProgress struct {
+	processed, failed int
+	checkpoint        string
+}
+
+func (p *rebuildProgress) Item(ok bool) {
+	p.processed++
+	if !ok {
+		p.failed++
+	}
+}
+
+func (p *rebuildProgress) Checkpoint(productID string) { p.checkpoint = productID }
+
+func TestRebuildProjection(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	// Two products share a name before their tenant opts in to unique names
+	const lateTenant = "late-adopter"
+	ctx := tenant.WithID(ts.ctx, lateTenant)
+	basePrice := domain.NewMoney(2500)
+	var ids []string
+	for i := 0; i < 2; i++ {
+		created, err := ts.createProduct.Execute(ctx, &create_product.Request{
+			Name:        "Desk  Organizer",
+			Description: "Bamboo desk organizer",
+			Category:    "Office",
+			BasePrice:   &basePrice,
+		})
+		if err != nil {
+			t.Fatalf("Failed to create product: %v", err)
+		}
+		ids = append(ids, created.ProductID)
+	}
+	sort.Strings(ids)
+
+	rebuild := rebuild_projection.NewInteractor(
+		repo.NewSpannerProjectionStore(ts.spannerClient),
+		repo.NewSpannerProductRepository(ts.spannerClient),
+		spannerdriver.NewCommitter(ts.spannerClient),
+		domainServices.NewUniqueNamePolicy([]string{lateTenant}),
+	)
+
+	// A dry run changes nothing
+	dry, err := rebuild.Execute(ts.ctx, &rebuild_projection.Request{DryRun: true}, &rebuildProgress{})
+	if err != nil {
+		t.Fatalf("Failed to dry-run rebuild: %v", err)
+	}
+	if dry.Scanned != 2 || dry.Updated != 2 {
+		t.Errorf("Expected 2 scanned and 2 updated in dry run, got %+v", dry)
+	}
+
+	// The first product in ID order takes the name; the second is reported as a conflict
+	progress := &rebuildProgress{}
+	resp, err := rebuild.Execute(ts.ctx, &rebuild_projection.Request{BatchSize: 1}, progress)
+	if err != nil {
+		t.Fatalf("Failed to rebuild projection: %v", err)
+	}
+	if resp.Scanned != 2 || resp.Updated != 1 || len(resp.Conflicts) != 1 || resp.Conflicts[0] != ids[1] {
+		t.Errorf("Expected 1 update and a conflict for %s, got %+v", ids[1], resp)
+	}
+	if progress.processed != 2 || progress.failed != 1 || progress.checkpoint != ids[1] {
+		t.Errorf("Expected progress for 2 products ending at %s, got %+v", ids[1], progress)
+	}
+
+	row, err := ts.spannerClient.Single().ReadRow(ts.ctx, m_product.TableName, spanner.Key{ids[0]}, []string{m_product.NameKey})
+	if err != nil {
+		t.Fatalf("Failed to read product: %v", err)
+	}
+	var nameKey spanner.NullString
+	if err := row.Columns(&nameKey); err != nil {
+		t.Fatalf("Failed to parse name key: %v", err)
+	}
+	if nameKey.StringVal != "desk organizer" {
+		t.Errorf("Expected rebuilt name key %q, got %v", "desk organizer", nameKey)
+	}
+
+	// Resuming from the checkpoint scans nothing further
+	resumed, err := rebuild.Execute(ts.ctx, &rebuild_projection.Request{StartAfter: progress.checkpoint}, &rebuildProgress{})
+	if err != nil {
+		t.Fatalf("Failed to resume rebuild: %v", err)
+	}
+	if resumed.Scanned != 0 {
+		t.Errorf("Expected a resumed rebuild to scan nothing, got %+v", resumed)
+	}
+}
+
+func TestInboxProcessesEventOnce(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	projector := inbox.NewInbox(ts.spannerClient, clock.NewRealClock(), "projector")
+	calls := 0
+	handler := func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		calls++
+		return nil
+	}
+
+	// A redelivered event is skipped
+	for i, want := range []bool{true, false} {
+		processed, err := projector.Process(ts.ctx, "event-1", handler)
+		if err != nil {
+			t.Fatalf("Failed to process event: %v", err)
+		}
+		if processed != want {
+			t.Errorf("Delivery %d: expected processed=%v, got %v", i+1, want, processed)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("Expected the handler to run once, ran %d times", calls)
+	}
+
+	// Consumers are tracked independently
+	dispatcher := inbox.NewInbox(ts.spannerClient, clock.NewRealClock(), "webhook_dispatcher")
+	seen, err := dispatcher.Seen(ts.ctx, "event-1")
+	if err != nil {
+		t.Fatalf("Failed to check inbox: %v", err)
+	}
+	if seen {
+		t.Error("Expected the dispatcher not to have seen the projector's event")
+	}
+	for i := 0; i < 2; i++ {
+		if err := dispatcher.MarkProcessed(ts.ctx, "event-1"); err != nil {
+			t.Fatalf("Failed to mark event processed: %v", err)
+		}
+	}
+	if seen, err = dispatcher.Seen(ts.ctx, "event-1"); err != nil || !seen {
+		t.Errorf("Expected the dispatcher to have seen the event, got %v (err %v)", seen, err)
+	}
+
+	// Purging one consumer leaves the other's rows alone
+	purged, err := dispatcher.Purge(ts.ctx, time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatalf("Failed to purge inbox: %v", err)
+	}
+	if purged != 1 {
+		t.Errorf("Expected 1 purged row, got %d", purged)
+	}
+	if processed, err := projector.Process(ts.ctx, "event-1", handler); err != nil || processed {
+		t.Errorf("Expected the projector to still skip the event, got %v (err %v)", processed, err)
+	}
+}
+
+func TestProductChannels(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	basePrice := domain.NewMoney(1500)
+	created, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{
+		Name:        "Travel Mug",
+		Description: "Insulated steel mug",
+		Category:    "Kitchen",
+		BasePrice:   &basePrice,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+
+	// Unknown channels are rejected
+	_, err = ts.setChannels.Execute(ts.ctx, &set_channels.Request{ProductID: created.ProductID, Channels: []domain.Channel{"kiosk"}})
+	if !errors.Is(err, domain.ErrInvalidChannel) {
+		t.Errorf("Expected ErrInvalidChannel, got %v", err)
+	}
+
+	// Channels are deduplicated and sorted
+	_, err = ts.setChannels.Execute(ts.ctx, &set_channels.Request{
+		ProductID: created.ProductID,
+		Channels:  []domain.Channel{domain.ChannelWeb, domain.ChannelMarketplace, domain.ChannelWeb},
+	})
+	if err != nil {
+		t.Fatalf("Failed to set channels: %v", err)
+	}
+	got, err := ts.getProductQuery.Execute(ts.ctx, created.ProductID)
+	if err != nil {
+		t.Fatalf("Failed to get product: %v", err)
+	}
+	if strings.Join(got.Channels, ",") != "marketplace,web" {
+		t.Errorf("Expected channels marketplace,web, got %v", got.Channels)
+	}
+
+	// The channel filter only returns products visible on the channel
+	for channel, want := range map[domain.Channel]int{domain.ChannelWeb: 1, domain.ChannelMobileApp: 0} {
+		result, err := ts.listProductsQuery.Execute(ts.ctx, &list_products.Request{
+			TenantID: tenant.DefaultID,
+			Channel:  string(channel),
+			Limit:    10,
+		})
+		if err != nil {
+			t.Fatalf("Failed to list products: %v", err)
+		}
+		if len(result.Products) != want || result.Total != want {
+			t.Errorf("Channel %s: expected %d products, got %d (total %d)", channel, want, len(result.Products), result.Total)
+		}
+	}
+
+	// Setting the same channels again is a no-op
+	_, err = ts.setChannels.Execute(ts.ctx, &set_channels.Request{
+		ProductID: created.ProductID,
+		Channels:  []domain.Channel{domain.ChannelMarketplace, domain.ChannelWeb},
+	})
+	if err != nil {
+		t.Fatalf("Failed to set channels: %v", err)
+	}
+	ts.assertOutboxEvents(t, []string{"product_created", "channels_changed"})
+}
+
+func TestProductShippingDetails(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	basePrice := domain.NewMoney(8900)
+	req := &create_product.Request{
+		Name:        "Standing Desk Frame",
+		Description: "Steel frame with dual motors",
+		Category:    "Furniture",
+		BasePrice:   &basePrice,
+		Shipping: domain.ShippingDetails{
+			Weight:        &domain.Weight{Value: 32.5, Unit: domain.WeightUnitKilogram},
+			Dimensions:    &domain.Dimensions{Length: 120, Width: 60, Height: 15, Unit: domain.LengthUnitCentimeter},
+			ShippingClass: "oversized",
+		},
+	}
+
+	// Units outside the whitelist and negative values are rejected
+	bad := *req
+	bad.Shipping.Weight = &domain.Weight{Value: 32.5, Unit: "stone"}
+	if _, err := ts.createProduct.Execute(ts.ctx, &bad); !errors.Is(err, domain.ErrInvalidWeight) {
+		t.Errorf("Expected ErrInvalidWeight, got %v", err)
+	}
+	bad.Shipping.Weight = req.Shipping.Weight
+	bad.Shipping.Dimensions = &domain.Dimensions{Length: -1, Width: 60, Height: 15, Unit: domain.LengthUnitCentimeter}
+	if _, err := ts.createProduct.Execute(ts.ctx, &bad); !errors.Is(err, domain.ErrInvalidDimensions) {
+		t.Errorf("Expected ErrInvalidDimensions, got %v", err)
+	}
+
+	created, err := ts.createProduct.Execute(ts.ctx, req)
+	if err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+	got, err := ts.getProductQuery.Execute(ts.ctx, created.ProductID)
+	if err != nil {
+		t.Fatalf("Failed to get product: %v", err)
+	}
+	if got.Shipping.Weight == nil || *got.Shipping.Weight != *req.Shipping.Weight ||
+		got.Shipping.Dimensions == nil || *got.Shipping.Dimensions != *req.Shipping.Dimensions ||
+		got.Shipping.ShippingClass != "oversized" {
+		t.Errorf("Expected the shipping details to round-trip, got %+v", got.Shipping)
+	}
+
+	// Updating the weight keeps the dimensions; an empty class clears it
+	noClass := ""
+	_, err = ts.updateProduct.Execute(ts.ctx, &update_product.Request{
+		ProductID:     created.ProductID,
+		Weight:        &domain.Weight{Value: 71.6, Unit: domain.WeightUnitPound},
+		ShippingClass: &noClass,
+	})
+	if err != nil {
+		t.Fatalf("Failed to update shipping details: %v", err)
+	}
+	got, err = ts.getProductQuery.Execute(ts.ctx, created.ProductID)
+	if err != nil {
+		t.Fatalf("Failed to get product: %v", err)
+	}
+	if got.Shipping.Weight == nil || got.Shipping.Weight.Unit != domain.WeightUnitPound || got.Shipping.Dimensions == nil || got.Shipping.ShippingClass != "" {
+		t.Errorf("Expected a new weight, unchanged dimensions and no class, got %+v", got.Shipping)
+	}
+
+	row, err := ts.spannerClient.Single().ReadRow(ts.ctx, m_product.TableName, spanner.Key{created.ProductID}, []string{m_product.ShippingClass})
+	if err != nil {
+		t.Fatalf("Failed to read product: %v", err)
+	}
+	var shippingClass spanner.NullString
+	if err := row.Columns(&shippingClass); err != nil {
+		t.Fatalf("Failed to parse shipping class: %v", err)
+	}
+	if shippingClass.Valid {
+		t.Errorf("Expected a NULL shipping class, got %q", shippingClass.StringVal)
+	}
+}
+
+func TestProductTypes(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	basePrice := domain.NewMoney(2900)
+	req := &create_product.Request{
+		Name:        "Photo Editing Course",
+		Description: "Twelve video lessons",
+		Category:    "Courses",
+		BasePrice:   &basePrice,
+		ProductType: domain.ProductTypeDigital,
+	}
+
+	// Digital products need a download URL or license terms and take no shipping details
+	if _, err := ts.createProduct.Execute(ts.ctx, req); !errors.Is(err, domain.ErrDigitalDeliveryRequired) {
+		t.Errorf("Expected ErrDigitalDeliveryRequired, got %v", err)
+	}
+	req.Digital = domain.DigitalDelivery{DownloadURL: "https://downloads.example.com/course.zip"}
+	bad := *req
+	bad.Shipping = domain.ShippingDetails{Weight: &domain.Weight{Value: 1, Unit: domain.WeightUnitGram}}
+	if _, err := ts.createProduct.Execute(ts.ctx, &bad); !errors.Is(err, domain.ErrShippingNotApplicable) {
+		t.Errorf("Expected ErrShippingNotApplicable, got %v", err)
+	}
+
+	created, err := ts.createProduct.Execute(ts.ctx, req)
+	if err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+	got, err := ts.getProductQuery.Execute(ts.ctx, created.ProductID)
+	if err != nil {
+		t.Fatalf("Failed to get product: %v", err)
+	}
+	if got.ProductType != domain.ProductTypeDigital || got.DigitalDelivery != req.Digital {
+		t.Errorf("Expected a digital product with its download URL, got %s %+v", got.ProductType, got.DigitalDelivery)
+	}
+
+	// Clearing the only delivery detail would leave the product undeliverable
+	noURL := ""
+	_, err = ts.updateProduct.Execute(ts.ctx, &update_product.Request{ProductID: created.ProductID, DownloadURL: &noURL})
+	if !errors.Is(err, domain.ErrDigitalDeliveryRequired) {
+		t.Errorf("Expected ErrDigitalDeliveryRequired, got %v", err)
+	}
+
+	// Switching to physical drops the delivery details and allows a weight
+	physical := domain.ProductTypePhysical
+	_, err = ts.updateProduct.Execute(ts.ctx, &update_product.Request{
+		ProductID:   created.ProductID,
+		ProductType: &physical,
+		Weight:      &domain.Weight{Value: 250, Unit: domain.WeightUnitGram},
+	})
+	if err != nil {
+		t.Fatalf("Failed to change product type: %v", err)
+	}
+	got, err = ts.getProductQuery.Execute(ts.ctx, created.ProductID)
+	if err != nil {
+		t.Fatalf("Failed to get product: %v", err)
+	}
+	if got.ProductType != domain.ProductTypePhysical || !got.DigitalDelivery.IsZero() || got.Shipping.Weight == nil {
+		t.Errorf("Expected a physical product with a weight and no delivery details, got %s %+v %+v", got.ProductType, got.DigitalDelivery, got.Shipping)
+	}
+
+	// Products created without a type are physical
+	legacy, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{
+		Name:        "Desk Lamp",
+		Description: "LED lamp",
+		Category:    "Lighting",
+		BasePrice:   &basePrice,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+	got, err = ts.getProductQuery.Execute(ts.ctx, legacy.ProductID)
+	if err != nil {
+		t.Fatalf("Failed to get product: %v", err)
+	}
+	if got.ProductType != domain.ProductTypePhysical {
+		t.Errorf("Expected the default type physical, got %s", got.ProductType)
+	}
+}
+
+func TestProductCompliance(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	basePrice := domain.NewMoney(1500)
+	newProduct := func(name string, compliance domain.Compliance) (*create_product.Response, error) {
+		return ts.createProduct.Execute(ts.ctx, &create_product.Request{
+			Name:        name,
+			Description: "Compliance test product",
+			Category:    "Pharmacy",
+			BasePrice:   &basePrice,
+			Compliance:  compliance,
+		})
+	}
+
+	if _, err := newProduct("Too Old", domain.Compliance{AgeRestriction: 30}); !errors.Is(err, domain.ErrInvalidAgeRestriction) {
+		t.Errorf("Expected ErrInvalidAgeRestriction, got %v", err)
+	}
+	if _, err := newProduct("Vitamin C", domain.Compliance{}); err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+	if _, err := newProduct("Lighter Fluid", domain.Compliance{AgeRestriction: 18, Hazardous: true}); err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+	antibiotic, err := newProduct("Antibiotic", domain.Compliance{RequiresPrescription: true})
+	if err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+
+	// Each filter excludes the products it restricts
+	maxAge := 16
+	cases := map[string]struct {
+		req  list_products.Request
+		want int
+	}{
+		"none":         {list_products.Request{}, 3},
+		"age":          {list_products.Request{MaxAgeRestriction: &maxAge}, 2},
+		"hazardous":    {list_products.Request{ExcludeHazardous: true}, 2},
+		"prescription": {list_products.Request{ExcludePrescription: true}, 2},
+		"all": {list_products.Request{
+			MaxAgeRestriction:   &maxAge,
+			ExcludeHazardous:    true,
+			ExcludePrescription: true,
+		}, 1},
+	}
+	for name, tc := range cases {
+		req := tc.req
+		req.TenantID = tenant.DefaultID
+		req.Category = "Pharmacy"
+		req.Limit = 10
+		result, err := ts.listProductsQuery.Execute(ts.ctx, &req)
+		if err != nil {
+			t.Fatalf("Failed to list products: %v", err)
+		}
+		if result.Total != tc.want {
+			t.Errorf("%s: expected %d products, got %d", name, tc.want, result.Total)
+		}
+	}
+
+	// Updating compliance replaces every flag and reports the changed ones
+	_, err = ts.updateProduct.Execute(ts.ctx, &update_product.Request{
+		ProductID:  antibiotic.ProductID,
+		Compliance: &domain.Compliance{AgeRestriction: 12},
+	})
+	if err != nil {
+		t.Fatalf("Failed to update compliance: %v", err)
+	}
+	got, err := ts.getProductQuery.Execute(ts.ctx, antibiotic.ProductID)
+	if err != nil {
+		t.Fatalf("Failed to get product: %v", err)
+	}
+	if got.Compliance != (domain.Compliance{AgeRestriction: 12}) {
+		t.Errorf("Expected age restriction 12 and no prescription, got %+v", got.Compliance)
+	}
+}
+
+func TestBatchStatusTransitions(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	basePrice := domain.NewMoney(1200)
+	var productIDs []string
+	for _, name := range []string{"Red Mug", "Blue Mug", "Green Mug"} {
+		created, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{
+			Name:        name,
+			Description: "Ceramic mug",
+			Category:    "Kitchen",
+			BasePrice:   &basePrice,
+		})
+		if err != nil {
+			t.Fatalf("Failed to create product: %v", err)
+		}
+		productIDs = append(productIDs, created.ProductID)
+	}
+
+	// Missing products fail on their own without stopping the batch
+	resp, err := ts.batchTransition.Execute(ts.ctx, &batch_transition.Request{
+		Transition: batch_transition.TransitionActivate,
+		ProductIDs: append([]string{"00000000-0000-0000-0000-000000000000"}, productIDs...),
+	})
+	if err != nil {
+		t.Fatalf("Failed to activate products: %v", err)
+	}
+	if !errors.Is(resp.Outcomes[0].Err, domain.ErrProductNotFound) {
+		t.Errorf("Expected ErrProductNotFound for the missing product, got %v", resp.Outcomes[0].Err)
+	}
+	for _, outcome := range resp.Outcomes[1:] {
+		if outcome.Err != nil {
+			t.Errorf("Expected %s to be activated, got %v", outcome.ProductID, outcome.Err)
+		}
+		got, err := ts.getProductQuery.Execute(ts.ctx, outcome.ProductID)
+		if err != nil {
+			t.Fatalf("Failed to get product: %v", err)
+		}
+		if got.Status != string(domain.ProductStatusActive) {
+			t.Errorf("Expected %s to be active, got %s", outcome.ProductID, got.Status)
+		}
+	}
+
+	// Archived products cannot be deactivated; the rest of the batch still is
+	if _, err := ts.batchTransition.Execute(ts.ctx, &batch_transition.Request{
+		Transition: batch_transition.TransitionArchive,
+		ProductIDs: productIDs[:1],
+	}); err != nil {
+		t.Fatalf("Failed to archive product: %v", err)
+	}
+	resp, err = ts.batchTransition.Execute(ts.ctx, &batch_transition.Request{
+		Transition: batch_transition.TransitionDeactivate,
+		ProductIDs: productIDs,
+	})
+	if err != nil {
+		t.Fatalf("Failed to deactivate products: %v", err)
+	}
+	if !errors.Is(resp.Outcomes[0].Err, domain.ErrProductAlreadyArchived) {
+		t.Errorf("Expected ErrProductAlreadyArchived, got %v", resp.Outcomes[0].Err)
+	}
+	if resp.Outcomes[1].Err != nil || resp.Outcomes[2].Err != nil {
+		t.Errorf("Expected the other products to be deactivated, got %v and %v", resp.Outcomes[1].Err, resp.Outcomes[2].Err)
+	}
+}
+
+func TestSagaCompensation(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	sagas := committer.NewSagaCommitter(spannerdriver.NewCommitter(ts.spannerClient), 3, time.Millisecond)
+	newPlan := func(eventID string) *commitplan.Plan {
+		plan := commitplan.NewPlan()
+		plan.Add((&m_processed_event.ProcessedEvent{Consumer: "saga", EventID: eventID, ProcessedAt: time.Now()}).InsertMut())
+		return plan
+	}
+
+	// A failed commit compensates the before steps, newest first
+	if err := sagas.Run(ts.ctx, committer.NewSaga(newPlan("event-1"))); err != nil {
+		t.Fatalf("Failed to run saga: %v", err)
+	}
+	var undone []string
+	saga := committer.NewSaga(newPlan("event-1")) // Already exists, so the commit fails
+	for _, name := range []string{"cache", "index"} {
+		saga.Before(committer.Step{
+			Name:       name,
+			Do:         func(ctx context.Context) error { return nil },
+			Compensate: func(ctx context.Context) error { undone = append(undone, name); return nil },
+		})
+	}
+	var sagaErr *committer.SagaError
+	if err := sagas.Run(ts.ctx, saga); !errors.As(err, &sagaErr) || sagaErr.Step != "commit" || sagaErr.Committed {
+		t.Fatalf("Expected an uncommitted commit failure, got %v", err)
+	}
+	if strings.Join(undone, ",") != "index,cache" {
+		t.Errorf("Expected index then cache to be compensated, got %v", undone)
+	}
+
+	// After steps are retried, and a step that keeps failing reports the committed plan
+	attempts := 0
+	saga = committer.NewSaga(newPlan("event-2"))
+	saga.After(committer.Step{Name: "index", Do: func(ctx context.Context) error {
+		attempts++
+		if attempts < 3 {
+			return errors.New("index unavailable")
+		}
+		return nil
+	}})
+	if err := sagas.Run(ts.ctx, saga); err != nil {
+		t.Fatalf("Expected the after step to succeed on retry, got %v", err)
+	}
+	saga = committer.NewSaga(newPlan("event-3"))
+	saga.After(committer.Step{Name: "index", Do: func(ctx context.Context) error { return errors.New("index unavailable") }})
+	if err := sagas.Run(ts.ctx, saga); !errors.As(err, &sagaErr) || !sagaErr.Committed {
+		t.Errorf("Expected a committed saga with a failed after step, got %v", err)
+	}
+}
+
+func TestApproximateListTotals(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	basePrice := domain.NewMoney(2500)
+	createProducts := func(category string, n int) {
+		for i := 0; i < n; i++ {
+			_, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{
+				Name:        fmt.Sprintf("%s %d", category, i),
+				Description: "Counted product",
+				Category:    category,
+				BasePrice:   &basePrice,
+			})
+			if err != nil {
+				t.Fatalf("Failed to create product: %v", err)
+			}
+		}
+	}
+	list := func() *list_products.DTO {
+		result, err := ts.listProductsQuery.Execute(ts.ctx, &list_products.Request{
+			TenantID:         tenant.DefaultID,
+			Category:         "Garden",
+			Limit:            2,
+			ApproximateTotal: true,
+		})
+		if err != nil {
+			t.Fatalf("Failed to list products: %v", err)
+		}
+		return result
+	}
+	createProducts("Garden", 3)
+	createProducts("Kitchen", 2)
+
+	// Nothing has been counted yet, so the total is exact
+	result := list()
+	if result.Total != 3 || result.TotalApproximate || !result.HasMore {
+		t.Errorf("Expected an exact total of 3 with more pages, got %d (approximate %t, has more %t)", result.Total, result.TotalApproximate, result.HasMore)
+	}
+
+	refresh := refresh_product_counts.NewInteractor(repo.NewSpannerProductCountStore(ts.spannerClient), clock.NewRealClock())
+	resp, err := refresh.Execute(ts.ctx)
+	if err != nil {
+		t.Fatalf("Failed to refresh counts: %v", err)
+	}
+	if resp.Groups != 2 {
+		t.Errorf("Expected 2 counted groups, got %d", resp.Groups)
+	}
+
+	// Cached counts are served until the next refresh
+	createProducts("Garden", 1)
+	result = list()
+	if result.Total != 3 || !result.TotalApproximate || !result.HasMore {
+		t.Errorf("Expected an approximate total of 3 with more pages, got %d (approximate %t, has more %t)", result.Total, result.TotalApproximate, result.HasMore)
+	}
+	if _, err := refresh.Execute(ts.ctx); err != nil {
+		t.Fatalf("Failed to refresh counts: %v", err)
+	}
+	if result = list(); result.Total != 4 {
+		t.Errorf("Expected an approximate total of 4 after refresh, got %d", result.Total)
+	}
+}
+
+func TestMergeProducts(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	basePrice := domain.NewMoney(4900)
+	ids := make([]string, 3)
+	for i := range ids {
+		created, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{
+			Name:        fmt.Sprintf("Desk Lamp %d", i),
+			Description: "Adjustable desk lamp",
+			Category:    "Lighting",
+			BasePrice:   &basePrice,
+		})
+		if err != nil {
+			t.Fatalf("Failed to create product: %v", err)
+		}
+		ids[i] = created.ProductID
+	}
+
+	if _, err := ts.mergeProducts.Execute(ts.ctx, &merge_products.Request{DuplicateID: ids[0], CanonicalID: ids[0]}); !errors.Is(err, domain.ErrMergeIntoSelf) {
+		t.Errorf("Expected ErrMergeIntoSelf, got %v", err)
+	}
+
+	// The duplicate is archived and GetProduct resolves its ID as an alias
+	if _, err := ts.mergeProducts.Execute(ts.ctx, &merge_products.Request{DuplicateID: ids[0], CanonicalID: ids[1]}); err != nil {
+		t.Fatalf("Failed to merge products: %v", err)
+	}
+	got, err := ts.getProductQuery.Execute(ts.ctx, ids[0])
+	if err != nil {
+		t.Fatalf("Failed to get product: %v", err)
+	}
+	if got.ID != ids[1] || got.AliasedFrom != ids[0] {
+		t.Errorf("Expected product %s aliased from %s, got %s aliased from %q", ids[1], ids[0], got.ID, got.AliasedFrom)
+	}
+	if _, err := ts.mergeProducts.Execute(ts.ctx, &merge_products.Request{DuplicateID: ids[2], CanonicalID: ids[0]}); !errors.Is(err, domain.ErrMergeTargetArchived) {
+		t.Errorf("Expected ErrMergeTargetArchived, got %v", err)
+	}
+
+	// Merging the canonical product in turn repoints the earlier alias
+	if _, err := ts.mergeProducts.Execute(ts.ctx, &merge_products.Request{DuplicateID: ids[1], CanonicalID: ids[2]}); err != nil {
+		t.Fatalf("Failed to merge products: %v", err)
+	}
+	if got, err = ts.getProductQuery.Execute(ts.ctx, ids[0]); err != nil {
+		t.Fatalf("Failed to get product: %v", err)
+	}
+	if got.ID != ids[2] {
+		t.Errorf("Expected %s to resolve to %s, got %s", ids[0], ids[2], got.ID)
+	}
+
+	// The merge is published as a product_merged event of the duplicate
+	var count int64
+	stmt := spanner.Statement{
+		SQL:    "SELECT COUNT(*) FROM outbox_events_by_aggregate WHERE aggregate_id = @id AND event_type = 'product_merged'",
+		Params: map[string]interface{}{"id": ids[0]},
+	}
+	if err := ts.spannerClient.Single().Query(ts.ctx, stmt).Do(func(row *spanner.Row) error {
+		return row.Columns(&count)
+	}); err != nil {
+		t.Fatalf("Failed to count events: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("Expected 1 product_merged event, got %d", count)
+	}
+}
+
+func TestUnitOfWork(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	basePrice := domain.NewMoney(1200)
+	ids := make([]string, 2)
+	for i := range ids {
+		created, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{
+			Name:        fmt.Sprintf("Mug %d", i),
+			Description: "Stoneware mug",
+			Category:    "Kitchen",
+			BasePrice:   &basePrice,
+		})
+		if err != nil {
+			t.Fatalf("Failed to create product: %v", err)
+		}
+		ids[i] = created.ProductID
+	}
+
+	// Changes to both products commit together, each with its events in the order they happened
+	uow := unit_of_work.New(repo.NewSpannerProductRepository(ts.spannerClient), spannerdriver.NewCommitter(ts.spannerClient))
+	now := time.Now()
+	for _, id := range ids {
+		product, err := uow.Load(ts.ctx, id)
+		if err != nil {
+			t.Fatalf("Failed to load product: %v", err)
+		}
+		if err := product.Activate(now); err != nil {
+			t.Fatalf("Failed to activate product: %v", err)
+		}
+		if err := product.SetMetadata(domain.Metadata{"batch": "b-1"}, now); err != nil {
+			t.Fatalf("Failed to set metadata: %v", err)
+		}
+		if err := product.Deactivate(now); err != nil {
+			t.Fatalf("Failed to deactivate product: %v", err)
+		}
+	}
+	if again, err := uow.Load(ts.ctx, ids[0]); err != nil || again.Status() != domain.ProductStatusInactive {
+		t.Errorf("Expected a repeated load to return the tracked instance, got %v, %v", again, err)
+	}
+	if err := uow.Commit(ts.ctx, now); err != nil {
+		t.Fatalf("Failed to commit unit of work: %v", err)
+	}
+
+	for _, id := range ids {
+		var events []string
+		stmt := spanner.Statement{
+			SQL:    "SELECT event_type FROM outbox_events_by_aggregate WHERE aggregate_id = @id ORDER BY created_at, event_id",
+			Params: map[string]interface{}{"id": id},
+		}
+		if err := ts.spannerClient.Single().Query(ts.ctx, stmt).Do(func(row *spanner.Row) error {
+			var eventType string
+			if err := row.Columns(&eventType); err != nil {
+				return err
+			}
+			events = append(events, eventType)
+			return nil
+		}); err != nil {
+			t.Fatalf("Failed to read events: %v", err)
+		}
+		want := []string{"product_created", "product_activated", "metadata_changed", "product_deactivated"}
+		if !slices.Equal(events, want) {
+			t.Errorf("Expected events %v for %s, got %v", want, id, events)
+		}
+		got, err := ts.getProductQuery.Execute(ts.ctx, id)
+		if err != nil {
+			t.Fatalf("Failed to get product: %v", err)
+		}
+		if got.Metadata["batch"] != "b-1" || got.Status != string(domain.ProductStatusInactive) {
+			t.Errorf("Expected the committed changes on %s, got %+v", id, got)
+		}
+	}
+}
+
+func TestProductMetadata(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	basePrice := domain.NewMoney(2900)
+	created, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{
+		Name:        "Wall Clock",
+		Description: "Silent sweep wall clock",
+		Category:    "Decor",
+		BasePrice:   &basePrice,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+
+	// Malformed keys and oversized values are rejected
+	for _, metadata := range []domain.Metadata{
+		{"ERP Code": "A-100"},
+		{"erp_code": strings.Repeat("x", domain.MaxMetadataValueLength+1)},
+	} {
+		_, err = ts.setMetadata.Execute(ts.ctx, &set_metadata.Request{ProductID: created.ProductID, Metadata: metadata})
+		if !errors.Is(err, domain.ErrInvalidMetadata) {
+			t.Errorf("Expected ErrInvalidMetadata for %v, got %v", metadata, err)
+		}
+	}
+
+	metadata := domain.Metadata{"erp_code": "A-100", "marketplace.listing-id": "B07XYZ"}
+	if _, err = ts.setMetadata.Execute(ts.ctx, &set_metadata.Request{ProductID: created.ProductID, Metadata: metadata}); err != nil {
+		t.Fatalf("Failed to set metadata: %v", err)
+	}
+	got, err := ts.getProductQuery.Execute(ts.ctx, created.ProductID)
+	if err != nil {
+		t.Fatalf("Failed to get product: %v", err)
+	}
+	if !maps.Equal(got.Metadata, metadata) {
+		t.Errorf("Expected metadata %v, got %v", metadata, got.Metadata)
+	}
+
+	// The filter matches a key/value pair exactly
+	for value, want := range map[string]int{"A-100": 1, "A-1": 0} {
+		result, err := ts.listProductsQuery.Execute(ts.ctx, &list_products.Request{
+			TenantID:      tenant.DefaultID,
+			MetadataKey:   "erp_code",
+			MetadataValue: value,
+			Limit:         10,
+		})
+		if err != nil {
+			t.Fatalf("Failed to list products: %v", err)
+		}
+		if len(result.Products) != want {
+			t.Errorf("erp_code=%s: expected %d products, got %d", value, want, len(result.Products))
+		}
+	}
+
+	// Setting the same metadata again is a no-op, and an empty map clears it
+	if _, err = ts.setMetadata.Execute(ts.ctx, &set_metadata.Request{ProductID: created.ProductID, Metadata: maps.Clone(metadata)}); err != nil {
+		t.Fatalf("Failed to set metadata: %v", err)
+	}
+	if _, err = ts.setMetadata.Execute(ts.ctx, &set_metadata.Request{ProductID: created.ProductID}); err != nil {
+		t.Fatalf("Failed to clear metadata: %v", err)
+	}
+	if got, err = ts.getProductQuery.Execute(ts.ctx, created.ProductID); err != nil {
+		t.Fatalf("Failed to get product: %v", err)
+	}
+	if len(got.Metadata) != 0 {
+		t.Errorf("Expected cleared metadata, got %v", got.Metadata)
+	}
+	ts.assertOutboxEvents(t, []string{"product_created", "metadata_changed", "metadata_changed"})
+}
+
+func TestProductVersions(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	basePrice := domain.NewMoney(2900)
+	created, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{
+		Name:        "Desk Lamp",
+		Description: "Adjustable arm",
+		Category:    "Lighting",
+		BasePrice:   &basePrice,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+	id := created.ProductID
+	versions := func() []*domain.ContentVersion {
+		t.Helper()
+		dto, err := ts.productVersions.Execute(ts.ctx, id)
+		if err != nil {
+			t.Fatalf("Failed to list versions: %v", err)
+		}
+		return dto.Versions
+	}
+
+	// Each content change adds a version; price and status changes do not
+	newName := "Reading Lamp"
+	if _, err := ts.updateProduct.Execute(ts.ctx, &update_product.Request{ProductID: id, Name: &newName}); err != nil {
+		t.Fatalf("Failed to update product: %v", err)
+	}
+	if _, err := ts.setMetadata.Execute(ts.ctx, &set_metadata.Request{ProductID: id, Metadata: domain.Metadata{"erp_code": "L-1"}}); err != nil {
+		t.Fatalf("Failed to set metadata: %v", err)
+	}
+	if _, err := ts.activateProduct.Execute(ts.ctx, &activate_product.Request{ProductID: id}); err != nil {
+		t.Fatalf("Failed to activate product: %v", err)
+	}
+	history := versions()
+	if len(history) != 3 {
+		t.Fatalf("Expected 3 versions, got %d", len(history))
+	}
+	latest, original := history[0], history[2]
+	if latest.Name != newName || latest.Metadata["erp_code"] != "L-1" {
+		t.Errorf("Expected the newest version first with the current content, got %+v", latest)
+	}
+	if original.Name != "Desk Lamp" || len(original.Metadata) != 0 {
+		t.Errorf("Expected the created content as the oldest version, got %+v", original)
+	}
+
+	// Rolling back restores the content as a new version and leaves the status alone
+	resp, err := ts.rollbackToVersion.Execute(ts.ctx, &rollback_to_version.Request{ProductID: id, VersionID: original.ID})
+	if err != nil {
+		t.Fatalf("Failed to roll back: %v", err)
+	}
+	got, err := ts.getProductQuery.Execute(ts.ctx, id)
+	if err != nil {
+		t.Fatalf("Failed to get product: %v", err)
+	}
+	if got.Name != "Desk Lamp" || len(got.Metadata) != 0 || got.Status != string(domain.ProductStatusActive) {
+		t.Errorf("Expected the original content on the active product, got %+v", got)
+	}
+	history = versions()
+	if len(history) != 4 || history[0].ID != resp.VersionID || history[0].Name != "Desk Lamp" {
+		t.Errorf("Expected the rollback recorded as version %s, got %d versions starting with %+v", resp.VersionID, len(history), history[0])
+	}
+
+	// Rolling back to the current content changes nothing
+	if resp, err := ts.rollbackToVersion.Execute(ts.ctx, &rollback_to_version.Request{ProductID: id, VersionID: original.ID}); err != nil || resp.VersionID != "" {
+		t.Errorf("Expected a no-op rollback, got %+v, %v", resp, err)
+	}
+
+	// Unknown versions and other tenants' products are not found
+	if _, err := ts.rollbackToVersion.Execute(ts.ctx, &rollback_to_version.Request{ProductID: id, VersionID: "no-such-version"}); !errors.Is(err, domain.ErrVersionNotFound) {
+		t.Errorf("Expected ErrVersionNotFound, got %v", err)
+	}
+	if _, err := ts.productVersions.Execute(tenant.WithID(ts.ctx, "other-tenant"), id); !errors.Is(err, domain.ErrProductNotFound) {
+		t.Errorf("Expected ErrProductNotFound for another tenant, got %v", err)
+	}
+	ts.assertOutboxEvents(t, []string{"product_created", "product_updated", "metadata_changed", "product_activated", "product_updated", "metadata_changed"})
+}
+
+func TestProductDrafts(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	basePrice := domain.NewMoney(4900)
+	created, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{
+		Name:        "Hiking Boots",
+		Description: "Waterproof leather",
+		Category:    "Footwear",
+		BasePrice:   &basePrice,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+	id := created.ProductID
+
+	// Draft edits stack on each other and stay out of the published product
+	newName := "Trail Boots"
+	newDescription := "Waterproof leather, grippy soles"
+	if _, err := ts.saveDraft.Execute(ts.ctx, &save_draft.Request{ProductID: id, Name: &newName}); err != nil {
+		t.Fatalf("Failed to save draft: %v", err)
+	}
+	metadata := domain.Metadata{"erp_code": "B-7"}
+	if _, err := ts.saveDraft.Execute(ts.ctx, &save_draft.Request{ProductID: id, Description: &newDescription, Metadata: &metadata}); err != nil {
+		t.Fatalf("Failed to save draft: %v", err)
+	}
+	empty := ""
+	if _, err := ts.saveDraft.Execute(ts.ctx, &save_draft.Request{ProductID: id, Name: &empty}); !errors.Is(err, domain.ErrInvalidProductName) {
+		t.Errorf("Expected ErrInvalidProductName, got %v", err)
+	}
+	published, err := ts.getProductQuery.Execute(ts.ctx, id)
+	if err != nil {
+		t.Fatalf("Failed to get product: %v", err)
+	}
+	if published.Name != "Hiking Boots" || published.Draft {
+		t.Errorf("Expected the published content, got %+v", published)
+	}
+	preview, err := ts.getProductQuery.ExecutePreview(ts.ctx, id)
+	if err != nil {
+		t.Fatalf("Failed to preview product: %v", err)
+	}
+	if !preview.Draft || preview.Name != newName || preview.Description != newDescription || preview.Metadata["erp_code"] != "B-7" {
+		t.Errorf("Expected the draft content in the preview, got %+v", preview)
+	}
+
+	// Publishing applies the draft as a new version and deletes it
+	resp, err := ts.publishDraft.Execute(ts.ctx, &publish_draft.Request{ProductID: id})
+	if err != nil {
+		t.Fatalf("Failed to publish draft: %v", err)
+	}
+	if resp.VersionID == "" {
+		t.Error("Expected the published content recorded as a version")
+	}
+	published, err = ts.getProductQuery.ExecutePreview(ts.ctx, id)
+	if err != nil {
+		t.Fatalf("Failed to get product: %v", err)
+	}
+	if published.Draft || published.Name != newName || published.Metadata["erp_code"] != "B-7" {
+		t.Errorf("Expected the published draft content, got %+v", published)
+	}
+	if _, err := ts.publishDraft.Execute(ts.ctx, &publish_draft.Request{ProductID: id}); !errors.Is(err, domain.ErrDraftNotFound) {
+		t.Errorf("Expected ErrDraftNotFound after publishing, got %v", err)
+	}
+
+	// Discarding leaves the published content alone
+	if _, err := ts.saveDraft.Execute(ts.ctx, &save_draft.Request{ProductID: id, Name: &empty, Description: &newDescription}); err == nil {
+		t.Error("Expected an invalid draft to be refused")
+	}
+	otherName := "Winter Boots"
+	if _, err := ts.saveDraft.Execute(ts.ctx, &save_draft.Request{ProductID: id, Name: &otherName}); err != nil {
+		t.Fatalf("Failed to save draft: %v", err)
+	}
+	if _, err := ts.discardDraft.Execute(tenant.WithID(ts.ctx, "other-tenant"), &discard_draft.Request{ProductID: id}); !errors.Is(err, domain.ErrDraftNotFound) {
+		t.Errorf("Expected ErrDraftNotFound for another tenant, got %v", err)
+	}
+	if _, err := ts.discardDraft.Execute(ts.ctx, &discard_draft.Request{ProductID: id}); err != nil {
+		t.Fatalf("Failed to discard draft: %v", err)
+	}
+	preview, err = ts.getProductQuery.ExecutePreview(ts.ctx, id)
+	if err != nil {
+		t.Fatalf("Failed to preview product: %v", err)
+	}
+	if preview.Draft || preview.Name != newName {
+		t.Errorf("Expected the published content after discarding, got %+v", preview)
+	}
+	ts.assertOutboxEvents(t, []string{"product_created", "product_updated", "metadata_changed"})
+}
+
+func TestExternalRefs(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	basePrice := domain.NewMoney(1500)
+	ids := make([]string, 2)
+	for i := range ids {
+		created, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{
+			Name:        fmt.Sprintf("Notebook %d", i),
+			Description: "Dotted A5 notebook",
+			Category:    "Stationery",
+			BasePrice:   &basePrice,
+		})
+		if err != nil {
+			t.Fatalf("Failed to create product: %v", err)
+		}
+		ids[i] = created.ProductID
+	}
+
+	ref := domain.ExternalRef{System: "erp", ExternalID: "MAT-000123"}
+	if _, err := ts.linkExternalRef.Execute(ts.ctx, &link_external_ref.Request{ProductID: ids[0], Ref: domain.ExternalRef{System: "ERP", ExternalID: "x"}}); !errors.Is(err, domain.ErrInvalidExternalRef) {
+		t.Errorf("Expected ErrInvalidExternalRef, got %v", err)
+	}
+	if _, err := ts.linkExternalRef.Execute(ts.ctx, &link_external_ref.Request{ProductID: ids[0], Ref: ref}); err != nil {
+		t.Fatalf("Failed to link external reference: %v", err)
+	}
+	got, err := ts.productByRef.Execute(ts.ctx, ref)
+	if err != nil {
+		t.Fatalf("Failed to get product by external reference: %v", err)
+	}
+	if got.ID != ids[0] {
+		t.Errorf("Expected product %s, got %s", ids[0], got.ID)
+	}
+
+	// Relinking is a no-op, and another product cannot take the reference
+	if _, err := ts.linkExternalRef.Execute(ts.ctx, &link_external_ref.Request{ProductID: ids[0], Ref: ref}); err != nil {
+		t.Errorf("Expected relinking to succeed, got %v", err)
+	}
+	var takenErr *domain.ExternalRefTakenError
+	if _, err := ts.linkExternalRef.Execute(ts.ctx, &link_external_ref.Request{ProductID: ids[1], Ref: ref}); !errors.As(err, &takenErr) || takenErr.ProductID != ids[0] {
+		t.Errorf("Expected ExternalRefTakenError naming %s, got %v", ids[0], err)
+	}
+
+	// Only the holder can unlink, after which the reference no longer resolves
+	if _, err := ts.unlinkExternalRef.Execute(ts.ctx, &unlink_external_ref.Request{ProductID: ids[1], Ref: ref}); !errors.Is(err, domain.ErrExternalRefNotFound) {
+		t.Errorf("Expected ErrExternalRefNotFound, got %v", err)
+	}
+	if _, err := ts.unlinkExternalRef.Execute(ts.ctx, &unlink_external_ref.Request{ProductID: ids[0], Ref: ref}); err != nil {
+		t.Fatalf("Failed to unlink external reference: %v", err)
+	}
+	if _, err := ts.productByRef.Execute(ts.ctx, ref); !errors.Is(err, domain.ErrExternalRefNotFound) {
+		t.Errorf("Expected ErrExternalRefNotFound after unlink, got %v", err)
+	}
+
+	// The freed reference can be linked to the other product
+	if _, err := ts.linkExternalRef.Execute(ts.ctx, &link_external_ref.Request{ProductID: ids[1], Ref: ref}); err != nil {
+		t.Fatalf("Failed to relink external reference: %v", err)
+	}
+	if got, err = ts.productByRef.Execute(ts.ctx, ref); err != nil || got.ID != ids[1] {
+		t.Errorf("Expected product %s, got %v (err %v)", ids[1], got, err)
+	}
+}
+
+// racingChangeStore runs before ahead of every decision, as a write landing after the product was loaded
+type racingChangeStore struct {
+	contracts.PendingChangeStore
+	before func()
+}
+
+func (s *racingChangeStore) Decide(ctx context.Context, change *domain.PendingChange, extra ...*spanner.Mutation) error {
+	s.before()
+	return s.PendingChangeStore.Decide(ctx, change, extra...)
+}
+
+func TestPriceChangeApprovalRechecksPriceOnCommit(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	basePrice := domain.NewMoney(10000)
+	created, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{
+		Name:        "Grinder",
+		Description: "Burr coffee grinder",
+		Category:    "Kitchen",
+		BasePrice:   &basePrice,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+	bigChange := domain.NewMoney(5000)
+	resp, err := ts.changeBasePrice.Execute(ts.ctx, &change_base_price.Request{ProductID: created.ProductID, BasePrice: &bigChange, RequestedBy: "alice"})
+	if err != nil {
+		t.Fatalf("Failed to request price change: %v", err)
+	}
+
+	// A direct price change commits after the approval loaded the product but before it commits
+	smallChange := domain.NewMoney(10500)
+	racing := &racingChangeStore{
+		PendingChangeStore: repo.NewSpannerPendingChangeStore(ts.spannerClient),
+		before: func() {
+			if _, err := ts.changeBasePrice.Execute(ts.ctx, &change_base_price.Request{ProductID: created.ProductID, BasePrice: &smallChange}); err != nil {
+				t.Fatalf("Failed to change base price: %v", err)
+			}
+		},
+	}
+	decide := decide_change.NewInteractor(repo.NewSpannerProductRepository(ts.spannerClient), racing, clock.NewRealClock())
+	if _, err := decide.Execute(ts.ctx, &decide_change.Request{ChangeID: resp.PendingChangeID, Decision: domain.ReviewApproved, Approver: "bob"}); !errors.Is(err, domain.ErrChangeStale) {
+		t.Errorf("Expected ErrChangeStale, got %v", err)
+	}
+
+	got, err := ts.getProductQuery.Execute(ts.ctx, created.ProductID)
+	if err != nil {
+		t.Fatalf("Failed to get product: %v", err)
+	}
+	if got.BasePrice.Cmp(big.NewRat(10500, 100)) != 0 {
+		t.Errorf("Expected the racing price to survive, got %s", got.BasePrice.FloatString(2))
+	}
+}
+
+func TestPriceChangeApproval(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	basePrice := domain.NewMoney(10000)
+	created, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{
+		Name:        "Espresso Machine",
+		Description: "15 bar pump espresso machine",
+		Category:    "Kitchen",
+		BasePrice:   &basePrice,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+	assertPrice := func(want int64) {
+		t.Helper()
+		got, err := ts.getProductQuery.Execute(ts.ctx, created.ProductID)
+		if err != nil {
+			t.Fatalf("Failed to get product: %v", err)
+		}
+		if got.BasePrice.Cmp(big.NewRat(want, 100)) != 0 {
+			t.Errorf("Expected base price %d cents, got %s", want, got.BasePrice.FloatString(2))
+		}
+	}
+
+	// Changes within the threshold apply directly
+	smallChange := domain.NewMoney(10500)
+	resp, err := ts.changeBasePrice.Execute(ts.ctx, &change_base_price.Request{ProductID: created.ProductID, BasePrice: &smallChange})
+	if err != nil {
+		t.Fatalf("Failed to change base price: %v", err)
+	}
+	if resp.PendingChangeID != "" {
+		t.Errorf("Expected a direct change, got pending change %s", resp.PendingChangeID)
+	}
+	assertPrice(10500)
+
+	// Larger changes are held until someone other than the requester approves them
+	bigChange := domain.NewMoney(5000)
+	if _, err := ts.changeBasePrice.Execute(ts.ctx, &change_base_price.Request{ProductID: created.ProductID, BasePrice: &bigChange}); !errors.Is(err, domain.ErrInvalidApprover) {
+		t.Errorf("Expected ErrInvalidApprover without a requester, got %v", err)
+	}
+	resp, err = ts.changeBasePrice.Execute(ts.ctx, &change_base_price.Request{ProductID: created.ProductID, BasePrice: &bigChange, RequestedBy: "alice"})
+	if err != nil {
+		t.Fatalf("Failed to request price change: %v", err)
+	}
+	if resp.PendingChangeID == "" {
+		t.Fatal("Expected a pending change")
+	}
+	assertPrice(10500)
+
+	competing := domain.NewMoney(20000)
+	competingResp, err := ts.changeBasePrice.Execute(ts.ctx, &change_base_price.Request{ProductID: created.ProductID, BasePrice: &competing, RequestedBy: "carol"})
+	if err != nil {
+		t.Fatalf("Failed to request price change: %v", err)
+	}
+
+	approve := &decide_change.Request{ChangeID: resp.PendingChangeID, Decision: domain.ReviewApproved, Approver: "alice"}
+	if _, err := ts.decideChange.Execute(ts.ctx, approve); !errors.Is(err, domain.ErrSelfApproval) {
+		t.Errorf("Expected ErrSelfApproval, got %v", err)
+	}
+	approve.Approver = "bob"
+	decided, err := ts.decideChange.Execute(ts.ctx, approve)
+	if err != nil {
+		t.Fatalf("Failed to approve change: %v", err)
+	}
+	if decided.Status != domain.PendingChangeStatusApproved {
+		t.Errorf("Expected approved status, got %s", decided.Status)
+	}
+	assertPrice(5000)
+	if _, err := ts.decideChange.Execute(ts.ctx, approve); !errors.Is(err, domain.ErrChangeNotPending) {
+		t.Errorf("Expected ErrChangeNotPending, got %v", err)
+	}
+
+	// A change requested against an older price can no longer be approved, only rejected
+	if _, err := ts.decideChange.Execute(ts.ctx, &decide_change.Request{ChangeID: competingResp.PendingChangeID, Decision: domain.ReviewApproved, Approver: "bob"}); !errors.Is(err, domain.ErrChangeStale) {
+		t.Errorf("Expected ErrChangeStale, got %v", err)
+	}
+	reject := &decide_change.Request{ChangeID: competingResp.PendingChangeID, Decision: domain.ReviewRejected, Approver: "bob"}
+	if _, err := ts.decideChange.Execute(ts.ctx, reject); !errors.Is(err, domain.ErrInvalidReviewComment) {
+		t.Errorf("Expected ErrInvalidReviewComment without a reason, got %v", err)
+	}
+	reject.Note = "superseded by the approved price"
+	if _, err := ts.decideChange.Execute(ts.ctx, reject); err != nil {
+		t.Fatalf("Failed to reject change: %v", err)
+	}
+	assertPrice(5000)
+
+	// Every step is audited in the outbox
+	for eventType, want := range map[string]int64{"base_price_changed": 2, "price_change_requested": 2, "change_approved": 1, "change_rejected": 1} {
+		var count int64
+		stmt := spanner.Statement{
+			SQL:    "SELECT COUNT(*) FROM outbox_events_by_aggregate WHERE aggregate_id = @id AND event_type = @type",
+			Params: map[string]interface{}{"id": created.ProductID, "type": eventType},
+		}
+		if err := ts.spannerClient.Single().Query(ts.ctx, stmt).Do(func(row *spanner.Row) error {
+			return row.Columns(&count)
+		}); err != nil {
+			t.Fatalf("Failed to count events: %v", err)
+		}
+		if count != want {
+			t.Errorf("Expected %d %s events, got %d", want, eventType, count)
+		}
+	}
+}
+
+func TestPriceFloors(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	basePrice := domain.NewMoney(10000)
+	created, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{
+		Name:        "Blender",
+		Description: "1200W countertop blender",
+		Category:    "Kitchen",
+		BasePrice:   &basePrice,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+	if _, err := ts.activateProduct.Execute(ts.ctx, &activate_product.Request{ProductID: created.ProductID}); err != nil {
+		t.Fatalf("Failed to activate product: %v", err)
+	}
+	money := func(cents int64) *domain.Money {
+		m := domain.NewMoney(cents)
+		return &m
+	}
+
+	// A margin needs a cost, and the current price must already respect a new floor
+	if _, err := ts.setPriceFloor.Execute(ts.ctx, &set_price_floor.Request{ProductID: created.ProductID, PriceFloor: domain.PriceFloor{MinMarginPercent: 25}}); !errors.Is(err, domain.ErrInvalidPriceFloor) {
+		t.Errorf("Expected ErrInvalidPriceFloor, got %v", err)
+	}
+	if _, err := ts.setPriceFloor.Execute(ts.ctx, &set_price_floor.Request{ProductID: created.ProductID, PriceFloor: domain.PriceFloor{MinPrice: money(15000)}}); !errors.Is(err, domain.ErrPriceBelowFloor) {
+		t.Errorf("Expected ErrPriceBelowFloor, got %v", err)
+	}
+
+	// A cost of 60.00 with a 25% margin puts the floor at 80.00
+	floor := domain.PriceFloor{Cost: money(6000), MinMarginPercent: 25}
+	if _, err := ts.setPriceFloor.Execute(ts.ctx, &set_price_floor.Request{ProductID: created.ProductID, PriceFloor: floor}); err != nil {
+		t.Fatalf("Failed to set price floor: %v", err)
+	}
+	got, err := ts.getProductQuery.Execute(ts.ctx, created.ProductID)
+	if err != nil {
+		t.Fatalf("Failed to get product: %v", err)
+	}
+	if got.PriceFloor.Floor().Cmp(big.NewRat(80, 1)) != 0 {
+		t.Errorf("Expected a floor of 80, got %v", got.PriceFloor.Floor())
+	}
+
+	// Price changes below the floor are refused before any approval
+	if _, err := ts.changeBasePrice.Execute(ts.ctx, &change_base_price.Request{ProductID: created.ProductID, BasePrice: money(7900), RequestedBy: "alice"}); !errors.Is(err, domain.ErrPriceBelowFloor) {
+		t.Errorf("Expected ErrPriceBelowFloor, got %v", err)
+	}
+
+	// Discounts may not take the effective price below the floor either
+	now := time.Now()
+	discount := func(percent int64) *apply_discount.Request {
+		amount := domain.NewMoney(percent)
+		return &apply_discount.Request{
+			ProductID: created.ProductID,
+			Discount:  &domain.Discount{ID: fmt.Sprintf("discount-%d", percent), Amount: &amount, StartDate: now.Add(-time.Hour), EndDate: now.Add(24 * time.Hour)},
+		}
+	}
+	if _, err := ts.applyDiscount.Execute(ts.ctx, discount(25)); !errors.Is(err, domain.ErrPriceBelowFloor) {
+		t.Errorf("Expected ErrPriceBelowFloor for a 25%% discount, got %v", err)
+	}
+	if _, err := ts.applyDiscount.Execute(ts.ctx, discount(15)); err != nil {
+		t.Fatalf("Failed to apply discount: %v", err)
+	}
+
+	// With the discount running, a base price of 94.00 would sell at 79.90
+	if _, err := ts.changeBasePrice.Execute(ts.ctx, &change_base_price.Request{ProductID: created.ProductID, BasePrice: money(9400)}); !errors.Is(err, domain.ErrPriceBelowFloor) {
+		t.Errorf("Expected ErrPriceBelowFloor with the discount applied, got %v", err)
+	}
+	if _, err := ts.changeBasePrice.Execute(ts.ctx, &change_base_price.Request{ProductID: created.ProductID, BasePrice: money(9500)}); err != nil {
+		t.Fatalf("Failed to change base price: %v", err)
+	}
+}
+
+func TestMinimumAdvertisedPrice(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	basePrice := domain.NewMoney(10000)
+	created, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{
+		Name:        "Espresso Machine",
+		Description: "15 bar pump espresso machine",
+		Category:    "MAP Appliances",
+		BasePrice:   &basePrice,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+	if _, err := ts.activateProduct.Execute(ts.ctx, &activate_product.Request{ProductID: created.ProductID}); err != nil {
+		t.Fatalf("Failed to activate product: %v", err)
+	}
+
+	// MAP does not limit the price charged, so a 20% discount below it is accepted
+	mapPrice := domain.NewMoney(9000)
+	if _, err := ts.setPriceFloor.Execute(ts.ctx, &set_price_floor.Request{ProductID: created.ProductID, PriceFloor: domain.PriceFloor{MapPrice: &mapPrice}}); err != nil {
+		t.Fatalf("Failed to set MAP: %v", err)
+	}
+	now := time.Now()
+	amount := domain.NewMoney(20)
+	if _, err := ts.applyDiscount.Execute(ts.ctx, &apply_discount.Request{
+		ProductID: created.ProductID,
+		Discount:  &domain.Discount{ID: "map-discount", Amount: &amount, StartDate: now.Add(-time.Hour), EndDate: now.Add(24 * time.Hour)},
+	}); err != nil {
+		t.Fatalf("Failed to apply discount: %v", err)
+	}
+
+	// The 80.00 effective price is shown as the 90.00 MAP, with the true discount kept
+	got, err := ts.getProductQuery.Execute(ts.ctx, created.ProductID)
+	if err != nil {
+		t.Fatalf("Failed to get product: %v", err)
+	}
+	if !got.MapApplied || got.EffectivePrice.Cmp(big.NewRat(90, 1)) != 0 {
+		t.Errorf("Expected the MAP of 90 to be shown, got %v (map applied %v)", got.EffectivePrice, got.MapApplied)
+	}
+	if got.DiscountAmount == nil || got.DiscountAmount.Cmp(big.NewRat(1, 5)) != 0 {
+		t.Errorf("Expected the 20%% discount to be kept, got %v", got.DiscountAmount)
+	}
+
+	result, err := ts.listProductsQuery.Execute(ts.ctx, &list_products.Request{Category: "MAP Appliances", Limit: 10})
+	if err != nil {
+		t.Fatalf("Failed to list products: %v", err)
+	}
+	if len(result.Products) != 1 || !result.Products[0].MapApplied || result.Products[0].EffectivePrice.Cmp(big.NewRat(90, 1)) != 0 {
+		t.Errorf("Expected the listed product to show the MAP, got %+v", result.Products)
+	}
+
+	// Without a discount the price is above the MAP and shown as is
+	if _, err := ts.removeDiscount.Execute(ts.ctx, &remove_discount.Request{ProductID: created.ProductID}); err != nil {
+		t.Fatalf("Failed to remove discount: %v", err)
+	}
+	got, err = ts.getProductQuery.Execute(ts.ctx, created.ProductID)
+	if err != nil {
+		t.Fatalf("Failed to get product: %v", err)
+	}
+	if got.MapApplied || got.EffectivePrice.Cmp(big.NewRat(100, 1)) != 0 {
+		t.Errorf("Expected the base price of 100 to be shown, got %v (map applied %v)", got.EffectivePrice, got.MapApplied)
+	}
+}
+
+func TestSearchMerchandising(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	create := func(name, description, category string, active bool) string {
+		price := domain.NewMoney(5000)
+		resp, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{Name: name, Description: description, Category: category, BasePrice: &price})
+		if err != nil {
+			t.Fatalf("Failed to create product %q: %v", name, err)
+		}
+		if active {
+			if _, err := ts.activateProduct.Execute(ts.ctx, &activate_product.Request{ProductID: resp.ProductID}); err != nil {
+				t.Fatalf("Failed to activate product %q: %v", name, err)
+			}
+		}
+		return resp.ProductID
+	}
+	ultrabook := create("Ultrabook Laptop", "Thin and light 13 inch notebook", "Computers", true)
+	gaming := create("Gaming Laptop", "RTX graphics and a 240Hz screen", "Gaming", true)
+	sleeve := create("Laptop Sleeve", "Padded sleeve for any laptop", "Accessories", true)
+	create("Laptop Stand", "Aluminium stand", "Accessories", false)
+	lamp := create("Desk Lamp", "LED lamp with a dimmer", "Furniture", true)
+
+	search := func(query string) []search_products.Hit {
+		t.Helper()
+		dto, err := ts.searchProducts.Execute(ts.ctx, &search_products.Request{TenantID: tenant.FromContext(ts.ctx), Query: query})
+		if err != nil {
+			t.Fatalf("Failed to search %q: %v", query, err)
+		}
+		return dto.Hits
+	}
+	ids := func(hits []search_products.Hit) []string {
+		out := make([]string, len(hits))
+		for i, hit := range hits {
+			out[i] = hit.ID
+		}
+		return out
+	}
+
+	// Inactive products are never found; a match in the description adds to a match in the name
+	if got, want := ids(search("Laptop")), []string{sleeve, gaming, ultrabook}; !slices.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+
+	// A boost without a query applies to every search
+	if _, err := ts.createMerchRule.Execute(ts.ctx, &create_merch_rule.Request{Rule: domain.MerchRule{Kind: domain.MerchRuleKindBoost, Category: "Gaming", Boost: 3}}); err != nil {
+		t.Fatalf("Failed to create boost: %v", err)
+	}
+	if got := search("laptop"); len(got) != 3 || got[0].ID != gaming || got[0].Score != 6 {
+		t.Errorf("Expected the boosted gaming laptop first with score 6, got %+v", got)
+	}
+
+	// Pins need a position, and place products for the normalized query even when they do not match it
+	if _, err := ts.createMerchRule.Execute(ts.ctx, &create_merch_rule.Request{Rule: domain.MerchRule{Kind: domain.MerchRuleKindPin, Query: "laptop", ProductID: lamp}}); !errors.Is(err, domain.ErrInvalidMerchRule) {
+		t.Errorf("Expected ErrInvalidMerchRule, got %v", err)
+	}
+	pin, err := ts.createMerchRule.Execute(ts.ctx, &create_merch_rule.Request{Rule: domain.MerchRule{Kind: domain.MerchRuleKindPin, Query: "  LAPTOP ", ProductID: lamp, Position: 1}})
+	if err != nil {
+		t.Fatalf("Failed to create pin: %v", err)
+	}
+	hits := search("laptop")
+	if got, want := ids(hits), []string{lamp, gaming, sleeve, ultrabook}; !slices.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+	if !hits[0].Pinned || hits[1].Pinned {
+		t.Errorf("Expected only the lamp to be pinned, got %+v", hits)
+	}
+	if got := ids(search("gaming laptop")); slices.Contains(got, lamp) {
+		t.Errorf("Expected the pin to apply to its query only, got %v", got)
+	}
+
+	// Deleting the pin restores the ranking
+	if _, err := ts.deleteMerchRule.Execute(ts.ctx, &delete_merch_rule.Request{RuleID: pin.RuleID}); err != nil {
+		t.Fatalf("Failed to delete pin: %v", err)
+	}
+	if got := ids(search("laptop")); slices.Contains(got, lamp) {
+		t.Errorf("Expected the lamp to be gone once unpinned, got %v", got)
+	}
+	if _, err := ts.deleteMerchRule.Execute(ts.ctx, &delete_merch_rule.Request{RuleID: pin.RuleID}); !errors.Is(err, domain.ErrMerchRuleNotFound) {
+		t.Errorf("Expected ErrMerchRuleNotFound, got %v", err)
+	}
+}
+
+func TestSearchSynonymsAndTypos(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	create := func(name, description string) string {
+		price := domain.NewMoney(2500)
+		resp, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{Name: name, Description: description, Category: "Apparel", BasePrice: &price})
+		if err != nil {
+			t.Fatalf("Failed to create product %q: %v", name, err)
+		}
+		if _, err := ts.activateProduct.Execute(ts.ctx, &activate_product.Request{ProductID: resp.ProductID}); err != nil {
+			t.Fatalf("Failed to activate product %q: %v", name, err)
+		}
+		return resp.ProductID
+	}
+	cotton := create("Cotton T-Shirt", "Crew neck, regular fit")
+	graphic := create("Graphic Tee", "Screen printed front")
+	headphones := create("Wireless Headphones", "Noise cancelling, 30 hour battery")
+
+	search := func(query string) []search_products.Hit {
+		t.Helper()
+		dto, err := ts.searchProducts.Execute(ts.ctx, &search_products.Request{TenantID: tenant.FromContext(ts.ctx), Query: query})
+		if err != nil {
+			t.Fatalf("Failed to search %q: %v", query, err)
+		}
+		return dto.Hits
+	}
+	ids := func(hits []search_products.Hit) []string {
+		out := make([]string, len(hits))
+		for i, hit := range hits {
+			out[i] = hit.ID
+		}
+		sort.Strings(out)
+		return out
+	}
+
+	// Every word of a synonym group finds the whole group
+	want := []string{cotton, graphic}
+	sort.Strings(want)
+	for _, query := range []string{"tshirt", "T-Shirt", "tee"} {
+		if got := ids(search(query)); !slices.Equal(got, want) {
+			t.Errorf("Search %q: expected %v, got %v", query, want, got)
+		}
+	}
+
+	// Typos still match, at half the relevance of an exact match
+	for _, query := range []string{"headphnoes", "wireles headphones"} {
+		hits := search(query)
+		if len(hits) != 1 || hits[0].ID != headphones {
+			t.Errorf("Search %q: expected the headphones, got %+v", query, hits)
+		}
+	}
+	if hits := search("headphnoes"); len(hits) == 1 && hits[0].Score != 1 {
+		t.Errorf("Expected a typo match in the name to score 1, got %v", hits[0].Score)
+	}
+
+	// Short words must match exactly
+	if hits := search("tea"); len(hits) != 0 {
+		t.Errorf("Expected no match for a short misspelled word, got %+v", hits)
+	}
+
+	// Tenants excluded from the search_fuzzy flag only get exact matches
+	exact := tenant.WithID(ts.ctx, exactSearchTenant)
+	price := domain.NewMoney(2500)
+	resp, err := ts.createProduct.Execute(exact, &create_product.Request{Name: "Wireless Headphones", Description: "Noise cancelling", Category: "Audio", BasePrice: &price})
+	if err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+	if _, err := ts.activateProduct.Execute(exact, &activate_product.Request{ProductID: resp.ProductID}); err != nil {
+		t.Fatalf("Failed to activate product: %v", err)
+	}
+	for query, want := range map[string]int{"headphones": 1, "headphnoes": 0} {
+		dto, err := ts.searchProducts.Execute(exact, &search_products.Request{TenantID: exactSearchTenant, Query: query})
+		if err != nil {
+			t.Fatalf("Failed to search %q: %v", query, err)
+		}
+		if len(dto.Hits) != want {
+			t.Errorf("Search %q without fuzzy matching: expected %d hits, got %+v", query, want, dto.Hits)
+		}
+	}
+}
+
+// memorySearchIndex records the documents an index sync writes
+type memorySearchIndex struct {
+	docs map[string]*get_product.DTO
+}
+
+func (m *memorySearchIndex) Upsert(_ context.Context, product *get_product.DTO) error {
+	m.docs[product.ID] = product
+	return nil
+}
+
+func (m *memorySearchIndex) Delete(_ context.Context, productID string) error {
+	delete(m.docs, productID)
+	return nil
+}
+
+func TestSyncSearchIndex(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	index := &memorySearchIndex{docs: map[string]*get_product.DTO{}}
+	sync := sync_search_index.NewInteractor(
+		repo.NewSpannerOutboxFeed(ts.spannerClient),
+		repo.NewSpannerReadModel(ts.spannerClient, 0),
+		index,
+		spannerdriver.NewCommitter(ts.spannerClient),
+		clock.NewRealClock(),
+	)
+	run := func() *sync_search_index.Response {
+		t.Helper()
+		resp, err := sync.Execute(ts.ctx, &sync_search_index.Request{BatchSize: 2})
+		if err != nil {
+			t.Fatalf("Failed to sync search index: %v", err)
+		}
+		return resp
+	}
+
+	price := domain.NewMoney(2500)
+	var ids []string
+	for _, name := range []string{"Desk Lamp", "Floor Lamp", "Lamp Shade"} {
+		created, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{Name: name, Description: "Lighting", Category: "Home", BasePrice: &price})
+		if err != nil {
+			t.Fatalf("Failed to create product %q: %v", name, err)
+		}
+		ids = append(ids, created.ProductID)
+	}
+	for _, id := range ids[:2] {
+		if _, err := ts.activateProduct.Execute(ts.ctx, &activate_product.Request{ProductID: id}); err != nil {
+			t.Fatalf("Failed to activate product: %v", err)
+		}
+	}
+
+	// The backfill indexes active products only, across several batches
+	resp := run()
+	if resp.Events < 5 || resp.Indexed+resp.Removed < 3 {
+		t.Errorf("Expected at least 5 events covering 3 products, got %+v", resp)
+	}
+	if len(index.docs) != 2 || index.docs[ids[0]] == nil || index.docs[ids[1]] == nil {
+		t.Errorf("Expected the two active products indexed, got %v", slices.Collect(maps.Keys(index.docs)))
+	}
+
+	// Processed events are not applied again
+	if resp := run(); resp.Events != 0 {
+		t.Errorf("Expected nothing left to sync, got %+v", resp)
+	}
+
+	// Changes reach the index on the next run
+	if _, err := ts.archiveProduct.Execute(ts.ctx, &archive_product.Request{ProductID: ids[0]}); err != nil {
+		t.Fatalf("Failed to archive product: %v", err)
+	}
+	newName := "Reading Lamp"
+	if _, err := ts.updateProduct.Execute(ts.ctx, &update_product.Request{ProductID: ids[1], Name: &newName}); err != nil {
+		t.Fatalf("Failed to update product: %v", err)
+	}
+	run()
+	if _, ok := index.docs[ids[0]]; ok {
+		t.Error("Expected the archived product removed from the index")
+	}
+	if doc := index.docs[ids[1]]; doc == nil || doc.Name != newName {
+		t.Errorf("Expected the renamed product reindexed, got %+v", doc)
+	}
+}
+
+// memoryPurger records the URLs a CDN purge sends, failing every call while err is set
+type memoryPurger struct {
+	urls []string
+	err  error
+}
+
+func (m *memoryPurger) Purge(_ context.Context, urls []string) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.urls = append(m.urls, urls...)
+	return nil
+}
+
+func TestPurgeCDNCache(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	purger := &memoryPurger{}
+	purge := purge_cdn_cache.NewInteractor(
+		repo.NewSpannerOutboxFeed(ts.spannerClient),
+		repo.NewSpannerReadModel(ts.spannerClient, 0),
+		purger,
+		spannerdriver.NewCommitter(ts.spannerClient),
+		clock.NewRealClock(),
+	)
+	req := &purge_cdn_cache.Request{
+		ProductURLs:  []string{"https://{tenant}.shop.example.com/p/{id}"},
+		CategoryURLs: []string{"https://{tenant}.shop.example.com/c/{category}"},
+		BatchSize:    2,
+	}
+
+	price := domain.NewMoney(2500)
+	created, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{Name: "Desk Lamp", Description: "Lighting", Category: "Home Office", BasePrice: &price})
+	if err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+	id := created.ProductID
+
+	// Creating a draft changes no cached page
+	resp, err := purge.Execute(ts.ctx, req)
+	if err != nil {
+		t.Fatalf("Failed to purge CDN cache: %v", err)
+	}
+	if resp.Events == 0 || resp.URLs != 0 || len(purger.urls) != 0 {
+		t.Errorf("Expected the create event processed without a purge, got %+v and %v", resp, purger.urls)
+	}
+
+	// A failed purge leaves its events for the next run
+	if _, err := ts.activateProduct.Execute(ts.ctx, &activate_product.Request{ProductID: id}); err != nil {
+		t.Fatalf("Failed to activate product: %v", err)
+	}
+	newName := "Reading Lamp"
+	if _, err := ts.updateProduct.Execute(ts.ctx, &update_product.Request{ProductID: id, Name: &newName}); err != nil {
+		t.Fatalf("Failed to update product: %v", err)
+	}
+	purger.err = errors.New("cdn unavailable")
+	if _, err := purge.Execute(ts.ctx, req); err == nil {
+		t.Fatal("Expected the purge to fail")
+	}
+	purger.err = nil
+
+	// The product's page and its category page are purged once per batch, with escaped placeholders
+	resp, err = purge.Execute(ts.ctx, req)
+	if err != nil {
+		t.Fatalf("Failed to purge CDN cache: %v", err)
+	}
+	want := []string{
+		"https://" + tenant.DefaultID + ".shop.example.com/p/" + id,
+		"https://" + tenant.DefaultID + ".shop.example.com/c/Home%20Office",
+	}
+	if resp.Events != 2 || !slices.Equal(purger.urls, want) {
+		t.Errorf("Expected 2 events purging %v, got %+v and %v", want, resp, purger.urls)
+	}
+
+	// Processed events are not purged again
+	if resp, err := purge.Execute(ts.ctx, req); err != nil || resp.Events != 0 {
+		t.Errorf("Expected nothing left to purge, got %+v, %v", resp, err)
+	}
+}
+
+func TestSuggestProducts(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	price := domain.NewMoney(2500)
+	create := func(name, category string, active bool) {
+		t.Helper()
+		created, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{Name: name, Description: "Suggested", Category: category, BasePrice: &price})
+		if err != nil {
+			t.Fatalf("Failed to create product %q: %v", name, err)
+		}
+		if !active {
+			return
+		}
+		if _, err := ts.activateProduct.Execute(ts.ctx, &activate_product.Request{ProductID: created.ProductID}); err != nil {
+			t.Fatalf("Failed to activate product %q: %v", name, err)
+		}
+	}
+	create("Desk Lamp", "Lighting", true)
+	create("Desk Chair", "Office", true)
+	create("Desktop Stand", "Office", true)
+	create("Desk Organizer", "Office", false)
+
+	store := repo.NewSpannerSuggestionStore(ts.spannerClient)
+	suggest := suggest_products.NewQuery(store)
+	run := func(prefix string) []suggest_products.Suggestion {
+		t.Helper()
+		dto, err := suggest.Execute(ts.ctx, &suggest_products.Request{TenantID: tenant.FromContext(ts.ctx), Prefix: prefix})
+		if err != nil {
+			t.Fatalf("Failed to suggest %q: %v", prefix, err)
+		}
+		return dto.Suggestions
+	}
+
+	// Nothing is suggested before the projection is refreshed
+	if got := run("desk"); len(got) != 0 {
+		t.Errorf("Expected no suggestions before a refresh, got %+v", got)
+	}
+
+	refresh := refresh_product_suggestions.NewInteractor(store, clock.NewRealClock())
+	if _, err := refresh.Execute(ts.ctx); err != nil {
+		t.Fatalf("Failed to refresh suggestions: %v", err)
+	}
+
+	// Active product names complete the prefix; inactive ones are left out
+	var names []string
+	for _, suggestion := range run("DESK") {
+		if suggestion.Kind != suggest_products.KindName || suggestion.Popularity != 1 {
+			t.Errorf("Expected name suggestions with popularity 1, got %+v", suggestion)
+		}
+		names = append(names, suggestion.Text)
+	}
+	sort.Strings(names)
+	if want := []string{"Desk Chair", "Desk Lamp", "Desktop Stand"}; !slices.Equal(names, want) {
+		t.Errorf("Expected %v, got %v", want, names)
+	}
+
+	// A trailing space only completes whole words
+	if got := run("desk "); len(got) != 2 {
+		t.Errorf("Expected 2 suggestions for \"desk \", got %+v", got)
+	}
+
+	// Categories rank by the number of active products in them
+	got := run("o")
+	if len(got) != 1 || got[0].Text != "Office" || got[0].Kind != suggest_products.KindCategory || got[0].Popularity != 2 {
+		t.Errorf("Expected the Office category with popularity 2, got %+v", got)
+	}
+}
+
+func TestProductViews(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	price := domain.NewMoney(2500)
+	var ids []string
+	for _, name := range []string{"Oak Table", "Pine Table", "Birch Table"} {
+		created, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{Name: name, Description: "Furniture", Category: "Tables", BasePrice: &price})
+		if err != nil {
+			t.Fatalf("Failed to create product %q: %v", name, err)
+		}
+		ids = append(ids, created.ProductID)
+	}
+
+	store := repo.NewSpannerViewStore(ts.spannerClient)
+	buffer := coalesce.NewBuffer("test_views", 100, func(ctx context.Context, counts map[contracts.ViewKey]int64) error {
+		return store.AddViews(ctx, counts, time.Now())
+	})
+	record := record_product_view.NewInteractor(buffer)
+	view := func(ctx context.Context, id string, n int) {
+		for i := 0; i < n; i++ {
+			record.Execute(ctx, &record_product_view.Request{ProductID: id})
+		}
+	}
+
+	// Views coalesce in memory and are written across two flushes
+	view(ts.ctx, ids[1], 3)
+	view(ts.ctx, ids[2], 1)
+	if err := buffer.Flush(ts.ctx); err != nil {
+		t.Fatalf("Failed to flush views: %v", err)
+	}
+	view(ts.ctx, ids[1], 2)
+	view(ts.ctx, ids[2], 1)
+	// Views of unknown products and of another tenant's products are discarded
+	view(ts.ctx, "no-such-product", 5)
+	view(tenant.WithID(ts.ctx, "other-tenant"), ids[0], 50)
+	if err := buffer.Flush(ts.ctx); err != nil {
+		t.Fatalf("Failed to flush views: %v", err)
+	}
+
+	stats, err := get_product_stats.NewQuery(store).Execute(ts.ctx, ids)
+	if err != nil {
+		t.Fatalf("Failed to get product stats: %v", err)
+	}
+	var counts []int64
+	for _, entry := range stats.Stats {
+		counts = append(counts, entry.ViewCount)
+	}
+	if want := []int64{0, 5, 2}; !slices.Equal(counts, want) {
+		t.Errorf("Expected view counts %v, got %v", want, counts)
+	}
+	if stats.Stats[0].LastViewedAt != nil || stats.Stats[1].LastViewedAt == nil {
+		t.Errorf("Expected last viewed times only for viewed products, got %+v", stats.Stats)
+	}
+
+	// Popularity orders the list by views, unviewed products last
+	result, err := ts.listProductsQuery.Execute(ts.ctx, &list_products.Request{
+		TenantID: tenant.DefaultID,
+		Category: "Tables",
+		OrderBy:  list_products.OrderByPopularity,
+	})
+	if err != nil {
+		t.Fatalf("Failed to list products: %v", err)
+	}
+	var order []string
+	for _, product := range result.Products {
+		order = append(order, product.ID)
+	}
+	if want := []string{ids[1], ids[2], ids[0]}; !slices.Equal(order, want) {
+		t.Errorf("Expected popularity order %v, got %v", want, order)
+	}
+}
+
+func TestCuratedLists(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	price := domain.NewMoney(2500)
+	var ids []string
+	for _, name := range []string{"Wool Scarf", "Silk Scarf", "Linen Scarf", "Cotton Scarf"} {
+		created, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{Name: name, Description: "Accessories", Category: "Scarves", BasePrice: &price})
+		if err != nil {
+			t.Fatalf("Failed to create product %q: %v", name, err)
+		}
+		ids = append(ids, created.ProductID)
+	}
+	// The last product stays a draft and is in neither list
+	for _, id := range ids[:3] {
+		if _, err := ts.activateProduct.Execute(ts.ctx, &activate_product.Request{ProductID: id}); err != nil {
+			t.Fatalf("Failed to activate product: %v", err)
+		}
+	}
+
+	views := repo.NewSpannerViewStore(ts.spannerClient)
+	addViews := func(counts map[string]int64) {
+		t.Helper()
+		batch := make(map[contracts.ViewKey]int64, len(counts))
+		for id, n := range counts {
+			batch[contracts.ViewKey{TenantID: tenant.DefaultID, ProductID: id}] = n
+		}
+		if err := views.AddViews(ts.ctx, batch, time.Now()); err != nil {
+			t.Fatalf("Failed to add views: %v", err)
+		}
+	}
+	addViews(map[string]int64{ids[0]: 1, ids[1]: 5, ids[3]: 9})
+
+	store := repo.NewSpannerCuratedListStore(ts.spannerClient)
+	query := list_curated_products.NewQuery(store, repo.NewSpannerReadModel(ts.spannerClient, 0), domainServices.NewPricingCalculator(true), clock.NewRealClock())
+	list := func(name string) (*list_curated_products.DTO, []string) {
+		t.Helper()
+		dto, err := query.Execute(ts.ctx, &list_curated_products.Request{List: name})
+		if err != nil {
+			t.Fatalf("Failed to list %s: %v", name, err)
+		}
+		var order []string
+		for _, product := range dto.Products {
+			order = append(order, product.ID)
+		}
+		return dto, order
+	}
+
+	// Lists are empty until the job has run
+	if dto, order := list(list_curated_products.ListTrending); len(order) != 0 || dto.ComputedAt != nil {
+		t.Errorf("Expected an empty trending list before a refresh, got %+v", dto)
+	}
+
+	refresh := refresh_curated_lists.NewInteractor(store, clock.NewRealClock())
+	request := &refresh_curated_lists.Request{Size: 10, NewArrivalsWindow: time.Hour, TrendingHalfLife: 24 * time.Hour}
+	if _, err := refresh.Execute(ts.ctx, request); err != nil {
+		t.Fatalf("Failed to refresh curated lists: %v", err)
+	}
+
+	dto, order := list(list_curated_products.ListNewArrivals)
+	if want := []string{ids[2], ids[1], ids[0]}; !slices.Equal(order, want) {
+		t.Errorf("Expected new arrivals %v, got %v", want, order)
+	}
+	if dto.ComputedAt == nil || dto.Products[0].EffectivePrice == nil {
+		t.Errorf("Expected a computed time and effective prices, got %+v", dto)
+	}
+	if _, order := list(list_curated_products.ListTrending); !slices.Equal(order, []string{ids[1], ids[0]}) {
+		t.Errorf("Expected trending %v, got %v", []string{ids[1], ids[0]}, order)
+	}
+
+	// Views since the last run are added to the score on the next one
+	addViews(map[string]int64{ids[0]: 10})
+	if _, err := refresh.Execute(ts.ctx, request); err != nil {
+		t.Fatalf("Failed to refresh curated lists: %v", err)
+	}
+	if _, order := list(list_curated_products.ListTrending); !slices.Equal(order, []string{ids[0], ids[1]}) {
+		t.Errorf("Expected trending %v, got %v", []string{ids[0], ids[1]}, order)
+	}
+
+	// Products deactivated after the last run are skipped
+	if _, err := ts.deactivateProduct.Execute(ts.ctx, &deactivate_product.Request{ProductID: ids[0]}); err != nil {
+		t.Fatalf("Failed to deactivate product: %v", err)
+	}
+	if _, order := list(list_curated_products.ListTrending); !slices.Equal(order, []string{ids[1]}) {
+		t.Errorf("Expected trending %v, got %v", []string{ids[1]}, order)
+	}
+}
+
+// fixedRecommender stands in for a pluggable recommender such as an ML-backed one
+type fixedRecommender []string
+
+func (r fixedRecommender) Recommend(ctx context.Context, seed *get_product.DTO, limit int) ([]string, error) {
+	return r, nil
+}
+
+func TestGetRecommendations(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	price := domain.NewMoney(4000)
+	create := func(name, category string, active bool) string {
+		t.Helper()
+		created, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{Name: name, Description: "Footwear", Category: category, BasePrice: &price})
+		if err != nil {
+			t.Fatalf("Failed to create product %q: %v", name, err)
+		}
+		if active {
+			if _, err := ts.activateProduct.Execute(ts.ctx, &activate_product.Request{ProductID: created.ProductID}); err != nil {
+				t.Fatalf("Failed to activate product %q: %v", name, err)
+			}
+		}
+		return created.ProductID
+	}
+	seed := create("Trail Runner", "Shoes", true)
+	older := create("Road Runner", "Shoes", true)
+	newer := create("Court Shoe", "Shoes", true)
+	draft := create("Hiking Boot", "Shoes", false)
+	other := create("Running Socks", "Socks", true)
+
+	// The popular peer leads; unviewed peers follow newest first
+	err := repo.NewSpannerViewStore(ts.spannerClient).AddViews(ts.ctx, map[contracts.ViewKey]int64{
+		{TenantID: tenant.DefaultID, ProductID: older}: 3,
+	}, time.Now())
+	if err != nil {
+		t.Fatalf("Failed to add views: %v", err)
+	}
+
+	readModel := repo.NewSpannerReadModel(ts.spannerClient, 0)
+	calculator := domainServices.NewPricingCalculator(true)
+	recommend := func(recommender get_recommendations.Recommender, ctx context.Context, id string) ([]string, error) {
+		t.Helper()
+		dto, err := get_recommendations.NewQuery(recommender, readModel, calculator, clock.NewRealClock()).Execute(ctx, &get_recommendations.Request{ProductID: id})
+		if err != nil {
+			return nil, err
+		}
+		var ids []string
+		for _, product := range dto.Products {
+			if product.EffectivePrice == nil {
+				t.Errorf("Expected an effective price for %s", product.ID)
+			}
+			ids = append(ids, product.ID)
+		}
+		return ids, nil
+	}
+
+	coCategory := repo.NewSpannerCoCategoryRecommender(ts.spannerClient)
+	got, err := recommend(coCategory, ts.ctx, seed)
+	if err != nil {
+		t.Fatalf("Failed to get recommendations: %v", err)
+	}
+	if want := []string{older, newer}; !slices.Equal(got, want) {
+		t.Errorf("Expected co-category recommendations %v, got %v", want, got)
+	}
+
+	// Whatever a recommender returns, only other active products of the tenant are served
+	got, err = recommend(fixedRecommender{other, seed, draft, "no-such-product", other, newer}, ts.ctx, seed)
+	if err != nil {
+		t.Fatalf("Failed to get recommendations: %v", err)
+	}
+	if want := []string{other, newer}; !slices.Equal(got, want) {
+		t.Errorf("Expected filtered recommendations %v, got %v", want, got)
+	}
+
+	// A seed of another tenant is not found
+	if _, err := recommend(coCategory, tenant.WithID(ts.ctx, "other-tenant"), seed); !errors.Is(err, domain.ErrProductNotFound) {
+		t.Errorf("Expected ErrProductNotFound for another tenant's seed, got %v", err)
+	}
+}
+
+// memoryBucket stands in for the Cloud Storage feed bucket
+type memoryBucket map[string][]byte
+
+func (b memoryBucket) Upload(_ context.Context, object, _ string, data []byte) error {
+	b[object] = data
+	return nil
+}
+
+func TestGenerateProductFeeds(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	price := domain.NewMoney(2500)
+	create := func(name string, channels []domain.Channel, active bool) string {
+		t.Helper()
+		created, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{Name: name, Description: "Kitchen", Category: "Kettles", SKU: "SKU-" + name, BasePrice: &price})
+		if err != nil {
+			t.Fatalf("Failed to create product %q: %v", name, err)
+		}
+		if _, err := ts.setChannels.Execute(ts.ctx, &set_channels.Request{ProductID: created.ProductID, Channels: channels}); err != nil {
+			t.Fatalf("Failed to set channels of %q: %v", name, err)
+		}
+		if active {
+			if _, err := ts.activateProduct.Execute(ts.ctx, &activate_product.Request{ProductID: created.ProductID}); err != nil {
+				t.Fatalf("Failed to activate product %q: %v", name, err)
+			}
+		}
+		return created.ProductID
+	}
+	listed := create("Steel Kettle", []domain.Channel{domain.ChannelWeb, domain.ChannelMarketplace}, true)
+	webOnly := create("Glass Kettle", []domain.Channel{domain.ChannelWeb}, true)
+	inactive := create("Copper Kettle", []domain.Channel{domain.ChannelMarketplace}, false)
+
+	if _, err := ts.setMetadata.Execute(ts.ctx, &set_metadata.Request{ProductID: listed, Metadata: domain.Metadata{"image_url": "https://cdn.example.com/kettle.jpg"}}); err != nil {
+		t.Fatalf("Failed to set metadata: %v", err)
+	}
+	now := time.Now()
+	discount := domain.NewMoney(20) // 20%
+	_, err := ts.applyDiscount.Execute(ts.ctx, &apply_discount.Request{
+		ProductID: listed,
+		Discount:  &domain.Discount{ID: "feed-sale", Amount: &discount, StartDate: now.Add(-time.Hour), EndDate: now.Add(24 * time.Hour)},
+	})
+	if err != nil {
+		t.Fatalf("Failed to apply discount: %v", err)
+	}
+
+	bucket := memoryBucket{}
+	generate := generate_product_feeds.NewInteractor(ts.listProductsQuery, bucket, clock.NewRealClock())
+	resp, err := generate.Execute(ts.ctx, &generate_product_feeds.Request{
+		Feeds: []generate_product_feeds.TenantFeed{{
+			TenantID:         tenant.DefaultID,
+			Formats:          []string{generate_product_feeds.FormatGoogleMerchant, generate_product_feeds.FormatFacebook},
+			Channel:          string(domain.ChannelMarketplace),
+			Currency:         "USD",
+			Brand:            "Acme",
+			ProductURL:       "https://shop.example.com/p/{id}",
+			ImageMetadataKey: "image_url",
+		}},
+		PageSize: 1,
+	})
+	if err != nil {
+		t.Fatalf("Failed to generate feeds: %v", err)
+	}
+	if resp.Products != 1 || resp.Uploaded != 2 {
+		t.Errorf("Expected 1 product in 2 uploaded feeds, got %+v", resp)
+	}
+
+	google := string(bucket["feeds/"+tenant.DefaultID+"/google_merchant.xml"])
+	for _, want := range []string{
+		"<g:id>" + listed + "</g:id>",
+		"<g:price>25.00 USD</g:price>",
+		"<g:sale_price>20.00 USD</g:sale_price>",
+		"<g:link>https://shop.example.com/p/" + listed + "</g:link>",
+		"<g:image_link>https://cdn.example.com/kettle.jpg</g:image_link>",
+	} {
+		if !strings.Contains(google, want) {
+			t.Errorf("Expected %s in the Google feed, got:\n%s", want, google)
+		}
+	}
+	// Brand and MPN identify the product, so the flag is left out
+	if strings.Contains(google, "<g:identifier_exists>") {
+		t.Errorf("Expected no identifier_exists flag, got:\n%s", google)
+	}
+	for _, id := range []string{webOnly, inactive} {
+		if strings.Contains(google, id) {
+			t.Errorf("Expected %s to be left out of the Google feed", id)
+		}
+	}
+
+	rows, err := csv.NewReader(bytes.NewReader(bucket["feeds/"+tenant.DefaultID+"/facebook.csv"])).ReadAll()
+	if err != nil {
+		t.Fatalf("Failed to parse the Facebook feed: %v", err)
+	}
+	if len(rows) != 2 || rows[1][0] != listed || rows[1][3] != "in stock" || rows[1][5] != "25.00 USD" || rows[1][6] != "20.00 USD" {
+		t.Errorf("Expected a header and the listed product in the Facebook feed, got %v", rows)
+	}
+}
+
+func TestGetProductJsonLd(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	price := domain.NewMoney(5000)
+	created, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{Name: "Trail Backpack", Description: "30 litres", Category: "Bags", SKU: "BP-30", BasePrice: &price})
+	if err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+	productID := created.ProductID
+
+	query := get_product_json_ld.NewQuery(ts.getProductQuery, clock.NewRealClock(), nil)
+	render := func() map[string]any {
+		t.Helper()
+		dto, err := query.Execute(ts.ctx, &get_product_json_ld.Request{ProductID: productID, Currency: "EUR", URL: "https://shop.example.com/bp-30"})
+		if err != nil {
+			t.Fatalf("Failed to get JSON-LD: %v", err)
+		}
+		var doc map[string]any
+		if err := json.Unmarshal(dto.JSONLD, &doc); err != nil {
+			t.Fatalf("Failed to decode JSON-LD %s: %v", dto.JSONLD, err)
+		}
+		return doc
+	}
+
+	// An inactive product is offered at its base price but not in stock
+	doc := render()
+	offer := doc["offers"].(map[string]any)
+	if doc["@type"] != "Product" || doc["sku"] != "BP-30" || offer["price"] != "50.00" || offer["priceCurrency"] != "EUR" ||
+		offer["availability"] != "https://schema.org/OutOfStock" || doc["brand"] != nil {
+		t.Errorf("Unexpected JSON-LD for an inactive product: %v", doc)
+	}
+
+	// Activated, discounted and branded, the offer shows the effective price until the discount ends
+	if _, err := ts.activateProduct.Execute(ts.ctx, &activate_product.Request{ProductID: productID}); err != nil {
+		t.Fatalf("Failed to activate product: %v", err)
+	}
+	if _, err := ts.setMetadata.Execute(ts.ctx, &set_metadata.Request{ProductID: productID, Metadata: domain.Metadata{"brand": "Summit"}}); err != nil {
+		t.Fatalf("Failed to set metadata: %v", err)
+	}
+	now := time.Now()
+	end := now.Add(72 * time.Hour)
+	discount := domain.NewMoney(10) // 10%
+	_, err = ts.applyDiscount.Execute(ts.ctx, &apply_discount.Request{
+		ProductID: productID,
+		Discount:  &domain.Discount{ID: "seo-sale", Amount: &discount, StartDate: now.Add(-time.Hour), EndDate: end},
+	})
+	if err != nil {
+		t.Fatalf("Failed to apply discount: %v", err)
+	}
+
+	doc = render()
+	offer = doc["offers"].(map[string]any)
+	brand, _ := doc["brand"].(map[string]any)
+	if offer["price"] != "45.00" || offer["availability"] != "https://schema.org/InStock" ||
+		offer["priceValidUntil"] != end.UTC().Format("2006-01-02") || brand["name"] != "Summit" {
+		t.Errorf("Unexpected JSON-LD for a discounted product: %v", doc)
+	}
+
+	if _, err := query.Execute(ts.ctx, &get_product_json_ld.Request{ProductID: productID, Currency: "euro"}); !errors.Is(err, get_product_json_ld.ErrInvalidCurrency) {
+		t.Errorf("Expected ErrInvalidCurrency, got %v", err)
+	}
+}
+
+func TestAPIKeys(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	const (
+		readMethod  = "/product.v1.ProductService/GetProduct"
+		writeMethod = "/product.v1.ProductService/CreateProduct"
+	)
+	manager := apikey.NewManager(apikey.NewSpannerStore(ts.spannerClient), clock.NewRealClock(), time.Minute)
+	scopes := map[string]apikey.Scope{readMethod: apikey.ScopeRead, writeMethod: apikey.ScopeWrite}
+
+	acme := tenant.WithID(ts.ctx, "acme")
+	readKey, readSecret, err := manager.Issue(acme, "storefront", []apikey.Scope{apikey.ScopeRead})
+	if err != nil {
+		t.Fatalf("Failed to issue read key: %v", err)
+	}
+	_, writeSecret, err := manager.Issue(acme, "erp-sync", []apikey.Scope{apikey.ScopeWrite})
+	if err != nil {
+		t.Fatalf("Failed to issue write key: %v", err)
+	}
+	if !strings.HasPrefix(readSecret, "ck_") || readKey.KeyHash == "" || strings.Contains(readKey.KeyHash, readSecret) {
+		t.Fatalf("Expected a ck_ secret stored only as a hash, got secret %q hash %q", readSecret, readKey.KeyHash)
+	}
+
+	// call runs the interceptor the way the server chains it, after tenant resolution
+	var writeGranted bool
+	call := func(required bool, method string, md ...string) (string, error) {
+		t.Helper()
+		ctx := metadata.NewIncomingContext(ts.ctx, metadata.Pairs(md...))
+		var served string
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			served = tenant.FromContext(ctx)
+			writeGranted = apikey.GrantedFromContext(ctx, apikey.ScopeWrite)
+			return nil, nil
+		}
+		interceptor := apikey.UnaryServerInterceptor(manager, scopes, required)
+		_, err := tenant.UnaryServerInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		})
+		return served, err
+	}
+	expectCode := func(name string, err error, code codes.Code) {
+		t.Helper()
+		if status.Code(err) != code {
+			t.Errorf("%s: expected %s, got %v", name, code, err)
+		}
+	}
+
+	// Keys are optional until required, but a presented key must be valid
+	if served, err := call(false, readMethod); err != nil || served != tenant.DefaultID {
+		t.Errorf("Expected keyless request to pass as the default tenant, got %q, %v", served, err)
+	}
+	_, err = call(true, readMethod)
+	expectCode("missing key", err, codes.Unauthenticated)
+	_, err = call(false, readMethod, apikey.MetadataKey, "ck_unknown")
+	expectCode("unknown key", err, codes.Unauthenticated)
+
+	// A key pins the request to its tenant and may not be used for another
+	if served, err := call(true, readMethod, apikey.MetadataKey, readSecret); err != nil || served != "acme" {
+		t.Errorf("Expected read key to serve tenant acme, got %q, %v", served, err)
+	}
+	_, err = call(true, readMethod, apikey.MetadataKey, readSecret, tenant.MetadataKey, "other-tenant")
+	expectCode("foreign tenant", err, codes.PermissionDenied)
+
+	// Scopes are hierarchical and unlisted methods need admin
+	_, err = call(true, writeMethod, apikey.MetadataKey, readSecret)
+	expectCode("read key on write method", err, codes.PermissionDenied)
+	if _, err := call(true, readMethod, apikey.MetadataKey, writeSecret); err != nil || !writeGranted {
+		t.Errorf("Expected write key to grant read and write, got %v, %v", writeGranted, err)
+	}
+	if _, err := call(true, readMethod, apikey.MetadataKey, readSecret); err != nil || writeGranted {
+		t.Errorf("Expected read key not to grant write features such as draft previews, got %v, %v", writeGranted, err)
+	}
+	_, err = call(true, "/product.v1.ProductService/PurgeArchivedProducts", apikey.MetadataKey, writeSecret)
+	expectCode("write key on unlisted method", err, codes.PermissionDenied)
+
+	// Keys are managed per tenant
+	if _, err := manager.Revoke(tenant.WithID(ts.ctx, "other-tenant"), readKey.KeyID); !errors.Is(err, apikey.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound revoking another tenant's key, got %v", err)
+	}
+	revoked, err := manager.Revoke(acme, readKey.KeyID)
+	if err != nil {
+		t.Fatalf("Failed to revoke key: %v", err)
+	}
+	if revoked.RevokedAt == nil {
+		t.Error("Expected revoked_at to be set")
+	}
+	_, err = call(true, readMethod, apikey.MetadataKey, readSecret)
+	expectCode("revoked key", err, codes.Unauthenticated)
+
+	keys, err := manager.List(acme)
+	if err != nil {
+		t.Fatalf("Failed to list keys: %v", err)
+	}
+	if len(keys) != 2 {
+		t.Errorf("Expected both keys listed, including the revoked one, got %d", len(keys))
+	}
+	if others, err := manager.List(tenant.WithID(ts.ctx, "other-tenant")); err != nil || len(others) != 0 {
+		t.Errorf("Expected no keys for another tenant, got %d, %v", len(others), err)
+	}
+}
+
+func TestPreviewTokens(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	clk := clock.NewFixedClock(time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC))
+	signer := preview.NewSigner(strings.Repeat("s", 32), time.Hour, 24*time.Hour, clk)
+
+	// A product token covers that product of the issuing tenant only
+	token, expiresAt, err := signer.Issue("acme", "product-1", 0)
+	if err != nil {
+		t.Fatalf("Failed to issue token: %v", err)
+	}
+	if !expiresAt.Equal(clk.Now().Add(time.Hour)) {
+		t.Errorf("Expected the default one hour lifetime, got expiry %s", expiresAt)
+	}
+	if err := signer.Verify(token, "acme", "product-1"); err != nil {
+		t.Errorf("Expected the token to verify, got %v", err)
+	}
+	for name, check := range map[string]error{
+		"other product": signer.Verify(token, "acme", "product-2"),
+		"other tenant":  signer.Verify(token, "other-tenant", "product-1"),
+		"tampered":      signer.Verify(token+"x", "acme", "product-1"),
+		"other secret":  preview.NewSigner(strings.Repeat("t", 32), time.Hour, time.Hour, clk).Verify(token, "acme", "product-1"),
+	} {
+		if !errors.Is(check, preview.ErrInvalidToken) {
+			t.Errorf("%s: expected ErrInvalidToken, got %v", name, check)
+		}
+	}
+
+	// A tenant token covers every product; lifetimes are capped and enforced
+	tenantToken, _, err := signer.Issue("acme", "", 2*time.Hour)
+	if err != nil {
+		t.Fatalf("Failed to issue tenant token: %v", err)
+	}
+	if err := signer.Verify(tenantToken, "acme", "product-2"); err != nil {
+		t.Errorf("Expected the tenant token to cover any product, got %v", err)
+	}
+	if _, _, err := signer.Issue("acme", "", 48*time.Hour); !errors.Is(err, preview.ErrInvalidTTL) {
+		t.Errorf("Expected ErrInvalidTTL above the maximum, got %v", err)
+	}
+	clk.Set(clk.Now().Add(time.Hour))
+	if err := signer.Verify(token, "acme", "product-1"); !errors.Is(err, preview.ErrInvalidToken) {
+		t.Errorf("Expected the expired token to be refused, got %v", err)
+	}
+	if err := signer.Verify(tenantToken, "acme", "product-1"); err != nil {
+		t.Errorf("Expected the longer-lived token to still verify, got %v", err)
+	}
+}
+
+func TestUsageAccounting(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	manager := apikey.NewManager(apikey.NewSpannerStore(ts.spannerClient), clock.NewRealClock(), 0)
+	recorder := usage.NewRecorder(usage.NewSpannerStore(ts.spannerClient), clock.NewRealClock(), 100)
+	metered := committer.NewUsageCommitter(spannerdriver.NewCommitter(ts.spannerClient))
+
+	acme := tenant.WithID(ts.ctx, "acme")
+	key, secret, err := manager.Issue(acme, "erp-sync", []apikey.Scope{apikey.ScopeAdmin})
+	if err != nil {
+		t.Fatalf("Failed to issue key: %v", err)
+	}
+
+	// call runs the server's interceptor chain around handler
+	call := func(handler grpc.UnaryHandler, md ...string) {
+		t.Helper()
+		ctx := metadata.NewIncomingContext(ts.ctx, metadata.Pairs(md...))
+		info := &grpc.UnaryServerInfo{FullMethod: "/product.v1.ProductService/ListProducts"}
+		chain := []grpc.UnaryServerInterceptor{
+			tenant.UnaryServerInterceptor(),
+			apikey.UnaryServerInterceptor(manager, nil, false),
+			recorder.UnaryServerInterceptor(),
+		}
+		var next grpc.UnaryHandler = handler
+		for i := len(chain) - 1; i >= 0; i-- {
+			interceptor, inner := chain[i], next
+			next = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return interceptor(ctx, req, info, inner)
+			}
+		}
+		_, _ = next(ctx, nil)
+	}
+
+	// A list returns three rows, a write commits two mutations and a failed request returns nothing
+	call(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &pb.ListProductsResponse{Products: []*pb.Product{{}, {}, {}}, Total: 3}, nil
+	}, apikey.MetadataKey, secret)
+	call(func(ctx context.Context, req interface{}) (interface{}, error) {
+		plan := commitplan.NewPlan()
+		plan.Add(spanner.InsertOrUpdate(m_product_view.TableName, m_product_view.AllColumns(), []interface{}{"usage-a", "acme", int64(1), time.Now()}))
+		plan.Add(spanner.InsertOrUpdate(m_product_view.TableName, m_product_view.AllColumns(), []interface{}{"usage-b", "acme", int64(1), time.Now()}))
+		return &pb.CreateProductResponse{}, metered.Apply(ctx, plan)
+	}, apikey.MetadataKey, secret)
+	call(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "product not found")
+	}, apikey.MetadataKey, secret)
+	// Keyless requests are accounted to the tenant with an empty key ID
+	call(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &pb.GetProductResponse{Product: &pb.Product{}}, nil
+	})
+
+	if err := recorder.Flush(ts.ctx); err != nil {
+		t.Fatalf("Failed to flush usage: %v", err)
+	}
+	// A second flush adds to the same day's row
+	call(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &pb.ListProductsResponse{Products: []*pb.Product{{}}}, nil
+	}, apikey.MetadataKey, secret)
+	if err := recorder.Flush(ts.ctx); err != nil {
+		t.Fatalf("Failed to flush usage: %v", err)
+	}
+
+	rows, err := recorder.List(acme, time.Time{}, time.Time{}, "")
+	if err != nil {
+		t.Fatalf("Failed to list usage: %v", err)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("Expected one usage row for acme, got %d", len(rows))
+	}
+	got := rows[0]
+	if got.KeyID != key.KeyID || got.RequestCount != 4 || got.RowsReturned != 4 || got.MutationCount != 2 {
+		t.Errorf("Expected key %s with 4 requests, 4 rows and 2 mutations, got %+v", key.KeyID, got)
+	}
+	if !got.Day.Equal(time.Now().UTC().Truncate(24 * time.Hour)) {
+		t.Errorf("Expected usage on today's UTC day, got %s", got.Day)
+	}
+
+	keyless, err := recorder.List(ts.ctx, time.Time{}, time.Time{}, "")
+	if err != nil {
+		t.Fatalf("Failed to list usage: %v", err)
+	}
+	if len(keyless) != 1 || keyless[0].KeyID != "" || keyless[0].RequestCount != 1 || keyless[0].RowsReturned != 1 {
+		t.Errorf("Expected one keyless request returning one product for the default tenant, got %+v", keyless)
+	}
+
+	if others, err := recorder.List(acme, time.Time{}, time.Time{}, "other-key"); err != nil || len(others) != 0 {
+		t.Errorf("Expected no usage for another key, got %d, %v", len(others), err)
+	}
+	now := time.Now()
+	if _, err := recorder.List(acme, now, now.Add(-48*time.Hour), ""); !errors.Is(err, usage.ErrInvalidRange) {
+		t.Errorf("Expected ErrInvalidRange for a reversed range, got %v", err)
+	}
+}
+
+func TestShadowGetProductToV2(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	price := domain.NewMoney(4200)
+	created, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{Name: "Shadow Lamp", Description: "Desk lamp", Category: "Lighting", BasePrice: &price})
+	if err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+
+	method := pb.ProductService_GetProduct_FullMethodName
+	shadower := shadow.New(shadow.Settings{Percent: 100, MaxInFlight: 4, Timeout: 5 * time.Second}, services.ShadowTargets(ts.opts.ProductV2Handler))
+	interceptor := shadower.UnaryServerInterceptor()
+	requests := metrics.Labeled("shadow_requests_total")
+	mismatches := metrics.Labeled("shadow_mismatches_total")
+	counter := func(m *expvar.Map) int64 {
+		if v, ok := m.Get(method).(*expvar.Int); ok {
+			return v.Value()
+		}
+		return 0
+	}
+	// Other tests may shadow too, so only wait for this test's calls to be counted
+	waitFor := func(want int64) {
+		t.Helper()
+		deadline := time.Now().Add(5 * time.Second)
+		for counter(requests) < want && time.Now().Before(deadline) {
+			time.Sleep(10 * time.Millisecond)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	get := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return ts.opts.ProductHandler.GetProduct(ctx, req.(*pb.GetProductRequest))
+	}
+	baseRequests, baseMismatches := counter(requests), counter(mismatches)
+
+	// v1 and v2 agree on the product, so the shadow call matches
+	resp, err := interceptor(ts.ctx, &pb.GetProductRequest{ProductId: created.ProductID}, &grpc.UnaryServerInfo{FullMethod: method}, get)
+	if err != nil {
+		t.Fatalf("Failed to get product: %v", err)
+	}
+	if resp.(*pb.GetProductResponse).Product.Id != created.ProductID {
+		t.Fatalf("Expected the primary response to be returned unchanged")
+	}
+	waitFor(baseRequests + 1)
+	if got := counter(mismatches) - baseMismatches; got != 0 {
+		t.Errorf("Expected no mismatches for an unchanged product, got %d", got)
+	}
+
+	// A primary that serves a different price than v2 is reported
+	stale := func(ctx context.Context, req interface{}) (interface{}, error) {
+		resp, err := get(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		resp.(*pb.GetProductResponse).Product.EffectivePrice = &pb.Money{Amount: 1}
+		return resp, nil
+	}
+	if _, err := interceptor(ts.ctx, &pb.GetProductRequest{ProductId: created.ProductID}, &grpc.UnaryServerInfo{FullMethod: method}, stale); err != nil {
+		t.Fatalf("Failed to get product: %v", err)
+	}
+	waitFor(baseRequests + 2)
+	if got := counter(mismatches) - baseMismatches; got < 1 {
+		t.Errorf("Expected the price difference to be counted as a mismatch, got %d", got)
+	}
+}
+
+func TestStaleListCacheKeysByValue(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	price := domain.NewMoney(1500)
+	if _, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{Name: "Stale Mug", Description: "Mug", Category: "Kitchen", BasePrice: &price}); err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+
+	injector := faults.New(faults.Settings{})
+	realClock := clock.NewRealClock()
+	readBreaker := breaker.New("stale_list_test", breaker.Settings{FailureThreshold: 1, OpenTimeout: time.Minute}, realClock)
+	readModel := repo.NewBreakerReadModel(
+		repo.NewFaultyReadModel(repo.NewSpannerReadModel(ts.spannerClient, 0), injector),
+		readBreaker,
+		realClock,
+		repo.StaleCacheOptions{Enabled: true, MaxAge: time.Minute, MaxEntries: 10},
+	)
+
+	// Requests are cached by the values their pointer fields hold, not by the pointers
+	firstAge, secondAge, otherAge := 18, 18, 21
+	if _, err := readModel.ListProducts(ts.ctx, &list_products.Request{Category: "Kitchen", MaxAgeRestriction: &firstAge}); err != nil {
+		t.Fatalf("Failed to list products: %v", err)
+	}
+	if _, err := readModel.ListProducts(ts.ctx, &list_products.Request{Category: "Kitchen", MinEffectivePrice: big.NewRat(10, 1)}); err != nil {
+		t.Fatalf("Failed to list products: %v", err)
+	}
+	injector.Set(faults.Settings{UnavailablePercent: 100})
+	if _, err := readModel.ListProducts(ts.ctx, &list_products.Request{Category: "Kitchen", MinEffectivePrice: big.NewRat(20, 2)}); err != nil {
+		t.Errorf("Expected the cached page for an equal price bound, got %v", err)
+	}
+	if _, err := readModel.ListProducts(ts.ctx, &list_products.Request{Category: "Kitchen", MinEffectivePrice: big.NewRat(11, 1)}); err == nil {
+		t.Error("Expected a request with another price bound to miss the cache")
+	}
+	stale, err := readModel.ListProducts(ts.ctx, &list_products.Request{Category: "Kitchen", MaxAgeRestriction: &secondAge})
+	if err != nil {
+		t.Fatalf("Expected the cached page for an equal request, got %v", err)
+	}
+	if len(stale.Products) != 1 {
+		t.Errorf("Expected 1 cached product, got %d", len(stale.Products))
+	}
+	if _, err := readModel.ListProducts(ts.ctx, &list_products.Request{Category: "Kitchen", MaxAgeRestriction: &otherAge}); err == nil {
+		t.Error("Expected a request with another age restriction to miss the cache")
+	}
+}
+
+func TestFaultInjection(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	price := domain.NewMoney(1500)
+	created, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{Name: "Chaos Mug", Description: "Mug", Category: "Kitchen", BasePrice: &price})
+	if err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+
+	// The same stack as the server: faults innermost, retries around them, the breaker outside
+	injector := faults.New(faults.Settings{})
+	policy := retry.DefaultPolicy()
+	policy.InitialBackoff = time.Millisecond
+	policy.MaxBackoff = 5 * time.Millisecond
+	policy.MaxAttempts = 10
+	policy.BudgetTokens = 0
+	retrier := retry.NewRetrier(policy)
+	realClock := clock.NewRealClock()
+	readBreaker := breaker.New("fault_injection_test", breaker.Settings{FailureThreshold: 2, OpenTimeout: time.Minute}, realClock)
+	readModel := repo.NewBreakerReadModel(
+		repo.NewRetryingReadModel(repo.NewFaultyReadModel(repo.NewSpannerReadModel(ts.spannerClient, 0), injector), retrier),
+		readBreaker,
+		realClock,
+		repo.StaleCacheOptions{},
+	)
+	faultyCommitter := committer.NewRetryingCommitter(committer.NewFaultyCommitter(spannerdriver.NewCommitter(ts.spannerClient), injector), retrier)
+	createRule := create_merch_rule.NewInteractor(repo.NewSpannerProductRepository(ts.spannerClient), repo.NewSpannerMerchRuleStore(ts.spannerClient), faultyCommitter, realClock)
+	boost := &create_merch_rule.Request{Rule: domain.MerchRule{Kind: domain.MerchRuleKindBoost, Category: "Kitchen", Boost: 2}}
+
+	// Without faults every call goes through
+	if _, err := readModel.GetProduct(ts.ctx, created.ProductID); err != nil {
+		t.Fatalf("Failed to get product without faults: %v", err)
+	}
+
+	// Injected not-founds look like missing products and neither retry nor trip the breaker
+	injector.Set(faults.Settings{NotFoundPercent: 100})
+	if _, err := readModel.GetProduct(ts.ctx, created.ProductID); !errors.Is(err, domain.ErrProductNotFound) {
+		t.Errorf("Expected ErrProductNotFound, got %v", err)
+	}
+	if _, err := readModel.ListProducts(ts.ctx, &list_products.Request{}); err != nil {
+		t.Errorf("Expected list queries never to miss, got %v", err)
+	}
+	if state := readBreaker.State(); state != breaker.StateClosed {
+		t.Errorf("Expected not-founds to leave the breaker closed, got %s", state)
+	}
+
+	// Aborted commits are retried until the attempts run out, and nothing is written
+	injector.Set(faults.Settings{AbortPercent: 100})
+	if _, err := createRule.Execute(ts.ctx, boost); status.Code(err) != codes.Aborted {
+		t.Errorf("Expected ABORTED after the retries ran out, got %v", err)
+	}
+	var rules int64
+	if err := ts.spannerClient.Single().Query(ts.ctx, spanner.Statement{SQL: "SELECT COUNT(*) FROM merch_rules"}).Do(func(row *spanner.Row) error {
+		return row.Columns(&rules)
+	}); err != nil {
+		t.Fatalf("Failed to count merch rules: %v", err)
+	}
+	if rules != 0 {
+		t.Errorf("Expected no merch rule after aborted commits, got %d", rules)
+	}
+
+	// An unavailable commit may have landed, so it is returned at once instead of replayed
+	injector.Set(faults.Settings{UnavailablePercent: 100})
+	if _, err := createRule.Execute(ts.ctx, boost); status.Code(err) != codes.Unavailable {
+		t.Errorf("Expected UNAVAILABLE from the first commit attempt, got %v", err)
+	}
+
+	// Persistent unavailability opens the breaker, which then fails fast
+	injector.Set(faults.Settings{UnavailablePercent: 100})
+	for range 2 {
+		if _, err := readModel.GetProduct(ts.ctx, created.ProductID); status.Code(err) != codes.Unavailable {
+			t.Errorf("Expected UNAVAILABLE, got %v", err)
+		}
+	}
+	if state := readBreaker.State(); state != breaker.StateOpen {
+		t.Fatalf("Expected the breaker to open, got %s", state)
+	}
+	injector.Set(faults.Settings{})
+	if _, err := readModel.GetProduct(ts.ctx, created.ProductID); !errors.Is(err, breaker.ErrOpen) {
+		t.Errorf("Expected the open breaker to reject the call, got %v", err)
+	}
+
+	// Latency past the caller's deadline surfaces as DEADLINE_EXCEEDED
+	injector.Set(faults.Settings{Latency: time.Second})
+	ctx, cancel := context.WithTimeout(ts.ctx, 50*time.Millisecond)
+	defer cancel()
+	if _, err := createRule.Execute(ctx, boost); status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("Expected DEADLINE_EXCEEDED, got %v", err)
+	}
+
+	// Occasional aborts are absorbed by the retries
+	injector.Set(faults.Settings{AbortPercent: 25})
+	for i := range 5 {
+		if _, err := createRule.Execute(ts.ctx, boost); err != nil {
+			t.Errorf("Commit %d: expected retries to absorb aborts, got %v", i, err)
+		}
+	}
+
+	// The admin RPCs are refused unless the server enables fault injection
+	if _, err := ts.opts.ProductHandler.SetFaultInjection(ts.ctx, &pb.SetFaultInjectionRequest{}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected FAILED_PRECONDITION with fault injection disabled, got %v", err)
+	}
+}
+
+func TestListProductsBasicView(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	price := domain.NewMoney(5000)
+	created, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{Name: "Grid Kettle", Description: "Stainless steel kettle", Category: "Kitchen", BasePrice: &price})
+	if err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+	if _, err := ts.activateProduct.Execute(ts.ctx, &activate_product.Request{ProductID: created.ProductID}); err != nil {
+		t.Fatalf("Failed to activate product: %v", err)
+	}
+	if _, err := ts.setMetadata.Execute(ts.ctx, &set_metadata.Request{ProductID: created.ProductID, Metadata: map[string]string{"erp_code": "K-1"}}); err != nil {
+		t.Fatalf("Failed to set metadata: %v", err)
+	}
+	now := time.Now()
+	discount := domain.NewMoney(20)
+	if _, err := ts.applyDiscount.Execute(ts.ctx, &apply_discount.Request{
+		ProductID: created.ProductID,
+		Discount:  &domain.Discount{ID: "grid-sale", Amount: &discount, StartDate: now.Add(-time.Hour), EndDate: now.Add(24 * time.Hour)},
+	}); err != nil {
+		t.Fatalf("Failed to apply discount: %v", err)
+	}
+
+	list := func(view pb.ProductView) *pb.Product {
+		t.Helper()
+		resp, err := ts.opts.ProductHandler.ListProducts(ts.ctx, &pb.ListProductsRequest{View: view})
+		if err != nil {
+			t.Fatalf("Failed to list products with view %s: %v", view, err)
+		}
+		if len(resp.Products) != 1 {
+			t.Fatalf("Expected 1 product with view %s, got %d", view, len(resp.Products))
+		}
+		return resp.Products[0]
+	}
+
+	// The default view is the full product
+	full := list(pb.ProductView_PRODUCT_VIEW_UNSPECIFIED)
+	if full.Description != "Stainless steel kettle" || full.Metadata["erp_code"] != "K-1" {
+		t.Errorf("Expected the full view to include description and metadata, got %+v", full)
+	}
+
+	// The basic view keeps identity and the discounted price, and drops everything else
+	basic := list(pb.ProductView_PRODUCT_VIEW_BASIC)
+	if basic.Id != created.ProductID || basic.Name != "Grid Kettle" || basic.Category != "Kitchen" || basic.Status != "active" {
+		t.Errorf("Expected the basic view to identify the product, got %+v", basic)
+	}
+	if !proto.Equal(basic.EffectivePrice, full.EffectivePrice) || !proto.Equal(basic.Discount, full.Discount) {
+		t.Errorf("Expected the basic view to price like the full view, got %v and %v", basic.EffectivePrice, full.EffectivePrice)
+	}
+	if basic.Description != "" || len(basic.Metadata) != 0 || basic.PriceFloor != nil || basic.Compliance != nil {
+		t.Errorf("Expected the basic view to omit description, metadata, price floor and compliance, got %+v", basic)
+	}
+	if proto.Size(basic) >= proto.Size(full) {
+		t.Errorf("Expected the basic product to be smaller on the wire, got %d >= %d bytes", proto.Size(basic), proto.Size(full))
+	}
+
+	if _, err := ts.opts.ProductHandler.ListProducts(ts.ctx, &pb.ListProductsRequest{View: pb.ProductView(99)}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected INVALID_ARGUMENT for an unknown view, got %v", err)
+	}
+}
+
+func TestListProductsScanGuard(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	// Deep pages of an unfiltered list would scan the tenant's products
+	deep := &pb.ListProductsRequest{Offset: 9990, Limit: 50, SkipTotal: true}
+	if _, err := ts.opts.ProductHandler.ListProducts(ts.ctx, deep); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected INVALID_ARGUMENT for a deep unfiltered page, got %v", err)
+	}
+
+	// An indexed filter, a shallow page or the export override lets it through
+	category := "Kitchen"
+	for name, req := range map[string]*pb.ListProductsRequest{
+		"category": {Category: &category, Offset: 9990, Limit: 50, SkipTotal: true},
+		"shallow":  {Offset: 9950, Limit: 50, SkipTotal: true},
+		"override": {Offset: 9990, Limit: 50, SkipTotal: true, AllowFullScan: true},
+	} {
+		if _, err := ts.opts.ProductHandler.ListProducts(ts.ctx, req); err != nil {
+			t.Errorf("Expected the %s list to pass the guard, got %v", name, err)
+		}
+	}
+
+	// Searches are bounded by their limit alone
+	readModel := repo.NewSpannerReadModel(ts.spannerClient, 5)
+	search := func(limit int) error {
+		_, err := readModel.SearchProducts(ts.ctx, &search_products.Request{
+			TenantID: tenant.FromContext(ts.ctx),
+			Terms:    []search_products.Term{{Word: "kettle"}},
+			Limit:    limit,
+		})
+		return err
+	}
+	if err := search(5); err != nil {
+		t.Errorf("Expected a search within the scan limit to run, got %v", err)
+	}
+	if err := search(6); !errors.Is(err, domain.ErrUnboundedScan) {
+		t.Errorf("Expected ErrUnboundedScan for a search over the scan limit, got %v", err)
+	}
+}
+
+func TestEffectivePriceOrderAndFilter(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	create := func(name string, cents int64) string {
+		price := domain.NewMoney(cents)
+		resp, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{Name: name, Description: "Kettle for the price test", Category: "Kitchen", BasePrice: &price})
+		if err != nil {
+			t.Fatalf("Failed to create product %q: %v", name, err)
+		}
+		if _, err := ts.activateProduct.Execute(ts.ctx, &activate_product.Request{ProductID: resp.ProductID}); err != nil {
+			t.Fatalf("Failed to activate product %q: %v", name, err)
+		}
+		return resp.ProductID
+	}
+	budget := create("Budget Kettle", 2000)
+	classic := create("Classic Kettle", 4000)
+	premium := create("Premium Kettle", 6000)
+
+	// Half off from now on makes the premium kettle the cheapest; a sale starting later changes nothing yet
+	now := time.Now()
+	half, quarter := domain.NewMoney(50), domain.NewMoney(25)
+	if _, err := ts.applyDiscount.Execute(ts.ctx, &apply_discount.Request{
+		ProductID: premium,
+		Discount:  &domain.Discount{ID: "premium-sale", Amount: &half, StartDate: now.Add(-time.Minute), EndDate: now.Add(24 * time.Hour)},
+	}); err != nil {
+		t.Fatalf("Failed to apply discount: %v", err)
+	}
+	if _, err := ts.applyDiscount.Execute(ts.ctx, &apply_discount.Request{
+		ProductID: classic,
+		Discount:  &domain.Discount{ID: "classic-sale", Amount: &quarter, StartDate: now.Add(time.Hour), EndDate: now.Add(24 * time.Hour)},
+	}); err != nil {
+		t.Fatalf("Failed to apply discount: %v", err)
+	}
+
+	list := func(req *pb.ListProductsRequest) []string {
+		t.Helper()
+		resp, err := ts.opts.ProductHandler.ListProducts(ts.ctx, req)
+		if err != nil {
+			t.Fatalf("Failed to list products: %v", err)
+		}
+		ids := make([]string, len(resp.Products))
+		for i, product := range resp.Products {
+			ids[i] = product.Id
+		}
+		return ids
+	}
+	search := func(req *pb.SearchProductsRequest) []string {
+		t.Helper()
+		req.Query = "kettle"
+		resp, err := ts.opts.ProductHandler.SearchProducts(ts.ctx, req)
+		if err != nil {
+			t.Fatalf("Failed to search products: %v", err)
+		}
+		ids := make([]string, len(resp.Hits))
+		for i, hit := range resp.Hits {
+			ids[i] = hit.ProductId
+		}
+		return ids
+	}
+
+	if got, want := list(&pb.ListProductsRequest{OrderBy: "effective_price"}), []string{budget, premium, classic}; !slices.Equal(got, want) {
+		t.Errorf("Expected cheapest first %v, got %v", want, got)
+	}
+	if got, want := list(&pb.ListProductsRequest{OrderBy: "effective_price_desc"}), []string{classic, premium, budget}; !slices.Equal(got, want) {
+		t.Errorf("Expected dearest first %v, got %v", want, got)
+	}
+	bounds := &pb.ListProductsRequest{MinEffectivePrice: &pb.Money{Amount: 2500}, MaxEffectivePrice: &pb.Money{Amount: 3500}}
+	if got, want := list(bounds), []string{premium}; !slices.Equal(got, want) {
+		t.Errorf("Expected only the discounted premium kettle within 25-35, got %v", got)
+	}
+	if got, want := search(&pb.SearchProductsRequest{OrderBy: "effective_price", MaxEffectivePrice: &pb.Money{Amount: 3500}}), []string{budget, premium}; !slices.Equal(got, want) {
+		t.Errorf("Expected search to return %v, got %v", want, got)
+	}
+
+	// Once the classic kettle's sale starts, the refresh reprices it (other tests' products may expire too)
+	store := repo.NewSpannerEffectivePriceStore(ts.spannerClient)
+	repriced, err := store.RefreshEffectivePrices(ts.ctx, now.Add(2*time.Hour), 100)
+	if err != nil {
+		t.Fatalf("Failed to refresh effective prices: %v", err)
+	}
+	if repriced < 1 {
+		t.Errorf("Expected the classic kettle to be repriced, got %d products", repriced)
+	}
+	if got, want := list(&pb.ListProductsRequest{MinEffectivePrice: &pb.Money{Amount: 3000}, MaxEffectivePrice: &pb.Money{Amount: 3000}}), []string{premium, classic}; !slices.Equal(got, want) {
+		t.Errorf("Expected both kettles now at 30, got %v", got)
+	}
+
+	for name, req := range map[string]*pb.ListProductsRequest{
+		"order":    {OrderBy: "price"},
+		"negative": {MinEffectivePrice: &pb.Money{Amount: -1}},
+		"inverted": {MinEffectivePrice: &pb.Money{Amount: 2}, MaxEffectivePrice: &pb.Money{Amount: 1}},
+	} {
+		if _, err := ts.opts.ProductHandler.ListProducts(ts.ctx, req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected INVALID_ARGUMENT for the %s request, got %v", name, err)
+		}
+	}
+}
+
+func TestMutationsReturnCommittedProduct(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	handler := ts.opts.ProductHandler
+	get := func(id string) *pb.Product {
+		t.Helper()
+		resp, err := handler.GetProduct(ts.ctx, &pb.GetProductRequest{ProductId: id})
+		if err != nil {
+			t.Fatalf("Failed to get product: %v", err)
+		}
+		return resp.Product
+	}
+
+	created, err := handler.CreateProduct(ts.ctx, &pb.CreateProductRequest{
+		Name: "Commit Mug", Description: "Ceramic mug", Category: "Kitchen", BasePrice: &pb.Money{Amount: 1299}, ReturnProduct: true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+	// The returned product carries the commit timestamp Spanner stored, so it matches a later read
+	if created.Product == nil || !proto.Equal(created.Product, get(created.ProductId)) {
+		t.Errorf("Expected the created product to match GetProduct, got %v", created.Product)
+	}
+
+	updated, err := handler.UpdateProduct(ts.ctx, &pb.UpdateProductRequest{ProductId: created.ProductId, Name: proto.String("Commit Cup"), ReturnProduct: true})
+	if err != nil {
+		t.Fatalf("Failed to update product: %v", err)
+	}
+	if updated.Product.GetName() != "Commit Cup" || !proto.Equal(updated.Product, get(created.ProductId)) {
+		t.Errorf("Expected the updated product to match GetProduct, got %v", updated.Product)
+	}
+	if !updated.Product.UpdatedAt.AsTime().After(created.Product.UpdatedAt.AsTime()) {
+		t.Errorf("Expected updated_at to move past %v, got %v", created.Product.UpdatedAt.AsTime(), updated.Product.UpdatedAt.AsTime())
+	}
+
+	// Without return_product only the ID comes back
+	activated, err := handler.ActivateProduct(ts.ctx, &pb.ActivateProductRequest{ProductId: created.ProductId})
+	if err != nil {
+		t.Fatalf("Failed to activate product: %v", err)
+	}
+	if activated.Product != nil {
+		t.Errorf("Expected no product without return_product, got %v", activated.Product)
+	}
+}
+
+func TestHandlersReportEveryInvalidField(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	// fields returns the fields named by the BadRequest detail of an INVALID_ARGUMENT error
+	fields := func(err error) []string {
+		t.Helper()
+		st := status.Convert(err)
+		if st.Code() != codes.InvalidArgument {
+			t.Fatalf("Expected INVALID_ARGUMENT, got %v", err)
+		}
+		var out []string
+		for _, detail := range st.Details() {
+			if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+				for _, v := range badRequest.FieldViolations {
+					out = append(out, v.Field)
+				}
+			}
+		}
+		return out
+	}
+
+	_, err := ts.opts.ProductHandler.CreateProduct(ts.ctx, &pb.CreateProductRequest{Name: " ", Category: "Kitchen", BasePrice: &pb.Money{Amount: 0}})
+	if got, want := fields(err), []string{"name", "description", "base_price"}; !slices.Equal(got, want) {
+		t.Errorf("Expected violations for %v, got %v", want, got)
+	}
+
+	_, err = ts.opts.ProductHandler.ApplyDiscount(ts.ctx, &pb.ApplyDiscountRequest{
+		ProductId: "any-product",
+		Discount:  &pb.Discount{PercentBasisPoints: proto.Int32(20000)},
+	})
+	if got, want := fields(err), []string{"discount.id", "discount.percent_basis_points", "discount.start_date", "discount.end_date"}; !slices.Equal(got, want) {
+		t.Errorf("Expected violations for %v, got %v", want, got)
+	}
+}
+
+func TestHandlersNormalizeText(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	created, err := ts.opts.ProductHandler.CreateProduct(ts.ctx, &pb.CreateProductRequest{
+		Name:        "  Cafe\u0301 \t Mug ",
+		Description: "<p>Holds   350ml.</p><script>alert(1)</script>\n\n\n\nDishwasher &lt;safe&gt;  ",
+		Category:    " Kitchen\nware ",
+		BasePrice:   &pb.Money{Amount: 1299},
+	})
+	if err != nil {
+		t.Fatalf("CreateProduct failed: %v", err)
+	}
+
+	got, err := ts.opts.ProductHandler.GetProduct(ts.ctx, &pb.GetProductRequest{ProductId: created.ProductId})
+	if err != nil {
+		t.Fatalf("GetProduct failed: %v", err)
+	}
+	if want := "Caf\u00e9 Mug"; got.Product.Name != want {
+		t.Errorf("Expected name %q, got %q", want, got.Product.Name)
+	}
+	if want := "Kitchen ware"; got.Product.Category != want {
+		t.Errorf("Expected category %q, got %q", want, got.Product.Category)
+	}
+	if want := "Holds 350ml.\n\nDishwasher &lt;safe&gt;"; got.Product.Description != want {
+		t.Errorf("Expected description %q, got %q", want, got.Product.Description)
+	}
+}
+
+func TestMarkdownDescriptions(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	_, err := ts.opts.ProductHandler.CreateProduct(ts.ctx, &pb.CreateProductRequest{
+		Name:              "Broken Mug",
+		Description:       "```\nnever closed",
+		Category:          "Kitchen",
+		BasePrice:         &pb.Money{Amount: 1299},
+		DescriptionFormat: pb.DescriptionFormat_DESCRIPTION_FORMAT_MARKDOWN,
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("Expected INVALID_ARGUMENT for malformed markdown, got %v", err)
+	}
+
+	created, err := ts.opts.ProductHandler.CreateProduct(ts.ctx, &pb.CreateProductRequest{
+		Name:              "Markdown Mug",
+		Description:       "**Stoneware** mug\n\n- 350ml\n- [Care guide](https://example.com/care)",
+		Category:          "Kitchen",
+		BasePrice:         &pb.Money{Amount: 1299},
+		DescriptionFormat: pb.DescriptionFormat_DESCRIPTION_FORMAT_MARKDOWN,
+	})
+	if err != nil {
+		t.Fatalf("CreateProduct failed: %v", err)
+	}
+
+	got, err := ts.opts.ProductHandler.GetProduct(ts.ctx, &pb.GetProductRequest{ProductId: created.ProductId, RenderDescriptionHtml: true})
+	if err != nil {
+		t.Fatalf("GetProduct failed: %v", err)
+	}
+	if got.Product.DescriptionFormat != pb.DescriptionFormat_DESCRIPTION_FORMAT_MARKDOWN {
+		t.Errorf("Expected markdown format, got %v", got.Product.DescriptionFormat)
+	}
+	want := "<p><strong>Stoneware</strong> mug</p>\n<ul><li>350ml</li><li><a href=\"https://example.com/care\">Care guide</a></li></ul>"
+	if got.Product.DescriptionHtml != want {
+		t.Errorf("Expected description HTML %q, got %q", want, got.Product.DescriptionHtml)
+	}
+
+	// Markdown checks follow the format: a description with an unsafe link is only accepted as plain text
+	_, err = ts.opts.ProductHandler.UpdateProduct(ts.ctx, &pb.UpdateProductRequest{
+		ProductId:   created.ProductId,
+		Description: proto.String("[Care guide](javascript:alert(1))"),
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("Expected INVALID_ARGUMENT for an unsafe link, got %v", err)
+	}
+	plain := pb.DescriptionFormat_DESCRIPTION_FORMAT_PLAIN
+	if _, err := ts.opts.ProductHandler.UpdateProduct(ts.ctx, &pb.UpdateProductRequest{
+		ProductId:         created.ProductId,
+		Description:       proto.String("[Care guide](javascript:alert(1))"),
+		DescriptionFormat: &plain,
+	}); err != nil {
+		t.Fatalf("UpdateProduct to plain failed: %v", err)
+	}
+
+	got, err = ts.opts.ProductHandler.GetProduct(ts.ctx, &pb.GetProductRequest{ProductId: created.ProductId, RenderDescriptionHtml: true})
+	if err != nil {
+		t.Fatalf("GetProduct failed: %v", err)
+	}
+	if want := "<p>[Care guide](javascript:alert(1))</p>"; got.Product.DescriptionHtml != want {
+		t.Errorf("Expected escaped plain description %q, got %q", want, got.Product.DescriptionHtml)
+	}
+}
+
+func TestCategoryTemplates(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	created, err := ts.opts.ProductHandler.CreateProduct(ts.ctx, &pb.CreateProductRequest{
+		Name:        "Travel Laptop",
+		Description: "Light laptop",
+		Category:    "Laptops",
+		BasePrice:   &pb.Money{Amount: 99900},
+	})
+	if err != nil {
+		t.Fatalf("CreateProduct failed: %v", err)
+	}
+
+	// Without a template any well-formed attributes are accepted
+	if _, err := ts.opts.ProductHandler.SetAttributes(ts.ctx, &pb.SetAttributesRequest{
+		ProductId:  created.ProductId,
+		Attributes: map[string]string{"finish": "matte"},
+	}); err != nil {
+		t.Fatalf("SetAttributes without a template failed: %v", err)
+	}
+
+	_, err = ts.opts.ProductHandler.PutCategoryTemplate(ts.ctx, &pb.PutCategoryTemplateRequest{
+		Template: &pb.CategoryTemplate{
+			Category:   "Laptops",
+			Attributes: []*pb.AttributeDefinition{{Name: "ram_gb", Type: pb.AttributeType_ATTRIBUTE_TYPE_NUMBER, AllowedValues: []string{"eight"}}},
+		},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("Expected INVALID_ARGUMENT for an allowed value of the wrong type, got %v", err)
+	}
+	if _, err := ts.opts.ProductHandler.PutCategoryTemplate(ts.ctx, &pb.PutCategoryTemplateRequest{
+		Template: &pb.CategoryTemplate{
+			Category: "Laptops",
+			Attributes: []*pb.AttributeDefinition{
+				{Name: "screen_inches", Type: pb.AttributeType_ATTRIBUTE_TYPE_NUMBER, Required: true},
+				{Name: "color", Type: pb.AttributeType_ATTRIBUTE_TYPE_TEXT, AllowedValues: []string{"black", "silver"}},
+				{Name: "touchscreen", Type: pb.AttributeType_ATTRIBUTE_TYPE_BOOLEAN},
+			},
+		},
+	}); err != nil {
+		t.Fatalf("PutCategoryTemplate failed: %v", err)
+	}
+
+	got, err := ts.opts.ProductHandler.GetCategoryTemplate(ts.ctx, &pb.GetCategoryTemplateRequest{Category: "Laptops"})
+	if err != nil {
+		t.Fatalf("GetCategoryTemplate failed: %v", err)
+	}
+	if len(got.Template.Attributes) != 3 || got.Template.Attributes[1].Name != "color" || !got.Template.Attributes[0].Required {
+		t.Errorf("Expected the template's definitions in order, got %v", got.Template.Attributes)
+	}
+
+	// Every violation of the template is reported
+	_, err = ts.opts.ProductHandler.SetAttributes(ts.ctx, &pb.SetAttributesRequest{
+		ProductId:  created.ProductId,
+		Attributes: map[string]string{"color": "red", "touchscreen": "yes", "finish": "matte"},
+	})
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("Expected INVALID_ARGUMENT for attributes breaking the template, got %v", err)
+	}
+	var fields []string
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, v := range badRequest.FieldViolations {
+				fields = append(fields, v.Field)
+			}
+		}
+	}
+	if want := []string{"attributes.screen_inches", "attributes.color", "attributes.touchscreen", "attributes.finish"}; !slices.Equal(fields, want) {
+		t.Errorf("Expected violations for %v, got %v", want, fields)
+	}
+
+	resp, err := ts.opts.ProductHandler.SetAttributes(ts.ctx, &pb.SetAttributesRequest{
+		ProductId:     created.ProductId,
+		Attributes:    map[string]string{"screen_inches": "13.3", "color": "silver"},
+		ReturnProduct: true,
+	})
+	if err != nil {
+		t.Fatalf("SetAttributes failed: %v", err)
+	}
+	if want := map[string]string{"screen_inches": "13.3", "color": "silver"}; !maps.Equal(resp.Product.Attributes, want) {
+		t.Errorf("Expected attributes %v, got %v", want, resp.Product.Attributes)
+	}
+
+	if _, err := ts.opts.ProductHandler.DeleteCategoryTemplate(ts.ctx, &pb.DeleteCategoryTemplateRequest{Category: "Laptops"}); err != nil {
+		t.Fatalf("DeleteCategoryTemplate failed: %v", err)
+	}
+	_, err = ts.opts.ProductHandler.GetCategoryTemplate(ts.ctx, &pb.GetCategoryTemplateRequest{Category: "Laptops"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NOT_FOUND after deleting the template, got %v", err)
+	}
+}
+
+func TestListCategories(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	create := func(category string, active bool) {
+		t.Helper()
+		created, err := ts.opts.ProductHandler.CreateProduct(ts.ctx, &pb.CreateProductRequest{
+			Name:        "Product in " + category,
+			Description: "Listed by category",
+			Category:    category,
+			BasePrice:   &pb.Money{Amount: 1000},
+		})
+		if err != nil {
+			t.Fatalf("CreateProduct failed: %v", err)
+		}
+		if active {
+			if _, err := ts.opts.ProductHandler.ActivateProduct(ts.ctx, &pb.ActivateProductRequest{ProductId: created.ProductId}); err != nil {
+				t.Fatalf("ActivateProduct failed: %v", err)
+			}
+		}
+	}
+	create("electronics/laptops", true)
+	create("electronics/laptops", true)
+	create("electronics/phones", false)
+	create("books", true)
+
+	resp, err := ts.opts.ProductHandler.ListCategories(ts.ctx, &pb.ListCategoriesRequest{})
+	if err != nil {
+		t.Fatalf("ListCategories failed: %v", err)
+	}
+	if len(resp.Categories) != 2 || resp.Categories[0].Path != "books" || resp.Categories[1].Path != "electronics" {
+		t.Fatalf("Expected books and electronics at the top level, got %v", resp.Categories)
+	}
+	electronics := resp.Categories[1]
+	if electronics.ActiveProductCount != 0 || electronics.TotalActiveProductCount != 2 {
+		t.Errorf("Expected electronics to count 0 own and 2 total active products, got %d and %d",
+			electronics.ActiveProductCount, electronics.TotalActiveProductCount)
+	}
+	if len(electronics.Children) != 1 || electronics.Children[0].Path != "electronics/laptops" || electronics.Children[0].ActiveProductCount != 2 {
+		t.Errorf("Expected only laptops under electronics, got %v", electronics.Children)
+	}
+
+	resp, err = ts.opts.ProductHandler.ListCategories(ts.ctx, &pb.ListCategoriesRequest{IncludeEmpty: true})
+	if err != nil {
+		t.Fatalf("ListCategories failed: %v", err)
+	}
+	if children := resp.Categories[1].Children; len(children) != 2 || children[1].Name != "phones" || children[1].TotalActiveProductCount != 0 {
+		t.Errorf("Expected phones with no active products when asked for empty categories, got %v", children)
+	}
+}
+
+func TestReassignCategory(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	basePrice := domain.NewMoney(1500)
+	var ids []string
+	for i := 0; i < 3; i++ {
+		created, err := ts.createProduct.Execute(ts.ctx, &create_product.Request{
+			Name:        fmt.Sprintf("Garden Hose %d", i),
+			Description: "Expandable garden hose",
+			Category:    "garden",
+			BasePrice:   &basePrice,
+		})
+		if err != nil {
+			t.Fatalf("Failed to create product: %v", err)
+		}
+		ids = append(ids, created.ProductID)
+	}
+	sort.Strings(ids)
+
+	// Archived products keep their category
+	if _, err := ts.archiveProduct.Execute(ts.ctx, &archive_product.Request{ProductID: ids[2]}); err != nil {
+		t.Fatalf("Failed to archive product: %v", err)
+	}
+
+	productRepo := repo.NewSpannerProductRepository(ts.spannerClient)
+	reassign := reassign_category.NewInteractor(
+		repo.NewSpannerCategoryScanner(ts.spannerClient),
+		productRepo,
+		repo.NewSpannerVersionStore(ts.spannerClient),
+		spannerdriver.NewCommitter(ts.spannerClient),
+		clock.NewRealClock(),
+		domainServices.NewUniqueNamePolicy(nil),
+		repo.NewSpannerNameLookup(ts.spannerClient),
+		testValidationRules(t),
+	)
+
+	total, err := reassign.Count(ts.ctx, "garden", "")
+	if err != nil {
+		t.Fatalf("Failed to count products: %v", err)
+	}
+	if total != 2 {
+		t.Errorf("Expected 2 products to move, got %d", total)
+	}
+
+	progress := &rebuildProgress{}
+	resp, err := reassign.Execute(ts.ctx, &reassign_category.Request{From: "garden", To: "outdoor/garden"}, progress)
+	if err != nil {
+		t.Fatalf("Failed to reassign category: %v", err)
+	}
+	if resp.Moved != 2 || len(resp.Failures) != 0 || resp.LastProductID != ids[1] {
+		t.Errorf("Expected 2 moves ending at %s, got %+v", ids[1], resp)
+	}
+	if progress.processed != 2 || progress.checkpoint != ids[1] {
+		t.Errorf("Expected progress for 2 products ending at %s, got %+v", ids[1], progress)
+	}
+
+	for i, id := range ids {
+		got, err := ts.getProductQuery.Execute(ts.ctx, id)
+		if err != nil {
+			t.Fatalf("Failed to get product: %v", err)
+		}
+		want := "outdoor/garden"
+		if i == 2 {
+			want = "garden"
+		}
+		if got.Category != want {
+			t.Errorf("Expected product %s in %q, got %q", id, want, got.Category)
+		}
+	}
+
+	// Moving a category onto itself is refused
+	if _, err := reassign.Execute(ts.ctx, &reassign_category.Request{From: "garden", To: "garden"}, &rebuildProgress{}); !errors.Is(err, domain.ErrSameCategory) {
+		t.Errorf("Expected ErrSameCategory, got %v", err)
+	}
+}
+
+func TestTenantSettings(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	ctx := tenant.WithID(ts.ctx, "eu-merchant")
+	handler := ts.opts.ProductHandler
+
+	// Without overrides the tenant gets the service defaults
+	got, err := handler.GetTenantSettings(ctx, &pb.GetTenantSettingsRequest{})
+	if err != nil {
+		t.Fatalf("GetTenantSettings failed: %v", err)
+	}
+	if got.Settings != nil || got.Effective.DefaultCurrency != "USD" || got.Effective.MaxNameLength != 255 {
+		t.Errorf("Expected the service defaults, got %v", got)
+	}
+
+	if _, err := handler.PutTenantSettings(ctx, &pb.PutTenantSettingsRequest{
+		Settings: &pb.TenantSettings{DefaultCurrency: "eur"},
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument for a lowercase currency, got %v", err)
+	}
+	put, err := handler.PutTenantSettings(ctx, &pb.PutTenantSettingsRequest{
+		Settings: &pb.TenantSettings{DefaultCurrency: "EUR", MaxNameLength: 20, MaxDiscountBasisPoints: 2000},
+	})
+	if err != nil {
+		t.Fatalf("PutTenantSettings failed: %v", err)
+	}
+	if put.Effective.MaxNameLength != 20 || put.Effective.MaxDiscountBasisPoints != 2000 || put.Settings.UpdatedAt == nil {
+		t.Errorf("Expected the overrides to apply, got %v", put)
+	}
+
+	// Names longer than the tenant allows are refused; other tenants keep the default limit
+	basePrice := domain.NewMoney(5000)
+	create := func(ctx context.Context, name string) (*create_product.Response, error) {
+		return ts.createProduct.Execute(ctx, &create_product.Request{
+			Name:        name,
+			Description: "Hand-thrown ceramic mug",
+			Category:    "Kitchen",
+			BasePrice:   &basePrice,
+		})
+	}
+	var failed *domain.ValidationFailedError
+	if _, err := create(ctx, "Hand-thrown Ceramic Mug"); !errors.As(err, &failed) || failed.Violations[0].Field != "name" {
+		t.Errorf("Expected a name violation, got %v", err)
+	}
+	if _, err := create(ts.ctx, "Hand-thrown Ceramic Mug"); err != nil {
+		t.Errorf("Expected the default tenant to accept the name, got %v", err)
+	}
+	created, err := create(ctx, "Ceramic Mug")
+	if err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+	if _, err := ts.activateProduct.Execute(ctx, &activate_product.Request{ProductID: created.ProductID}); err != nil {
+		t.Fatalf("Failed to activate product: %v", err)
+	}
+
+	// Discounts above the tenant's maximum are refused
+	now := time.Now()
+	discount := func(percent int64) *apply_discount.Request {
+		amount := domain.NewMoney(percent)
+		return &apply_discount.Request{
+			ProductID: created.ProductID,
+			Discount:  &domain.Discount{ID: fmt.Sprintf("discount-%d", percent), Amount: &amount, StartDate: now.Add(-time.Hour), EndDate: now.Add(24 * time.Hour)},
+		}
+	}
+	if _, err := ts.applyDiscount.Execute(ctx, discount(30)); !errors.Is(err, domain.ErrDiscountOutOfRange) {
+		t.Errorf("Expected ErrDiscountOutOfRange, got %v", err)
+	}
+	if _, err := ts.applyDiscount.Execute(ctx, discount(20)); err != nil {
+		t.Errorf("Expected a 20%% discount to be accepted, got %v", err)
+	}
+
+	// Snippets without a currency are priced in the tenant's default currency
+	jsonLd, err := handler.GetProductJsonLd(ctx, &pb.GetProductJsonLdRequest{ProductId: created.ProductID})
+	if err != nil {
+		t.Fatalf("GetProductJsonLd failed: %v", err)
+	}
+	if !strings.Contains(jsonLd.JsonLd, `"priceCurrency":"EUR"`) {
+		t.Errorf("Expected the snippet to be priced in EUR, got %s", jsonLd.JsonLd)
+	}
+
+	// Deleting the overrides returns the tenant to the defaults
+	if _, err := handler.DeleteTenantSettings(ctx, &pb.DeleteTenantSettingsRequest{}); err != nil {
+		t.Fatalf("DeleteTenantSettings failed: %v", err)
+	}
+	if _, err := create(ctx, "Hand-thrown Ceramic Mug"); err != nil {
+		t.Errorf("Expected the default name limit after deleting the settings, got %v", err)
+	}
+}
+
+func TestTenantProvisioning(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	const operator = "ops"
+	realClock := clock.NewRealClock()
+	committer := spannerdriver.NewCommitter(ts.spannerClient)
+	registry := repo.NewSpannerTenantRegistry(ts.spannerClient)
+	settingsStore := repo.NewSpannerTenantSettingsStore(ts.spannerClient)
+	keys := apikey.NewManager(apikey.NewSpannerStore(ts.spannerClient), realClock, 0)
+	tenantConfig := tenant_config.NewTenantConfig(settingsStore, domain.TenantSettings{DefaultCurrency: "USD"}, realClock, 0)
+	createTenant := create_tenant.NewInteractor(registry, settingsStore, repo.NewSpannerCategoryTemplateStore(ts.spannerClient), keys, tenantConfig, committer, realClock, operator)
+	deleteTenant := delete_tenant.NewInteractor(registry, repo.NewSpannerTenantPurger(ts.spannerClient), keys, nil, nil, tenantConfig, committer, realClock, operator)
+
+	opsCtx := tenant.WithID(ts.ctx, operator)
+	acmeCtx := tenant.WithID(ts.ctx, "acme")
+	req := &create_tenant.Request{
+		TenantID:    "acme",
+		DisplayName: "Acme Inc.",
+		Settings:    &domain.TenantSettings{DefaultCurrency: "EUR"},
+		Templates: []domain.CategoryTemplate{{
+			Category:   "Laptops",
+			Attributes: []domain.AttributeDefinition{{Name: "ram_gb", Type: domain.AttributeTypeNumber, Required: true}},
+		}},
+		APIKeys: []create_tenant.KeySpec{{Name: "acme-admin", Scopes: []apikey.Scope{apikey.ScopeAdmin}}},
+	}
+
+	// Only the operator tenant may provision
+	if _, err := createTenant.Begin(ts.ctx, req); !errors.Is(err, domain.ErrTenantProvisioningDenied) {
+		t.Errorf("Expected ErrTenantProvisioningDenied, got %v", err)
+	}
+
+	reg, err := createTenant.Begin(opsCtx, req)
+	if err != nil {
+		t.Fatalf("Failed to register tenant: %v", err)
+	}
+	if reg.Tenant.State != domain.TenantProvisioning || len(reg.Keys) != 1 || reg.Keys[0].Key.TenantID != "acme" || reg.Keys[0].Secret == "" {
+		t.Errorf("Expected a provisioning tenant with one acme key, got %+v", reg)
+	}
+	if _, err := createTenant.Begin(opsCtx, req); !errors.Is(err, domain.ErrTenantExists) {
+		t.Errorf("Expected ErrTenantExists, got %v", err)
+	}
+
+	created, err := createTenant.Execute(opsCtx, req, &rebuildProgress{})
+	if err != nil {
+		t.Fatalf("Failed to provision tenant: %v", err)
+	}
+	if created.Tenant.State != domain.TenantActive || created.CategoryTemplates != 1 {
+		t.Errorf("Expected an active tenant with one template, got %+v", created)
+	}
+	settings, err := tenantConfig.Settings(acmeCtx)
+	if err != nil {
+		t.Fatalf("Failed to load settings: %v", err)
+	}
+	if settings.DefaultCurrency != "EUR" {
+		t.Errorf("Expected EUR as the tenant's currency, got %q", settings.DefaultCurrency)
+	}
+
+	basePrice := domain.NewMoney(99900)
+	product, err := ts.createProduct.Execute(acmeCtx, &create_product.Request{
+		Name:        "Ultrabook 14",
+		Description: "Lightweight laptop",
+		Category:    "Laptops",
+		BasePrice:   &basePrice,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+
+	// The operator cannot delete itself, and exports need a bucket
+	if _, _, err := deleteTenant.Begin(opsCtx, &delete_tenant.Request{TenantID: operator}); !errors.Is(err, domain.ErrOperatorTenantDeletion) {
+		t.Errorf("Expected ErrOperatorTenantDeletion, got %v", err)
+	}
+	if _, _, err := deleteTenant.Begin(opsCtx, &delete_tenant.Request{TenantID: "acme", Export: true}); !errors.Is(err, domain.ErrTenantExportUnavailable) {
+		t.Errorf("Expected ErrTenantExportUnavailable, got %v", err)
+	}
+
+	deleting, total, err := deleteTenant.Begin(opsCtx, &delete_tenant.Request{TenantID: "acme"})
+	if err != nil {
+		t.Fatalf("Failed to start deletion: %v", err)
+	}
+	if deleting.State != domain.TenantDeleting || total != 1 {
+		t.Errorf("Expected a deleting tenant with 1 product, got %+v and %d", deleting, total)
+	}
+	if _, err := createTenant.Begin(opsCtx, req); !errors.Is(err, domain.ErrTenantBeingDeleted) {
+		t.Errorf("Expected ErrTenantBeingDeleted, got %v", err)
+	}
+
+	progress := &rebuildProgress{}
+	deleted, err := deleteTenant.Execute(opsCtx, &delete_tenant.Request{TenantID: "acme"}, progress)
+	if err != nil {
+		t.Fatalf("Failed to delete tenant: %v", err)
+	}
+	if deleted.Tenant.State != domain.TenantDeleted || deleted.ProductsPurged != 1 || deleted.KeysRevoked != 1 || deleted.RowsDeleted == 0 {
+		t.Errorf("Expected a deleted tenant with 1 product and 1 key removed, got %+v", deleted)
+	}
+	if progress.processed != 1 || progress.checkpoint != product.ProductID {
+		t.Errorf("Expected progress for 1 product, got %+v", progress)
+	}
+
+	// Nothing of the tenant is left
+	if _, err := ts.getProductQuery.Execute(acmeCtx, product.ProductID); !errors.Is(err, domain.ErrProductNotFound) {
+		t.Errorf("Expected the product to be purged, got %v", err)
+	}
+	if stored, err := settingsStore.Load(acmeCtx); err != nil || stored != nil {
+		t.Errorf("Expected no settings, got %v, %v", stored, err)
+	}
+	if remaining, err := keys.List(acmeCtx); err != nil || len(remaining) != 0 {
+		t.Errorf("Expected no keys, got %d, %v", len(remaining), err)
+	}
+
+	// A deleted tenant's ID can be provisioned again under a new registration
+	again, err := createTenant.Begin(opsCtx, req)
+	if err != nil {
+		t.Fatalf("Failed to register tenant again: %v", err)
+	}
+	if again.Tenant.RegistrationID == reg.Tenant.RegistrationID {
+		t.Errorf("Expected a new registration ID, got %s again", again.Tenant.RegistrationID)
+	}
+}
+
+func TestCloneProduct(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	const operator = "ops"
+	realClock := clock.NewRealClock()
+	spannerClient := ts.spannerClient
+	tenantConfig := tenant_config.NewTenantConfig(repo.NewSpannerTenantSettingsStore(spannerClient), domain.TenantSettings{DefaultCurrency: "USD"}, realClock, 0)
+	cloneProduct := clone_product.NewInteractor(
+		repo.NewSpannerProductRepository(spannerClient),
+		repo.NewSpannerVersionStore(spannerClient),
+		repo.NewSpannerCategoryTemplateStore(spannerClient),
+		repo.NewSpannerTenantRegistry(spannerClient),
+		spannerdriver.NewCommitter(spannerClient),
+		realClock,
+		repo.NewSpannerQuotaCounter(spannerClient),
+		domainServices.NewQuotaPolicy(domainServices.QuotaLimits{}),
+		nil,
+		repo.NewSpannerNameLookup(spannerClient),
+		testValidationRules(t),
+		idgen.NewRandom(),
+		tenantConfig,
+		operator,
+	)
+
+	opsCtx := tenant.WithID(ts.ctx, operator)
+	basePrice := domain.NewMoney(4999)
+	source, err := ts.createProduct.Execute(opsCtx, &create_product.Request{
+		Name:        "Trail Runner",
+		Description: "Lightweight running shoe",
+		Category:    "Shoes",
+		SKU:         "TRAIL-001",
+		BasePrice:   &basePrice,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+	if _, err := ts.setMetadata.Execute(opsCtx, &set_metadata.Request{
+		ProductID: source.ProductID,
+		Metadata:  domain.Metadata{"image_url": "https://cdn.example.com/trail.jpg"},
+	}); err != nil {
+		t.Fatalf("Failed to set metadata: %v", err)
+	}
+
+	// Prices are copied or given, never left unset
+	if _, err := cloneProduct.Execute(opsCtx, &clone_product.Request{ProductID: source.ProductID}); !errors.Is(err, domain.ErrInvalidPrice) {
+		t.Errorf("Expected ErrInvalidPrice, got %v", err)
+	}
+
+	// A clone within the tenant is a new inactive product without the source's SKU
+	newPrice := domain.NewMoney(3999)
+	sibling, err := cloneProduct.Execute(opsCtx, &clone_product.Request{
+		ProductID: source.ProductID,
+		Name:      "Trail Runner Kids",
+		BasePrice: &newPrice,
+	})
+	if err != nil {
+		t.Fatalf("Failed to clone product: %v", err)
+	}
+	got, err := ts.getProductQuery.Execute(opsCtx, sibling.ProductID)
+	if err != nil {
+		t.Fatalf("Failed to get clone: %v", err)
+	}
+	if got.ID == source.ProductID || got.Name != "Trail Runner Kids" || got.Status != "inactive" || got.SKU != "" {
+		t.Errorf("Expected an inactive renamed clone without SKU, got %+v", got)
+	}
+	if got.BasePrice.Cmp(newPrice) != 0 || got.Metadata["image_url"] != "https://cdn.example.com/trail.jpg" {
+		t.Errorf("Expected the given price and the source's metadata, got %v and %v", got.BasePrice, got.Metadata)
+	}
+
+	// Only the operator tenant may clone into another tenant
+	acmeCtx := tenant.WithID(ts.ctx, "acme")
+	if _, err := cloneProduct.Execute(acmeCtx, &clone_product.Request{ProductID: source.ProductID, TargetTenantID: operator, CopyPrices: true}); !errors.Is(err, domain.ErrCrossTenantCloneDenied) {
+		t.Errorf("Expected ErrCrossTenantCloneDenied, got %v", err)
+	}
+	if _, err := cloneProduct.Execute(opsCtx, &clone_product.Request{ProductID: source.ProductID, TargetTenantID: "Not A Tenant", CopyPrices: true}); !errors.Is(err, domain.ErrInvalidTenant) {
+		t.Errorf("Expected ErrInvalidTenant, got %v", err)
+	}
+
+	cloned, err := cloneProduct.Execute(opsCtx, &clone_product.Request{ProductID: source.ProductID, TargetTenantID: "acme", CopyPrices: true})
+	if err != nil {
+		t.Fatalf("Failed to clone product into acme: %v", err)
+	}
+	if cloned.TenantID != "acme" {
+		t.Errorf("Expected the clone in acme, got %s", cloned.TenantID)
+	}
+	got, err = ts.getProductQuery.Execute(acmeCtx, cloned.ProductID)
+	if err != nil {
+		t.Fatalf("Failed to get clone in acme: %v", err)
+	}
+	if got.Name != "Trail Runner" || got.BasePrice.Cmp(basePrice) != 0 {
+		t.Errorf("Expected the source's name and price, got %q and %v", got.Name, got.BasePrice)
+	}
+	if _, err := ts.getProductQuery.Execute(opsCtx, cloned.ProductID); !errors.Is(err, domain.ErrProductNotFound) {
+		t.Errorf("Expected the clone to be invisible to the operator tenant, got %v", err)
+	}
+
+	// product_created names the source
+	var payload string
+	err = spannerClient.Single().Query(ts.ctx, spanner.Statement{
+		SQL:    "SELECT TO_JSON_STRING(payload) FROM outbox_events_by_aggregate WHERE aggregate_id = @id AND event_type = 'product_created'",
+		Params: map[string]interface{}{"id": cloned.ProductID},
+	}).Do(func(row *spanner.Row) error {
+		return row.Column(0, &payload)
+	})
+	if err != nil {
+		t.Fatalf("Failed to read product_created: %v", err)
+	}
+	if !strings.Contains(payload, `"cloned_from":{"product_id":"`+source.ProductID+`","tenant_id":"`+operator+`"}`) {
+		t.Errorf("Expected cloned_from in the payload, got %s", payload)
+	}
+}
+
+func TestGRPCWeb(t *testing.T) {
+	t.Parallel()
+
+	ts := setupTest(t)
+	defer ts.teardownTest(t)
+	defer ts.cleanupDatabase(t)
+
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(tenant.UnaryServerInterceptor()))
+	pb.RegisterProductServiceServer(server, ts.opts.ProductHandler)
+	web := httptest.NewServer(gateway.New(gateway.Settings{
+		CORS: gateway.CORSSettings{
+			AllowedOrigins: []string{"https://admin.example.com"},
+			AllowedMethods: []string{http.MethodPost},
+			ExposedHeaders: grpcweb.ExposedHeaders,
+		},
+		Security: gateway.SecuritySettings{HSTSMaxAge: time.Hour},
+	}, grpcweb.New(server)))
+	defer web.Close()
+
+	basePrice := domain.NewMoney(1999)
+	created, err := ts.createProduct.Execute(tenant.WithID(ts.ctx, "web"), &create_product.Request{
+		Name:        "Browser Mug",
+		Description: "Mug for the admin console test",
+		Category:    "Kitchen",
+		BasePrice:   &basePrice,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+
+	// call posts one GetProduct over gRPC-Web and returns the response message and the trailers
+	call := func(contentType, origin, productID string) (*http.Response, []byte, string) {
+		t.Helper()
+		msg, err := proto.Marshal(&pb.GetProductRequest{ProductId: productID})
+		if err != nil {
+			t.Fatalf("Failed to marshal request: %v", err)
+		}
+		body := append([]byte{0, 0, 0, 0, byte(len(msg))}, msg...)
+		text := strings.HasPrefix(contentType, "application/grpc-web-text")
+		if text {
+			body = []byte(base64.StdEncoding.EncodeToString(body))
+		}
+		req, err := http.NewRequest(http.MethodPost, web.URL+pb.ProductService_GetProduct_FullMethodName, bytes.NewReader(body))
+		if err != nil {
+			t.Fatalf("Failed to build request: %v", err)
+		}
+		req.Header.Set("Content-Type", contentType)
+		req.Header.Set("Origin", origin)
+		req.Header.Set(tenant.MetadataKey, "web")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Failed to call gRPC-Web: %v", err)
+		}
+		defer resp.Body.Close()
+		data, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("Failed to read response: %v", err)
+		}
+		if text {
+			if data, err = base64.StdEncoding.DecodeString(string(data)); err != nil {
+				t.Fatalf("Failed to decode grpc-web-text response: %v", err)
+			}
+		}
+
+		var message []byte
+		var trailers string
+		for len(data) >= 5 {
+			size := binary.BigEndian.Uint32(data[1:5])
+			frame := data[5 : 5+size]
+			if data[0]&0x80 != 0 {
+				trailers = string(frame)
+			} else {
+				message = frame
+			}
+			data = data[5+size:]
+		}
+		return resp, message, trailers
+	}
+
+	for _, contentType := range []string{"application/grpc-web+proto", "application/grpc-web-text+proto"} {
+		resp, message, trailers := call(contentType, "https://admin.example.com", created.ProductID)
+		if resp.StatusCode != http.StatusOK || resp.Header.Get("Access-Control-Allow-Origin") != "https://admin.example.com" {
+			t.Fatalf("%s: expected 200 with the origin allowed, got %d %v", contentType, resp.StatusCode, resp.Header)
+		}
+		if resp.Header.Get("X-Content-Type-Options") != "nosniff" || resp.Header.Get("Strict-Transport-Security") != "" {
+			t.Errorf("%s: expected security headers without HSTS over plaintext, got %v", contentType, resp.Header)
+		}
+		if resp.Header.Get("Content-Type") != contentType || !strings.Contains(trailers, "grpc-status: 0\r\n") {
+			t.Errorf("%s: expected a %s response with status OK, got %q and trailers %q", contentType, contentType, resp.Header.Get("Content-Type"), trailers)
+		}
+		var got pb.GetProductResponse
+		if err := proto.Unmarshal(message, &got); err != nil {
+			t.Fatalf("%s: failed to unmarshal response: %v", contentType, err)
+		}
+		if got.Product.GetName() != "Browser Mug" {
+			t.Errorf("%s: expected the web tenant's product, got %v", contentType, got.Product)
+		}
+	}
+
+	// Errors are reported in the trailers
+	if _, _, trailers := call("application/grpc-web+proto", "https://admin.example.com", "missing"); !strings.Contains(trailers, fmt.Sprintf("grpc-status: %d\r\n", codes.NotFound)) {
+		t.Errorf("Expected NOT_FOUND in the trailers, got %q", trailers)
+	}
+
+	// Other origins are refused, preflights included
+	if resp, _, _ := call("application/grpc-web+proto", "https://evil.example.com", created.ProductID); resp.StatusCode != http.StatusForbidden {
+		t.Errorf("Expected 403 for another origin, got %d", resp.StatusCode)
+	}
+	req, err := http.NewRequest(http.MethodOptions, web.URL+pb.ProductService_GetProduct_FullMethodName, nil)
+	if err != nil {
+		t.Fatalf("Failed to build preflight: %v", err)
+	}
+	req.Header.Set("Origin", "https://admin.example.com")
+	req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+	req.Header.Set("Access-Control-Request-Headers", "content-type,x-grpc-web,x-tenant-id")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Failed to send preflight: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent || resp.Header.Get("Access-Control-Allow-Headers") != "content-type,x-grpc-web,x-tenant-id" {
+		t.Errorf("Expected the preflight to allow the requested headers, got %d %v", resp.StatusCode, resp.Header)
+	}
+	req.Header.Set("Access-Control-Request-Method", http.MethodDelete)
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Failed to send preflight: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("Expected 403 for a preflight of a method that is not allowed, got %d", resp.StatusCode)
+	}
+}